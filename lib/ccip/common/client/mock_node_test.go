@@ -5,6 +5,8 @@ package client
 import (
 	context "context"
 
+	time "time"
+
 	types "github.com/smartcontractkit/chainlink/v2/common/types"
 	mock "github.com/stretchr/testify/mock"
 )
@@ -247,6 +249,51 @@ func (_c *mockNode_Order_Call[CHAIN_ID, HEAD, RPC]) RunAndReturn(run func() int3
 	return _c
 }
 
+// QualityStats provides a mock function with given fields:
+func (_m *mockNode[CHAIN_ID, HEAD, RPC]) QualityStats() NodeQualityStats {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for QualityStats")
+	}
+
+	var r0 NodeQualityStats
+	if rf, ok := ret.Get(0).(func() NodeQualityStats); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(NodeQualityStats)
+	}
+
+	return r0
+}
+
+// mockNode_QualityStats_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'QualityStats'
+type mockNode_QualityStats_Call[CHAIN_ID types.ID, HEAD Head, RPC NodeClient[CHAIN_ID, HEAD]] struct {
+	*mock.Call
+}
+
+// QualityStats is a helper method to define mock.On call
+func (_e *mockNode_Expecter[CHAIN_ID, HEAD, RPC]) QualityStats() *mockNode_QualityStats_Call[CHAIN_ID, HEAD, RPC] {
+	return &mockNode_QualityStats_Call[CHAIN_ID, HEAD, RPC]{Call: _e.mock.On("QualityStats")}
+}
+
+func (_c *mockNode_QualityStats_Call[CHAIN_ID, HEAD, RPC]) Run(run func()) *mockNode_QualityStats_Call[CHAIN_ID, HEAD, RPC] {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *mockNode_QualityStats_Call[CHAIN_ID, HEAD, RPC]) Return(_a0 NodeQualityStats) *mockNode_QualityStats_Call[CHAIN_ID, HEAD, RPC] {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *mockNode_QualityStats_Call[CHAIN_ID, HEAD, RPC]) RunAndReturn(run func() NodeQualityStats) *mockNode_QualityStats_Call[CHAIN_ID, HEAD, RPC] {
+	_c.Call.Return(run)
+	return _c
+}
+
 // RPC provides a mock function with given fields:
 func (_m *mockNode[CHAIN_ID, HEAD, RPC]) RPC() RPC {
 	ret := _m.Called()
@@ -292,6 +339,40 @@ func (_c *mockNode_RPC_Call[CHAIN_ID, HEAD, RPC]) RunAndReturn(run func() RPC) *
 	return _c
 }
 
+// RecordCallResult provides a mock function with given fields: latency, err
+func (_m *mockNode[CHAIN_ID, HEAD, RPC]) RecordCallResult(latency time.Duration, err error) {
+	_m.Called(latency, err)
+}
+
+// mockNode_RecordCallResult_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RecordCallResult'
+type mockNode_RecordCallResult_Call[CHAIN_ID types.ID, HEAD Head, RPC NodeClient[CHAIN_ID, HEAD]] struct {
+	*mock.Call
+}
+
+// RecordCallResult is a helper method to define mock.On call
+//   - latency time.Duration
+//   - err error
+func (_e *mockNode_Expecter[CHAIN_ID, HEAD, RPC]) RecordCallResult(latency interface{}, err interface{}) *mockNode_RecordCallResult_Call[CHAIN_ID, HEAD, RPC] {
+	return &mockNode_RecordCallResult_Call[CHAIN_ID, HEAD, RPC]{Call: _e.mock.On("RecordCallResult", latency, err)}
+}
+
+func (_c *mockNode_RecordCallResult_Call[CHAIN_ID, HEAD, RPC]) Run(run func(latency time.Duration, err error)) *mockNode_RecordCallResult_Call[CHAIN_ID, HEAD, RPC] {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(time.Duration), args[1].(error))
+	})
+	return _c
+}
+
+func (_c *mockNode_RecordCallResult_Call[CHAIN_ID, HEAD, RPC]) Return() *mockNode_RecordCallResult_Call[CHAIN_ID, HEAD, RPC] {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *mockNode_RecordCallResult_Call[CHAIN_ID, HEAD, RPC]) RunAndReturn(run func(time.Duration, error)) *mockNode_RecordCallResult_Call[CHAIN_ID, HEAD, RPC] {
+	_c.Call.Return(run)
+	return _c
+}
+
 // SetPoolChainInfoProvider provides a mock function with given fields: _a0
 func (_m *mockNode[CHAIN_ID, HEAD, RPC]) SetPoolChainInfoProvider(_a0 PoolChainInfoProvider) {
 	_m.Called(_a0)
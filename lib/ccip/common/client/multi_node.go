@@ -1,6 +1,7 @@
 package client
 
 import (
+	"bytes"
 	"context"
 	"fmt"
 	"math"
@@ -102,6 +103,7 @@ type multiNode[
 	reportInterval        time.Duration
 	deathDeclarationDelay time.Duration
 	sendTxSoftTimeout     time.Duration // defines max waiting time from first response til responses evaluation
+	quorumReads           QuorumReadConfig
 
 	activeMu   sync.RWMutex
 	activeNode Node[CHAIN_ID, HEAD, RPC_CLIENT]
@@ -138,6 +140,7 @@ func NewMultiNode[
 	classifySendTxError func(tx TX, err error) SendTxReturnCode,
 	sendTxSoftTimeout time.Duration,
 	deathDeclarationDelay time.Duration,
+	quorumReads QuorumReadConfig,
 ) MultiNode[CHAIN_ID, SEQ, ADDR, BLOCK_HASH, TX, TX_HASH, EVENT, EVENT_OPS, TX_RECEIPT, FEE, HEAD, RPC_CLIENT, BATCH_ELEM] {
 	nodeSelector := newNodeSelector(selectionMode, nodes)
 	// Prometheus' default interval is 15s, set this to under 7.5s to avoid
@@ -161,6 +164,7 @@ func NewMultiNode[
 		reportInterval:        reportInterval,
 		deathDeclarationDelay: deathDeclarationDelay,
 		sendTxSoftTimeout:     sendTxSoftTimeout,
+		quorumReads:           quorumReads,
 	}
 
 	c.lggr.Debugf("The MultiNode is configured to use NodeSelectionMode: %s", selectionMode)
@@ -404,19 +408,25 @@ func (c *multiNode[CHAIN_ID, SEQ, ADDR, BLOCK_HASH, TX, TX_HASH, EVENT, EVENT_OP
 }
 
 // ClientAPI methods
-func (c *multiNode[CHAIN_ID, SEQ, ADDR, BLOCK_HASH, TX, TX_HASH, EVENT, EVENT_OPS, TX_RECEIPT, FEE, HEAD, RPC_CLIENT, BATCH_ELEM]) BalanceAt(ctx context.Context, account ADDR, blockNumber *big.Int) (*big.Int, error) {
+//
+// Read methods record the latency and outcome of each call in the node's quality stats, which are used by the
+// WeightedLatency node selector. Calls that usually fail because of the request rather than the RPC, like
+// CallContract or EstimateGas reverting, are not recorded.
+func (c *multiNode[CHAIN_ID, SEQ, ADDR, BLOCK_HASH, TX, TX_HASH, EVENT, EVENT_OPS, TX_RECEIPT, FEE, HEAD, RPC_CLIENT, BATCH_ELEM]) BalanceAt(ctx context.Context, account ADDR, blockNumber *big.Int) (b *big.Int, err error) {
 	n, err := c.selectNode()
 	if err != nil {
 		return nil, err
 	}
+	defer func(start time.Time) { n.RecordCallResult(time.Since(start), err) }(time.Now())
 	return n.RPC().BalanceAt(ctx, account, blockNumber)
 }
 
-func (c *multiNode[CHAIN_ID, SEQ, ADDR, BLOCK_HASH, TX, TX_HASH, EVENT, EVENT_OPS, TX_RECEIPT, FEE, HEAD, RPC_CLIENT, BATCH_ELEM]) BatchCallContext(ctx context.Context, b []BATCH_ELEM) error {
+func (c *multiNode[CHAIN_ID, SEQ, ADDR, BLOCK_HASH, TX, TX_HASH, EVENT, EVENT_OPS, TX_RECEIPT, FEE, HEAD, RPC_CLIENT, BATCH_ELEM]) BatchCallContext(ctx context.Context, b []BATCH_ELEM) (err error) {
 	n, err := c.selectNode()
 	if err != nil {
 		return err
 	}
+	defer func(start time.Time) { n.RecordCallResult(time.Since(start), err) }(time.Now())
 	return n.RPC().BatchCallContext(ctx, b)
 }
 
@@ -467,6 +477,7 @@ func (c *multiNode[CHAIN_ID, SEQ, ADDR, BLOCK_HASH, TX, TX_HASH, EVENT, EVENT_OP
 	if err != nil {
 		return h, err
 	}
+	defer func(start time.Time) { n.RecordCallResult(time.Since(start), err) }(time.Now())
 	return n.RPC().BlockByHash(ctx, hash)
 }
 
@@ -475,14 +486,29 @@ func (c *multiNode[CHAIN_ID, SEQ, ADDR, BLOCK_HASH, TX, TX_HASH, EVENT, EVENT_OP
 	if err != nil {
 		return h, err
 	}
+	// block tags such as latest or finalized are negative and resolve to a different block on each node
+	if number != nil && number.Sign() >= 0 && c.quorumReads.enabled(QuorumMethodGetBlockByNumber) {
+		return quorumRead(ctx, c.lggr, c.chainFamily, c.chainID, c.quorumReads, QuorumMethodGetBlockByNumber, n, c.nodes,
+			func(ctx context.Context, rpc RPC_CLIENT) (HEAD, error) {
+				return rpc.BlockByNumber(ctx, number)
+			},
+			func(a, b HEAD) bool {
+				if !a.IsValid() || !b.IsValid() {
+					return a.IsValid() == b.IsValid()
+				}
+				return a.BlockHash() == b.BlockHash()
+			})
+	}
+	defer func(start time.Time) { n.RecordCallResult(time.Since(start), err) }(time.Now())
 	return n.RPC().BlockByNumber(ctx, number)
 }
 
-func (c *multiNode[CHAIN_ID, SEQ, ADDR, BLOCK_HASH, TX, TX_HASH, EVENT, EVENT_OPS, TX_RECEIPT, FEE, HEAD, RPC_CLIENT, BATCH_ELEM]) CallContext(ctx context.Context, result interface{}, method string, args ...interface{}) error {
+func (c *multiNode[CHAIN_ID, SEQ, ADDR, BLOCK_HASH, TX, TX_HASH, EVENT, EVENT_OPS, TX_RECEIPT, FEE, HEAD, RPC_CLIENT, BATCH_ELEM]) CallContext(ctx context.Context, result interface{}, method string, args ...interface{}) (err error) {
 	n, err := c.selectNode()
 	if err != nil {
 		return err
 	}
+	defer func(start time.Time) { n.RecordCallResult(time.Since(start), err) }(time.Now())
 	return n.RPC().CallContext(ctx, result, method, args...)
 }
 
@@ -495,6 +521,13 @@ func (c *multiNode[CHAIN_ID, SEQ, ADDR, BLOCK_HASH, TX, TX_HASH, EVENT, EVENT_OP
 	if err != nil {
 		return rpcErr, err
 	}
+	// calls against the latest block or a block tag may legitimately differ between nodes
+	if blockNumber != nil && blockNumber.Sign() >= 0 && c.quorumReads.enabled(QuorumMethodCall) {
+		return quorumRead(ctx, c.lggr, c.chainFamily, c.chainID, c.quorumReads, QuorumMethodCall, n, c.nodes,
+			func(ctx context.Context, rpc RPC_CLIENT) ([]byte, error) {
+				return rpc.CallContract(ctx, attempt, blockNumber)
+			}, bytes.Equal)
+	}
 	return n.RPC().CallContract(ctx, attempt, blockNumber)
 }
 
@@ -516,6 +549,7 @@ func (c *multiNode[CHAIN_ID, SEQ, ADDR, BLOCK_HASH, TX, TX_HASH, EVENT, EVENT_OP
 	if err != nil {
 		return id, err
 	}
+	defer func(start time.Time) { n.RecordCallResult(time.Since(start), err) }(time.Now())
 	return n.RPC().ChainID(ctx)
 }
 
@@ -524,6 +558,7 @@ func (c *multiNode[CHAIN_ID, SEQ, ADDR, BLOCK_HASH, TX, TX_HASH, EVENT, EVENT_OP
 	if err != nil {
 		return code, err
 	}
+	defer func(start time.Time) { n.RecordCallResult(time.Since(start), err) }(time.Now())
 	return n.RPC().CodeAt(ctx, account, blockNumber)
 }
 
@@ -544,6 +579,14 @@ func (c *multiNode[CHAIN_ID, SEQ, ADDR, BLOCK_HASH, TX, TX_HASH, EVENT, EVENT_OP
 	if err != nil {
 		return e, err
 	}
+	if c.quorumReads.enabled(QuorumMethodGetLogs) && c.quorumReads.EventsToBlock != nil &&
+		finalizedByLiveNodes(c.nodes, c.quorumReads.EventsToBlock(query)) {
+		return quorumRead(ctx, c.lggr, c.chainFamily, c.chainID, c.quorumReads, QuorumMethodGetLogs, n, c.nodes,
+			func(ctx context.Context, rpc RPC_CLIENT) ([]EVENT, error) {
+				return rpc.FilterEvents(ctx, query)
+			}, equalEvents[EVENT])
+	}
+	defer func(start time.Time) { n.RecordCallResult(time.Since(start), err) }(time.Now())
 	return n.RPC().FilterEvents(ctx, query)
 }
 
@@ -552,6 +595,7 @@ func (c *multiNode[CHAIN_ID, SEQ, ADDR, BLOCK_HASH, TX, TX_HASH, EVENT, EVENT_OP
 	if err != nil {
 		return h, err
 	}
+	defer func(start time.Time) { n.RecordCallResult(time.Since(start), err) }(time.Now())
 	return n.RPC().LatestBlockHeight(ctx)
 }
 
@@ -560,6 +604,7 @@ func (c *multiNode[CHAIN_ID, SEQ, ADDR, BLOCK_HASH, TX, TX_HASH, EVENT, EVENT_OP
 	if err != nil {
 		return b, err
 	}
+	defer func(start time.Time) { n.RecordCallResult(time.Since(start), err) }(time.Now())
 	return n.RPC().LINKBalance(ctx, accountAddress, linkAddress)
 }
 
@@ -579,6 +624,7 @@ func (c *multiNode[CHAIN_ID, SEQ, ADDR, BLOCK_HASH, TX, TX_HASH, EVENT, EVENT_OP
 	if err != nil {
 		return s, err
 	}
+	defer func(start time.Time) { n.RecordCallResult(time.Since(start), err) }(time.Now())
 	return n.RPC().PendingSequenceAt(ctx, addr)
 }
 
@@ -803,6 +849,7 @@ func (c *multiNode[CHAIN_ID, SEQ, ADDR, BLOCK_HASH, TX, TX_HASH, EVENT, EVENT_OP
 	if err != nil {
 		return s, err
 	}
+	defer func(start time.Time) { n.RecordCallResult(time.Since(start), err) }(time.Now())
 	return n.RPC().SequenceAt(ctx, account, blockNumber)
 }
 
@@ -835,6 +882,7 @@ func (c *multiNode[CHAIN_ID, SEQ, ADDR, BLOCK_HASH, TX, TX_HASH, EVENT, EVENT_OP
 	if err != nil {
 		return b, err
 	}
+	defer func(start time.Time) { n.RecordCallResult(time.Since(start), err) }(time.Now())
 	return n.RPC().TokenBalance(ctx, account, tokenAddr)
 }
 
@@ -843,6 +891,7 @@ func (c *multiNode[CHAIN_ID, SEQ, ADDR, BLOCK_HASH, TX, TX_HASH, EVENT, EVENT_OP
 	if err != nil {
 		return tx, err
 	}
+	defer func(start time.Time) { n.RecordCallResult(time.Since(start), err) }(time.Now())
 	return n.RPC().TransactionByHash(ctx, txHash)
 }
 
@@ -851,6 +900,7 @@ func (c *multiNode[CHAIN_ID, SEQ, ADDR, BLOCK_HASH, TX, TX_HASH, EVENT, EVENT_OP
 	if err != nil {
 		return txr, err
 	}
+	defer func(start time.Time) { n.RecordCallResult(time.Since(start), err) }(time.Now())
 	return n.RPC().TransactionReceipt(ctx, txHash)
 }
 
@@ -859,6 +909,7 @@ func (c *multiNode[CHAIN_ID, SEQ, ADDR, BLOCK_HASH, TX, TX_HASH, EVENT, EVENT_OP
 	if err != nil {
 		return head, err
 	}
+	defer func(start time.Time) { n.RecordCallResult(time.Since(start), err) }(time.Now())
 
 	return n.RPC().LatestFinalizedBlock(ctx)
 }
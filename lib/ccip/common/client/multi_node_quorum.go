@@ -0,0 +1,215 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"reflect"
+	"slices"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/logger"
+
+	"github.com/smartcontractkit/chainlink/v2/common/types"
+)
+
+// Read methods that can be configured to require a quorum of nodes
+const (
+	QuorumMethodCall             = "eth_call"
+	QuorumMethodGetLogs          = "eth_getLogs"
+	QuorumMethodGetBlockByNumber = "eth_getBlockByNumber"
+)
+
+var QuorumMethods = []string{QuorumMethodCall, QuorumMethodGetLogs, QuorumMethodGetBlockByNumber}
+
+var (
+	// ErrQuorumNotReached is returned when not enough nodes agree on the result of a quorum read
+	ErrQuorumNotReached = errors.New("quorum not reached")
+	// errQuorumMismatch is recorded as the call result of nodes that disagree with the quorum
+	errQuorumMismatch = errors.New("result does not match the quorum")
+)
+
+var (
+	promMultiNodeQuorumReads = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "multi_node_quorum_reads",
+		Help: "The total number of quorum reads, by method and outcome",
+	}, []string{"network", "chainID", "method", "outcome"})
+	promPoolRPCNodeQuorumMismatches = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "pool_rpc_node_quorum_mismatches",
+		Help: "The total number of quorum reads where the given RPC node returned a result that did not match the quorum",
+	}, []string{"network", "chainID", "nodeName", "method"})
+)
+
+// QuorumReadConfig configures reads that are sent to several nodes in parallel and only returned once enough of
+// them agree on the result. It protects callers from a single lying or lagging RPC.
+type QuorumReadConfig struct {
+	// Methods that require a quorum, see QuorumMethods. Quorum reads are disabled if empty.
+	Methods []string
+	// Nodes is the number of live nodes queried in parallel
+	Nodes int
+	// Threshold is the number of nodes that must return the same result
+	Threshold int
+	// EventsToBlock returns the last block of an event query, or nil if the query is not bounded by a block number.
+	// Events are only read with a quorum when every live node has finalized that block, as nodes may legitimately
+	// disagree on the events of more recent blocks.
+	EventsToBlock func(query any) *big.Int
+}
+
+func (q QuorumReadConfig) enabled(method string) bool {
+	return q.Threshold > 0 && slices.Contains(q.Methods, method)
+}
+
+// finalizedByLiveNodes reports whether every live node has finalized block
+func finalizedByLiveNodes[CHAIN_ID types.ID, HEAD Head, RPC NodeClient[CHAIN_ID, HEAD]](nodes []Node[CHAIN_ID, HEAD, RPC], block *big.Int) bool {
+	if block == nil || block.Sign() < 0 || !block.IsInt64() {
+		return false
+	}
+	live := false
+	for _, n := range nodes {
+		state, chainInfo := n.StateAndLatest()
+		if state != nodeStateAlive {
+			continue
+		}
+		if chainInfo.FinalizedBlockNumber < block.Int64() {
+			return false
+		}
+		live = true
+	}
+	return live
+}
+
+// equalEvents compares the events returned by two nodes, nodes may return either nil or an empty slice for no events
+func equalEvents[EVENT any](a, b []EVENT) bool {
+	if len(a) == 0 || len(b) == 0 {
+		return len(a) == len(b)
+	}
+	return reflect.DeepEqual(a, b)
+}
+
+type quorumResult[T any] struct {
+	nodeName string
+	value    T
+	err      error
+	latency  time.Duration
+}
+
+// quorumRead calls fn on cfg.Nodes live nodes, starting with the selected one, and returns the result as soon as
+// cfg.Threshold of them agree on it. Identical errors count as agreement, so that a call reverting on every node
+// is returned as is. Nodes that disagree with the quorum are penalised in their quality stats, calls still in
+// flight once the quorum is reached are cancelled.
+func quorumRead[CHAIN_ID types.ID, HEAD Head, RPC NodeClient[CHAIN_ID, HEAD], T any](
+	ctx context.Context,
+	lggr logger.SugaredLogger,
+	chainFamily string,
+	chainID CHAIN_ID,
+	cfg QuorumReadConfig,
+	method string,
+	selected Node[CHAIN_ID, HEAD, RPC],
+	nodes []Node[CHAIN_ID, HEAD, RPC],
+	fn func(ctx context.Context, rpc RPC) (T, error),
+	equal func(a, b T) bool,
+) (result T, err error) {
+	queried := []Node[CHAIN_ID, HEAD, RPC]{selected}
+	for _, n := range nodes {
+		if len(queried) >= cfg.Nodes {
+			break
+		}
+		if n != selected && n.State() == nodeStateAlive {
+			queried = append(queried, n)
+		}
+	}
+	outcome := func(o string) {
+		promMultiNodeQuorumReads.WithLabelValues(chainFamily, chainID.String(), method, o).Inc()
+	}
+	if len(queried) < cfg.Threshold {
+		outcome("insufficient_nodes")
+		return result, fmt.Errorf("%w for %s: %d live nodes available, %d required", ErrQuorumNotReached, method, len(queried), cfg.Threshold)
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	results := make(chan quorumResult[T], len(queried))
+	byName := make(map[string]Node[CHAIN_ID, HEAD, RPC], len(queried))
+	for _, n := range queried {
+		byName[n.Name()] = n
+		go func(n Node[CHAIN_ID, HEAD, RPC]) {
+			start := time.Now()
+			value, err := fn(ctx, n.RPC())
+			results <- quorumResult[T]{nodeName: n.Name(), value: value, err: err, latency: time.Since(start)}
+		}(n)
+	}
+
+	same := func(a, b quorumResult[T]) bool {
+		if a.err != nil || b.err != nil {
+			return a.err != nil && b.err != nil && a.err.Error() == b.err.Error()
+		}
+		return equal(a.value, b.value)
+	}
+
+	// groups of matching results, in order of arrival
+	var groups [][]quorumResult[T]
+	for received := 0; received < len(queried); received++ {
+		var r quorumResult[T]
+		select {
+		case r = <-results:
+		case <-ctx.Done():
+			outcome("timeout")
+			return result, fmt.Errorf("%w for %s: %w", ErrQuorumNotReached, method, ctx.Err())
+		}
+
+		i := slices.IndexFunc(groups, func(g []quorumResult[T]) bool { return same(g[0], r) })
+		if i < 0 {
+			groups = append(groups, []quorumResult[T]{r})
+			i = len(groups) - 1
+		} else {
+			groups[i] = append(groups[i], r)
+		}
+
+		if len(groups[i]) >= cfg.Threshold {
+			for j, g := range groups {
+				for _, gr := range g {
+					n := byName[gr.nodeName]
+					switch {
+					case j == i:
+						n.RecordCallResult(gr.latency, nil)
+					case gr.err != nil && r.err == nil:
+						// the node failed, while the quorum succeeded
+						n.RecordCallResult(gr.latency, gr.err)
+					default:
+						promPoolRPCNodeQuorumMismatches.WithLabelValues(chainFamily, chainID.String(), gr.nodeName, method).Inc()
+						lggr.Warnw("RPC node returned a result that does not match the quorum", "nodeName", gr.nodeName, "method", method, "err", gr.err)
+						n.RecordCallResult(gr.latency, errQuorumMismatch)
+					}
+				}
+			}
+			if len(groups) > 1 {
+				outcome("agreed_with_mismatches")
+			} else {
+				outcome("agreed")
+			}
+			return r.value, r.err
+		}
+
+		largest := 0
+		for _, g := range groups {
+			largest = max(largest, len(g))
+		}
+		if largest+len(queried)-received-1 < cfg.Threshold {
+			break
+		}
+	}
+
+	outcome("disagreed")
+	lggr.Errorw("RPC nodes did not agree on the result of a quorum read", "method", method, "nodes", len(queried), "groups", len(groups))
+	// there is no telling which of the nodes returning a result is right, so only failures are penalised
+	for _, g := range groups {
+		for _, gr := range g {
+			byName[gr.nodeName].RecordCallResult(gr.latency, gr.err)
+		}
+	}
+	return result, fmt.Errorf("%w for %s: %d nodes returned %d different results", ErrQuorumNotReached, method, len(queried), len(groups))
+}
@@ -0,0 +1,210 @@
+package client
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/utils/tests"
+
+	"github.com/smartcontractkit/chainlink/v2/common/types"
+)
+
+func TestMultiNode_QuorumReads(t *testing.T) {
+	t.Parallel()
+
+	type nodeResult struct {
+		result []byte
+		err    error
+		delay  time.Duration
+	}
+	type node = *mockNode[types.ID, types.Head[Hashable], multiNodeRPCClient]
+	newQuorumMultiNode := func(t *testing.T, cfg QuorumReadConfig, results ...nodeResult) (testMultiNode, []node) {
+		var nodes []node
+		var mnNodes []Node[types.ID, types.Head[Hashable], multiNodeRPCClient]
+		for i, r := range results {
+			rpc := newMultiNodeRPCClient(t)
+			rpc.On("CallContract", mock.Anything, mock.Anything, mock.Anything).Return(r.result, r.err).After(r.delay).Maybe()
+			n := newMockNode[types.ID, types.Head[Hashable], multiNodeRPCClient](t)
+			n.On("State").Return(nodeStateAlive).Maybe()
+			n.On("Name").Return(fmt.Sprintf("node_%d", i)).Maybe()
+			n.On("RPC").Return(rpc).Maybe()
+			n.On("RecordCallResult", mock.Anything, mock.Anything).Maybe()
+			nodes = append(nodes, n)
+			mnNodes = append(mnNodes, n)
+		}
+		mn := newTestMultiNode(t, multiNodeOpts{
+			selectionMode: NodeSelectionModeRoundRobin,
+			chainID:       types.RandomID(),
+			chainFamily:   "EVM",
+			nodes:         mnNodes,
+			quorumReads:   cfg,
+		})
+		nodeSelector := newMockNodeSelector[types.ID, types.Head[Hashable], multiNodeRPCClient](t)
+		nodeSelector.On("Select").Return(nodes[0]).Maybe()
+		mn.nodeSelector = nodeSelector
+		return mn, nodes
+	}
+	cfg := QuorumReadConfig{Methods: []string{QuorumMethodCall}, Nodes: 3, Threshold: 2}
+	block := big.NewInt(100)
+
+	t.Run("returns the result nodes agree on", func(t *testing.T) {
+		t.Parallel()
+		mn, _ := newQuorumMultiNode(t, cfg, nodeResult{result: []byte{1}}, nodeResult{result: []byte{1}}, nodeResult{result: []byte{1}})
+		result, err := mn.CallContract(tests.Context(t), nil, block)
+		require.NoError(t, err)
+		assert.Equal(t, []byte{1}, result)
+	})
+
+	t.Run("penalises the node that disagrees", func(t *testing.T) {
+		t.Parallel()
+		// delay the matching results, so that the mismatch is received before the quorum is reached
+		delay := 100 * time.Millisecond
+		mn, nodes := newQuorumMultiNode(t, cfg, nodeResult{result: []byte{2}}, nodeResult{result: []byte{1}, delay: delay}, nodeResult{result: []byte{1}, delay: delay})
+		result, err := mn.CallContract(tests.Context(t), nil, block)
+		require.NoError(t, err)
+		assert.Equal(t, []byte{1}, result)
+		nodes[0].AssertCalled(t, "RecordCallResult", mock.Anything, errQuorumMismatch)
+	})
+
+	t.Run("returns identical errors", func(t *testing.T) {
+		t.Parallel()
+		reverted := errors.New("execution reverted")
+		mn, _ := newQuorumMultiNode(t, cfg, nodeResult{err: reverted}, nodeResult{err: reverted}, nodeResult{result: []byte{1}})
+		_, err := mn.CallContract(tests.Context(t), nil, block)
+		// either both errors arrive first, or the result is outvoted once the last one does
+		require.ErrorIs(t, err, reverted)
+	})
+
+	t.Run("fails without agreement", func(t *testing.T) {
+		t.Parallel()
+		mn, _ := newQuorumMultiNode(t, cfg, nodeResult{result: []byte{1}}, nodeResult{result: []byte{2}}, nodeResult{err: errors.New("rpc down")})
+		_, err := mn.CallContract(tests.Context(t), nil, block)
+		require.ErrorIs(t, err, ErrQuorumNotReached)
+	})
+
+	t.Run("fails without enough live nodes", func(t *testing.T) {
+		t.Parallel()
+		mn, _ := newQuorumMultiNode(t, QuorumReadConfig{Methods: []string{QuorumMethodCall}, Nodes: 3, Threshold: 3},
+			nodeResult{result: []byte{1}}, nodeResult{result: []byte{1}})
+		_, err := mn.CallContract(tests.Context(t), nil, block)
+		require.ErrorIs(t, err, ErrQuorumNotReached)
+		require.ErrorContains(t, err, "2 live nodes available, 3 required")
+	})
+
+	t.Run("calls at the latest block go to a single node", func(t *testing.T) {
+		t.Parallel()
+		mn, nodes := newQuorumMultiNode(t, cfg, nodeResult{result: []byte{2}}, nodeResult{result: []byte{1}}, nodeResult{result: []byte{1}})
+		result, err := mn.CallContract(tests.Context(t), nil, nil)
+		require.NoError(t, err)
+		assert.Equal(t, []byte{2}, result)
+		for _, n := range nodes[1:] {
+			n.AssertNotCalled(t, "RPC")
+		}
+	})
+
+	t.Run("calls at a block tag go to a single node", func(t *testing.T) {
+		t.Parallel()
+		mn, nodes := newQuorumMultiNode(t, cfg, nodeResult{result: []byte{2}}, nodeResult{result: []byte{1}}, nodeResult{result: []byte{1}})
+		// block tags, such as finalized, are negative block numbers
+		result, err := mn.CallContract(tests.Context(t), nil, big.NewInt(-3))
+		require.NoError(t, err)
+		assert.Equal(t, []byte{2}, result)
+		for _, n := range nodes[1:] {
+			n.AssertNotCalled(t, "RPC")
+		}
+	})
+
+	t.Run("other methods are not affected", func(t *testing.T) {
+		t.Parallel()
+		mn, nodes := newQuorumMultiNode(t, QuorumReadConfig{Methods: []string{QuorumMethodGetLogs}, Nodes: 3, Threshold: 2},
+			nodeResult{result: []byte{2}}, nodeResult{result: []byte{1}}, nodeResult{result: []byte{1}})
+		result, err := mn.CallContract(tests.Context(t), nil, block)
+		require.NoError(t, err)
+		assert.Equal(t, []byte{2}, result)
+		for _, n := range nodes[1:] {
+			n.AssertNotCalled(t, "RPC")
+		}
+	})
+}
+
+func TestMultiNode_QuorumEvents(t *testing.T) {
+	t.Parallel()
+
+	type nodeResult struct {
+		events    []any
+		finalized int64
+	}
+	type node = *mockNode[types.ID, types.Head[Hashable], multiNodeRPCClient]
+	// the query of the tests is the last block of the range
+	cfg := QuorumReadConfig{Methods: []string{QuorumMethodGetLogs}, Nodes: 3, Threshold: 2, EventsToBlock: func(query any) *big.Int {
+		block, _ := query.(*big.Int)
+		return block
+	}}
+	newQuorumMultiNode := func(t *testing.T, results ...nodeResult) (testMultiNode, []node) {
+		var nodes []node
+		var mnNodes []Node[types.ID, types.Head[Hashable], multiNodeRPCClient]
+		for i, r := range results {
+			rpc := newMultiNodeRPCClient(t)
+			rpc.On("FilterEvents", mock.Anything, mock.Anything).Return(r.events, nil).Maybe()
+			n := newMockNode[types.ID, types.Head[Hashable], multiNodeRPCClient](t)
+			n.On("State").Return(nodeStateAlive).Maybe()
+			n.On("StateAndLatest").Return(nodeStateAlive, ChainInfo{BlockNumber: r.finalized + 10, FinalizedBlockNumber: r.finalized}).Maybe()
+			n.On("Name").Return(fmt.Sprintf("node_%d", i)).Maybe()
+			n.On("RPC").Return(rpc).Maybe()
+			n.On("RecordCallResult", mock.Anything, mock.Anything).Maybe()
+			nodes = append(nodes, n)
+			mnNodes = append(mnNodes, n)
+		}
+		mn := newTestMultiNode(t, multiNodeOpts{
+			selectionMode: NodeSelectionModeRoundRobin,
+			chainID:       types.RandomID(),
+			chainFamily:   "EVM",
+			nodes:         mnNodes,
+			quorumReads:   cfg,
+		})
+		nodeSelector := newMockNodeSelector[types.ID, types.Head[Hashable], multiNodeRPCClient](t)
+		nodeSelector.On("Select").Return(nodes[0]).Maybe()
+		mn.nodeSelector = nodeSelector
+		return mn, nodes
+	}
+
+	t.Run("no events agree whether nil or empty", func(t *testing.T) {
+		t.Parallel()
+		mn, _ := newQuorumMultiNode(t, nodeResult{events: nil, finalized: 100}, nodeResult{events: []any{}, finalized: 100}, nodeResult{events: []any{1}, finalized: 100})
+		events, err := mn.FilterEvents(tests.Context(t), big.NewInt(100))
+		require.NoError(t, err)
+		assert.Empty(t, events)
+	})
+
+	t.Run("finalized ranges require a quorum", func(t *testing.T) {
+		t.Parallel()
+		mn, nodes := newQuorumMultiNode(t, nodeResult{events: []any{2}, finalized: 100}, nodeResult{events: []any{1}, finalized: 100}, nodeResult{events: []any{1}, finalized: 100})
+		events, err := mn.FilterEvents(tests.Context(t), big.NewInt(100))
+		require.NoError(t, err)
+		assert.Equal(t, []any{1}, events)
+		nodes[0].AssertCalled(t, "RecordCallResult", mock.Anything, errQuorumMismatch)
+	})
+
+	for name, query := range map[string]any{
+		"ranges not finalized by every node go to a single node": big.NewInt(100),
+		"unbounded ranges go to a single node":                   nil,
+	} {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			mn, nodes := newQuorumMultiNode(t, nodeResult{events: []any{2}, finalized: 100}, nodeResult{events: []any{1}, finalized: 100}, nodeResult{events: []any{1}, finalized: 99})
+			events, err := mn.FilterEvents(tests.Context(t), query)
+			require.NoError(t, err)
+			assert.Equal(t, []any{2}, events)
+			for _, n := range nodes[1:] {
+				n.AssertNotCalled(t, "RPC")
+			}
+		})
+	}
+}
@@ -79,6 +79,10 @@ type Node[
 	UnsubscribeAllExceptAliveLoop()
 	ConfiguredChainID() CHAIN_ID
 	Order() int32
+	// RecordCallResult feeds the latency and outcome of an RPC call into the node's quality stats
+	RecordCallResult(latency time.Duration, err error)
+	// QualityStats returns recent latency percentiles and the error rate of the node's RPC
+	QualityStats() NodeQualityStats
 	Start(context.Context) error
 	Close() error
 }
@@ -108,6 +112,8 @@ type node[
 
 	poolInfoProvider PoolChainInfoProvider
 
+	quality *nodeQuality
+
 	stopCh services.StopChan
 	// wg waits for subsidiary goroutines
 	wg sync.WaitGroup
@@ -143,6 +149,7 @@ func NewNode[
 	if httpuri != nil {
 		n.http = httpuri
 	}
+	n.quality = newNodeQuality()
 	n.stopCh = make(services.StopChan)
 	lggr = logger.Named(lggr, "Node")
 	lggr = logger.With(lggr,
@@ -329,6 +336,14 @@ func (n *node[CHAIN_ID, HEAD, RPC]) Order() int32 {
 	return n.order
 }
 
+func (n *node[CHAIN_ID, HEAD, RPC]) RecordCallResult(latency time.Duration, err error) {
+	n.quality.record(latency, err)
+}
+
+func (n *node[CHAIN_ID, HEAD, RPC]) QualityStats() NodeQualityStats {
+	return n.quality.stats()
+}
+
 func (n *node[CHAIN_ID, HEAD, RPC]) newCtx() (context.Context, context.CancelFunc) {
 	ctx, cancel := n.stopCh.NewCtx()
 	ctx = CtxAddHealthCheckFlag(ctx)
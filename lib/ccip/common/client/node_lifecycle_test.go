@@ -205,43 +205,45 @@ func TestUnit_NodeLifecycle_aliveLoop(t *testing.T) {
 		tests.AssertLogEventually(t, observedLogs, fmt.Sprintf("RPC endpoint failed to respond to %d consecutive polls", pollFailureThreshold))
 		assert.Equal(t, nodeStateAlive, node.State())
 	})
-	t.Run("when behind more than SyncThreshold, transitions to out of sync", func(t *testing.T) {
-		t.Parallel()
-		rpc := newMockNodeClient[types.ID, Head](t)
-		lggr, observedLogs := logger.TestObserved(t, zap.DebugLevel)
-		const syncThreshold = 10
-		node := newSubscribedNode(t, testNodeOpts{
-			config: testNodeConfig{
-				pollInterval:  tests.TestInterval,
-				syncThreshold: syncThreshold,
-				selectionMode: NodeSelectionModeRoundRobin,
-			},
-			rpc:  rpc,
-			lggr: lggr,
+	for _, selectionMode := range []string{NodeSelectionModeRoundRobin, NodeSelectionModeWeightedLatency} {
+		t.Run(fmt.Sprintf("when behind more than SyncThreshold, transitions to out of sync: %s", selectionMode), func(t *testing.T) {
+			t.Parallel()
+			rpc := newMockNodeClient[types.ID, Head](t)
+			lggr, observedLogs := logger.TestObserved(t, zap.DebugLevel)
+			const syncThreshold = 10
+			node := newSubscribedNode(t, testNodeOpts{
+				config: testNodeConfig{
+					pollInterval:  tests.TestInterval,
+					syncThreshold: syncThreshold,
+					selectionMode: selectionMode,
+				},
+				rpc:  rpc,
+				lggr: lggr,
+			})
+			defer func() { assert.NoError(t, node.close()) }()
+			const mostRecentBlock = 20
+			rpc.On("GetInterceptedChainInfo").Return(ChainInfo{BlockNumber: mostRecentBlock}, ChainInfo{BlockNumber: 30})
+			poolInfo := newMockPoolChainInfoProvider(t)
+			poolInfo.On("LatestChainInfo").Return(10, ChainInfo{
+				BlockNumber:     syncThreshold + mostRecentBlock + 1,
+				TotalDifficulty: big.NewInt(10),
+			}).Once()
+			node.SetPoolChainInfoProvider(poolInfo)
+			rpc.On("ClientVersion", mock.Anything).Return("", nil)
+			// tries to redial in outOfSync
+			rpc.On("Dial", mock.Anything).Return(errors.New("failed to dial")).Run(func(_ mock.Arguments) {
+				assert.Equal(t, nodeStateOutOfSync, node.State())
+			}).Once()
+			// disconnects all on transfer to unreachable or outOfSync
+			rpc.On("DisconnectAll").Maybe()
+			// might be called in unreachable loop
+			rpc.On("Dial", mock.Anything).Run(func(_ mock.Arguments) {
+				require.Equal(t, nodeStateOutOfSync, node.State())
+			}).Return(errors.New("failed to dial")).Maybe()
+			node.declareAlive()
+			tests.AssertLogEventually(t, observedLogs, "Dial failed: Node is unreachable")
 		})
-		defer func() { assert.NoError(t, node.close()) }()
-		const mostRecentBlock = 20
-		rpc.On("GetInterceptedChainInfo").Return(ChainInfo{BlockNumber: mostRecentBlock}, ChainInfo{BlockNumber: 30})
-		poolInfo := newMockPoolChainInfoProvider(t)
-		poolInfo.On("LatestChainInfo").Return(10, ChainInfo{
-			BlockNumber:     syncThreshold + mostRecentBlock + 1,
-			TotalDifficulty: big.NewInt(10),
-		}).Once()
-		node.SetPoolChainInfoProvider(poolInfo)
-		rpc.On("ClientVersion", mock.Anything).Return("", nil)
-		// tries to redial in outOfSync
-		rpc.On("Dial", mock.Anything).Return(errors.New("failed to dial")).Run(func(_ mock.Arguments) {
-			assert.Equal(t, nodeStateOutOfSync, node.State())
-		}).Once()
-		// disconnects all on transfer to unreachable or outOfSync
-		rpc.On("DisconnectAll").Maybe()
-		// might be called in unreachable loop
-		rpc.On("Dial", mock.Anything).Run(func(_ mock.Arguments) {
-			require.Equal(t, nodeStateOutOfSync, node.State())
-		}).Return(errors.New("failed to dial")).Maybe()
-		node.declareAlive()
-		tests.AssertLogEventually(t, observedLogs, "Dial failed: Node is unreachable")
-	})
+	}
 	t.Run("when behind more than SyncThreshold but we are the last live node, forcibly stays alive", func(t *testing.T) {
 		t.Parallel()
 		rpc := newMockNodeClient[types.ID, Head](t)
@@ -1679,7 +1681,7 @@ func TestUnit_NodeLifecycle_outOfSyncWithPool(t *testing.T) {
 			},
 		}
 
-		for _, selectionMode := range []string{NodeSelectionModeHighestHead, NodeSelectionModeRoundRobin, NodeSelectionModePriorityLevel} {
+		for _, selectionMode := range []string{NodeSelectionModeHighestHead, NodeSelectionModeRoundRobin, NodeSelectionModePriorityLevel, NodeSelectionModeWeightedLatency} {
 			node := newTestNode(t, testNodeOpts{
 				config: testNodeConfig{
 					syncThreshold: syncThreshold,
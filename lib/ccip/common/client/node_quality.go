@@ -0,0 +1,120 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"math"
+	"slices"
+	"sync"
+	"time"
+)
+
+const (
+	// qualityLatencyWindow is the number of most recent latency samples used to compute percentiles
+	qualityLatencyWindow = 100
+	// qualityHalfLife is the time it takes for an error penalty to lose half of its weight
+	qualityHalfLife = 5 * time.Minute
+	// qualityMaxSampleAge - latency samples older than this are ignored, so that a node that used to be slow is
+	// not penalised forever if it is not getting any traffic
+	qualityMaxSampleAge = 2 * qualityHalfLife
+	// qualityErrorRatePrior is added to the number of calls when computing the error rate. It keeps a single failure
+	// on an idle node from resulting in a 100% error rate, and lets the rate decay to zero once the node stops failing.
+	qualityErrorRatePrior = 1.0
+)
+
+// NodeQualityStats is a snapshot of the recent RPC quality of a node.
+type NodeQualityStats struct {
+	// LatencyP50 and LatencyP90 are percentiles of recent call latencies. Both are zero if Samples is zero.
+	LatencyP50 time.Duration
+	LatencyP90 time.Duration
+	// Samples is the number of latency samples the percentiles are based on
+	Samples int
+	// ErrorRate is the time decayed share of failed calls, in [0, 1]
+	ErrorRate float64
+}
+
+type latencySample struct {
+	at      time.Time
+	latency time.Duration
+}
+
+// nodeQuality keeps rolling latency percentiles and an exponentially decaying error rate for a node.
+// It is fed by health check polls as well as by calls made through the MultiNode.
+type nodeQuality struct {
+	mu        sync.Mutex
+	samples   [qualityLatencyWindow]latencySample
+	next      int
+	calls     float64
+	errors    float64
+	updatedAt time.Time
+
+	now func() time.Time
+}
+
+func newNodeQuality() *nodeQuality {
+	return &nodeQuality{now: time.Now}
+}
+
+// record adds the outcome of a single call. Calls cancelled by the caller say nothing about the RPC and are ignored.
+// The latency of failed calls is only recorded for timeouts, as other errors are often returned right away.
+func (q *nodeQuality) record(latency time.Duration, err error) {
+	if errors.Is(err, context.Canceled) {
+		return
+	}
+
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	now := q.now()
+	q.decay(now)
+	q.calls++
+	if err != nil {
+		q.errors++
+	}
+	if err == nil || errors.Is(err, context.DeadlineExceeded) {
+		q.samples[q.next] = latencySample{at: now, latency: latency}
+		q.next = (q.next + 1) % qualityLatencyWindow
+	}
+}
+
+func (q *nodeQuality) stats() NodeQualityStats {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	now := q.now()
+	q.decay(now)
+
+	var latencies []time.Duration
+	for _, s := range q.samples {
+		if !s.at.IsZero() && now.Sub(s.at) <= qualityMaxSampleAge {
+			latencies = append(latencies, s.latency)
+		}
+	}
+	stats := NodeQualityStats{
+		Samples:   len(latencies),
+		ErrorRate: q.errors / (q.calls + qualityErrorRatePrior),
+	}
+	if len(latencies) > 0 {
+		slices.Sort(latencies)
+		stats.LatencyP50 = percentile(latencies, 0.5)
+		stats.LatencyP90 = percentile(latencies, 0.9)
+	}
+	return stats
+}
+
+// decay scales down calls and errors according to the time passed since the last update. Must be called with mu held.
+func (q *nodeQuality) decay(now time.Time) {
+	if !q.updatedAt.IsZero() {
+		elapsed := now.Sub(q.updatedAt)
+		if elapsed > 0 {
+			factor := math.Pow(0.5, float64(elapsed)/float64(qualityHalfLife))
+			q.calls *= factor
+			q.errors *= factor
+		}
+	}
+	q.updatedAt = now
+}
+
+// percentile returns the p-th percentile of sorted, using the nearest-rank method
+func percentile(sorted []time.Duration, p float64) time.Duration {
+	rank := int(math.Ceil(p*float64(len(sorted)))) - 1
+	return sorted[max(rank, 0)]
+}
@@ -0,0 +1,92 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNodeQuality(t *testing.T) {
+	t.Parallel()
+
+	newQuality := func() (*nodeQuality, *time.Time) {
+		now := time.Unix(1700000000, 0)
+		q := newNodeQuality()
+		q.now = func() time.Time { return now }
+		return q, &now
+	}
+
+	t.Run("empty", func(t *testing.T) {
+		q, _ := newQuality()
+		assert.Equal(t, NodeQualityStats{}, q.stats())
+	})
+
+	t.Run("latency percentiles", func(t *testing.T) {
+		q, _ := newQuality()
+		for i := 1; i <= 10; i++ {
+			q.record(time.Duration(i)*time.Millisecond, nil)
+		}
+		stats := q.stats()
+		assert.Equal(t, 10, stats.Samples)
+		assert.Equal(t, 5*time.Millisecond, stats.LatencyP50)
+		assert.Equal(t, 9*time.Millisecond, stats.LatencyP90)
+		assert.Zero(t, stats.ErrorRate)
+	})
+
+	t.Run("keeps a rolling window of samples", func(t *testing.T) {
+		q, _ := newQuality()
+		for i := 0; i < qualityLatencyWindow; i++ {
+			q.record(time.Second, nil)
+		}
+		for i := 0; i < qualityLatencyWindow; i++ {
+			q.record(time.Millisecond, nil)
+		}
+		stats := q.stats()
+		assert.Equal(t, qualityLatencyWindow, stats.Samples)
+		assert.Equal(t, time.Millisecond, stats.LatencyP90)
+	})
+
+	t.Run("ignores old samples", func(t *testing.T) {
+		q, now := newQuality()
+		q.record(time.Second, nil)
+		*now = now.Add(qualityMaxSampleAge + time.Second)
+		q.record(time.Millisecond, nil)
+		stats := q.stats()
+		assert.Equal(t, 1, stats.Samples)
+		assert.Equal(t, time.Millisecond, stats.LatencyP90)
+	})
+
+	t.Run("error rate decays", func(t *testing.T) {
+		q, now := newQuality()
+		for i := 0; i < 9; i++ {
+			q.record(time.Millisecond, nil)
+		}
+		q.record(time.Millisecond, errors.New("connection refused"))
+		q.record(time.Millisecond, errors.New("connection refused"))
+		stats := q.stats()
+		assert.InDelta(t, 2.0/12.0, stats.ErrorRate, 1e-9)
+		// latency of failed calls is not recorded
+		assert.Equal(t, 9, stats.Samples)
+
+		*now = now.Add(qualityHalfLife)
+		assert.InDelta(t, 1.0/(5.5+1), q.stats().ErrorRate, 1e-9)
+
+		*now = now.Add(10 * qualityHalfLife)
+		assert.Less(t, q.stats().ErrorRate, 0.01)
+	})
+
+	t.Run("timeouts and cancellations", func(t *testing.T) {
+		q, _ := newQuality()
+		q.record(time.Second, context.Canceled)
+		assert.Equal(t, NodeQualityStats{}, q.stats())
+
+		q.record(time.Second, context.DeadlineExceeded)
+		stats := q.stats()
+		assert.Equal(t, 1, stats.Samples)
+		assert.Equal(t, time.Second, stats.LatencyP90)
+		assert.InDelta(t, 0.5, stats.ErrorRate, 1e-9)
+	})
+}
@@ -11,8 +11,18 @@ const (
 	NodeSelectionModeRoundRobin      = "RoundRobin"
 	NodeSelectionModeTotalDifficulty = "TotalDifficulty"
 	NodeSelectionModePriorityLevel   = "PriorityLevel"
+	NodeSelectionModeWeightedLatency = "WeightedLatency"
 )
 
+// NodeSelectionModes lists the supported values of NodePool.SelectionMode.
+var NodeSelectionModes = []string{
+	NodeSelectionModeHighestHead,
+	NodeSelectionModeRoundRobin,
+	NodeSelectionModeTotalDifficulty,
+	NodeSelectionModePriorityLevel,
+	NodeSelectionModeWeightedLatency,
+}
+
 type NodeSelector[
 	CHAIN_ID types.ID,
 	HEAD Head,
@@ -39,6 +49,8 @@ func newNodeSelector[
 		return NewTotalDifficultyNodeSelector[CHAIN_ID, HEAD, RPC](nodes)
 	case NodeSelectionModePriorityLevel:
 		return NewPriorityLevelNodeSelector[CHAIN_ID, HEAD, RPC](nodes)
+	case NodeSelectionModeWeightedLatency:
+		return NewWeightedLatencyNodeSelector[CHAIN_ID, HEAD, RPC](nodes)
 	default:
 		panic(fmt.Sprintf("unsupported NodeSelectionMode: %s", selectionMode))
 	}
@@ -0,0 +1,118 @@
+package client
+
+import (
+	"math/rand"
+	"slices"
+	"time"
+
+	"github.com/smartcontractkit/chainlink/v2/common/types"
+)
+
+const (
+	// weightedLatencyMinRelativeWeight - nodes with a weight below this share of the best node's weight are not selected
+	weightedLatencyMinRelativeWeight = 0.2
+	// weightedLatencyMinLatency puts a floor under latencies, so that very fast nodes don't take all the weight
+	weightedLatencyMinLatency = time.Millisecond
+)
+
+type weightedLatencyNodeSelector[
+	CHAIN_ID types.ID,
+	HEAD Head,
+	RPC NodeClient[CHAIN_ID, HEAD],
+] struct {
+	nodes []Node[CHAIN_ID, HEAD, RPC]
+	// random returns a pseudo-random number in [0.0,1.0)
+	random func() float64
+}
+
+// NewWeightedLatencyNodeSelector returns a NodeSelector that prefers alive nodes with low latency and few errors.
+// Each node is weighted by (1 - errorRate)^2 / p90 latency, nodes that are far behind the best one are left out,
+// and one of the remaining nodes is picked at random according to its weight. As penalties decay over time,
+// a node that recovers is gradually given traffic again.
+func NewWeightedLatencyNodeSelector[
+	CHAIN_ID types.ID,
+	HEAD Head,
+	RPC NodeClient[CHAIN_ID, HEAD],
+](nodes []Node[CHAIN_ID, HEAD, RPC]) NodeSelector[CHAIN_ID, HEAD, RPC] {
+	return &weightedLatencyNodeSelector[CHAIN_ID, HEAD, RPC]{
+		nodes:  nodes,
+		random: rand.Float64,
+	}
+}
+
+func (s *weightedLatencyNodeSelector[CHAIN_ID, HEAD, RPC]) Select() Node[CHAIN_ID, HEAD, RPC] {
+	var liveNodes []Node[CHAIN_ID, HEAD, RPC]
+	var stats []NodeQualityStats
+	var knownLatencies []time.Duration
+	for _, n := range s.nodes {
+		if n.State() != nodeStateAlive {
+			continue
+		}
+		liveNodes = append(liveNodes, n)
+		nodeStats := n.QualityStats()
+		stats = append(stats, nodeStats)
+		if nodeStats.Samples > 0 {
+			knownLatencies = append(knownLatencies, nodeStats.LatencyP90)
+		}
+	}
+
+	if len(liveNodes) == 0 {
+		return nil
+	}
+
+	// nodes without latency samples are assumed to be as fast as the median node, so that they get probed
+	defaultLatency := weightedLatencyMinLatency
+	if len(knownLatencies) > 0 {
+		slices.Sort(knownLatencies)
+		defaultLatency = percentile(knownLatencies, 0.5)
+	}
+
+	weights := make([]float64, len(liveNodes))
+	var bestWeight float64
+	for i, nodeStats := range stats {
+		weights[i] = qualityWeight(nodeStats, defaultLatency)
+		bestWeight = max(bestWeight, weights[i])
+	}
+
+	var totalWeight float64
+	for i, w := range weights {
+		if w < bestWeight*weightedLatencyMinRelativeWeight {
+			weights[i] = 0
+		}
+		totalWeight += weights[i]
+	}
+
+	if totalWeight == 0 {
+		// every live node is failing all of its calls, fall back to the first one
+		return liveNodes[0]
+	}
+
+	target := s.random() * totalWeight
+	for i, w := range weights {
+		if target < w {
+			return liveNodes[i]
+		}
+		target -= w
+	}
+	// only reachable because of floating point rounding
+	for i := len(weights) - 1; i >= 0; i-- {
+		if weights[i] > 0 {
+			return liveNodes[i]
+		}
+	}
+	return liveNodes[0]
+}
+
+func (s *weightedLatencyNodeSelector[CHAIN_ID, HEAD, RPC]) Name() string {
+	return NodeSelectionModeWeightedLatency
+}
+
+func qualityWeight(stats NodeQualityStats, defaultLatency time.Duration) float64 {
+	latency := defaultLatency
+	if stats.Samples > 0 {
+		latency = stats.LatencyP90
+	}
+	latency = max(latency, weightedLatencyMinLatency)
+	successRate := 1 - stats.ErrorRate
+	return successRate * successRate / latency.Seconds()
+}
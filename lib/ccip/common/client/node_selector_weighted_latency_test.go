@@ -0,0 +1,100 @@
+package client
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/smartcontractkit/chainlink/v2/common/types"
+)
+
+func TestWeightedLatencyNodeSelectorName(t *testing.T) {
+	selector := newNodeSelector[types.ID, Head, NodeClient[types.ID, Head]](NodeSelectionModeWeightedLatency, nil)
+	assert.Equal(t, selector.Name(), NodeSelectionModeWeightedLatency)
+}
+
+func TestWeightedLatencyNodeSelector(t *testing.T) {
+	t.Parallel()
+
+	type nodeClient NodeClient[types.ID, Head]
+
+	type nodeSpec struct {
+		state nodeState
+		stats NodeQualityStats
+	}
+	newSelector := func(t *testing.T, specs []nodeSpec, random float64) (*weightedLatencyNodeSelector[types.ID, Head, nodeClient], []Node[types.ID, Head, nodeClient]) {
+		var nodes []Node[types.ID, Head, nodeClient]
+		for _, spec := range specs {
+			node := newMockNode[types.ID, Head, nodeClient](t)
+			node.On("State").Return(spec.state)
+			if spec.state == nodeStateAlive {
+				node.On("QualityStats").Return(spec.stats)
+			}
+			nodes = append(nodes, node)
+		}
+		selector := NewWeightedLatencyNodeSelector(nodes).(*weightedLatencyNodeSelector[types.ID, Head, nodeClient])
+		selector.random = func() float64 { return random }
+		return selector, nodes
+	}
+	latency := func(d time.Duration, errorRate float64) NodeQualityStats {
+		return NodeQualityStats{LatencyP50: d, LatencyP90: d, Samples: 10, ErrorRate: errorRate}
+	}
+
+	t.Run("no live nodes", func(t *testing.T) {
+		selector, _ := newSelector(t, []nodeSpec{{state: nodeStateOutOfSync}, {state: nodeStateUnreachable}}, 0)
+		assert.Nil(t, selector.Select())
+	})
+
+	t.Run("skips nodes that are not alive", func(t *testing.T) {
+		selector, nodes := newSelector(t, []nodeSpec{
+			{state: nodeStateOutOfSync},
+			{state: nodeStateAlive, stats: latency(100*time.Millisecond, 0)},
+		}, 0)
+		assert.Same(t, nodes[1], selector.Select())
+	})
+
+	t.Run("picks nodes according to their weight", func(t *testing.T) {
+		specs := []nodeSpec{
+			{state: nodeStateAlive, stats: latency(100*time.Millisecond, 0)},
+			{state: nodeStateAlive, stats: latency(200*time.Millisecond, 0)},
+		}
+		// weights are 10 and 5, so the first node gets 2/3 of the traffic
+		selector, nodes := newSelector(t, specs, 0.6)
+		assert.Same(t, nodes[0], selector.Select())
+		selector, nodes = newSelector(t, specs, 0.7)
+		assert.Same(t, nodes[1], selector.Select())
+	})
+
+	t.Run("leaves out slow and failing nodes", func(t *testing.T) {
+		specs := []nodeSpec{
+			{state: nodeStateAlive, stats: latency(2*time.Second, 0)},
+			{state: nodeStateAlive, stats: latency(100*time.Millisecond, 0.8)},
+			{state: nodeStateAlive, stats: latency(100*time.Millisecond, 0)},
+		}
+		for _, random := range []float64{0, 0.5, 0.999} {
+			selector, nodes := newSelector(t, specs, random)
+			assert.Same(t, nodes[2], selector.Select())
+		}
+	})
+
+	t.Run("nodes without samples are assumed to have median latency", func(t *testing.T) {
+		specs := []nodeSpec{
+			{state: nodeStateAlive, stats: latency(100*time.Millisecond, 0)},
+			{state: nodeStateAlive, stats: latency(300*time.Millisecond, 0)},
+			{state: nodeStateAlive, stats: NodeQualityStats{}},
+		}
+		// median of the known latencies is 100ms, so weights are 10, 3.33 and 10
+		selector, nodes := newSelector(t, specs, 0.6)
+		assert.Same(t, nodes[2], selector.Select())
+	})
+
+	t.Run("falls back to the first live node if all nodes are failing", func(t *testing.T) {
+		selector, nodes := newSelector(t, []nodeSpec{
+			{state: nodeStateUnreachable},
+			{state: nodeStateAlive, stats: latency(100*time.Millisecond, 1)},
+			{state: nodeStateAlive, stats: latency(100*time.Millisecond, 1)},
+		}, 0.5)
+		assert.Same(t, nodes[1], selector.Select())
+	})
+}
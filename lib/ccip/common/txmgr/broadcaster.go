@@ -11,6 +11,10 @@ import (
 	"github.com/jpillora/backoff"
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/prometheus/client_golang/prometheus/promauto"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
 	"go.uber.org/multierr"
 	"gopkg.in/guregu/null.v4"
 
@@ -92,6 +96,22 @@ type TransmitChecker[
 	Check(ctx context.Context, l logger.SugaredLogger, tx txmgrtypes.Tx[CHAIN_ID, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE], a txmgrtypes.TxAttempt[CHAIN_ID, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE]) error
 }
 
+// PayloadRebuildingTransmitChecker is a TransmitChecker that can also rebuild the payload of a transaction,
+// so that only the parts of it that are still valid are submitted on-chain.
+type PayloadRebuildingTransmitChecker[
+	CHAIN_ID types.ID,
+	ADDR types.Hashable,
+	TX_HASH, BLOCK_HASH types.Hashable,
+	SEQ types.Sequence,
+	FEE feetypes.Fee,
+] interface {
+	TransmitChecker[CHAIN_ID, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE]
+
+	// RebuildPayload checks the given transaction like Check does, and returns the payload it should
+	// be sent with instead, or nil if it can be sent as is.
+	RebuildPayload(ctx context.Context, l logger.SugaredLogger, tx txmgrtypes.Tx[CHAIN_ID, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE], a txmgrtypes.TxAttempt[CHAIN_ID, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE]) ([]byte, error)
+}
+
 // Broadcaster monitors txes for transactions that need to
 // be broadcast, assigns sequences and ensures that at least one node
 // somewhere has received the transaction successfully.
@@ -462,7 +482,12 @@ func (eb *Broadcaster[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE]) hand
 
 	checkCtx, cancel := context.WithTimeout(ctx, TransmitCheckTimeout)
 	defer cancel()
-	err = checker.Check(checkCtx, lgr, *etx, attempt)
+	var payload []byte
+	if rebuilder, ok := checker.(PayloadRebuildingTransmitChecker[CHAIN_ID, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE]); ok {
+		payload, err = rebuilder.RebuildPayload(checkCtx, lgr, *etx, attempt)
+	} else {
+		err = checker.Check(checkCtx, lgr, *etx, attempt)
+	}
 	if errors.Is(err, context.Canceled) {
 		lgr.Warn("Transmission checker timed out, sending anyway")
 	} else if err != nil {
@@ -472,6 +497,16 @@ func (eb *Broadcaster[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE]) hand
 	}
 	cancel()
 
+	if payload != nil {
+		// The attempt was signed with the original payload, so it needs to be created again.
+		lgr.Infow("Transmission checker rebuilt the transaction payload", "oldPayloadLength", len(etx.EncodedPayload), "newPayloadLength", len(payload))
+		etx.EncodedPayload = payload
+		attempt, _, _, retryable, err = eb.NewTxAttempt(ctx, *etx, eb.lggr)
+		if err != nil {
+			return fmt.Errorf("processUnstartedTxs failed on NewAttempt: %w", err), retryable
+		}
+	}
+
 	if err = eb.txStore.UpdateTxUnstartedToInProgress(ctx, etx, &attempt); errors.Is(err, ErrTxRemoved) {
 		eb.lggr.Debugw("tx removed", "txID", etx.ID, "subject", etx.Subject)
 		return nil, false
@@ -491,12 +526,27 @@ func (eb *Broadcaster[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE]) hand
 
 	lgr := etx.GetLogger(logger.With(eb.lggr, "fee", attempt.TxFee))
 	lgr.Infow("Sending transaction", "txAttemptID", attempt.ID, "txHash", attempt.Hash, "meta", etx.Meta, "feeLimit", attempt.ChainSpecificFeeLimit, "callerProvidedFeeLimit", etx.FeeLimit, "attempt", attempt, "etx", etx)
-	errType, err := eb.client.SendTransactionReturnCode(ctx, etx, attempt, lgr)
+	// The span continues the trace of the caller that created the tx, see Txm.CreateTransaction
+	sendCtx, span := otel.Tracer("").Start(etx.WithTraceContext(ctx), "Broadcaster.SendTransaction", trace.WithAttributes(
+		attribute.String("chainID", eb.chainID.String()),
+		attribute.String("fromAddress", etx.FromAddress.String()),
+		attribute.Int64("txID", etx.ID),
+		attribute.Int64("txAttemptID", attempt.ID),
+		attribute.String("txHash", attempt.Hash.String()),
+		attribute.Int("retryCount", retryCount),
+	))
+	errType, err := eb.client.SendTransactionReturnCode(sendCtx, etx, attempt, lgr)
 
 	// The validation below is only applicable to Hedera because it has instant finality and a unique sequence behavior
 	if eb.chainType == hederaChainType {
-		errType, err = eb.validateOnChainSequence(ctx, lgr, errType, err, etx, retryCount)
+		errType, err = eb.validateOnChainSequence(sendCtx, lgr, errType, err, etx, retryCount)
+	}
+	span.SetAttributes(attribute.String("sendTxReturnCode", errType.String()))
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
 	}
+	span.End()
 
 	if errType == client.Fatal || errType == client.TerminallyStuck {
 		eb.SvcErrBuffer.Append(err)
@@ -9,6 +9,7 @@ import (
 	"sort"
 	"strconv"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/prometheus/client_golang/prometheus"
@@ -70,6 +71,14 @@ var (
 		Name: "tx_manager_fwd_tx_count",
 		Help: "The number of forwarded transaction attempts labeled by status",
 	}, []string{"chainID", "successful"})
+	promStuckTxDecisions = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "tx_manager_stuck_tx_decisions",
+		Help: "Number of stuck transactions recovered, labeled by why they were stuck and how they were recovered",
+	}, []string{"chainID", "reason", "remedy"})
+	promFeePolicyBumpRejected = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "tx_manager_fee_policy_bump_rejected",
+		Help: "Number of times a fee bump was not allowed by the fee policy of the tx",
+	}, []string{"chainID"})
 	promTxAttemptCount = promauto.NewGaugeVec(prometheus.GaugeOpts{
 		Name: "tx_manager_tx_attempt_count",
 		Help: "The number of transaction attempts that are currently being processed by the transaction manager",
@@ -128,6 +137,7 @@ type Confirmer[
 	client  txmgrtypes.TxmClient[CHAIN_ID, ADDR, TX_HASH, BLOCK_HASH, R, SEQ, FEE]
 	txmgrtypes.TxAttemptBuilder[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE]
 	stuckTxDetector txmgrtypes.StuckTxDetector[CHAIN_ID, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE]
+	feePolicies     txmgrtypes.FeePolicyEnforcer[CHAIN_ID, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE]
 	resumeCallback  ResumeCallback
 	chainConfig     txmgrtypes.ConfirmerChainConfig
 	feeConfig       txmgrtypes.ConfirmerFeeConfig
@@ -147,6 +157,13 @@ type Confirmer[
 	isReceiptNil                    func(R) bool
 
 	headTracker confirmerHeadTracker[HEAD, BLOCK_HASH]
+
+	// recoveredTxIDs are the transactions remedied by RecoverStuckTransactions at recoveredBlockNum. They are not
+	// bumped again by RebroadcastWhereNecessary for the same block.
+	recoveredTxIDs    map[int64]struct{}
+	recoveredBlockNum int64
+	// latestBlockNum is the number of the latest head processed
+	latestBlockNum atomic.Int64
 }
 
 func NewConfirmer[
@@ -171,6 +188,7 @@ func NewConfirmer[
 	isReceiptNil func(R) bool,
 	stuckTxDetector txmgrtypes.StuckTxDetector[CHAIN_ID, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE],
 	headTracker confirmerHeadTracker[HEAD, BLOCK_HASH],
+	feePolicies txmgrtypes.FeePolicyEnforcer[CHAIN_ID, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE],
 ) *Confirmer[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, R, SEQ, FEE] {
 	lggr = logger.Named(lggr, "Confirmer")
 	return &Confirmer[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, R, SEQ, FEE]{
@@ -188,6 +206,7 @@ func NewConfirmer[
 		mb:               mailbox.NewSingle[HEAD](),
 		isReceiptNil:     isReceiptNil,
 		stuckTxDetector:  stuckTxDetector,
+		feePolicies:      feePolicies,
 		headTracker:      headTracker,
 	}
 }
@@ -297,6 +316,7 @@ func (ec *Confirmer[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, R, SEQ, FEE]) pro
 	mark := time.Now()
 
 	ec.lggr.Debugw("processHead start", "headNum", head.BlockNumber(), "id", "confirmer")
+	ec.latestBlockNum.Store(head.BlockNumber())
 
 	if err := ec.txStore.SetBroadcastBeforeBlockNum(ctx, head.BlockNumber(), ec.chainID); err != nil {
 		return fmt.Errorf("SetBroadcastBeforeBlockNum failed: %w", err)
@@ -332,6 +352,14 @@ func (ec *Confirmer[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, R, SEQ, FEE]) pro
 	ec.lggr.Debugw("Finished ProcessStuckTransactions", "headNum", head.BlockNumber(), "time", time.Since(mark), "id", "confirmer")
 	mark = time.Now()
 
+	if err := ec.RecoverStuckTransactions(ctx, head.BlockNumber()); err != nil {
+		// The keys that failed to recover must not hold back the fee bumping of the others
+		ec.lggr.Errorw("RecoverStuckTransactions failed", "headNum", head.BlockNumber(), "err", err)
+	}
+
+	ec.lggr.Debugw("Finished RecoverStuckTransactions", "headNum", head.BlockNumber(), "time", time.Since(mark), "id", "confirmer")
+	mark = time.Now()
+
 	if err := ec.RebroadcastWhereNecessary(ctx, head.BlockNumber()); err != nil {
 		return fmt.Errorf("RebroadcastWhereNecessary failed: %w", err)
 	}
@@ -491,36 +519,12 @@ func (ec *Confirmer[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, R, SEQ, FEE]) Pro
 		// NOTE: This design will block one key if another takes a really long time to execute
 		go func(tx txmgrtypes.Tx[CHAIN_ID, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE]) {
 			defer wg.Done()
-			lggr := tx.GetLogger(ec.lggr)
-			// Create a purge attempt for tx
-			purgeAttempt, err := ec.TxAttemptBuilder.NewPurgeTxAttempt(ctx, tx, lggr)
+			err := ec.purgeStuckTransaction(ctx, tx, blockNum)
+			ec.recordStuckTxDecision(ctx, tx.ID, tx.FromAddress, *tx.Sequence, txmgrtypes.StuckTxReasonTerminallyStuck, txmgrtypes.StuckTxRemedyPurge, blockNum, err)
 			if err != nil {
 				errMu.Lock()
-				errorList = append(errorList, fmt.Errorf("failed to create a purge attempt: %w", err))
-				errMu.Unlock()
-				return
-			}
-			// Save purge attempt
-			if err := ec.txStore.SaveInProgressAttempt(ctx, &purgeAttempt); err != nil {
-				errMu.Lock()
-				errorList = append(errorList, fmt.Errorf("failed to save purge attempt: %w", err))
-				errMu.Unlock()
-				return
-			}
-			lggr.Warnw("marked transaction as terminally stuck", "etx", tx)
-			// Send purge attempt
-			if err := ec.handleInProgressAttempt(ctx, lggr, tx, purgeAttempt, blockNum); err != nil {
-				errMu.Lock()
-				errorList = append(errorList, fmt.Errorf("failed to send purge attempt: %w", err))
-				errMu.Unlock()
-				return
-			}
-			// Resume pending task runs with failure for stuck transactions
-			if err := ec.resumeFailedTaskRuns(ctx, tx); err != nil {
-				errMu.Lock()
-				errorList = append(errorList, fmt.Errorf("failed to resume pending task run for transaction: %w", err))
+				errorList = append(errorList, err)
 				errMu.Unlock()
-				return
 			}
 		}(tx)
 	}
@@ -528,6 +532,126 @@ func (ec *Confirmer[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, R, SEQ, FEE]) Pro
 	return errors.Join(errorList...)
 }
 
+// purgeStuckTransaction sends an empty attempt with bumped gas to replace the stuck transaction, and fails its pending task run
+func (ec *Confirmer[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, R, SEQ, FEE]) purgeStuckTransaction(ctx context.Context, tx txmgrtypes.Tx[CHAIN_ID, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE], blockNum int64) error {
+	lggr := tx.GetLogger(ec.lggr)
+	// Create a purge attempt for tx
+	purgeAttempt, err := ec.TxAttemptBuilder.NewPurgeTxAttempt(ctx, tx, lggr)
+	if err != nil {
+		return fmt.Errorf("failed to create a purge attempt: %w", err)
+	}
+	// Save purge attempt
+	if err := ec.txStore.SaveInProgressAttempt(ctx, &purgeAttempt); err != nil {
+		return fmt.Errorf("failed to save purge attempt: %w", err)
+	}
+	lggr.Warnw("marked transaction as terminally stuck", "etx", tx)
+	// Send purge attempt
+	if err := ec.handleInProgressAttempt(ctx, lggr, tx, purgeAttempt, blockNum); err != nil {
+		return fmt.Errorf("failed to send purge attempt: %w", err)
+	}
+	// Resume pending task runs with failure for stuck transactions
+	if err := ec.resumeFailedTaskRuns(ctx, tx); err != nil {
+		return fmt.Errorf("failed to resume pending task run for transaction: %w", err)
+	}
+	return nil
+}
+
+// RecoverStuckTransactions diagnoses why the lowest sequence unconfirmed transaction of each enabled address is stuck, if it is,
+// and applies a remedy. Each decision is saved in the stuck transaction history of the address. An address failing to
+// recover does not prevent the others from recovering.
+func (ec *Confirmer[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, R, SEQ, FEE]) RecoverStuckTransactions(ctx context.Context, blockNum int64) error {
+	ec.recoveredTxIDs = make(map[int64]struct{})
+	ec.recoveredBlockNum = blockNum
+
+	diagnoses, err := ec.stuckTxDetector.DiagnoseStuckTransactions(ctx, ec.enabledAddresses, blockNum)
+	if err != nil {
+		return fmt.Errorf("failed to diagnose stuck transactions: %w", err)
+	}
+
+	var errs []error
+	// Diagnoses are for distinct addresses, and there are few of them, so they are processed sequentially
+	for _, d := range diagnoses {
+		reason, remedy := decideStuckTxRemedy(d)
+		seq := *d.Tx.Sequence
+		if remedy == txmgrtypes.StuckTxRemedySelfTransfer {
+			seq = d.MinedSequence
+		}
+		err := ec.applyStuckTxRemedy(ctx, d, remedy, blockNum)
+		ec.recoveredTxIDs[d.Tx.ID] = struct{}{}
+		ec.recordStuckTxDecision(ctx, d.Tx.ID, d.Tx.FromAddress, seq, reason, remedy, blockNum, err)
+		if err != nil {
+			ec.lggr.Errorw("Failed to recover stuck transaction", "fromAddress", d.Tx.FromAddress, "txID", d.Tx.ID, "remedy", remedy, "err", err)
+			errs = append(errs, fmt.Errorf("failed to %s stuck transaction %d of %s: %w", remedy, d.Tx.ID, d.Tx.FromAddress, err))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+func (ec *Confirmer[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, R, SEQ, FEE]) applyStuckTxRemedy(ctx context.Context, d txmgrtypes.StuckTxDiagnosis[CHAIN_ID, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE], remedy txmgrtypes.StuckTxRemedy, blockNum int64) error {
+	etx := d.Tx
+	if len(etx.TxAttempts) == 0 {
+		return fmt.Errorf("invariant violation: stuck transaction %d has no attempts", etx.ID)
+	}
+	lggr := etx.GetLogger(ec.lggr)
+	switch remedy {
+	case txmgrtypes.StuckTxRemedyRebroadcast:
+		attempt := etx.TxAttempts[0]
+		attempt.Tx = etx
+		if errCode, err := ec.client.SendTransactionReturnCode(ctx, etx, attempt, lggr); errCode != client.Successful && err != nil {
+			return err
+		}
+		return nil
+	case txmgrtypes.StuckTxRemedyBump:
+		attempt, err := ec.attemptForRebroadcast(ctx, lggr, etx)
+		if err != nil {
+			return fmt.Errorf("attemptForRebroadcast failed: %w", err)
+		}
+		if err := ec.txStore.SaveInProgressAttempt(ctx, &attempt); err != nil {
+			return fmt.Errorf("saveInProgressAttempt failed: %w", err)
+		}
+		return ec.handleInProgressAttempt(ctx, lggr, etx, attempt, blockNum)
+	case txmgrtypes.StuckTxRemedySelfTransfer:
+		// The newest attempt pays a fee the chain accepted recently, so it is used for the empty transaction as well
+		_, err := ec.sendEmptyTransaction(ctx, etx.FromAddress, d.MinedSequence, 0, etx.TxAttempts[0].TxFee)
+		return err
+	case txmgrtypes.StuckTxRemedyPurge:
+		return ec.purgeStuckTransaction(ctx, etx, blockNum)
+	default:
+		return fmt.Errorf("unknown stuck transaction remedy: %s", remedy)
+	}
+}
+
+func (ec *Confirmer[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, R, SEQ, FEE]) recordStuckTxDecision(ctx context.Context, txID int64, fromAddress ADDR, seq SEQ, reason txmgrtypes.StuckTxReason, remedy txmgrtypes.StuckTxRemedy, blockNum int64, err error) {
+	d := txmgrtypes.StuckTxDecision[ADDR, SEQ]{
+		TxID:        txID,
+		FromAddress: fromAddress,
+		Sequence:    seq,
+		Reason:      reason,
+		Remedy:      remedy,
+		BlockNum:    blockNum,
+		DecidedAt:   time.Now(),
+	}
+	if err != nil {
+		d.Error = err.Error()
+	}
+	promStuckTxDecisions.WithLabelValues(ec.chainID.String(), string(reason), string(remedy)).Inc()
+	ec.lggr.Warnw("Recovering stuck transaction", "txID", txID, "fromAddress", fromAddress, "sequence", seq, "reason", reason, "remedy", remedy, "blockNum", blockNum, "err", err)
+	if err := ec.txStore.SaveStuckTxDecision(ctx, d, stuckTxHistorySize, ec.chainID); err != nil {
+		ec.lggr.Errorw("Failed to save stuck transaction decision", "txID", txID, "fromAddress", fromAddress, "err", err)
+	}
+}
+
+// StuckTxHistory returns the most recent stuck transaction decisions for the address, oldest first
+func (ec *Confirmer[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, R, SEQ, FEE]) StuckTxHistory(ctx context.Context, address ADDR) ([]txmgrtypes.StuckTxDecision[ADDR, SEQ], error) {
+	return ec.txStore.FindStuckTxDecisions(ctx, address, stuckTxHistorySize, ec.chainID)
+}
+
+// FindStuckTransactions returns the lowest sequence unconfirmed transaction of each enabled address if it has been
+// waiting for confirmation for too long at the latest head processed
+func (ec *Confirmer[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, R, SEQ, FEE]) FindStuckTransactions(ctx context.Context) ([]txmgrtypes.Tx[CHAIN_ID, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE], error) {
+	return ec.stuckTxDetector.FindStuckTransactions(ctx, ec.enabledAddresses, ec.latestBlockNum.Load())
+}
+
 func (ec *Confirmer[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, R, SEQ, FEE]) separateLikelyConfirmedAttempts(from ADDR, attempts []txmgrtypes.TxAttempt[CHAIN_ID, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE], minedSequence SEQ) []txmgrtypes.TxAttempt[CHAIN_ID, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE] {
 	if len(attempts) == 0 {
 		return attempts
@@ -601,6 +725,10 @@ func (ec *Confirmer[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, R, SEQ, FEE]) fet
 		allReceipts = append(allReceipts, receipts...)
 	}
 
+	if ec.feePolicies != nil {
+		ec.recordConfirmedFees(ctx, attempts, allReceipts)
+	}
+
 	observeUntilTxConfirmed(ec.chainID, attempts, allReceipts)
 
 	return nil
@@ -785,6 +913,11 @@ func (ec *Confirmer[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, R, SEQ, FEE]) reb
 	for _, etx := range etxs {
 		lggr := etx.GetLogger(ec.lggr)
 
+		if _, ok := ec.recoveredTxIDs[etx.ID]; ok && ec.recoveredBlockNum == blockHeight {
+			lggr.Debugw("Skipping rebroadcast of transaction already recovered at this block", "blockHeight", blockHeight)
+			continue
+		}
+
 		attempt, err := ec.attemptForRebroadcast(ctx, lggr, *etx)
 		if err != nil {
 			return fmt.Errorf("attemptForRebroadcast failed: %w", err)
@@ -937,6 +1070,25 @@ func (ec *Confirmer[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, R, SEQ, FEE]) bum
 	var bumpedFeeLimit uint64
 	bumpedAttempt, bumpedFee, bumpedFeeLimit, _, err = ec.NewBumpTxAttempt(ctx, etx, previousAttempt, previousAttempts, ec.lggr)
 
+	if err == nil && ec.feePolicies != nil {
+		// Urgent transactions may be bumped more than once at a time. If one
+		// of the extra bumps fails, e.g. because it would exceed the max fee
+		// price, the last successful bump is used.
+		for i := 1; i < ec.feePolicies.BumpCount(etx); i++ {
+			attempts := append([]txmgrtypes.TxAttempt[CHAIN_ID, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE]{bumpedAttempt}, previousAttempts...)
+			attempt, fee, feeLimit, _, bumpErr := ec.NewBumpTxAttempt(ctx, etx, bumpedAttempt, attempts, ec.lggr)
+			if bumpErr != nil {
+				ec.lggr.Debugw("Failed to bump fee again for urgent tx", append(logFields, "err", bumpErr)...)
+				break
+			}
+			bumpedAttempt, bumpedFee, bumpedFeeLimit = attempt, fee, feeLimit
+		}
+		err = ec.feePolicies.CheckBump(ctx, etx, bumpedAttempt)
+		if err != nil {
+			promFeePolicyBumpRejected.WithLabelValues(ec.chainID.String()).Inc()
+		}
+	}
+
 	// if no error, return attempt
 	// if err, continue below
 	if err == nil {
@@ -1293,6 +1445,24 @@ func (ec *Confirmer[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, R, SEQ, FEE]) Res
 	return nil
 }
 
+// recordConfirmedFees records the fees spent by each confirmed attempt with the fee policy enforcer
+func (ec *Confirmer[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, R, SEQ, FEE]) recordConfirmedFees(ctx context.Context, attempts []txmgrtypes.TxAttempt[CHAIN_ID, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE], receipts []R) {
+	receiptMap := make(map[TX_HASH]R, len(receipts))
+	for _, r := range receipts {
+		receiptMap[r.GetTxHash()] = r
+	}
+	for _, attempt := range attempts {
+		// Purge attempts replace the original tx, but their fees are spent all the same
+		r, ok := receiptMap[attempt.Hash]
+		if !ok {
+			continue
+		}
+		if err := ec.feePolicies.RecordConfirmed(ctx, attempt, r.GetFeeUsed(), r.GetEffectiveFeePrice()); err != nil {
+			ec.lggr.Errorw("Failed to record the fee spent by confirmed tx", "etxID", attempt.TxID, "txHash", attempt.Hash, "err", err)
+		}
+	}
+}
+
 // observeUntilTxConfirmed observes the promBlocksUntilTxConfirmed metric for each confirmed
 // transaction.
 func observeUntilTxConfirmed[
@@ -0,0 +1,34 @@
+package txmgr
+
+import (
+	feetypes "github.com/smartcontractkit/chainlink/v2/common/fee/types"
+	txmgrtypes "github.com/smartcontractkit/chainlink/v2/common/txmgr/types"
+	"github.com/smartcontractkit/chainlink/v2/common/types"
+)
+
+// stuckTxHistorySize is the number of decisions kept per address
+const stuckTxHistorySize = 100
+
+// decideStuckTxRemedy picks the remedy for a stuck transaction. It is chain agnostic, the chain specific
+// detection is done by the StuckTxDetector when diagnosing the transaction.
+func decideStuckTxRemedy[
+	CHAIN_ID types.ID,
+	ADDR types.Hashable,
+	TX_HASH, BLOCK_HASH types.Hashable,
+	SEQ types.Sequence,
+	FEE feetypes.Fee,
+](d txmgrtypes.StuckTxDiagnosis[CHAIN_ID, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE]) (txmgrtypes.StuckTxReason, txmgrtypes.StuckTxRemedy) {
+	switch {
+	case d.NonceGap:
+		// The transaction can't be mined until the gap is filled, whatever its fee
+		return txmgrtypes.StuckTxReasonNonceGap, txmgrtypes.StuckTxRemedySelfTransfer
+	case !d.InMempool:
+		return txmgrtypes.StuckTxReasonDroppedFromMempool, txmgrtypes.StuckTxRemedyRebroadcast
+	case d.FeeBelowMarket:
+		return txmgrtypes.StuckTxReasonFeeTooLow, txmgrtypes.StuckTxRemedyBump
+	case d.PurgeSupported:
+		return txmgrtypes.StuckTxReasonTerminallyStuck, txmgrtypes.StuckTxRemedyPurge
+	default:
+		return txmgrtypes.StuckTxReasonTerminallyStuck, txmgrtypes.StuckTxRemedyRebroadcast
+	}
+}
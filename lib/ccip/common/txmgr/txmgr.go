@@ -9,6 +9,8 @@ import (
 	"time"
 
 	"github.com/google/uuid"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/trace"
 	nullv4 "gopkg.in/guregu/null.v4"
 
 	"github.com/smartcontractkit/chainlink-common/pkg/logger"
@@ -63,6 +65,10 @@ type TxManager[
 	FindEarliestUnconfirmedTxAttemptBlock(ctx context.Context) (nullv4.Int, error)
 	CountTransactionsByState(ctx context.Context, state txmgrtypes.TxState) (count uint32, err error)
 	GetTransactionStatus(ctx context.Context, transactionID string) (state commontypes.TransactionStatus, err error)
+	// Returns the most recent stuck transaction recovery decisions for the address, oldest first
+	StuckTxHistory(ctx context.Context, address ADDR) ([]txmgrtypes.StuckTxDecision[ADDR, SEQ], error)
+	// Returns the lowest sequence unconfirmed transaction of each enabled address if it has been waiting for confirmation for too long
+	FindStuckTransactions(ctx context.Context) ([]txmgrtypes.Tx[CHAIN_ID, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE], error)
 }
 
 type reset struct {
@@ -110,6 +116,7 @@ type Txm[
 	tracker            *Tracker[CHAIN_ID, ADDR, TX_HASH, BLOCK_HASH, R, SEQ, FEE]
 	finalizer          txmgrtypes.Finalizer[BLOCK_HASH, HEAD]
 	fwdMgr             txmgrtypes.ForwarderManager[ADDR]
+	smartAccounts      txmgrtypes.SmartAccountManager[ADDR, TX_HASH]
 	txAttemptBuilder   txmgrtypes.TxAttemptBuilder[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE]
 	newErrorClassifier NewErrorClassifier
 }
@@ -118,6 +125,9 @@ func (b *Txm[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, R, SEQ, FEE]) RegisterRe
 	b.resumeCallback = fn
 	b.broadcaster.SetResumeCallback(fn)
 	b.confirmer.SetResumeCallback(fn)
+	if b.smartAccounts != nil {
+		b.smartAccounts.SetResumeCallback(fn)
+	}
 }
 
 // NewTxm creates a new Txm with the given configuration.
@@ -138,6 +148,7 @@ func NewTxm[
 	lggr logger.Logger,
 	checkerFactory TransmitCheckerFactory[CHAIN_ID, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE],
 	fwdMgr txmgrtypes.ForwarderManager[ADDR],
+	smartAccounts txmgrtypes.SmartAccountManager[ADDR, TX_HASH],
 	txAttemptBuilder txmgrtypes.TxAttemptBuilder[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE],
 	txStore txmgrtypes.TxStore[ADDR, CHAIN_ID, TX_HASH, BLOCK_HASH, R, SEQ, FEE],
 	broadcaster *Broadcaster[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE],
@@ -161,6 +172,7 @@ func NewTxm[
 		chSubbed:           make(chan struct{}),
 		reset:              make(chan reset),
 		fwdMgr:             fwdMgr,
+		smartAccounts:      smartAccounts,
 		txAttemptBuilder:   txAttemptBuilder,
 		broadcaster:        broadcaster,
 		confirmer:          confirmer,
@@ -225,6 +237,12 @@ func (b *Txm[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, R, SEQ, FEE]) Start(ctx
 			}
 		}
 
+		if b.smartAccounts != nil {
+			if err := ms.Start(ctx, b.smartAccounts); err != nil {
+				return fmt.Errorf("Txm: SmartAccountManager failed to start: %w", err)
+			}
+		}
+
 		return nil
 	})
 }
@@ -277,6 +295,11 @@ func (b *Txm[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, R, SEQ, FEE]) Close() (m
 				merr = errors.Join(merr, fmt.Errorf("Txm: failed to stop ForwarderManager: %w", err))
 			}
 		}
+		if b.smartAccounts != nil {
+			if err := b.smartAccounts.Close(); err != nil {
+				merr = errors.Join(merr, fmt.Errorf("Txm: failed to stop SmartAccountManager: %w", err))
+			}
+		}
 
 		b.wg.Wait()
 
@@ -306,6 +329,9 @@ func (b *Txm[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, R, SEQ, FEE]) HealthRepo
 	if b.txConfig.ForwardersEnabled() {
 		services.CopyHealth(report, b.fwdMgr.HealthReport())
 	}
+	if b.smartAccounts != nil {
+		services.CopyHealth(report, b.smartAccounts.HealthReport())
+	}
 	return report
 }
 
@@ -528,6 +554,10 @@ func (b *Txm[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, R, SEQ, FEE]) CreateTran
 		return tx, err
 	}
 
+	if b.smartAccounts != nil && b.smartAccounts.SendsUserOperations(txRequest.FromAddress) {
+		return b.sendUserOperation(ctx, txRequest)
+	}
+
 	if b.txConfig.ForwardersEnabled() && (!utils.IsZero(txRequest.ForwarderAddress)) {
 		fwdPayload, fwdErr := b.fwdMgr.ConvertPayload(txRequest.ToAddress, txRequest.EncodedPayload)
 		if fwdErr == nil {
@@ -546,11 +576,27 @@ func (b *Txm[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, R, SEQ, FEE]) CreateTran
 		}
 	}
 
+	if b.smartAccounts != nil {
+		txRequest, err = b.smartAccounts.ConvertTxRequest(txRequest)
+		if err != nil {
+			return tx, fmt.Errorf("Txm#CreateTransaction: failed to convert request for smart account: %w", err)
+		}
+	}
+
 	err = b.txStore.CheckTxQueueCapacity(ctx, txRequest.FromAddress, b.txConfig.MaxQueued(), b.chainID)
 	if err != nil {
 		return tx, fmt.Errorf("Txm#CreateTransaction: %w", err)
 	}
 
+	// The tx is broadcast asynchronously, carry the span of the caller so the broadcast span is its child.
+	if trace.SpanContextFromContext(ctx).IsValid() {
+		if txRequest.Meta == nil {
+			txRequest.Meta = &txmgrtypes.TxMeta[ADDR, TX_HASH]{}
+		}
+		txRequest.Meta.TraceContext = make(map[string]string)
+		propagation.TraceContext{}.Inject(ctx, propagation.MapCarrier(txRequest.Meta.TraceContext))
+	}
+
 	tx, err = b.pruneQueueAndCreateTxn(ctx, txRequest, b.chainID)
 	if err != nil {
 		return tx, err
@@ -562,6 +608,34 @@ func (b *Txm[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, R, SEQ, FEE]) CreateTran
 	return tx, nil
 }
 
+// sendUserOperation sends the request of a key with an ERC4337 account as a user operation. The user operation is tracked
+// by the smart account manager, which also resumes the pipeline task of the request, so the returned Tx is not stored.
+func (b *Txm[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, R, SEQ, FEE]) sendUserOperation(ctx context.Context, txRequest txmgrtypes.TxRequest[ADDR, TX_HASH]) (tx txmgrtypes.Tx[CHAIN_ID, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE], err error) {
+	hash, err := b.smartAccounts.SendTxRequest(ctx, txRequest)
+	if err != nil {
+		return tx, fmt.Errorf("Txm#CreateTransaction: failed to send user operation: %w", err)
+	}
+	b.logger.Debugw("Sent transaction as user operation", "hash", hash, "fromAddress", txRequest.FromAddress, "pipelineTaskRunID", txRequest.PipelineTaskRunID)
+
+	tx = txmgrtypes.Tx[CHAIN_ID, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE]{
+		IdempotencyKey:   txRequest.IdempotencyKey,
+		FromAddress:      txRequest.FromAddress,
+		ToAddress:        txRequest.ToAddress,
+		EncodedPayload:   txRequest.EncodedPayload,
+		Value:            txRequest.Value,
+		FeeLimit:         txRequest.FeeLimit,
+		CreatedAt:        time.Now(),
+		State:            TxUnconfirmed,
+		ChainID:          b.chainID,
+		MinConfirmations: txRequest.MinConfirmations,
+		SignalCallback:   txRequest.SignalCallback,
+	}
+	if txRequest.PipelineTaskRunID != nil {
+		tx.PipelineTaskRunID = uuid.NullUUID{UUID: *txRequest.PipelineTaskRunID, Valid: true}
+	}
+	return tx, nil
+}
+
 // Calls forwarderMgr to get a proper forwarder for a given EOA.
 func (b *Txm[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, R, SEQ, FEE]) GetForwarderForEOA(ctx context.Context, eoa ADDR) (forwarder ADDR, err error) {
 	if !b.txConfig.ForwardersEnabled() {
@@ -642,6 +716,14 @@ func (b *Txm[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, R, SEQ, FEE]) CountTrans
 	return b.txStore.CountTransactionsByState(ctx, state, b.chainID)
 }
 
+func (b *Txm[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, R, SEQ, FEE]) StuckTxHistory(ctx context.Context, address ADDR) ([]txmgrtypes.StuckTxDecision[ADDR, SEQ], error) {
+	return b.confirmer.StuckTxHistory(ctx, address)
+}
+
+func (b *Txm[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, R, SEQ, FEE]) FindStuckTransactions(ctx context.Context) ([]txmgrtypes.Tx[CHAIN_ID, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE], error) {
+	return b.confirmer.FindStuckTransactions(ctx)
+}
+
 func (b *Txm[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, R, SEQ, FEE]) GetTransactionStatus(ctx context.Context, transactionID string) (status commontypes.TransactionStatus, err error) {
 	// Loads attempts and receipts in the transaction
 	tx, err := b.txStore.FindTxWithIdempotencyKey(ctx, transactionID, b.chainID)
@@ -649,6 +731,14 @@ func (b *Txm[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, R, SEQ, FEE]) GetTransac
 		return status, fmt.Errorf("failed to find transaction with IdempotencyKey %s: %w", transactionID, err)
 	}
 	// This check is required since a no-rows error returns nil err
+	if tx == nil && b.smartAccounts != nil {
+		// the requests of keys with an ERC4337 account are sent as user operations
+		var found bool
+		status, found, err = b.smartAccounts.TransactionStatus(ctx, transactionID)
+		if found || err != nil {
+			return status, err
+		}
+	}
 	if tx == nil {
 		return status, fmt.Errorf("failed to find transaction with IdempotencyKey %s", transactionID)
 	}
@@ -762,6 +852,14 @@ func (n *NullTxManager[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE]) Get
 	return
 }
 
+func (n *NullTxManager[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE]) StuckTxHistory(ctx context.Context, address ADDR) ([]txmgrtypes.StuckTxDecision[ADDR, SEQ], error) {
+	return nil, nil
+}
+
+func (n *NullTxManager[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE]) FindStuckTransactions(ctx context.Context) ([]txmgrtypes.Tx[CHAIN_ID, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE], error) {
+	return nil, nil
+}
+
 func (b *Txm[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, R, SEQ, FEE]) pruneQueueAndCreateTxn(
 	ctx context.Context,
 	txRequest txmgrtypes.TxRequest[ADDR, TX_HASH],
@@ -0,0 +1,26 @@
+package types
+
+import (
+	"context"
+	"math/big"
+
+	feetypes "github.com/smartcontractkit/chainlink/v2/common/fee/types"
+	"github.com/smartcontractkit/chainlink/v2/common/types"
+)
+
+// FeePolicyEnforcer is used by the Confirmer to apply the fee policy of a transaction when bumping its fee
+type FeePolicyEnforcer[
+	CHAIN_ID types.ID, // CHAIN_ID - chain id type
+	ADDR types.Hashable, // ADDR - chain address type
+	TX_HASH, BLOCK_HASH types.Hashable, // various chain hash types
+	SEQ types.Sequence, // SEQ - chain sequence type (nonce, utxo, etc)
+	FEE feetypes.Fee, // FEE - chain fee type
+] interface {
+	// Returns the number of times the fee of the transaction is bumped at once, which is at least 1
+	BumpCount(tx Tx[CHAIN_ID, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE]) int
+	// Returns an error wrapping fee.ErrBump if the bumped attempt is not allowed by the fee policy of its transaction
+	CheckBump(ctx context.Context, tx Tx[CHAIN_ID, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE], bumpedAttempt TxAttempt[CHAIN_ID, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE]) error
+	// Records the fee spent by a confirmed attempt, given the fee units (e.g. gas) it used and the price it paid
+	// per unit from its receipt, which is nil if the chain did not report it
+	RecordConfirmed(ctx context.Context, attempt TxAttempt[CHAIN_ID, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE], feeUsed uint64, effectiveFeePrice *big.Int) error
+}
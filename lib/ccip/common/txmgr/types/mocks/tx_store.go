@@ -673,6 +673,67 @@ func (_c *TxStore_FindNextUnstartedTransactionFromAddress_Call[ADDR, CHAIN_ID, T
 	return _c
 }
 
+// FindStuckTxDecisions provides a mock function with given fields: ctx, address, limit, chainID
+func (_m *TxStore[ADDR, CHAIN_ID, TX_HASH, BLOCK_HASH, R, SEQ, FEE]) FindStuckTxDecisions(ctx context.Context, address ADDR, limit int, chainID CHAIN_ID) ([]txmgrtypes.StuckTxDecision[ADDR, SEQ], error) {
+	ret := _m.Called(ctx, address, limit, chainID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindStuckTxDecisions")
+	}
+
+	var r0 []txmgrtypes.StuckTxDecision[ADDR, SEQ]
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, ADDR, int, CHAIN_ID) ([]txmgrtypes.StuckTxDecision[ADDR, SEQ], error)); ok {
+		return rf(ctx, address, limit, chainID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, ADDR, int, CHAIN_ID) []txmgrtypes.StuckTxDecision[ADDR, SEQ]); ok {
+		r0 = rf(ctx, address, limit, chainID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]txmgrtypes.StuckTxDecision[ADDR, SEQ])
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, ADDR, int, CHAIN_ID) error); ok {
+		r1 = rf(ctx, address, limit, chainID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// TxStore_FindStuckTxDecisions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindStuckTxDecisions'
+type TxStore_FindStuckTxDecisions_Call[ADDR types.Hashable, CHAIN_ID types.ID, TX_HASH types.Hashable, BLOCK_HASH types.Hashable, R txmgrtypes.ChainReceipt[TX_HASH, BLOCK_HASH], SEQ types.Sequence, FEE feetypes.Fee] struct {
+	*mock.Call
+}
+
+// FindStuckTxDecisions is a helper method to define mock.On call
+//   - ctx context.Context
+//   - address ADDR
+//   - limit int
+//   - chainID CHAIN_ID
+func (_e *TxStore_Expecter[ADDR, CHAIN_ID, TX_HASH, BLOCK_HASH, R, SEQ, FEE]) FindStuckTxDecisions(ctx interface{}, address interface{}, limit interface{}, chainID interface{}) *TxStore_FindStuckTxDecisions_Call[ADDR, CHAIN_ID, TX_HASH, BLOCK_HASH, R, SEQ, FEE] {
+	return &TxStore_FindStuckTxDecisions_Call[ADDR, CHAIN_ID, TX_HASH, BLOCK_HASH, R, SEQ, FEE]{Call: _e.mock.On("FindStuckTxDecisions", ctx, address, limit, chainID)}
+}
+
+func (_c *TxStore_FindStuckTxDecisions_Call[ADDR, CHAIN_ID, TX_HASH, BLOCK_HASH, R, SEQ, FEE]) Run(run func(ctx context.Context, address ADDR, limit int, chainID CHAIN_ID)) *TxStore_FindStuckTxDecisions_Call[ADDR, CHAIN_ID, TX_HASH, BLOCK_HASH, R, SEQ, FEE] {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(ADDR), args[2].(int), args[3].(CHAIN_ID))
+	})
+	return _c
+}
+
+func (_c *TxStore_FindStuckTxDecisions_Call[ADDR, CHAIN_ID, TX_HASH, BLOCK_HASH, R, SEQ, FEE]) Return(_a0 []txmgrtypes.StuckTxDecision[ADDR, SEQ], _a1 error) *TxStore_FindStuckTxDecisions_Call[ADDR, CHAIN_ID, TX_HASH, BLOCK_HASH, R, SEQ, FEE] {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *TxStore_FindStuckTxDecisions_Call[ADDR, CHAIN_ID, TX_HASH, BLOCK_HASH, R, SEQ, FEE]) RunAndReturn(run func(context.Context, ADDR, int, CHAIN_ID) ([]txmgrtypes.StuckTxDecision[ADDR, SEQ], error)) *TxStore_FindStuckTxDecisions_Call[ADDR, CHAIN_ID, TX_HASH, BLOCK_HASH, R, SEQ, FEE] {
+	_c.Call.Return(run)
+	return _c
+}
+
 // FindTransactionsConfirmedInBlockRange provides a mock function with given fields: ctx, highBlockNumber, lowBlockNumber, chainID
 func (_m *TxStore[ADDR, CHAIN_ID, TX_HASH, BLOCK_HASH, R, SEQ, FEE]) FindTransactionsConfirmedInBlockRange(ctx context.Context, highBlockNumber int64, lowBlockNumber int64, chainID CHAIN_ID) ([]*txmgrtypes.Tx[CHAIN_ID, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE], error) {
 	ret := _m.Called(ctx, highBlockNumber, lowBlockNumber, chainID)
@@ -2350,6 +2411,55 @@ func (_c *TxStore_SaveSentAttempt_Call[ADDR, CHAIN_ID, TX_HASH, BLOCK_HASH, R, S
 	return _c
 }
 
+// SaveStuckTxDecision provides a mock function with given fields: ctx, decision, historySize, chainID
+func (_m *TxStore[ADDR, CHAIN_ID, TX_HASH, BLOCK_HASH, R, SEQ, FEE]) SaveStuckTxDecision(ctx context.Context, decision txmgrtypes.StuckTxDecision[ADDR, SEQ], historySize int, chainID CHAIN_ID) error {
+	ret := _m.Called(ctx, decision, historySize, chainID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SaveStuckTxDecision")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, txmgrtypes.StuckTxDecision[ADDR, SEQ], int, CHAIN_ID) error); ok {
+		r0 = rf(ctx, decision, historySize, chainID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// TxStore_SaveStuckTxDecision_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SaveStuckTxDecision'
+type TxStore_SaveStuckTxDecision_Call[ADDR types.Hashable, CHAIN_ID types.ID, TX_HASH types.Hashable, BLOCK_HASH types.Hashable, R txmgrtypes.ChainReceipt[TX_HASH, BLOCK_HASH], SEQ types.Sequence, FEE feetypes.Fee] struct {
+	*mock.Call
+}
+
+// SaveStuckTxDecision is a helper method to define mock.On call
+//   - ctx context.Context
+//   - decision txmgrtypes.StuckTxDecision[ADDR, SEQ]
+//   - historySize int
+//   - chainID CHAIN_ID
+func (_e *TxStore_Expecter[ADDR, CHAIN_ID, TX_HASH, BLOCK_HASH, R, SEQ, FEE]) SaveStuckTxDecision(ctx interface{}, decision interface{}, historySize interface{}, chainID interface{}) *TxStore_SaveStuckTxDecision_Call[ADDR, CHAIN_ID, TX_HASH, BLOCK_HASH, R, SEQ, FEE] {
+	return &TxStore_SaveStuckTxDecision_Call[ADDR, CHAIN_ID, TX_HASH, BLOCK_HASH, R, SEQ, FEE]{Call: _e.mock.On("SaveStuckTxDecision", ctx, decision, historySize, chainID)}
+}
+
+func (_c *TxStore_SaveStuckTxDecision_Call[ADDR, CHAIN_ID, TX_HASH, BLOCK_HASH, R, SEQ, FEE]) Run(run func(ctx context.Context, decision txmgrtypes.StuckTxDecision[ADDR, SEQ], historySize int, chainID CHAIN_ID)) *TxStore_SaveStuckTxDecision_Call[ADDR, CHAIN_ID, TX_HASH, BLOCK_HASH, R, SEQ, FEE] {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(txmgrtypes.StuckTxDecision[ADDR, SEQ]), args[2].(int), args[3].(CHAIN_ID))
+	})
+	return _c
+}
+
+func (_c *TxStore_SaveStuckTxDecision_Call[ADDR, CHAIN_ID, TX_HASH, BLOCK_HASH, R, SEQ, FEE]) Return(_a0 error) *TxStore_SaveStuckTxDecision_Call[ADDR, CHAIN_ID, TX_HASH, BLOCK_HASH, R, SEQ, FEE] {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *TxStore_SaveStuckTxDecision_Call[ADDR, CHAIN_ID, TX_HASH, BLOCK_HASH, R, SEQ, FEE]) RunAndReturn(run func(context.Context, txmgrtypes.StuckTxDecision[ADDR, SEQ], int, CHAIN_ID) error) *TxStore_SaveStuckTxDecision_Call[ADDR, CHAIN_ID, TX_HASH, BLOCK_HASH, R, SEQ, FEE] {
+	_c.Call.Return(run)
+	return _c
+}
+
 // SetBroadcastBeforeBlockNum provides a mock function with given fields: ctx, blockNum, chainID
 func (_m *TxStore[ADDR, CHAIN_ID, TX_HASH, BLOCK_HASH, R, SEQ, FEE]) SetBroadcastBeforeBlockNum(ctx context.Context, blockNum int64, chainID CHAIN_ID) error {
 	ret := _m.Called(ctx, blockNum, chainID)
@@ -0,0 +1,28 @@
+package types
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/services"
+	commontypes "github.com/smartcontractkit/chainlink-common/pkg/types"
+
+	"github.com/smartcontractkit/chainlink/v2/common/types"
+)
+
+type SmartAccountManager[ADDR types.Hashable, TX_HASH types.Hashable] interface {
+	services.Service
+	// Converts the request of a key which submits through a smart account to the call of that account.
+	// Requests of other keys are returned unchanged. Keys which send user operations are rejected with an error, their
+	// requests must be sent with SendTxRequest.
+	ConvertTxRequest(txRequest TxRequest[ADDR, TX_HASH]) (TxRequest[ADDR, TX_HASH], error)
+	// SendsUserOperations returns true if the transactions of key are sent as user operations of its account
+	SendsUserOperations(key ADDR) bool
+	// SendTxRequest sends the request as a user operation and returns its hash. The pipeline task of the request is
+	// resumed with the callback set by SetResumeCallback once the user operation is included.
+	SendTxRequest(ctx context.Context, txRequest TxRequest[ADDR, TX_HASH]) (TX_HASH, error)
+	// TransactionStatus returns the status of the user operation sent for the idempotency key, and false if there is none
+	TransactionStatus(ctx context.Context, idempotencyKey string) (commontypes.TransactionStatus, bool, error)
+	SetResumeCallback(fn func(ctx context.Context, id uuid.UUID, result interface{}, err error) error)
+}
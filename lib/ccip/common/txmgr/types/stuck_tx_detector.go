@@ -23,4 +23,10 @@ type StuckTxDetector[
 	SetPurgeBlockNum(fromAddress ADDR, blockNum int64)
 	// Returns the error message to set in the transaction error field to mark it as terminally stuck
 	StuckTxFatalError() string
+	// Diagnoses the lowest sequence unconfirmed transaction of each enabled address if it has been waiting for confirmation for too long.
+	// Returns nothing if stuck transaction recovery is disabled.
+	DiagnoseStuckTransactions(ctx context.Context, enabledAddresses []ADDR, blockNum int64) ([]StuckTxDiagnosis[CHAIN_ID, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE], error)
+	// Returns the lowest sequence unconfirmed transaction of each enabled address if it has been waiting for confirmation for too long,
+	// whether or not it was diagnosed recently
+	FindStuckTransactions(ctx context.Context, enabledAddresses []ADDR, blockNum int64) ([]Tx[CHAIN_ID, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE], error)
 }
@@ -0,0 +1,71 @@
+package types
+
+import (
+	"time"
+
+	feetypes "github.com/smartcontractkit/chainlink/v2/common/fee/types"
+	"github.com/smartcontractkit/chainlink/v2/common/types"
+)
+
+// StuckTxReason is why an unconfirmed transaction is considered stuck
+type StuckTxReason string
+
+const (
+	// The fee of the newest attempt is below the fee currently estimated for the chain
+	StuckTxReasonFeeTooLow StuckTxReason = "fee_too_low"
+	// None of the attempts are known by the RPC anymore
+	StuckTxReasonDroppedFromMempool StuckTxReason = "dropped_from_mempool"
+	// A lower sequence was neither mined nor sent by the TXM, so the transaction can never be mined
+	StuckTxReasonNonceGap StuckTxReason = "nonce_gap"
+	// The transaction is not mined despite paying the market fee, e.g. because it can never fit in a block
+	StuckTxReasonTerminallyStuck StuckTxReason = "terminally_stuck"
+)
+
+// StuckTxRemedy is how a stuck transaction is recovered
+type StuckTxRemedy string
+
+const (
+	// Sends the newest attempt of the transaction again
+	StuckTxRemedyRebroadcast StuckTxRemedy = "rebroadcast"
+	// Sends a new attempt of the transaction with a bumped fee
+	StuckTxRemedyBump StuckTxRemedy = "bump"
+	// Sends an empty transaction to the from address at the missing sequence
+	StuckTxRemedySelfTransfer StuckTxRemedy = "self_transfer"
+	// Replaces the transaction with an empty transaction at the same sequence, and marks it as fatally errored
+	StuckTxRemedyPurge StuckTxRemedy = "purge"
+)
+
+// StuckTxDiagnosis is the chain specific view of a transaction that has been waiting for confirmation for too long
+type StuckTxDiagnosis[
+	CHAIN_ID types.ID,
+	ADDR types.Hashable,
+	TX_HASH, BLOCK_HASH types.Hashable,
+	SEQ types.Sequence,
+	FEE feetypes.Fee,
+] struct {
+	Tx Tx[CHAIN_ID, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE]
+	// MinedSequence is the next sequence to be mined for the from address of the transaction
+	MinedSequence SEQ
+	// NonceGap is true if the sequences between MinedSequence and the sequence of the transaction are not used by any transaction
+	NonceGap bool
+	// InMempool is false if none of the attempts of the transaction are known by the RPC
+	InMempool bool
+	// FeeBelowMarket is true if the fee of the newest attempt is below the fee currently estimated for the chain
+	FeeBelowMarket bool
+	// PurgeSupported is true if the transaction may be purged on this chain
+	PurgeSupported bool
+}
+
+// StuckTxDecision records how a stuck transaction was recovered
+type StuckTxDecision[ADDR types.Hashable, SEQ types.Sequence] struct {
+	TxID        int64
+	FromAddress ADDR
+	// Sequence the remedy applies to. It is the missing sequence for a self transfer
+	Sequence  SEQ
+	Reason    StuckTxReason
+	Remedy    StuckTxRemedy
+	BlockNum  int64
+	DecidedAt time.Time
+	// Error is set if the remedy failed to be applied
+	Error string
+}
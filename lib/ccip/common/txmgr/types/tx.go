@@ -11,6 +11,7 @@ import (
 	"time"
 
 	"github.com/google/uuid"
+	"go.opentelemetry.io/otel/propagation"
 	"gopkg.in/guregu/null.v4"
 
 	"github.com/smartcontractkit/chainlink-common/pkg/logger"
@@ -93,6 +94,10 @@ type TxRequest[ADDR types.Hashable, TX_HASH types.Hashable] struct {
 
 	// Mark tx requiring callback
 	SignalCallback bool
+
+	// Blobs is the data carried alongside the tx on chains that support blob transactions (EIP-4844), one entry per blob.
+	// A tx with blobs is always sent as a blob transaction.
+	Blobs [][]byte
 }
 
 // TransmitCheckerSpec defines the check that should be performed before a transaction is submitted
@@ -159,6 +164,14 @@ type TxMeta[ADDR types.Hashable, TX_HASH types.Hashable] struct {
 	MessageIDs []string `json:"MessageIDs,omitempty"`
 	// SeqNumbers is used by CCIP for tx to committed sequence numbers correlation in logs
 	SeqNumbers []uint64 `json:"SeqNumbers,omitempty"`
+
+	// FeePolicy is the name of the fee policy applied when bumping the fee of the tx
+	FeePolicy *string `json:"FeePolicy,omitempty"`
+	// Deadline is the time by which the tx should be confirmed, fee policy urgency steps bump its fee faster as it approaches
+	Deadline *time.Time `json:"Deadline,omitempty"`
+
+	// TraceContext is the W3C trace context of the span that created the tx, continued by the span of its broadcast
+	TraceContext map[string]string `json:"TraceContext,omitempty"`
 }
 
 type TxAttempt[
@@ -232,6 +245,9 @@ type Tx[
 	SignalCallback bool
 	// Marks tx callback as signaled
 	CallbackCompleted bool
+
+	// Blobs is the data carried alongside the tx in blob transactions, one entry per blob
+	Blobs [][]byte
 }
 
 func (e *Tx[CHAIN_ID, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE]) GetError() error {
@@ -246,6 +262,16 @@ func (e *Tx[CHAIN_ID, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE]) GetID() string {
 	return fmt.Sprintf("%d", e.ID)
 }
 
+// WithTraceContext returns ctx carrying the trace context of the span that created the Tx, if any, so the spans
+// started from it are children of that span.
+func (e *Tx[CHAIN_ID, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE]) WithTraceContext(ctx context.Context) context.Context {
+	meta, err := e.GetMeta()
+	if err != nil || meta == nil || len(meta.TraceContext) == 0 {
+		return ctx
+	}
+	return propagation.TraceContext{}.Extract(ctx, propagation.MapCarrier(meta.TraceContext))
+}
+
 // GetMeta returns an Tx's meta in struct form, unmarshalling it from JSON first.
 func (e *Tx[CHAIN_ID, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE]) GetMeta() (*TxMeta[ADDR, TX_HASH], error) {
 	if e.Meta == nil {
@@ -319,6 +345,14 @@ func (e *Tx[CHAIN_ID, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE]) GetLogger(lgr logger
 		if len(meta.SeqNumbers) > 0 {
 			lgr = logger.With(lgr, "SeqNumbers", meta.SeqNumbers)
 		}
+
+		if meta.FeePolicy != nil {
+			lgr = logger.With(lgr, "feePolicy", *meta.FeePolicy)
+		}
+
+		if meta.Deadline != nil {
+			lgr = logger.With(lgr, "deadline", *meta.Deadline)
+		}
 	}
 
 	return logger.Sugared(lgr)
@@ -38,6 +38,10 @@ type TxStore[
 	// Update tx to mark that its callback has been signaled
 	UpdateTxCallbackCompleted(ctx context.Context, pipelineTaskRunRid uuid.UUID, chainId CHAIN_ID) error
 	SaveFetchedReceipts(ctx context.Context, r []R, state TxState, errorMsg *string, chainID CHAIN_ID) error
+	// Save how a stuck transaction was recovered, keeping only the latest historySize decisions of its from address
+	SaveStuckTxDecision(ctx context.Context, decision StuckTxDecision[ADDR, SEQ], historySize int, chainID CHAIN_ID) error
+	// Find the latest limit stuck transaction decisions of the address, oldest first
+	FindStuckTxDecisions(ctx context.Context, address ADDR, limit int, chainID CHAIN_ID) ([]StuckTxDecision[ADDR, SEQ], error)
 
 	// additional methods for tx store management
 	CheckTxQueueCapacity(ctx context.Context, fromAddress ADDR, maxQueuedTransactions uint64, chainID CHAIN_ID) (err error)
@@ -131,6 +135,8 @@ type ChainReceipt[TX_HASH, BLOCK_HASH types.Hashable] interface {
 	IsZero() bool
 	IsUnmined() bool
 	GetFeeUsed() uint64
+	// GetEffectiveFeePrice returns the price paid per fee unit, or nil if the chain did not report it
+	GetEffectiveFeePrice() *big.Int
 	GetTransactionIndex() uint
 	GetBlockHash() BLOCK_HASH
 	GetRevertReason() *string
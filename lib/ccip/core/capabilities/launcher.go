@@ -23,6 +23,7 @@ import (
 	"github.com/smartcontractkit/chainlink/v2/core/capabilities/remote/target"
 	remotetypes "github.com/smartcontractkit/chainlink/v2/core/capabilities/remote/types"
 	"github.com/smartcontractkit/chainlink/v2/core/capabilities/streams"
+	"github.com/smartcontractkit/chainlink/v2/core/config"
 	"github.com/smartcontractkit/chainlink/v2/core/logger"
 	p2ptypes "github.com/smartcontractkit/chainlink/v2/core/services/p2p/types"
 	"github.com/smartcontractkit/chainlink/v2/core/services/registrysyncer"
@@ -44,11 +45,13 @@ var defaultStreamConfig = p2ptypes.StreamConfig{
 
 type launcher struct {
 	services.StateMachine
-	lggr        logger.Logger
-	peerWrapper p2ptypes.PeerWrapper
-	dispatcher  remotetypes.Dispatcher
-	registry    *Registry
-	subServices []services.Service
+	lggr          logger.Logger
+	peerWrapper   p2ptypes.PeerWrapper
+	decrypter     p2ptypes.Decrypter
+	dispatcher    remotetypes.Dispatcher
+	registry      *Registry
+	remoteTargets []config.RemoteTarget
+	subServices   []services.Service
 }
 
 func unmarshalCapabilityConfig(data []byte) (capabilities.CapabilityConfiguration, error) {
@@ -87,18 +90,24 @@ func unmarshalCapabilityConfig(data []byte) (capabilities.CapabilityConfiguratio
 	}, nil
 }
 
+// NewLauncher creates a launcher. The decrypter, which may be nil, decrypts the remote target payloads encrypted to
+// the p2p key of the node. remoteTargets is the node local configuration of remote target capabilities.
 func NewLauncher(
 	lggr logger.Logger,
 	peerWrapper p2ptypes.PeerWrapper,
+	decrypter p2ptypes.Decrypter,
 	dispatcher remotetypes.Dispatcher,
 	registry *Registry,
+	remoteTargets []config.RemoteTarget,
 ) *launcher {
 	return &launcher{
-		lggr:        lggr.Named("CapabilitiesLauncher"),
-		peerWrapper: peerWrapper,
-		dispatcher:  dispatcher,
-		registry:    registry,
-		subServices: []services.Service{},
+		lggr:          lggr.Named("CapabilitiesLauncher"),
+		peerWrapper:   peerWrapper,
+		decrypter:     decrypter,
+		dispatcher:    dispatcher,
+		registry:      registry,
+		remoteTargets: remoteTargets,
+		subServices:   []services.Service{},
 	}
 }
 
@@ -298,12 +307,17 @@ func (w *launcher) addRemoteCapabilities(ctx context.Context, myDON registrysync
 			w.lggr.Warn("no remote client configured for capability type consensus, skipping configuration")
 		case capabilities.CapabilityTypeTarget:
 			newTargetFn := func(info capabilities.CapabilityInfo) (capabilityService, error) {
+				opts, err := w.targetClientOpts(info.ID)
+				if err != nil {
+					return nil, err
+				}
 				client := target.NewClient(
 					info,
 					myDON.DON,
 					w.dispatcher,
 					defaultTargetRequestTimeout,
 					w.lggr,
+					opts...,
 				)
 				return client, nil
 			}
@@ -427,6 +441,7 @@ func (w *launcher) exposeCapabilities(ctx context.Context, myPeerID p2ptypes.Pee
 					w.dispatcher,
 					defaultTargetRequestTimeout,
 					w.lggr,
+					w.targetServerOpts(info.ID)...,
 				), nil
 			}
 
@@ -482,6 +497,48 @@ func (w *launcher) addReceiver(ctx context.Context, capability registrysyncer.Ca
 	return nil
 }
 
+func (w *launcher) remoteTargetConfig(capabilityID string) config.RemoteTarget {
+	for _, t := range w.remoteTargets {
+		if t.CapabilityID() == capabilityID {
+			return t
+		}
+	}
+	return nil
+}
+
+func (w *launcher) targetClientOpts(capabilityID string) ([]target.ClientOpt, error) {
+	cfg := w.remoteTargetConfig(capabilityID)
+	if cfg == nil || !cfg.EncryptRequests() {
+		return nil, nil
+	}
+	if w.decrypter == nil {
+		return nil, fmt.Errorf("cannot encrypt requests to capability %s without the p2p key of the node", capabilityID)
+	}
+	return []target.ClientOpt{target.WithEncryptedRequests(w.decrypter)}, nil
+}
+
+func (w *launcher) targetServerOpts(capabilityID string) []target.ServerOpt {
+	var opts []target.ServerOpt
+	if w.decrypter != nil {
+		opts = append(opts, target.WithDecrypter(w.decrypter))
+	}
+
+	cfg := w.remoteTargetConfig(capabilityID)
+	if cfg == nil {
+		return opts
+	}
+	if cfg.RequireEncryptedRequests() {
+		opts = append(opts, target.WithRequireEncryptedRequests())
+	}
+	if owners := cfg.AllowedWorkflowOwners(); len(owners) > 0 {
+		opts = append(opts, target.WithAllowedWorkflowOwners(owners...))
+	}
+	if donIDs := cfg.AllowedCallerDONs(); len(donIDs) > 0 {
+		opts = append(opts, target.WithAllowedCallerDONs(donIDs...))
+	}
+	return opts
+}
+
 func signersFor(don registrysyncer.DON, state *registrysyncer.LocalRegistry) ([][]byte, error) {
 	s := [][]byte{}
 	for _, nodeID := range don.Members {
@@ -172,8 +172,10 @@ func TestLauncher_WiresUpExternalCapabilities(t *testing.T) {
 	launcher := NewLauncher(
 		lggr,
 		wrapper,
+		nil,
 		dispatcher,
 		registry,
+		nil,
 	)
 
 	dispatcher.On("SetReceiver", fullTriggerCapID, dID, mock.AnythingOfType("*remote.triggerPublisher")).Return(nil)
@@ -274,8 +276,10 @@ func TestSyncer_IgnoresCapabilitiesForPrivateDON(t *testing.T) {
 	launcher := NewLauncher(
 		lggr,
 		wrapper,
+		nil,
 		dispatcher,
 		registry,
+		nil,
 	)
 
 	// If the DON were public, this would fail with two errors:
@@ -431,8 +435,10 @@ func TestLauncher_WiresUpClientsForPublicWorkflowDON(t *testing.T) {
 	launcher := NewLauncher(
 		lggr,
 		wrapper,
+		nil,
 		dispatcher,
 		registry,
+		nil,
 	)
 
 	dispatcher.On("SetReceiver", fullTriggerCapID, capDonID, mock.AnythingOfType("*remote.triggerSubscriber")).Return(nil)
@@ -588,8 +594,10 @@ func TestLauncher_WiresUpClientsForPublicWorkflowDONButIgnoresPrivateCapabilitie
 	launcher := NewLauncher(
 		lggr,
 		wrapper,
+		nil,
 		dispatcher,
 		registry,
+		nil,
 	)
 
 	dispatcher.On("SetReceiver", fullTriggerCapID, triggerCapDonID, mock.AnythingOfType("*remote.triggerSubscriber")).Return(nil)
@@ -730,8 +738,10 @@ func TestLauncher_SucceedsEvenIfDispatcherAlreadyHasReceiver(t *testing.T) {
 	launcher := NewLauncher(
 		lggr,
 		wrapper,
+		nil,
 		dispatcher,
 		registry,
+		nil,
 	)
 
 	err = launcher.Launch(ctx, state)
@@ -193,6 +193,19 @@ func (r *Registry) Add(ctx context.Context, c capabilities.BaseCapability) error
 	return nil
 }
 
+// Remove removes the capability with the given ID from the registry.
+func (r *Registry) Remove(_ context.Context, id string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, ok := r.m[id]; !ok {
+		return fmt.Errorf("capability not found with id %s", id)
+	}
+	delete(r.m, id)
+	r.lggr.Infow("capability removed", "id", id)
+	return nil
+}
+
 // NewRegistry returns a new Registry.
 func NewRegistry(lggr logger.Logger) *Registry {
 	return &Registry{
@@ -59,6 +59,11 @@ func TestRegistry(t *testing.T) {
 	require.NoError(t, err)
 	assert.Len(t, cs, 1)
 	assert.Equal(t, c, cs[0])
+
+	require.NoError(t, r.Remove(ctx, id))
+	_, err = r.Get(ctx, id)
+	require.ErrorContains(t, err, "capability not found")
+	require.ErrorContains(t, r.Remove(ctx, id), "capability not found")
 }
 
 func TestRegistry_NoDuplicateIDs(t *testing.T) {
@@ -14,6 +14,7 @@ import (
 	"github.com/smartcontractkit/chainlink/v2/core/capabilities/remote/types"
 	"github.com/smartcontractkit/chainlink/v2/core/capabilities/validation"
 	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	p2ptypes "github.com/smartcontractkit/chainlink/v2/core/services/p2p/types"
 )
 
 // client is a shim for remote target capabilities.
@@ -31,6 +32,9 @@ type client struct {
 	dispatcher           types.Dispatcher
 	requestTimeout       time.Duration
 
+	// decrypter is set when requests are encrypted, to decrypt the responses
+	decrypter p2ptypes.Decrypter
+
 	messageIDToCallerRequest map[string]*request.ClientRequest
 	mutex                    sync.Mutex
 	stopCh                   services.StopChan
@@ -41,9 +45,20 @@ var _ commoncap.TargetCapability = &client{}
 var _ types.Receiver = &client{}
 var _ services.Service = &client{}
 
+// ClientOpt configures the node local options of a client, which are not part of the capability config.
+type ClientOpt func(*client)
+
+// WithEncryptedRequests encrypts the request payloads to the p2p keys of the capability DON nodes. The responses are
+// encrypted to the p2p key of the node and decrypted by decrypter.
+func WithEncryptedRequests(decrypter p2ptypes.Decrypter) ClientOpt {
+	return func(c *client) {
+		c.decrypter = decrypter
+	}
+}
+
 func NewClient(remoteCapabilityInfo commoncap.CapabilityInfo, localDonInfo commoncap.DON, dispatcher types.Dispatcher,
-	requestTimeout time.Duration, lggr logger.Logger) *client {
-	return &client{
+	requestTimeout time.Duration, lggr logger.Logger, opts ...ClientOpt) *client {
+	c := &client{
 		lggr:                     lggr.Named("TargetClient"),
 		remoteCapabilityInfo:     remoteCapabilityInfo,
 		localDONInfo:             localDonInfo,
@@ -52,6 +67,10 @@ func NewClient(remoteCapabilityInfo commoncap.CapabilityInfo, localDonInfo commo
 		messageIDToCallerRequest: make(map[string]*request.ClientRequest),
 		stopCh:                   make(services.StopChan),
 	}
+	for _, opt := range opts {
+		opt(c)
+	}
+	return c
 }
 
 func (c *client) Start(ctx context.Context) error {
@@ -149,7 +168,7 @@ func (c *client) executeRequest(ctx context.Context, capReq commoncap.Capability
 	}
 
 	req, err := request.NewClientRequest(ctx, c.lggr, capReq, messageID, c.remoteCapabilityInfo, c.localDONInfo, c.dispatcher,
-		c.requestTimeout)
+		c.requestTimeout, c.decrypter != nil)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create client request: %w", err)
 	}
@@ -176,6 +195,15 @@ func (c *client) Receive(ctx context.Context, msg *types.MessageBody) {
 		return
 	}
 
+	if remote.IsEncryptedPayload(msg.Payload) {
+		payload, err := remote.DecryptPayload(c.decrypter, msg.Payload)
+		if err != nil {
+			c.lggr.Errorw("failed to decrypt response", "messageID", messageID, "err", err)
+			return
+		}
+		msg.Payload = payload
+	}
+
 	if err := req.OnMessage(ctx, msg); err != nil {
 		c.lggr.Errorw("failed to add response to request", "messageID", messageID, "err", err)
 	}
@@ -24,6 +24,7 @@ import (
 const (
 	workflowID1          = "15c631d295ef5e32deb99a10ee6804bc4af13855687559d7ff6552ac6dbb2ce0"
 	workflowExecutionID1 = "95ef5e32deb99a10ee6804bc4af13855687559d7ff6552ac6dbb2ce0abbadeed"
+	workflowOwnerID      = "0x68902d681c28119f9b2531473a417088bf008e59"
 )
 
 func Test_Client_DonTopologies(t *testing.T) {
@@ -2,6 +2,7 @@ package target_test
 
 import (
 	"context"
+	"crypto/ed25519"
 	"crypto/rand"
 	"errors"
 	"sync"
@@ -13,6 +14,8 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
+	ragetypes "github.com/smartcontractkit/libocr/ragep2p/types"
+
 	commoncap "github.com/smartcontractkit/chainlink-common/pkg/capabilities"
 	"github.com/smartcontractkit/chainlink-common/pkg/services"
 	"github.com/smartcontractkit/chainlink-common/pkg/services/servicetest"
@@ -23,6 +26,7 @@ import (
 	"github.com/smartcontractkit/chainlink/v2/core/capabilities/transmission"
 	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
 	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	"github.com/smartcontractkit/chainlink/v2/core/services/p2p"
 	p2ptypes "github.com/smartcontractkit/chainlink/v2/core/services/p2p/types"
 )
 
@@ -165,16 +169,142 @@ func Test_RemoteTargetCapability_RandomCapabilityError(t *testing.T) {
 	testRemoteTarget(ctx, t, capability, 10, 9, 10*time.Millisecond, 10, 9, 10*time.Minute, transmissionSchedule, responseTest)
 }
 
+func Test_RemoteTargetCapability_EncryptedRequests(t *testing.T) {
+	ctx := testutils.Context(t)
+
+	responseTest := func(t *testing.T, response commoncap.CapabilityResponse, responseError error) {
+		require.NoError(t, responseError)
+		mp, err := response.Value.Unwrap()
+		require.NoError(t, err)
+		assert.Equal(t, "aValue1", mp.(map[string]any)["response"].(string))
+	}
+
+	transmissionSchedule, err := values.NewMap(map[string]any{
+		"schedule":   transmission.Schedule_AllAtOnce,
+		"deltaStage": "10ms",
+	})
+	require.NoError(t, err)
+
+	timeOut := 10 * time.Minute
+
+	capability := &TestCapability{}
+
+	opts := remoteTargetTestOpts{encryptRequests: true, requireEncryptedRequests: true}
+	testRemoteTargetWithOpts(ctx, t, opts, capability, 4, 3, timeOut, 4, 3, timeOut, transmissionSchedule, responseTest)
+	testRemoteTargetWithOpts(ctx, t, opts, capability, 10, 3, timeOut, 1, 0, timeOut, transmissionSchedule, responseTest)
+
+	// servers decrypt encrypted requests without requiring them
+	opts = remoteTargetTestOpts{encryptRequests: true}
+	testRemoteTargetWithOpts(ctx, t, opts, capability, 4, 3, timeOut, 4, 3, timeOut, transmissionSchedule, responseTest)
+}
+
+func Test_RemoteTargetCapability_PlaintextRequestsRejected(t *testing.T) {
+	ctx := testutils.Context(t)
+
+	responseTest := func(t *testing.T, response commoncap.CapabilityResponse, responseError error) {
+		require.Error(t, responseError)
+		assert.Equal(t, "capability requires encrypted requests", responseError.Error())
+	}
+
+	transmissionSchedule, err := values.NewMap(map[string]any{
+		"schedule":   transmission.Schedule_AllAtOnce,
+		"deltaStage": "10ms",
+	})
+	require.NoError(t, err)
+
+	opts := remoteTargetTestOpts{requireEncryptedRequests: true}
+	testRemoteTargetWithOpts(ctx, t, opts, &TestCapability{}, 4, 3, 10*time.Minute, 4, 3, 10*time.Minute, transmissionSchedule, responseTest)
+}
+
+func Test_RemoteTargetCapability_AllowedWorkflowOwners(t *testing.T) {
+	ctx := testutils.Context(t)
+
+	transmissionSchedule, err := values.NewMap(map[string]any{
+		"schedule":   transmission.Schedule_AllAtOnce,
+		"deltaStage": "10ms",
+	})
+	require.NoError(t, err)
+
+	timeOut := 10 * time.Minute
+
+	capability := &TestCapability{}
+
+	allowed := func(t *testing.T, response commoncap.CapabilityResponse, responseError error) {
+		require.NoError(t, responseError)
+		mp, err := response.Value.Unwrap()
+		require.NoError(t, err)
+		assert.Equal(t, "aValue1", mp.(map[string]any)["response"].(string))
+	}
+	// owners are compared without the 0x prefix and case insensitively
+	opts := remoteTargetTestOpts{allowedWorkflowOwners: []string{"0x68902D681C28119F9B2531473A417088BF008E59"}}
+	testRemoteTargetWithOpts(ctx, t, opts, capability, 4, 3, timeOut, 4, 3, timeOut, transmissionSchedule, allowed)
+
+	rejected := func(t *testing.T, response commoncap.CapabilityResponse, responseError error) {
+		require.Error(t, responseError)
+		assert.Equal(t, "workflow owner "+workflowOwnerID+" is not allowed to call capability cap_id@1.0.0", responseError.Error())
+	}
+	opts = remoteTargetTestOpts{allowedWorkflowOwners: []string{"0x0000000000000000000000000000000000000001"}}
+	testRemoteTargetWithOpts(ctx, t, opts, capability, 4, 3, timeOut, 4, 3, timeOut, transmissionSchedule, rejected)
+
+	// the owner of encrypted requests is checked after decryption
+	opts.encryptRequests = true
+	testRemoteTargetWithOpts(ctx, t, opts, capability, 4, 3, timeOut, 4, 3, timeOut, transmissionSchedule, rejected)
+}
+
+func Test_RemoteTargetCapability_AllowedCallerDONs(t *testing.T) {
+	ctx := testutils.Context(t)
+
+	transmissionSchedule, err := values.NewMap(map[string]any{
+		"schedule":   transmission.Schedule_AllAtOnce,
+		"deltaStage": "10ms",
+	})
+	require.NoError(t, err)
+
+	timeOut := 10 * time.Minute
+
+	capability := &TestCapability{}
+
+	allowed := func(t *testing.T, response commoncap.CapabilityResponse, responseError error) {
+		require.NoError(t, responseError)
+		mp, err := response.Value.Unwrap()
+		require.NoError(t, err)
+		assert.Equal(t, "aValue1", mp.(map[string]any)["response"].(string))
+	}
+	opts := remoteTargetTestOpts{allowedCallerDONs: []uint32{1, 3}}
+	testRemoteTargetWithOpts(ctx, t, opts, capability, 4, 3, timeOut, 4, 3, timeOut, transmissionSchedule, allowed)
+
+	rejected := func(t *testing.T, response commoncap.CapabilityResponse, responseError error) {
+		require.Error(t, responseError)
+		assert.Equal(t, "caller DON 1 is not allowed to call capability cap_id@1.0.0", responseError.Error())
+	}
+	opts = remoteTargetTestOpts{allowedCallerDONs: []uint32{3}}
+	testRemoteTargetWithOpts(ctx, t, opts, capability, 4, 3, timeOut, 4, 3, timeOut, transmissionSchedule, rejected)
+}
+
+// remoteTargetTestOpts are the node local options of the workflow and capability nodes.
+type remoteTargetTestOpts struct {
+	encryptRequests          bool
+	requireEncryptedRequests bool
+	allowedWorkflowOwners    []string
+	allowedCallerDONs        []uint32
+}
+
 func testRemoteTarget(ctx context.Context, t *testing.T, underlying commoncap.TargetCapability, numWorkflowPeers int, workflowDonF uint8, workflowNodeTimeout time.Duration,
+	numCapabilityPeers int, capabilityDonF uint8, capabilityNodeResponseTimeout time.Duration, transmissionSchedule *values.Map,
+	responseTest func(t *testing.T, response commoncap.CapabilityResponse, responseError error)) {
+	testRemoteTargetWithOpts(ctx, t, remoteTargetTestOpts{}, underlying, numWorkflowPeers, workflowDonF, workflowNodeTimeout,
+		numCapabilityPeers, capabilityDonF, capabilityNodeResponseTimeout, transmissionSchedule, responseTest)
+}
+
+func testRemoteTargetWithOpts(ctx context.Context, t *testing.T, opts remoteTargetTestOpts, underlying commoncap.TargetCapability, numWorkflowPeers int, workflowDonF uint8, workflowNodeTimeout time.Duration,
 	numCapabilityPeers int, capabilityDonF uint8, capabilityNodeResponseTimeout time.Duration, transmissionSchedule *values.Map,
 	responseTest func(t *testing.T, response commoncap.CapabilityResponse, responseError error)) {
 	lggr := logger.TestLogger(t)
 
 	capabilityPeers := make([]p2ptypes.PeerID, numCapabilityPeers)
+	capabilityDecrypters := make([]p2ptypes.Decrypter, numCapabilityPeers)
 	for i := 0; i < numCapabilityPeers; i++ {
-		capabilityPeerID := p2ptypes.PeerID{}
-		require.NoError(t, capabilityPeerID.UnmarshalText([]byte(NewPeerID())))
-		capabilityPeers[i] = capabilityPeerID
+		capabilityPeers[i], capabilityDecrypters[i] = newTestPeer(t)
 	}
 
 	capabilityPeerID := p2ptypes.PeerID{}
@@ -194,10 +324,9 @@ func testRemoteTarget(ctx context.Context, t *testing.T, underlying commoncap.Ta
 	}
 
 	workflowPeers := make([]p2ptypes.PeerID, numWorkflowPeers)
+	workflowDecrypters := make([]p2ptypes.Decrypter, numWorkflowPeers)
 	for i := 0; i < numWorkflowPeers; i++ {
-		workflowPeerID := p2ptypes.PeerID{}
-		require.NoError(t, workflowPeerID.UnmarshalText([]byte(NewPeerID())))
-		workflowPeers[i] = workflowPeerID
+		workflowPeers[i], workflowDecrypters[i] = newTestPeer(t)
 	}
 
 	workflowDonInfo := commoncap.DON{
@@ -216,8 +345,18 @@ func testRemoteTarget(ctx context.Context, t *testing.T, underlying commoncap.Ta
 	for i := 0; i < numCapabilityPeers; i++ {
 		capabilityPeer := capabilityPeers[i]
 		capabilityDispatcher := broker.NewDispatcherForNode(capabilityPeer)
+		serverOpts := []target.ServerOpt{target.WithDecrypter(capabilityDecrypters[i])}
+		if opts.requireEncryptedRequests {
+			serverOpts = append(serverOpts, target.WithRequireEncryptedRequests())
+		}
+		if len(opts.allowedWorkflowOwners) > 0 {
+			serverOpts = append(serverOpts, target.WithAllowedWorkflowOwners(opts.allowedWorkflowOwners...))
+		}
+		if len(opts.allowedCallerDONs) > 0 {
+			serverOpts = append(serverOpts, target.WithAllowedCallerDONs(opts.allowedCallerDONs...))
+		}
 		capabilityNode := target.NewServer(&commoncap.RemoteTargetConfig{RequestHashExcludedAttributes: []string{}}, capabilityPeer, underlying, capInfo, capDonInfo, workflowDONs, capabilityDispatcher,
-			capabilityNodeResponseTimeout, lggr)
+			capabilityNodeResponseTimeout, lggr, serverOpts...)
 		servicetest.Run(t, capabilityNode)
 		broker.RegisterReceiverNode(capabilityPeer, capabilityNode)
 		capabilityNodes[i] = capabilityNode
@@ -226,7 +365,11 @@ func testRemoteTarget(ctx context.Context, t *testing.T, underlying commoncap.Ta
 	workflowNodes := make([]commoncap.TargetCapability, numWorkflowPeers)
 	for i := 0; i < numWorkflowPeers; i++ {
 		workflowPeerDispatcher := broker.NewDispatcherForNode(workflowPeers[i])
-		workflowNode := target.NewClient(capInfo, workflowDonInfo, workflowPeerDispatcher, workflowNodeTimeout, lggr)
+		var clientOpts []target.ClientOpt
+		if opts.encryptRequests {
+			clientOpts = append(clientOpts, target.WithEncryptedRequests(workflowDecrypters[i]))
+		}
+		workflowNode := target.NewClient(capInfo, workflowDonInfo, workflowPeerDispatcher, workflowNodeTimeout, lggr, clientOpts...)
 		servicetest.Run(t, workflowNode)
 		broker.RegisterReceiverNode(workflowPeers[i], workflowNode)
 		workflowNodes[i] = workflowNode
@@ -253,6 +396,7 @@ func testRemoteTarget(ctx context.Context, t *testing.T, underlying commoncap.Ta
 					Metadata: commoncap.RequestMetadata{
 						WorkflowID:          workflowID1,
 						WorkflowExecutionID: workflowExecutionID1,
+						WorkflowOwner:       workflowOwnerID,
 					},
 					Config: transmissionSchedule,
 					Inputs: executeInputs,
@@ -421,6 +565,21 @@ func (t TestRandomErrorCapability) Execute(ctx context.Context, request commonca
 	return commoncap.CapabilityResponse{}, errors.New(uuid.New().String())
 }
 
+type testDecrypter ed25519.PrivateKey
+
+func (d testDecrypter) Decrypt(ciphertext []byte) ([]byte, error) {
+	return p2p.Decrypt(ed25519.PrivateKey(d), ciphertext)
+}
+
+// newTestPeer returns the peer ID of a new p2p key, which encrypted payloads can be encrypted to.
+func newTestPeer(t *testing.T) (p2ptypes.PeerID, p2ptypes.Decrypter) {
+	_, privKey, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+	peerID, err := ragetypes.PeerIDFromPrivateKey(privKey)
+	require.NoError(t, err)
+	return peerID, testDecrypter(privKey)
+}
+
 func NewP2PPeerID(t *testing.T) p2ptypes.PeerID {
 	id := p2ptypes.PeerID{}
 	require.NoError(t, id.UnmarshalText([]byte(NewPeerID())))
@@ -47,7 +47,7 @@ type ClientRequest struct {
 
 func NewClientRequest(ctx context.Context, lggr logger.Logger, req commoncap.CapabilityRequest, messageID string,
 	remoteCapabilityInfo commoncap.CapabilityInfo, localDonInfo capabilities.DON, dispatcher types.Dispatcher,
-	requestTimeout time.Duration) (*ClientRequest, error) {
+	requestTimeout time.Duration, encryptRequests bool) (*ClientRequest, error) {
 	remoteCapabilityDonInfo := remoteCapabilityInfo.DON
 	if remoteCapabilityDonInfo == nil {
 		return nil, errors.New("remote capability info missing DON")
@@ -66,6 +66,18 @@ func NewClientRequest(ctx context.Context, lggr logger.Logger, req commoncap.Cap
 
 	lggr.Debugw("sending request to peers", "execID", req.Metadata.WorkflowExecutionID, "schedule", peerIDToTransmissionDelay)
 
+	// Encrypted requests are encrypted to the key of each peer, so the payloads differ between peers
+	peerIDToPayload := make(map[p2ptypes.PeerID][]byte, len(peerIDToTransmissionDelay))
+	for peerID := range peerIDToTransmissionDelay {
+		peerIDToPayload[peerID] = rawRequest
+		if encryptRequests {
+			peerIDToPayload[peerID], err = remote.EncryptPayload(peerID, rawRequest)
+			if err != nil {
+				return nil, fmt.Errorf("failed to encrypt request for peer %s: %w", peerID, err)
+			}
+		}
+	}
+
 	responseReceived := make(map[p2ptypes.PeerID]bool)
 
 	ctxWithCancel, cancelFn := context.WithCancel(ctx)
@@ -80,7 +92,7 @@ func NewClientRequest(ctx context.Context, lggr logger.Logger, req commoncap.Cap
 				CapabilityDonId: remoteCapabilityDonInfo.ID,
 				CallerDonId:     localDonInfo.ID,
 				Method:          types.MethodExecute,
-				Payload:         rawRequest,
+				Payload:         peerIDToPayload[peerID],
 				MessageId:       []byte(messageID),
 			}
 
@@ -107,7 +107,7 @@ func Test_ClientRequest_MessageValidation(t *testing.T) {
 
 		dispatcher := &clientRequestTestDispatcher{msgs: make(chan *types.MessageBody, 100)}
 		request, err := request.NewClientRequest(ctx, lggr, capabilityRequest, messageID, capInfo,
-			workflowDonInfo, dispatcher, 10*time.Minute)
+			workflowDonInfo, dispatcher, 10*time.Minute, false)
 		defer request.Cancel(errors.New("test end"))
 
 		require.NoError(t, err)
@@ -150,7 +150,7 @@ func Test_ClientRequest_MessageValidation(t *testing.T) {
 
 		dispatcher := &clientRequestTestDispatcher{msgs: make(chan *types.MessageBody, 100)}
 		request, err := request.NewClientRequest(ctx, lggr, capabilityRequest, messageID, capInfo,
-			workflowDonInfo, dispatcher, 10*time.Minute)
+			workflowDonInfo, dispatcher, 10*time.Minute, false)
 		require.NoError(t, err)
 		defer request.Cancel(errors.New("test end"))
 
@@ -176,7 +176,7 @@ func Test_ClientRequest_MessageValidation(t *testing.T) {
 
 		dispatcher := &clientRequestTestDispatcher{msgs: make(chan *types.MessageBody, 100)}
 		request, err := request.NewClientRequest(ctx, lggr, capabilityRequest, messageID, capInfo,
-			workflowDonInfo, dispatcher, 10*time.Minute)
+			workflowDonInfo, dispatcher, 10*time.Minute, false)
 		require.NoError(t, err)
 		defer request.Cancel(errors.New("test end"))
 
@@ -199,7 +199,7 @@ func Test_ClientRequest_MessageValidation(t *testing.T) {
 
 		dispatcher := &clientRequestTestDispatcher{msgs: make(chan *types.MessageBody, 100)}
 		request, err := request.NewClientRequest(ctx, lggr, capabilityRequest, messageID, capInfo,
-			workflowDonInfo, dispatcher, 10*time.Minute)
+			workflowDonInfo, dispatcher, 10*time.Minute, false)
 		require.NoError(t, err)
 		defer request.Cancel(errors.New("test end"))
 
@@ -237,7 +237,7 @@ func Test_ClientRequest_MessageValidation(t *testing.T) {
 
 		dispatcher := &clientRequestTestDispatcher{msgs: make(chan *types.MessageBody, 100)}
 		request, err := request.NewClientRequest(ctx, lggr, capabilityRequest, messageID, capInfo,
-			workflowDonInfo, dispatcher, 10*time.Minute)
+			workflowDonInfo, dispatcher, 10*time.Minute, false)
 		require.NoError(t, err)
 		defer request.Cancel(errors.New("test end"))
 
@@ -287,7 +287,7 @@ func Test_ClientRequest_MessageValidation(t *testing.T) {
 
 		dispatcher := &clientRequestTestDispatcher{msgs: make(chan *types.MessageBody, 100)}
 		request, err := request.NewClientRequest(ctx, lggr, capabilityRequest, messageID, capInfo,
-			workflowDonInfo, dispatcher, 10*time.Minute)
+			workflowDonInfo, dispatcher, 10*time.Minute, false)
 		require.NoError(t, err)
 		defer request.Cancel(errors.New("test end"))
 
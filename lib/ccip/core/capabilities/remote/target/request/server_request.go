@@ -33,6 +33,7 @@ type ServerRequest struct {
 
 	requesters              map[p2ptypes.PeerID]bool
 	responseSentToRequester map[p2ptypes.PeerID]bool
+	encryptedRequesters     map[p2ptypes.PeerID]bool
 
 	createdTime time.Time
 
@@ -59,6 +60,7 @@ func NewServerRequest(capability capabilities.TargetCapability, capabilityID str
 		dispatcher:              dispatcher,
 		requesters:              map[p2ptypes.PeerID]bool{},
 		responseSentToRequester: map[p2ptypes.PeerID]bool{},
+		encryptedRequesters:     map[p2ptypes.PeerID]bool{},
 		callingDon:              callingDon,
 		requestMessageID:        requestMessageID,
 		requestTimeout:          requestTimeout,
@@ -67,6 +69,16 @@ func NewServerRequest(capability capabilities.TargetCapability, capabilityID str
 }
 
 func (e *ServerRequest) OnMessage(ctx context.Context, msg *types.MessageBody) error {
+	return e.onMessage(ctx, msg, false)
+}
+
+// OnEncryptedMessage handles a message whose encrypted payload has been decrypted by the server.
+// The response to its sender is encrypted to the sender.
+func (e *ServerRequest) OnEncryptedMessage(ctx context.Context, msg *types.MessageBody) error {
+	return e.onMessage(ctx, msg, true)
+}
+
+func (e *ServerRequest) onMessage(ctx context.Context, msg *types.MessageBody, encrypted bool) error {
 	e.mux.Lock()
 	defer e.mux.Unlock()
 
@@ -82,6 +94,7 @@ func (e *ServerRequest) OnMessage(ctx context.Context, msg *types.MessageBody) e
 	if err := e.addRequester(requester); err != nil {
 		return fmt.Errorf("failed to add requester to request: %w", err)
 	}
+	e.encryptedRequesters[requester] = encrypted
 
 	e.lggr.Debugw("OnMessage called for request", "msgId", msg.MessageId, "calls", len(e.requesters), "hasResponse", e.response != nil)
 	if e.minimumRequiredRequestsReceived() && !e.hasResponse() {
@@ -214,6 +227,12 @@ func (e *ServerRequest) sendResponse(requester p2ptypes.PeerID) error {
 	if e.response.error != types.Error_OK {
 		responseMsg.Error = e.response.error
 		responseMsg.ErrorMsg = e.response.errorMsg
+	} else if e.encryptedRequesters[requester] {
+		payload, err := remote.EncryptPayload(requester, e.response.response)
+		if err != nil {
+			return fmt.Errorf("failed to encrypt response: %w", err)
+		}
+		responseMsg.Payload = payload
 	} else {
 		responseMsg.Payload = e.response.response
 	}
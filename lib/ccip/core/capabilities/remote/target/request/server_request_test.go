@@ -1,7 +1,9 @@
 package request_test
 
 import (
+	"bytes"
 	"context"
+	"crypto/ed25519"
 	"crypto/rand"
 	"errors"
 	"testing"
@@ -11,12 +13,16 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
+	ragetypes "github.com/smartcontractkit/libocr/ragep2p/types"
+
 	commoncap "github.com/smartcontractkit/chainlink-common/pkg/capabilities"
 	"github.com/smartcontractkit/chainlink-common/pkg/capabilities/pb"
 	"github.com/smartcontractkit/chainlink-common/pkg/values"
+	"github.com/smartcontractkit/chainlink/v2/core/capabilities/remote"
 	"github.com/smartcontractkit/chainlink/v2/core/capabilities/remote/target/request"
 	"github.com/smartcontractkit/chainlink/v2/core/capabilities/remote/types"
 	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	"github.com/smartcontractkit/chainlink/v2/core/services/p2p"
 	p2ptypes "github.com/smartcontractkit/chainlink/v2/core/services/p2p/types"
 )
 
@@ -165,6 +171,55 @@ func Test_ServerRequest_MessageValidation(t *testing.T) {
 		assert.Equal(t, dispatcher.msgs[0].Error, types.Error_OK)
 		assert.Equal(t, dispatcher.msgs[1].Error, types.Error_OK)
 	})
+
+	t.Run("Send encrypted request", func(t *testing.T) {
+		_, encryptingPeerKey, err := ed25519.GenerateKey(rand.Reader)
+		require.NoError(t, err)
+		encryptingPeer, err := ragetypes.PeerIDFromPrivateKey(encryptingPeerKey)
+		require.NoError(t, err)
+		plaintextPeer := NewP2PPeerID(t)
+
+		dispatcher := &testDispatcher{}
+		req := request.NewServerRequest(capability, "capabilityID", 2, capabilityPeerID,
+			commoncap.DON{Members: []p2ptypes.PeerID{encryptingPeer, plaintextPeer}, ID: 1, F: 1},
+			"requestMessageID", dispatcher, 10*time.Minute, lggr)
+
+		err = req.OnEncryptedMessage(context.Background(), &types.MessageBody{
+			Sender:          encryptingPeer[:],
+			Receiver:        capabilityPeerID[:],
+			MessageId:       []byte("workflowID" + "workflowExecutionID"),
+			CapabilityId:    "capabilityID",
+			CapabilityDonId: 2,
+			CallerDonId:     1,
+			Method:          types.MethodExecute,
+			Payload:         rawRequest,
+		})
+		require.NoError(t, err)
+		err = sendValidRequest(req, []p2ptypes.PeerID{plaintextPeer}, capabilityPeerID, rawRequest)
+		require.NoError(t, err)
+
+		require.Len(t, dispatcher.msgs, 2)
+		var encryptedResponse, plaintextResponse []byte
+		for _, msg := range dispatcher.msgs {
+			require.Equal(t, types.Error_OK, msg.Error)
+			if bytes.Equal(msg.Receiver, encryptingPeer[:]) {
+				encryptedResponse = msg.Payload
+			} else {
+				plaintextResponse = msg.Payload
+			}
+		}
+		assert.False(t, remote.IsEncryptedPayload(plaintextResponse))
+		require.True(t, remote.IsEncryptedPayload(encryptedResponse))
+		decrypted, err := remote.DecryptPayload(testDecrypter(encryptingPeerKey), encryptedResponse)
+		require.NoError(t, err)
+		assert.Equal(t, plaintextResponse, decrypted)
+	})
+}
+
+type testDecrypter ed25519.PrivateKey
+
+func (d testDecrypter) Decrypt(ciphertext []byte) ([]byte, error) {
+	return p2p.Decrypt(ed25519.PrivateKey(d), ciphertext)
 }
 
 type serverRequest interface {
@@ -5,6 +5,8 @@ import (
 	"crypto/sha256"
 	"encoding/hex"
 	"fmt"
+	"slices"
+	"strings"
 	"sync"
 	"time"
 
@@ -38,6 +40,12 @@ type server struct {
 	workflowDONs map[uint32]commoncap.DON
 	dispatcher   types.Dispatcher
 
+	// node local options, see ServerOpt
+	decrypter                p2ptypes.Decrypter
+	requireEncryptedRequests bool
+	allowedWorkflowOwners    []string
+	allowedCallerDONs        []uint32
+
 	requestIDToRequest map[string]requestAndMsgID
 	requestTimeout     time.Duration
 
@@ -57,13 +65,47 @@ type requestAndMsgID struct {
 	messageID string
 }
 
+// ServerOpt configures the node local options of a server, which are not part of the capability config.
+type ServerOpt func(*server)
+
+// WithDecrypter decrypts encrypted requests with the p2p key of the node. Responses to encrypted requests are
+// encrypted to the requester. Encrypted requests are rejected without a decrypter.
+func WithDecrypter(decrypter p2ptypes.Decrypter) ServerOpt {
+	return func(s *server) {
+		s.decrypter = decrypter
+	}
+}
+
+// WithRequireEncryptedRequests rejects requests with a plaintext payload.
+func WithRequireEncryptedRequests() ServerOpt {
+	return func(s *server) {
+		s.requireEncryptedRequests = true
+	}
+}
+
+// WithAllowedWorkflowOwners rejects requests of workflows owned by other owners.
+func WithAllowedWorkflowOwners(owners ...string) ServerOpt {
+	return func(s *server) {
+		for _, owner := range owners {
+			s.allowedWorkflowOwners = append(s.allowedWorkflowOwners, normalizeWorkflowOwner(owner))
+		}
+	}
+}
+
+// WithAllowedCallerDONs rejects requests from other workflow DONs.
+func WithAllowedCallerDONs(donIDs ...uint32) ServerOpt {
+	return func(s *server) {
+		s.allowedCallerDONs = append(s.allowedCallerDONs, donIDs...)
+	}
+}
+
 func NewServer(config *commoncap.RemoteTargetConfig, peerID p2ptypes.PeerID, underlying commoncap.TargetCapability, capInfo commoncap.CapabilityInfo, localDonInfo commoncap.DON,
-	workflowDONs map[uint32]commoncap.DON, dispatcher types.Dispatcher, requestTimeout time.Duration, lggr logger.Logger) *server {
+	workflowDONs map[uint32]commoncap.DON, dispatcher types.Dispatcher, requestTimeout time.Duration, lggr logger.Logger, opts ...ServerOpt) *server {
 	if config == nil {
 		lggr.Info("no config provided, using default values")
 		config = &commoncap.RemoteTargetConfig{}
 	}
-	return &server{
+	s := &server{
 		config:       config,
 		underlying:   underlying,
 		peerID:       peerID,
@@ -79,6 +121,10 @@ func NewServer(config *commoncap.RemoteTargetConfig, peerID p2ptypes.PeerID, und
 		lggr:   lggr.Named("TargetServer"),
 		stopCh: make(services.StopChan),
 	}
+	for _, opt := range opts {
+		opt(s)
+	}
+	return s
 }
 
 func (r *server) Start(ctx context.Context) error {
@@ -142,6 +188,44 @@ func (r *server) Receive(ctx context.Context, msg *types.MessageBody) {
 		return
 	}
 
+	// Only members of a registered workflow DON may make the server do any work, including decrypting the request
+	// and sending error responses.
+	callingDon, ok := r.workflowDONs[msg.CallerDonId]
+	if !ok {
+		r.lggr.Errorw("received request from unregistered don", "donId", msg.CallerDonId)
+		return
+	}
+	sender, err := remote.ToPeerID(msg.Sender)
+	if err != nil {
+		r.lggr.Errorw("failed to convert message sender to PeerID", "messageID", messageId, "err", err)
+		return
+	}
+	if !slices.Contains(callingDon.Members, sender) {
+		r.lggr.Errorw("received request from peer outside of the calling don", "messageID", messageId, "donId", msg.CallerDonId, "sender", sender)
+		return
+	}
+
+	encrypted := remote.IsEncryptedPayload(msg.Payload)
+	if encrypted {
+		payload, err := remote.DecryptPayload(r.decrypter, msg.Payload)
+		if err != nil {
+			r.lggr.Errorw("failed to decrypt request", "messageID", messageId, "err", err)
+			r.sendErrorResponse(msg, messageId, types.Error_VALIDATION_FAILED, "failed to decrypt request")
+			return
+		}
+		msg.Payload = payload
+	} else if r.requireEncryptedRequests {
+		r.lggr.Warnw("received plaintext request for capability requiring encrypted requests", "messageID", messageId, "callerDonID", msg.CallerDonId)
+		r.sendErrorResponse(msg, messageId, types.Error_VALIDATION_FAILED, "capability requires encrypted requests")
+		return
+	}
+
+	if err = r.authorize(msg); err != nil {
+		r.lggr.Warnw("rejected unauthorized request", "messageID", messageId, "callerDonID", msg.CallerDonId, "err", err)
+		r.sendErrorResponse(msg, messageId, types.Error_VALIDATION_FAILED, err.Error())
+		return
+	}
+
 	msgHash, err := r.getMessageHash(msg)
 	if err != nil {
 		r.lggr.Errorw("failed to get message hash", "err", err)
@@ -168,12 +252,6 @@ func (r *server) Receive(ctx context.Context, msg *types.MessageBody) {
 	}
 
 	if _, ok := r.requestIDToRequest[requestID]; !ok {
-		callingDon, ok := r.workflowDONs[msg.CallerDonId]
-		if !ok {
-			r.lggr.Errorw("received request from unregistered don", "donId", msg.CallerDonId)
-			return
-		}
-
 		r.requestIDToRequest[requestID] = requestAndMsgID{
 			request: request.NewServerRequest(r.underlying, r.capInfo.ID, r.localDonInfo.ID, r.peerID,
 				callingDon, messageId, r.dispatcher, r.requestTimeout, r.lggr),
@@ -183,12 +261,66 @@ func (r *server) Receive(ctx context.Context, msg *types.MessageBody) {
 
 	reqAndMsgID := r.requestIDToRequest[requestID]
 
-	err = reqAndMsgID.request.OnMessage(ctx, msg)
+	if encrypted {
+		err = reqAndMsgID.request.OnEncryptedMessage(ctx, msg)
+	} else {
+		err = reqAndMsgID.request.OnMessage(ctx, msg)
+	}
 	if err != nil {
 		r.lggr.Errorw("request failed to OnMessage new message", "messageID", reqAndMsgID.messageID, "err", err)
 	}
 }
 
+// authorize checks the calling DON and the workflow owner of the request against the allowlists of the server.
+// The calling DON is authenticated by Receive, which only accepts requests from its members. The workflow owner is
+// taken from the request metadata as declared by the caller and is not verified: the owner allowlist only holds as
+// long as the members of the allowed calling DONs report the owners of their workflows honestly.
+func (r *server) authorize(msg *types.MessageBody) error {
+	if len(r.allowedCallerDONs) > 0 && !slices.Contains(r.allowedCallerDONs, msg.CallerDonId) {
+		return fmt.Errorf("caller DON %d is not allowed to call capability %s", msg.CallerDonId, r.capInfo.ID)
+	}
+
+	if len(r.allowedWorkflowOwners) > 0 {
+		req, err := pb.UnmarshalCapabilityRequest(msg.Payload)
+		if err != nil {
+			return fmt.Errorf("failed to unmarshal capability request: %w", err)
+		}
+		if !slices.Contains(r.allowedWorkflowOwners, normalizeWorkflowOwner(req.Metadata.WorkflowOwner)) {
+			return fmt.Errorf("workflow owner %s is not allowed to call capability %s", req.Metadata.WorkflowOwner, r.capInfo.ID)
+		}
+	}
+
+	return nil
+}
+
+func normalizeWorkflowOwner(owner string) string {
+	return strings.ToLower(strings.TrimPrefix(owner, "0x"))
+}
+
+// sendErrorResponse responds to a rejected request, without creating a server request for it.
+func (r *server) sendErrorResponse(msg *types.MessageBody, messageID string, errType types.Error, errMsg string) {
+	requester, err := remote.ToPeerID(msg.Sender)
+	if err != nil {
+		r.lggr.Errorw("failed to convert message sender to PeerID", "messageID", messageID, "err", err)
+		return
+	}
+
+	response := &types.MessageBody{
+		CapabilityId:    r.capInfo.ID,
+		CapabilityDonId: r.localDonInfo.ID,
+		CallerDonId:     msg.CallerDonId,
+		Method:          types.MethodExecute,
+		MessageId:       []byte(messageID),
+		Sender:          r.peerID[:],
+		Receiver:        requester[:],
+		Error:           errType,
+		ErrorMsg:        errMsg,
+	}
+	if err = r.dispatcher.Send(requester, response); err != nil {
+		r.lggr.Errorw("failed to send error response", "messageID", messageID, "err", err)
+	}
+}
+
 func (r *server) getMessageHash(msg *types.MessageBody) ([32]byte, error) {
 	req, err := pb.UnmarshalCapabilityRequest(msg.Payload)
 	if err != nil {
@@ -15,9 +15,11 @@ import (
 	"github.com/smartcontractkit/chainlink-common/pkg/values"
 	"github.com/smartcontractkit/chainlink/v2/core/capabilities/remote/target"
 	remotetypes "github.com/smartcontractkit/chainlink/v2/core/capabilities/remote/types"
+	remotetypesmocks "github.com/smartcontractkit/chainlink/v2/core/capabilities/remote/types/mocks"
 	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
 	"github.com/smartcontractkit/chainlink/v2/core/logger"
 	p2ptypes "github.com/smartcontractkit/chainlink/v2/core/services/p2p/types"
+	p2ptypesmocks "github.com/smartcontractkit/chainlink/v2/core/services/p2p/types/mocks"
 )
 
 func Test_Server_ExcludesNonDeterministicInputAttributes(t *testing.T) {
@@ -137,6 +139,54 @@ func Test_Server_CapabilityError(t *testing.T) {
 	closeServices(t, srvcs)
 }
 
+func Test_Server_IgnoresRequestsFromOutsideWorkflowDONs(t *testing.T) {
+	ctx := testutils.Context(t)
+
+	capabilityPeer := NewP2PPeerID(t)
+	capDonInfo := commoncap.DON{ID: 1, Members: []p2ptypes.PeerID{capabilityPeer}}
+	capInfo := commoncap.CapabilityInfo{
+		ID:             "cap_id@1.0.0",
+		CapabilityType: commoncap.CapabilityTypeTarget,
+		Description:    "Remote Target",
+		DON:            &capDonInfo,
+	}
+	workflowDonInfo := commoncap.DON{ID: 2, Members: []p2ptypes.PeerID{NewP2PPeerID(t)}}
+
+	// Neither mock has expectations, so decrypting or responding to any of the requests below fails the test.
+	dispatcher := remotetypesmocks.NewDispatcher(t)
+	decrypter := p2ptypesmocks.NewDecrypter(t)
+	server := target.NewServer(&commoncap.RemoteTargetConfig{}, capabilityPeer, &TestCapability{}, capInfo, capDonInfo,
+		map[uint32]commoncap.DON{workflowDonInfo.ID: workflowDonInfo}, dispatcher, time.Minute, logger.TestLogger(t),
+		target.WithDecrypter(decrypter), target.WithRequireEncryptedRequests())
+
+	outsider := NewP2PPeerID(t)
+	encryptedPayload := []byte{0x00, 0x01, 0xde, 0xad, 0xbe, 0xef}
+	for _, tc := range []struct {
+		name        string
+		sender      p2ptypes.PeerID
+		callerDonID uint32
+		payload     []byte
+	}{
+		{"unregistered DON, encrypted", outsider, 3, encryptedPayload},
+		{"unregistered DON, plaintext", outsider, 3, []byte("plaintext")},
+		{"non-member of registered DON, encrypted", outsider, workflowDonInfo.ID, encryptedPayload},
+		{"non-member of registered DON, plaintext", outsider, workflowDonInfo.ID, []byte("plaintext")},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			server.Receive(ctx, &remotetypes.MessageBody{
+				Sender:          tc.sender[:],
+				Receiver:        capabilityPeer[:],
+				CapabilityId:    capInfo.ID,
+				CapabilityDonId: capDonInfo.ID,
+				CallerDonId:     tc.callerDonID,
+				Method:          remotetypes.MethodExecute,
+				MessageId:       []byte("messageID"),
+				Payload:         tc.payload,
+			})
+		})
+	}
+}
+
 func testRemoteTargetServer(ctx context.Context, t *testing.T,
 	config *commoncap.RemoteTargetConfig,
 	underlying commoncap.TargetCapability,
@@ -14,11 +14,17 @@ import (
 	commoncap "github.com/smartcontractkit/chainlink-common/pkg/capabilities"
 	"github.com/smartcontractkit/chainlink-common/pkg/capabilities/pb"
 	remotetypes "github.com/smartcontractkit/chainlink/v2/core/capabilities/remote/types"
+	"github.com/smartcontractkit/chainlink/v2/core/services/p2p"
 	p2ptypes "github.com/smartcontractkit/chainlink/v2/core/services/p2p/types"
 )
 
 const (
 	maxLoggedStringLen = 256
+
+	// Encrypted payloads start with a zero byte, which never starts a marshalled capability request or response,
+	// followed by the version of the encryption scheme.
+	encryptedPayloadPrefix  = 0x00
+	encryptedPayloadVersion = 0x01
 )
 
 func ValidateMessage(msg p2ptypes.Message, expectedReceiver p2ptypes.PeerID) (*remotetypes.MessageBody, error) {
@@ -58,6 +64,37 @@ func ToPeerID(peerID []byte) (p2ptypes.PeerID, error) {
 	return id, nil
 }
 
+// EncryptPayload encrypts a message payload to the p2p key of the receiving peer.
+func EncryptPayload(receiver p2ptypes.PeerID, payload []byte) ([]byte, error) {
+	sealed, err := p2p.Encrypt(receiver, payload)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encrypt payload: %w", err)
+	}
+	return append([]byte{encryptedPayloadPrefix, encryptedPayloadVersion}, sealed...), nil
+}
+
+func IsEncryptedPayload(payload []byte) bool {
+	return len(payload) > 0 && payload[0] == encryptedPayloadPrefix
+}
+
+// DecryptPayload decrypts a payload encrypted by EncryptPayload to the p2p key of decrypter.
+func DecryptPayload(decrypter p2ptypes.Decrypter, payload []byte) ([]byte, error) {
+	if !IsEncryptedPayload(payload) {
+		return nil, errors.New("payload is not encrypted")
+	}
+	if len(payload) < 2 || payload[1] != encryptedPayloadVersion {
+		return nil, errors.New("unsupported payload encryption version")
+	}
+	if decrypter == nil {
+		return nil, errors.New("no decrypter configured")
+	}
+	decrypted, err := decrypter.Decrypt(payload[2:])
+	if err != nil {
+		return nil, fmt.Errorf("failed to decrypt payload: %w", err)
+	}
+	return decrypted, nil
+}
+
 // Default MODE Aggregator needs a configurable number of identical responses for aggregation to succeed
 type defaultModeAggregator struct {
 	minIdenticalResponses uint32
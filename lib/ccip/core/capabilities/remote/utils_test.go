@@ -16,6 +16,7 @@ import (
 
 	"github.com/smartcontractkit/chainlink/v2/core/capabilities/remote"
 	remotetypes "github.com/smartcontractkit/chainlink/v2/core/capabilities/remote/types"
+	"github.com/smartcontractkit/chainlink/v2/core/services/p2p"
 	p2ptypes "github.com/smartcontractkit/chainlink/v2/core/services/p2p/types"
 )
 
@@ -89,6 +90,44 @@ func TestToPeerID(t *testing.T) {
 	require.Equal(t, "12D3KooWD8QYTQVYjB6oog4Ej8PcPpqTrPRnxLQap8yY8KUQRVvq", id.String())
 }
 
+type decrypter ed25519.PrivateKey
+
+func (d decrypter) Decrypt(ciphertext []byte) ([]byte, error) {
+	return p2p.Decrypt(ed25519.PrivateKey(d), ciphertext)
+}
+
+func TestEncryptDecryptPayload(t *testing.T) {
+	privKey1, peerId1 := newKeyPair(t)
+	privKey2, _ := newKeyPair(t)
+
+	require.False(t, remote.IsEncryptedPayload([]byte(payload1)))
+
+	encrypted, err := remote.EncryptPayload(peerId1, []byte(payload1))
+	require.NoError(t, err)
+	require.True(t, remote.IsEncryptedPayload(encrypted))
+
+	decrypted, err := remote.DecryptPayload(decrypter(privKey1), encrypted)
+	require.NoError(t, err)
+	require.Equal(t, payload1, string(decrypted))
+
+	// wrong key
+	_, err = remote.DecryptPayload(decrypter(privKey2), encrypted)
+	require.Error(t, err)
+
+	// no decrypter
+	_, err = remote.DecryptPayload(nil, encrypted)
+	require.Error(t, err)
+
+	// plaintext
+	_, err = remote.DecryptPayload(decrypter(privKey1), []byte(payload1))
+	require.Error(t, err)
+
+	// unsupported version
+	encrypted[1] = 0xff
+	_, err = remote.DecryptPayload(decrypter(privKey1), encrypted)
+	require.Error(t, err)
+}
+
 func TestDefaultModeAggregator_Aggregate(t *testing.T) {
 	val, err := values.NewMap(triggerEvent1)
 	require.NoError(t, err)
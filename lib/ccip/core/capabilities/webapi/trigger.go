@@ -1,18 +1,451 @@
 package webapi
 
 import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"sync"
+
+	ethCommon "github.com/ethereum/go-ethereum/common"
+	"github.com/pelletier/go-toml"
+	"github.com/shopspring/decimal"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/capabilities"
+	"github.com/smartcontractkit/chainlink-common/pkg/services"
 	"github.com/smartcontractkit/chainlink-common/pkg/types/core"
+	"github.com/smartcontractkit/chainlink-common/pkg/values"
+
 	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	"github.com/smartcontractkit/chainlink/v2/core/services/gateway/api"
 	"github.com/smartcontractkit/chainlink/v2/core/services/gateway/connector"
+	hc "github.com/smartcontractkit/chainlink/v2/core/services/gateway/handlers/common"
 	"github.com/smartcontractkit/chainlink/v2/core/services/job"
 )
 
+const (
+	TriggerID = "web-api-trigger@1.0.0"
+	// MethodWebAPITrigger is the Gateway method of trigger event messages.
+	MethodWebAPITrigger = "web_api_trigger"
+
+	TriggerStatusAccepted = "ACCEPTED"
+	TriggerStatusError    = "ERROR"
+
+	defaultSendChannelBufferSize = 1000
+)
+
+var capInfo = capabilities.MustNewCapabilityInfo(
+	TriggerID,
+	capabilities.CapabilityTypeTrigger,
+	"Web API Trigger",
+)
+
+// Config is the node level configuration of the trigger, from the standard capabilities job spec.
+//
+//	allowedSenders = ["0x..."]
+//	allowedTopics = ["daily_rebalance"]
+//	[rateLimiter]
+//	globalRPS = 100.0
+//	globalBurst = 100
+//	perSenderRPS = 1.0
+//	perSenderBurst = 10
+type Config struct {
+	// AllowedSenders are the addresses allowed to send trigger events to this node.
+	AllowedSenders []string `toml:"allowedSenders"`
+	// AllowedTopics restricts the topics of trigger events, all topics are allowed if empty.
+	AllowedTopics []string          `toml:"allowedTopics"`
+	RateLimiter   RateLimiterConfig `toml:"rateLimiter"`
+}
+
+type RateLimiterConfig struct {
+	GlobalRPS      float64 `toml:"globalRPS"`
+	GlobalBurst    int     `toml:"globalBurst"`
+	PerSenderRPS   float64 `toml:"perSenderRPS"`
+	PerSenderBurst int     `toml:"perSenderBurst"`
+}
+
+// TriggerConfig is the workflow level configuration of the trigger. Empty lists allow all senders and topics
+// allowed by the node level config.
+type TriggerConfig struct {
+	AllowedSenders []string `mapstructure:"allowedSenders"`
+	AllowedTopics  []string `mapstructure:"allowedTopics"`
+	RequiredParams []string `mapstructure:"requiredParams"`
+}
+
+// TriggerRequestPayload is the payload of a trigger event message sent through the Gateway.
+type TriggerRequestPayload struct {
+	TriggerEventID string          `json:"trigger_event_id"`
+	Timestamp      int64           `json:"timestamp"`
+	Topics         []string        `json:"topics"`
+	Params         json.RawMessage `json:"params"`
+}
+
+// TriggerResponsePayload is sent back through the Gateway for each trigger event message.
+type TriggerResponsePayload struct {
+	Status       string `json:"status"`
+	ErrorMessage string `json:"error_message,omitempty"`
+	// Workflows is the number of workflows the event was delivered to.
+	Workflows int `json:"workflows"`
+}
+
+type subscriber struct {
+	ch         chan capabilities.TriggerResponse
+	workflowID string
+	config     TriggerConfig
+}
+
+type trigger struct {
+	services.StateMachine
+	capabilities.CapabilityInfo
+
+	registry       core.CapabilitiesRegistry
+	connector      connector.GatewayConnector
+	allowedSenders map[string]struct{}
+	allowedTopics  map[string]struct{}
+	rateLimiter    *hc.RateLimiter
+	subscribers    map[string]*subscriber
+	mu             sync.Mutex
+	lggr           logger.Logger
+}
+
+// capabilityRemover is implemented by registries that can drop a capability again, e.g. *capabilities.Registry.
+type capabilityRemover interface {
+	Remove(ctx context.Context, id string) error
+}
+
+var _ capabilities.TriggerCapability = (*trigger)(nil)
+var _ connector.GatewayConnectorHandler = (*trigger)(nil)
+
+// NewTrigger returns a service which adds the Web API Trigger capability to the registry, and fans out the
+// trigger events it receives from the Gateway to the workflows subscribed to it.
 func NewTrigger(config string, registry core.CapabilitiesRegistry, connector connector.GatewayConnector, lggr logger.Logger) (job.ServiceCtx, error) {
-	// TODO (CAPPL-22, CAPPL-24):
-	//   - decode config
-	//   - create an implementation of the capability API and add it to the Registry
-	//   - create a handler and register it with Gateway Connector
-	//   - manage trigger subscriptions
-	//   - process incoming trigger events and related metadata
-	return nil, nil
+	if connector == nil {
+		return nil, errors.New("missing connector")
+	}
+	cfg, err := parseConfig(config)
+	if err != nil {
+		return nil, err
+	}
+	rateLimiter, err := hc.NewRateLimiter(hc.RateLimiterConfig{
+		GlobalRPS:      cfg.RateLimiter.GlobalRPS,
+		GlobalBurst:    cfg.RateLimiter.GlobalBurst,
+		PerSenderRPS:   cfg.RateLimiter.PerSenderRPS,
+		PerSenderBurst: cfg.RateLimiter.PerSenderBurst,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("invalid rate limiter config: %w", err)
+	}
+
+	allowedSenders := make(map[string]struct{}, len(cfg.AllowedSenders))
+	for _, s := range cfg.AllowedSenders {
+		allowedSenders[normalizeAddress(s)] = struct{}{}
+	}
+	allowedTopics := make(map[string]struct{}, len(cfg.AllowedTopics))
+	for _, t := range cfg.AllowedTopics {
+		allowedTopics[t] = struct{}{}
+	}
+
+	return &trigger{
+		CapabilityInfo: capInfo,
+		registry:       registry,
+		connector:      connector,
+		allowedSenders: allowedSenders,
+		allowedTopics:  allowedTopics,
+		rateLimiter:    rateLimiter,
+		subscribers:    make(map[string]*subscriber),
+		lggr:           lggr.Named("WebAPITrigger"),
+	}, nil
+}
+
+func parseConfig(config string) (Config, error) {
+	var cfg Config
+	if err := toml.Unmarshal([]byte(config), &cfg); err != nil {
+		return cfg, fmt.Errorf("failed to parse Web API Trigger config: %w", err)
+	}
+	if len(cfg.AllowedSenders) == 0 {
+		return cfg, errors.New("at least one allowed sender is required")
+	}
+	for _, s := range cfg.AllowedSenders {
+		if !ethCommon.IsHexAddress(s) {
+			return cfg, fmt.Errorf("invalid allowed sender address %q", s)
+		}
+	}
+	return cfg, nil
+}
+
+func normalizeAddress(address string) string {
+	return strings.ToLower(ethCommon.HexToAddress(address).Hex())
+}
+
+func (t *trigger) Start(ctx context.Context) error {
+	return t.StartOnce("WebAPITrigger", func() error {
+		if err := t.registry.Add(ctx, t); err != nil {
+			return fmt.Errorf("failed to add the Web API Trigger to the registry: %w", err)
+		}
+		return t.connector.AddHandler([]string{MethodWebAPITrigger}, t)
+	})
+}
+
+func (t *trigger) Close() error {
+	return t.StopOnce("WebAPITrigger", func() error {
+		var err error
+		if r, ok := t.registry.(capabilityRemover); ok {
+			if rerr := r.Remove(context.Background(), t.ID); rerr != nil {
+				err = fmt.Errorf("failed to remove the Web API Trigger from the registry: %w", rerr)
+			}
+		} else {
+			t.lggr.Warnw("capabilities registry does not support removal, Web API Trigger stays registered", "id", t.ID)
+		}
+
+		t.mu.Lock()
+		defer t.mu.Unlock()
+		for id, sub := range t.subscribers {
+			close(sub.ch)
+			delete(t.subscribers, id)
+		}
+		return err
+	})
+}
+
+func (t *trigger) Name() string { return t.lggr.Name() }
+
+func (t *trigger) HealthReport() map[string]error {
+	return map[string]error{t.Name(): t.Healthy()}
+}
+
+func (t *trigger) RegisterTrigger(ctx context.Context, req capabilities.TriggerRegistrationRequest) (<-chan capabilities.TriggerResponse, error) {
+	config, err := t.validateConfig(req.Config)
+	if err != nil {
+		return nil, err
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if _, ok := t.subscribers[req.TriggerID]; ok {
+		return nil, fmt.Errorf("triggerId %s already registered", req.TriggerID)
+	}
+	ch := make(chan capabilities.TriggerResponse, defaultSendChannelBufferSize)
+	t.subscribers[req.TriggerID] = &subscriber{
+		ch:         ch,
+		workflowID: req.Metadata.WorkflowID,
+		config:     config,
+	}
+	return ch, nil
+}
+
+func (t *trigger) UnregisterTrigger(ctx context.Context, req capabilities.TriggerRegistrationRequest) error {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	sub, ok := t.subscribers[req.TriggerID]
+	if !ok {
+		return fmt.Errorf("triggerId %s not registered", req.TriggerID)
+	}
+	close(sub.ch)
+	delete(t.subscribers, req.TriggerID)
+	return nil
+}
+
+// validateConfig checks that the workflow doesn't allow senders or topics which aren't allowed by the node.
+func (t *trigger) validateConfig(config *values.Map) (TriggerConfig, error) {
+	var cfg TriggerConfig
+	if config != nil {
+		if err := config.UnwrapTo(&cfg); err != nil {
+			return cfg, fmt.Errorf("invalid Web API Trigger config: %w", err)
+		}
+	}
+	for i, s := range cfg.AllowedSenders {
+		if !ethCommon.IsHexAddress(s) {
+			return cfg, fmt.Errorf("invalid allowed sender address %q", s)
+		}
+		cfg.AllowedSenders[i] = normalizeAddress(s)
+		if _, ok := t.allowedSenders[cfg.AllowedSenders[i]]; !ok {
+			return cfg, fmt.Errorf("sender %s is not allowed by the node", s)
+		}
+	}
+	if len(t.allowedTopics) > 0 {
+		for _, topic := range cfg.AllowedTopics {
+			if _, ok := t.allowedTopics[topic]; !ok {
+				return cfg, fmt.Errorf("topic %s is not allowed by the node", topic)
+			}
+		}
+	}
+	return cfg, nil
+}
+
+func (t *trigger) HandleGatewayMessage(ctx context.Context, gatewayID string, msg *api.Message) {
+	body := &msg.Body
+	sender := strings.ToLower(body.Sender)
+	if _, ok := t.allowedSenders[sender]; !ok {
+		t.lggr.Errorw("allowlist prevented the request from this address", "id", gatewayID, "address", sender)
+		t.sendResponse(ctx, gatewayID, body, TriggerResponsePayload{Status: TriggerStatusError, ErrorMessage: "sender not allowed"})
+		return
+	}
+	if !t.rateLimiter.Allow(sender) {
+		t.lggr.Errorw("request rate-limited", "id", gatewayID, "address", sender)
+		t.sendResponse(ctx, gatewayID, body, TriggerResponsePayload{Status: TriggerStatusError, ErrorMessage: "request rate-limited"})
+		return
+	}
+
+	switch body.Method {
+	case MethodWebAPITrigger:
+		n, err := t.processTrigger(sender, body.Payload)
+		if err != nil {
+			t.lggr.Errorw("failed to process trigger event", "id", gatewayID, "messageId", body.MessageId, "err", err)
+			t.sendResponse(ctx, gatewayID, body, TriggerResponsePayload{Status: TriggerStatusError, ErrorMessage: err.Error()})
+			return
+		}
+		t.sendResponse(ctx, gatewayID, body, TriggerResponsePayload{Status: TriggerStatusAccepted, Workflows: n})
+	default:
+		t.lggr.Errorw("unsupported method", "id", gatewayID, "method", body.Method)
+	}
+}
+
+// processTrigger sends the trigger event to all matching subscribers, and returns their number.
+func (t *trigger) processTrigger(sender string, payload json.RawMessage) (int, error) {
+	var req TriggerRequestPayload
+	if err := json.Unmarshal(payload, &req); err != nil {
+		return 0, fmt.Errorf("invalid payload: %w", err)
+	}
+	if req.TriggerEventID == "" {
+		return 0, errors.New("missing trigger_event_id")
+	}
+	if len(t.allowedTopics) > 0 {
+		for _, topic := range req.Topics {
+			if _, ok := t.allowedTopics[topic]; !ok {
+				return 0, fmt.Errorf("topic %s is not allowed", topic)
+			}
+		}
+	}
+	params, err := decodeParams(req.Params)
+	if err != nil {
+		return 0, err
+	}
+
+	topics := make([]any, len(req.Topics))
+	for i, topic := range req.Topics {
+		topics[i] = topic
+	}
+	outputs, err := values.NewMap(map[string]any{
+		"trigger_event_id": req.TriggerEventID,
+		"timestamp":        req.Timestamp,
+		"sender":           sender,
+		"topics":           topics,
+		"params":           params,
+	})
+	if err != nil {
+		return 0, fmt.Errorf("failed to wrap trigger event: %w", err)
+	}
+	event := capabilities.TriggerResponse{
+		Event: capabilities.TriggerEvent{
+			TriggerType: TriggerID,
+			ID:          req.TriggerEventID,
+			Outputs:     outputs,
+		},
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	sent := 0
+	for triggerID, sub := range t.subscribers {
+		if !sub.matches(sender, req.Topics, params) {
+			continue
+		}
+		select {
+		case sub.ch <- event:
+			sent++
+		default:
+			t.lggr.Errorw("subscriber channel full, dropping event", "eventID", req.TriggerEventID, "workflowID", sub.workflowID, "triggerID", triggerID)
+		}
+	}
+	return sent, nil
+}
+
+func (s *subscriber) matches(sender string, topics []string, params map[string]any) bool {
+	if len(s.config.AllowedSenders) > 0 && !slices.Contains(s.config.AllowedSenders, sender) {
+		return false
+	}
+	if len(s.config.AllowedTopics) > 0 && !slices.ContainsFunc(topics, func(topic string) bool {
+		return slices.Contains(s.config.AllowedTopics, topic)
+	}) {
+		return false
+	}
+	for _, p := range s.config.RequiredParams {
+		if _, ok := params[p]; !ok {
+			return false
+		}
+	}
+	return true
+}
+
+// decodeParams decodes the JSON event params into types supported by values.Wrap, integers as int64
+// and other numbers as decimals.
+func decodeParams(raw json.RawMessage) (map[string]any, error) {
+	params := map[string]any{}
+	if len(raw) == 0 {
+		return params, nil
+	}
+	d := json.NewDecoder(bytes.NewReader(raw))
+	d.UseNumber()
+	if err := d.Decode(&params); err != nil {
+		return nil, fmt.Errorf("invalid params: %w", err)
+	}
+	v, err := convertNumbers(params)
+	if err != nil {
+		return nil, err
+	}
+	return v.(map[string]any), nil
+}
+
+func convertNumbers(v any) (any, error) {
+	switch tv := v.(type) {
+	case json.Number:
+		if i, err := tv.Int64(); err == nil {
+			return i, nil
+		}
+		d, err := decimal.NewFromString(tv.String())
+		if err != nil {
+			return nil, fmt.Errorf("invalid number %s: %w", tv, err)
+		}
+		return d, nil
+	case map[string]any:
+		for k, e := range tv {
+			c, err := convertNumbers(e)
+			if err != nil {
+				return nil, err
+			}
+			tv[k] = c
+		}
+		return tv, nil
+	case []any:
+		for i, e := range tv {
+			c, err := convertNumbers(e)
+			if err != nil {
+				return nil, err
+			}
+			tv[i] = c
+		}
+		return tv, nil
+	}
+	return v, nil
+}
+
+func (t *trigger) sendResponse(ctx context.Context, gatewayID string, requestBody *api.MessageBody, payload TriggerResponsePayload) {
+	payloadJSON, err := json.Marshal(payload)
+	if err != nil {
+		t.lggr.Errorw("failed to marshal response", "id", gatewayID, "err", err)
+		return
+	}
+	body := &api.MessageBody{
+		MessageId: requestBody.MessageId,
+		DonId:     requestBody.DonId,
+		Method:    requestBody.Method,
+		Receiver:  requestBody.Sender,
+		Payload:   payloadJSON,
+	}
+	if err = t.connector.SignAndSendToGateway(ctx, gatewayID, body); err != nil {
+		t.lggr.Errorw("failed to send response to gateway", "id", gatewayID, "err", err)
+	}
 }
@@ -0,0 +1,239 @@
+package webapi
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/capabilities"
+	"github.com/smartcontractkit/chainlink-common/pkg/values"
+
+	corecapabilities "github.com/smartcontractkit/chainlink/v2/core/capabilities"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	"github.com/smartcontractkit/chainlink/v2/core/services/gateway/api"
+	gcmocks "github.com/smartcontractkit/chainlink/v2/core/services/gateway/connector/mocks"
+)
+
+const (
+	sender1 = "0x853d51D5d9935964267a5050aC53aa63ECA39bc5"
+	sender2 = "0x4bE0C9F8C1Dd5C6C4D3E0e8E1E6F3e2a1E7C2a3B"
+)
+
+func testConfig(rps float64) string {
+	return fmt.Sprintf(`
+allowedSenders = ["%s", "%s"]
+allowedTopics = ["rebalance", "pause"]
+
+[rateLimiter]
+globalRPS = %f
+globalBurst = 10
+perSenderRPS = %f
+perSenderBurst = 10
+`, sender1, sender2, rps, rps)
+}
+
+func newTestTrigger(t *testing.T, config string) (*trigger, *gcmocks.GatewayConnector) {
+	connector := gcmocks.NewGatewayConnector(t)
+	lggr := logger.TestLogger(t)
+	srv, err := NewTrigger(config, corecapabilities.NewRegistry(lggr), connector, lggr)
+	require.NoError(t, err)
+	return srv.(*trigger), connector
+}
+
+func triggerMessage(t *testing.T, sender string, payload TriggerRequestPayload) *api.Message {
+	b, err := json.Marshal(payload)
+	require.NoError(t, err)
+	return &api.Message{
+		Body: api.MessageBody{
+			MessageId: "1",
+			Method:    MethodWebAPITrigger,
+			DonId:     "workflow_don",
+			Payload:   b,
+			Sender:    strings.ToLower(sender),
+		},
+	}
+}
+
+func registerWorkflow(t *testing.T, trig *trigger, triggerID string, config map[string]any) <-chan capabilities.TriggerResponse {
+	cfg, err := values.NewMap(config)
+	require.NoError(t, err)
+	ch, err := trig.RegisterTrigger(testutils.Context(t), capabilities.TriggerRegistrationRequest{
+		TriggerID: triggerID,
+		Metadata:  capabilities.RequestMetadata{WorkflowID: triggerID},
+		Config:    cfg,
+	})
+	require.NoError(t, err)
+	return ch
+}
+
+func expectResponse(t *testing.T, connector *gcmocks.GatewayConnector, expected TriggerResponsePayload) {
+	connector.EXPECT().SignAndSendToGateway(mock.Anything, "gateway1", mock.Anything).Run(func(_ context.Context, _ string, body *api.MessageBody) {
+		var resp TriggerResponsePayload
+		require.NoError(t, json.Unmarshal(body.Payload, &resp))
+		assert.Equal(t, expected, resp)
+	}).Return(nil).Once()
+}
+
+func TestNewTrigger_InvalidConfig(t *testing.T) {
+	t.Parallel()
+
+	lggr := logger.TestLogger(t)
+	connector := gcmocks.NewGatewayConnector(t)
+	registry := corecapabilities.NewRegistry(lggr)
+
+	_, err := NewTrigger(`allowedTopics = ["a"]`, registry, connector, lggr)
+	require.ErrorContains(t, err, "at least one allowed sender is required")
+
+	_, err = NewTrigger(`allowedSenders = ["not an address"]`, registry, connector, lggr)
+	require.ErrorContains(t, err, "invalid allowed sender address")
+
+	_, err = NewTrigger(fmt.Sprintf(`allowedSenders = ["%s"]`, sender1), registry, connector, lggr)
+	require.ErrorContains(t, err, "invalid rate limiter config")
+}
+
+func TestTrigger_StartAndCloseUpdateRegistry(t *testing.T) {
+	t.Parallel()
+
+	lggr := logger.TestLogger(t)
+	connector := gcmocks.NewGatewayConnector(t)
+	registry := corecapabilities.NewRegistry(lggr)
+	srv, err := NewTrigger(testConfig(100), registry, connector, lggr)
+	require.NoError(t, err)
+
+	connector.EXPECT().AddHandler([]string{MethodWebAPITrigger}, srv).Return(nil).Once()
+	require.NoError(t, srv.Start(testutils.Context(t)))
+
+	c, err := registry.GetTrigger(testutils.Context(t), TriggerID)
+	require.NoError(t, err)
+	assert.Equal(t, srv, c)
+
+	require.NoError(t, srv.Close())
+	_, err = registry.GetTrigger(testutils.Context(t), TriggerID)
+	require.ErrorContains(t, err, "capability not found")
+}
+
+func TestTrigger_RegisterTrigger(t *testing.T) {
+	t.Parallel()
+
+	trig, _ := newTestTrigger(t, testConfig(100))
+	ctx := testutils.Context(t)
+
+	cfg, err := values.NewMap(map[string]any{"allowedSenders": []any{"0x0000000000000000000000000000000000000001"}})
+	require.NoError(t, err)
+	_, err = trig.RegisterTrigger(ctx, capabilities.TriggerRegistrationRequest{TriggerID: "wf1", Config: cfg})
+	require.ErrorContains(t, err, "is not allowed by the node")
+
+	cfg, err = values.NewMap(map[string]any{"allowedTopics": []any{"unknown"}})
+	require.NoError(t, err)
+	_, err = trig.RegisterTrigger(ctx, capabilities.TriggerRegistrationRequest{TriggerID: "wf1", Config: cfg})
+	require.ErrorContains(t, err, "is not allowed by the node")
+
+	registerWorkflow(t, trig, "wf1", map[string]any{})
+	_, err = trig.RegisterTrigger(ctx, capabilities.TriggerRegistrationRequest{TriggerID: "wf1"})
+	require.ErrorContains(t, err, "already registered")
+
+	require.NoError(t, trig.UnregisterTrigger(ctx, capabilities.TriggerRegistrationRequest{TriggerID: "wf1"}))
+	require.ErrorContains(t, trig.UnregisterTrigger(ctx, capabilities.TriggerRegistrationRequest{TriggerID: "wf1"}), "not registered")
+}
+
+func TestTrigger_HandleGatewayMessage(t *testing.T) {
+	t.Parallel()
+
+	trig, connector := newTestTrigger(t, testConfig(100))
+	ctx := testutils.Context(t)
+
+	all := registerWorkflow(t, trig, "all", map[string]any{})
+	fromSender2 := registerWorkflow(t, trig, "fromSender2", map[string]any{"allowedSenders": []any{sender2}})
+	pause := registerWorkflow(t, trig, "pause", map[string]any{"allowedTopics": []any{"pause"}})
+	withAmount := registerWorkflow(t, trig, "withAmount", map[string]any{"requiredParams": []any{"amount"}})
+
+	t.Run("fans out to matching workflows", func(t *testing.T) {
+		expectResponse(t, connector, TriggerResponsePayload{Status: TriggerStatusAccepted, Workflows: 2})
+		trig.HandleGatewayMessage(ctx, "gateway1", triggerMessage(t, sender1, TriggerRequestPayload{
+			TriggerEventID: "event1",
+			Timestamp:      1700000000,
+			Topics:         []string{"rebalance"},
+			Params:         json.RawMessage(`{"amount": 100, "ratio": 0.5, "token": "LINK"}`),
+		}))
+
+		resp := <-all
+		require.NoError(t, resp.Err)
+		assert.Equal(t, "event1", resp.Event.ID)
+		assert.Equal(t, TriggerID, resp.Event.TriggerType)
+		var outputs struct {
+			TriggerEventID string         `mapstructure:"trigger_event_id"`
+			Sender         string         `mapstructure:"sender"`
+			Topics         []string       `mapstructure:"topics"`
+			Params         map[string]any `mapstructure:"params"`
+		}
+		require.NoError(t, resp.Event.Outputs.UnwrapTo(&outputs))
+		assert.Equal(t, "event1", outputs.TriggerEventID)
+		assert.Equal(t, strings.ToLower(sender1), outputs.Sender)
+		assert.Equal(t, []string{"rebalance"}, outputs.Topics)
+		assert.Equal(t, int64(100), outputs.Params["amount"])
+		assert.Equal(t, "LINK", outputs.Params["token"])
+
+		resp = <-withAmount
+		assert.Equal(t, "event1", resp.Event.ID)
+		assert.Empty(t, fromSender2)
+		assert.Empty(t, pause)
+	})
+
+	t.Run("filters by topic and sender", func(t *testing.T) {
+		expectResponse(t, connector, TriggerResponsePayload{Status: TriggerStatusAccepted, Workflows: 3})
+		trig.HandleGatewayMessage(ctx, "gateway1", triggerMessage(t, sender2, TriggerRequestPayload{
+			TriggerEventID: "event2",
+			Topics:         []string{"pause"},
+		}))
+
+		for _, ch := range []<-chan capabilities.TriggerResponse{all, fromSender2, pause} {
+			resp := <-ch
+			assert.Equal(t, "event2", resp.Event.ID)
+		}
+		assert.Empty(t, withAmount)
+	})
+
+	t.Run("rejects topics not allowed by the node", func(t *testing.T) {
+		expectResponse(t, connector, TriggerResponsePayload{Status: TriggerStatusError, ErrorMessage: "topic unknown is not allowed"})
+		trig.HandleGatewayMessage(ctx, "gateway1", triggerMessage(t, sender1, TriggerRequestPayload{
+			TriggerEventID: "event3",
+			Topics:         []string{"unknown"},
+		}))
+		assert.Empty(t, all)
+	})
+
+	t.Run("rejects senders not allowed by the node", func(t *testing.T) {
+		expectResponse(t, connector, TriggerResponsePayload{Status: TriggerStatusError, ErrorMessage: "sender not allowed"})
+		trig.HandleGatewayMessage(ctx, "gateway1", triggerMessage(t, "0x0000000000000000000000000000000000000001", TriggerRequestPayload{
+			TriggerEventID: "event4",
+		}))
+		assert.Empty(t, all)
+	})
+
+	t.Run("requires an event ID", func(t *testing.T) {
+		expectResponse(t, connector, TriggerResponsePayload{Status: TriggerStatusError, ErrorMessage: "missing trigger_event_id"})
+		trig.HandleGatewayMessage(ctx, "gateway1", triggerMessage(t, sender1, TriggerRequestPayload{}))
+	})
+}
+
+func TestTrigger_HandleGatewayMessage_RateLimited(t *testing.T) {
+	t.Parallel()
+
+	trig, connector := newTestTrigger(t, testConfig(0.001))
+	ctx := testutils.Context(t)
+	ch := registerWorkflow(t, trig, "wf1", map[string]any{})
+
+	for i := 0; i < 10; i++ {
+		trig.rateLimiter.Allow(strings.ToLower(sender1))
+	}
+	expectResponse(t, connector, TriggerResponsePayload{Status: TriggerStatusError, ErrorMessage: "request rate-limited"})
+	trig.HandleGatewayMessage(ctx, "gateway1", triggerMessage(t, sender1, TriggerRequestPayload{TriggerEventID: "event1"}))
+	assert.Empty(t, ch)
+}
@@ -134,7 +134,16 @@ func NewChainClient(
 	chainType chaintype.ChainType,
 	clientErrors evmconfig.ClientErrors,
 	deathDeclarationDelay time.Duration,
+	quorumReads commonclient.QuorumReadConfig,
 ) Client {
+	quorumReads.EventsToBlock = func(query any) *big.Int {
+		q, ok := query.(ethereum.FilterQuery)
+		// block tags such as finalized resolve to a different block on each node
+		if !ok || q.BlockHash != nil || q.ToBlock == nil || q.ToBlock.Sign() < 0 {
+			return nil
+		}
+		return q.ToBlock
+	}
 	multiNode := commonclient.NewMultiNode(
 		lggr,
 		selectionMode,
@@ -149,6 +158,7 @@ func NewChainClient(
 		},
 		0, // use the default value provided by the implementation
 		deathDeclarationDelay,
+		quorumReads,
 	)
 	return &chainClient{
 		multiNode:    multiNode,
@@ -39,8 +39,14 @@ func NewEvmClient(cfg evmconfig.NodePool, chainCfg commonclient.ChainConfig, cli
 		}
 	}
 
+	quorumReads := commonclient.QuorumReadConfig{
+		Methods:   cfg.QuorumReads().Methods(),
+		Nodes:     int(cfg.QuorumReads().Nodes()),
+		Threshold: int(cfg.QuorumReads().Threshold()),
+	}
+
 	return NewChainClient(lggr, cfg.SelectionMode(), cfg.LeaseDuration(), chainCfg.NodeNoNewHeadsThreshold(),
-		primaries, sendonlys, chainID, chainType, clientErrors, cfg.DeathDeclarationDelay())
+		primaries, sendonlys, chainID, chainType, clientErrors, cfg.DeathDeclarationDelay(), quorumReads)
 }
 
 func getRPCTimeouts(chainType chaintype.ChainType) (largePayload, defaultTimeout time.Duration) {
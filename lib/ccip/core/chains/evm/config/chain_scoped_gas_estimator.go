@@ -110,6 +110,18 @@ func (g *gasEstimatorConfig) Mode() string {
 	return *g.c.Mode
 }
 
+func (g *gasEstimatorConfig) FeePolicies() []FeePolicy {
+	policies := make([]FeePolicy, len(g.c.FeePolicies))
+	for i := range g.c.FeePolicies {
+		policies[i] = &feePolicyConfig{c: g.c.FeePolicies[i]}
+	}
+	return policies
+}
+
+func (g *gasEstimatorConfig) BlobFees() BlobFees {
+	return &blobFeesConfig{c: g.c.BlobFees}
+}
+
 func (g *gasEstimatorConfig) LimitJobType() LimitJobType {
 	return &limitJobTypeConfig{c: g.c.LimitJobType}
 }
@@ -190,3 +202,62 @@ type feeHistoryConfig struct {
 func (u *feeHistoryConfig) CacheTimeout() time.Duration {
 	return u.c.CacheTimeout.Duration()
 }
+
+type feePolicyConfig struct {
+	c toml.FeePolicy
+}
+
+func (p *feePolicyConfig) Name() string {
+	return *p.c.Name
+}
+
+func (p *feePolicyConfig) MaxSpendPerHour() *assets.Wei {
+	return p.c.MaxSpendPerHour
+}
+
+func (p *feePolicyConfig) MaxFeeValuePercent() uint16 {
+	if p.c.MaxFeeValuePercent == nil {
+		return 0
+	}
+	return *p.c.MaxFeeValuePercent
+}
+
+func (p *feePolicyConfig) Urgency() []FeePolicyUrgency {
+	urgency := make([]FeePolicyUrgency, len(p.c.Urgency))
+	for i, u := range p.c.Urgency {
+		urgency[i] = FeePolicyUrgency{Bumps: *u.Bumps}
+		if u.After != nil {
+			urgency[i].After = u.After.Duration()
+		}
+		if u.BeforeDeadline != nil {
+			urgency[i].BeforeDeadline = u.BeforeDeadline.Duration()
+		}
+	}
+	return urgency
+}
+
+type blobFeesConfig struct {
+	c toml.BlobFees
+}
+
+func (b *blobFeesConfig) Enabled() bool {
+	return b.c.Enabled != nil && *b.c.Enabled
+}
+
+func (b *blobFeesConfig) PriceMax() *assets.Wei {
+	return b.c.PriceMax
+}
+
+func (b *blobFeesConfig) HistoryDepth() uint16 {
+	if b.c.HistoryDepth == nil {
+		return 0
+	}
+	return *b.c.HistoryDepth
+}
+
+func (b *blobFeesConfig) BufferPercent() uint16 {
+	if b.c.BufferPercent == nil {
+		return 0
+	}
+	return *b.c.BufferPercent
+}
@@ -44,6 +44,8 @@ func (n *NodePoolConfig) NewHeadsPollInterval() time.Duration {
 
 func (n *NodePoolConfig) Errors() ClientErrors { return &clientErrorsConfig{c: n.C.Errors} }
 
+func (n *NodePoolConfig) QuorumReads() QuorumReads { return &quorumReadsConfig{c: n.C.QuorumReads} }
+
 func (n *NodePoolConfig) EnforceRepeatableRead() bool {
 	return *n.C.EnforceRepeatableRead
 }
@@ -51,3 +53,23 @@ func (n *NodePoolConfig) EnforceRepeatableRead() bool {
 func (n *NodePoolConfig) DeathDeclarationDelay() time.Duration {
 	return n.C.DeathDeclarationDelay.Duration()
 }
+
+type quorumReadsConfig struct {
+	c toml.QuorumReads
+}
+
+func (q *quorumReadsConfig) Methods() []string { return q.c.Methods }
+
+func (q *quorumReadsConfig) Nodes() uint32 {
+	if q.c.Nodes == nil {
+		return 0
+	}
+	return *q.c.Nodes
+}
+
+func (q *quorumReadsConfig) Threshold() uint32 {
+	if q.c.Threshold == nil {
+		return 0
+	}
+	return *q.c.Threshold
+}
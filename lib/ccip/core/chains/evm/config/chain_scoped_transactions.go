@@ -4,6 +4,8 @@ import (
 	"net/url"
 	"time"
 
+	"github.com/ethereum/go-ethereum/common"
+
 	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/config/toml"
 )
 
@@ -39,6 +41,18 @@ func (t *transactionsConfig) AutoPurge() AutoPurgeConfig {
 	return &autoPurgeConfig{c: t.c.AutoPurge}
 }
 
+func (t *transactionsConfig) StuckTxRecovery() StuckTxRecoveryConfig {
+	return &stuckTxRecoveryConfig{c: t.c.StuckTxRecovery}
+}
+
+func (t *transactionsConfig) SmartAccounts() []SmartAccount {
+	accounts := make([]SmartAccount, len(t.c.SmartAccounts))
+	for i := range t.c.SmartAccounts {
+		accounts[i] = &smartAccountConfig{c: t.c.SmartAccounts[i]}
+	}
+	return accounts
+}
+
 type autoPurgeConfig struct {
 	c toml.AutoPurgeConfig
 }
@@ -58,3 +72,49 @@ func (a *autoPurgeConfig) MinAttempts() *uint32 {
 func (a *autoPurgeConfig) DetectionApiUrl() *url.URL {
 	return a.c.DetectionApiUrl.URL()
 }
+
+type stuckTxRecoveryConfig struct {
+	c toml.StuckTxRecoveryConfig
+}
+
+func (r *stuckTxRecoveryConfig) Enabled() bool {
+	return r.c.Enabled != nil && *r.c.Enabled
+}
+
+func (r *stuckTxRecoveryConfig) Threshold() uint32 {
+	if r.c.Threshold == nil {
+		return 0
+	}
+	return *r.c.Threshold
+}
+
+type smartAccountConfig struct {
+	c toml.SmartAccount
+}
+
+func (a *smartAccountConfig) Key() common.Address {
+	return a.c.Key.Address()
+}
+
+func (a *smartAccountConfig) Account() common.Address {
+	return a.c.Account.Address()
+}
+
+func (a *smartAccountConfig) Mode() string {
+	return *a.c.Mode
+}
+
+func (a *smartAccountConfig) EntryPoint() common.Address {
+	if a.c.EntryPoint == nil {
+		return common.Address{}
+	}
+	return a.c.EntryPoint.Address()
+}
+
+func (a *smartAccountConfig) BundlerURL() *url.URL {
+	return a.c.BundlerURL.URL()
+}
+
+func (a *smartAccountConfig) PaymasterURL() *url.URL {
+	return a.c.PaymasterURL.URL()
+}
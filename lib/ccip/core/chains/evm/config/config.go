@@ -108,6 +108,8 @@ type Transactions interface {
 	MaxInFlight() uint32
 	MaxQueued() uint64
 	AutoPurge() AutoPurgeConfig
+	StuckTxRecovery() StuckTxRecoveryConfig
+	SmartAccounts() []SmartAccount
 }
 
 type AutoPurgeConfig interface {
@@ -117,10 +119,31 @@ type AutoPurgeConfig interface {
 	DetectionApiUrl() *url.URL
 }
 
+type StuckTxRecoveryConfig interface {
+	Enabled() bool
+	// Threshold is the number of blocks an unconfirmed transaction may wait before it is considered stuck
+	Threshold() uint32
+}
+
+type SmartAccount interface {
+	// Key is the sending key which signs for the account
+	Key() gethcommon.Address
+	Account() gethcommon.Address
+	// Mode is one of toml.SmartAccountModeERC4337 or toml.SmartAccountModeEIP7702
+	Mode() string
+	// EntryPoint, BundlerURL and PaymasterURL are only set for ERC4337 accounts. PaymasterURL is nil if the account pays
+	// for its own user operations.
+	EntryPoint() gethcommon.Address
+	BundlerURL() *url.URL
+	PaymasterURL() *url.URL
+}
+
 type GasEstimator interface {
 	BlockHistory() BlockHistory
 	FeeHistory() FeeHistory
 	LimitJobType() LimitJobType
+	FeePolicies() []FeePolicy
+	BlobFees() BlobFees
 
 	EIP1559DynamicFees() bool
 	BumpPercent() uint16
@@ -142,6 +165,14 @@ type GasEstimator interface {
 	EstimateLimit() bool
 }
 
+// BlobFees configures the fees of blob (EIP-4844) transactions
+type BlobFees interface {
+	Enabled() bool
+	PriceMax() *assets.Wei
+	HistoryDepth() uint16
+	BufferPercent() uint16
+}
+
 type LimitJobType interface {
 	OCR() *uint32
 	OCR2() *uint32
@@ -165,6 +196,23 @@ type FeeHistory interface {
 	CacheTimeout() time.Duration
 }
 
+type FeePolicy interface {
+	Name() string
+	// MaxSpendPerHour is nil if the spend is unlimited
+	MaxSpendPerHour() *assets.Wei
+	// MaxFeeValuePercent is 0 if the fee is not limited by the value of the tx
+	MaxFeeValuePercent() uint16
+	Urgency() []FeePolicyUrgency
+}
+
+// FeePolicyUrgency applies to txes older than After, or whose deadline is less than BeforeDeadline away.
+// Only one of After and BeforeDeadline is set, the other is 0.
+type FeePolicyUrgency struct {
+	After          time.Duration
+	BeforeDeadline time.Duration
+	Bumps          uint16
+}
+
 type Workflow interface {
 	FromAddress() *types.EIP55Address
 	ForwarderAddress() *types.EIP55Address
@@ -183,6 +231,13 @@ type NodePool interface {
 	EnforceRepeatableRead() bool
 	DeathDeclarationDelay() time.Duration
 	NewHeadsPollInterval() time.Duration
+	QuorumReads() QuorumReads
+}
+
+type QuorumReads interface {
+	Methods() []string
+	Nodes() uint32
+	Threshold() uint32
 }
 
 // TODO BCF-2509 does the chainscopedconfig really need the entire app config?
@@ -24,6 +24,53 @@ func (_m *GasEstimator) EXPECT() *GasEstimator_Expecter {
 	return &GasEstimator_Expecter{mock: &_m.Mock}
 }
 
+// BlobFees provides a mock function with given fields:
+func (_m *GasEstimator) BlobFees() config.BlobFees {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for BlobFees")
+	}
+
+	var r0 config.BlobFees
+	if rf, ok := ret.Get(0).(func() config.BlobFees); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(config.BlobFees)
+		}
+	}
+
+	return r0
+}
+
+// GasEstimator_BlobFees_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'BlobFees'
+type GasEstimator_BlobFees_Call struct {
+	*mock.Call
+}
+
+// BlobFees is a helper method to define mock.On call
+func (_e *GasEstimator_Expecter) BlobFees() *GasEstimator_BlobFees_Call {
+	return &GasEstimator_BlobFees_Call{Call: _e.mock.On("BlobFees")}
+}
+
+func (_c *GasEstimator_BlobFees_Call) Run(run func()) *GasEstimator_BlobFees_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *GasEstimator_BlobFees_Call) Return(_a0 config.BlobFees) *GasEstimator_BlobFees_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *GasEstimator_BlobFees_Call) RunAndReturn(run func() config.BlobFees) *GasEstimator_BlobFees_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // BlockHistory provides a mock function with given fields:
 func (_m *GasEstimator) BlockHistory() config.BlockHistory {
 	ret := _m.Called()
@@ -437,6 +484,53 @@ func (_c *GasEstimator_FeeHistory_Call) RunAndReturn(run func() config.FeeHistor
 	return _c
 }
 
+// FeePolicies provides a mock function with given fields:
+func (_m *GasEstimator) FeePolicies() []config.FeePolicy {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for FeePolicies")
+	}
+
+	var r0 []config.FeePolicy
+	if rf, ok := ret.Get(0).(func() []config.FeePolicy); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]config.FeePolicy)
+		}
+	}
+
+	return r0
+}
+
+// GasEstimator_FeePolicies_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FeePolicies'
+type GasEstimator_FeePolicies_Call struct {
+	*mock.Call
+}
+
+// FeePolicies is a helper method to define mock.On call
+func (_e *GasEstimator_Expecter) FeePolicies() *GasEstimator_FeePolicies_Call {
+	return &GasEstimator_FeePolicies_Call{Call: _e.mock.On("FeePolicies")}
+}
+
+func (_c *GasEstimator_FeePolicies_Call) Run(run func()) *GasEstimator_FeePolicies_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *GasEstimator_FeePolicies_Call) Return(_a0 []config.FeePolicy) *GasEstimator_FeePolicies_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *GasEstimator_FeePolicies_Call) RunAndReturn(run func() []config.FeePolicy) *GasEstimator_FeePolicies_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // LimitDefault provides a mock function with given fields:
 func (_m *GasEstimator) LimitDefault() uint64 {
 	ret := _m.Called()
@@ -17,6 +17,8 @@ import (
 	commonconfig "github.com/smartcontractkit/chainlink-common/pkg/config"
 	commontypes "github.com/smartcontractkit/chainlink-common/pkg/types"
 
+	commonclient "github.com/smartcontractkit/chainlink/v2/common/client"
+
 	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/assets"
 	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/config/chaintype"
 	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/types"
@@ -313,6 +315,7 @@ func (c *EVMConfig) ValidateConfig() (err error) {
 		err = multierr.Append(err, commonconfig.ErrMissing{Name: "Nodes", Msg: "must have at least one node"})
 	} else {
 		var hasPrimary bool
+		var primaries uint32
 		var logBroadcasterEnabled bool
 		var newHeadsPollingInterval commonconfig.Duration
 		if c.LogBroadcasterEnabled != nil {
@@ -329,6 +332,7 @@ func (c *EVMConfig) ValidateConfig() (err error) {
 			}
 
 			hasPrimary = true
+			primaries++
 
 			// if the node is a primary node, then the WS URL is required when
 			//	1. LogBroadcaster is enabled
@@ -346,6 +350,11 @@ func (c *EVMConfig) ValidateConfig() (err error) {
 			err = multierr.Append(err, commonconfig.ErrMissing{Name: "Nodes",
 				Msg: "must have at least one primary node"})
 		}
+
+		if q := c.NodePool.QuorumReads; len(q.Methods) > 0 && q.Nodes != nil && *q.Nodes > primaries {
+			err = multierr.Append(err, commonconfig.ErrInvalid{Name: "NodePool.QuorumReads.Nodes", Value: *q.Nodes,
+				Msg: fmt.Sprintf("must be less than or equal to the number of primary nodes (%d)", primaries)})
+		}
 	}
 
 	err = multierr.Append(err, c.Chain.ValidateConfig())
@@ -478,7 +487,9 @@ type Transactions struct {
 	ReaperThreshold      *commonconfig.Duration
 	ResendAfterThreshold *commonconfig.Duration
 
-	AutoPurge AutoPurgeConfig `toml:",omitempty"`
+	AutoPurge       AutoPurgeConfig       `toml:",omitempty"`
+	StuckTxRecovery StuckTxRecoveryConfig `toml:",omitempty"`
+	SmartAccounts   SmartAccounts         `toml:",omitempty"`
 }
 
 func (t *Transactions) setFrom(f *Transactions) {
@@ -501,6 +512,8 @@ func (t *Transactions) setFrom(f *Transactions) {
 		t.ResendAfterThreshold = v
 	}
 	t.AutoPurge.setFrom(&f.AutoPurge)
+	t.StuckTxRecovery.setFrom(&f.StuckTxRecovery)
+	t.SmartAccounts.setFrom(f.SmartAccounts)
 }
 
 type AutoPurgeConfig struct {
@@ -525,6 +538,133 @@ func (a *AutoPurgeConfig) setFrom(f *AutoPurgeConfig) {
 	}
 }
 
+// StuckTxRecoveryConfig enables the recovery of unconfirmed transactions that have not been mined for Threshold blocks
+type StuckTxRecoveryConfig struct {
+	Enabled   *bool
+	Threshold *uint32
+}
+
+func (r *StuckTxRecoveryConfig) ValidateConfig() (err error) {
+	if r.Enabled == nil || !*r.Enabled {
+		return
+	}
+	if r.Threshold == nil {
+		err = multierr.Append(err, commonconfig.ErrMissing{Name: "Threshold", Msg: "required when stuck transaction recovery is enabled"})
+	} else if *r.Threshold == 0 {
+		err = multierr.Append(err, commonconfig.ErrInvalid{Name: "Threshold", Value: *r.Threshold, Msg: "must be greater than 0"})
+	}
+	return
+}
+
+func (r *StuckTxRecoveryConfig) setFrom(f *StuckTxRecoveryConfig) {
+	if v := f.Enabled; v != nil {
+		r.Enabled = v
+	}
+	if v := f.Threshold; v != nil {
+		r.Threshold = v
+	}
+}
+
+const (
+	// SmartAccountModeERC4337 submits the transactions of a key as user operations of an ERC-4337 account, signed by the key
+	SmartAccountModeERC4337 = "ERC4337"
+	// SmartAccountModeEIP7702 submits the transactions of a key as calls of an EIP-7702 delegated EOA, the key being a
+	// session key of the account
+	SmartAccountModeEIP7702 = "EIP7702"
+)
+
+type SmartAccounts []SmartAccount
+
+func (as SmartAccounts) ValidateConfig() (err error) {
+	keys := map[string]struct{}{}
+	for i, a := range as {
+		if a.Key == nil {
+			continue
+		}
+		key := a.Key.String()
+		if _, ok := keys[key]; ok {
+			err = multierr.Append(err, commonconfig.NewErrDuplicate(fmt.Sprintf("%d.Key", i), key))
+		} else {
+			keys[key] = struct{}{}
+		}
+	}
+	return
+}
+
+func (as *SmartAccounts) setFrom(f SmartAccounts) {
+	for _, v := range f {
+		if i := slices.IndexFunc(*as, func(a SmartAccount) bool { return a.Key != nil && v.Key != nil && *a.Key == *v.Key }); i == -1 {
+			*as = append(*as, v)
+		} else {
+			(*as)[i].setFrom(&v)
+		}
+	}
+}
+
+// SmartAccount makes the transactions of the sending key Key submitted through the smart account Account
+type SmartAccount struct {
+	Key          *types.EIP55Address
+	Account      *types.EIP55Address
+	Mode         *string
+	EntryPoint   *types.EIP55Address
+	BundlerURL   *commonconfig.URL
+	PaymasterURL *commonconfig.URL
+}
+
+func (a *SmartAccount) ValidateConfig() (err error) {
+	if a.Key == nil {
+		err = multierr.Append(err, commonconfig.ErrMissing{Name: "Key", Msg: "required for all smart accounts"})
+	}
+	if a.Account == nil {
+		err = multierr.Append(err, commonconfig.ErrMissing{Name: "Account", Msg: "required for all smart accounts"})
+	}
+	if a.Mode == nil {
+		err = multierr.Append(err, commonconfig.ErrMissing{Name: "Mode", Msg: "required for all smart accounts"})
+		return
+	}
+	switch *a.Mode {
+	case SmartAccountModeERC4337:
+		if a.EntryPoint == nil {
+			err = multierr.Append(err, commonconfig.ErrMissing{Name: "EntryPoint", Msg: "required for ERC4337 smart accounts"})
+		}
+		if a.BundlerURL == nil {
+			err = multierr.Append(err, commonconfig.ErrMissing{Name: "BundlerURL", Msg: "required for ERC4337 smart accounts"})
+		}
+	case SmartAccountModeEIP7702:
+		if a.EntryPoint != nil {
+			err = multierr.Append(err, commonconfig.ErrInvalid{Name: "EntryPoint", Value: a.EntryPoint, Msg: "only used by ERC4337 smart accounts"})
+		}
+		if a.BundlerURL != nil {
+			err = multierr.Append(err, commonconfig.ErrInvalid{Name: "BundlerURL", Value: a.BundlerURL, Msg: "only used by ERC4337 smart accounts"})
+		}
+		if a.PaymasterURL != nil {
+			err = multierr.Append(err, commonconfig.ErrInvalid{Name: "PaymasterURL", Value: a.PaymasterURL, Msg: "only used by ERC4337 smart accounts"})
+		}
+	default:
+		err = multierr.Append(err, commonconfig.ErrInvalid{Name: "Mode", Value: *a.Mode,
+			Msg: fmt.Sprintf("must be one of %s or %s", SmartAccountModeERC4337, SmartAccountModeEIP7702)})
+	}
+	return
+}
+
+func (a *SmartAccount) setFrom(f *SmartAccount) {
+	if v := f.Account; v != nil {
+		a.Account = v
+	}
+	if v := f.Mode; v != nil {
+		a.Mode = v
+	}
+	if v := f.EntryPoint; v != nil {
+		a.EntryPoint = v
+	}
+	if v := f.BundlerURL; v != nil {
+		a.BundlerURL = v
+	}
+	if v := f.PaymasterURL; v != nil {
+		a.PaymasterURL = v
+	}
+}
+
 type OCR2 struct {
 	Automation Automation `toml:",omitempty"`
 }
@@ -599,6 +739,8 @@ type GasEstimator struct {
 
 	BlockHistory BlockHistoryEstimator `toml:",omitempty"`
 	FeeHistory   FeeHistoryEstimator   `toml:",omitempty"`
+	FeePolicies  FeePolicies           `toml:",omitempty"`
+	BlobFees     BlobFees              `toml:",omitempty"`
 }
 
 func (e *GasEstimator) ValidateConfig() (err error) {
@@ -635,6 +777,10 @@ func (e *GasEstimator) ValidateConfig() (err error) {
 		err = multierr.Append(err, commonconfig.ErrInvalid{Name: "BlockHistory.BlockHistorySize", Value: *e.BlockHistory.BlockHistorySize,
 			Msg: "must be greater than or equal to 1 with BlockHistory Mode"})
 	}
+	if e.BlobFees.Enabled != nil && *e.BlobFees.Enabled && !*e.EIP1559DynamicFees {
+		err = multierr.Append(err, commonconfig.ErrInvalid{Name: "BlobFees.Enabled", Value: *e.BlobFees.Enabled,
+			Msg: "blob transactions require EIP1559DynamicFees to be enabled"})
+	}
 
 	return
 }
@@ -694,6 +840,8 @@ func (e *GasEstimator) setFrom(f *GasEstimator) {
 	e.LimitJobType.setFrom(&f.LimitJobType)
 	e.BlockHistory.setFrom(&f.BlockHistory)
 	e.FeeHistory.setFrom(&f.FeeHistory)
+	e.FeePolicies.setFrom(f.FeePolicies)
+	e.BlobFees.setFrom(&f.BlobFees)
 }
 
 type GasLimitJobType struct {
@@ -766,6 +914,138 @@ func (u *FeeHistoryEstimator) setFrom(f *FeeHistoryEstimator) {
 	}
 }
 
+// BlobFees configures the fees of blob (EIP-4844) transactions, which are estimated from the recent history of eth_blobBaseFee.
+type BlobFees struct {
+	Enabled       *bool
+	PriceMax      *assets.Wei
+	HistoryDepth  *uint16
+	BufferPercent *uint16
+}
+
+func (b *BlobFees) ValidateConfig() (err error) {
+	if b.Enabled == nil || !*b.Enabled {
+		return
+	}
+	if b.PriceMax == nil {
+		err = multierr.Append(err, commonconfig.ErrMissing{Name: "PriceMax", Msg: "required when blob fees are enabled"})
+	}
+	if b.HistoryDepth == nil {
+		err = multierr.Append(err, commonconfig.ErrMissing{Name: "HistoryDepth", Msg: "required when blob fees are enabled"})
+	} else if *b.HistoryDepth == 0 {
+		err = multierr.Append(err, commonconfig.ErrInvalid{Name: "HistoryDepth", Value: *b.HistoryDepth, Msg: "must be greater than 0"})
+	}
+	return
+}
+
+func (b *BlobFees) setFrom(f *BlobFees) {
+	if v := f.Enabled; v != nil {
+		b.Enabled = v
+	}
+	if v := f.PriceMax; v != nil {
+		b.PriceMax = v
+	}
+	if v := f.HistoryDepth; v != nil {
+		b.HistoryDepth = v
+	}
+	if v := f.BufferPercent; v != nil {
+		b.BufferPercent = v
+	}
+}
+
+type FeePolicies []FeePolicy
+
+func (ps FeePolicies) ValidateConfig() (err error) {
+	names := map[string]struct{}{}
+	for i, p := range ps {
+		if p.Name == nil || *p.Name == "" {
+			err = multierr.Append(err, commonconfig.ErrMissing{Name: fmt.Sprintf("%d.Name", i), Msg: "required for all fee policies"})
+			continue
+		}
+		if _, ok := names[*p.Name]; ok {
+			err = multierr.Append(err, commonconfig.NewErrDuplicate(fmt.Sprintf("%d.Name", i), *p.Name))
+		} else {
+			names[*p.Name] = struct{}{}
+		}
+	}
+	return
+}
+
+func (ps *FeePolicies) setFrom(f FeePolicies) {
+	for _, v := range f {
+		if i := slices.IndexFunc(*ps, func(p FeePolicy) bool { return p.Name != nil && v.Name != nil && *p.Name == *v.Name }); i == -1 {
+			*ps = append(*ps, v)
+		} else {
+			(*ps)[i].setFrom(&v)
+		}
+	}
+}
+
+// FeePolicy limits the fee bumps of the transactions whose TxMeta.FeePolicy is Name
+type FeePolicy struct {
+	Name               *string
+	MaxSpendPerHour    *assets.Wei
+	MaxFeeValuePercent *uint16
+	Urgency            []FeePolicyUrgency `toml:",omitempty"`
+}
+
+func (p *FeePolicy) ValidateConfig() (err error) {
+	var prevAfter, prevBeforeDeadline *commonconfig.Duration
+	for i, u := range p.Urgency {
+		switch {
+		case u.After == nil && u.BeforeDeadline == nil:
+			err = multierr.Append(err, commonconfig.ErrMissing{Name: fmt.Sprintf("Urgency.%d.After", i), Msg: "required for all urgency steps without BeforeDeadline"})
+		case u.After != nil && u.BeforeDeadline != nil:
+			err = multierr.Append(err, commonconfig.ErrInvalid{Name: fmt.Sprintf("Urgency.%d.BeforeDeadline", i), Value: u.BeforeDeadline,
+				Msg: "must not be set with After"})
+		case u.After != nil:
+			if u.After.Duration() <= 0 {
+				err = multierr.Append(err, commonconfig.ErrInvalid{Name: fmt.Sprintf("Urgency.%d.After", i), Value: u.After,
+					Msg: "must be greater than 0"})
+			} else if prevAfter != nil && u.After.Duration() <= prevAfter.Duration() {
+				err = multierr.Append(err, commonconfig.ErrInvalid{Name: fmt.Sprintf("Urgency.%d.After", i), Value: u.After,
+					Msg: "must be greater than the previous urgency step"})
+			}
+			prevAfter = u.After
+		default:
+			if u.BeforeDeadline.Duration() <= 0 {
+				err = multierr.Append(err, commonconfig.ErrInvalid{Name: fmt.Sprintf("Urgency.%d.BeforeDeadline", i), Value: u.BeforeDeadline,
+					Msg: "must be greater than 0"})
+			} else if prevBeforeDeadline != nil && u.BeforeDeadline.Duration() >= prevBeforeDeadline.Duration() {
+				err = multierr.Append(err, commonconfig.ErrInvalid{Name: fmt.Sprintf("Urgency.%d.BeforeDeadline", i), Value: u.BeforeDeadline,
+					Msg: "must be less than the previous urgency step"})
+			}
+			prevBeforeDeadline = u.BeforeDeadline
+		}
+		if u.Bumps == nil {
+			err = multierr.Append(err, commonconfig.ErrMissing{Name: fmt.Sprintf("Urgency.%d.Bumps", i), Msg: "required for all urgency steps"})
+		} else if *u.Bumps == 0 {
+			err = multierr.Append(err, commonconfig.ErrInvalid{Name: fmt.Sprintf("Urgency.%d.Bumps", i), Value: *u.Bumps,
+				Msg: "must be greater than 0"})
+		}
+	}
+	return
+}
+
+func (p *FeePolicy) setFrom(f *FeePolicy) {
+	if v := f.MaxSpendPerHour; v != nil {
+		p.MaxSpendPerHour = v
+	}
+	if v := f.MaxFeeValuePercent; v != nil {
+		p.MaxFeeValuePercent = v
+	}
+	if v := f.Urgency; v != nil {
+		p.Urgency = v
+	}
+}
+
+// FeePolicyUrgency bumps the fee of transactions Bumps times at once, once they are older than After,
+// or once their TxMeta.Deadline is less than BeforeDeadline away
+type FeePolicyUrgency struct {
+	After          *commonconfig.Duration
+	BeforeDeadline *commonconfig.Duration
+	Bumps          *uint16
+}
+
 type KeySpecificConfig []KeySpecific
 
 func (ks KeySpecificConfig) ValidateConfig() (err error) {
@@ -910,6 +1190,7 @@ type NodePool struct {
 	EnforceRepeatableRead      *bool
 	DeathDeclarationDelay      *commonconfig.Duration
 	NewHeadsPollInterval       *commonconfig.Duration
+	QuorumReads                QuorumReads `toml:",omitempty"`
 }
 
 func (p *NodePool) setFrom(f *NodePool) {
@@ -948,6 +1229,63 @@ func (p *NodePool) setFrom(f *NodePool) {
 	}
 
 	p.Errors.setFrom(&f.Errors)
+	p.QuorumReads.setFrom(&f.QuorumReads)
+}
+
+func (p *NodePool) ValidateConfig() (err error) {
+	if p.SelectionMode != nil && !slices.Contains(commonclient.NodeSelectionModes, *p.SelectionMode) {
+		err = multierr.Append(err, commonconfig.ErrInvalid{Name: "SelectionMode", Value: *p.SelectionMode,
+			Msg: fmt.Sprintf("must be one of %v", commonclient.NodeSelectionModes)})
+	}
+	return
+}
+
+// QuorumReads configures RPC methods that are sent to several nodes and only return once enough of them agree.
+type QuorumReads struct {
+	Methods   []string `toml:",omitempty"`
+	Nodes     *uint32  `toml:",omitempty"`
+	Threshold *uint32  `toml:",omitempty"`
+}
+
+func (q *QuorumReads) setFrom(f *QuorumReads) {
+	if v := f.Methods; v != nil {
+		q.Methods = v
+	}
+	if v := f.Nodes; v != nil {
+		q.Nodes = v
+	}
+	if v := f.Threshold; v != nil {
+		q.Threshold = v
+	}
+}
+
+func (q *QuorumReads) ValidateConfig() (err error) {
+	if len(q.Methods) == 0 {
+		return
+	}
+	for i, m := range q.Methods {
+		if !slices.Contains(commonclient.QuorumMethods, m) {
+			err = multierr.Append(err, commonconfig.ErrInvalid{Name: fmt.Sprintf("Methods.%d", i), Value: m,
+				Msg: fmt.Sprintf("must be one of %v", commonclient.QuorumMethods)})
+		}
+	}
+	if q.Threshold == nil {
+		err = multierr.Append(err, commonconfig.ErrMissing{Name: "Threshold", Msg: "must be set if Methods is set"})
+	} else if *q.Threshold < 2 {
+		err = multierr.Append(err, commonconfig.ErrInvalid{Name: "Threshold", Value: *q.Threshold,
+			Msg: "must be greater than or equal to 2"})
+	}
+	if q.Nodes == nil {
+		err = multierr.Append(err, commonconfig.ErrMissing{Name: "Nodes", Msg: "must be set if Methods is set"})
+	} else if q.Threshold != nil && *q.Nodes < *q.Threshold {
+		err = multierr.Append(err, commonconfig.ErrInvalid{Name: "Nodes", Value: *q.Nodes,
+			Msg: "must be greater than or equal to Threshold"})
+	} else if q.Threshold != nil && *q.Threshold <= *q.Nodes/2 {
+		// otherwise two disagreeing groups could both reach the threshold
+		err = multierr.Append(err, commonconfig.ErrInvalid{Name: "Threshold", Value: *q.Threshold,
+			Msg: "must be greater than half of Nodes"})
+	}
+	return
 }
 
 type OCR struct {
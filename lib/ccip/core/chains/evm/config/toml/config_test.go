@@ -3,12 +3,15 @@ package toml_test
 import (
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 
 	"github.com/smartcontractkit/chainlink-common/pkg/config"
 
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/assets"
 	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/config/toml"
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/types"
 )
 
 func TestEVMConfig_ValidateConfig(t *testing.T) {
@@ -29,3 +32,142 @@ func TestEVMConfig_ValidateConfig(t *testing.T) {
 		})
 	}
 }
+
+func TestNodePool_ValidateConfig(t *testing.T) {
+	str := func(v string) *string { return &v }
+
+	assert.NoError(t, (&toml.NodePool{}).ValidateConfig())
+	for _, mode := range []string{"HighestHead", "RoundRobin", "TotalDifficulty", "PriorityLevel", "WeightedLatency"} {
+		assert.NoError(t, (&toml.NodePool{SelectionMode: str(mode)}).ValidateConfig(), mode)
+	}
+
+	err := (&toml.NodePool{SelectionMode: str("Fastest")}).ValidateConfig()
+	assert.ErrorContains(t, err, "SelectionMode: invalid value (Fastest)")
+}
+
+func TestQuorumReads_ValidateConfig(t *testing.T) {
+	u32 := func(v uint32) *uint32 { return &v }
+
+	assert.NoError(t, (&toml.QuorumReads{}).ValidateConfig())
+	assert.NoError(t, (&toml.QuorumReads{Methods: []string{"eth_call", "eth_getLogs"}, Nodes: u32(3), Threshold: u32(2)}).ValidateConfig())
+
+	err := (&toml.QuorumReads{Methods: []string{"eth_sendRawTransaction"}, Nodes: u32(3), Threshold: u32(2)}).ValidateConfig()
+	assert.ErrorContains(t, err, "Methods.0: invalid value (eth_sendRawTransaction)")
+
+	err = (&toml.QuorumReads{Methods: []string{"eth_call"}}).ValidateConfig()
+	assert.ErrorContains(t, err, "Threshold: missing")
+	assert.ErrorContains(t, err, "Nodes: missing")
+
+	err = (&toml.QuorumReads{Methods: []string{"eth_call"}, Nodes: u32(2), Threshold: u32(3)}).ValidateConfig()
+	assert.ErrorContains(t, err, "Nodes: invalid value (2): must be greater than or equal to Threshold")
+
+	err = (&toml.QuorumReads{Methods: []string{"eth_call"}, Nodes: u32(4), Threshold: u32(2)}).ValidateConfig()
+	assert.ErrorContains(t, err, "Threshold: invalid value (2): must be greater than half of Nodes")
+
+	err = (&toml.QuorumReads{Methods: []string{"eth_call"}, Nodes: u32(1), Threshold: u32(1)}).ValidateConfig()
+	assert.ErrorContains(t, err, "Threshold: invalid value (1): must be greater than or equal to 2")
+}
+
+func TestFeePolicies_ValidateConfig(t *testing.T) {
+	str := func(v string) *string { return &v }
+	u16 := func(v uint16) *uint16 { return &v }
+	after := func(d time.Duration) *config.Duration { return config.MustNewDuration(d) }
+
+	assert.NoError(t, config.Validate(toml.FeePolicies{}))
+	assert.NoError(t, config.Validate(toml.FeePolicies{
+		{Name: str("ccip_commit"), Urgency: []toml.FeePolicyUrgency{
+			{After: after(time.Minute), Bumps: u16(2)},
+			{BeforeDeadline: after(time.Hour), Bumps: u16(2)},
+			{After: after(time.Hour), Bumps: u16(3)},
+			{BeforeDeadline: after(10 * time.Minute), Bumps: u16(4)},
+		}},
+		{Name: str("ccip_exec"), MaxFeeValuePercent: u16(5)},
+	}))
+
+	err := config.Validate(toml.FeePolicies{{}, {Name: str("ccip_exec")}, {Name: str("ccip_exec")}})
+	assert.ErrorContains(t, err, "0.Name: missing: required for all fee policies")
+	assert.ErrorContains(t, err, "2.Name: invalid value (ccip_exec): duplicate - must be unique")
+
+	err = config.Validate(toml.FeePolicies{{Name: str("ccip_commit"), Urgency: []toml.FeePolicyUrgency{
+		{After: after(time.Hour), Bumps: u16(2)},
+		{After: after(time.Minute), Bumps: u16(0)},
+		{Bumps: u16(1)},
+		{BeforeDeadline: after(time.Minute), Bumps: u16(2)},
+		{BeforeDeadline: after(time.Hour), Bumps: u16(3)},
+		{After: after(2 * time.Hour), BeforeDeadline: after(time.Second), Bumps: u16(3)},
+		{After: after(0), Bumps: u16(1)},
+	}}})
+	assert.ErrorContains(t, err, "Urgency.1.After: invalid value (1m0s): must be greater than the previous urgency step")
+	assert.ErrorContains(t, err, "Urgency.1.Bumps: invalid value (0): must be greater than 0")
+	assert.ErrorContains(t, err, "Urgency.2.After: missing: required for all urgency steps without BeforeDeadline")
+	assert.ErrorContains(t, err, "Urgency.4.BeforeDeadline: invalid value (1h0m0s): must be less than the previous urgency step")
+	assert.ErrorContains(t, err, "Urgency.5.BeforeDeadline: invalid value (1s): must not be set with After")
+	assert.ErrorContains(t, err, "Urgency.6.After: invalid value (0s): must be greater than 0")
+}
+
+func TestStuckTxRecoveryConfig_ValidateConfig(t *testing.T) {
+	enabled, disabled := true, false
+	u32 := func(v uint32) *uint32 { return &v }
+
+	assert.NoError(t, config.Validate(&toml.StuckTxRecoveryConfig{}))
+	assert.NoError(t, config.Validate(&toml.StuckTxRecoveryConfig{Enabled: &disabled}))
+	assert.NoError(t, config.Validate(&toml.StuckTxRecoveryConfig{Enabled: &enabled, Threshold: u32(20)}))
+
+	err := config.Validate(&toml.StuckTxRecoveryConfig{Enabled: &enabled})
+	assert.ErrorContains(t, err, "Threshold: missing: required when stuck transaction recovery is enabled")
+	err = config.Validate(&toml.StuckTxRecoveryConfig{Enabled: &enabled, Threshold: u32(0)})
+	assert.ErrorContains(t, err, "Threshold: invalid value (0): must be greater than 0")
+}
+
+func TestBlobFees_ValidateConfig(t *testing.T) {
+	enabled, disabled := true, false
+	u16 := func(v uint16) *uint16 { return &v }
+
+	assert.NoError(t, config.Validate(&toml.BlobFees{}))
+	assert.NoError(t, config.Validate(&toml.BlobFees{Enabled: &disabled}))
+	assert.NoError(t, config.Validate(&toml.BlobFees{Enabled: &enabled, PriceMax: assets.GWei(100), HistoryDepth: u16(10)}))
+
+	err := config.Validate(&toml.BlobFees{Enabled: &enabled})
+	assert.ErrorContains(t, err, "PriceMax: missing: required when blob fees are enabled")
+	assert.ErrorContains(t, err, "HistoryDepth: missing: required when blob fees are enabled")
+	err = config.Validate(&toml.BlobFees{Enabled: &enabled, PriceMax: assets.GWei(100), HistoryDepth: u16(0)})
+	assert.ErrorContains(t, err, "HistoryDepth: invalid value (0): must be greater than 0")
+}
+
+func TestSmartAccounts_ValidateConfig(t *testing.T) {
+	addr := func(s string) *types.EIP55Address { a := types.MustEIP55Address(s); return &a }
+	str := func(s string) *string { return &s }
+	key, account := addr("0x2a3e23c6f242F5345320814aC8a1b4E58707D292"), addr("0x538aAaB4ea120b2bC2fe5D296852D948F07D849e")
+	entryPoint := addr("0x0000000071727De22E5E9d8BAf0edAc6f37da032")
+	bundlerURL := config.MustParseURL("https://bundler.test")
+
+	assert.NoError(t, config.Validate(toml.SmartAccounts{}))
+	assert.NoError(t, config.Validate(toml.SmartAccounts{
+		{Key: key, Account: account, Mode: str(toml.SmartAccountModeERC4337), EntryPoint: entryPoint, BundlerURL: bundlerURL},
+	}))
+	assert.NoError(t, config.Validate(toml.SmartAccounts{
+		{Key: key, Account: key, Mode: str(toml.SmartAccountModeEIP7702)},
+	}))
+
+	err := config.Validate(&toml.SmartAccount{})
+	assert.ErrorContains(t, err, "Key: missing: required for all smart accounts")
+	assert.ErrorContains(t, err, "Account: missing: required for all smart accounts")
+	assert.ErrorContains(t, err, "Mode: missing: required for all smart accounts")
+
+	err = config.Validate(&toml.SmartAccount{Key: key, Account: account, Mode: str(toml.SmartAccountModeERC4337)})
+	assert.ErrorContains(t, err, "EntryPoint: missing: required for ERC4337 smart accounts")
+	assert.ErrorContains(t, err, "BundlerURL: missing: required for ERC4337 smart accounts")
+
+	err = config.Validate(&toml.SmartAccount{Key: key, Account: key, Mode: str(toml.SmartAccountModeEIP7702), EntryPoint: entryPoint, BundlerURL: bundlerURL})
+	assert.ErrorContains(t, err, "EntryPoint: invalid value")
+	assert.ErrorContains(t, err, "only used by ERC4337 smart accounts")
+
+	err = config.Validate(&toml.SmartAccount{Key: key, Account: account, Mode: str("Safe")})
+	assert.ErrorContains(t, err, "Mode: invalid value (Safe): must be one of ERC4337 or EIP7702")
+
+	err = config.Validate(toml.SmartAccounts{
+		{Key: key, Account: key, Mode: str(toml.SmartAccountModeEIP7702)},
+		{Key: key, Account: account, Mode: str(toml.SmartAccountModeERC4337), EntryPoint: entryPoint, BundlerURL: bundlerURL},
+	})
+	assert.ErrorContains(t, err, "1.Key: invalid value")
+}
@@ -0,0 +1,180 @@
+package gas
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	pkgerrors "github.com/pkg/errors"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/logger"
+	"github.com/smartcontractkit/chainlink-common/pkg/services"
+
+	commonfee "github.com/smartcontractkit/chainlink/v2/common/fee"
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/assets"
+	evmclient "github.com/smartcontractkit/chainlink/v2/core/chains/evm/client"
+	evmconfig "github.com/smartcontractkit/chainlink/v2/core/chains/evm/config"
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/label"
+)
+
+// BlobTxPriceBump is the minimum percentage by which every fee of a blob transaction must be bumped to replace it.
+// It is the default price bump of Geth's blob pool, which is much higher than the one of the legacy pool.
+const BlobTxPriceBump = 100
+
+// ErrBlobFeesDisabled is returned when a blob transaction is created for a chain without blob fees enabled
+var ErrBlobFeesDisabled = errors.New("blob fees are not enabled for this chain, set EVM.GasEstimator.BlobFees.Enabled to send blob transactions")
+
+type blobFeeEstimatorClient interface {
+	CallContext(ctx context.Context, result interface{}, method string, args ...interface{}) error
+}
+
+// BlobFeeEstimator estimates the fee cap per blob gas of blob (EIP-4844) transactions. It polls eth_blobBaseFee and
+// uses the highest blob base fee of the last HistoryDepth polls, plus BufferPercent, so that the fee cap survives the
+// short spikes of the blob base fee.
+type BlobFeeEstimator struct {
+	services.StateMachine
+
+	cfg        evmconfig.BlobFees
+	client     blobFeeEstimatorClient
+	pollPeriod time.Duration
+	logger     logger.SugaredLogger
+
+	historyMu sync.RWMutex
+	history   []*assets.Wei // Blob base fees of the last HistoryDepth polls, oldest first
+
+	chInitialised chan struct{}
+	chStop        services.StopChan
+	chDone        chan struct{}
+}
+
+// NewBlobFeeEstimator returns a new BlobFeeEstimator, which polls the blob base fee every slot.
+func NewBlobFeeEstimator(lggr logger.Logger, client blobFeeEstimatorClient, cfg evmconfig.BlobFees) *BlobFeeEstimator {
+	return &BlobFeeEstimator{
+		cfg:           cfg,
+		client:        client,
+		pollPeriod:    12 * time.Second,
+		logger:        logger.Sugared(logger.Named(lggr, "BlobFeeEstimator")),
+		chInitialised: make(chan struct{}),
+		chStop:        make(chan struct{}),
+		chDone:        make(chan struct{}),
+	}
+}
+
+func (b *BlobFeeEstimator) Name() string {
+	return b.logger.Name()
+}
+
+func (b *BlobFeeEstimator) Start(context.Context) error {
+	return b.StartOnce("BlobFeeEstimator", func() error {
+		go b.run()
+		<-b.chInitialised
+		return nil
+	})
+}
+
+func (b *BlobFeeEstimator) Close() error {
+	return b.StopOnce("BlobFeeEstimator", func() error {
+		close(b.chStop)
+		<-b.chDone
+		return nil
+	})
+}
+
+func (b *BlobFeeEstimator) HealthReport() map[string]error {
+	return map[string]error{b.Name(): b.Healthy()}
+}
+
+func (b *BlobFeeEstimator) run() {
+	defer close(b.chDone)
+
+	b.refreshBlobBaseFee()
+	close(b.chInitialised)
+
+	t := services.TickerConfig{
+		Initial:   b.pollPeriod,
+		JitterPct: services.DefaultJitter,
+	}.NewTicker(b.pollPeriod)
+
+	for {
+		select {
+		case <-b.chStop:
+			return
+		case <-t.C:
+			b.refreshBlobBaseFee()
+		}
+	}
+}
+
+func (b *BlobFeeEstimator) refreshBlobBaseFee() {
+	var res hexutil.Big
+	ctx, cancel := b.chStop.CtxCancel(evmclient.ContextWithDefaultTimeout())
+	defer cancel()
+
+	if err := b.client.CallContext(ctx, &res, "eth_blobBaseFee"); err != nil {
+		b.logger.Warnf("Failed to refresh blob base fee, got error: %s", err)
+		return
+	}
+	b.addBlobBaseFee((*assets.Wei)(&res))
+}
+
+func (b *BlobFeeEstimator) addBlobBaseFee(blobBaseFee *assets.Wei) {
+	b.logger.Debugw("refreshBlobBaseFee", "BlobBaseFee", blobBaseFee)
+
+	b.historyMu.Lock()
+	defer b.historyMu.Unlock()
+	b.history = append(b.history, blobBaseFee)
+	if depth := int(b.cfg.HistoryDepth()); len(b.history) > depth {
+		b.history = b.history[len(b.history)-depth:]
+	}
+}
+
+// maxBlobBaseFee returns the highest blob base fee of the history, or nil if none was fetched yet
+func (b *BlobFeeEstimator) maxBlobBaseFee() *assets.Wei {
+	b.historyMu.RLock()
+	defer b.historyMu.RUnlock()
+	var max *assets.Wei
+	for _, fee := range b.history {
+		if max == nil || fee.Cmp(max) > 0 {
+			max = fee
+		}
+	}
+	return max
+}
+
+// GetBlobFee returns the fee cap per blob gas of a new blob transaction
+func (b *BlobFeeEstimator) GetBlobFee(_ context.Context) (blobFeeCap *assets.Wei, err error) {
+	ok := b.IfStarted(func() {
+		maxBlobBaseFee := b.maxBlobBaseFee()
+		if maxBlobBaseFee == nil {
+			err = pkgerrors.New("failed to estimate blob fee; blob base fee not set")
+			return
+		}
+		blobFeeCap = maxBlobBaseFee.AddPercentage(b.cfg.BufferPercent())
+	})
+	if !ok {
+		return nil, pkgerrors.New("estimator is not started")
+	} else if err != nil {
+		return
+	}
+	if priceMax := b.cfg.PriceMax(); blobFeeCap.Cmp(priceMax) > 0 {
+		return nil, fmt.Errorf("estimated blob fee cap: %s is greater than the maximum blob fee cap configured: %s", blobFeeCap, priceMax)
+	}
+	return
+}
+
+// BumpBlobFee bumps the fee cap per blob gas by BlobTxPriceBump percent, or to the current estimate if it is higher
+func (b *BlobFeeEstimator) BumpBlobFee(ctx context.Context, originalBlobFeeCap *assets.Wei) (*assets.Wei, error) {
+	// The blob base fee may be as low as 1 wei, so bump by at least 1 wei for the bump to be effective
+	bumped := bumpFeePrice(originalBlobFeeCap, BlobTxPriceBump, assets.NewWeiI(1))
+	if current, err := b.GetBlobFee(ctx); err == nil && current.Cmp(bumped) > 0 {
+		bumped = current
+	}
+	if priceMax := b.cfg.PriceMax(); bumped.Cmp(priceMax) > 0 {
+		return nil, pkgerrors.Wrapf(commonfee.ErrBumpFeeExceedsLimit, "bumped blob fee cap of %s would exceed configured max blob fee cap of %s (original blob fee cap was %s). %s",
+			bumped, priceMax, originalBlobFeeCap, label.NodeConnectivityProblemWarning)
+	}
+	return bumped, nil
+}
@@ -0,0 +1,114 @@
+package gas_test
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	pkgerrors "github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/logger"
+	"github.com/smartcontractkit/chainlink-common/pkg/services/servicetest"
+	"github.com/smartcontractkit/chainlink-common/pkg/utils/tests"
+
+	commonfee "github.com/smartcontractkit/chainlink/v2/common/fee"
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/assets"
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/gas"
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/gas/mocks"
+)
+
+func TestBlobFeeEstimator(t *testing.T) {
+	t.Parallel()
+
+	cfg := &gas.MockBlobFeesConfig{EnabledF: true, PriceMaxF: assets.NewWeiI(1000), HistoryDepthF: 3, BufferPercentF: 20}
+
+	newClient := func(t *testing.T, blobBaseFee int64) *mocks.FeeEstimatorClient {
+		client := mocks.NewFeeEstimatorClient(t)
+		client.On("CallContext", mock.Anything, mock.Anything, "eth_blobBaseFee").Return(nil).Run(func(args mock.Arguments) {
+			res := args.Get(1).(*hexutil.Big)
+			(*big.Int)(res).SetInt64(blobBaseFee)
+		}).Maybe()
+		return client
+	}
+
+	t.Run("calling GetBlobFee on unstarted estimator returns error", func(t *testing.T) {
+		o := gas.NewBlobFeeEstimator(logger.Test(t), mocks.NewFeeEstimatorClient(t), cfg)
+		_, err := o.GetBlobFee(tests.Context(t))
+		assert.EqualError(t, err, "estimator is not started")
+	})
+
+	t.Run("returns error if the blob base fee was never fetched", func(t *testing.T) {
+		client := mocks.NewFeeEstimatorClient(t)
+		client.On("CallContext", mock.Anything, mock.Anything, "eth_blobBaseFee").Return(pkgerrors.New("kaboom")).Maybe()
+
+		o := gas.NewBlobFeeEstimator(logger.Test(t), client, cfg)
+		servicetest.RunHealthy(t, o)
+		_, err := o.GetBlobFee(tests.Context(t))
+		assert.EqualError(t, err, "failed to estimate blob fee; blob base fee not set")
+	})
+
+	t.Run("returns the highest blob base fee of the history plus the buffer", func(t *testing.T) {
+		o := gas.NewBlobFeeEstimator(logger.Test(t), newClient(t, 100), cfg)
+		servicetest.RunHealthy(t, o)
+
+		blobFeeCap, err := o.GetBlobFee(tests.Context(t))
+		require.NoError(t, err)
+		assert.Equal(t, assets.NewWeiI(120), blobFeeCap)
+
+		gas.AddBlobBaseFee(o, assets.NewWeiI(200))
+		gas.AddBlobBaseFee(o, assets.NewWeiI(50))
+		blobFeeCap, err = o.GetBlobFee(tests.Context(t))
+		require.NoError(t, err)
+		assert.Equal(t, assets.NewWeiI(240), blobFeeCap)
+
+		// The spike drops out of the history after HistoryDepth polls
+		gas.AddBlobBaseFee(o, assets.NewWeiI(50))
+		gas.AddBlobBaseFee(o, assets.NewWeiI(50))
+		blobFeeCap, err = o.GetBlobFee(tests.Context(t))
+		require.NoError(t, err)
+		assert.Equal(t, assets.NewWeiI(60), blobFeeCap)
+	})
+
+	t.Run("returns error if the estimate exceeds PriceMax", func(t *testing.T) {
+		o := gas.NewBlobFeeEstimator(logger.Test(t), newClient(t, 900), cfg)
+		servicetest.RunHealthy(t, o)
+
+		_, err := o.GetBlobFee(tests.Context(t))
+		assert.EqualError(t, err, "estimated blob fee cap: 1.08 kwei is greater than the maximum blob fee cap configured: 1 kwei")
+	})
+
+	t.Run("BumpBlobFee doubles the original blob fee cap", func(t *testing.T) {
+		o := gas.NewBlobFeeEstimator(logger.Test(t), newClient(t, 10), cfg)
+		servicetest.RunHealthy(t, o)
+
+		bumped, err := o.BumpBlobFee(tests.Context(t), assets.NewWeiI(100))
+		require.NoError(t, err)
+		assert.Equal(t, assets.NewWeiI(200), bumped)
+
+		// A blob fee cap of 1 wei is still bumped
+		bumped, err = o.BumpBlobFee(tests.Context(t), assets.NewWeiI(1))
+		require.NoError(t, err)
+		assert.Equal(t, assets.NewWeiI(12), bumped)
+	})
+
+	t.Run("BumpBlobFee uses the current estimate if it is higher", func(t *testing.T) {
+		o := gas.NewBlobFeeEstimator(logger.Test(t), newClient(t, 500), cfg)
+		servicetest.RunHealthy(t, o)
+
+		bumped, err := o.BumpBlobFee(tests.Context(t), assets.NewWeiI(100))
+		require.NoError(t, err)
+		assert.Equal(t, assets.NewWeiI(600), bumped)
+	})
+
+	t.Run("BumpBlobFee returns error if the bumped blob fee cap exceeds PriceMax", func(t *testing.T) {
+		o := gas.NewBlobFeeEstimator(logger.Test(t), newClient(t, 10), cfg)
+		servicetest.RunHealthy(t, o)
+
+		_, err := o.BumpBlobFee(tests.Context(t), assets.NewWeiI(600))
+		require.Error(t, err)
+		assert.True(t, pkgerrors.Is(err, commonfee.ErrBumpFeeExceedsLimit))
+	})
+}
@@ -360,7 +360,7 @@ func (b *BlockHistoryEstimator) haltBumping(attempts []EvmPriorAttempt) error {
 		switch attempt.TxType {
 		case 0x0, 0x1:
 			attemptEip1559 = false
-		case 0x2:
+		case 0x2, 0x3:
 			attemptEip1559 = true
 		default:
 			return fmt.Errorf("attempt %s has unknown transaction type 0x%d", attempt.TxHash, attempt.TxType)
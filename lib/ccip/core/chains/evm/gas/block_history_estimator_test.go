@@ -2304,13 +2304,13 @@ func TestBlockHistoryEstimator_HaltBumping(t *testing.T) {
 	num := int64(0)
 	hash := utils.NewHash()
 	attempts = []gas.EvmPriorAttempt{
-		{TxType: 0x3, BroadcastBeforeBlockNum: &num, TxHash: hash},
+		{TxType: 0x5, BroadcastBeforeBlockNum: &num, TxHash: hash},
 	}
 
 	t.Run("returns error if one of the supplied attempts has an unknown transaction type", func(t *testing.T) {
 		err := bhe.HaltBumping(attempts)
 		require.Error(t, err)
-		assert.Contains(t, err.Error(), fmt.Sprintf("attempt %s has unknown transaction type 0x3", hash))
+		assert.Contains(t, err.Error(), fmt.Sprintf("attempt %s has unknown transaction type 0x5", hash))
 	})
 
 	attempts = []gas.EvmPriorAttempt{
@@ -79,6 +79,10 @@ func GetLatestBaseFee(b *BlockHistoryEstimator) *assets.Wei {
 	return b.latest.BaseFeePerGas
 }
 
+func AddBlobBaseFee(b *BlobFeeEstimator, blobBaseFee *assets.Wei) {
+	b.addBlobBaseFee(blobBaseFee)
+}
+
 func SimulateStart(t *testing.T, b *BlockHistoryEstimator) {
 	require.NoError(t, b.StartOnce("BlockHistoryEstimatorSimulatedStart", func() error { return nil }))
 }
@@ -122,6 +126,29 @@ func (m *MockBlockHistoryConfig) TransactionPercentile() uint16 {
 	return m.TransactionPercentileF
 }
 
+type MockBlobFeesConfig struct {
+	EnabledF       bool
+	PriceMaxF      *assets.Wei
+	HistoryDepthF  uint16
+	BufferPercentF uint16
+}
+
+func (m *MockBlobFeesConfig) Enabled() bool {
+	return m.EnabledF
+}
+
+func (m *MockBlobFeesConfig) PriceMax() *assets.Wei {
+	return m.PriceMaxF
+}
+
+func (m *MockBlobFeesConfig) HistoryDepth() uint16 {
+	return m.HistoryDepthF
+}
+
+func (m *MockBlobFeesConfig) BufferPercent() uint16 {
+	return m.BufferPercentF
+}
+
 type MockConfig struct {
 	ChainTypeF          string
 	FinalityTagEnabledF bool
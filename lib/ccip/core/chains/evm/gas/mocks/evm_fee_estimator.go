@@ -147,6 +147,64 @@ func (_c *EvmFeeEstimator_Close_Call) RunAndReturn(run func() error) *EvmFeeEsti
 	return _c
 }
 
+// GetBlobFee provides a mock function with given fields: ctx
+func (_m *EvmFeeEstimator) GetBlobFee(ctx context.Context) (*assets.Wei, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetBlobFee")
+	}
+
+	var r0 *assets.Wei
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) (*assets.Wei, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) *assets.Wei); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*assets.Wei)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// EvmFeeEstimator_GetBlobFee_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetBlobFee'
+type EvmFeeEstimator_GetBlobFee_Call struct {
+	*mock.Call
+}
+
+// GetBlobFee is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *EvmFeeEstimator_Expecter) GetBlobFee(ctx interface{}) *EvmFeeEstimator_GetBlobFee_Call {
+	return &EvmFeeEstimator_GetBlobFee_Call{Call: _e.mock.On("GetBlobFee", ctx)}
+}
+
+func (_c *EvmFeeEstimator_GetBlobFee_Call) Run(run func(ctx context.Context)) *EvmFeeEstimator_GetBlobFee_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *EvmFeeEstimator_GetBlobFee_Call) Return(blobFeeCap *assets.Wei, err error) *EvmFeeEstimator_GetBlobFee_Call {
+	_c.Call.Return(blobFeeCap, err)
+	return _c
+}
+
+func (_c *EvmFeeEstimator_GetBlobFee_Call) RunAndReturn(run func(context.Context) (*assets.Wei, error)) *EvmFeeEstimator_GetBlobFee_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetFee provides a mock function with given fields: ctx, calldata, feeLimit, maxFeePrice, fromAddress, toAddress, opts
 func (_m *EvmFeeEstimator) GetFee(ctx context.Context, calldata []byte, feeLimit uint64, maxFeePrice *assets.Wei, fromAddress *common.Address, toAddress *common.Address, opts ...types.Opt) (gas.EvmFee, uint64, error) {
 	_va := make([]interface{}, len(opts))
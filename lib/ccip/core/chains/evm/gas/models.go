@@ -38,6 +38,8 @@ type EvmFeeEstimator interface {
 	L1Oracle() rollups.L1Oracle
 	GetFee(ctx context.Context, calldata []byte, feeLimit uint64, maxFeePrice *assets.Wei, fromAddress, toAddress *common.Address, opts ...feetypes.Opt) (fee EvmFee, estimatedFeeLimit uint64, err error)
 	BumpFee(ctx context.Context, originalFee EvmFee, feeLimit uint64, maxFeePrice *assets.Wei, attempts []EvmPriorAttempt) (bumpedFee EvmFee, chainSpecificFeeLimit uint64, err error)
+	// GetBlobFee returns the fee cap per blob gas of a new blob transaction. Returns ErrBlobFeesDisabled if blob fees are not enabled for the chain.
+	GetBlobFee(ctx context.Context) (blobFeeCap *assets.Wei, err error)
 
 	// GetMaxCost returns the total value = max price x fee units + transferred value
 	GetMaxCost(ctx context.Context, amount assets.Eth, calldata []byte, feeLimit uint64, maxFeePrice *assets.Wei, fromAddress, toAddress *common.Address, opts ...feetypes.Opt) (*big.Int, error)
@@ -130,7 +132,11 @@ func NewEstimator(lggr logger.Logger, ethClient feeEstimatorClient, cfg Config,
 			return NewFixedPriceEstimator(geCfg, ethClient, bh, lggr, l1Oracle)
 		}
 	}
-	return NewEvmFeeEstimator(lggr, newEstimator, df, geCfg, ethClient), nil
+	var blobEstimator *BlobFeeEstimator
+	if bf := geCfg.BlobFees(); bf.Enabled() {
+		blobEstimator = NewBlobFeeEstimator(lggr, ethClient, bf)
+	}
+	return newEvmFeeEstimator(lggr, newEstimator, df, geCfg, ethClient, blobEstimator), nil
 }
 
 // DynamicFee encompasses both FeeCap and TipCap for EIP1559 transactions
@@ -184,9 +190,15 @@ type EvmFee struct {
 	// dynamic/EIP1559 fees
 	DynamicFeeCap *assets.Wei
 	DynamicTipCap *assets.Wei
+
+	// blob/EIP4844 fee, only set for blob transactions on top of the dynamic fees
+	BlobFeeCap *assets.Wei
 }
 
 func (fee EvmFee) String() string {
+	if fee.BlobFeeCap != nil {
+		return fmt.Sprintf("{Legacy: %s, DynamicFeeCap: %s, DynamicTipCap: %s, BlobFeeCap: %s}", fee.Legacy, fee.DynamicFeeCap, fee.DynamicTipCap, fee.BlobFeeCap)
+	}
 	return fmt.Sprintf("{Legacy: %s, DynamicFeeCap: %s, DynamicTipCap: %s}", fee.Legacy, fee.DynamicFeeCap, fee.DynamicTipCap)
 }
 
@@ -194,6 +206,10 @@ func (fee EvmFee) ValidDynamic() bool {
 	return fee.DynamicFeeCap != nil && fee.DynamicTipCap != nil
 }
 
+func (fee EvmFee) ValidBlob() bool {
+	return fee.ValidDynamic() && fee.BlobFeeCap != nil
+}
+
 // evmFeeEstimator provides a struct that wraps the EVM specific dynamic and legacy estimators into one estimator that conforms to the generic FeeEstimator
 type evmFeeEstimator struct {
 	services.StateMachine
@@ -202,11 +218,17 @@ type evmFeeEstimator struct {
 	EIP1559Enabled bool
 	geCfg          GasEstimatorConfig
 	ethClient      feeEstimatorClient
+	// blobEstimator is nil if blob fees are disabled
+	blobEstimator *BlobFeeEstimator
 }
 
 var _ EvmFeeEstimator = (*evmFeeEstimator)(nil)
 
 func NewEvmFeeEstimator(lggr logger.Logger, newEstimator func(logger.Logger) EvmEstimator, eip1559Enabled bool, geCfg GasEstimatorConfig, ethClient feeEstimatorClient) EvmFeeEstimator {
+	return newEvmFeeEstimator(lggr, newEstimator, eip1559Enabled, geCfg, ethClient, nil)
+}
+
+func newEvmFeeEstimator(lggr logger.Logger, newEstimator func(logger.Logger) EvmEstimator, eip1559Enabled bool, geCfg GasEstimatorConfig, ethClient feeEstimatorClient, blobEstimator *BlobFeeEstimator) *evmFeeEstimator {
 	lggr = logger.Named(lggr, "WrappedEvmEstimator")
 	return &evmFeeEstimator{
 		lggr:           lggr,
@@ -214,6 +236,7 @@ func NewEvmFeeEstimator(lggr logger.Logger, newEstimator func(logger.Logger) Evm
 		EIP1559Enabled: eip1559Enabled,
 		geCfg:          geCfg,
 		ethClient:      ethClient,
+		blobEstimator:  blobEstimator,
 	}
 }
 
@@ -232,23 +255,34 @@ func (e *evmFeeEstimator) Start(ctx context.Context) error {
 				return pkgerrors.Wrap(err, "failed to start L1Oracle")
 			}
 		}
+		if e.blobEstimator != nil {
+			if err := e.blobEstimator.Start(ctx); err != nil {
+				return pkgerrors.Wrap(err, "failed to start BlobFeeEstimator")
+			}
+		}
 		return nil
 	})
 }
 func (e *evmFeeEstimator) Close() error {
 	return e.StopOnce(e.Name(), func() error {
-		var errEVM, errOracle error
+		var errEVM, errOracle, errBlob error
 
 		errEVM = pkgerrors.Wrap(e.EvmEstimator.Close(), "failed to stop EVMEstimator")
 		l1Oracle := e.L1Oracle()
 		if l1Oracle != nil {
 			errOracle = pkgerrors.Wrap(l1Oracle.Close(), "failed to stop L1Oracle")
 		}
+		if e.blobEstimator != nil {
+			errBlob = pkgerrors.Wrap(e.blobEstimator.Close(), "failed to stop BlobFeeEstimator")
+		}
 
 		if errEVM != nil {
 			return errEVM
 		}
-		return errOracle
+		if errOracle != nil {
+			return errOracle
+		}
+		return errBlob
 	})
 }
 
@@ -275,6 +309,9 @@ func (e *evmFeeEstimator) HealthReport() map[string]error {
 	if l1Oracle != nil {
 		services.CopyHealth(report, l1Oracle.HealthReport())
 	}
+	if e.blobEstimator != nil {
+		services.CopyHealth(report, e.blobEstimator.HealthReport())
+	}
 
 	return report
 }
@@ -346,6 +383,9 @@ func (e *evmFeeEstimator) BumpFee(ctx context.Context, originalFee EvmFee, feeLi
 		if err != nil {
 			return
 		}
+		if originalFee.BlobFeeCap != nil {
+			return e.bumpBlobTxFee(ctx, originalFee, bumpedDynamic, feeLimit, maxFeePrice)
+		}
 		chainSpecificFeeLimit, err = commonfee.ApplyMultiplier(feeLimit, e.geCfg.LimitMultiplier())
 		bumpedFee.DynamicFeeCap = bumpedDynamic.FeeCap
 		bumpedFee.DynamicTipCap = bumpedDynamic.TipCap
@@ -361,6 +401,35 @@ func (e *evmFeeEstimator) BumpFee(ctx context.Context, originalFee EvmFee, feeLi
 	return
 }
 
+func (e *evmFeeEstimator) GetBlobFee(ctx context.Context) (*assets.Wei, error) {
+	if e.blobEstimator == nil {
+		return nil, ErrBlobFeesDisabled
+	}
+	return e.blobEstimator.GetBlobFee(ctx)
+}
+
+// bumpBlobTxFee bumps the fees of a blob transaction. The blob pool only replaces a blob transaction if its tip cap,
+// fee cap and blob fee cap are all bumped by at least BlobTxPriceBump percent, so the dynamic fee bumped by the
+// estimator is raised to that minimum if needed.
+func (e *evmFeeEstimator) bumpBlobTxFee(ctx context.Context, originalFee EvmFee, bumpedDynamic DynamicFee, feeLimit uint64, maxFeePrice *assets.Wei) (bumpedFee EvmFee, chainSpecificFeeLimit uint64, err error) {
+	if e.blobEstimator == nil {
+		err = ErrBlobFeesDisabled
+		return
+	}
+	bumpedFee.DynamicTipCap = assets.MaxWei(bumpedDynamic.TipCap, originalFee.DynamicTipCap.AddPercentage(BlobTxPriceBump))
+	bumpedFee.DynamicFeeCap = assets.MaxWei(bumpedDynamic.FeeCap, originalFee.DynamicFeeCap.AddPercentage(BlobTxPriceBump))
+	if bumpedFee.DynamicFeeCap.Cmp(maxFeePrice) > 0 {
+		err = pkgerrors.Wrapf(commonfee.ErrBumpFeeExceedsLimit, "bumped fee cap of %s for blob transaction would exceed configured max gas price of %s (original fee cap was %s). %s",
+			bumpedFee.DynamicFeeCap, maxFeePrice, originalFee.DynamicFeeCap, label.NodeConnectivityProblemWarning)
+		return
+	}
+	if bumpedFee.BlobFeeCap, err = e.blobEstimator.BumpBlobFee(ctx, originalFee.BlobFeeCap); err != nil {
+		return
+	}
+	chainSpecificFeeLimit, err = commonfee.ApplyMultiplier(feeLimit, e.geCfg.LimitMultiplier())
+	return
+}
+
 func (e *evmFeeEstimator) estimateFeeLimit(ctx context.Context, feeLimit uint64, calldata []byte, fromAddress, toAddress *common.Address) (estimatedFeeLimit uint64, err error) {
 	// Use the feeLimit * LimitMultiplier as the provided gas limit since this multiplier is applied on top of the caller specified gas limit
 	providedGasLimit, err := commonfee.ApplyMultiplier(feeLimit, e.geCfg.LimitMultiplier())
@@ -141,6 +141,24 @@ func TestWrappedEvmEstimator(t *testing.T) {
 		assert.Error(t, err)
 	})
 
+	t.Run("blob fees disabled", func(t *testing.T) {
+		lggr := logger.Test(t)
+		blobEst := mocks.NewEvmEstimator(t)
+		blobEst.On("BumpDynamicFee", mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+			Return(dynamicFee, nil).Once()
+		estimator := gas.NewEvmFeeEstimator(lggr, func(logger.Logger) gas.EvmEstimator { return blobEst }, true, geCfg, nil)
+
+		_, err := estimator.GetBlobFee(ctx)
+		assert.ErrorIs(t, err, gas.ErrBlobFeesDisabled)
+
+		_, _, err = estimator.BumpFee(ctx, gas.EvmFee{
+			DynamicFeeCap: dynamicFee.FeeCap,
+			DynamicTipCap: dynamicFee.TipCap,
+			BlobFeeCap:    assets.NewWeiI(1),
+		}, gasLimit, assets.NewWeiI(100), nil)
+		assert.ErrorIs(t, err, gas.ErrBlobFeesDisabled)
+	})
+
 	t.Run("GetMaxCost", func(t *testing.T) {
 		lggr := logger.Test(t)
 		val := assets.NewEthValue(1)
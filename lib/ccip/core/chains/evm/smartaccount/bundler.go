@@ -0,0 +1,92 @@
+package smartaccount
+
+import (
+	"context"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+type rpcClient interface {
+	CallContext(ctx context.Context, result interface{}, method string, args ...interface{}) error
+}
+
+// Bundler submits the user operations of ERC-4337 accounts
+type Bundler interface {
+	EstimateUserOperationGas(ctx context.Context, op *UserOperation, entryPoint common.Address) (UserOperationGasEstimate, error)
+	// SendUserOperation returns the hash of the user operation accepted by the bundler
+	SendUserOperation(ctx context.Context, op *UserOperation, entryPoint common.Address) (common.Hash, error)
+	// GetUserOperationReceipt returns nil if the user operation was not included yet
+	GetUserOperationReceipt(ctx context.Context, hash common.Hash) (*UserOperationReceipt, error)
+}
+
+type bundlerClient struct {
+	c rpcClient
+}
+
+// NewBundlerClient returns a Bundler which calls the ERC-4337 bundler RPC methods of c
+func NewBundlerClient(c rpcClient) Bundler {
+	return &bundlerClient{c}
+}
+
+func (b *bundlerClient) EstimateUserOperationGas(ctx context.Context, op *UserOperation, entryPoint common.Address) (estimate UserOperationGasEstimate, err error) {
+	err = b.c.CallContext(ctx, &estimate, "eth_estimateUserOperationGas", op, entryPoint)
+	return
+}
+
+func (b *bundlerClient) SendUserOperation(ctx context.Context, op *UserOperation, entryPoint common.Address) (hash common.Hash, err error) {
+	err = b.c.CallContext(ctx, &hash, "eth_sendUserOperation", op, entryPoint)
+	return
+}
+
+func (b *bundlerClient) GetUserOperationReceipt(ctx context.Context, hash common.Hash) (receipt *UserOperationReceipt, err error) {
+	err = b.c.CallContext(ctx, &receipt, "eth_getUserOperationReceipt", hash)
+	return
+}
+
+// PaymasterData is the paymaster fields of a user operation set by a Paymaster
+type PaymasterData struct {
+	Paymaster                     common.Address `json:"paymaster"`
+	PaymasterData                 hexutil.Bytes  `json:"paymasterData"`
+	PaymasterVerificationGasLimit *hexutil.Big   `json:"paymasterVerificationGasLimit,omitempty"`
+	PaymasterPostOpGasLimit       *hexutil.Big   `json:"paymasterPostOpGasLimit,omitempty"`
+}
+
+func (d PaymasterData) apply(op *UserOperation) {
+	op.Paymaster = &d.Paymaster
+	op.PaymasterData = d.PaymasterData
+	if d.PaymasterVerificationGasLimit != nil {
+		op.PaymasterVerificationGasLimit = (*big.Int)(d.PaymasterVerificationGasLimit)
+	}
+	if d.PaymasterPostOpGasLimit != nil {
+		op.PaymasterPostOpGasLimit = (*big.Int)(d.PaymasterPostOpGasLimit)
+	}
+}
+
+// Paymaster is the hook which sponsors the user operations of an account. The data of GetPaymasterStubData is used to
+// estimate the gas of the user operation, the data of GetPaymasterData is the final data the user operation is signed
+// with.
+type Paymaster interface {
+	GetPaymasterStubData(ctx context.Context, op *UserOperation, entryPoint common.Address, chainID *big.Int) (PaymasterData, error)
+	GetPaymasterData(ctx context.Context, op *UserOperation, entryPoint common.Address, chainID *big.Int) (PaymasterData, error)
+}
+
+type paymasterClient struct {
+	c rpcClient
+}
+
+// NewPaymasterClient returns a Paymaster which calls the ERC-7677 paymaster web service RPC methods of c
+func NewPaymasterClient(c rpcClient) Paymaster {
+	return &paymasterClient{c}
+}
+
+func (p *paymasterClient) GetPaymasterStubData(ctx context.Context, op *UserOperation, entryPoint common.Address, chainID *big.Int) (data PaymasterData, err error) {
+	err = p.c.CallContext(ctx, &data, "pm_getPaymasterStubData", op, entryPoint, (*hexutil.Big)(chainID), map[string]any{})
+	return
+}
+
+func (p *paymasterClient) GetPaymasterData(ctx context.Context, op *UserOperation, entryPoint common.Address, chainID *big.Int) (data PaymasterData, err error) {
+	err = p.c.CallContext(ctx, &data, "pm_getPaymasterData", op, entryPoint, (*hexutil.Big)(chainID), map[string]any{})
+	return
+}
@@ -0,0 +1,508 @@
+package smartaccount
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"math/big"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/google/uuid"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/logger"
+	"github.com/smartcontractkit/chainlink-common/pkg/services"
+	commontypes "github.com/smartcontractkit/chainlink-common/pkg/types"
+
+	feetypes "github.com/smartcontractkit/chainlink/v2/common/fee/types"
+	txmgrtypes "github.com/smartcontractkit/chainlink/v2/common/txmgr/types"
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/assets"
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/config"
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/config/toml"
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/gas"
+)
+
+const (
+	// receiptPollInterval is how often the receipts of pending user operations are polled from the bundlers
+	receiptPollInterval = 5 * time.Second
+	// dropTimeout is how long a user operation can be pending before it is considered dropped by the bundler
+	dropTimeout = 10 * time.Minute
+	// resultRetention is how long the status of included or dropped user operations is kept
+	resultRetention = 7 * 24 * time.Hour
+)
+
+var ErrUnknownAccount = errors.New("no ERC4337 smart account is configured for key")
+
+type UserOperationState string
+
+const (
+	UserOperationPending   UserOperationState = "pending"
+	UserOperationSucceeded UserOperationState = "succeeded"
+	UserOperationReverted  UserOperationState = "reverted"
+	UserOperationDropped   UserOperationState = "dropped"
+)
+
+// UserOperationStatus is the tracked state of a user operation sent by the Manager
+type UserOperationStatus struct {
+	Hash    common.Hash
+	Key     common.Address
+	Account common.Address
+	Nonce   *big.Int
+	State   UserOperationState
+	// Receipt is set once the user operation is included
+	Receipt *UserOperationReceipt
+	SentAt  time.Time
+	// UpdatedAt is when the status was last changed
+	UpdatedAt time.Time
+	// IdempotencyKey is the key of the transaction request the user operation was sent for, if any
+	IdempotencyKey *string
+	// PipelineTaskRunID is the pipeline task resumed once the user operation has MinConfirmations, when SignalCallback
+	// is set. The task fails if the user operation is dropped, or if it reverts and FailOnRevert is set.
+	PipelineTaskRunID uuid.NullUUID
+	MinConfirmations  uint32
+	SignalCallback    bool
+	FailOnRevert      bool
+	CallbackCompleted bool
+}
+
+type Client interface {
+	contractCaller
+	ConfiguredChainID() *big.Int
+	LatestBlockHeight(ctx context.Context) (*big.Int, error)
+}
+
+type KeyStore interface {
+	SignMessage(ctx context.Context, address common.Address, message []byte) ([]byte, error)
+}
+
+type FeeEstimator interface {
+	GetFee(ctx context.Context, calldata []byte, feeLimit uint64, maxFeePrice *assets.Wei, fromAddress, toAddress *common.Address, opts ...feetypes.Opt) (fee gas.EvmFee, estimatedFeeLimit uint64, err error)
+}
+
+type FeeConfig interface {
+	LimitDefault() uint64
+	PriceMaxKey(addr common.Address) *assets.Wei
+}
+
+var _ txmgrtypes.SmartAccountManager[common.Address, common.Hash] = (*Manager)(nil)
+
+// Manager submits the transactions of the keys configured with a smart account. Transactions of EIP7702 keys are
+// converted by the transaction manager to calls of the delegated account. The transaction manager hands the requests of
+// ERC4337 keys to SendTxRequest, which submits them as user operations to the bundler of the account. The sent user
+// operations are persisted with the ORM and tracked until they are included or dropped, also across restarts.
+type Manager struct {
+	services.Service
+	eng *services.Engine
+
+	chainID   *big.Int
+	client    Client
+	ks        KeyStore
+	estimator FeeEstimator
+	feeCfg    FeeConfig
+	accounts  map[common.Address]config.SmartAccount
+	nonces    *nonceManager
+	orm       ORM
+
+	// bundlers and paymasters are keyed by the key of the account
+	mu             sync.RWMutex
+	bundlers       map[common.Address]Bundler
+	paymasters     map[common.Address]Paymaster
+	rpcClients     []*rpc.Client
+	resumeCallback func(ctx context.Context, id uuid.UUID, result interface{}, err error) error
+}
+
+func NewManager(lggr logger.Logger, orm ORM, client Client, ks KeyStore, estimator FeeEstimator, feeCfg FeeConfig, accounts []config.SmartAccount) *Manager {
+	m := &Manager{
+		chainID:    client.ConfiguredChainID(),
+		client:     client,
+		ks:         ks,
+		estimator:  estimator,
+		feeCfg:     feeCfg,
+		accounts:   make(map[common.Address]config.SmartAccount, len(accounts)),
+		nonces:     newNonceManager(client),
+		orm:        orm,
+		bundlers:   make(map[common.Address]Bundler),
+		paymasters: make(map[common.Address]Paymaster),
+	}
+	for _, a := range accounts {
+		m.accounts[a.Key()] = a
+	}
+	m.Service, m.eng = services.Config{
+		Name:  "SmartAccountManager",
+		Start: m.start,
+		Close: m.close,
+	}.NewServiceEngine(lggr)
+	return m
+}
+
+func (m *Manager) start(ctx context.Context) error {
+	// the user operations still pending since the last run hold the nonces of their accounts
+	pending, err := m.orm.PendingUserOperations(ctx)
+	if err != nil {
+		return err
+	}
+	for _, op := range pending {
+		m.nonces.commit(op.Account, op.Nonce)
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	for key, a := range m.accounts {
+		if a.Mode() != toml.SmartAccountModeERC4337 {
+			continue
+		}
+		if _, ok := m.bundlers[key]; !ok {
+			c, err := rpc.DialContext(ctx, a.BundlerURL().String())
+			if err != nil {
+				return fmt.Errorf("failed to dial bundler of account %s: %w", a.Account(), err)
+			}
+			m.rpcClients = append(m.rpcClients, c)
+			m.bundlers[key] = NewBundlerClient(c)
+		}
+		if _, ok := m.paymasters[key]; !ok && a.PaymasterURL() != nil {
+			c, err := rpc.DialContext(ctx, a.PaymasterURL().String())
+			if err != nil {
+				return fmt.Errorf("failed to dial paymaster of account %s: %w", a.Account(), err)
+			}
+			m.rpcClients = append(m.rpcClients, c)
+			m.paymasters[key] = NewPaymasterClient(c)
+		}
+	}
+	m.eng.GoTick(services.NewTicker(receiptPollInterval), m.pollReceipts)
+	return nil
+}
+
+func (m *Manager) close() error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	for _, c := range m.rpcClients {
+		c.Close()
+	}
+	m.rpcClients = nil
+	return nil
+}
+
+// SetPaymaster overrides the paymaster which sponsors the user operations of key. It must be called before the Manager
+// is started to replace the paymaster of PaymasterURL.
+func (m *Manager) SetPaymaster(key common.Address, paymaster Paymaster) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.paymasters[key] = paymaster
+}
+
+// SetResumeCallback sets the callback resuming the pipeline runs waiting for their user operations
+func (m *Manager) SetResumeCallback(fn func(ctx context.Context, id uuid.UUID, result interface{}, err error) error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.resumeCallback = fn
+}
+
+// UserOperationAccount returns the ERC4337 account of key, if any. The transactions of such keys are sent as user
+// operations of the account.
+func (m *Manager) UserOperationAccount(key common.Address) (common.Address, bool) {
+	a, ok := m.accounts[key]
+	if !ok || a.Mode() != toml.SmartAccountModeERC4337 {
+		return common.Address{}, false
+	}
+	return a.Account(), true
+}
+
+// SendsUserOperations returns true if the transactions of key are sent with SendTxRequest
+func (m *Manager) SendsUserOperations(key common.Address) bool {
+	_, ok := m.UserOperationAccount(key)
+	return ok
+}
+
+// ConvertTxRequest converts the requests of EIP7702 keys to the execution of the call by the delegated account. The
+// original destination is kept in the meta of the transaction, as for forwarded transactions. Requests of ERC4337 keys
+// must be sent with SendTxRequest instead.
+func (m *Manager) ConvertTxRequest(txRequest txmgrtypes.TxRequest[common.Address, common.Hash]) (txmgrtypes.TxRequest[common.Address, common.Hash], error) {
+	a, ok := m.accounts[txRequest.FromAddress]
+	if !ok {
+		return txRequest, nil
+	}
+	if a.Mode() == toml.SmartAccountModeERC4337 {
+		return txRequest, fmt.Errorf("%w %s: requests must be sent as user operations", ErrUnknownAccount, txRequest.FromAddress)
+	}
+	payload, err := EncodeExecute(txRequest.ToAddress, &txRequest.Value, txRequest.EncodedPayload)
+	if err != nil {
+		return txRequest, fmt.Errorf("failed to encode execute call of account %s: %w", a.Account(), err)
+	}
+	dest := txRequest.ToAddress
+	if txRequest.Meta != nil {
+		meta := *txRequest.Meta
+		meta.FwdrDestAddress = &dest
+		txRequest.Meta = &meta
+	} else {
+		txRequest.Meta = &txmgrtypes.TxMeta[common.Address, common.Hash]{FwdrDestAddress: &dest}
+	}
+	txRequest.ToAddress = a.Account()
+	txRequest.EncodedPayload = payload
+	txRequest.Value = big.Int{}
+	return txRequest, nil
+}
+
+// SendTxRequest sends the transaction request of an ERC4337 key as a user operation of its account, and returns the
+// hash of the user operation. A request with the IdempotencyKey of a user operation already sent returns that user
+// operation. The pipeline task of the request is resumed once the user operation is included with MinConfirmations.
+func (m *Manager) SendTxRequest(ctx context.Context, txRequest txmgrtypes.TxRequest[common.Address, common.Hash]) (common.Hash, error) {
+	if txRequest.IdempotencyKey != nil {
+		existing, err := m.orm.FindUserOperationWithIdempotencyKey(ctx, *txRequest.IdempotencyKey)
+		if err == nil {
+			m.eng.Infow("Found a user operation with IdempotencyKey, returning it without sending a new one", "hash", existing.Hash, "idempotencyKey", *txRequest.IdempotencyKey)
+			return existing.Hash, nil
+		}
+		if !errors.Is(err, sql.ErrNoRows) {
+			return common.Hash{}, fmt.Errorf("failed to search for user operation with IdempotencyKey: %w", err)
+		}
+	}
+	status := UserOperationStatus{
+		IdempotencyKey: txRequest.IdempotencyKey,
+		SignalCallback: txRequest.SignalCallback,
+	}
+	if txRequest.PipelineTaskRunID != nil {
+		status.PipelineTaskRunID = uuid.NullUUID{UUID: *txRequest.PipelineTaskRunID, Valid: true}
+	}
+	if txRequest.MinConfirmations.Valid {
+		status.MinConfirmations = txRequest.MinConfirmations.Uint32
+	}
+	if txRequest.Meta != nil {
+		status.FailOnRevert = txRequest.Meta.FailOnRevert.Bool
+	}
+	return m.sendUserOperation(ctx, txRequest.FromAddress, txRequest.ToAddress, &txRequest.Value, txRequest.EncodedPayload, status)
+}
+
+// SendUserOperation sends the call of to with value and data as a user operation of the ERC4337 account of key, and
+// returns the hash of the user operation. The status of the user operation is tracked until it is included or dropped,
+// see UserOperationStatus.
+func (m *Manager) SendUserOperation(ctx context.Context, key common.Address, to common.Address, value *big.Int, data []byte) (common.Hash, error) {
+	return m.sendUserOperation(ctx, key, to, value, data, UserOperationStatus{})
+}
+
+// sendUserOperation sends the user operation and saves it with the request fields of status
+func (m *Manager) sendUserOperation(ctx context.Context, key common.Address, to common.Address, value *big.Int, data []byte, status UserOperationStatus) (common.Hash, error) {
+	a, ok := m.accounts[key]
+	if !ok || a.Mode() != toml.SmartAccountModeERC4337 {
+		return common.Hash{}, fmt.Errorf("%w %s", ErrUnknownAccount, key)
+	}
+	m.mu.RLock()
+	bundler, paymaster := m.bundlers[key], m.paymasters[key]
+	m.mu.RUnlock()
+	if bundler == nil {
+		return common.Hash{}, fmt.Errorf("bundler of account %s is not started", a.Account())
+	}
+	entryPoint, account := a.EntryPoint(), a.Account()
+
+	callData, err := EncodeExecute(to, value, data)
+	if err != nil {
+		return common.Hash{}, fmt.Errorf("failed to encode execute call of account %s: %w", account, err)
+	}
+	nonce, err := m.nonces.next(ctx, entryPoint, account)
+	if err != nil {
+		return common.Hash{}, err
+	}
+	op := &UserOperation{Sender: account, Nonce: nonce, CallData: callData}
+
+	// The fee limit is only used to fall back on if the estimation of the inner call fails, the gas limits of the user
+	// operation are estimated by the bundler
+	fee, _, err := m.estimator.GetFee(ctx, data, m.feeCfg.LimitDefault(), m.feeCfg.PriceMaxKey(key), &account, &to)
+	if err != nil {
+		return common.Hash{}, fmt.Errorf("failed to estimate fee of user operation: %w", err)
+	}
+	if fee.ValidDynamic() {
+		op.MaxFeePerGas, op.MaxPriorityFeePerGas = fee.DynamicFeeCap.ToInt(), fee.DynamicTipCap.ToInt()
+	} else {
+		op.MaxFeePerGas, op.MaxPriorityFeePerGas = fee.Legacy.ToInt(), fee.Legacy.ToInt()
+	}
+
+	if paymaster != nil {
+		stub, perr := paymaster.GetPaymasterStubData(ctx, op, entryPoint, m.chainID)
+		if perr != nil {
+			return common.Hash{}, fmt.Errorf("failed to get paymaster stub data: %w", perr)
+		}
+		stub.apply(op)
+	}
+	op.Signature = dummySignature
+	estimate, err := bundler.EstimateUserOperationGas(ctx, op, entryPoint)
+	if err != nil {
+		return common.Hash{}, fmt.Errorf("failed to estimate gas of user operation: %w", err)
+	}
+	estimate.apply(op)
+	if paymaster != nil {
+		pmData, perr := paymaster.GetPaymasterData(ctx, op, entryPoint, m.chainID)
+		if perr != nil {
+			return common.Hash{}, fmt.Errorf("failed to get paymaster data: %w", perr)
+		}
+		pmData.apply(op)
+	}
+	if err = op.Validate(); err != nil {
+		return common.Hash{}, fmt.Errorf("invalid user operation: %w", err)
+	}
+
+	opHash := op.Hash(entryPoint, m.chainID)
+	// SimpleAccount compatible accounts validate the signature of the EIP-191 message of the user operation hash
+	op.Signature, err = m.ks.SignMessage(ctx, key, opHash.Bytes())
+	if err != nil {
+		return common.Hash{}, fmt.Errorf("failed to sign user operation: %w", err)
+	}
+
+	hash, err := bundler.SendUserOperation(ctx, op, entryPoint)
+	if err != nil {
+		// the nonce might be stale if another sender uses the account
+		m.nonces.reset(account)
+		return common.Hash{}, fmt.Errorf("failed to send user operation of account %s: %w", account, err)
+	}
+	m.nonces.commit(account, nonce)
+	m.eng.Infow("Sent user operation", "hash", hash, "key", key, "account", account, "nonce", nonce)
+
+	now := time.Now()
+	status.Hash, status.Key, status.Account, status.Nonce = hash, key, account, nonce
+	status.State, status.SentAt, status.UpdatedAt = UserOperationPending, now, now
+	err = m.orm.InsertUserOperation(ctx, status)
+	if err != nil {
+		// the user operation was accepted by the bundler, so the hash is returned along with the error
+		return hash, fmt.Errorf("sent user operation %s, but failed to save it: %w", hash, err)
+	}
+	return hash, nil
+}
+
+// UserOperationStatus returns the status of a user operation sent by the Manager. It returns sql.ErrNoRows for unknown
+// user operations, and for the ones included or dropped longer than a week ago.
+func (m *Manager) UserOperationStatus(ctx context.Context, hash common.Hash) (UserOperationStatus, error) {
+	return m.orm.FindUserOperation(ctx, hash)
+}
+
+// TransactionStatus returns the status of the user operation sent by SendTxRequest with the idempotency key, and false
+// if there is none. Included user operations are unconfirmed, as they may still be reorged out.
+func (m *Manager) TransactionStatus(ctx context.Context, idempotencyKey string) (commontypes.TransactionStatus, bool, error) {
+	op, err := m.orm.FindUserOperationWithIdempotencyKey(ctx, idempotencyKey)
+	if errors.Is(err, sql.ErrNoRows) {
+		return commontypes.Unknown, false, nil
+	} else if err != nil {
+		return commontypes.Unknown, false, fmt.Errorf("failed to find user operation with IdempotencyKey %s: %w", idempotencyKey, err)
+	}
+	switch op.State {
+	case UserOperationPending:
+		return commontypes.Pending, true, nil
+	case UserOperationSucceeded:
+		return commontypes.Unconfirmed, true, nil
+	case UserOperationReverted:
+		return commontypes.Failed, true, fmt.Errorf("user operation %s reverted: %s", op.Hash, op.Receipt.Reason)
+	default:
+		return commontypes.Failed, true, fmt.Errorf("user operation %s was dropped by the bundler", op.Hash)
+	}
+}
+
+func (m *Manager) pollReceipts(ctx context.Context) {
+	pending, err := m.orm.PendingUserOperations(ctx)
+	if err != nil {
+		m.eng.Errorw("Failed to load pending user operations", "err", err)
+		return
+	}
+
+	for _, status := range pending {
+		m.mu.RLock()
+		bundler := m.bundlers[status.Key]
+		m.mu.RUnlock()
+		if bundler == nil {
+			// the account of the key was removed from the config since the user operation was sent
+			m.eng.Warnw("No bundler for pending user operation", "hash", status.Hash, "key", status.Key)
+			continue
+		}
+		receipt, err := bundler.GetUserOperationReceipt(ctx, status.Hash)
+		if err != nil {
+			m.eng.Warnw("Failed to get user operation receipt", "hash", status.Hash, "err", err)
+			continue
+		}
+		if err = m.updateStatus(ctx, status, receipt); err != nil {
+			m.eng.Errorw("Failed to update user operation", "hash", status.Hash, "err", err)
+		}
+	}
+
+	m.resumeCallbacks(ctx)
+
+	if _, err = m.orm.DeleteUserOperationsBefore(ctx, time.Now().Add(-resultRetention)); err != nil {
+		m.eng.Errorw("Failed to prune user operations", "err", err)
+	}
+}
+
+// resumeCallbacks resumes the pipeline runs of the user operations included with enough confirmations, as the
+// Confirmer does for transactions, and fails the ones of dropped user operations
+func (m *Manager) resumeCallbacks(ctx context.Context) {
+	m.mu.RLock()
+	resume := m.resumeCallback
+	m.mu.RUnlock()
+	if resume == nil {
+		return
+	}
+	ops, err := m.orm.UserOperationsPendingCallback(ctx)
+	if err != nil {
+		m.eng.Errorw("Failed to load user operations pending callback", "err", err)
+		return
+	}
+	if len(ops) == 0 {
+		return
+	}
+	latest, err := m.client.LatestBlockHeight(ctx)
+	if err != nil {
+		m.eng.Warnw("Failed to get latest block height to resume pipeline runs", "err", err)
+		return
+	}
+
+	for _, op := range ops {
+		var output interface{}
+		var taskErr error
+		switch op.State {
+		case UserOperationDropped:
+			taskErr = fmt.Errorf("user operation %s was dropped by the bundler", op.Hash)
+		case UserOperationReverted:
+			if op.FailOnRevert {
+				taskErr = fmt.Errorf("user operation %s reverted on-chain: %s", op.Hash, op.Receipt.Reason)
+			} else {
+				output = op.Receipt
+			}
+		default:
+			output = op.Receipt
+		}
+		if op.Receipt != nil && op.Receipt.Receipt.BlockNumber != nil {
+			confirmedAt := new(big.Int).Add(op.Receipt.Receipt.BlockNumber.ToInt(), new(big.Int).SetUint64(uint64(op.MinConfirmations)))
+			if confirmedAt.Cmp(latest) > 0 {
+				continue
+			}
+		}
+
+		m.eng.Debugw("Callback: resuming pipeline run of user operation", "hash", op.Hash, "output", output, "taskErr", taskErr, "pipelineTaskRunID", op.PipelineTaskRunID.UUID)
+		if err = resume(ctx, op.PipelineTaskRunID.UUID, output, taskErr); err != nil {
+			m.eng.Errorw("Failed to resume pipeline run of user operation", "hash", op.Hash, "err", err)
+			continue
+		}
+		if err = m.orm.UpdateUserOperationCallbackCompleted(ctx, op.Hash); err != nil {
+			m.eng.Errorw("Failed to mark callback completed for user operation", "hash", op.Hash, "err", err)
+		}
+	}
+}
+
+func (m *Manager) updateStatus(ctx context.Context, op UserOperationStatus, receipt *UserOperationReceipt) error {
+	now := time.Now()
+	switch {
+	case receipt == nil && now.Sub(op.SentAt) > dropTimeout:
+		op.State = UserOperationDropped
+		// the nonce of the dropped user operation is free again
+		m.nonces.reset(op.Account)
+		m.eng.Warnw("User operation was not included in time, marking as dropped", "hash", op.Hash, "account", op.Account, "nonce", op.Nonce)
+	case receipt == nil:
+		return nil
+	case receipt.Success:
+		op.State = UserOperationSucceeded
+		op.Receipt = receipt
+	default:
+		op.State = UserOperationReverted
+		op.Receipt = receipt
+		m.eng.Warnw("User operation reverted", "hash", op.Hash, "account", op.Account, "reason", receipt.Reason)
+	}
+	op.UpdatedAt = now
+	return m.orm.UpdateUserOperation(ctx, op)
+}
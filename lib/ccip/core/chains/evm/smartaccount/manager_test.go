@@ -0,0 +1,618 @@
+package smartaccount
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"database/sql"
+	"errors"
+	"math/big"
+	"net/url"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/guregu/null.v4"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/logger"
+	"github.com/smartcontractkit/chainlink-common/pkg/services/servicetest"
+	commontypes "github.com/smartcontractkit/chainlink-common/pkg/types"
+	clnull "github.com/smartcontractkit/chainlink-common/pkg/utils/null"
+
+	feetypes "github.com/smartcontractkit/chainlink/v2/common/fee/types"
+	txmgrtypes "github.com/smartcontractkit/chainlink/v2/common/txmgr/types"
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/assets"
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/config"
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/config/toml"
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/gas"
+)
+
+var testChainID = big.NewInt(11155111)
+
+type testSmartAccount struct {
+	key, account, entryPoint common.Address
+	mode                     string
+	bundlerURL, pmURL        *url.URL
+}
+
+func (a testSmartAccount) Key() common.Address        { return a.key }
+func (a testSmartAccount) Account() common.Address    { return a.account }
+func (a testSmartAccount) Mode() string               { return a.mode }
+func (a testSmartAccount) EntryPoint() common.Address { return a.entryPoint }
+func (a testSmartAccount) BundlerURL() *url.URL       { return a.bundlerURL }
+func (a testSmartAccount) PaymasterURL() *url.URL     { return a.pmURL }
+
+type fakeClient struct {
+	nonce  *big.Int
+	calls  int
+	height int64
+}
+
+func (c *fakeClient) ConfiguredChainID() *big.Int { return testChainID }
+
+func (c *fakeClient) LatestBlockHeight(context.Context) (*big.Int, error) {
+	return big.NewInt(c.height), nil
+}
+
+func (c *fakeClient) CallContract(_ context.Context, msg ethereum.CallMsg, _ *big.Int) ([]byte, error) {
+	c.calls++
+	if *msg.To != testEntryPoint {
+		return nil, errors.New("unexpected call")
+	}
+	return entryPointABI.Methods["getNonce"].Outputs.Pack(c.nonce)
+}
+
+type fakeKeyStore struct {
+	pk      *ecdsa.PrivateKey
+	address common.Address
+}
+
+func newFakeKeyStore(t *testing.T) *fakeKeyStore {
+	pk, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	return &fakeKeyStore{pk: pk, address: crypto.PubkeyToAddress(pk.PublicKey)}
+}
+
+func (ks *fakeKeyStore) SignMessage(_ context.Context, address common.Address, message []byte) ([]byte, error) {
+	if address != ks.address {
+		return nil, errors.New("unknown key")
+	}
+	sig, err := crypto.Sign(accounts.TextHash(message), ks.pk)
+	if err != nil {
+		return nil, err
+	}
+	sig[crypto.RecoveryIDOffset] += 27
+	return sig, nil
+}
+
+type fakeEstimator struct{}
+
+func (fakeEstimator) GetFee(_ context.Context, _ []byte, feeLimit uint64, _ *assets.Wei, _, _ *common.Address, _ ...feetypes.Opt) (gas.EvmFee, uint64, error) {
+	return gas.EvmFee{DynamicFeeCap: assets.GWei(30), DynamicTipCap: assets.GWei(1)}, feeLimit, nil
+}
+
+type fakeFeeConfig struct{}
+
+func (fakeFeeConfig) LimitDefault() uint64                   { return 500_000 }
+func (fakeFeeConfig) PriceMaxKey(common.Address) *assets.Wei { return assets.GWei(100) }
+
+type fakeBundler struct {
+	mu       sync.Mutex
+	sent     []UserOperation
+	sendErr  error
+	receipts map[common.Hash]*UserOperationReceipt
+}
+
+func (b *fakeBundler) EstimateUserOperationGas(_ context.Context, op *UserOperation, entryPoint common.Address) (UserOperationGasEstimate, error) {
+	if entryPoint != testEntryPoint {
+		return UserOperationGasEstimate{}, errors.New("unsupported entry point")
+	}
+	return UserOperationGasEstimate{
+		PreVerificationGas:   (*hexutil.Big)(big.NewInt(50_000)),
+		VerificationGasLimit: (*hexutil.Big)(big.NewInt(150_000)),
+		CallGasLimit:         (*hexutil.Big)(big.NewInt(80_000)),
+	}, nil
+}
+
+func (b *fakeBundler) SendUserOperation(_ context.Context, op *UserOperation, entryPoint common.Address) (common.Hash, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if b.sendErr != nil {
+		return common.Hash{}, b.sendErr
+	}
+	b.sent = append(b.sent, *op)
+	return op.Hash(entryPoint, testChainID), nil
+}
+
+func (b *fakeBundler) GetUserOperationReceipt(_ context.Context, hash common.Hash) (*UserOperationReceipt, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.receipts[hash], nil
+}
+
+type fakePaymaster struct{}
+
+func (fakePaymaster) GetPaymasterStubData(context.Context, *UserOperation, common.Address, *big.Int) (PaymasterData, error) {
+	return PaymasterData{Paymaster: testPaymaster, PaymasterData: []byte{0x00}, PaymasterVerificationGasLimit: (*hexutil.Big)(big.NewInt(40_000))}, nil
+}
+
+func (fakePaymaster) GetPaymasterData(context.Context, *UserOperation, common.Address, *big.Int) (PaymasterData, error) {
+	return PaymasterData{Paymaster: testPaymaster, PaymasterData: []byte{0x01, 0x02}}, nil
+}
+
+type fakeORM struct {
+	mu  sync.Mutex
+	ops map[common.Hash]UserOperationStatus
+}
+
+func newFakeORM() *fakeORM {
+	return &fakeORM{ops: make(map[common.Hash]UserOperationStatus)}
+}
+
+func (o *fakeORM) InsertUserOperation(_ context.Context, status UserOperationStatus) error {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	o.ops[status.Hash] = status
+	return nil
+}
+
+func (o *fakeORM) UpdateUserOperation(_ context.Context, status UserOperationStatus) error {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	o.ops[status.Hash] = status
+	return nil
+}
+
+func (o *fakeORM) FindUserOperation(_ context.Context, hash common.Hash) (UserOperationStatus, error) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	status, ok := o.ops[hash]
+	if !ok {
+		return UserOperationStatus{}, sql.ErrNoRows
+	}
+	return status, nil
+}
+
+func (o *fakeORM) FindUserOperationWithIdempotencyKey(_ context.Context, key string) (UserOperationStatus, error) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	for _, status := range o.ops {
+		if status.IdempotencyKey != nil && *status.IdempotencyKey == key {
+			return status, nil
+		}
+	}
+	return UserOperationStatus{}, sql.ErrNoRows
+}
+
+func (o *fakeORM) UserOperationsPendingCallback(context.Context) ([]UserOperationStatus, error) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	var pending []UserOperationStatus
+	for _, status := range o.ops {
+		if status.State != UserOperationPending && status.SignalCallback && !status.CallbackCompleted && status.PipelineTaskRunID.Valid {
+			pending = append(pending, status)
+		}
+	}
+	return pending, nil
+}
+
+func (o *fakeORM) UpdateUserOperationCallbackCompleted(_ context.Context, hash common.Hash) error {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	status := o.ops[hash]
+	status.CallbackCompleted = true
+	o.ops[hash] = status
+	return nil
+}
+
+func (o *fakeORM) PendingUserOperations(context.Context) ([]UserOperationStatus, error) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	var pending []UserOperationStatus
+	for _, status := range o.ops {
+		if status.State == UserOperationPending {
+			pending = append(pending, status)
+		}
+	}
+	return pending, nil
+}
+
+func (o *fakeORM) DeleteUserOperationsBefore(_ context.Context, before time.Time) (int64, error) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	var n int64
+	for hash, status := range o.ops {
+		if status.State != UserOperationPending && status.UpdatedAt.Before(before) && (!status.SignalCallback || status.CallbackCompleted) {
+			delete(o.ops, hash)
+			n++
+		}
+	}
+	return n, nil
+}
+
+func (o *fakeORM) update(hash common.Hash, fn func(*UserOperationStatus)) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	status := o.ops[hash]
+	fn(&status)
+	o.ops[hash] = status
+}
+
+func newTestManager(t *testing.T, ks *fakeKeyStore, accts ...config.SmartAccount) (*Manager, *fakeClient, *fakeBundler) {
+	return newTestManagerWithORM(t, newFakeORM(), ks, accts...)
+}
+
+func newTestManagerWithORM(t *testing.T, orm ORM, ks *fakeKeyStore, accts ...config.SmartAccount) (*Manager, *fakeClient, *fakeBundler) {
+	client := &fakeClient{nonce: big.NewInt(3)}
+	m := NewManager(logger.Test(t), orm, client, ks, fakeEstimator{}, fakeFeeConfig{}, accts)
+	bundler := &fakeBundler{receipts: make(map[common.Hash]*UserOperationReceipt)}
+	for _, a := range accts {
+		if a.Mode() == toml.SmartAccountModeERC4337 {
+			m.bundlers[a.Key()] = bundler
+		}
+	}
+	return m, client, bundler
+}
+
+func newTestERC4337Account(ks *fakeKeyStore) testSmartAccount {
+	return testSmartAccount{key: ks.address, account: testAccount, entryPoint: testEntryPoint, mode: toml.SmartAccountModeERC4337}
+}
+
+func TestManager_ConvertTxRequest(t *testing.T) {
+	ks := newFakeKeyStore(t)
+	other := common.HexToAddress("0x2a3e23c6f242F5345320814aC8a1b4E58707D292")
+	erc4337Key := common.HexToAddress("0x8F1fD5d3A3B9C8a7e8B5aE5a6C8e4b3D2f1A0B9c")
+	m := NewManager(logger.Test(t), newFakeORM(), &fakeClient{}, ks, fakeEstimator{}, fakeFeeConfig{}, []config.SmartAccount{
+		testSmartAccount{key: ks.address, account: ks.address, mode: toml.SmartAccountModeEIP7702},
+		testSmartAccount{key: erc4337Key, account: testAccount, entryPoint: testEntryPoint, mode: toml.SmartAccountModeERC4337},
+	})
+
+	t.Run("converts requests of EIP7702 keys", func(t *testing.T) {
+		req := txmgrtypes.TxRequest[common.Address, common.Hash]{
+			FromAddress:    ks.address,
+			ToAddress:      testDest,
+			EncodedPayload: []byte{0x01, 0x02},
+			Value:          *big.NewInt(10),
+			Meta:           &txmgrtypes.TxMeta[common.Address, common.Hash]{JobID: ptr(int32(1))},
+		}
+		converted, err := m.ConvertTxRequest(req)
+		require.NoError(t, err)
+
+		assert.Equal(t, ks.address, converted.ToAddress)
+		assert.Equal(t, 0, converted.Value.Sign())
+		expected, err := EncodeExecute(testDest, big.NewInt(10), []byte{0x01, 0x02})
+		require.NoError(t, err)
+		assert.Equal(t, expected, converted.EncodedPayload)
+		require.NotNil(t, converted.Meta.FwdrDestAddress)
+		assert.Equal(t, testDest, *converted.Meta.FwdrDestAddress)
+		assert.Equal(t, int32(1), *converted.Meta.JobID)
+		// the meta of the caller is not modified
+		assert.Nil(t, req.Meta.FwdrDestAddress)
+	})
+
+	t.Run("rejects requests of ERC4337 keys", func(t *testing.T) {
+		req := txmgrtypes.TxRequest[common.Address, common.Hash]{FromAddress: erc4337Key, ToAddress: testDest, EncodedPayload: []byte{0x01}}
+		_, err := m.ConvertTxRequest(req)
+		require.ErrorContains(t, err, "requests must be sent as user operations")
+
+		account, ok := m.UserOperationAccount(erc4337Key)
+		require.True(t, ok)
+		assert.Equal(t, testAccount, account)
+		assert.True(t, m.SendsUserOperations(erc4337Key))
+		_, ok = m.UserOperationAccount(ks.address)
+		assert.False(t, ok)
+		assert.False(t, m.SendsUserOperations(ks.address))
+	})
+
+	t.Run("leaves requests of other keys unchanged", func(t *testing.T) {
+		req := txmgrtypes.TxRequest[common.Address, common.Hash]{FromAddress: other, ToAddress: testDest, EncodedPayload: []byte{0x01}}
+		converted, err := m.ConvertTxRequest(req)
+		require.NoError(t, err)
+		assert.Equal(t, req, converted)
+	})
+}
+
+func TestManager_SendUserOperation(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("signs and sends user operations with consecutive nonces", func(t *testing.T) {
+		ks := newFakeKeyStore(t)
+		m, client, bundler := newTestManager(t, ks, newTestERC4337Account(ks))
+		m.SetPaymaster(ks.address, fakePaymaster{})
+		servicetest.Run(t, m)
+
+		hash, err := m.SendUserOperation(ctx, ks.address, testDest, big.NewInt(1), []byte{0xab})
+		require.NoError(t, err)
+		_, err = m.SendUserOperation(ctx, ks.address, testDest, nil, []byte{0xcd})
+		require.NoError(t, err)
+
+		require.Len(t, bundler.sent, 2)
+		op := bundler.sent[0]
+		assert.Equal(t, hash, op.Hash(testEntryPoint, testChainID))
+		assert.Equal(t, big.NewInt(3), op.Nonce)
+		assert.Equal(t, big.NewInt(4), bundler.sent[1].Nonce)
+		assert.Equal(t, 1, client.calls)
+		assert.Equal(t, assets.GWei(30).ToInt(), op.MaxFeePerGas)
+		assert.Equal(t, big.NewInt(80_000), op.CallGasLimit)
+		assert.Equal(t, testPaymaster, *op.Paymaster)
+		assert.Equal(t, []byte{0x01, 0x02}, op.PaymasterData)
+		assert.Equal(t, big.NewInt(40_000), op.PaymasterVerificationGasLimit)
+
+		pub, err := crypto.SigToPub(accounts.TextHash(hash.Bytes()), append(op.Signature[:64:64], op.Signature[64]-27))
+		require.NoError(t, err)
+		assert.Equal(t, ks.address, crypto.PubkeyToAddress(*pub))
+
+		status, err := m.UserOperationStatus(ctx, hash)
+		require.NoError(t, err)
+		assert.Equal(t, UserOperationPending, status.State)
+		assert.Equal(t, testAccount, status.Account)
+	})
+
+	t.Run("resets the nonce if the bundler rejects the user operation", func(t *testing.T) {
+		ks := newFakeKeyStore(t)
+		m, client, bundler := newTestManager(t, ks, newTestERC4337Account(ks))
+		servicetest.Run(t, m)
+
+		bundler.sendErr = errors.New("AA25 invalid account nonce")
+		_, err := m.SendUserOperation(ctx, ks.address, testDest, nil, nil)
+		require.ErrorContains(t, err, "AA25 invalid account nonce")
+
+		bundler.sendErr = nil
+		client.nonce = big.NewInt(9)
+		_, err = m.SendUserOperation(ctx, ks.address, testDest, nil, nil)
+		require.NoError(t, err)
+		assert.Equal(t, big.NewInt(9), bundler.sent[0].Nonce)
+		assert.Equal(t, 2, client.calls)
+	})
+
+	t.Run("rejects keys without an ERC4337 account", func(t *testing.T) {
+		m, _, _ := newTestManager(t, newFakeKeyStore(t))
+		_, err := m.SendUserOperation(ctx, testDest, testDest, nil, nil)
+		require.ErrorIs(t, err, ErrUnknownAccount)
+	})
+}
+
+func TestManager_SendTxRequest(t *testing.T) {
+	ctx := context.Background()
+	ks := newFakeKeyStore(t)
+	m, _, bundler := newTestManager(t, ks, newTestERC4337Account(ks))
+	servicetest.Run(t, m)
+
+	runID := uuid.New()
+	req := txmgrtypes.TxRequest[common.Address, common.Hash]{
+		IdempotencyKey:    ptr("key"),
+		FromAddress:       ks.address,
+		ToAddress:         testDest,
+		EncodedPayload:    []byte{0xab},
+		Value:             *big.NewInt(5),
+		PipelineTaskRunID: &runID,
+		MinConfirmations:  clnull.Uint32From(2),
+		SignalCallback:    true,
+		Meta:              &txmgrtypes.TxMeta[common.Address, common.Hash]{FailOnRevert: null.BoolFrom(true)},
+	}
+	hash, err := m.SendTxRequest(ctx, req)
+	require.NoError(t, err)
+	require.Len(t, bundler.sent, 1)
+	expected, err := EncodeExecute(testDest, big.NewInt(5), []byte{0xab})
+	require.NoError(t, err)
+	assert.Equal(t, expected, bundler.sent[0].CallData)
+
+	status, err := m.UserOperationStatus(ctx, hash)
+	require.NoError(t, err)
+	assert.Equal(t, "key", *status.IdempotencyKey)
+	assert.Equal(t, uuid.NullUUID{UUID: runID, Valid: true}, status.PipelineTaskRunID)
+	assert.Equal(t, uint32(2), status.MinConfirmations)
+	assert.True(t, status.SignalCallback)
+	assert.True(t, status.FailOnRevert)
+
+	t.Run("returns the user operation sent for the idempotency key", func(t *testing.T) {
+		again, err := m.SendTxRequest(ctx, req)
+		require.NoError(t, err)
+		assert.Equal(t, hash, again)
+		assert.Len(t, bundler.sent, 1)
+	})
+
+	t.Run("TransactionStatus", func(t *testing.T) {
+		txStatus, found, err := m.TransactionStatus(ctx, "key")
+		require.NoError(t, err)
+		require.True(t, found)
+		assert.Equal(t, commontypes.Pending, txStatus)
+
+		_, found, err = m.TransactionStatus(ctx, "unknown")
+		require.NoError(t, err)
+		assert.False(t, found)
+	})
+}
+
+func TestManager_resumeCallbacks(t *testing.T) {
+	ctx := context.Background()
+	ks := newFakeKeyStore(t)
+	orm := newFakeORM()
+	m, client, bundler := newTestManagerWithORM(t, orm, ks, newTestERC4337Account(ks))
+	servicetest.Run(t, m)
+
+	type resumed struct {
+		result interface{}
+		err    error
+	}
+	var mu sync.Mutex
+	calls := make(map[uuid.UUID]resumed)
+	m.SetResumeCallback(func(_ context.Context, id uuid.UUID, result interface{}, err error) error {
+		mu.Lock()
+		defer mu.Unlock()
+		calls[id] = resumed{result, err}
+		return nil
+	})
+	send := func(data byte, failOnRevert bool) (common.Hash, uuid.UUID) {
+		runID := uuid.New()
+		hash, err := m.SendTxRequest(ctx, txmgrtypes.TxRequest[common.Address, common.Hash]{
+			FromAddress:       ks.address,
+			ToAddress:         testDest,
+			EncodedPayload:    []byte{data},
+			PipelineTaskRunID: &runID,
+			MinConfirmations:  clnull.Uint32From(3),
+			SignalCallback:    true,
+			Meta:              &txmgrtypes.TxMeta[common.Address, common.Hash]{FailOnRevert: null.BoolFrom(failOnRevert)},
+		})
+		require.NoError(t, err)
+		return hash, runID
+	}
+	succeeded, succeededRun := send(0x01, true)
+	reverted, revertedRun := send(0x02, true)
+	revertedIgnored, revertedIgnoredRun := send(0x03, false)
+	dropped, droppedRun := send(0x04, true)
+
+	included := func(hash common.Hash, success bool) *UserOperationReceipt {
+		r := &UserOperationReceipt{UserOpHash: hash, Success: success, Reason: "0x"}
+		r.Receipt.BlockNumber = (*hexutil.Big)(big.NewInt(10))
+		return r
+	}
+	bundler.mu.Lock()
+	bundler.receipts[succeeded] = included(succeeded, true)
+	bundler.receipts[reverted] = included(reverted, false)
+	bundler.receipts[revertedIgnored] = included(revertedIgnored, false)
+	bundler.mu.Unlock()
+	orm.update(dropped, func(status *UserOperationStatus) { status.SentAt = time.Now().Add(-dropTimeout - time.Minute) })
+
+	// dropped user operations fail their run right away, included ones wait for the confirmations
+	client.height = 12
+	m.pollReceipts(ctx)
+	require.Len(t, calls, 1)
+	require.ErrorContains(t, calls[droppedRun].err, "dropped")
+
+	client.height = 13
+	m.pollReceipts(ctx)
+	require.Len(t, calls, 4)
+	require.NoError(t, calls[succeededRun].err)
+	assert.Equal(t, succeeded, calls[succeededRun].result.(*UserOperationReceipt).UserOpHash)
+	require.ErrorContains(t, calls[revertedRun].err, "reverted")
+	require.NoError(t, calls[revertedIgnoredRun].err)
+	assert.NotNil(t, calls[revertedIgnoredRun].result)
+
+	// runs are resumed only once
+	calls = make(map[uuid.UUID]resumed)
+	m.pollReceipts(ctx)
+	assert.Empty(t, calls)
+}
+
+func TestManager_pollReceipts(t *testing.T) {
+	ctx := context.Background()
+	ks := newFakeKeyStore(t)
+	orm := newFakeORM()
+	m, client, bundler := newTestManagerWithORM(t, orm, ks, newTestERC4337Account(ks))
+	servicetest.Run(t, m)
+
+	succeeded, err := m.SendUserOperation(ctx, ks.address, testDest, nil, []byte{0x01})
+	require.NoError(t, err)
+	reverted, err := m.SendUserOperation(ctx, ks.address, testDest, nil, []byte{0x02})
+	require.NoError(t, err)
+	dropped, err := m.SendUserOperation(ctx, ks.address, testDest, nil, []byte{0x03})
+	require.NoError(t, err)
+
+	bundler.mu.Lock()
+	bundler.receipts[succeeded] = &UserOperationReceipt{UserOpHash: succeeded, Success: true}
+	bundler.receipts[reverted] = &UserOperationReceipt{UserOpHash: reverted, Reason: "0x"}
+	bundler.mu.Unlock()
+	orm.update(dropped, func(status *UserOperationStatus) { status.SentAt = time.Now().Add(-dropTimeout - time.Minute) })
+
+	m.pollReceipts(ctx)
+
+	status, err := m.UserOperationStatus(ctx, succeeded)
+	require.NoError(t, err)
+	assert.Equal(t, UserOperationSucceeded, status.State)
+	assert.True(t, status.Receipt.Success)
+	status, err = m.UserOperationStatus(ctx, reverted)
+	require.NoError(t, err)
+	assert.Equal(t, UserOperationReverted, status.State)
+	status, err = m.UserOperationStatus(ctx, dropped)
+	require.NoError(t, err)
+	assert.Equal(t, UserOperationDropped, status.State)
+
+	// the nonce is read again from the entry point after a user operation is dropped
+	_, err = m.SendUserOperation(ctx, ks.address, testDest, nil, nil)
+	require.NoError(t, err)
+	assert.Equal(t, 2, client.calls)
+
+	// results are pruned after the retention period
+	orm.update(succeeded, func(status *UserOperationStatus) { status.UpdatedAt = time.Now().Add(-resultRetention - time.Minute) })
+	m.pollReceipts(ctx)
+	_, err = m.UserOperationStatus(ctx, succeeded)
+	require.ErrorIs(t, err, sql.ErrNoRows)
+	_, err = m.UserOperationStatus(ctx, reverted)
+	require.NoError(t, err)
+}
+
+func TestManager_restart(t *testing.T) {
+	ctx := context.Background()
+	ks := newFakeKeyStore(t)
+	orm := newFakeORM()
+
+	m, _, bundler := newTestManagerWithORM(t, orm, ks, newTestERC4337Account(ks))
+	require.NoError(t, m.Start(ctx))
+	pending, err := m.SendUserOperation(ctx, ks.address, testDest, nil, []byte{0x01})
+	require.NoError(t, err)
+	require.NoError(t, m.Close())
+
+	// the entry point nonce does not include the pending user operation yet
+	restarted, client, restartedBundler := newTestManagerWithORM(t, orm, ks, newTestERC4337Account(ks))
+	servicetest.Run(t, restarted)
+	_, err = restarted.SendUserOperation(ctx, ks.address, testDest, nil, []byte{0x02})
+	require.NoError(t, err)
+	assert.Equal(t, big.NewInt(4), restartedBundler.sent[0].Nonce)
+	assert.Equal(t, 0, client.calls)
+
+	// the user operation sent before the restart is still tracked
+	restartedBundler.mu.Lock()
+	restartedBundler.receipts[pending] = &UserOperationReceipt{UserOpHash: pending, Success: true}
+	restartedBundler.mu.Unlock()
+	restarted.pollReceipts(ctx)
+	status, err := restarted.UserOperationStatus(ctx, pending)
+	require.NoError(t, err)
+	assert.Equal(t, UserOperationSucceeded, status.State)
+	assert.Len(t, bundler.sent, 1)
+}
+
+type fakeBundlerRPC struct {
+	received []UserOperation
+}
+
+func (f *fakeBundlerRPC) SendUserOperation(op UserOperation, entryPoint common.Address) (common.Hash, error) {
+	f.received = append(f.received, op)
+	return op.Hash(entryPoint, testChainID), nil
+}
+
+func (f *fakeBundlerRPC) GetUserOperationReceipt(hash common.Hash) (*UserOperationReceipt, error) {
+	return nil, nil
+}
+
+func TestBundlerClient(t *testing.T) {
+	ctx := context.Background()
+	server := rpc.NewServer()
+	t.Cleanup(server.Stop)
+	fake := &fakeBundlerRPC{}
+	require.NoError(t, server.RegisterName("eth", fake))
+	c := rpc.DialInProc(server)
+	t.Cleanup(c.Close)
+	bundler := NewBundlerClient(c)
+
+	op := newTestUserOperation()
+	hash, err := bundler.SendUserOperation(ctx, op, testEntryPoint)
+	require.NoError(t, err)
+	assert.Equal(t, op.Hash(testEntryPoint, testChainID), hash)
+	require.Len(t, fake.received, 1)
+	assert.Equal(t, *op, fake.received[0])
+
+	receipt, err := bundler.GetUserOperationReceipt(ctx, hash)
+	require.NoError(t, err)
+	assert.Nil(t, receipt)
+}
+
+func ptr[T any](t T) *T { return &t }
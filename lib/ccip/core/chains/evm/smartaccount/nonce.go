@@ -0,0 +1,81 @@
+package smartaccount
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"sync"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// nonceKey is the key of the nonce sequence used for the user operations of the accounts. The EntryPoint keeps a
+// separate sequence per key, only the sequence of key 0 is used.
+var nonceKey = big.NewInt(0)
+
+type contractCaller interface {
+	CallContract(ctx context.Context, msg ethereum.CallMsg, blockNumber *big.Int) ([]byte, error)
+}
+
+// nonceManager hands out the EntryPoint nonces of the accounts. The nonce of an account is read from the EntryPoint on
+// its first user operation and incremented locally for the following ones, until it is reset.
+type nonceManager struct {
+	client contractCaller
+
+	mu     sync.Mutex
+	nonces map[common.Address]*big.Int
+}
+
+func newNonceManager(client contractCaller) *nonceManager {
+	return &nonceManager{client: client, nonces: make(map[common.Address]*big.Int)}
+}
+
+// next returns the nonce of the next user operation of account. The nonce is only consumed once the user operation is
+// accepted by the bundler, see commit.
+func (n *nonceManager) next(ctx context.Context, entryPoint, account common.Address) (*big.Int, error) {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	if nonce, ok := n.nonces[account]; ok {
+		return new(big.Int).Set(nonce), nil
+	}
+	nonce, err := n.fetch(ctx, entryPoint, account)
+	if err != nil {
+		return nil, err
+	}
+	n.nonces[account] = nonce
+	return new(big.Int).Set(nonce), nil
+}
+
+// commit marks nonce as used by account
+func (n *nonceManager) commit(account common.Address, nonce *big.Int) {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	if current, ok := n.nonces[account]; ok && current.Cmp(nonce) > 0 {
+		return
+	}
+	n.nonces[account] = new(big.Int).Add(nonce, big.NewInt(1))
+}
+
+// reset drops the local nonce of account, so that the next user operation reads it from the EntryPoint again
+func (n *nonceManager) reset(account common.Address) {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	delete(n.nonces, account)
+}
+
+func (n *nonceManager) fetch(ctx context.Context, entryPoint, account common.Address) (*big.Int, error) {
+	data, err := entryPointABI.Pack("getNonce", account, nonceKey)
+	if err != nil {
+		return nil, err
+	}
+	b, err := n.client.CallContract(ctx, ethereum.CallMsg{To: &entryPoint, Data: data}, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get the nonce of account %s from entry point %s: %w", account, entryPoint, err)
+	}
+	out, err := entryPointABI.Unpack("getNonce", b)
+	if err != nil {
+		return nil, fmt.Errorf("failed to unpack the nonce of account %s: %w", account, err)
+	}
+	return out[0].(*big.Int), nil
+}
@@ -0,0 +1,187 @@
+package smartaccount
+
+import (
+	"context"
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/google/uuid"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/sqlutil"
+
+	ubig "github.com/smartcontractkit/chainlink/v2/core/chains/evm/utils/big"
+)
+
+// ORM persists the user operations sent by the Manager, so that they are still tracked after a restart
+type ORM interface {
+	InsertUserOperation(ctx context.Context, status UserOperationStatus) error
+	UpdateUserOperation(ctx context.Context, status UserOperationStatus) error
+	FindUserOperation(ctx context.Context, hash common.Hash) (UserOperationStatus, error)
+	FindUserOperationWithIdempotencyKey(ctx context.Context, idempotencyKey string) (UserOperationStatus, error)
+	PendingUserOperations(ctx context.Context) ([]UserOperationStatus, error)
+	// UserOperationsPendingCallback returns the included or dropped user operations whose pipeline run has not been
+	// resumed yet
+	UserOperationsPendingCallback(ctx context.Context) ([]UserOperationStatus, error)
+	UpdateUserOperationCallbackCompleted(ctx context.Context, hash common.Hash) error
+	// DeleteUserOperationsBefore deletes the user operations which are no longer pending nor waiting to resume their
+	// pipeline run, and were last updated before the given time
+	DeleteUserOperationsBefore(ctx context.Context, before time.Time) (int64, error)
+}
+
+type DSORM struct {
+	chainID ubig.Big
+	ds      sqlutil.DataSource
+}
+
+var _ ORM = &DSORM{}
+
+func NewORM(chainID *big.Int, ds sqlutil.DataSource) *DSORM {
+	return &DSORM{chainID: *ubig.New(chainID), ds: ds}
+}
+
+const userOperationColumns = `hash, key_address, account, nonce, state, receipt, sent_at, updated_at, idempotency_key,
+pipeline_task_run_id, min_confirmations, signal_callback, fail_on_revert, callback_completed`
+
+type userOperationRow struct {
+	Hash              common.Hash
+	Key               common.Address `db:"key_address"`
+	Account           common.Address
+	Nonce             ubig.Big
+	State             UserOperationState
+	Receipt           *UserOperationReceipt
+	SentAt            time.Time
+	UpdatedAt         time.Time
+	IdempotencyKey    *string
+	PipelineTaskRunID uuid.NullUUID `db:"pipeline_task_run_id"`
+	MinConfirmations  int64
+	SignalCallback    bool
+	FailOnRevert      bool
+	CallbackCompleted bool
+}
+
+func (r userOperationRow) toStatus() UserOperationStatus {
+	return UserOperationStatus{
+		Hash:              r.Hash,
+		Key:               r.Key,
+		Account:           r.Account,
+		Nonce:             r.Nonce.ToInt(),
+		State:             r.State,
+		Receipt:           r.Receipt,
+		SentAt:            r.SentAt,
+		UpdatedAt:         r.UpdatedAt,
+		IdempotencyKey:    r.IdempotencyKey,
+		PipelineTaskRunID: r.PipelineTaskRunID,
+		MinConfirmations:  uint32(r.MinConfirmations),
+		SignalCallback:    r.SignalCallback,
+		FailOnRevert:      r.FailOnRevert,
+		CallbackCompleted: r.CallbackCompleted,
+	}
+}
+
+func (o *DSORM) InsertUserOperation(ctx context.Context, status UserOperationStatus) error {
+	_, err := o.ds.ExecContext(ctx, `INSERT INTO evm.user_operations (evm_chain_id, hash, key_address, account, nonce, state, receipt, sent_at, updated_at,
+idempotency_key, pipeline_task_run_id, min_confirmations, signal_callback, fail_on_revert)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14)`,
+		o.chainID, status.Hash, status.Key, status.Account, ubig.New(status.Nonce), status.State, status.Receipt, status.SentAt, status.UpdatedAt,
+		status.IdempotencyKey, status.PipelineTaskRunID, int64(status.MinConfirmations), status.SignalCallback, status.FailOnRevert)
+	if err != nil {
+		return fmt.Errorf("failed to insert user operation %s: %w", status.Hash, err)
+	}
+	return nil
+}
+
+func (o *DSORM) UpdateUserOperation(ctx context.Context, status UserOperationStatus) error {
+	_, err := o.ds.ExecContext(ctx, `UPDATE evm.user_operations SET state = $3, receipt = $4, updated_at = $5
+WHERE evm_chain_id = $1 AND hash = $2`,
+		o.chainID, status.Hash, status.State, status.Receipt, status.UpdatedAt)
+	if err != nil {
+		return fmt.Errorf("failed to update user operation %s: %w", status.Hash, err)
+	}
+	return nil
+}
+
+func (o *DSORM) FindUserOperation(ctx context.Context, hash common.Hash) (UserOperationStatus, error) {
+	var row userOperationRow
+	err := o.ds.GetContext(ctx, &row, `SELECT `+userOperationColumns+`
+FROM evm.user_operations WHERE evm_chain_id = $1 AND hash = $2`, o.chainID, hash)
+	if err != nil {
+		return UserOperationStatus{}, err
+	}
+	return row.toStatus(), nil
+}
+
+func (o *DSORM) FindUserOperationWithIdempotencyKey(ctx context.Context, idempotencyKey string) (UserOperationStatus, error) {
+	var row userOperationRow
+	err := o.ds.GetContext(ctx, &row, `SELECT `+userOperationColumns+`
+FROM evm.user_operations WHERE evm_chain_id = $1 AND idempotency_key = $2`, o.chainID, idempotencyKey)
+	if err != nil {
+		return UserOperationStatus{}, err
+	}
+	return row.toStatus(), nil
+}
+
+func (o *DSORM) PendingUserOperations(ctx context.Context) ([]UserOperationStatus, error) {
+	var rows []userOperationRow
+	err := o.ds.SelectContext(ctx, &rows, `SELECT `+userOperationColumns+`
+FROM evm.user_operations WHERE evm_chain_id = $1 AND state = $2 ORDER BY account, nonce`, o.chainID, UserOperationPending)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load pending user operations: %w", err)
+	}
+	return toStatuses(rows), nil
+}
+
+func (o *DSORM) UserOperationsPendingCallback(ctx context.Context) ([]UserOperationStatus, error) {
+	var rows []userOperationRow
+	err := o.ds.SelectContext(ctx, &rows, `SELECT `+userOperationColumns+`
+FROM evm.user_operations WHERE evm_chain_id = $1 AND signal_callback AND NOT callback_completed AND pipeline_task_run_id IS NOT NULL
+AND state <> $2`, o.chainID, UserOperationPending)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load user operations pending callback: %w", err)
+	}
+	return toStatuses(rows), nil
+}
+
+func (o *DSORM) UpdateUserOperationCallbackCompleted(ctx context.Context, hash common.Hash) error {
+	_, err := o.ds.ExecContext(ctx, `UPDATE evm.user_operations SET callback_completed = TRUE WHERE evm_chain_id = $1 AND hash = $2`, o.chainID, hash)
+	if err != nil {
+		return fmt.Errorf("failed to mark callback completed for user operation %s: %w", hash, err)
+	}
+	return nil
+}
+
+func toStatuses(rows []userOperationRow) []UserOperationStatus {
+	statuses := make([]UserOperationStatus, len(rows))
+	for i, row := range rows {
+		statuses[i] = row.toStatus()
+	}
+	return statuses
+}
+
+func (o *DSORM) DeleteUserOperationsBefore(ctx context.Context, before time.Time) (int64, error) {
+	res, err := o.ds.ExecContext(ctx, `DELETE FROM evm.user_operations WHERE evm_chain_id = $1 AND state <> $2 AND updated_at < $3
+AND (NOT signal_callback OR callback_completed)`,
+		o.chainID, UserOperationPending, before)
+	if err != nil {
+		return 0, fmt.Errorf("failed to delete user operations: %w", err)
+	}
+	return res.RowsAffected()
+}
+
+func (r UserOperationReceipt) Value() (driver.Value, error) {
+	return json.Marshal(r)
+}
+
+func (r *UserOperationReceipt) Scan(value interface{}) error {
+	switch v := value.(type) {
+	case []byte:
+		return json.Unmarshal(v, r)
+	case string:
+		return json.Unmarshal([]byte(v), r)
+	default:
+		return fmt.Errorf("unable to convert %v of %T to UserOperationReceipt", value, value)
+	}
+}
@@ -0,0 +1,111 @@
+package smartaccount
+
+import (
+	"database/sql"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	evmutils "github.com/smartcontractkit/chainlink/v2/core/chains/evm/utils"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils/pgtest"
+)
+
+func TestORM(t *testing.T) {
+	t.Parallel()
+	ctx := testutils.Context(t)
+	db := pgtest.NewSqlxDB(t)
+	orm := NewORM(testutils.FixtureChainID, db)
+	otherChain := NewORM(big.NewInt(1337), db)
+
+	now := time.Now().Truncate(time.Microsecond)
+	pending := UserOperationStatus{
+		Hash:      evmutils.NewHash(),
+		Key:       testutils.NewAddress(),
+		Account:   testAccount,
+		Nonce:     big.NewInt(7),
+		State:     UserOperationPending,
+		SentAt:    now,
+		UpdatedAt: now,
+	}
+	included := pending
+	included.Hash, included.Nonce = evmutils.NewHash(), big.NewInt(6)
+	require.NoError(t, orm.InsertUserOperation(ctx, pending))
+	require.NoError(t, orm.InsertUserOperation(ctx, included))
+	require.Error(t, orm.InsertUserOperation(ctx, pending))
+
+	included.State = UserOperationSucceeded
+	included.Receipt = &UserOperationReceipt{UserOpHash: included.Hash, Sender: testAccount, ActualGasUsed: (*hexutil.Big)(big.NewInt(21_000)), Success: true}
+	included.UpdatedAt = now.Add(-2 * time.Hour)
+	require.NoError(t, orm.UpdateUserOperation(ctx, included))
+
+	found, err := orm.FindUserOperation(ctx, included.Hash)
+	require.NoError(t, err)
+	assert.Equal(t, UserOperationSucceeded, found.State)
+	assert.Equal(t, big.NewInt(6), found.Nonce)
+	require.NotNil(t, found.Receipt)
+	assert.Equal(t, included.Receipt.ActualGasUsed, found.Receipt.ActualGasUsed)
+	assert.True(t, found.Receipt.Success)
+
+	ops, err := orm.PendingUserOperations(ctx)
+	require.NoError(t, err)
+	require.Len(t, ops, 1)
+	assert.Equal(t, pending.Hash, ops[0].Hash)
+	assert.Equal(t, pending.Key, ops[0].Key)
+	assert.Nil(t, ops[0].Receipt)
+	ops, err = otherChain.PendingUserOperations(ctx)
+	require.NoError(t, err)
+	assert.Empty(t, ops)
+
+	// pending user operations are never deleted
+	deleted, err := orm.DeleteUserOperationsBefore(ctx, now.Add(time.Hour))
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), deleted)
+	_, err = orm.FindUserOperation(ctx, included.Hash)
+	require.ErrorIs(t, err, sql.ErrNoRows)
+	_, err = orm.FindUserOperation(ctx, pending.Hash)
+	require.NoError(t, err)
+
+	t.Run("callbacks", func(t *testing.T) {
+		runID := uuid.New()
+		waiting := pending
+		waiting.Hash, waiting.Nonce, waiting.State = evmutils.NewHash(), big.NewInt(8), UserOperationSucceeded
+		waiting.IdempotencyKey = ptr("idempotency-key")
+		waiting.PipelineTaskRunID = uuid.NullUUID{UUID: runID, Valid: true}
+		waiting.MinConfirmations, waiting.SignalCallback, waiting.FailOnRevert = 3, true, true
+		waiting.UpdatedAt = now.Add(-2 * time.Hour)
+		require.NoError(t, orm.InsertUserOperation(ctx, waiting))
+
+		found, err := orm.FindUserOperationWithIdempotencyKey(ctx, "idempotency-key")
+		require.NoError(t, err)
+		assert.Equal(t, waiting.Hash, found.Hash)
+		assert.Equal(t, waiting.PipelineTaskRunID, found.PipelineTaskRunID)
+		assert.Equal(t, uint32(3), found.MinConfirmations)
+		assert.True(t, found.FailOnRevert)
+		_, err = otherChain.FindUserOperationWithIdempotencyKey(ctx, "idempotency-key")
+		require.ErrorIs(t, err, sql.ErrNoRows)
+
+		ops, err := orm.UserOperationsPendingCallback(ctx)
+		require.NoError(t, err)
+		require.Len(t, ops, 1)
+		assert.Equal(t, waiting.Hash, ops[0].Hash)
+
+		// user operations are kept until their run is resumed
+		deleted, err := orm.DeleteUserOperationsBefore(ctx, now.Add(time.Hour))
+		require.NoError(t, err)
+		assert.Equal(t, int64(0), deleted)
+
+		require.NoError(t, orm.UpdateUserOperationCallbackCompleted(ctx, waiting.Hash))
+		ops, err = orm.UserOperationsPendingCallback(ctx)
+		require.NoError(t, err)
+		assert.Empty(t, ops)
+		deleted, err = orm.DeleteUserOperationsBefore(ctx, now.Add(time.Hour))
+		require.NoError(t, err)
+		assert.Equal(t, int64(1), deleted)
+	})
+}
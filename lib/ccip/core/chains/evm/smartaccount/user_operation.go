@@ -0,0 +1,249 @@
+package smartaccount
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+// dummySignature is the signature of user operations being estimated. It has the length of an ECDSA signature and does
+// not revert on recovery, so that the validation of the account uses as much gas as it would with the real signature.
+var dummySignature = hexutil.MustDecode("0xfffffffffffffffffffffffffffffff0000000000000000000000000000000007aaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa1c")
+
+var (
+	// accountABI is the execution function of SimpleAccount compatible accounts, which most ERC-4337 accounts and
+	// EIP-7702 delegates implement
+	accountABI = mustParseABI(`[{"type":"function","name":"execute","stateMutability":"nonpayable","inputs":[{"name":"dest","type":"address"},{"name":"value","type":"uint256"},{"name":"func","type":"bytes"}],"outputs":[]}]`)
+	// entryPointABI is the nonce getter of the ERC-4337 EntryPoint
+	entryPointABI = mustParseABI(`[{"type":"function","name":"getNonce","stateMutability":"view","inputs":[{"name":"sender","type":"address"},{"name":"key","type":"uint192"}],"outputs":[{"name":"nonce","type":"uint256"}]}]`)
+)
+
+func mustParseABI(s string) abi.ABI {
+	a, err := abi.JSON(strings.NewReader(s))
+	if err != nil {
+		panic(err)
+	}
+	return a
+}
+
+// EncodeExecute encodes the call of the account which executes a call of to with value and data
+func EncodeExecute(to common.Address, value *big.Int, data []byte) ([]byte, error) {
+	if value == nil {
+		value = big.NewInt(0)
+	}
+	return accountABI.Pack("execute", to, value, data)
+}
+
+// UserOperation is an ERC-4337 user operation of the EntryPoint v0.7, in the unpacked form used by the bundler RPC
+type UserOperation struct {
+	Sender                        common.Address
+	Nonce                         *big.Int
+	Factory                       *common.Address
+	FactoryData                   []byte
+	CallData                      []byte
+	CallGasLimit                  *big.Int
+	VerificationGasLimit          *big.Int
+	PreVerificationGas            *big.Int
+	MaxFeePerGas                  *big.Int
+	MaxPriorityFeePerGas          *big.Int
+	Paymaster                     *common.Address
+	PaymasterVerificationGasLimit *big.Int
+	PaymasterPostOpGasLimit       *big.Int
+	PaymasterData                 []byte
+	Signature                     []byte
+}
+
+type rpcUserOperation struct {
+	Sender                        common.Address  `json:"sender"`
+	Nonce                         *hexutil.Big    `json:"nonce"`
+	Factory                       *common.Address `json:"factory,omitempty"`
+	FactoryData                   hexutil.Bytes   `json:"factoryData,omitempty"`
+	CallData                      hexutil.Bytes   `json:"callData"`
+	CallGasLimit                  *hexutil.Big    `json:"callGasLimit"`
+	VerificationGasLimit          *hexutil.Big    `json:"verificationGasLimit"`
+	PreVerificationGas            *hexutil.Big    `json:"preVerificationGas"`
+	MaxFeePerGas                  *hexutil.Big    `json:"maxFeePerGas"`
+	MaxPriorityFeePerGas          *hexutil.Big    `json:"maxPriorityFeePerGas"`
+	Paymaster                     *common.Address `json:"paymaster,omitempty"`
+	PaymasterVerificationGasLimit *hexutil.Big    `json:"paymasterVerificationGasLimit,omitempty"`
+	PaymasterPostOpGasLimit       *hexutil.Big    `json:"paymasterPostOpGasLimit,omitempty"`
+	PaymasterData                 hexutil.Bytes   `json:"paymasterData,omitempty"`
+	Signature                     hexutil.Bytes   `json:"signature"`
+}
+
+func (op UserOperation) MarshalJSON() ([]byte, error) {
+	return json.Marshal(rpcUserOperation{
+		Sender:                        op.Sender,
+		Nonce:                         hexBig(op.Nonce),
+		Factory:                       op.Factory,
+		FactoryData:                   op.FactoryData,
+		CallData:                      op.CallData,
+		CallGasLimit:                  hexBig(op.CallGasLimit),
+		VerificationGasLimit:          hexBig(op.VerificationGasLimit),
+		PreVerificationGas:            hexBig(op.PreVerificationGas),
+		MaxFeePerGas:                  hexBig(op.MaxFeePerGas),
+		MaxPriorityFeePerGas:          hexBig(op.MaxPriorityFeePerGas),
+		Paymaster:                     op.Paymaster,
+		PaymasterVerificationGasLimit: (*hexutil.Big)(op.PaymasterVerificationGasLimit),
+		PaymasterPostOpGasLimit:       (*hexutil.Big)(op.PaymasterPostOpGasLimit),
+		PaymasterData:                 op.PaymasterData,
+		Signature:                     op.Signature,
+	})
+}
+
+func (op *UserOperation) UnmarshalJSON(input []byte) error {
+	var rop rpcUserOperation
+	if err := json.Unmarshal(input, &rop); err != nil {
+		return err
+	}
+	*op = UserOperation{
+		Sender:                        rop.Sender,
+		Nonce:                         (*big.Int)(rop.Nonce),
+		Factory:                       rop.Factory,
+		FactoryData:                   rop.FactoryData,
+		CallData:                      rop.CallData,
+		CallGasLimit:                  (*big.Int)(rop.CallGasLimit),
+		VerificationGasLimit:          (*big.Int)(rop.VerificationGasLimit),
+		PreVerificationGas:            (*big.Int)(rop.PreVerificationGas),
+		MaxFeePerGas:                  (*big.Int)(rop.MaxFeePerGas),
+		MaxPriorityFeePerGas:          (*big.Int)(rop.MaxPriorityFeePerGas),
+		Paymaster:                     rop.Paymaster,
+		PaymasterVerificationGasLimit: (*big.Int)(rop.PaymasterVerificationGasLimit),
+		PaymasterPostOpGasLimit:       (*big.Int)(rop.PaymasterPostOpGasLimit),
+		PaymasterData:                 rop.PaymasterData,
+		Signature:                     rop.Signature,
+	}
+	return nil
+}
+
+// hexBig encodes nil as 0, since the bundler requires all the gas fields of the user operation
+func hexBig(i *big.Int) *hexutil.Big {
+	if i == nil {
+		return (*hexutil.Big)(big.NewInt(0))
+	}
+	return (*hexutil.Big)(i)
+}
+
+// initCode is the factory and its data, which deploy the account on its first user operation
+func (op *UserOperation) initCode() []byte {
+	if op.Factory == nil {
+		return nil
+	}
+	return append(op.Factory.Bytes(), op.FactoryData...)
+}
+
+// paymasterAndData is the paymaster, its gas limits and data, packed as in the PackedUserOperation of the EntryPoint
+func (op *UserOperation) paymasterAndData() []byte {
+	if op.Paymaster == nil {
+		return nil
+	}
+	b := op.Paymaster.Bytes()
+	b = append(b, uint128Bytes(op.PaymasterVerificationGasLimit)...)
+	b = append(b, uint128Bytes(op.PaymasterPostOpGasLimit)...)
+	return append(b, op.PaymasterData...)
+}
+
+func uint128Bytes(i *big.Int) []byte {
+	if i == nil {
+		return make([]byte, 16)
+	}
+	return common.LeftPadBytes(i.Bytes(), 16)
+}
+
+func uint256Bytes(i *big.Int) []byte {
+	if i == nil {
+		return make([]byte, 32)
+	}
+	return common.LeftPadBytes(i.Bytes(), 32)
+}
+
+// Validate checks that the fields of the user operation fit in the fields of the PackedUserOperation
+func (op *UserOperation) Validate() error {
+	for name, i := range map[string]*big.Int{
+		"callGasLimit":                  op.CallGasLimit,
+		"verificationGasLimit":          op.VerificationGasLimit,
+		"maxFeePerGas":                  op.MaxFeePerGas,
+		"maxPriorityFeePerGas":          op.MaxPriorityFeePerGas,
+		"paymasterVerificationGasLimit": op.PaymasterVerificationGasLimit,
+		"paymasterPostOpGasLimit":       op.PaymasterPostOpGasLimit,
+	} {
+		if i != nil && (i.Sign() < 0 || i.BitLen() > 128) {
+			return fmt.Errorf("%s of %s does not fit in 128 bits", name, i)
+		}
+	}
+	for name, i := range map[string]*big.Int{"nonce": op.Nonce, "preVerificationGas": op.PreVerificationGas} {
+		if i != nil && (i.Sign() < 0 || i.BitLen() > 256) {
+			return fmt.Errorf("%s of %s does not fit in 256 bits", name, i)
+		}
+	}
+	return nil
+}
+
+// Hash returns the hash of the user operation which the account signs, as computed by EntryPoint.getUserOpHash.
+// The signature is not part of the hash.
+func (op *UserOperation) Hash(entryPoint common.Address, chainID *big.Int) common.Hash {
+	// abi.encode of the static fields of the PackedUserOperation, with the dynamic fields hashed
+	var packed []byte
+	packed = append(packed, common.LeftPadBytes(op.Sender.Bytes(), 32)...)
+	packed = append(packed, uint256Bytes(op.Nonce)...)
+	packed = append(packed, crypto.Keccak256(op.initCode())...)
+	packed = append(packed, crypto.Keccak256(op.CallData)...)
+	// accountGasLimits is verificationGasLimit << 128 | callGasLimit
+	packed = append(packed, uint128Bytes(op.VerificationGasLimit)...)
+	packed = append(packed, uint128Bytes(op.CallGasLimit)...)
+	packed = append(packed, uint256Bytes(op.PreVerificationGas)...)
+	// gasFees is maxPriorityFeePerGas << 128 | maxFeePerGas
+	packed = append(packed, uint128Bytes(op.MaxPriorityFeePerGas)...)
+	packed = append(packed, uint128Bytes(op.MaxFeePerGas)...)
+	packed = append(packed, crypto.Keccak256(op.paymasterAndData())...)
+
+	var b []byte
+	b = append(b, crypto.Keccak256(packed)...)
+	b = append(b, common.LeftPadBytes(entryPoint.Bytes(), 32)...)
+	b = append(b, uint256Bytes(chainID)...)
+	return crypto.Keccak256Hash(b)
+}
+
+// UserOperationGasEstimate is the result of eth_estimateUserOperationGas
+type UserOperationGasEstimate struct {
+	PreVerificationGas            *hexutil.Big `json:"preVerificationGas"`
+	VerificationGasLimit          *hexutil.Big `json:"verificationGasLimit"`
+	CallGasLimit                  *hexutil.Big `json:"callGasLimit"`
+	PaymasterVerificationGasLimit *hexutil.Big `json:"paymasterVerificationGasLimit,omitempty"`
+	PaymasterPostOpGasLimit       *hexutil.Big `json:"paymasterPostOpGasLimit,omitempty"`
+}
+
+func (e UserOperationGasEstimate) apply(op *UserOperation) {
+	op.PreVerificationGas = (*big.Int)(e.PreVerificationGas)
+	op.VerificationGasLimit = (*big.Int)(e.VerificationGasLimit)
+	op.CallGasLimit = (*big.Int)(e.CallGasLimit)
+	if e.PaymasterVerificationGasLimit != nil {
+		op.PaymasterVerificationGasLimit = (*big.Int)(e.PaymasterVerificationGasLimit)
+	}
+	if e.PaymasterPostOpGasLimit != nil {
+		op.PaymasterPostOpGasLimit = (*big.Int)(e.PaymasterPostOpGasLimit)
+	}
+}
+
+// UserOperationReceipt is the result of eth_getUserOperationReceipt
+type UserOperationReceipt struct {
+	UserOpHash    common.Hash    `json:"userOpHash"`
+	Sender        common.Address `json:"sender"`
+	Nonce         *hexutil.Big   `json:"nonce"`
+	Paymaster     common.Address `json:"paymaster"`
+	ActualGasCost *hexutil.Big   `json:"actualGasCost"`
+	ActualGasUsed *hexutil.Big   `json:"actualGasUsed"`
+	Success       bool           `json:"success"`
+	Reason        string         `json:"reason"`
+	Receipt       struct {
+		TransactionHash common.Hash  `json:"transactionHash"`
+		BlockHash       common.Hash  `json:"blockHash"`
+		BlockNumber     *hexutil.Big `json:"blockNumber"`
+	} `json:"receipt"`
+}
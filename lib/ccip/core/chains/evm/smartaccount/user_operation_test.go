@@ -0,0 +1,152 @@
+package smartaccount
+
+import (
+	"encoding/json"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	testEntryPoint = common.HexToAddress("0x0000000071727De22E5E9d8BAf0edAc6f37da032")
+	testAccount    = common.HexToAddress("0x538aAaB4ea120b2bC2fe5D296852D948F07D849e")
+	testDest       = common.HexToAddress("0xa0788FC17B1dEe36f057c42B6F373A34B014687e")
+	testPaymaster  = common.HexToAddress("0xae4E781a6218A8031764928E88d457937A954fC3")
+)
+
+func newTestUserOperation() *UserOperation {
+	factory := common.HexToAddress("0xa5B85635Be42F21f94F28034B7DA440EeFF0F418")
+	return &UserOperation{
+		Sender:                        testAccount,
+		Nonce:                         big.NewInt(7),
+		Factory:                       &factory,
+		FactoryData:                   []byte{0x01, 0x02},
+		CallData:                      []byte{0xde, 0xad, 0xbe, 0xef},
+		CallGasLimit:                  big.NewInt(100_000),
+		VerificationGasLimit:          big.NewInt(200_000),
+		PreVerificationGas:            big.NewInt(50_000),
+		MaxFeePerGas:                  big.NewInt(30_000_000_000),
+		MaxPriorityFeePerGas:          big.NewInt(1_000_000_000),
+		Paymaster:                     &testPaymaster,
+		PaymasterVerificationGasLimit: big.NewInt(60_000),
+		PaymasterPostOpGasLimit:       big.NewInt(10_000),
+		PaymasterData:                 []byte{0xaa},
+		Signature:                     []byte{0x01},
+	}
+}
+
+func TestUserOperation_Hash(t *testing.T) {
+	op := newTestUserOperation()
+	chainID := big.NewInt(11155111)
+
+	// the hash of EntryPoint.getUserOpHash, computed with the abi encoder
+	mustType := func(s string) abi.Type {
+		typ, err := abi.NewType(s, "", nil)
+		require.NoError(t, err)
+		return typ
+	}
+	packedArgs := abi.Arguments{
+		{Type: mustType("address")}, {Type: mustType("uint256")}, {Type: mustType("bytes32")}, {Type: mustType("bytes32")},
+		{Type: mustType("bytes32")}, {Type: mustType("uint256")}, {Type: mustType("bytes32")}, {Type: mustType("bytes32")},
+	}
+	accountGasLimits := new(big.Int).Or(new(big.Int).Lsh(op.VerificationGasLimit, 128), op.CallGasLimit)
+	gasFees := new(big.Int).Or(new(big.Int).Lsh(op.MaxPriorityFeePerGas, 128), op.MaxFeePerGas)
+	packed, err := packedArgs.Pack(
+		op.Sender, op.Nonce,
+		crypto.Keccak256Hash(append(op.Factory.Bytes(), op.FactoryData...)),
+		crypto.Keccak256Hash(op.CallData),
+		common.BigToHash(accountGasLimits),
+		op.PreVerificationGas,
+		common.BigToHash(gasFees),
+		crypto.Keccak256Hash(op.paymasterAndData()),
+	)
+	require.NoError(t, err)
+	hashArgs := abi.Arguments{{Type: mustType("bytes32")}, {Type: mustType("address")}, {Type: mustType("uint256")}}
+	encoded, err := hashArgs.Pack(crypto.Keccak256Hash(packed), testEntryPoint, chainID)
+	require.NoError(t, err)
+
+	assert.Equal(t, crypto.Keccak256Hash(encoded), op.Hash(testEntryPoint, chainID))
+
+	t.Run("signature is not part of the hash", func(t *testing.T) {
+		signed := *op
+		signed.Signature = []byte{0x02, 0x03}
+		assert.Equal(t, op.Hash(testEntryPoint, chainID), signed.Hash(testEntryPoint, chainID))
+	})
+
+	t.Run("hash depends on the chain and entry point", func(t *testing.T) {
+		assert.NotEqual(t, op.Hash(testEntryPoint, chainID), op.Hash(testEntryPoint, big.NewInt(1)))
+		assert.NotEqual(t, op.Hash(testEntryPoint, chainID), op.Hash(testDest, chainID))
+	})
+}
+
+func TestUserOperation_paymasterAndData(t *testing.T) {
+	op := newTestUserOperation()
+	b := op.paymasterAndData()
+	require.Len(t, b, 20+16+16+1)
+	assert.Equal(t, testPaymaster.Bytes(), b[:20])
+	assert.Equal(t, int64(60_000), new(big.Int).SetBytes(b[20:36]).Int64())
+	assert.Equal(t, int64(10_000), new(big.Int).SetBytes(b[36:52]).Int64())
+	assert.Equal(t, []byte{0xaa}, b[52:])
+
+	op.Paymaster = nil
+	assert.Empty(t, op.paymasterAndData())
+}
+
+func TestUserOperation_JSON(t *testing.T) {
+	op := newTestUserOperation()
+	b, err := json.Marshal(op)
+	require.NoError(t, err)
+
+	var fields map[string]any
+	require.NoError(t, json.Unmarshal(b, &fields))
+	assert.Equal(t, "0x7", fields["nonce"])
+	assert.Equal(t, "0x186a0", fields["callGasLimit"])
+	assert.Equal(t, "0xdeadbeef", fields["callData"])
+	assert.Equal(t, "0x0102", fields["factoryData"])
+
+	var decoded UserOperation
+	require.NoError(t, json.Unmarshal(b, &decoded))
+	assert.Equal(t, *op, decoded)
+
+	t.Run("omits unset optional fields", func(t *testing.T) {
+		b, err := json.Marshal(&UserOperation{Sender: testAccount})
+		require.NoError(t, err)
+		var fields map[string]any
+		require.NoError(t, json.Unmarshal(b, &fields))
+		assert.NotContains(t, fields, "factory")
+		assert.NotContains(t, fields, "paymaster")
+		assert.Equal(t, "0x0", fields["nonce"])
+		assert.Equal(t, "0x0", fields["preVerificationGas"])
+	})
+}
+
+func TestUserOperation_Validate(t *testing.T) {
+	op := newTestUserOperation()
+	require.NoError(t, op.Validate())
+
+	op.CallGasLimit = new(big.Int).Lsh(big.NewInt(1), 128)
+	assert.ErrorContains(t, op.Validate(), "callGasLimit")
+}
+
+func TestEncodeExecute(t *testing.T) {
+	b, err := EncodeExecute(testDest, big.NewInt(5), []byte{0x01})
+	require.NoError(t, err)
+	assert.Equal(t, crypto.Keccak256([]byte("execute(address,uint256,bytes)"))[:4], b[:4])
+
+	args, err := accountABI.Methods["execute"].Inputs.Unpack(b[4:])
+	require.NoError(t, err)
+	assert.Equal(t, testDest, args[0])
+	assert.Equal(t, big.NewInt(5), args[1])
+	assert.Equal(t, []byte{0x01}, args[2])
+
+	b, err = EncodeExecute(testDest, nil, nil)
+	require.NoError(t, err)
+	args, err = accountABI.Methods["execute"].Inputs.Unpack(b[4:])
+	require.NoError(t, err)
+	assert.Zero(t, args[1].(*big.Int).Sign())
+}
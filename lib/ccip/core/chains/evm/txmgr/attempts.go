@@ -3,11 +3,13 @@ package txmgr
 import (
 	"bytes"
 	"context"
+	"errors"
 	"fmt"
 	"math/big"
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/holiman/uint256"
 	pkgerrors "github.com/pkg/errors"
 
 	"github.com/smartcontractkit/chainlink-common/pkg/logger"
@@ -46,7 +48,9 @@ func NewEvmTxAttemptBuilder(chainID big.Int, feeConfig evmTxAttemptBuilderFeeCon
 // used for when a brand new transaction is being created in the txm
 func (c *evmTxAttemptBuilder) NewTxAttempt(ctx context.Context, etx Tx, lggr logger.Logger, opts ...feetypes.Opt) (attempt TxAttempt, fee gas.EvmFee, feeLimit uint64, retryable bool, err error) {
 	txType := 0x0
-	if c.feeConfig.EIP1559DynamicFees() {
+	if len(etx.Blobs) > 0 {
+		txType = 0x3
+	} else if c.feeConfig.EIP1559DynamicFees() {
 		txType = 0x2
 	}
 	return c.NewTxAttemptWithType(ctx, etx, lggr, txType, opts...)
@@ -60,6 +64,13 @@ func (c *evmTxAttemptBuilder) NewTxAttemptWithType(ctx context.Context, etx Tx,
 	if err != nil {
 		return attempt, fee, feeLimit, true, pkgerrors.Wrap(err, "failed to get fee") // estimator errors are retryable
 	}
+	if txType == 0x3 {
+		fee.BlobFeeCap, err = c.EvmFeeEstimator.GetBlobFee(ctx)
+		if err != nil {
+			// blob fees will not be enabled by retrying
+			return attempt, fee, feeLimit, !errors.Is(err, gas.ErrBlobFeesDisabled), pkgerrors.Wrap(err, "failed to get blob fee")
+		}
+	}
 
 	attempt, retryable, err = c.NewCustomTxAttempt(ctx, etx, fee, feeLimit, txType, lggr)
 	return attempt, fee, feeLimit, retryable, err
@@ -78,6 +89,7 @@ func (c *evmTxAttemptBuilder) NewBumpTxAttempt(ctx context.Context, etx Tx, prev
 	if previousAttempt.IsPurgeAttempt {
 		etx.EncodedPayload = []byte{}
 		etx.Value = *big.NewInt(0)
+		etx.Blobs = purgeBlobs(previousAttempt.TxType)
 		bumpedFeeLimit = c.feeConfig.LimitDefault()
 	}
 	attempt, retryable, err = c.NewCustomTxAttempt(ctx, etx, bumpedFee, bumpedFeeLimit, previousAttempt.TxType, lggr)
@@ -101,6 +113,7 @@ func (c *evmTxAttemptBuilder) NewPurgeTxAttempt(ctx context.Context, etx Tx, lgg
 	// Set empty payload and 0 value for purge attempts
 	etx.EncodedPayload = []byte{}
 	etx.Value = *big.NewInt(0)
+	etx.Blobs = purgeBlobs(previousAttempt.TxType)
 	attempt, _, err = c.NewCustomTxAttempt(ctx, etx, bumpedFee, gasLimit, previousAttempt.TxType, lggr)
 	if err != nil {
 		return attempt, fmt.Errorf("failed to create purge attempt: %w", err)
@@ -132,6 +145,15 @@ func (c *evmTxAttemptBuilder) NewCustomTxAttempt(ctx context.Context, etx Tx, fe
 			TipCap: fee.DynamicTipCap,
 		}, gasLimit)
 		return attempt, true, err
+	case 0x3: // blob, EIP4844
+		if !fee.ValidBlob() {
+			err = pkgerrors.Errorf("Attempt %v is a type 3 transaction but fee is missing the dynamic or blob fee caps", attempt.ID)
+			logger.Sugared(lggr).AssumptionViolation(err.Error())
+			return attempt, false, err // not retryable
+		}
+		attempt, err = c.newBlobAttempt(ctx, etx, fee, gasLimit)
+		// the blobs of the tx can't be made valid by retrying
+		return attempt, false, err
 	default:
 		err = pkgerrors.Errorf("invariant violation: Attempt %v had unrecognised transaction type %v"+
 			"This is a bug! Please report to https://github.com/smartcontractkit/chainlink/issues", attempt.ID, attempt.TxType)
@@ -198,6 +220,56 @@ func (c *evmTxAttemptBuilder) newDynamicFeeAttempt(ctx context.Context, etx Tx,
 	return attempt, nil
 }
 
+func (c *evmTxAttemptBuilder) newBlobAttempt(ctx context.Context, etx Tx, fee gas.EvmFee, gasLimit uint64) (attempt TxAttempt, err error) {
+	dynamicFee := gas.DynamicFee{FeeCap: fee.DynamicFeeCap, TipCap: fee.DynamicTipCap}
+	if err = validateDynamicFeeGas(c.feeConfig, dynamicFee, etx); err != nil {
+		return attempt, pkgerrors.Wrap(err, "error validating gas")
+	}
+	if fee.BlobFeeCap.ToInt().Cmp(Max256BitUInt) > 0 {
+		return attempt, pkgerrors.New("impossibly large blob fee cap")
+	}
+	sidecar, err := newBlobTxSidecar(etx.Blobs)
+	if err != nil {
+		return attempt, pkgerrors.Wrapf(err, "error building blob sidecar of transaction %v", etx.ID)
+	}
+
+	tx := types.NewTx(&types.BlobTx{
+		ChainID:    uint256.MustFromBig(&c.chainID),
+		Nonce:      uint64(*etx.Sequence),
+		GasTipCap:  uint256.MustFromBig(dynamicFee.TipCap.ToInt()),
+		GasFeeCap:  uint256.MustFromBig(dynamicFee.FeeCap.ToInt()),
+		Gas:        gasLimit,
+		To:         etx.ToAddress,
+		Value:      uint256.MustFromBig(&etx.Value),
+		Data:       etx.EncodedPayload,
+		BlobFeeCap: uint256.MustFromBig(fee.BlobFeeCap.ToInt()),
+		BlobHashes: sidecar.BlobHashes(),
+	})
+	// The sidecar holds ~128KB per blob and is not covered by the signature, so it is left out of the signed tx stored
+	// with each attempt and rebuilt from the blobs of the tx when the attempt is sent, see getGethSignedTxWithSidecar
+	attempt, err = c.newSignedAttempt(ctx, etx, tx)
+	if err != nil {
+		return attempt, err
+	}
+	attempt.TxFee = gas.EvmFee{
+		DynamicFeeCap: dynamicFee.FeeCap,
+		DynamicTipCap: dynamicFee.TipCap,
+		BlobFeeCap:    fee.BlobFeeCap,
+	}
+	attempt.ChainSpecificFeeLimit = gasLimit
+	attempt.TxType = 3
+	return attempt, nil
+}
+
+// purgeBlobs returns the blobs of a purge attempt. Blob pools don't let a blob transaction be replaced by another type
+// of transaction, so the purge attempt of a blob transaction carries a single empty blob.
+func purgeBlobs(txType int) [][]byte {
+	if txType == 0x3 {
+		return [][]byte{{}}
+	}
+	return nil
+}
+
 var Max256BitUInt = big.NewInt(0).Exp(big.NewInt(2), big.NewInt(256), nil)
 
 type keySpecificEstimator interface {
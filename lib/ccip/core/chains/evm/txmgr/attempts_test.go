@@ -1,14 +1,18 @@
 package txmgr_test
 
 import (
+	"bytes"
+	"context"
 	"fmt"
 	"math/big"
+	"slices"
 	"testing"
 
 	gethcommon "github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/ethereum/go-ethereum/core/types"
 	gethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto/kzg4844"
 	pkgerrors "github.com/pkg/errors"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
@@ -17,6 +21,7 @@ import (
 	"github.com/smartcontractkit/chainlink-common/pkg/logger"
 	"github.com/smartcontractkit/chainlink-common/pkg/utils/tests"
 
+	commonclient "github.com/smartcontractkit/chainlink/v2/common/client"
 	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/assets"
 	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/config/toml"
 	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/gas"
@@ -193,6 +198,110 @@ func TestTxm_NewDynamicFeeTx(t *testing.T) {
 	})
 }
 
+func TestTxm_NewBlobTx(t *testing.T) {
+	addr := NewEvmAddress()
+	kst := ksmocks.NewEth(t)
+	kst.On("SignTx", mock.Anything, addr, mock.Anything, big.NewInt(1)).Return(
+		func(_ context.Context, _ gethcommon.Address, tx *types.Transaction, _ *big.Int) (*types.Transaction, error) {
+			return tx, nil
+		})
+	feeCfg := newFeeConfig()
+	feeCfg.priceMax = assets.GWei(200)
+	cks := txmgr.NewEvmTxAttemptBuilder(*big.NewInt(1), feeCfg, kst, nil)
+	lggr := logger.Test(t)
+	fee := gas.EvmFee{DynamicTipCap: assets.GWei(100), DynamicFeeCap: assets.GWei(200), BlobFeeCap: assets.GWei(1)}
+
+	t.Run("creates attempt with fields and sidecar", func(t *testing.T) {
+		n := evmtypes.Nonce(7)
+		etx := txmgr.Tx{Sequence: &n, FromAddress: addr, Blobs: [][]byte{{1, 2, 3}, {}}}
+		a, _, err := cks.NewCustomTxAttempt(tests.Context(t), etx, fee, 100, 0x3, lggr)
+		require.NoError(t, err)
+		assert.Equal(t, 0x3, a.TxType)
+		assert.Equal(t, 100, int(a.ChainSpecificFeeLimit))
+		assert.Nil(t, a.TxFee.Legacy)
+		assert.Equal(t, assets.GWei(100).String(), a.TxFee.DynamicTipCap.String())
+		assert.Equal(t, assets.GWei(200).String(), a.TxFee.DynamicFeeCap.String())
+		assert.Equal(t, assets.GWei(1).String(), a.TxFee.BlobFeeCap.String())
+
+		// The signed tx is stored without the sidecar, only the blob hashes
+		tx, err := txmgr.GetGethSignedTx(a.SignedRawTx)
+		require.NoError(t, err)
+		assert.Equal(t, uint8(types.BlobTxType), tx.Type())
+		assert.Equal(t, uint64(7), tx.Nonce())
+		assert.Equal(t, assets.GWei(1).ToInt(), tx.BlobGasFeeCap())
+		assert.Nil(t, tx.BlobTxSidecar())
+		assert.Len(t, tx.BlobHashes(), 2)
+		assert.Equal(t, a.Hash, tx.Hash())
+	})
+
+	t.Run("sends attempt with the sidecar of the blobs", func(t *testing.T) {
+		n := evmtypes.Nonce(7)
+		etx := txmgr.Tx{Sequence: &n, FromAddress: addr, Blobs: [][]byte{{1, 2, 3}, {}}}
+		a, _, err := cks.NewCustomTxAttempt(tests.Context(t), etx, fee, 100, 0x3, lggr)
+		require.NoError(t, err)
+
+		ethClient := testutils.NewEthClientMockWithDefaultChain(t)
+		ethClient.On("SendTransactionReturnCode", mock.Anything, mock.MatchedBy(func(tx *types.Transaction) bool {
+			sidecar := tx.BlobTxSidecar()
+			return tx.Hash() == a.Hash && sidecar != nil && len(sidecar.Blobs) == 2 &&
+				bytes.Equal([]byte{0, 1, 2, 3, 0}, sidecar.Blobs[0][:5]) && slices.Equal(sidecar.BlobHashes(), tx.BlobHashes())
+		}), addr).Return(commonclient.Successful, nil).Once()
+		code, err := txmgr.NewEvmTxmClient(ethClient, nil).SendTransactionReturnCode(tests.Context(t), etx, a, logger.Sugared(lggr))
+		require.NoError(t, err)
+		assert.Equal(t, commonclient.Successful, code)
+
+		// the blobs must match the attempt
+		etx.Blobs = [][]byte{{1, 2, 3}}
+		code, err = txmgr.NewEvmTxmClient(ethClient, nil).SendTransactionReturnCode(tests.Context(t), etx, a, logger.Sugared(lggr))
+		require.ErrorContains(t, err, "do not match the blob hashes")
+		assert.Equal(t, commonclient.Fatal, code)
+	})
+
+	t.Run("blob data is packed in the field elements of the blob", func(t *testing.T) {
+		n := evmtypes.Nonce(0)
+		data := make([]byte, 40)
+		for i := range data {
+			data[i] = byte(i + 1)
+		}
+		a, _, err := cks.NewCustomTxAttempt(tests.Context(t), txmgr.Tx{Sequence: &n, FromAddress: addr, Blobs: [][]byte{data}}, fee, 100, 0x3, lggr)
+		require.NoError(t, err)
+		ethClient := testutils.NewEthClientMockWithDefaultChain(t)
+		var blob kzg4844.Blob
+		ethClient.On("SendTransactionReturnCode", mock.Anything, mock.Anything, addr).Run(func(args mock.Arguments) {
+			blob = args.Get(1).(*types.Transaction).BlobTxSidecar().Blobs[0]
+		}).Return(commonclient.Successful, nil).Once()
+		_, err = txmgr.NewEvmTxmClient(ethClient, nil).SendTransactionReturnCode(tests.Context(t), txmgr.Tx{FromAddress: addr, Blobs: [][]byte{data}}, a, logger.Sugared(lggr))
+		require.NoError(t, err)
+		// The first byte of each 32 byte field element is left empty
+		assert.Equal(t, append([]byte{0}, data[:31]...), blob[:32])
+		assert.Equal(t, append([]byte{0}, data[31:]...), blob[32:42])
+	})
+
+	t.Run("returns non retryable error for invalid blobs", func(t *testing.T) {
+		n := evmtypes.Nonce(0)
+		for name, blobs := range map[string][][]byte{
+			"no blobs":            nil,
+			"too many blobs":      make([][]byte, txmgr.MaxBlobsPerTx+1),
+			"blob data too large": {make([]byte, txmgr.MaxBlobDataSize+1)},
+		} {
+			_, retryable, err := cks.NewCustomTxAttempt(tests.Context(t), txmgr.Tx{Sequence: &n, FromAddress: addr, Blobs: blobs}, fee, 100, 0x3, lggr)
+			require.Error(t, err, name)
+			assert.Contains(t, err.Error(), "error building blob sidecar", name)
+			assert.False(t, retryable, name)
+		}
+	})
+
+	t.Run("verifies max gas price", func(t *testing.T) {
+		n := evmtypes.Nonce(0)
+		_, _, err := cks.NewCustomTxAttempt(tests.Context(t), txmgr.Tx{Sequence: &n, FromAddress: addr, Blobs: [][]byte{{}}}, gas.EvmFee{
+			DynamicTipCap: assets.GWei(100),
+			DynamicFeeCap: assets.GWei(300),
+			BlobFeeCap:    assets.GWei(1),
+		}, 100, 0x3, lggr)
+		require.ErrorContains(t, err, "specified gas fee cap of 300 gwei would exceed max configured gas price of 200 gwei")
+	})
+}
+
 func TestTxm_NewLegacyAttempt(t *testing.T) {
 	addr := NewEvmAddress()
 	kst := ksmocks.NewEth(t)
@@ -280,6 +389,31 @@ func TestTxm_NewPurgeAttempt(t *testing.T) {
 		require.Equal(t, *big.NewInt(0), a.Tx.Value)
 	})
 
+	t.Run("creates blob purge attempt with an empty blob if previous attempt is blob", func(t *testing.T) {
+		blobEst := gasmocks.NewEvmFeeEstimator(t)
+		blobEst.On("BumpFee", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+			Return(gas.EvmFee{DynamicTipCap: bumpedDynamicTip, DynamicFeeCap: bumpedDynamicFee, BlobFeeCap: assets.GWei(2)}, uint64(10_000), nil)
+		blobKst := ksmocks.NewEth(t)
+		blobKst.On("SignTx", mock.Anything, addr, mock.Anything, big.NewInt(1)).Return(
+			func(_ context.Context, _ gethcommon.Address, tx *types.Transaction, _ *big.Int) (*types.Transaction, error) {
+				return tx, nil
+			})
+		blobCks := txmgr.NewEvmTxAttemptBuilder(*big.NewInt(1), gc, blobKst, blobEst)
+
+		n := evmtypes.Nonce(0)
+		etx := txmgr.Tx{Sequence: &n, FromAddress: addr, EncodedPayload: []byte{1, 2, 3}, Blobs: [][]byte{{1, 2, 3}}}
+		prevAttempt, _, err := blobCks.NewCustomTxAttempt(ctx, etx, gas.EvmFee{DynamicTipCap: bumpedDynamicTip.Sub(assets.GWei(1)), DynamicFeeCap: bumpedDynamicFee.Sub(assets.GWei(1)), BlobFeeCap: assets.GWei(1)}, 100, 0x3, lggr)
+		require.NoError(t, err)
+		etx.TxAttempts = append(etx.TxAttempts, prevAttempt)
+		a, err := blobCks.NewPurgeTxAttempt(ctx, etx, lggr)
+		require.NoError(t, err)
+		require.Equal(t, 0x3, a.TxType)
+		require.Equal(t, assets.GWei(2).String(), a.TxFee.BlobFeeCap.String())
+		require.Equal(t, true, a.IsPurgeAttempt)
+		require.Equal(t, []byte{}, a.Tx.EncodedPayload)
+		require.Equal(t, [][]byte{{}}, a.Tx.Blobs)
+	})
+
 	t.Run("creates bump purge attempt with fields", func(t *testing.T) {
 		n := evmtypes.Nonce(0)
 		etx := txmgr.Tx{Sequence: &n, FromAddress: addr, EncodedPayload: []byte{1, 2, 3}}
@@ -327,6 +461,15 @@ func TestTxm_NewCustomTxAttempt_NonRetryableErrors(t *testing.T) {
 		assert.False(t, retryable)
 	})
 
+	t.Run("dynamic fee with blob tx type", func(t *testing.T) {
+		_, retryable, err := cks.NewCustomTxAttempt(tests.Context(t), txmgr.Tx{}, gas.EvmFee{
+			DynamicTipCap: dynamicFee.TipCap,
+			DynamicFeeCap: dynamicFee.FeeCap,
+		}, 100, 0x3, lggr)
+		require.Error(t, err)
+		assert.False(t, retryable)
+	})
+
 	t.Run("invalid type", func(t *testing.T) {
 		_, retryable, err := cks.NewCustomTxAttempt(tests.Context(t), txmgr.Tx{}, gas.EvmFee{}, 100, 0xA, lggr)
 		require.Error(t, err)
@@ -363,3 +506,33 @@ func TestTxm_EvmTxAttemptBuilder_RetryableEstimatorError(t *testing.T) {
 		assert.True(t, retryable)
 	})
 }
+
+func TestTxm_EvmTxAttemptBuilder_BlobFeeErrors(t *testing.T) {
+	kst := ksmocks.NewEth(t)
+	lggr := logger.Test(t)
+	ctx := tests.Context(t)
+	etx := txmgr.Tx{Blobs: [][]byte{{1}}}
+	dynamicFee := gas.EvmFee{DynamicTipCap: assets.GWei(1), DynamicFeeCap: assets.GWei(2)}
+
+	t.Run("blob fees disabled is not retryable", func(t *testing.T) {
+		est := gasmocks.NewEvmFeeEstimator(t)
+		est.On("GetFee", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(dynamicFee, uint64(100), nil)
+		est.On("GetBlobFee", mock.Anything).Return(nil, gas.ErrBlobFeesDisabled)
+		cks := txmgr.NewEvmTxAttemptBuilder(*big.NewInt(1), &feeConfig{eip1559DynamicFees: true}, kst, est)
+
+		_, _, _, retryable, err := cks.NewTxAttempt(ctx, etx, lggr)
+		require.ErrorIs(t, err, gas.ErrBlobFeesDisabled)
+		assert.False(t, retryable)
+	})
+	t.Run("blob fee estimation errors are retryable", func(t *testing.T) {
+		est := gasmocks.NewEvmFeeEstimator(t)
+		est.On("GetFee", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(dynamicFee, uint64(100), nil)
+		est.On("GetBlobFee", mock.Anything).Return(nil, pkgerrors.New("fail"))
+		cks := txmgr.NewEvmTxAttemptBuilder(*big.NewInt(1), &feeConfig{eip1559DynamicFees: true}, kst, est)
+
+		_, _, _, retryable, err := cks.NewTxAttempt(ctx, etx, lggr)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "failed to get blob fee")
+		assert.True(t, retryable)
+	})
+}
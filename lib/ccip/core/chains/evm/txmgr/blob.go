@@ -0,0 +1,99 @@
+package txmgr
+
+import (
+	"fmt"
+	"slices"
+
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto/kzg4844"
+	"github.com/ethereum/go-ethereum/params"
+	"github.com/holiman/uint256"
+)
+
+const (
+	// blobDataPerFieldElement is the number of bytes of data packed in each field element of a blob. The first byte of
+	// each field element is left empty, so that the field element is always lower than the BLS modulus.
+	blobDataPerFieldElement = params.BlobTxBytesPerFieldElement - 1
+	// MaxBlobDataSize is the max size of the data carried by a single blob
+	MaxBlobDataSize = blobDataPerFieldElement * params.BlobTxFieldElementsPerBlob
+	// MaxBlobsPerTx is the max number of blobs a transaction may carry, as it is limited by the blob gas of a block
+	MaxBlobsPerTx = params.MaxBlobGasPerBlock / params.BlobTxBlobGasPerBlob
+)
+
+// encodeBlob packs the data in a blob, blobDataPerFieldElement bytes per field element
+func encodeBlob(data []byte) (blob kzg4844.Blob, err error) {
+	if len(data) > MaxBlobDataSize {
+		return blob, fmt.Errorf("blob data of %d bytes exceeds the max of %d bytes per blob", len(data), MaxBlobDataSize)
+	}
+	for i := 0; len(data) > 0; i++ {
+		n := copy(blob[i*params.BlobTxBytesPerFieldElement+1:(i+1)*params.BlobTxBytesPerFieldElement], data)
+		data = data[n:]
+	}
+	return blob, nil
+}
+
+// newBlobTxSidecar builds the sidecar of a blob transaction carrying the blobs, with their KZG commitments and proofs
+func newBlobTxSidecar(blobs [][]byte) (*types.BlobTxSidecar, error) {
+	if len(blobs) == 0 {
+		return nil, fmt.Errorf("blob transactions must carry at least one blob")
+	}
+	if len(blobs) > MaxBlobsPerTx {
+		return nil, fmt.Errorf("%d blobs exceed the max of %d blobs per transaction", len(blobs), MaxBlobsPerTx)
+	}
+	sidecar := &types.BlobTxSidecar{}
+	for i, data := range blobs {
+		blob, err := encodeBlob(data)
+		if err != nil {
+			return nil, fmt.Errorf("failed to encode blob %d: %w", i, err)
+		}
+		commitment, err := kzg4844.BlobToCommitment(blob)
+		if err != nil {
+			return nil, fmt.Errorf("failed to compute commitment of blob %d: %w", i, err)
+		}
+		proof, err := kzg4844.ComputeBlobProof(blob, commitment)
+		if err != nil {
+			return nil, fmt.Errorf("failed to compute proof of blob %d: %w", i, err)
+		}
+		sidecar.Blobs = append(sidecar.Blobs, blob)
+		sidecar.Commitments = append(sidecar.Commitments, commitment)
+		sidecar.Proofs = append(sidecar.Proofs, proof)
+	}
+	return sidecar, nil
+}
+
+// getGethSignedTxWithSidecar decodes the signed tx of the attempt, adding the sidecar of the blobs to blob transactions
+// so that they can be sent. Purge attempts carry the blobs of purgeBlobs instead of the blobs of the tx.
+func getGethSignedTxWithSidecar(attempt TxAttempt, blobs [][]byte) (*types.Transaction, error) {
+	tx, err := GetGethSignedTx(attempt.SignedRawTx)
+	if err != nil || tx.Type() != types.BlobTxType || tx.BlobTxSidecar() != nil {
+		return tx, err
+	}
+	if attempt.IsPurgeAttempt {
+		blobs = purgeBlobs(attempt.TxType)
+	}
+	sidecar, err := newBlobTxSidecar(blobs)
+	if err != nil {
+		return nil, fmt.Errorf("error building blob sidecar of attempt %v: %w", attempt.ID, err)
+	}
+	if !slices.Equal(sidecar.BlobHashes(), tx.BlobHashes()) {
+		return nil, fmt.Errorf("blobs of transaction %v do not match the blob hashes of attempt %v", attempt.TxID, attempt.ID)
+	}
+	v, r, s := tx.RawSignatureValues()
+	return types.NewTx(&types.BlobTx{
+		ChainID:    uint256.MustFromBig(tx.ChainId()),
+		Nonce:      tx.Nonce(),
+		GasTipCap:  uint256.MustFromBig(tx.GasTipCap()),
+		GasFeeCap:  uint256.MustFromBig(tx.GasFeeCap()),
+		Gas:        tx.Gas(),
+		To:         *tx.To(),
+		Value:      uint256.MustFromBig(tx.Value()),
+		Data:       tx.Data(),
+		AccessList: tx.AccessList(),
+		BlobFeeCap: uint256.MustFromBig(tx.BlobGasFeeCap()),
+		BlobHashes: tx.BlobHashes(),
+		Sidecar:    sidecar,
+		V:          uint256.MustFromBig(v),
+		R:          uint256.MustFromBig(r),
+		S:          uint256.MustFromBig(s),
+	}), nil
+}
@@ -1,6 +1,7 @@
 package txmgr_test
 
 import (
+	"bytes"
 	"context"
 	"encoding/json"
 	"errors"
@@ -609,6 +610,34 @@ func TestEthBroadcaster_TransmitChecking(t *testing.T) {
 		assert.Equal(t, txmgrcommon.TxUnconfirmed, ethTx.State)
 	})
 
+	t.Run("when transmit checking rebuilds the payload, sends tx with the new payload", func(t *testing.T) {
+		// Checker will return a new payload
+		checkerFactory.err = nil
+		checkerFactory.payload = []byte{42, 42}
+		defer func() { checkerFactory.payload = nil }()
+
+		ethClient.On("SendTransactionReturnCode", mock.Anything, mock.MatchedBy(func(tx *gethTypes.Transaction) bool {
+			return tx.Nonce() == 2 && bytes.Equal(tx.Data(), []byte{42, 42})
+		}), fromAddress).Return(commonclient.Successful, nil).Once()
+
+		ethTx := mustCreateUnstartedGeneratedTx(t, txStore, fromAddress, testutils.FixtureChainID, txRequestWithChecker(checker))
+		{
+			retryable, err := eb.ProcessUnstartedTxs(tests.Context(t), fromAddress)
+			assert.NoError(t, err)
+			assert.False(t, retryable)
+		}
+
+		// Check ethtx was sent and saved with the new payload
+		ethTx, err := txStore.FindTxWithAttempts(ctx, ethTx.ID)
+		require.NoError(t, err)
+		assert.Equal(t, txmgrcommon.TxUnconfirmed, ethTx.State)
+		assert.Equal(t, []byte{42, 42}, ethTx.EncodedPayload)
+		require.Len(t, ethTx.TxAttempts, 1)
+		signedTx, err := txmgr.GetGethSignedTx(ethTx.TxAttempts[0].SignedRawTx)
+		require.NoError(t, err)
+		assert.Equal(t, []byte{42, 42}, signedTx.Data())
+	})
+
 	t.Run("when transmit errors, fatally error transaction", func(t *testing.T) {
 		// Checker will return a fatal error
 		checkerFactory.err = errors.New("fatal checker error")
@@ -1969,10 +1998,14 @@ func TestEthBroadcaster_HederaBroadcastValidation(t *testing.T) {
 }
 
 type testCheckerFactory struct {
-	err error
+	err     error
+	payload []byte
 }
 
 func (t *testCheckerFactory) BuildChecker(spec txmgr.TransmitCheckerSpec) (txmgr.TransmitChecker, error) {
+	if t.payload != nil {
+		return &testRebuildingChecker{testChecker{t.err}, t.payload}, nil
+	}
 	return &testChecker{t.err}, nil
 }
 
@@ -1988,3 +2021,17 @@ func (t *testChecker) Check(
 ) error {
 	return t.err
 }
+
+type testRebuildingChecker struct {
+	testChecker
+	payload []byte
+}
+
+func (t *testRebuildingChecker) RebuildPayload(
+	_ context.Context,
+	_ logger.SugaredLogger,
+	_ txmgr.Tx,
+	_ txmgr.TxAttempt,
+) ([]byte, error) {
+	return t.payload, t.err
+}
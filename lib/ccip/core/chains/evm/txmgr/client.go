@@ -96,7 +96,7 @@ func (c *evmTxmClient) BatchSendTransactions(
 }
 
 func (c *evmTxmClient) SendTransactionReturnCode(ctx context.Context, etx Tx, attempt TxAttempt, lggr logger.SugaredLogger) (commonclient.SendTxReturnCode, error) {
-	signedTx, err := GetGethSignedTx(attempt.SignedRawTx)
+	signedTx, err := getGethSignedTxWithSidecar(attempt, etx.Blobs)
 	if err != nil {
 		lggr.Criticalw("Fatal error signing transaction", "err", err, "etx", etx)
 		return commonclient.Fatal, err
@@ -40,8 +40,8 @@ func batchSendTransactions(
 	for i, attempt := range attempts {
 		ethTxIDs[i] = attempt.TxID
 		hashes[i] = attempt.Hash.String()
-		// Decode the signed raw tx back into a Transaction object
-		signedTx, decodeErr := GetGethSignedTx(attempt.SignedRawTx)
+		// Decode the signed raw tx back into a Transaction object, blob transactions need the tx to be loaded for their blobs
+		signedTx, decodeErr := getGethSignedTxWithSidecar(attempt, attempt.Tx.Blobs)
 		if decodeErr != nil {
 			return reqs, now, successfulBroadcast, fmt.Errorf("failed to decode signed raw tx into Transaction object: %w", decodeErr)
 		}
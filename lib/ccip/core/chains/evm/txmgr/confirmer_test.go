@@ -31,6 +31,7 @@ import (
 	txmgrtypes "github.com/smartcontractkit/chainlink/v2/common/txmgr/types"
 	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/assets"
 	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/client"
+	clmocks "github.com/smartcontractkit/chainlink/v2/core/chains/evm/client/mocks"
 	evmconfig "github.com/smartcontractkit/chainlink/v2/core/chains/evm/config"
 	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/gas"
 	gasmocks "github.com/smartcontractkit/chainlink/v2/core/chains/evm/gas/mocks"
@@ -131,9 +132,9 @@ func TestEthConfirmer_Lifecycle(t *testing.T) {
 	ge := config.EVM().GasEstimator()
 	feeEstimator := gas.NewEvmFeeEstimator(lggr, newEst, ge.EIP1559DynamicFees(), ge, ethClient)
 	txBuilder := txmgr.NewEvmTxAttemptBuilder(*ethClient.ConfiguredChainID(), ge, ethKeyStore, feeEstimator)
-	stuckTxDetector := txmgr.NewStuckTxDetector(lggr, testutils.FixtureChainID, "", assets.NewWei(assets.NewEth(100).ToInt()), config.EVM().Transactions().AutoPurge(), feeEstimator, txStore, ethClient)
+	stuckTxDetector := txmgr.NewStuckTxDetector(lggr, testutils.FixtureChainID, "", assets.NewWei(assets.NewEth(100).ToInt()), config.EVM().Transactions().AutoPurge(), config.EVM().Transactions().StuckTxRecovery(), feeEstimator, txStore, ethClient)
 	ht := headtracker.NewSimulatedHeadTracker(ethClient, true, 0)
-	ec := txmgr.NewEvmConfirmer(txStore, txmgr.NewEvmTxmClient(ethClient, nil), txmgr.NewEvmTxmConfig(config.EVM()), txmgr.NewEvmTxmFeeConfig(ge), config.EVM().Transactions(), gconfig.Database(), ethKeyStore, txBuilder, lggr, stuckTxDetector, ht)
+	ec := txmgr.NewEvmConfirmer(txStore, txmgr.NewEvmTxmClient(ethClient, nil), txmgr.NewEvmTxmConfig(config.EVM()), txmgr.NewEvmTxmFeeConfig(ge), config.EVM().Transactions(), gconfig.Database(), ethKeyStore, txBuilder, lggr, stuckTxDetector, ht, nil)
 	ctx := tests.Context(t)
 
 	// Can't close unstarted instance
@@ -1666,10 +1667,10 @@ func TestEthConfirmer_RebroadcastWhereNecessary_WithConnectivityCheck(t *testing
 		txBuilder := txmgr.NewEvmTxAttemptBuilder(*ethClient.ConfiguredChainID(), ge, kst, feeEstimator)
 		addresses := []gethCommon.Address{fromAddress}
 		kst.On("EnabledAddressesForChain", mock.Anything, &cltest.FixtureChainID).Return(addresses, nil).Maybe()
-		stuckTxDetector := txmgr.NewStuckTxDetector(lggr, testutils.FixtureChainID, "", assets.NewWei(assets.NewEth(100).ToInt()), ccfg.EVM().Transactions().AutoPurge(), feeEstimator, txStore, ethClient)
+		stuckTxDetector := txmgr.NewStuckTxDetector(lggr, testutils.FixtureChainID, "", assets.NewWei(assets.NewEth(100).ToInt()), ccfg.EVM().Transactions().AutoPurge(), ccfg.EVM().Transactions().StuckTxRecovery(), feeEstimator, txStore, ethClient)
 		ht := headtracker.NewSimulatedHeadTracker(ethClient, true, 0)
 		// Create confirmer with necessary state
-		ec := txmgr.NewEvmConfirmer(txStore, txmgr.NewEvmTxmClient(ethClient, nil), ccfg.EVM(), txmgr.NewEvmTxmFeeConfig(ccfg.EVM().GasEstimator()), ccfg.EVM().Transactions(), cfg.Database(), kst, txBuilder, lggr, stuckTxDetector, ht)
+		ec := txmgr.NewEvmConfirmer(txStore, txmgr.NewEvmTxmClient(ethClient, nil), ccfg.EVM(), txmgr.NewEvmTxmFeeConfig(ccfg.EVM().GasEstimator()), ccfg.EVM().Transactions(), cfg.Database(), kst, txBuilder, lggr, stuckTxDetector, ht, nil)
 		servicetest.Run(t, ec)
 		currentHead := int64(30)
 		oldEnough := int64(15)
@@ -1716,9 +1717,9 @@ func TestEthConfirmer_RebroadcastWhereNecessary_WithConnectivityCheck(t *testing
 		txBuilder := txmgr.NewEvmTxAttemptBuilder(*ethClient.ConfiguredChainID(), ge, kst, feeEstimator)
 		addresses := []gethCommon.Address{fromAddress}
 		kst.On("EnabledAddressesForChain", mock.Anything, &cltest.FixtureChainID).Return(addresses, nil).Maybe()
-		stuckTxDetector := txmgr.NewStuckTxDetector(lggr, testutils.FixtureChainID, "", assets.NewWei(assets.NewEth(100).ToInt()), ccfg.EVM().Transactions().AutoPurge(), feeEstimator, txStore, ethClient)
+		stuckTxDetector := txmgr.NewStuckTxDetector(lggr, testutils.FixtureChainID, "", assets.NewWei(assets.NewEth(100).ToInt()), ccfg.EVM().Transactions().AutoPurge(), ccfg.EVM().Transactions().StuckTxRecovery(), feeEstimator, txStore, ethClient)
 		ht := headtracker.NewSimulatedHeadTracker(ethClient, true, 0)
-		ec := txmgr.NewEvmConfirmer(txStore, txmgr.NewEvmTxmClient(ethClient, nil), ccfg.EVM(), txmgr.NewEvmTxmFeeConfig(ccfg.EVM().GasEstimator()), ccfg.EVM().Transactions(), cfg.Database(), kst, txBuilder, lggr, stuckTxDetector, ht)
+		ec := txmgr.NewEvmConfirmer(txStore, txmgr.NewEvmTxmClient(ethClient, nil), ccfg.EVM(), txmgr.NewEvmTxmFeeConfig(ccfg.EVM().GasEstimator()), ccfg.EVM().Transactions(), cfg.Database(), kst, txBuilder, lggr, stuckTxDetector, ht, nil)
 		servicetest.Run(t, ec)
 		currentHead := int64(30)
 		oldEnough := int64(15)
@@ -3233,9 +3234,9 @@ func TestEthConfirmer_ProcessStuckTransactions(t *testing.T) {
 	evmcfg := evmtest.NewChainScopedConfig(t, cfg)
 	ge := evmcfg.EVM().GasEstimator()
 	txBuilder := txmgr.NewEvmTxAttemptBuilder(*ethClient.ConfiguredChainID(), ge, ethKeyStore, feeEstimator)
-	stuckTxDetector := txmgr.NewStuckTxDetector(lggr, testutils.FixtureChainID, "", assets.NewWei(assets.NewEth(100).ToInt()), evmcfg.EVM().Transactions().AutoPurge(), feeEstimator, txStore, ethClient)
+	stuckTxDetector := txmgr.NewStuckTxDetector(lggr, testutils.FixtureChainID, "", assets.NewWei(assets.NewEth(100).ToInt()), evmcfg.EVM().Transactions().AutoPurge(), evmcfg.EVM().Transactions().StuckTxRecovery(), feeEstimator, txStore, ethClient)
 	ht := headtracker.NewSimulatedHeadTracker(ethClient, true, 0)
-	ec := txmgr.NewEvmConfirmer(txStore, txmgr.NewEvmTxmClient(ethClient, nil), txmgr.NewEvmTxmConfig(evmcfg.EVM()), txmgr.NewEvmTxmFeeConfig(ge), evmcfg.EVM().Transactions(), cfg.Database(), ethKeyStore, txBuilder, lggr, stuckTxDetector, ht)
+	ec := txmgr.NewEvmConfirmer(txStore, txmgr.NewEvmTxmClient(ethClient, nil), txmgr.NewEvmTxmConfig(evmcfg.EVM()), txmgr.NewEvmTxmFeeConfig(ge), evmcfg.EVM().Transactions(), cfg.Database(), ethKeyStore, txBuilder, lggr, stuckTxDetector, ht, nil)
 	fn := func(ctx context.Context, id uuid.UUID, result interface{}, err error) error {
 		require.ErrorContains(t, err, client.TerminallyStuckMsg)
 		return nil
@@ -3318,6 +3319,159 @@ func TestEthConfirmer_ProcessStuckTransactions(t *testing.T) {
 	})
 }
 
+func TestEthConfirmer_RecoverStuckTransactions(t *testing.T) {
+	t.Parallel()
+
+	db := pgtest.NewSqlxDB(t)
+	txStore := cltest.NewTestTxStore(t, db)
+	ethKeyStore := cltest.NewKeyStore(t, db).Eth()
+	lggr := logger.Test(t)
+	ctx := tests.Context(t)
+	blockNum := int64(100)
+	recoveryThreshold := uint32(5)
+	marketGasPrice := assets.GWei(15)
+	bumpedFee := gas.EvmFee{Legacy: assets.GWei(30)}
+
+	cfg := configtest.NewGeneralConfig(t, func(c *chainlink.Config, s *chainlink.Secrets) {
+		c.EVM[0].Transactions.StuckTxRecovery.Enabled = ptr(true)
+		c.EVM[0].Transactions.StuckTxRecovery.Threshold = ptr(recoveryThreshold)
+	})
+	evmcfg := evmtest.NewChainScopedConfig(t, cfg)
+	ge := evmcfg.EVM().GasEstimator()
+
+	// newConfirmer starts a confirmer for the given keys, after their transactions are inserted
+	newConfirmer := func(t *testing.T, ethClient *clmocks.Client) *txmgr.Confirmer {
+		feeEstimator := gasmocks.NewEvmFeeEstimator(t)
+		feeEstimator.On("GetFee", mock.Anything, []byte{}, uint64(0), mock.Anything, mock.Anything, mock.Anything).Return(gas.EvmFee{Legacy: marketGasPrice}, uint64(0), nil).Maybe()
+		feeEstimator.On("BumpFee", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(bumpedFee, uint64(10_000), nil).Maybe()
+		txBuilder := txmgr.NewEvmTxAttemptBuilder(*ethClient.ConfiguredChainID(), ge, ethKeyStore, feeEstimator)
+		stuckTxDetector := txmgr.NewStuckTxDetector(lggr, testutils.FixtureChainID, "", assets.NewWei(assets.NewEth(100).ToInt()), evmcfg.EVM().Transactions().AutoPurge(), evmcfg.EVM().Transactions().StuckTxRecovery(), feeEstimator, txStore, ethClient)
+		ht := headtracker.NewSimulatedHeadTracker(ethClient, true, 0)
+		ec := txmgr.NewEvmConfirmer(txStore, txmgr.NewEvmTxmClient(ethClient, nil), txmgr.NewEvmTxmConfig(evmcfg.EVM()), txmgr.NewEvmTxmFeeConfig(ge), evmcfg.EVM().Transactions(), cfg.Database(), ethKeyStore, txBuilder, lggr, stuckTxDetector, ht, nil)
+		servicetest.Run(t, ec)
+		return ec
+	}
+
+	// mockDiagnosis answers the diagnosis batch of the address with its mined nonce and whether its attempts are known by the RPC
+	mockDiagnosis := func(ethClient *clmocks.Client, minedNonces map[gethCommon.Address]uint64, inMempool bool) {
+		ethClient.On("BatchCallContext", mock.Anything, mock.MatchedBy(func(b []rpc.BatchElem) bool {
+			return len(b) > 0 && b[0].Method == "eth_getTransactionCount"
+		})).Return(nil).Run(func(args mock.Arguments) {
+			elems := args.Get(1).([]rpc.BatchElem)
+			for _, elem := range elems {
+				switch elem.Method {
+				case "eth_getTransactionCount":
+					*(elem.Result.(*hexutil.Uint64)) = hexutil.Uint64(minedNonces[elem.Args[0].(gethCommon.Address)])
+				case "eth_getTransactionByHash":
+					if inMempool {
+						*(elem.Result.(*map[string]interface{})) = map[string]interface{}{"hash": elem.Args[0]}
+					}
+				}
+			}
+		}).Once()
+	}
+
+	t.Run("rebroadcasts a transaction dropped from the mempool and does not bump it at the same block", func(t *testing.T) {
+		ethClient := testutils.NewEthClientMockWithDefaultChain(t)
+		_, fromAddress := cltest.MustInsertRandomKey(t, ethKeyStore)
+		tx := mustInsertUnconfirmedTxWithBroadcastAttempts(t, txStore, 0, fromAddress, 1, blockNum-10, marketGasPrice)
+		ec := newConfirmer(t, ethClient)
+		mockDiagnosis(ethClient, map[gethCommon.Address]uint64{fromAddress: 0}, false)
+		ethClient.On("SendTransactionReturnCode", mock.Anything, mock.MatchedBy(func(signedTx *types.Transaction) bool {
+			return signedTx.Nonce() == uint64(*tx.Sequence)
+		}), fromAddress).Return(commonclient.Successful, nil).Once()
+
+		require.NoError(t, ec.RecoverStuckTransactions(ctx, blockNum))
+		// The transaction requires a bump, but it was just rebroadcast
+		require.NoError(t, ec.RebroadcastWhereNecessary(ctx, blockNum))
+
+		dbTx, err := txStore.FindTxWithAttempts(ctx, tx.ID)
+		require.NoError(t, err)
+		require.Len(t, dbTx.TxAttempts, 1)
+
+		history, err := ec.StuckTxHistory(ctx, fromAddress)
+		require.NoError(t, err)
+		require.Len(t, history, 1)
+		assert.Equal(t, tx.ID, history[0].TxID)
+		assert.Equal(t, *tx.Sequence, history[0].Sequence)
+		assert.Equal(t, txmgrtypes.StuckTxReasonDroppedFromMempool, history[0].Reason)
+		assert.Equal(t, txmgrtypes.StuckTxRemedyRebroadcast, history[0].Remedy)
+		assert.Equal(t, blockNum, history[0].BlockNum)
+		assert.Empty(t, history[0].Error)
+	})
+
+	t.Run("bumps a transaction with a fee below market", func(t *testing.T) {
+		ethClient := testutils.NewEthClientMockWithDefaultChain(t)
+		_, fromAddress := cltest.MustInsertRandomKey(t, ethKeyStore)
+		tx := mustInsertUnconfirmedTxWithBroadcastAttempts(t, txStore, 0, fromAddress, 1, blockNum-10, tenGwei)
+		ec := newConfirmer(t, ethClient)
+		mockDiagnosis(ethClient, map[gethCommon.Address]uint64{fromAddress: 0}, true)
+		ethClient.On("SendTransactionReturnCode", mock.Anything, mock.MatchedBy(func(signedTx *types.Transaction) bool {
+			return signedTx.Nonce() == uint64(*tx.Sequence) && signedTx.GasPrice().Cmp(bumpedFee.Legacy.ToInt()) == 0
+		}), fromAddress).Return(commonclient.Successful, nil).Once()
+
+		require.NoError(t, ec.RecoverStuckTransactions(ctx, blockNum))
+
+		dbTx, err := txStore.FindTxWithAttempts(ctx, tx.ID)
+		require.NoError(t, err)
+		require.Len(t, dbTx.TxAttempts, 2)
+		assert.Equal(t, bumpedFee.Legacy, dbTx.TxAttempts[0].TxFee.Legacy)
+		assert.Equal(t, txmgrtypes.TxAttemptBroadcast, dbTx.TxAttempts[0].State)
+
+		history, err := ec.StuckTxHistory(ctx, fromAddress)
+		require.NoError(t, err)
+		require.Len(t, history, 1)
+		assert.Equal(t, txmgrtypes.StuckTxReasonFeeTooLow, history[0].Reason)
+		assert.Equal(t, txmgrtypes.StuckTxRemedyBump, history[0].Remedy)
+	})
+
+	t.Run("sends a self transfer at the missing nonce of a nonce gap", func(t *testing.T) {
+		ethClient := testutils.NewEthClientMockWithDefaultChain(t)
+		_, fromAddress := cltest.MustInsertRandomKey(t, ethKeyStore)
+		tx := mustInsertUnconfirmedTxWithBroadcastAttempts(t, txStore, 2, fromAddress, 1, blockNum-10, marketGasPrice)
+		ec := newConfirmer(t, ethClient)
+		mockDiagnosis(ethClient, map[gethCommon.Address]uint64{fromAddress: 1}, true)
+		ethClient.On("SendTransactionReturnCode", mock.Anything, mock.MatchedBy(func(signedTx *types.Transaction) bool {
+			return signedTx.Nonce() == 1 && *signedTx.To() == fromAddress && signedTx.Value().Sign() == 0
+		}), fromAddress).Return(commonclient.Successful, nil).Once()
+
+		require.NoError(t, ec.RecoverStuckTransactions(ctx, blockNum))
+
+		history, err := ec.StuckTxHistory(ctx, fromAddress)
+		require.NoError(t, err)
+		require.Len(t, history, 1)
+		assert.Equal(t, tx.ID, history[0].TxID)
+		assert.Equal(t, evmtypes.Nonce(1), history[0].Sequence)
+		assert.Equal(t, txmgrtypes.StuckTxReasonNonceGap, history[0].Reason)
+		assert.Equal(t, txmgrtypes.StuckTxRemedySelfTransfer, history[0].Remedy)
+	})
+
+	t.Run("records a failed remedy and recovers the other keys", func(t *testing.T) {
+		ethClient := testutils.NewEthClientMockWithDefaultChain(t)
+		_, fromAddress1 := cltest.MustInsertRandomKey(t, ethKeyStore)
+		_, fromAddress2 := cltest.MustInsertRandomKey(t, ethKeyStore)
+		mustInsertUnconfirmedTxWithBroadcastAttempts(t, txStore, 0, fromAddress1, 1, blockNum-10, marketGasPrice)
+		mustInsertUnconfirmedTxWithBroadcastAttempts(t, txStore, 0, fromAddress2, 1, blockNum-10, marketGasPrice)
+		ec := newConfirmer(t, ethClient)
+		mockDiagnosis(ethClient, map[gethCommon.Address]uint64{fromAddress1: 0, fromAddress2: 0}, false)
+		ethClient.On("SendTransactionReturnCode", mock.Anything, mock.Anything, fromAddress1).Return(commonclient.Fatal, errors.New("rpc unavailable")).Once()
+		ethClient.On("SendTransactionReturnCode", mock.Anything, mock.Anything, fromAddress2).Return(commonclient.Successful, nil).Once()
+
+		err := ec.RecoverStuckTransactions(ctx, blockNum)
+		require.ErrorContains(t, err, "rpc unavailable")
+
+		history, err := ec.StuckTxHistory(ctx, fromAddress1)
+		require.NoError(t, err)
+		require.Len(t, history, 1)
+		assert.Contains(t, history[0].Error, "rpc unavailable")
+
+		history, err = ec.StuckTxHistory(ctx, fromAddress2)
+		require.NoError(t, err)
+		require.Len(t, history, 1)
+		assert.Empty(t, history[0].Error)
+	})
+}
+
 func ptr[T any](t T) *T { return &t }
 
 func newEthConfirmer(t testing.TB, txStore txmgr.EvmTxStore, ethClient client.Client, gconfig chainlink.GeneralConfig, config evmconfig.ChainScopedConfig, ks keystore.Eth, fn txmgrcommon.ResumeCallback) *txmgr.Confirmer {
@@ -3327,9 +3481,9 @@ func newEthConfirmer(t testing.TB, txStore txmgr.EvmTxStore, ethClient client.Cl
 		return gas.NewFixedPriceEstimator(ge, nil, ge.BlockHistory(), lggr, nil)
 	}, ge.EIP1559DynamicFees(), ge, ethClient)
 	txBuilder := txmgr.NewEvmTxAttemptBuilder(*ethClient.ConfiguredChainID(), ge, ks, estimator)
-	stuckTxDetector := txmgr.NewStuckTxDetector(lggr, testutils.FixtureChainID, "", assets.NewWei(assets.NewEth(100).ToInt()), config.EVM().Transactions().AutoPurge(), estimator, txStore, ethClient)
+	stuckTxDetector := txmgr.NewStuckTxDetector(lggr, testutils.FixtureChainID, "", assets.NewWei(assets.NewEth(100).ToInt()), config.EVM().Transactions().AutoPurge(), config.EVM().Transactions().StuckTxRecovery(), estimator, txStore, ethClient)
 	ht := headtracker.NewSimulatedHeadTracker(ethClient, true, 0)
-	ec := txmgr.NewEvmConfirmer(txStore, txmgr.NewEvmTxmClient(ethClient, nil), txmgr.NewEvmTxmConfig(config.EVM()), txmgr.NewEvmTxmFeeConfig(ge), config.EVM().Transactions(), gconfig.Database(), ks, txBuilder, lggr, stuckTxDetector, ht)
+	ec := txmgr.NewEvmConfirmer(txStore, txmgr.NewEvmTxmClient(ethClient, nil), txmgr.NewEvmTxmConfig(config.EVM()), txmgr.NewEvmTxmFeeConfig(ge), config.EVM().Transactions(), gconfig.Database(), ks, txBuilder, lggr, stuckTxDetector, ht, nil)
 	ec.SetResumeCallback(fn)
 	servicetest.Run(t, ec)
 	return ec
@@ -199,6 +199,8 @@ type DbEthTx struct {
 	SignalCallback bool
 	// Marks tx callback as signaled
 	CallbackCompleted bool
+	// Blobs carried by blob transactions
+	Blobs pq.ByteaArray
 }
 
 func (db *DbEthTx) FromTx(tx *Tx) {
@@ -221,6 +223,7 @@ func (db *DbEthTx) FromTx(tx *Tx) {
 	db.InitialBroadcastAt = tx.InitialBroadcastAt
 	db.SignalCallback = tx.SignalCallback
 	db.CallbackCompleted = tx.CallbackCompleted
+	db.Blobs = tx.Blobs
 
 	if tx.ChainID != nil {
 		db.EVMChainID = *ubig.New(tx.ChainID)
@@ -256,6 +259,7 @@ func (db DbEthTx) ToTx(tx *Tx) {
 	tx.InitialBroadcastAt = db.InitialBroadcastAt
 	tx.SignalCallback = db.SignalCallback
 	tx.CallbackCompleted = db.CallbackCompleted
+	tx.Blobs = db.Blobs
 }
 
 func dbEthTxsToEvmEthTxs(dbEthTxs []DbEthTx) []Tx {
@@ -289,6 +293,7 @@ type DbEthTxAttempt struct {
 	GasTipCap               *assets.Wei
 	GasFeeCap               *assets.Wei
 	IsPurgeAttempt          bool
+	BlobFeeCap              *assets.Wei
 }
 
 func (db *DbEthTxAttempt) FromTxAttempt(attempt *TxAttempt) {
@@ -303,6 +308,7 @@ func (db *DbEthTxAttempt) FromTxAttempt(attempt *TxAttempt) {
 	db.TxType = attempt.TxType
 	db.GasTipCap = attempt.TxFee.DynamicTipCap
 	db.GasFeeCap = attempt.TxFee.DynamicFeeCap
+	db.BlobFeeCap = attempt.TxFee.BlobFeeCap
 	db.IsPurgeAttempt = attempt.IsPurgeAttempt
 
 	// handle state naming difference between generic + EVM
@@ -334,6 +340,7 @@ func (db DbEthTxAttempt) ToTxAttempt(attempt *TxAttempt) {
 		Legacy:        db.GasPrice,
 		DynamicTipCap: db.GasTipCap,
 		DynamicFeeCap: db.GasFeeCap,
+		BlobFeeCap:    db.BlobFeeCap,
 	}
 	attempt.IsPurgeAttempt = db.IsPurgeAttempt
 }
@@ -359,8 +366,8 @@ func NewTxStore(
 }
 
 const insertIntoEthTxAttemptsQuery = `
-INSERT INTO evm.tx_attempts (eth_tx_id, gas_price, signed_raw_tx, hash, broadcast_before_block_num, state, created_at, chain_specific_gas_limit, tx_type, gas_tip_cap, gas_fee_cap, is_purge_attempt)
-VALUES (:eth_tx_id, :gas_price, :signed_raw_tx, :hash, :broadcast_before_block_num, :state, NOW(), :chain_specific_gas_limit, :tx_type, :gas_tip_cap, :gas_fee_cap, :is_purge_attempt)
+INSERT INTO evm.tx_attempts (eth_tx_id, gas_price, signed_raw_tx, hash, broadcast_before_block_num, state, created_at, chain_specific_gas_limit, tx_type, gas_tip_cap, gas_fee_cap, is_purge_attempt, blob_fee_cap)
+VALUES (:eth_tx_id, :gas_price, :signed_raw_tx, :hash, :broadcast_before_block_num, :state, NOW(), :chain_specific_gas_limit, :tx_type, :gas_tip_cap, :gas_fee_cap, :is_purge_attempt, :blob_fee_cap)
 RETURNING *;
 `
 
@@ -407,6 +414,17 @@ func (o *evmTxStore) PreloadTxes(ctx context.Context, attempts []TxAttempt) erro
 	return o.preloadTxesAtomic(ctx, attempts)
 }
 
+// preloadBlobTxesAtomic loads the txes of the attempts if any of them is a blob tx attempt, whose sidecar is built from
+// the blobs of the tx when it is sent
+func (o *evmTxStore) preloadBlobTxesAtomic(ctx context.Context, attempts []TxAttempt) error {
+	for _, attempt := range attempts {
+		if attempt.TxType == 0x3 {
+			return o.preloadTxesAtomic(ctx, attempts)
+		}
+	}
+	return nil
+}
+
 // Only to be used for atomic transactions internal to the tx store
 func (o *evmTxStore) preloadTxesAtomic(ctx context.Context, attempts []TxAttempt) error {
 	ethTxM := make(map[int64]Tx)
@@ -531,8 +549,8 @@ func (o *evmTxStore) InsertTx(ctx context.Context, etx *Tx) error {
 	if etx.CreatedAt == (time.Time{}) {
 		etx.CreatedAt = time.Now()
 	}
-	const insertEthTxSQL = `INSERT INTO evm.txes (nonce, from_address, to_address, encoded_payload, value, gas_limit, error, broadcast_at, initial_broadcast_at, created_at, state, meta, subject, pipeline_task_run_id, min_confirmations, evm_chain_id, transmit_checker, idempotency_key, signal_callback, callback_completed) VALUES (
-:nonce, :from_address, :to_address, :encoded_payload, :value, :gas_limit, :error, :broadcast_at, :initial_broadcast_at, :created_at, :state, :meta, :subject, :pipeline_task_run_id, :min_confirmations, :evm_chain_id, :transmit_checker, :idempotency_key, :signal_callback, :callback_completed
+	const insertEthTxSQL = `INSERT INTO evm.txes (nonce, from_address, to_address, encoded_payload, value, gas_limit, error, broadcast_at, initial_broadcast_at, created_at, state, meta, subject, pipeline_task_run_id, min_confirmations, evm_chain_id, transmit_checker, idempotency_key, signal_callback, callback_completed, blobs) VALUES (
+:nonce, :from_address, :to_address, :encoded_payload, :value, :gas_limit, :error, :broadcast_at, :initial_broadcast_at, :created_at, :state, :meta, :subject, :pipeline_task_run_id, :min_confirmations, :evm_chain_id, :transmit_checker, :idempotency_key, :signal_callback, :callback_completed, :blobs
 ) RETURNING *`
 	var dbTx DbEthTx
 	dbTx.FromTx(etx)
@@ -777,8 +795,11 @@ ORDER BY evm.txes.nonce ASC, evm.tx_attempts.gas_price DESC, evm.tx_attempts.gas
 LIMIT $4
 `, olderThan, chainID.String(), address, limit)
 
+	if err != nil {
+		return nil, pkgerrors.Wrap(err, "FindEthTxAttemptsRequiringResend failed to load evm.tx_attempts")
+	}
 	attempts = dbEthTxAttemptsToEthTxAttempts(dbAttempts)
-	return attempts, pkgerrors.Wrap(err, "FindEthTxAttemptsRequiringResend failed to load evm.tx_attempts")
+	return attempts, o.preloadBlobTxesAtomic(ctx, attempts)
 }
 
 func (o *evmTxStore) UpdateBroadcastAts(ctx context.Context, now time.Time, etxIDs []int64) error {
@@ -828,8 +849,10 @@ func (o *evmTxStore) FindTxAttemptsConfirmedMissingReceipt(ctx context.Context,
 		chainID.String())
 	if err != nil {
 		err = pkgerrors.Wrap(err, "FindEtxAttemptsConfirmedMissingReceipt failed to query")
+		return
 	}
 	attempts = dbEthTxAttemptsToEthTxAttempts(dbAttempts)
+	err = o.preloadBlobTxesAtomic(ctx, attempts)
 	return
 }
 
@@ -983,6 +1006,69 @@ func (o *evmTxStore) SaveFetchedReceipts(ctx context.Context, r []*evmtypes.Rece
 	return pkgerrors.Wrap(err, "SaveFetchedReceipts failed to save receipts")
 }
 
+// Directly maps to columns of database table "evm.stuck_tx_decisions".
+type dbStuckTxDecision struct {
+	EthTxID     int64
+	FromAddress common.Address
+	Nonce       int64
+	Reason      string
+	Remedy      string
+	BlockNum    int64
+	Error       nullv4.String
+	DecidedAt   time.Time
+}
+
+func (d dbStuckTxDecision) toDecision() StuckTxDecision {
+	return StuckTxDecision{
+		TxID:        d.EthTxID,
+		FromAddress: d.FromAddress,
+		Sequence:    evmtypes.Nonce(d.Nonce),
+		Reason:      txmgrtypes.StuckTxReason(d.Reason),
+		Remedy:      txmgrtypes.StuckTxRemedy(d.Remedy),
+		BlockNum:    d.BlockNum,
+		DecidedAt:   d.DecidedAt,
+		Error:       d.Error.String,
+	}
+}
+
+// SaveStuckTxDecision inserts the decision and deletes the decisions of the same from address older than the latest historySize
+func (o *evmTxStore) SaveStuckTxDecision(ctx context.Context, decision StuckTxDecision, historySize int, chainID *big.Int) error {
+	var cancel context.CancelFunc
+	ctx, cancel = o.stopCh.Ctx(ctx)
+	defer cancel()
+	return o.Transact(ctx, false, func(orm *evmTxStore) error {
+		_, err := orm.q.ExecContext(ctx, `INSERT INTO evm.stuck_tx_decisions (evm_chain_id, eth_tx_id, from_address, nonce, reason, remedy, block_num, error, decided_at)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)`,
+			chainID.String(), decision.TxID, decision.FromAddress, decision.Sequence.Int64(), decision.Reason, decision.Remedy, decision.BlockNum, nullv4.NewString(decision.Error, decision.Error != ""), decision.DecidedAt)
+		if err != nil {
+			return pkgerrors.Wrap(err, "SaveStuckTxDecision failed to insert decision")
+		}
+		_, err = orm.q.ExecContext(ctx, `DELETE FROM evm.stuck_tx_decisions WHERE evm_chain_id = $1 AND from_address = $2 AND id <= (
+	SELECT id FROM evm.stuck_tx_decisions WHERE evm_chain_id = $1 AND from_address = $2 ORDER BY id DESC OFFSET $3 LIMIT 1
+)`, chainID.String(), decision.FromAddress, historySize)
+		return pkgerrors.Wrap(err, "SaveStuckTxDecision failed to prune decisions")
+	})
+}
+
+// FindStuckTxDecisions returns the latest limit stuck transaction decisions of the address, oldest first
+func (o *evmTxStore) FindStuckTxDecisions(ctx context.Context, address common.Address, limit int, chainID *big.Int) ([]StuckTxDecision, error) {
+	var cancel context.CancelFunc
+	ctx, cancel = o.stopCh.Ctx(ctx)
+	defer cancel()
+	var dbDecisions []dbStuckTxDecision
+	err := o.q.SelectContext(ctx, &dbDecisions, `SELECT eth_tx_id, from_address, nonce, reason, remedy, block_num, error, decided_at FROM (
+	SELECT * FROM evm.stuck_tx_decisions WHERE evm_chain_id = $1 AND from_address = $2 ORDER BY id DESC LIMIT $3
+) latest ORDER BY id ASC`, chainID.String(), address, limit)
+	if err != nil {
+		return nil, pkgerrors.Wrap(err, "FindStuckTxDecisions failed to load decisions")
+	}
+	decisions := make([]StuckTxDecision, len(dbDecisions))
+	for i, d := range dbDecisions {
+		decisions[i] = d.toDecision()
+	}
+	return decisions, nil
+}
+
 // MarkAllConfirmedMissingReceipt
 // It is possible that we can fail to get a receipt for all evm.tx_attempts
 // even though a transaction with this nonce has long since been confirmed (we
@@ -1670,7 +1756,8 @@ func (o *evmTxStore) UpdateTxAttemptInProgressToBroadcast(ctx context.Context, e
 	})
 }
 
-// Updates eth tx from unstarted to in_progress and inserts in_progress eth attempt
+// Updates eth tx from unstarted to in_progress and inserts in_progress eth attempt.
+// The payload is saved as well, since it may have been rebuilt by the transmit checker.
 func (o *evmTxStore) UpdateTxUnstartedToInProgress(ctx context.Context, etx *Tx, attempt *TxAttempt) error {
 	var cancel context.CancelFunc
 	ctx, cancel = o.stopCh.Ctx(ctx)
@@ -1731,7 +1818,7 @@ func (o *evmTxStore) UpdateTxUnstartedToInProgress(ctx context.Context, etx *Tx,
 		dbAttempt.ToTxAttempt(attempt)
 		var dbEtx DbEthTx
 		dbEtx.FromTx(etx)
-		err = orm.q.GetContext(ctx, &dbEtx, `UPDATE evm.txes SET nonce=$1, state=$2, broadcast_at=$3, initial_broadcast_at=$4 WHERE id=$5 RETURNING *`, etx.Sequence, etx.State, etx.BroadcastAt, etx.InitialBroadcastAt, etx.ID)
+		err = orm.q.GetContext(ctx, &dbEtx, `UPDATE evm.txes SET nonce=$1, state=$2, broadcast_at=$3, initial_broadcast_at=$4, encoded_payload=$5 WHERE id=$6 RETURNING *`, etx.Sequence, etx.State, etx.BroadcastAt, etx.InitialBroadcastAt, etx.EncodedPayload, etx.ID)
 		dbEtx.ToTx(etx)
 		return pkgerrors.Wrap(err, "UpdateTxUnstartedToInProgress failed to update eth_tx")
 	})
@@ -1850,12 +1937,12 @@ func (o *evmTxStore) CreateTransaction(ctx context.Context, txRequest TxRequest,
 			}
 		}
 		err = orm.q.GetContext(ctx, &dbEtx, `
-INSERT INTO evm.txes (from_address, to_address, encoded_payload, value, gas_limit, state, created_at, meta, subject, evm_chain_id, min_confirmations, pipeline_task_run_id, transmit_checker, idempotency_key, signal_callback)
+INSERT INTO evm.txes (from_address, to_address, encoded_payload, value, gas_limit, state, created_at, meta, subject, evm_chain_id, min_confirmations, pipeline_task_run_id, transmit_checker, idempotency_key, signal_callback, blobs)
 VALUES (
-$1,$2,$3,$4,$5,'unstarted',NOW(),$6,$7,$8,$9,$10,$11,$12,$13
+$1,$2,$3,$4,$5,'unstarted',NOW(),$6,$7,$8,$9,$10,$11,$12,$13,$14
 )
 RETURNING "txes".*
-`, txRequest.FromAddress, txRequest.ToAddress, txRequest.EncodedPayload, assets.Eth(txRequest.Value), txRequest.FeeLimit, txRequest.Meta, txRequest.Strategy.Subject(), chainID.String(), txRequest.MinConfirmations, txRequest.PipelineTaskRunID, txRequest.Checker, txRequest.IdempotencyKey, txRequest.SignalCallback)
+`, txRequest.FromAddress, txRequest.ToAddress, txRequest.EncodedPayload, assets.Eth(txRequest.Value), txRequest.FeeLimit, txRequest.Meta, txRequest.Strategy.Subject(), chainID.String(), txRequest.MinConfirmations, txRequest.PipelineTaskRunID, txRequest.Checker, txRequest.IdempotencyKey, txRequest.SignalCallback, pq.ByteaArray(txRequest.Blobs))
 		if err != nil {
 			return pkgerrors.Wrap(err, "CreateEthTransaction failed to insert evm tx")
 		}
@@ -1367,7 +1367,7 @@ func TestORM_UpdateTxUnstartedToInProgress(t *testing.T) {
 		evmTxmCfg := txmgr.NewEvmTxmConfig(ccfg.EVM())
 		ec := evmtest.NewEthClientMockWithDefaultChain(t)
 		txMgr := txmgr.NewEvmTxm(ec.ConfiguredChainID(), evmTxmCfg, ccfg.EVM().Transactions(), nil, logger.Test(t), nil, nil,
-			nil, txStore, nil, nil, nil, nil, nil)
+			nil, nil, txStore, nil, nil, nil, nil, nil)
 		err := txMgr.XXXTestAbandon(fromAddress) // mark transaction as abandoned
 		require.NoError(t, err)
 
@@ -1913,3 +1913,69 @@ func TestORM_UpdateTxesFinalized(t *testing.T) {
 		require.Equal(t, txmgrcommon.TxFinalized, etx.State)
 	})
 }
+
+func TestORM_StuckTxDecisions(t *testing.T) {
+	t.Parallel()
+
+	db := pgtest.NewSqlxDB(t)
+	txStore := cltest.NewTestTxStore(t, db)
+	ethKeyStore := cltest.NewKeyStore(t, db).Eth()
+	_, fromAddress := cltest.MustInsertRandomKey(t, ethKeyStore)
+	_, otherAddress := cltest.MustInsertRandomKey(t, ethKeyStore)
+	ctx := tests.Context(t)
+	etx := cltest.MustInsertUnconfirmedEthTx(t, txStore, 0, fromAddress)
+	otherEtx := cltest.MustInsertUnconfirmedEthTx(t, txStore, 0, otherAddress)
+
+	decision := func(etx txmgr.Tx, blockNum int64, errMsg string) txmgr.StuckTxDecision {
+		return txmgr.StuckTxDecision{
+			TxID:        etx.ID,
+			FromAddress: etx.FromAddress,
+			Sequence:    *etx.Sequence,
+			Reason:      txmgrtypes.StuckTxReasonFeeTooLow,
+			Remedy:      txmgrtypes.StuckTxRemedyBump,
+			BlockNum:    blockNum,
+			DecidedAt:   time.Now().UTC().Truncate(time.Microsecond),
+			Error:       errMsg,
+		}
+	}
+
+	t.Run("returns the latest decisions of the address, oldest first", func(t *testing.T) {
+		first := decision(etx, 1, "")
+		require.NoError(t, txStore.SaveStuckTxDecision(ctx, first, 3, testutils.FixtureChainID))
+		require.NoError(t, txStore.SaveStuckTxDecision(ctx, decision(etx, 2, "failed"), 3, testutils.FixtureChainID))
+		require.NoError(t, txStore.SaveStuckTxDecision(ctx, decision(otherEtx, 2, ""), 3, testutils.FixtureChainID))
+
+		decisions, err := txStore.FindStuckTxDecisions(ctx, fromAddress, 10, testutils.FixtureChainID)
+		require.NoError(t, err)
+		require.Len(t, decisions, 2)
+		assert.Equal(t, first.TxID, decisions[0].TxID)
+		assert.Equal(t, first.Sequence, decisions[0].Sequence)
+		assert.Equal(t, first.Reason, decisions[0].Reason)
+		assert.Equal(t, first.Remedy, decisions[0].Remedy)
+		assert.Equal(t, first.DecidedAt, decisions[0].DecidedAt.UTC())
+		assert.Empty(t, decisions[0].Error)
+		assert.Equal(t, int64(2), decisions[1].BlockNum)
+		assert.Equal(t, "failed", decisions[1].Error)
+
+		decisions, err = txStore.FindStuckTxDecisions(ctx, fromAddress, 1, testutils.FixtureChainID)
+		require.NoError(t, err)
+		require.Len(t, decisions, 1)
+		assert.Equal(t, int64(2), decisions[0].BlockNum)
+	})
+
+	t.Run("keeps only the latest decisions of the address", func(t *testing.T) {
+		for blockNum := int64(3); blockNum <= 5; blockNum++ {
+			require.NoError(t, txStore.SaveStuckTxDecision(ctx, decision(etx, blockNum, ""), 3, testutils.FixtureChainID))
+		}
+
+		decisions, err := txStore.FindStuckTxDecisions(ctx, fromAddress, 10, testutils.FixtureChainID)
+		require.NoError(t, err)
+		require.Len(t, decisions, 3)
+		assert.Equal(t, int64(3), decisions[0].BlockNum)
+		assert.Equal(t, int64(5), decisions[2].BlockNum)
+
+		decisions, err = txStore.FindStuckTxDecisions(ctx, otherAddress, 10, testutils.FixtureChainID)
+		require.NoError(t, err)
+		require.Len(t, decisions, 1)
+	})
+}
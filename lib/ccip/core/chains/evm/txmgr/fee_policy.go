@@ -0,0 +1,192 @@
+package txmgr
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/logger"
+	"github.com/smartcontractkit/chainlink-common/pkg/sqlutil"
+	commonfee "github.com/smartcontractkit/chainlink/v2/common/fee"
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/assets"
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/config"
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/gas"
+	ubig "github.com/smartcontractkit/chainlink/v2/core/chains/evm/utils/big"
+)
+
+var promFeePolicySpend = promauto.NewCounterVec(prometheus.CounterOpts{
+	Name: "tx_manager_fee_policy_spend_wei",
+	Help: "Total fees in wei spent by confirmed transactions, labeled by fee policy",
+}, []string{"chainID", "policy"})
+
+// feePolicyWindow is the window over which the spend of a fee policy is limited
+const feePolicyWindow = time.Hour
+
+var _ FeePolicyEnforcer = (*feePolicyEnforcer)(nil)
+
+// feePolicyEnforcer applies the fee policies configured in GasEstimator.FeePolicies
+// to the transactions whose TxMeta.FeePolicy names them. Transactions without a
+// fee policy are bumped as usual.
+//
+// The fees spent by each policy are stored in evm.fee_policy_spend, so the spend
+// limits hold across restarts and the txes confirmed by other instances of the
+// chain are accounted for.
+type feePolicyEnforcer struct {
+	lggr     logger.SugaredLogger
+	ds       sqlutil.DataSource
+	chainID  *big.Int
+	policies map[string]config.FeePolicy
+	now      func() time.Time
+}
+
+func NewFeePolicyEnforcer(lggr logger.Logger, ds sqlutil.DataSource, chainID *big.Int, policies []config.FeePolicy) *feePolicyEnforcer {
+	e := &feePolicyEnforcer{
+		lggr:     logger.Sugared(logger.Named(lggr, "FeePolicyEnforcer")),
+		ds:       ds,
+		chainID:  chainID,
+		policies: make(map[string]config.FeePolicy, len(policies)),
+		now:      time.Now,
+	}
+	for _, p := range policies {
+		e.policies[p.Name()] = p
+	}
+	return e
+}
+
+// BumpCount returns the most bumps of the urgency steps reached by the tx, either by its age,
+// or by how close it is to its TxMeta.Deadline
+func (e *feePolicyEnforcer) BumpCount(etx Tx) int {
+	policy, meta := e.policyFor(etx)
+	if policy == nil {
+		return 1
+	}
+	bumps := 1
+	now := e.now()
+	age := now.Sub(etx.CreatedAt)
+	for _, u := range policy.Urgency() {
+		reached := u.After > 0 && age >= u.After
+		if u.BeforeDeadline > 0 && meta.Deadline != nil {
+			reached = meta.Deadline.Sub(now) <= u.BeforeDeadline
+		}
+		if reached && int(u.Bumps) > bumps {
+			bumps = int(u.Bumps)
+		}
+	}
+	return bumps
+}
+
+func (e *feePolicyEnforcer) CheckBump(ctx context.Context, etx Tx, bumpedAttempt TxAttempt) error {
+	policy, _ := e.policyFor(etx)
+	if policy == nil {
+		return nil
+	}
+	// The fee limit of the attempt is the max gas the tx may use, so this is the max fee it may spend
+	maxFee := new(big.Int).Mul(feePrice(bumpedAttempt.TxFee).ToInt(), new(big.Int).SetUint64(bumpedAttempt.ChainSpecificFeeLimit))
+
+	if pct := policy.MaxFeeValuePercent(); pct > 0 && etx.Value.Sign() > 0 {
+		maxValueFee := new(big.Int).Mul(&etx.Value, big.NewInt(int64(pct)))
+		maxValueFee.Div(maxValueFee, big.NewInt(100))
+		if maxFee.Cmp(maxValueFee) > 0 {
+			return fmt.Errorf("fee policy %s: bumped fee %s exceeds %d%% of the tx value %s: %w", policy.Name(), assets.NewWei(maxFee), pct, assets.NewWei(&etx.Value), commonfee.ErrBump)
+		}
+	}
+
+	if maxSpend := policy.MaxSpendPerHour(); maxSpend != nil {
+		spent, err := e.Spend(ctx, policy.Name())
+		if err != nil {
+			return err
+		}
+		if new(big.Int).Add(spent.ToInt(), maxFee).Cmp(maxSpend.ToInt()) > 0 {
+			return fmt.Errorf("fee policy %s: bumped fee %s would exceed the max spend per hour %s, already spent %s: %w", policy.Name(), assets.NewWei(maxFee), maxSpend, spent, commonfee.ErrBump)
+		}
+	}
+	return nil
+}
+
+func (e *feePolicyEnforcer) RecordConfirmed(ctx context.Context, attempt TxAttempt, gasUsed uint64, effectiveGasPrice *big.Int) error {
+	policy, _ := e.policyFor(attempt.Tx)
+	if policy == nil {
+		return nil
+	}
+	// The fee cap of dynamic fee attempts is usually well above what they pay, the receipt has the actual price
+	price := effectiveGasPrice
+	if price == nil {
+		e.lggr.Debugw("Receipt has no effective gas price, recording the spend at the attempt price", "txID", attempt.TxID, "txHash", attempt.Hash)
+		price = feePrice(attempt.TxFee).ToInt()
+	}
+	spend := new(big.Int).Mul(price, new(big.Int).SetUint64(gasUsed))
+
+	now := e.now()
+	var recorded bool
+	err := sqlutil.TransactDataSource(ctx, e.ds, nil, func(tx sqlutil.DataSource) error {
+		// Receipts may be fetched more than once for the same tx, its spend is only recorded once
+		res, err := tx.ExecContext(ctx, `INSERT INTO evm.fee_policy_spend (evm_chain_id, eth_tx_id, policy, spend, spent_at)
+VALUES ($1, $2, $3, $4, $5) ON CONFLICT (evm_chain_id, eth_tx_id) DO NOTHING`,
+			ubig.New(e.chainID), attempt.TxID, policy.Name(), ubig.New(spend), now)
+		if err != nil {
+			return fmt.Errorf("failed to insert fee policy spend: %w", err)
+		}
+		n, err := res.RowsAffected()
+		if err != nil {
+			return err
+		}
+		recorded = n > 0
+		_, err = tx.ExecContext(ctx, `DELETE FROM evm.fee_policy_spend WHERE evm_chain_id = $1 AND spent_at <= $2`, ubig.New(e.chainID), now.Add(-feePolicyWindow))
+		if err != nil {
+			return fmt.Errorf("failed to prune fee policy spend: %w", err)
+		}
+		return nil
+	})
+	if err != nil || !recorded {
+		return err
+	}
+
+	f, _ := new(big.Float).SetInt(spend).Float64()
+	promFeePolicySpend.WithLabelValues(e.chainID.String(), policy.Name()).Add(f)
+	e.lggr.Debugw("Recorded fee spent by confirmed tx", "policy", policy.Name(), "txID", attempt.TxID, "spend", assets.NewWei(spend))
+	return nil
+}
+
+// Spend returns the fees spent within the last hour by the txes of the named fee policy
+func (e *feePolicyEnforcer) Spend(ctx context.Context, policy string) (*assets.Wei, error) {
+	var spend ubig.Big
+	err := e.ds.GetContext(ctx, &spend, `SELECT COALESCE(SUM(spend), 0) FROM evm.fee_policy_spend WHERE evm_chain_id = $1 AND policy = $2 AND spent_at > $3`,
+		ubig.New(e.chainID), policy, e.now().Add(-feePolicyWindow))
+	if err != nil {
+		return nil, fmt.Errorf("failed to load the spend of fee policy %s: %w", policy, err)
+	}
+	return assets.NewWei(spend.ToInt()), nil
+}
+
+func (e *feePolicyEnforcer) policyFor(etx Tx) (config.FeePolicy, *TxMeta) {
+	meta, err := etx.GetMeta()
+	if err != nil {
+		e.lggr.Errorw("Failed to get meta of tx, ignoring its fee policy", "etxID", etx.ID, "err", err)
+		return nil, nil
+	}
+	if meta == nil || meta.FeePolicy == nil {
+		return nil, nil
+	}
+	policy, ok := e.policies[*meta.FeePolicy]
+	if !ok {
+		e.lggr.Debugw("Unknown fee policy, ignoring", "etxID", etx.ID, "policy", *meta.FeePolicy)
+		return nil, nil
+	}
+	return policy, meta
+}
+
+// feePrice returns the price per gas of the fee. The fee cap is used for dynamic
+// fees, as it is the most the tx may pay.
+func feePrice(fee gas.EvmFee) *assets.Wei {
+	if fee.ValidDynamic() {
+		return fee.DynamicFeeCap
+	}
+	if fee.Legacy != nil {
+		return fee.Legacy
+	}
+	return assets.NewWeiI(0)
+}
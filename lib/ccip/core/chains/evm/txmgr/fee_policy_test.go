@@ -0,0 +1,164 @@
+package txmgr_test
+
+import (
+	"encoding/json"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/logger"
+	"github.com/smartcontractkit/chainlink-common/pkg/sqlutil"
+	"github.com/smartcontractkit/chainlink-common/pkg/utils/tests"
+
+	commonfee "github.com/smartcontractkit/chainlink/v2/common/fee"
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/assets"
+	evmconfig "github.com/smartcontractkit/chainlink/v2/core/chains/evm/config"
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/gas"
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/txmgr"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils/pgtest"
+)
+
+type testFeePolicy struct {
+	name            string
+	maxSpendPerHour *assets.Wei
+	maxValuePercent uint16
+	urgency         []evmconfig.FeePolicyUrgency
+}
+
+func (p *testFeePolicy) Name() string                          { return p.name }
+func (p *testFeePolicy) MaxSpendPerHour() *assets.Wei          { return p.maxSpendPerHour }
+func (p *testFeePolicy) MaxFeeValuePercent() uint16            { return p.maxValuePercent }
+func (p *testFeePolicy) Urgency() []evmconfig.FeePolicyUrgency { return p.urgency }
+
+func newFeePolicyTx(t *testing.T, id int64, policy *string, age time.Duration, value int64) txmgr.Tx {
+	return newFeePolicyTxWithMeta(t, id, txmgr.TxMeta{FeePolicy: policy}, age, value)
+}
+
+func newFeePolicyTxWithMeta(t *testing.T, id int64, txMeta txmgr.TxMeta, age time.Duration, value int64) txmgr.Tx {
+	meta, err := json.Marshal(txMeta)
+	require.NoError(t, err)
+	m := sqlutil.JSON(meta)
+	return txmgr.Tx{
+		ID:        id,
+		CreatedAt: time.Now().Add(-age),
+		Value:     *big.NewInt(value),
+		Meta:      &m,
+	}
+}
+
+func newFeePolicyAttempt(etx txmgr.Tx, price int64, gasLimit uint64) txmgr.TxAttempt {
+	return txmgr.TxAttempt{
+		TxID:                  etx.ID,
+		Tx:                    etx,
+		TxFee:                 gas.EvmFee{Legacy: assets.NewWeiI(price)},
+		ChainSpecificFeeLimit: gasLimit,
+	}
+}
+
+func TestFeePolicyEnforcer(t *testing.T) {
+	t.Parallel()
+
+	ctx := tests.Context(t)
+	db := pgtest.NewSqlxDB(t)
+	commit, exec := "commit", "exec"
+	policies := []evmconfig.FeePolicy{
+		&testFeePolicy{
+			name:            commit,
+			maxSpendPerHour: assets.NewWeiI(1000),
+			urgency: []evmconfig.FeePolicyUrgency{
+				{After: time.Minute, Bumps: 2},
+				{BeforeDeadline: 30 * time.Minute, Bumps: 3},
+				{After: 10 * time.Minute, Bumps: 4},
+				{BeforeDeadline: 5 * time.Minute, Bumps: 5},
+			},
+		},
+		&testFeePolicy{name: exec, maxValuePercent: 10},
+	}
+	enforcer := txmgr.NewFeePolicyEnforcer(logger.Test(t), db, big.NewInt(1), policies)
+
+	t.Run("BumpCount", func(t *testing.T) {
+		assert.Equal(t, 1, enforcer.BumpCount(newFeePolicyTx(t, 1, nil, time.Hour, 0)))
+		unknown := "unknown"
+		assert.Equal(t, 1, enforcer.BumpCount(newFeePolicyTx(t, 1, &unknown, time.Hour, 0)))
+		assert.Equal(t, 1, enforcer.BumpCount(newFeePolicyTx(t, 1, &commit, 30*time.Second, 0)))
+		assert.Equal(t, 2, enforcer.BumpCount(newFeePolicyTx(t, 1, &commit, 5*time.Minute, 0)))
+		assert.Equal(t, 4, enforcer.BumpCount(newFeePolicyTx(t, 1, &commit, time.Hour, 0)))
+		assert.Equal(t, 1, enforcer.BumpCount(newFeePolicyTx(t, 1, &exec, time.Hour, 0)))
+	})
+
+	t.Run("BumpCount bumps txes close to their deadline faster", func(t *testing.T) {
+		deadline := func(d time.Duration) txmgr.TxMeta {
+			at := time.Now().Add(d)
+			return txmgr.TxMeta{FeePolicy: &commit, Deadline: &at}
+		}
+		assert.Equal(t, 1, enforcer.BumpCount(newFeePolicyTxWithMeta(t, 1, deadline(time.Hour), 0, 0)))
+		assert.Equal(t, 3, enforcer.BumpCount(newFeePolicyTxWithMeta(t, 1, deadline(20*time.Minute), 0, 0)))
+		assert.Equal(t, 5, enforcer.BumpCount(newFeePolicyTxWithMeta(t, 1, deadline(time.Minute), 0, 0)))
+		assert.Equal(t, 5, enforcer.BumpCount(newFeePolicyTxWithMeta(t, 1, deadline(-time.Minute), 0, 0)))
+		// the most bumps of the reached steps apply
+		assert.Equal(t, 4, enforcer.BumpCount(newFeePolicyTxWithMeta(t, 1, deadline(20*time.Minute), time.Hour, 0)))
+	})
+
+	t.Run("CheckBump limits the fee relative to the value of the tx", func(t *testing.T) {
+		etx := newFeePolicyTx(t, 1, &exec, 0, 1000)
+		require.NoError(t, enforcer.CheckBump(ctx, etx, newFeePolicyAttempt(etx, 10, 10)))
+		err := enforcer.CheckBump(ctx, etx, newFeePolicyAttempt(etx, 11, 10))
+		require.ErrorIs(t, err, commonfee.ErrBump)
+		assert.Contains(t, err.Error(), "exceeds 10% of the tx value")
+
+		// txes without value are not limited
+		etx = newFeePolicyTx(t, 1, &exec, 0, 0)
+		require.NoError(t, enforcer.CheckBump(ctx, etx, newFeePolicyAttempt(etx, 1000, 1000)))
+	})
+
+	t.Run("CheckBump limits the spend per hour", func(t *testing.T) {
+		etx := newFeePolicyTx(t, 2, &commit, 0, 0)
+		require.NoError(t, enforcer.CheckBump(ctx, etx, newFeePolicyAttempt(etx, 10, 100)))
+
+		// the spend is the price paid according to the receipt, not the price of the attempt
+		require.NoError(t, enforcer.RecordConfirmed(ctx, newFeePolicyAttempt(newFeePolicyTx(t, 3, &commit, 0, 0), 20, 100), 50, big.NewInt(10)))
+		// receipts fetched again for the same tx are not counted twice
+		require.NoError(t, enforcer.RecordConfirmed(ctx, newFeePolicyAttempt(newFeePolicyTx(t, 3, &commit, 0, 0), 20, 100), 50, big.NewInt(10)))
+		spend, err := enforcer.Spend(ctx, commit)
+		require.NoError(t, err)
+		assert.Equal(t, assets.NewWeiI(500), spend)
+		spend, err = enforcer.Spend(ctx, exec)
+		require.NoError(t, err)
+		assert.Equal(t, assets.NewWeiI(0), spend)
+
+		require.NoError(t, enforcer.CheckBump(ctx, etx, newFeePolicyAttempt(etx, 5, 100)))
+		err = enforcer.CheckBump(ctx, etx, newFeePolicyAttempt(etx, 6, 100))
+		require.ErrorIs(t, err, commonfee.ErrBump)
+		assert.Contains(t, err.Error(), "would exceed the max spend per hour")
+
+		// the spend survives restarts
+		restarted := txmgr.NewFeePolicyEnforcer(logger.Test(t), db, big.NewInt(1), policies)
+		spend, err = restarted.Spend(ctx, commit)
+		require.NoError(t, err)
+		assert.Equal(t, assets.NewWeiI(500), spend)
+		err = restarted.CheckBump(ctx, etx, newFeePolicyAttempt(etx, 6, 100))
+		require.ErrorIs(t, err, commonfee.ErrBump)
+
+		// and is kept per chain
+		otherChain := txmgr.NewFeePolicyEnforcer(logger.Test(t), db, big.NewInt(2), policies)
+		spend, err = otherChain.Spend(ctx, commit)
+		require.NoError(t, err)
+		assert.Equal(t, assets.NewWeiI(0), spend)
+	})
+
+	t.Run("txes without a fee policy are not limited", func(t *testing.T) {
+		etx := newFeePolicyTx(t, 4, nil, 0, 1)
+		require.NoError(t, enforcer.CheckBump(ctx, etx, newFeePolicyAttempt(etx, 1e9, 1e6)))
+		require.NoError(t, enforcer.RecordConfirmed(ctx, newFeePolicyAttempt(etx, 1e9, 1e6), 1e6, nil))
+	})
+
+	t.Run("RecordConfirmed uses the attempt price without an effective gas price", func(t *testing.T) {
+		require.NoError(t, enforcer.RecordConfirmed(ctx, newFeePolicyAttempt(newFeePolicyTx(t, 5, &exec, 0, 0), 3, 100), 50, nil))
+		spend, err := enforcer.Spend(ctx, exec)
+		require.NoError(t, err)
+		assert.Equal(t, assets.NewWeiI(150), spend)
+	})
+}
@@ -738,6 +738,67 @@ func (_c *EvmTxStore_FindNextUnstartedTransactionFromAddress_Call) RunAndReturn(
 	return _c
 }
 
+// FindStuckTxDecisions provides a mock function with given fields: ctx, address, limit, chainID
+func (_m *EvmTxStore) FindStuckTxDecisions(ctx context.Context, address common.Address, limit int, chainID *big.Int) ([]types.StuckTxDecision[common.Address, evmtypes.Nonce], error) {
+	ret := _m.Called(ctx, address, limit, chainID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindStuckTxDecisions")
+	}
+
+	var r0 []types.StuckTxDecision[common.Address, evmtypes.Nonce]
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, common.Address, int, *big.Int) ([]types.StuckTxDecision[common.Address, evmtypes.Nonce], error)); ok {
+		return rf(ctx, address, limit, chainID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, common.Address, int, *big.Int) []types.StuckTxDecision[common.Address, evmtypes.Nonce]); ok {
+		r0 = rf(ctx, address, limit, chainID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]types.StuckTxDecision[common.Address, evmtypes.Nonce])
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, common.Address, int, *big.Int) error); ok {
+		r1 = rf(ctx, address, limit, chainID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// EvmTxStore_FindStuckTxDecisions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindStuckTxDecisions'
+type EvmTxStore_FindStuckTxDecisions_Call struct {
+	*mock.Call
+}
+
+// FindStuckTxDecisions is a helper method to define mock.On call
+//   - ctx context.Context
+//   - address common.Address
+//   - limit int
+//   - chainID *big.Int
+func (_e *EvmTxStore_Expecter) FindStuckTxDecisions(ctx interface{}, address interface{}, limit interface{}, chainID interface{}) *EvmTxStore_FindStuckTxDecisions_Call {
+	return &EvmTxStore_FindStuckTxDecisions_Call{Call: _e.mock.On("FindStuckTxDecisions", ctx, address, limit, chainID)}
+}
+
+func (_c *EvmTxStore_FindStuckTxDecisions_Call) Run(run func(ctx context.Context, address common.Address, limit int, chainID *big.Int)) *EvmTxStore_FindStuckTxDecisions_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(common.Address), args[2].(int), args[3].(*big.Int))
+	})
+	return _c
+}
+
+func (_c *EvmTxStore_FindStuckTxDecisions_Call) Return(_a0 []types.StuckTxDecision[common.Address, evmtypes.Nonce], _a1 error) *EvmTxStore_FindStuckTxDecisions_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *EvmTxStore_FindStuckTxDecisions_Call) RunAndReturn(run func(context.Context, common.Address, int, *big.Int) ([]types.StuckTxDecision[common.Address, evmtypes.Nonce], error)) *EvmTxStore_FindStuckTxDecisions_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // FindTransactionsConfirmedInBlockRange provides a mock function with given fields: ctx, highBlockNumber, lowBlockNumber, chainID
 func (_m *EvmTxStore) FindTransactionsConfirmedInBlockRange(ctx context.Context, highBlockNumber int64, lowBlockNumber int64, chainID *big.Int) ([]*types.Tx[*big.Int, common.Address, common.Hash, common.Hash, evmtypes.Nonce, gas.EvmFee], error) {
 	ret := _m.Called(ctx, highBlockNumber, lowBlockNumber, chainID)
@@ -2710,6 +2771,55 @@ func (_c *EvmTxStore_SaveSentAttempt_Call) RunAndReturn(run func(context.Context
 	return _c
 }
 
+// SaveStuckTxDecision provides a mock function with given fields: ctx, decision, historySize, chainID
+func (_m *EvmTxStore) SaveStuckTxDecision(ctx context.Context, decision types.StuckTxDecision[common.Address, evmtypes.Nonce], historySize int, chainID *big.Int) error {
+	ret := _m.Called(ctx, decision, historySize, chainID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SaveStuckTxDecision")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, types.StuckTxDecision[common.Address, evmtypes.Nonce], int, *big.Int) error); ok {
+		r0 = rf(ctx, decision, historySize, chainID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// EvmTxStore_SaveStuckTxDecision_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SaveStuckTxDecision'
+type EvmTxStore_SaveStuckTxDecision_Call struct {
+	*mock.Call
+}
+
+// SaveStuckTxDecision is a helper method to define mock.On call
+//   - ctx context.Context
+//   - decision types.StuckTxDecision[common.Address, evmtypes.Nonce]
+//   - historySize int
+//   - chainID *big.Int
+func (_e *EvmTxStore_Expecter) SaveStuckTxDecision(ctx interface{}, decision interface{}, historySize interface{}, chainID interface{}) *EvmTxStore_SaveStuckTxDecision_Call {
+	return &EvmTxStore_SaveStuckTxDecision_Call{Call: _e.mock.On("SaveStuckTxDecision", ctx, decision, historySize, chainID)}
+}
+
+func (_c *EvmTxStore_SaveStuckTxDecision_Call) Run(run func(ctx context.Context, decision types.StuckTxDecision[common.Address, evmtypes.Nonce], historySize int, chainID *big.Int)) *EvmTxStore_SaveStuckTxDecision_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(types.StuckTxDecision[common.Address, evmtypes.Nonce]), args[2].(int), args[3].(*big.Int))
+	})
+	return _c
+}
+
+func (_c *EvmTxStore_SaveStuckTxDecision_Call) Return(_a0 error) *EvmTxStore_SaveStuckTxDecision_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *EvmTxStore_SaveStuckTxDecision_Call) RunAndReturn(run func(context.Context, types.StuckTxDecision[common.Address, evmtypes.Nonce], int, *big.Int) error) *EvmTxStore_SaveStuckTxDecision_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // SetBroadcastBeforeBlockNum provides a mock function with given fields: ctx, blockNum, chainID
 func (_m *EvmTxStore) SetBroadcastBeforeBlockNum(ctx context.Context, blockNum int64, chainID *big.Int) error {
 	ret := _m.Called(ctx, blockNum, chainID)
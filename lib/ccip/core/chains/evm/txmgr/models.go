@@ -32,6 +32,7 @@ type (
 	TxManager              = txmgr.TxManager[*big.Int, *evmtypes.Head, common.Address, common.Hash, common.Hash, evmtypes.Nonce, gas.EvmFee]
 	NullTxManager          = txmgr.NullTxManager[*big.Int, *evmtypes.Head, common.Address, common.Hash, common.Hash, evmtypes.Nonce, gas.EvmFee]
 	FwdMgr                 = txmgrtypes.ForwarderManager[common.Address]
+	SmartAccountManager    = txmgrtypes.SmartAccountManager[common.Address, common.Hash]
 	TxRequest              = txmgrtypes.TxRequest[common.Address, common.Hash]
 	Tx                     = txmgrtypes.Tx[*big.Int, common.Address, common.Hash, common.Hash, evmtypes.Nonce, gas.EvmFee]
 	TxMeta                 = txmgrtypes.TxMeta[common.Address, common.Hash]
@@ -39,6 +40,9 @@ type (
 	Receipt                = DbReceipt // DbReceipt is the exported DB table model for receipts
 	ReceiptPlus            = txmgrtypes.ReceiptPlus[*evmtypes.Receipt]
 	StuckTxDetector        = txmgrtypes.StuckTxDetector[*big.Int, common.Address, common.Hash, common.Hash, evmtypes.Nonce, gas.EvmFee]
+	FeePolicyEnforcer      = txmgrtypes.FeePolicyEnforcer[*big.Int, common.Address, common.Hash, common.Hash, evmtypes.Nonce, gas.EvmFee]
+	StuckTxDiagnosis       = txmgrtypes.StuckTxDiagnosis[*big.Int, common.Address, common.Hash, common.Hash, evmtypes.Nonce, gas.EvmFee]
+	StuckTxDecision        = txmgrtypes.StuckTxDecision[common.Address, evmtypes.Nonce]
 	TxmClient              = txmgrtypes.TxmClient[*big.Int, common.Address, common.Hash, common.Hash, *evmtypes.Receipt, evmtypes.Nonce, gas.EvmFee]
 	TransactionClient      = txmgrtypes.TransactionClient[*big.Int, common.Address, common.Hash, common.Hash, evmtypes.Nonce, gas.EvmFee]
 	ChainReceipt           = txmgrtypes.ChainReceipt[common.Hash, common.Hash]
@@ -63,6 +67,15 @@ const (
 	// TransmitCheckerTypeVRFV2Plus is a checker that will not submit VRF V2 plus fulfillment requests that
 	// have already been fulfilled. This could happen if the request was fulfilled by another node.
 	TransmitCheckerTypeVRFV2Plus = txmgrtypes.TransmitCheckerType("vrf_v2plus")
+
+	// TransmitCheckerTypeCCIPExec is a checker that drops messages from CCIP execution reports that
+	// have already been executed, and does not submit the report if none are left. This could happen
+	// if the messages were executed by another node. It checks reports of the 1.2 and 1.5 EVM2EVMOffRamps.
+	TransmitCheckerTypeCCIPExec = txmgrtypes.TransmitCheckerType("ccip_exec")
+
+	// TransmitCheckerTypeCCIPExecV1_0 is the TransmitCheckerTypeCCIPExec checker of the 1.0 and 1.1
+	// EVM2EVMOffRamps, whose execution reports encode messages in a different field order.
+	TransmitCheckerTypeCCIPExecV1_0 = txmgrtypes.TransmitCheckerType("ccip_exec_1_0")
 )
 
 // GetGethSignedTx decodes the SignedRawTx into a types.Transaction struct
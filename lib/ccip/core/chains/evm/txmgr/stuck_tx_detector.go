@@ -12,6 +12,7 @@ import (
 	"sync"
 
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/ethereum/go-ethereum/rpc"
 
 	"github.com/smartcontractkit/chainlink-common/pkg/logger"
@@ -22,6 +23,7 @@ import (
 	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/client"
 	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/config/chaintype"
 	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/gas"
+	evmtypes "github.com/smartcontractkit/chainlink/v2/core/chains/evm/types"
 )
 
 type stuckTxDetectorGasEstimator interface {
@@ -34,6 +36,7 @@ type stuckTxDetectorClient interface {
 
 type stuckTxDetectorTxStore interface {
 	FindTxsByStateAndFromAddresses(ctx context.Context, addresses []common.Address, state types.TxState, chainID *big.Int) (txs []*Tx, err error)
+	FindTxWithSequence(ctx context.Context, fromAddress common.Address, seq evmtypes.Nonce) (etx *Tx, err error)
 }
 
 type stuckTxDetectorConfig interface {
@@ -43,12 +46,18 @@ type stuckTxDetectorConfig interface {
 	DetectionApiUrl() *url.URL
 }
 
+type stuckTxDetectorRecoveryConfig interface {
+	Enabled() bool
+	Threshold() uint32
+}
+
 type stuckTxDetector struct {
 	lggr      logger.SugaredLogger
 	chainID   *big.Int
 	chainType chaintype.ChainType
 	maxPrice  *assets.Wei
 	cfg       stuckTxDetectorConfig
+	recovery  stuckTxDetectorRecoveryConfig
 
 	gasEstimator stuckTxDetectorGasEstimator
 	txStore      stuckTxDetectorTxStore
@@ -57,9 +66,11 @@ type stuckTxDetector struct {
 
 	purgeBlockNumLock sync.RWMutex
 	purgeBlockNumMap  map[common.Address]int64 // Tracks the last block num a tx was purged for each from address if the PurgeOverflowTxs feature is enabled
+
+	recoveryBlockNumMap map[common.Address]int64 // Tracks the last block num a stuck tx was diagnosed for each from address, to give its remedy time to take effect
 }
 
-func NewStuckTxDetector(lggr logger.Logger, chainID *big.Int, chainType chaintype.ChainType, maxPrice *assets.Wei, cfg stuckTxDetectorConfig, gasEstimator stuckTxDetectorGasEstimator, txStore stuckTxDetectorTxStore, chainClient stuckTxDetectorClient) *stuckTxDetector {
+func NewStuckTxDetector(lggr logger.Logger, chainID *big.Int, chainType chaintype.ChainType, maxPrice *assets.Wei, cfg stuckTxDetectorConfig, recovery stuckTxDetectorRecoveryConfig, gasEstimator stuckTxDetectorGasEstimator, txStore stuckTxDetectorTxStore, chainClient stuckTxDetectorClient) *stuckTxDetector {
 	t := http.DefaultTransport.(*http.Transport).Clone()
 	t.DisableCompression = true
 	httpClient := &http.Client{Transport: t}
@@ -69,11 +80,14 @@ func NewStuckTxDetector(lggr logger.Logger, chainID *big.Int, chainType chaintyp
 		chainType:        chainType,
 		maxPrice:         maxPrice,
 		cfg:              cfg,
+		recovery:         recovery,
 		gasEstimator:     gasEstimator,
 		txStore:          txStore,
 		chainClient:      chainClient,
 		httpClient:       httpClient,
 		purgeBlockNumMap: make(map[common.Address]int64),
+
+		recoveryBlockNumMap: make(map[common.Address]int64),
 	}
 }
 
@@ -512,3 +526,123 @@ func (d *stuckTxDetector) SetPurgeBlockNum(fromAddress common.Address, blockNum
 func (d *stuckTxDetector) StuckTxFatalError() string {
 	return client.TerminallyStuckMsg
 }
+
+// FindStuckTransactions returns the lowest nonce unconfirmed transaction of each address whose oldest attempt was
+// broadcast at least Threshold blocks ago
+func (d *stuckTxDetector) FindStuckTransactions(ctx context.Context, enabledAddresses []common.Address, blockNum int64) ([]Tx, error) {
+	threshold := int64(d.recovery.Threshold())
+	txs, err := d.FindUnconfirmedTxWithLowestNonce(ctx, enabledAddresses)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get list of transactions waiting confirmations with lowest nonce for distinct from addresses: %w", err)
+	}
+	var stuckTxs []Tx
+	for _, tx := range txs {
+		oldestBroadcastAttempt, _, _ := findBroadcastedAttempts(tx)
+		if oldestBroadcastAttempt == nil || oldestBroadcastAttempt.BroadcastBeforeBlockNum == nil {
+			continue
+		}
+		if *oldestBroadcastAttempt.BroadcastBeforeBlockNum > blockNum-threshold {
+			continue
+		}
+		stuckTxs = append(stuckTxs, tx)
+	}
+	return stuckTxs, nil
+}
+
+// DiagnoseStuckTransactions diagnoses the transactions returned by FindStuckTransactions. A transaction is diagnosed at
+// most once every Threshold blocks, so that its remedy has time to take effect. An address whose diagnosis fails is
+// skipped and diagnosed again on the next block, without failing the diagnosis of the other addresses.
+func (d *stuckTxDetector) DiagnoseStuckTransactions(ctx context.Context, enabledAddresses []common.Address, blockNum int64) ([]StuckTxDiagnosis, error) {
+	if !d.recovery.Enabled() {
+		return nil, nil
+	}
+	threshold := int64(d.recovery.Threshold())
+	txs, err := d.FindStuckTransactions(ctx, enabledAddresses, blockNum)
+	if err != nil {
+		return nil, err
+	}
+
+	d.purgeBlockNumLock.RLock()
+	var stuckTxs []Tx
+	for _, tx := range txs {
+		if d.recoveryBlockNumMap[tx.FromAddress] > blockNum-threshold {
+			continue
+		}
+		stuckTxs = append(stuckTxs, tx)
+	}
+	d.purgeBlockNumLock.RUnlock()
+	if len(stuckTxs) == 0 {
+		return nil, nil
+	}
+
+	// Send with max gas price time 2 to prevent the results from being capped. Need the market gas price here.
+	marketGasPrice, _, err := d.gasEstimator.GetFee(ctx, []byte{}, 0, d.maxPrice.Mul(big.NewInt(2)), nil, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get market gas price for stuck transaction diagnosis: %w", err)
+	}
+
+	// Fetch the mined nonce of each address and whether the RPC knows the attempts of its stuck tx in one batch
+	var reqs []rpc.BatchElem
+	txReqs := make([][]int, len(stuckTxs))
+	minedNonces := make([]hexutil.Uint64, len(stuckTxs))
+	attemptResults := make([][]*map[string]interface{}, len(stuckTxs))
+	for i, tx := range stuckTxs {
+		txReqs[i] = append(txReqs[i], len(reqs))
+		reqs = append(reqs, rpc.BatchElem{Method: "eth_getTransactionCount", Args: []interface{}{tx.FromAddress, "latest"}, Result: &minedNonces[i]})
+		for _, attempt := range tx.TxAttempts {
+			if attempt.State != types.TxAttemptBroadcast {
+				continue
+			}
+			var result map[string]interface{}
+			txReqs[i] = append(txReqs[i], len(reqs))
+			reqs = append(reqs, rpc.BatchElem{Method: "eth_getTransactionByHash", Args: []interface{}{attempt.Hash}, Result: &result})
+			attemptResults[i] = append(attemptResults[i], &result)
+		}
+	}
+	if err = d.chainClient.BatchCallContext(ctx, reqs); err != nil {
+		return nil, fmt.Errorf("failed to diagnose stuck transactions in batch: %w", err)
+	}
+
+	diagnoses := make([]StuckTxDiagnosis, 0, len(stuckTxs))
+	for i, tx := range stuckTxs {
+		diagnosis, err := d.diagnoseStuckTransaction(ctx, tx, reqs, txReqs[i], minedNonces[i], attemptResults[i], marketGasPrice)
+		if err != nil {
+			d.lggr.Errorw("Failed to diagnose stuck transaction", "fromAddress", tx.FromAddress, "txID", tx.ID, "err", err)
+			continue
+		}
+		d.purgeBlockNumLock.Lock()
+		d.recoveryBlockNumMap[tx.FromAddress] = blockNum
+		d.purgeBlockNumLock.Unlock()
+		diagnoses = append(diagnoses, diagnosis)
+	}
+	return diagnoses, nil
+}
+
+func (d *stuckTxDetector) diagnoseStuckTransaction(ctx context.Context, tx Tx, reqs []rpc.BatchElem, txReqs []int, minedNonce hexutil.Uint64, attemptResults []*map[string]interface{}, marketGasPrice gas.EvmFee) (StuckTxDiagnosis, error) {
+	for _, i := range txReqs {
+		if reqs[i].Error != nil {
+			return StuckTxDiagnosis{}, fmt.Errorf("%s failed: %w", reqs[i].Method, reqs[i].Error)
+		}
+	}
+	diagnosis := StuckTxDiagnosis{
+		Tx:             tx,
+		MinedSequence:  evmtypes.Nonce(minedNonce),
+		FeeBelowMarket: compareGasFees(tx.TxAttempts[0].TxFee, marketGasPrice) < 0,
+		PurgeSupported: d.cfg.Enabled(),
+	}
+	for _, result := range attemptResults {
+		if *result != nil {
+			diagnosis.InMempool = true
+			break
+		}
+	}
+	if *tx.Sequence > diagnosis.MinedSequence {
+		// The gap may be a tx of ours that is waiting for its receipt, in which case it is not stuck
+		gapTx, err := d.txStore.FindTxWithSequence(ctx, tx.FromAddress, diagnosis.MinedSequence)
+		if err != nil {
+			return StuckTxDiagnosis{}, fmt.Errorf("failed to find transaction with nonce %d: %w", diagnosis.MinedSequence, err)
+		}
+		diagnosis.NonceGap = gapTx == nil
+	}
+	return diagnosis, nil
+}
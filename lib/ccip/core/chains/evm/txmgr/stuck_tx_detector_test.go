@@ -2,6 +2,7 @@ package txmgr_test
 
 import (
 	"encoding/json"
+	"errors"
 	"fmt"
 	"net/http"
 	"net/http/httptest"
@@ -10,6 +11,7 @@ import (
 	"time"
 
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/ethereum/go-ethereum/rpc"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
@@ -50,7 +52,7 @@ func TestStuckTxDetector_Disabled(t *testing.T) {
 	autoPurgeCfg := testAutoPurgeConfig{
 		enabled: false,
 	}
-	stuckTxDetector := txmgr.NewStuckTxDetector(lggr, testutils.FixtureChainID, "", assets.NewWei(assets.NewEth(100).ToInt()), autoPurgeCfg, feeEstimator, txStore, ethClient)
+	stuckTxDetector := txmgr.NewStuckTxDetector(lggr, testutils.FixtureChainID, "", assets.NewWei(assets.NewEth(100).ToInt()), autoPurgeCfg, testStuckTxRecoveryConfig{}, feeEstimator, txStore, ethClient)
 
 	t.Run("returns empty list if auto-purge feature is disabled", func(t *testing.T) {
 		txs, err := stuckTxDetector.DetectStuckTransactions(tests.Context(t), []common.Address{fromAddress}, 100)
@@ -81,7 +83,7 @@ func TestStuckTxDetector_LoadPurgeBlockNumMap(t *testing.T) {
 		threshold:   &autoPurgeThreshold,
 		minAttempts: &autoPurgeMinAttempts,
 	}
-	stuckTxDetector := txmgr.NewStuckTxDetector(lggr, testutils.FixtureChainID, "", assets.NewWei(assets.NewEth(100).ToInt()), autoPurgeCfg, feeEstimator, txStore, ethClient)
+	stuckTxDetector := txmgr.NewStuckTxDetector(lggr, testutils.FixtureChainID, "", assets.NewWei(assets.NewEth(100).ToInt()), autoPurgeCfg, testStuckTxRecoveryConfig{}, feeEstimator, txStore, ethClient)
 
 	t.Run("purge num map loaded on startup rate limits new purges on startup", func(t *testing.T) {
 		_, fromAddress := cltest.MustInsertRandomKey(t, ethKeyStore)
@@ -115,7 +117,7 @@ func TestStuckTxDetector_FindPotentialStuckTxs(t *testing.T) {
 	lggr := logger.Test(t)
 	ethClient := testutils.NewEthClientMockWithDefaultChain(t)
 	feeEstimator := gasmocks.NewEvmFeeEstimator(t)
-	stuckTxDetector := txmgr.NewStuckTxDetector(lggr, testutils.FixtureChainID, "", assets.NewWei(assets.NewEth(100).ToInt()), config.EVM().Transactions().AutoPurge(), feeEstimator, txStore, ethClient)
+	stuckTxDetector := txmgr.NewStuckTxDetector(lggr, testutils.FixtureChainID, "", assets.NewWei(assets.NewEth(100).ToInt()), config.EVM().Transactions().AutoPurge(), config.EVM().Transactions().StuckTxRecovery(), feeEstimator, txStore, ethClient)
 
 	t.Run("returns empty list if no unconfimed transactions found", func(t *testing.T) {
 		_, fromAddress := cltest.MustInsertRandomKey(t, ethKeyStore)
@@ -204,7 +206,7 @@ func TestStuckTxDetector_DetectStuckTransactionsHeuristic(t *testing.T) {
 		minAttempts: &autoPurgeMinAttempts,
 	}
 	blockNum := int64(100)
-	stuckTxDetector := txmgr.NewStuckTxDetector(lggr, testutils.FixtureChainID, "", assets.NewWei(assets.NewEth(100).ToInt()), autoPurgeCfg, feeEstimator, txStore, ethClient)
+	stuckTxDetector := txmgr.NewStuckTxDetector(lggr, testutils.FixtureChainID, "", assets.NewWei(assets.NewEth(100).ToInt()), autoPurgeCfg, testStuckTxRecoveryConfig{}, feeEstimator, txStore, ethClient)
 
 	t.Run("not stuck, Threshold amount of blocks have not passed since broadcast", func(t *testing.T) {
 		_, fromAddress := cltest.MustInsertRandomKey(t, ethKeyStore)
@@ -312,7 +314,7 @@ func TestStuckTxDetector_DetectStuckTransactionsZircuit(t *testing.T) {
 		minAttempts: &autoPurgeMinAttempts,
 	}
 	blockNum := int64(100)
-	stuckTxDetector := txmgr.NewStuckTxDetector(lggr, testutils.FixtureChainID, chaintype.ChainZircuit, assets.NewWei(assets.NewEth(100).ToInt()), autoPurgeCfg, feeEstimator, txStore, ethClient)
+	stuckTxDetector := txmgr.NewStuckTxDetector(lggr, testutils.FixtureChainID, chaintype.ChainZircuit, assets.NewWei(assets.NewEth(100).ToInt()), autoPurgeCfg, testStuckTxRecoveryConfig{}, feeEstimator, txStore, ethClient)
 
 	t.Run("returns empty list if no fraud or stuck transactions identified", func(t *testing.T) {
 		_, fromAddress := cltest.MustInsertRandomKey(t, ethKeyStore)
@@ -408,7 +410,7 @@ func TestStuckTxDetector_DetectStuckTransactionsZkEVM(t *testing.T) {
 	blockNum := int64(100)
 
 	t.Run("returns empty list if no stuck transactions identified", func(t *testing.T) {
-		stuckTxDetector := txmgr.NewStuckTxDetector(lggr, testutils.FixtureChainID, chaintype.ChainZkEvm, assets.NewWei(assets.NewEth(100).ToInt()), autoPurgeCfg, feeEstimator, txStore, ethClient)
+		stuckTxDetector := txmgr.NewStuckTxDetector(lggr, testutils.FixtureChainID, chaintype.ChainZkEvm, assets.NewWei(assets.NewEth(100).ToInt()), autoPurgeCfg, testStuckTxRecoveryConfig{}, feeEstimator, txStore, ethClient)
 		_, fromAddress := cltest.MustInsertRandomKey(t, ethKeyStore)
 		tx := mustInsertUnconfirmedTxWithBroadcastAttempts(t, txStore, 0, fromAddress, 1, blockNum, tenGwei)
 		attempts := tx.TxAttempts[0]
@@ -428,7 +430,7 @@ func TestStuckTxDetector_DetectStuckTransactionsZkEVM(t *testing.T) {
 	})
 
 	t.Run("returns stuck transactions discarded by chain", func(t *testing.T) {
-		stuckTxDetector := txmgr.NewStuckTxDetector(lggr, testutils.FixtureChainID, chaintype.ChainZkEvm, assets.NewWei(assets.NewEth(100).ToInt()), autoPurgeCfg, feeEstimator, txStore, ethClient)
+		stuckTxDetector := txmgr.NewStuckTxDetector(lggr, testutils.FixtureChainID, chaintype.ChainZkEvm, assets.NewWei(assets.NewEth(100).ToInt()), autoPurgeCfg, testStuckTxRecoveryConfig{}, feeEstimator, txStore, ethClient)
 		// Insert tx that will be mocked as stuck
 		_, fromAddress1 := cltest.MustInsertRandomKey(t, ethKeyStore)
 		mustInsertUnconfirmedTxWithBroadcastAttempts(t, txStore, 0, fromAddress1, 1, blockNum, tenGwei)
@@ -456,7 +458,7 @@ func TestStuckTxDetector_DetectStuckTransactionsZkEVM(t *testing.T) {
 
 	t.Run("skips stuck tx detection for transactions that do not have enough attempts", func(t *testing.T) {
 		autoPurgeCfg.minAttempts = ptr(uint32(2))
-		stuckTxDetector := txmgr.NewStuckTxDetector(lggr, testutils.FixtureChainID, chaintype.ChainZkEvm, assets.NewWei(assets.NewEth(100).ToInt()), autoPurgeCfg, feeEstimator, txStore, ethClient)
+		stuckTxDetector := txmgr.NewStuckTxDetector(lggr, testutils.FixtureChainID, chaintype.ChainZkEvm, assets.NewWei(assets.NewEth(100).ToInt()), autoPurgeCfg, testStuckTxRecoveryConfig{}, feeEstimator, txStore, ethClient)
 		// Insert tx with enough attempts for detection
 		_, fromAddress1 := cltest.MustInsertRandomKey(t, ethKeyStore)
 		etx1 := mustInsertUnconfirmedTxWithBroadcastAttempts(t, txStore, 0, fromAddress1, 1, blockNum, tenGwei)
@@ -519,7 +521,7 @@ func TestStuckTxDetector_DetectStuckTransactionsScroll(t *testing.T) {
 			enabled:         true,
 			detectionApiUrl: testUrl,
 		}
-		stuckTxDetector := txmgr.NewStuckTxDetector(lggr, testutils.FixtureChainID, chaintype.ChainScroll, assets.NewWei(assets.NewEth(100).ToInt()), autoPurgeCfg, feeEstimator, txStore, ethClient)
+		stuckTxDetector := txmgr.NewStuckTxDetector(lggr, testutils.FixtureChainID, chaintype.ChainScroll, assets.NewWei(assets.NewEth(100).ToInt()), autoPurgeCfg, testStuckTxRecoveryConfig{}, feeEstimator, txStore, ethClient)
 
 		txs, err := stuckTxDetector.DetectStuckTransactions(ctx, []common.Address{fromAddress1, fromAddress2}, blockNum)
 		require.NoError(t, err)
@@ -528,6 +530,158 @@ func TestStuckTxDetector_DetectStuckTransactionsScroll(t *testing.T) {
 	})
 }
 
+func TestStuckTxDetector_DiagnoseStuckTransactions(t *testing.T) {
+	t.Parallel()
+
+	db := pgtest.NewSqlxDB(t)
+	txStore := cltest.NewTestTxStore(t, db)
+	ethKeyStore := cltest.NewKeyStore(t, db).Eth()
+	ctx := tests.Context(t)
+
+	lggr := logger.Test(t)
+	feeEstimator := gasmocks.NewEvmFeeEstimator(t)
+	marketGasPrice := assets.GWei(15)
+	feeEstimator.On("GetFee", mock.Anything, []byte{}, uint64(0), mock.Anything, mock.Anything, mock.Anything).Return(gas.EvmFee{Legacy: marketGasPrice}, uint64(0), nil).Maybe()
+	ethClient := testutils.NewEthClientMockWithDefaultChain(t)
+	recoveryCfg := testStuckTxRecoveryConfig{enabled: true, threshold: 5}
+	blockNum := int64(100)
+
+	mockBatch := func(minedNonce uint64, inMempool bool) {
+		ethClient.On("BatchCallContext", mock.Anything, mock.MatchedBy(func(b []rpc.BatchElem) bool {
+			return len(b) == 2 && b[0].Method == "eth_getTransactionCount" && b[1].Method == "eth_getTransactionByHash"
+		})).Return(nil).Run(func(args mock.Arguments) {
+			elems := args.Get(1).([]rpc.BatchElem)
+			*(elems[0].Result.(*hexutil.Uint64)) = hexutil.Uint64(minedNonce)
+			if inMempool {
+				*(elems[1].Result.(*map[string]interface{})) = map[string]interface{}{"hash": "0x1"}
+			}
+		}).Once()
+	}
+
+	t.Run("returns empty list if stuck tx recovery is disabled", func(t *testing.T) {
+		stuckTxDetector := txmgr.NewStuckTxDetector(lggr, testutils.FixtureChainID, "", assets.NewWei(assets.NewEth(100).ToInt()), testAutoPurgeConfig{}, testStuckTxRecoveryConfig{}, feeEstimator, txStore, ethClient)
+		_, fromAddress := cltest.MustInsertRandomKey(t, ethKeyStore)
+		mustInsertUnconfirmedTxWithBroadcastAttempts(t, txStore, 0, fromAddress, 1, blockNum-10, tenGwei)
+
+		diagnoses, err := stuckTxDetector.DiagnoseStuckTransactions(ctx, []common.Address{fromAddress}, blockNum)
+		require.NoError(t, err)
+		require.Empty(t, diagnoses)
+	})
+
+	t.Run("skips transactions broadcast within the threshold", func(t *testing.T) {
+		stuckTxDetector := txmgr.NewStuckTxDetector(lggr, testutils.FixtureChainID, "", assets.NewWei(assets.NewEth(100).ToInt()), testAutoPurgeConfig{}, recoveryCfg, feeEstimator, txStore, ethClient)
+		_, fromAddress := cltest.MustInsertRandomKey(t, ethKeyStore)
+		mustInsertUnconfirmedTxWithBroadcastAttempts(t, txStore, 0, fromAddress, 1, blockNum-1, tenGwei)
+
+		diagnoses, err := stuckTxDetector.DiagnoseStuckTransactions(ctx, []common.Address{fromAddress}, blockNum)
+		require.NoError(t, err)
+		require.Empty(t, diagnoses)
+	})
+
+	t.Run("diagnoses a transaction with a fee below market that was dropped from the mempool", func(t *testing.T) {
+		stuckTxDetector := txmgr.NewStuckTxDetector(lggr, testutils.FixtureChainID, "", assets.NewWei(assets.NewEth(100).ToInt()), testAutoPurgeConfig{enabled: true}, recoveryCfg, feeEstimator, txStore, ethClient)
+		_, fromAddress := cltest.MustInsertRandomKey(t, ethKeyStore)
+		mustInsertUnconfirmedTxWithBroadcastAttempts(t, txStore, 0, fromAddress, 1, blockNum-10, tenGwei)
+		mockBatch(0, false)
+
+		diagnoses, err := stuckTxDetector.DiagnoseStuckTransactions(ctx, []common.Address{fromAddress}, blockNum)
+		require.NoError(t, err)
+		require.Len(t, diagnoses, 1)
+		require.False(t, diagnoses[0].NonceGap)
+		require.False(t, diagnoses[0].InMempool)
+		require.True(t, diagnoses[0].FeeBelowMarket)
+		require.True(t, diagnoses[0].PurgeSupported)
+
+		// Not diagnosed again until the threshold has passed, to give the remedy time to take effect
+		diagnoses, err = stuckTxDetector.DiagnoseStuckTransactions(ctx, []common.Address{fromAddress}, blockNum+1)
+		require.NoError(t, err)
+		require.Empty(t, diagnoses)
+	})
+
+	t.Run("diagnoses a nonce gap", func(t *testing.T) {
+		stuckTxDetector := txmgr.NewStuckTxDetector(lggr, testutils.FixtureChainID, "", assets.NewWei(assets.NewEth(100).ToInt()), testAutoPurgeConfig{}, recoveryCfg, feeEstimator, txStore, ethClient)
+		_, fromAddress := cltest.MustInsertRandomKey(t, ethKeyStore)
+		mustInsertUnconfirmedTxWithBroadcastAttempts(t, txStore, 2, fromAddress, 1, blockNum-10, marketGasPrice)
+		mockBatch(1, true)
+
+		diagnoses, err := stuckTxDetector.DiagnoseStuckTransactions(ctx, []common.Address{fromAddress}, blockNum)
+		require.NoError(t, err)
+		require.Len(t, diagnoses, 1)
+		require.True(t, diagnoses[0].NonceGap)
+		require.Equal(t, types.Nonce(1), diagnoses[0].MinedSequence)
+		require.True(t, diagnoses[0].InMempool)
+		require.False(t, diagnoses[0].FeeBelowMarket)
+		require.False(t, diagnoses[0].PurgeSupported)
+	})
+
+	t.Run("skips the addresses whose diagnosis failed and diagnoses them on the next block", func(t *testing.T) {
+		stuckTxDetector := txmgr.NewStuckTxDetector(lggr, testutils.FixtureChainID, "", assets.NewWei(assets.NewEth(100).ToInt()), testAutoPurgeConfig{}, recoveryCfg, feeEstimator, txStore, ethClient)
+		_, fromAddress1 := cltest.MustInsertRandomKey(t, ethKeyStore)
+		_, fromAddress2 := cltest.MustInsertRandomKey(t, ethKeyStore)
+		tx1 := mustInsertUnconfirmedTxWithBroadcastAttempts(t, txStore, 0, fromAddress1, 1, blockNum-10, marketGasPrice)
+		tx2 := mustInsertUnconfirmedTxWithBroadcastAttempts(t, txStore, 0, fromAddress2, 1, blockNum-10, marketGasPrice)
+		ethClient.On("BatchCallContext", mock.Anything, mock.MatchedBy(func(b []rpc.BatchElem) bool {
+			return len(b) == 4
+		})).Return(nil).Run(func(args mock.Arguments) {
+			elems := args.Get(1).([]rpc.BatchElem)
+			for i := 0; i < len(elems); i += 2 {
+				if elems[i].Args[0] == fromAddress1 {
+					elems[i].Error = errors.New("rpc unavailable")
+				}
+			}
+		}).Once()
+
+		diagnoses, err := stuckTxDetector.DiagnoseStuckTransactions(ctx, []common.Address{fromAddress1, fromAddress2}, blockNum)
+		require.NoError(t, err)
+		require.Len(t, diagnoses, 1)
+		require.Equal(t, tx2.ID, diagnoses[0].Tx.ID)
+
+		mockBatch(0, true)
+		diagnoses, err = stuckTxDetector.DiagnoseStuckTransactions(ctx, []common.Address{fromAddress1, fromAddress2}, blockNum+1)
+		require.NoError(t, err)
+		require.Len(t, diagnoses, 1)
+		require.Equal(t, tx1.ID, diagnoses[0].Tx.ID)
+	})
+}
+
+func TestStuckTxDetector_FindStuckTransactions(t *testing.T) {
+	t.Parallel()
+
+	db := pgtest.NewSqlxDB(t)
+	txStore := cltest.NewTestTxStore(t, db)
+	ethKeyStore := cltest.NewKeyStore(t, db).Eth()
+	ctx := tests.Context(t)
+
+	lggr := logger.Test(t)
+	feeEstimator := gasmocks.NewEvmFeeEstimator(t)
+	feeEstimator.On("GetFee", mock.Anything, []byte{}, uint64(0), mock.Anything, mock.Anything, mock.Anything).Return(gas.EvmFee{Legacy: tenGwei}, uint64(0), nil).Maybe()
+	ethClient := testutils.NewEthClientMockWithDefaultChain(t)
+	stuckTxDetector := txmgr.NewStuckTxDetector(lggr, testutils.FixtureChainID, "", assets.NewWei(assets.NewEth(100).ToInt()), testAutoPurgeConfig{}, testStuckTxRecoveryConfig{enabled: true, threshold: 5}, feeEstimator, txStore, ethClient)
+	blockNum := int64(100)
+
+	_, fromAddress1 := cltest.MustInsertRandomKey(t, ethKeyStore)
+	_, fromAddress2 := cltest.MustInsertRandomKey(t, ethKeyStore)
+	tx1 := mustInsertUnconfirmedTxWithBroadcastAttempts(t, txStore, 0, fromAddress1, 1, blockNum-10, tenGwei)
+	mustInsertUnconfirmedTxWithBroadcastAttempts(t, txStore, 1, fromAddress1, 1, blockNum-10, tenGwei)
+	mustInsertUnconfirmedTxWithBroadcastAttempts(t, txStore, 0, fromAddress2, 1, blockNum-1, tenGwei)
+	addresses := []common.Address{fromAddress1, fromAddress2}
+
+	txs, err := stuckTxDetector.FindStuckTransactions(ctx, addresses, blockNum)
+	require.NoError(t, err)
+	require.Len(t, txs, 1)
+	require.Equal(t, tx1.ID, txs[0].ID)
+
+	// The transaction is still stuck after it was diagnosed
+	ethClient.On("BatchCallContext", mock.Anything, mock.Anything).Return(nil).Once()
+	diagnoses, err := stuckTxDetector.DiagnoseStuckTransactions(ctx, addresses, blockNum)
+	require.NoError(t, err)
+	require.Len(t, diagnoses, 1)
+	txs, err = stuckTxDetector.FindStuckTransactions(ctx, addresses, blockNum+1)
+	require.NoError(t, err)
+	require.Len(t, txs, 1)
+	require.Equal(t, tx1.ID, txs[0].ID)
+}
+
 func mustInsertUnconfirmedTxWithBroadcastAttempts(t *testing.T, txStore txmgr.TestEvmTxStore, nonce int64, fromAddress common.Address, numAttempts uint32, latestBroadcastBlockNum int64, latestGasPrice *assets.Wei) txmgr.Tx {
 	ctx := tests.Context(t)
 	etx := cltest.MustInsertUnconfirmedEthTx(t, txStore, nonce, fromAddress)
@@ -614,3 +768,11 @@ func (t testAutoPurgeConfig) Enabled() bool             { return t.enabled }
 func (t testAutoPurgeConfig) Threshold() *uint32        { return t.threshold }
 func (t testAutoPurgeConfig) MinAttempts() *uint32      { return t.minAttempts }
 func (t testAutoPurgeConfig) DetectionApiUrl() *url.URL { return t.detectionApiUrl }
+
+type testStuckTxRecoveryConfig struct {
+	enabled   bool
+	threshold uint32
+}
+
+func (t testStuckTxRecoveryConfig) Enabled() bool     { return t.enabled }
+func (t testStuckTxRecoveryConfig) Threshold() uint32 { return t.threshold }
@@ -100,6 +100,10 @@ func (g *TestGasEstimatorConfig) EstimateLimit() bool        { return false }
 func (g *TestGasEstimatorConfig) LimitJobType() evmconfig.LimitJobType {
 	return &TestLimitJobTypeConfig{}
 }
+func (g *TestGasEstimatorConfig) FeePolicies() []evmconfig.FeePolicy { return nil }
+func (g *TestGasEstimatorConfig) BlobFees() evmconfig.BlobFees {
+	return &TestBlobFeesConfig{}
+}
 func (g *TestGasEstimatorConfig) PriceMaxKey(addr common.Address) *assets.Wei {
 	return assets.NewWeiI(42)
 }
@@ -118,6 +122,14 @@ func (l *TestLimitJobTypeConfig) FM() *uint32     { return ptr(uint32(0)) }
 func (l *TestLimitJobTypeConfig) Keeper() *uint32 { return ptr(uint32(0)) }
 func (l *TestLimitJobTypeConfig) VRF() *uint32    { return ptr(uint32(0)) }
 
+type TestBlobFeesConfig struct {
+}
+
+func (b *TestBlobFeesConfig) Enabled() bool         { return false }
+func (b *TestBlobFeesConfig) PriceMax() *assets.Wei { return assets.NewWeiI(42) }
+func (b *TestBlobFeesConfig) HistoryDepth() uint16  { return 42 }
+func (b *TestBlobFeesConfig) BufferPercent() uint16 { return 42 }
+
 type TestBlockHistoryConfig struct {
 	evmconfig.BlockHistory
 }
@@ -147,6 +159,13 @@ func (t *transactionsConfig) ReaperInterval() time.Duration        { return t.e.
 func (t *transactionsConfig) ReaperThreshold() time.Duration       { return t.e.ReaperThreshold }
 func (t *transactionsConfig) ResendAfterThreshold() time.Duration  { return t.e.ResendAfterThreshold }
 func (t *transactionsConfig) AutoPurge() evmconfig.AutoPurgeConfig { return t.autoPurge }
+func (t *transactionsConfig) SmartAccounts() []evmconfig.SmartAccount {
+	return nil
+}
+
+func (t *transactionsConfig) StuckTxRecovery() evmconfig.StuckTxRecoveryConfig {
+	return &stuckTxRecoveryConfig{}
+}
 
 type autoPurgeConfig struct {
 	evmconfig.AutoPurgeConfig
@@ -154,6 +173,11 @@ type autoPurgeConfig struct {
 
 func (a *autoPurgeConfig) Enabled() bool { return false }
 
+type stuckTxRecoveryConfig struct{}
+
+func (r *stuckTxRecoveryConfig) Enabled() bool     { return false }
+func (r *stuckTxRecoveryConfig) Threshold() uint32 { return 0 }
+
 type MockConfig struct {
 	EvmConfig          *TestEvmConfig
 	finalityDepth      uint32
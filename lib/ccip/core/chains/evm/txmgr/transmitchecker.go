@@ -4,6 +4,7 @@ import (
 	"context"
 	"math/big"
 
+	"github.com/ethereum/go-ethereum/accounts/abi"
 	"github.com/ethereum/go-ethereum/accounts/abi/bind"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
@@ -11,6 +12,7 @@ import (
 	"github.com/ethereum/go-ethereum/rpc"
 	pkgerrors "github.com/pkg/errors"
 
+	"github.com/smartcontractkit/chainlink-common/pkg/hashutil"
 	"github.com/smartcontractkit/chainlink-common/pkg/logger"
 	bigmath "github.com/smartcontractkit/chainlink-common/pkg/utils/big_math"
 	"github.com/smartcontractkit/chainlink-common/pkg/utils/bytes"
@@ -21,6 +23,9 @@ import (
 	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/gas"
 	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/types"
 	evmtypes "github.com/smartcontractkit/chainlink/v2/core/chains/evm/types"
+	"github.com/smartcontractkit/chainlink/v2/core/gethwrappers/ccip/generated/evm_2_evm_offramp"
+	"github.com/smartcontractkit/chainlink/v2/core/gethwrappers/ccip/generated/evm_2_evm_offramp_1_0_0"
+	"github.com/smartcontractkit/chainlink/v2/core/gethwrappers/generated/authorized_forwarder"
 	v1 "github.com/smartcontractkit/chainlink/v2/core/gethwrappers/generated/solidity_vrf_coordinator_interface"
 	v2 "github.com/smartcontractkit/chainlink/v2/core/gethwrappers/generated/vrf_coordinator_v2"
 	"github.com/smartcontractkit/chainlink/v2/core/gethwrappers/generated/vrf_coordinator_v2plus_interface"
@@ -39,6 +44,9 @@ var (
 	_ TransmitChecker        = &SimulateChecker{}
 	_ TransmitChecker        = &VRFV1Checker{}
 	_ TransmitChecker        = &VRFV2Checker{}
+	_ TransmitChecker        = &CCIPExecChecker{}
+
+	_ txmgr.PayloadRebuildingTransmitChecker[*big.Int, common.Address, common.Hash, common.Hash, evmtypes.Nonce, gas.EvmFee] = &CCIPExecChecker{}
 )
 
 // CheckerFactory is a real implementation of TransmitCheckerFactory.
@@ -98,6 +106,10 @@ func (c *CheckerFactory) BuildChecker(spec TransmitCheckerSpec) (TransmitChecker
 			HeadByNumber:       c.Client.HeadByNumber,
 			RequestBlockNumber: spec.VRFRequestBlockNumber,
 		}, nil
+	case TransmitCheckerTypeCCIPExec:
+		return &CCIPExecChecker{Client: c.Client}, nil
+	case TransmitCheckerTypeCCIPExecV1_0:
+		return &CCIPExecChecker{Client: c.Client, V1_0: true}, nil
 	case "":
 		return NoChecker, nil
 	default:
@@ -358,3 +370,370 @@ func (v *VRFV2Checker) Check(
 		"vrfRequestId", vrfRequestID)
 	return nil
 }
+
+var (
+	ccipOffRampABI = evmtypes.MustGetABI(evm_2_evm_offramp.EVM2EVMOffRampABI)
+	forwardABI     = evmtypes.MustGetABI(authorized_forwarder.AuthorizedForwarderABI).Methods["forward"]
+	// ccipExecReportArgs are the arguments an execution report is encoded with in transmit calls
+	ccipExecReportArgs = ccipOffRampABI.Methods["manuallyExecute"].Inputs[:1]
+	// ccipExecReportArgsV1_0 are the ccipExecReportArgs of the 1.0 and 1.1 offramps
+	ccipExecReportArgsV1_0 = evmtypes.MustGetABI(evm_2_evm_offramp_1_0_0.EVM2EVMOffRampABI).Methods["manuallyExecute"].Inputs[:1]
+)
+
+// ccipExecStateUntouched is the execution state of messages that have not been executed yet,
+// see Internal.MessageExecutionState.
+const ccipExecStateUntouched = 0
+
+// CCIPExecChecker is an implementation of TransmitChecker that checks whether the messages of a CCIP
+// execution report have already been executed on the EVM2EVMOffRamp the report is transmitted to.
+// Messages that have been executed are dropped from the report, and the transaction is not sent if no
+// messages are left.
+//
+// The transmit and getExecutionState calls of all EVM2EVMOffRamp versions are the same, the 1.0 and 1.1
+// reports encode their messages in a different field order.
+type CCIPExecChecker struct {
+	Client evmclient.Client
+	// V1_0 is set for the 1.0 and 1.1 offramps
+	V1_0 bool
+}
+
+// Check satisfies the TransmitChecker interface.
+func (c *CCIPExecChecker) Check(
+	ctx context.Context,
+	l logger.SugaredLogger,
+	tx Tx,
+	a TxAttempt,
+) error {
+	_, err := c.RebuildPayload(ctx, l, tx, a)
+	return err
+}
+
+// RebuildPayload satisfies the PayloadRebuildingTransmitChecker interface.
+func (c *CCIPExecChecker) RebuildPayload(
+	ctx context.Context,
+	l logger.SugaredLogger,
+	tx Tx,
+	_ TxAttempt,
+) ([]byte, error) {
+	transmit, err := decodeCCIPExecTransmit(tx.ToAddress, tx.EncodedPayload, c.V1_0)
+	if err != nil {
+		l.Errorw("Failed to decode CCIP execution report. Attempting to transmit anyway.",
+			"err", err,
+			"ethTxID", tx.ID,
+			"meta", tx.Meta)
+		return nil, nil
+	}
+
+	report := transmit.report
+	executed, err := c.executedMessages(ctx, transmit.offRamp, report.Messages)
+	if err != nil {
+		l.Errorw("Failed to check execution state of CCIP messages. Attempting to transmit anyway.",
+			"err", err,
+			"ethTxID", tx.ID,
+			"meta", tx.Meta)
+		return nil, nil
+	}
+
+	if len(executed) == 0 {
+		l.Debugw("Messages not yet executed",
+			"ethTxID", tx.ID,
+			"meta", tx.Meta)
+		return nil, nil
+	}
+	if len(executed) == len(report.Messages) {
+		l.Infow("All messages already executed",
+			"ethTxID", tx.ID,
+			"meta", tx.Meta)
+		return nil, pkgerrors.New("all messages already executed")
+	}
+
+	pruned, err := pruneCCIPExecReport(report, executed)
+	if err != nil {
+		l.Errorw("Failed to drop executed messages from CCIP execution report. Attempting to transmit anyway.",
+			"err", err,
+			"ethTxID", tx.ID,
+			"meta", tx.Meta)
+		return nil, nil
+	}
+	payload, err := transmit.encode(pruned)
+	if err != nil {
+		l.Errorw("Failed to encode CCIP execution report. Attempting to transmit anyway.",
+			"err", err,
+			"ethTxID", tx.ID,
+			"meta", tx.Meta)
+		return nil, nil
+	}
+
+	var executedSeqNrs []uint64
+	for i := range executed {
+		executedSeqNrs = append(executedSeqNrs, report.Messages[i].SequenceNumber)
+	}
+	l.Infow("Dropped already executed messages from CCIP execution report",
+		"ethTxID", tx.ID,
+		"meta", tx.Meta,
+		"executedSeqNrs", executedSeqNrs,
+		"remainingMessages", len(pruned.Messages))
+	return payload, nil
+}
+
+// executedMessages returns the indices of the messages that are no longer untouched on the offramp.
+func (c *CCIPExecChecker) executedMessages(ctx context.Context, offRamp common.Address, messages []evm_2_evm_offramp.InternalEVM2EVMMessage) (map[int]bool, error) {
+	results := make([]hexutil.Bytes, len(messages))
+	batch := make([]rpc.BatchElem, len(messages))
+	for i, msg := range messages {
+		data, err := ccipOffRampABI.Pack("getExecutionState", msg.SequenceNumber)
+		if err != nil {
+			return nil, err
+		}
+		batch[i] = rpc.BatchElem{
+			Method: "eth_call",
+			Args: []interface{}{map[string]interface{}{
+				"to":   offRamp,
+				"data": hexutil.Bytes(data),
+			}, evmclient.ToBlockNumArg(nil)},
+			Result: &results[i],
+		}
+	}
+	if err := c.Client.BatchCallContext(ctx, batch); err != nil {
+		return nil, err
+	}
+
+	executed := make(map[int]bool)
+	for i, elem := range batch {
+		if elem.Error != nil {
+			return nil, pkgerrors.Wrapf(elem.Error, "getExecutionState(%d)", messages[i].SequenceNumber)
+		}
+		out, err := ccipOffRampABI.Unpack("getExecutionState", results[i])
+		if err != nil {
+			return nil, pkgerrors.Wrapf(err, "getExecutionState(%d)", messages[i].SequenceNumber)
+		}
+		if state := *abi.ConvertType(out[0], new(uint8)).(*uint8); state != ccipExecStateUntouched {
+			executed[i] = true
+		}
+	}
+	return executed, nil
+}
+
+// ccipExecTransmit is a decoded transmit call of an execution report, which may be wrapped in a call
+// to an AuthorizedForwarder.
+type ccipExecTransmit struct {
+	offRamp   common.Address
+	forwarded bool
+	v1_0      bool
+	args      []interface{}
+	// report is the execution report, converted from the 1.0 encoding if v1_0 is set
+	report evm_2_evm_offramp.InternalExecutionReport
+}
+
+func decodeCCIPExecTransmit(to common.Address, payload []byte, v1_0 bool) (t ccipExecTransmit, err error) {
+	t.offRamp, t.v1_0 = to, v1_0
+	if len(payload) >= 4 && string(payload[:4]) == string(forwardABI.ID) {
+		args, err2 := forwardABI.Inputs.Unpack(payload[4:])
+		if err2 != nil {
+			return t, pkgerrors.Wrap(err2, "failed to unpack forward arguments")
+		}
+		t.offRamp, t.forwarded, payload = args[0].(common.Address), true, args[1].([]byte)
+	}
+	if len(payload) < 4 {
+		return t, pkgerrors.New("payload too short")
+	}
+	method, err := ccipOffRampABI.MethodById(payload[:4])
+	if err != nil {
+		return t, err
+	}
+	if method.Name != "transmit" {
+		return t, pkgerrors.Errorf("expected a transmit call, got %s", method.Name)
+	}
+	t.args, err = method.Inputs.Unpack(payload[4:])
+	if err != nil {
+		return t, pkgerrors.Wrap(err, "failed to unpack transmit arguments")
+	}
+	reportArgs := ccipExecReportArgs
+	if v1_0 {
+		reportArgs = ccipExecReportArgsV1_0
+	}
+	unpacked, err := reportArgs.Unpack(t.args[1].([]byte))
+	if err != nil {
+		return t, pkgerrors.Wrap(err, "failed to unpack execution report")
+	}
+	if v1_0 {
+		t.report = ccipExecReportFromV1_0(*abi.ConvertType(unpacked[0], new(evm_2_evm_offramp_1_0_0.InternalExecutionReport)).(*evm_2_evm_offramp_1_0_0.InternalExecutionReport))
+	} else {
+		t.report = *abi.ConvertType(unpacked[0], new(evm_2_evm_offramp.InternalExecutionReport)).(*evm_2_evm_offramp.InternalExecutionReport)
+	}
+	if len(t.report.Messages) != len(t.report.OffchainTokenData) {
+		return t, pkgerrors.Errorf("got %d messages and %d offchain token data", len(t.report.Messages), len(t.report.OffchainTokenData))
+	}
+	return t, nil
+}
+
+// encode returns the payload of the transmit call with the given report.
+func (t ccipExecTransmit) encode(report evm_2_evm_offramp.InternalExecutionReport) ([]byte, error) {
+	var encodedReport []byte
+	var err error
+	if t.v1_0 {
+		reportV1_0 := ccipExecReportToV1_0(report)
+		encodedReport, err = ccipExecReportArgsV1_0.PackValues([]interface{}{&reportV1_0})
+	} else {
+		encodedReport, err = ccipExecReportArgs.PackValues([]interface{}{&report})
+	}
+	if err != nil {
+		return nil, pkgerrors.Wrap(err, "failed to pack execution report")
+	}
+	args := append([]interface{}{}, t.args...)
+	args[1] = encodedReport
+	payload, err := ccipOffRampABI.Pack("transmit", args...)
+	if err != nil || !t.forwarded {
+		return payload, err
+	}
+	forwardArgs, err := forwardABI.Inputs.Pack(t.offRamp, payload)
+	if err != nil {
+		return nil, err
+	}
+	return append(append([]byte{}, forwardABI.ID...), forwardArgs...), nil
+}
+
+// ccipExecReportFromV1_0 converts a 1.0 execution report, 1.0 messages have no source token data.
+func ccipExecReportFromV1_0(r evm_2_evm_offramp_1_0_0.InternalExecutionReport) evm_2_evm_offramp.InternalExecutionReport {
+	report := evm_2_evm_offramp.InternalExecutionReport{
+		OffchainTokenData: r.OffchainTokenData,
+		Proofs:            r.Proofs,
+		ProofFlagBits:     r.ProofFlagBits,
+	}
+	for _, m := range r.Messages {
+		msg := evm_2_evm_offramp.InternalEVM2EVMMessage{
+			SourceChainSelector: m.SourceChainSelector,
+			Sender:              m.Sender,
+			Receiver:            m.Receiver,
+			SequenceNumber:      m.SequenceNumber,
+			GasLimit:            m.GasLimit,
+			Strict:              m.Strict,
+			Nonce:               m.Nonce,
+			FeeToken:            m.FeeToken,
+			FeeTokenAmount:      m.FeeTokenAmount,
+			Data:                m.Data,
+			MessageId:           m.MessageId,
+		}
+		for _, ta := range m.TokenAmounts {
+			msg.TokenAmounts = append(msg.TokenAmounts, evm_2_evm_offramp.ClientEVMTokenAmount{Token: ta.Token, Amount: ta.Amount})
+		}
+		report.Messages = append(report.Messages, msg)
+	}
+	return report
+}
+
+// ccipExecReportToV1_0 converts an execution report back to the 1.0 encoding.
+func ccipExecReportToV1_0(r evm_2_evm_offramp.InternalExecutionReport) evm_2_evm_offramp_1_0_0.InternalExecutionReport {
+	report := evm_2_evm_offramp_1_0_0.InternalExecutionReport{
+		OffchainTokenData: r.OffchainTokenData,
+		Proofs:            r.Proofs,
+		ProofFlagBits:     r.ProofFlagBits,
+	}
+	for _, m := range r.Messages {
+		msg := evm_2_evm_offramp_1_0_0.InternalEVM2EVMMessage{
+			SourceChainSelector: m.SourceChainSelector,
+			SequenceNumber:      m.SequenceNumber,
+			FeeTokenAmount:      m.FeeTokenAmount,
+			Sender:              m.Sender,
+			Nonce:               m.Nonce,
+			GasLimit:            m.GasLimit,
+			Strict:              m.Strict,
+			Receiver:            m.Receiver,
+			Data:                m.Data,
+			TokenAmounts:        []evm_2_evm_offramp_1_0_0.ClientEVMTokenAmount{},
+			FeeToken:            m.FeeToken,
+			MessageId:           m.MessageId,
+		}
+		for _, ta := range m.TokenAmounts {
+			msg.TokenAmounts = append(msg.TokenAmounts, evm_2_evm_offramp_1_0_0.ClientEVMTokenAmount{Token: ta.Token, Amount: ta.Amount})
+		}
+		report.Messages = append(report.Messages, msg)
+	}
+	return report
+}
+
+// pruneCCIPExecReport drops the given messages from an execution report. The offramp only verifies
+// that the messages of a report belong to a committed merkle root, so the hashes of dropped messages
+// become part of the proof. Message IDs are the leaf hashes of EVM2EVM messages.
+func pruneCCIPExecReport(report evm_2_evm_offramp.InternalExecutionReport, drop map[int]bool) (evm_2_evm_offramp.InternalExecutionReport, error) {
+	leaves := make([][32]byte, len(report.Messages))
+	pruned := evm_2_evm_offramp.InternalExecutionReport{}
+	for i, msg := range report.Messages {
+		leaves[i] = msg.MessageId
+		if !drop[i] {
+			pruned.Messages = append(pruned.Messages, msg)
+			pruned.OffchainTokenData = append(pruned.OffchainTokenData, report.OffchainTokenData[i])
+		}
+	}
+	proofs, flagBits, err := pruneMerkleMultiProof(leaves, report.Proofs, report.ProofFlagBits, drop)
+	if err != nil {
+		return report, err
+	}
+	pruned.Proofs = proofs
+	pruned.ProofFlagBits = flagBits
+	return pruned, nil
+}
+
+// pruneMerkleMultiProof replays the root computation of MerkleMultiProof.merkleRoot and returns the proofs and
+// flag bits that produce the same root from the leaves that are not dropped. Hashes that only depend on dropped
+// leaves are turned into proofs, the order in which the remaining leaves and hashes are consumed is unchanged.
+func pruneMerkleMultiProof(leaves [][32]byte, proofs [][32]byte, flagBits *big.Int, drop map[int]bool) ([][32]byte, *big.Int, error) {
+	type node struct {
+		hash [32]byte
+		// kept is set if the node depends on a leaf that is not dropped
+		kept bool
+	}
+	totalHashes := len(leaves) + len(proofs) - 1
+	if len(leaves) == 0 || totalHashes > 256 {
+		return nil, nil, pkgerrors.Errorf("invalid proof for %d leaves and %d proofs", len(leaves), len(proofs))
+	}
+
+	hasher := hashutil.NewKeccak()
+	// leaves followed by the computed hashes, consumed in order
+	queue := make([]node, 0, len(leaves)+totalHashes)
+	for i, leaf := range leaves {
+		queue = append(queue, node{hash: leaf, kept: !drop[i]})
+	}
+	var queuePos, proofPos, step int
+	var newProofs [][32]byte
+	newFlagBits := new(big.Int)
+	for i := 0; i < totalHashes; i++ {
+		var a node
+		if flagBits.Bit(i) == 1 {
+			if queuePos >= len(queue) {
+				return nil, nil, pkgerrors.New("invalid proof flag bits")
+			}
+			a = queue[queuePos]
+			queuePos++
+		} else {
+			if proofPos >= len(proofs) {
+				return nil, nil, pkgerrors.New("invalid proof flag bits")
+			}
+			a = node{hash: proofs[proofPos]}
+			proofPos++
+		}
+		if queuePos >= len(queue) {
+			return nil, nil, pkgerrors.New("invalid proof flag bits")
+		}
+		b := queue[queuePos]
+		queuePos++
+		queue = append(queue, node{hash: hasher.HashInternal(a.hash, b.hash), kept: a.kept || b.kept})
+
+		// internal hashes are order independent, so a kept node can always be consumed second
+		switch {
+		case a.kept && b.kept:
+			newFlagBits.SetBit(newFlagBits, step, 1)
+			step++
+		case a.kept:
+			newProofs = append(newProofs, b.hash)
+			step++
+		case b.kept:
+			newProofs = append(newProofs, a.hash)
+			step++
+		}
+	}
+	if queuePos != len(queue)-1 || proofPos != len(proofs) {
+		return nil, nil, pkgerrors.New("not all proofs used")
+	}
+	return newProofs, newFlagBits, nil
+}
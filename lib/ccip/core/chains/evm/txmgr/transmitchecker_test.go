@@ -8,15 +8,19 @@ import (
 	"testing"
 	"time"
 
+	"github.com/ethereum/go-ethereum/accounts/abi"
 	"github.com/ethereum/go-ethereum/accounts/abi/bind"
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/rpc"
 	pkgerrors "github.com/pkg/errors"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 
+	"github.com/smartcontractkit/chainlink-common/pkg/hashutil"
 	"github.com/smartcontractkit/chainlink-common/pkg/logger"
+	"github.com/smartcontractkit/chainlink-common/pkg/merklemulti"
 	"github.com/smartcontractkit/chainlink-common/pkg/sqlutil"
 	"github.com/smartcontractkit/chainlink-common/pkg/utils/tests"
 
@@ -27,6 +31,9 @@ import (
 	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/testutils"
 	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/txmgr"
 	evmtypes "github.com/smartcontractkit/chainlink/v2/core/chains/evm/types"
+	"github.com/smartcontractkit/chainlink/v2/core/gethwrappers/ccip/generated/evm_2_evm_offramp"
+	"github.com/smartcontractkit/chainlink/v2/core/gethwrappers/ccip/generated/evm_2_evm_offramp_1_0_0"
+	"github.com/smartcontractkit/chainlink/v2/core/gethwrappers/generated/authorized_forwarder"
 	v1 "github.com/smartcontractkit/chainlink/v2/core/gethwrappers/generated/solidity_vrf_coordinator_interface"
 )
 
@@ -93,6 +100,22 @@ func TestFactory(t *testing.T) {
 		require.Equal(t, &txmgr.SimulateChecker{Client: client}, c)
 	})
 
+	t.Run("ccip exec checker", func(t *testing.T) {
+		c, err := factory.BuildChecker(txmgr.TransmitCheckerSpec{
+			CheckerType: txmgr.TransmitCheckerTypeCCIPExec,
+		})
+		require.NoError(t, err)
+		require.Equal(t, &txmgr.CCIPExecChecker{Client: client}, c)
+	})
+
+	t.Run("ccip exec 1.0 checker", func(t *testing.T) {
+		c, err := factory.BuildChecker(txmgr.TransmitCheckerSpec{
+			CheckerType: txmgr.TransmitCheckerTypeCCIPExecV1_0,
+		})
+		require.NoError(t, err)
+		require.Equal(t, &txmgr.CCIPExecChecker{Client: client, V1_0: true}, c)
+	})
+
 	t.Run("invalid checker type", func(t *testing.T) {
 		_, err := factory.BuildChecker(txmgr.TransmitCheckerSpec{
 			CheckerType: "invalid",
@@ -370,3 +393,199 @@ func TestTransmitCheckers(t *testing.T) {
 		})
 	})
 }
+
+func TestCCIPExecChecker(t *testing.T) {
+	client := testutils.NewEthClientMockWithDefaultChain(t)
+	log := logger.Sugared(logger.Test(t))
+	ctx := tests.Context(t)
+
+	offRampABI := evmtypes.MustGetABI(evm_2_evm_offramp.EVM2EVMOffRampABI)
+	forwardABI := evmtypes.MustGetABI(authorized_forwarder.AuthorizedForwarderABI).Methods["forward"]
+	reportArgs := offRampABI.Methods["manuallyExecute"].Inputs[:1]
+	offRamp := testutils.NewAddress()
+	hasher := hashutil.NewKeccak()
+
+	// the report executes messages 11 to 15, out of a commit of 8 messages
+	var leaves [][32]byte
+	for i := 0; i < 8; i++ {
+		leaves = append(leaves, hasher.Hash([]byte{byte(i)}))
+	}
+	tree, err := merklemulti.NewTree(hasher, leaves)
+	require.NoError(t, err)
+	indices := []int{1, 2, 3, 4, 5}
+	proof, err := tree.Prove(indices)
+	require.NoError(t, err)
+	report := evm_2_evm_offramp.InternalExecutionReport{
+		Proofs:        proof.Hashes,
+		ProofFlagBits: big.NewInt(0),
+	}
+	for i, flag := range proof.SourceFlags {
+		if flag == merklemulti.SourceFromHashes {
+			report.ProofFlagBits.SetBit(report.ProofFlagBits, i, 1)
+		}
+	}
+	for _, i := range indices {
+		report.Messages = append(report.Messages, evm_2_evm_offramp.InternalEVM2EVMMessage{
+			SequenceNumber: uint64(10 + i),
+			GasLimit:       big.NewInt(0),
+			FeeTokenAmount: big.NewInt(0),
+			TokenAmounts:   []evm_2_evm_offramp.ClientEVMTokenAmount{},
+			MessageId:      leaves[i],
+		})
+		report.OffchainTokenData = append(report.OffchainTokenData, [][]byte{{byte(i)}})
+	}
+	encodedReport, err := reportArgs.PackValues([]interface{}{&report})
+	require.NoError(t, err)
+	payload, err := offRampABI.Pack("transmit", [3][32]byte{{1}}, encodedReport, [][32]byte{}, [][32]byte{}, [32]byte{})
+	require.NoError(t, err)
+
+	// decodeReport decodes the report of a rebuilt payload and checks that it still proves the committed root
+	decodeReport := func(t *testing.T, payload []byte) evm_2_evm_offramp.InternalExecutionReport {
+		args, err := offRampABI.Methods["transmit"].Inputs.Unpack(payload[4:])
+		require.NoError(t, err)
+		require.Equal(t, [3][32]byte{{1}}, args[0])
+		unpacked, err := reportArgs.Unpack(args[1].([]byte))
+		require.NoError(t, err)
+		rebuilt := *abi.ConvertType(unpacked[0], new(evm_2_evm_offramp.InternalExecutionReport)).(*evm_2_evm_offramp.InternalExecutionReport)
+
+		var rebuiltLeaves [][32]byte
+		for _, msg := range rebuilt.Messages {
+			rebuiltLeaves = append(rebuiltLeaves, msg.MessageId)
+		}
+		rebuiltProof := merklemulti.Proof[[32]byte]{Hashes: rebuilt.Proofs}
+		for i := 0; i < len(rebuiltLeaves)+len(rebuilt.Proofs)-1; i++ {
+			rebuiltProof.SourceFlags = append(rebuiltProof.SourceFlags, rebuilt.ProofFlagBits.Bit(i) == 1)
+		}
+		root, err := merklemulti.VerifyComputeRoot(hasher, rebuiltLeaves, rebuiltProof)
+		require.NoError(t, err)
+		require.Equal(t, tree.Root(), root)
+		return rebuilt
+	}
+
+	var states map[uint64]uint8
+	client.On("BatchCallContext", mock.Anything, mock.Anything).Return(nil).Run(func(args mock.Arguments) {
+		for _, elem := range args.Get(1).([]rpc.BatchElem) {
+			call := elem.Args[0].(map[string]interface{})
+			require.Equal(t, offRamp, call["to"])
+			data := call["data"].(hexutil.Bytes)
+			in, err := offRampABI.Methods["getExecutionState"].Inputs.Unpack(data[4:])
+			require.NoError(t, err)
+			out, err := offRampABI.Methods["getExecutionState"].Outputs.Pack(states[in[0].(uint64)])
+			require.NoError(t, err)
+			*elem.Result.(*hexutil.Bytes) = out
+		}
+	})
+
+	checker := txmgr.CCIPExecChecker{Client: client}
+	tx := txmgr.Tx{
+		ToAddress:      offRamp,
+		EncodedPayload: payload,
+		State:          txmgrcommon.TxUnstarted,
+	}
+
+	t.Run("no messages executed", func(t *testing.T) {
+		states = map[uint64]uint8{}
+		rebuilt, err := checker.RebuildPayload(ctx, log, tx, txmgr.TxAttempt{})
+		require.NoError(t, err)
+		require.Nil(t, rebuilt)
+	})
+
+	t.Run("all messages executed", func(t *testing.T) {
+		states = map[uint64]uint8{11: 2, 12: 2, 13: 3, 14: 2, 15: 2}
+		_, err := checker.RebuildPayload(ctx, log, tx, txmgr.TxAttempt{})
+		require.EqualError(t, err, "all messages already executed")
+		require.EqualError(t, checker.Check(ctx, log, tx, txmgr.TxAttempt{}), "all messages already executed")
+	})
+
+	t.Run("drops executed messages", func(t *testing.T) {
+		for _, executed := range [][]uint64{{11}, {15}, {12, 14}, {12, 13}, {11, 12, 13, 14}} {
+			states = map[uint64]uint8{}
+			for _, seqNr := range executed {
+				states[seqNr] = 2
+			}
+			rebuilt, err := checker.RebuildPayload(ctx, log, tx, txmgr.TxAttempt{})
+			require.NoError(t, err)
+			require.NotNil(t, rebuilt)
+			require.NoError(t, checker.Check(ctx, log, tx, txmgr.TxAttempt{}))
+
+			rebuiltReport := decodeReport(t, rebuilt)
+			require.Len(t, rebuiltReport.Messages, 5-len(executed))
+			require.Len(t, rebuiltReport.OffchainTokenData, 5-len(executed))
+			for i, msg := range rebuiltReport.Messages {
+				require.NotContains(t, executed, msg.SequenceNumber)
+				require.Equal(t, [][]byte{{byte(msg.SequenceNumber - 10)}}, rebuiltReport.OffchainTokenData[i])
+			}
+		}
+	})
+
+	t.Run("forwarded transmission", func(t *testing.T) {
+		states = map[uint64]uint8{13: 2}
+		forwardArgs, err := forwardABI.Inputs.Pack(offRamp, payload)
+		require.NoError(t, err)
+		forwardedTx := tx
+		forwardedTx.ToAddress = testutils.NewAddress()
+		forwardedTx.EncodedPayload = append(append([]byte{}, forwardABI.ID...), forwardArgs...)
+
+		rebuilt, err := checker.RebuildPayload(ctx, log, forwardedTx, txmgr.TxAttempt{})
+		require.NoError(t, err)
+		require.Equal(t, forwardABI.ID, rebuilt[:4])
+		args, err := forwardABI.Inputs.Unpack(rebuilt[4:])
+		require.NoError(t, err)
+		require.Equal(t, offRamp, args[0])
+		require.Len(t, decodeReport(t, args[1].([]byte)).Messages, 4)
+	})
+
+	t.Run("1.0 execution report", func(t *testing.T) {
+		offRampABIV1_0 := evmtypes.MustGetABI(evm_2_evm_offramp_1_0_0.EVM2EVMOffRampABI)
+		reportArgsV1_0 := offRampABIV1_0.Methods["manuallyExecute"].Inputs[:1]
+		reportV1_0 := evm_2_evm_offramp_1_0_0.InternalExecutionReport{
+			OffchainTokenData: report.OffchainTokenData,
+			Proofs:            report.Proofs,
+			ProofFlagBits:     report.ProofFlagBits,
+		}
+		for _, msg := range report.Messages {
+			reportV1_0.Messages = append(reportV1_0.Messages, evm_2_evm_offramp_1_0_0.InternalEVM2EVMMessage{
+				SequenceNumber: msg.SequenceNumber,
+				GasLimit:       msg.GasLimit,
+				FeeTokenAmount: msg.FeeTokenAmount,
+				TokenAmounts:   []evm_2_evm_offramp_1_0_0.ClientEVMTokenAmount{},
+				MessageId:      msg.MessageId,
+			})
+		}
+		encodedReportV1_0, err := reportArgsV1_0.PackValues([]interface{}{&reportV1_0})
+		require.NoError(t, err)
+		txV1_0 := tx
+		txV1_0.EncodedPayload, err = offRampABIV1_0.Pack("transmit", [3][32]byte{{1}}, encodedReportV1_0, [][32]byte{}, [][32]byte{}, [32]byte{})
+		require.NoError(t, err)
+
+		states = map[uint64]uint8{12: 2, 14: 2}
+		checkerV1_0 := txmgr.CCIPExecChecker{Client: client, V1_0: true}
+		rebuilt, err := checkerV1_0.RebuildPayload(ctx, log, txV1_0, txmgr.TxAttempt{})
+		require.NoError(t, err)
+
+		args, err := offRampABIV1_0.Methods["transmit"].Inputs.Unpack(rebuilt[4:])
+		require.NoError(t, err)
+		unpacked, err := reportArgsV1_0.Unpack(args[1].([]byte))
+		require.NoError(t, err)
+		rebuiltReport := *abi.ConvertType(unpacked[0], new(evm_2_evm_offramp_1_0_0.InternalExecutionReport)).(*evm_2_evm_offramp_1_0_0.InternalExecutionReport)
+		var seqNrs []uint64
+		for _, msg := range rebuiltReport.Messages {
+			seqNrs = append(seqNrs, msg.SequenceNumber)
+		}
+		require.Equal(t, []uint64{11, 13, 15}, seqNrs)
+		require.Len(t, rebuiltReport.OffchainTokenData, 3)
+
+		// the 1.5 checker can't decode the 1.0 report, and transmits it unchanged
+		rebuilt, err = checker.RebuildPayload(ctx, log, txV1_0, txmgr.TxAttempt{})
+		require.NoError(t, err)
+		require.Nil(t, rebuilt)
+	})
+
+	t.Run("not an execution report, should transmit", func(t *testing.T) {
+		notReport := tx
+		notReport.EncodedPayload = []byte{42, 0, 0}
+		rebuilt, err := checker.RebuildPayload(ctx, log, notReport, txmgr.TxAttempt{})
+		require.NoError(t, err)
+		require.Nil(t, rebuilt)
+	})
+}
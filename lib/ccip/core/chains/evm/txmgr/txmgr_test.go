@@ -16,6 +16,7 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/trace"
 	"gopkg.in/guregu/null.v4"
 
 	"github.com/jmoiron/sqlx"
@@ -86,7 +87,8 @@ func makeTestEvmTxm(
 		lp,
 		keyStore,
 		estimator,
-		ht)
+		ht,
+		nil)
 }
 
 func TestTxm_SendNativeToken_DoesNotSendToZero(t *testing.T) {
@@ -343,6 +345,33 @@ func TestTxm_CreateTransaction(t *testing.T) {
 		require.Equal(t, etx.ToAddress.String(), fwdrAddr.String())
 	})
 
+	t.Run("carries the trace context of the caller", func(t *testing.T) {
+		pgtest.MustExec(t, db, `DELETE FROM evm.txes`)
+		evmConfig.MaxQueued = uint64(1)
+		sc := trace.NewSpanContext(trace.SpanContextConfig{
+			TraceID:    trace.TraceID{1},
+			SpanID:     trace.SpanID{2},
+			TraceFlags: trace.FlagsSampled,
+		})
+
+		etx, err := txm.CreateTransaction(trace.ContextWithSpanContext(tests.Context(t), sc), txmgr.TxRequest{
+			FromAddress:    fromAddress,
+			ToAddress:      toAddress,
+			EncodedPayload: payload,
+			FeeLimit:       gasLimit,
+			Strategy:       txmgrcommon.NewSendEveryStrategy(),
+		})
+		require.NoError(t, err)
+
+		m, err := etx.GetMeta()
+		require.NoError(t, err)
+		require.Contains(t, m.TraceContext, "traceparent")
+		broadcastSC := trace.SpanContextFromContext(etx.WithTraceContext(tests.Context(t)))
+		require.Equal(t, sc.TraceID(), broadcastSC.TraceID())
+		require.Equal(t, sc.SpanID(), broadcastSC.SpanID())
+		require.True(t, broadcastSC.IsRemote())
+	})
+
 	t.Run("insert Tx successfully with a IdempotencyKey", func(t *testing.T) {
 		evmConfig.MaxQueued = uint64(3)
 		id := uuid.New()
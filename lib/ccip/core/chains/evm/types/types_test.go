@@ -56,6 +56,7 @@ var (
 		BlockHash:         common.HexToHash("0x11111111111111"),
 		BlockNumber:       big.NewInt(555),
 		TransactionIndex:  777,
+		EffectiveGasPrice: big.NewInt(888),
 		Logs: []*gethTypes.Log{
 			testGethLog1,
 			testGethLog2,
@@ -9,6 +9,7 @@ import (
 	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/gas"
 	httypes "github.com/smartcontractkit/chainlink/v2/core/chains/evm/headtracker/types"
 	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/logpoller"
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/smartaccount"
 	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/txmgr"
 	"github.com/smartcontractkit/chainlink/v2/core/logger"
 )
@@ -26,12 +27,13 @@ func newEvmTxm(
 	headTracker httypes.HeadTracker,
 ) (txm txmgr.TxManager,
 	estimator gas.EvmFeeEstimator,
+	smartAccounts *smartaccount.Manager,
 	err error,
 ) {
 	chainID := cfg.ChainID()
 	if !evmRPCEnabled {
 		txm = &txmgr.NullTxManager{ErrMsg: fmt.Sprintf("Ethereum is disabled for chain %d", chainID)}
-		return txm, nil, nil
+		return txm, nil, nil, nil
 	}
 
 	lggr = lggr.Named("Txm")
@@ -46,13 +48,18 @@ func newEvmTxm(
 	// build estimator from factory
 	if opts.GenGasEstimator == nil {
 		if estimator, err = gas.NewEstimator(lggr, client, cfg, cfg.GasEstimator()); err != nil {
-			return nil, nil, fmt.Errorf("failed to initialize estimator: %w", err)
+			return nil, nil, nil, fmt.Errorf("failed to initialize estimator: %w", err)
 		}
 	} else {
 		estimator = opts.GenGasEstimator(chainID)
 	}
 
 	if opts.GenTxManager == nil {
+		var smartAccountMgr txmgr.SmartAccountManager
+		if accounts := cfg.Transactions().SmartAccounts(); len(accounts) > 0 {
+			smartAccounts = smartaccount.NewManager(lggr, smartaccount.NewORM(chainID, ds), client, opts.KeyStore, estimator, cfg.GasEstimator(), accounts)
+			smartAccountMgr = smartAccounts
+		}
 		txm, err = txmgr.NewTxm(
 			ds,
 			cfg,
@@ -66,7 +73,8 @@ func newEvmTxm(
 			logPoller,
 			opts.KeyStore,
 			estimator,
-			headTracker)
+			headTracker,
+			smartAccountMgr)
 	} else {
 		txm = opts.GenTxManager(chainID)
 	}
@@ -28,6 +28,8 @@ import (
 
 	monitor "github.com/smartcontractkit/chainlink/v2/core/chains/evm/monitor"
 
+	smartaccount "github.com/smartcontractkit/chainlink/v2/core/chains/evm/smartaccount"
+
 	txmgr "github.com/smartcontractkit/chainlink/v2/common/txmgr"
 
 	types "github.com/smartcontractkit/chainlink-common/pkg/types"
@@ -884,6 +886,53 @@ func (_c *Chain_Ready_Call) RunAndReturn(run func() error) *Chain_Ready_Call {
 	return _c
 }
 
+// SmartAccounts provides a mock function with given fields:
+func (_m *Chain) SmartAccounts() *smartaccount.Manager {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for SmartAccounts")
+	}
+
+	var r0 *smartaccount.Manager
+	if rf, ok := ret.Get(0).(func() *smartaccount.Manager); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*smartaccount.Manager)
+		}
+	}
+
+	return r0
+}
+
+// Chain_SmartAccounts_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SmartAccounts'
+type Chain_SmartAccounts_Call struct {
+	*mock.Call
+}
+
+// SmartAccounts is a helper method to define mock.On call
+func (_e *Chain_Expecter) SmartAccounts() *Chain_SmartAccounts_Call {
+	return &Chain_SmartAccounts_Call{Call: _e.mock.On("SmartAccounts")}
+}
+
+func (_c *Chain_SmartAccounts_Call) Run(run func()) *Chain_SmartAccounts_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *Chain_SmartAccounts_Call) Return(_a0 *smartaccount.Manager) *Chain_SmartAccounts_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Chain_SmartAccounts_Call) RunAndReturn(run func() *smartaccount.Manager) *Chain_SmartAccounts_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Start provides a mock function with given fields: _a0
 func (_m *Chain) Start(_a0 context.Context) error {
 	ret := _m.Called(_a0)
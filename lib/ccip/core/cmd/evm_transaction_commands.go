@@ -6,6 +6,8 @@ import (
 	"errors"
 	"fmt"
 	"math/big"
+	"net/url"
+	"strconv"
 
 	"github.com/urfave/cli"
 	"go.uber.org/multierr"
@@ -62,6 +64,21 @@ func initEVMTxSubCmd(s *Shell) cli.Command {
 				Usage:  "get information on a specific Ethereum Transaction",
 				Action: s.ShowTransaction,
 			},
+			{
+				Name:   "stuck",
+				Usage:  "List the Ethereum Transactions that are currently stuck, or how the stuck transactions of each key were recovered",
+				Action: s.IndexStuckTransactions,
+				Flags: []cli.Flag{
+					cli.Int64Flag{
+						Name:  "id",
+						Usage: "chain ID",
+					},
+					cli.BoolFlag{
+						Name:  "history",
+						Usage: "list how the stuck transactions of each key were recovered, oldest first",
+					},
+				},
+			},
 		},
 	}
 }
@@ -131,6 +148,93 @@ func (s *Shell) ShowTransaction(c *cli.Context) (err error) {
 	return err
 }
 
+type EthStuckTxPresenter struct {
+	JAID
+	presenters.EthStuckTxResource
+}
+
+type EthStuckTxPresenters []EthStuckTxPresenter
+
+// RenderTable implements TableRenderer
+func (ps EthStuckTxPresenters) RenderTable(rt RendererTable) error {
+	table := rt.newTable([]string{"From", "TxID", "Nonce", "Hash", "Attempts", "Sent At", "Last Reason", "Last Remedy", "Last Decided At"})
+	for _, p := range ps {
+		lastDecidedAt := ""
+		if p.LastDecidedAt != nil {
+			lastDecidedAt = p.LastDecidedAt.String()
+		}
+		table.Append([]string{
+			p.From.Hex(),
+			p.TxID,
+			p.Nonce,
+			p.Hash.Hex(),
+			strconv.Itoa(p.Attempts),
+			p.SentAt,
+			p.LastReason,
+			p.LastRemedy,
+			lastDecidedAt,
+		})
+	}
+
+	render("Stuck Ethereum Transactions", table)
+	return nil
+}
+
+type EthStuckTxDecisionPresenter struct {
+	JAID
+	presenters.EthStuckTxDecisionResource
+}
+
+type EthStuckTxDecisionPresenters []EthStuckTxDecisionPresenter
+
+// RenderTable implements TableRenderer
+func (ps EthStuckTxDecisionPresenters) RenderTable(rt RendererTable) error {
+	table := rt.newTable([]string{"From", "TxID", "Nonce", "Reason", "Remedy", "BlockNum", "DecidedAt", "Error"})
+	for _, p := range ps {
+		table.Append([]string{
+			p.From.Hex(),
+			p.TxID,
+			p.Nonce,
+			p.Reason,
+			p.Remedy,
+			p.BlockNum,
+			p.DecidedAt.String(),
+			p.Error,
+		})
+	}
+
+	render("Stuck Ethereum Transaction Decisions", table)
+	return nil
+}
+
+// IndexStuckTransactions returns the transactions of the chain that are currently stuck, or how the stuck transactions
+// of each key of the chain were recovered if the history flag is set
+func (s *Shell) IndexStuckTransactions(c *cli.Context) (err error) {
+	v := url.Values{}
+	if c.IsSet("id") {
+		v.Add("evmChainID", fmt.Sprintf("%d", c.Int64("id")))
+	}
+
+	path := "/v2/stuck_transactions/evm"
+	if c.Bool("history") {
+		path += "/history"
+	}
+	resp, err := s.HTTP.Get(s.ctx(), path+"?"+v.Encode())
+	if err != nil {
+		return s.errorOut(err)
+	}
+	defer func() {
+		if cerr := resp.Body.Close(); cerr != nil {
+			err = multierr.Append(err, cerr)
+		}
+	}()
+
+	if c.Bool("history") {
+		return s.renderAPIResponse(resp, &EthStuckTxDecisionPresenters{})
+	}
+	return s.renderAPIResponse(resp, &EthStuckTxPresenters{})
+}
+
 // SendEther transfers ETH from the node's account to a specified address.
 func (s *Shell) SendEther(c *cli.Context) (err error) {
 	if c.NArg() < 3 {
@@ -5,6 +5,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"io"
+	"strconv"
 	"strings"
 	"time"
 
@@ -12,6 +13,7 @@ import (
 	"github.com/urfave/cli"
 	"go.uber.org/multierr"
 
+	"github.com/smartcontractkit/chainlink/v2/core/services/job"
 	"github.com/smartcontractkit/chainlink/v2/core/services/pipeline"
 	"github.com/smartcontractkit/chainlink/v2/core/web"
 	"github.com/smartcontractkit/chainlink/v2/core/web/presenters"
@@ -50,6 +52,62 @@ func initJobsSubCmds(s *Shell) []cli.Command {
 			Usage:  "Trigger a job run",
 			Action: s.TriggerPipelineRun,
 		},
+		{
+			Name:   "dry-run",
+			Usage:  "Execute a run of a job, or of a TOML spec, without side effects, the run is not saved",
+			Action: s.DryRunPipelineRun,
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:  "vars",
+					Usage: "JSON object of run variables, e.g. '{\"jobRun\":{\"requestBody\":\"...\"}}'",
+				},
+				cli.StringFlag{
+					Name:  "stubs",
+					Usage: "JSON object of http and bridge task responses, keyed by task name",
+				},
+			},
+		},
+		{
+			Name:   "plan",
+			Usage:  "Show the changes between a job and a new TOML spec, without updating the job",
+			Action: s.PlanJob,
+		},
+		{
+			Name:   "replay",
+			Usage:  "Dry run the given inputs against a job and a new TOML spec, and compare their outputs",
+			Action: s.ReplayJob,
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:  "inputs",
+					Usage: "JSON array of dry run inputs, each with vars and stubs, e.g. '[{\"vars\":{},\"stubs\":{\"fetch\":\"1\"}}]'",
+				},
+				cli.BoolFlag{
+					Name:  "promote",
+					Usage: "update the job to the new spec if the outputs of all inputs match",
+				},
+			},
+		},
+		{
+			Name:   "canary",
+			Usage:  "Shadow the next live runs of a job with dry runs of a new TOML spec, and compare their outputs",
+			Action: s.CanaryJob,
+			Flags: []cli.Flag{
+				cli.IntFlag{
+					Name:  "runs",
+					Usage: "number of live runs to shadow",
+					Value: 10,
+				},
+				cli.BoolFlag{
+					Name:  "promote",
+					Usage: "update the job to the new spec once the outputs of all runs match",
+				},
+			},
+		},
+		{
+			Name:   "canary-status",
+			Usage:  "Show the progress of the last canary of a job",
+			Action: s.ShowJobCanary,
+		},
 	}
 }
 
@@ -313,3 +371,299 @@ func (s *Shell) TriggerPipelineRun(c *cli.Context) error {
 	err = s.renderAPIResponse(resp, &run, "Pipeline run successfully triggered")
 	return err
 }
+
+// DryRunPipelineRun executes a job run without side effects
+// Valid input is a job ID, or a TOML string or a path to TOML file of a job which doesn't need to exist
+func (s *Shell) DryRunPipelineRun(c *cli.Context) (err error) {
+	if !c.Args().Present() {
+		return s.errorOut(errors.New("must pass the job id, or a TOML or filepath, to dry run"))
+	}
+
+	var req web.DryRunJobRequest
+	if v := c.String("vars"); v != "" {
+		if err = json.Unmarshal([]byte(v), &req.Vars); err != nil {
+			return s.errorOut(errors.Wrap(err, "invalid vars"))
+		}
+	}
+	if v := c.String("stubs"); v != "" {
+		if err = json.Unmarshal([]byte(v), &req.Stubs); err != nil {
+			return s.errorOut(errors.Wrap(err, "invalid stubs"))
+		}
+	}
+
+	path := "/v2/jobs/dry-run"
+	if _, perr := strconv.ParseInt(c.Args().First(), 10, 32); perr == nil {
+		path = "/v2/jobs/" + c.Args().First() + "/runs/dry-run"
+	} else if req.TOML, err = getTOMLString(c.Args().First()); err != nil {
+		return s.errorOut(err)
+	}
+	request, err := json.Marshal(req)
+	if err != nil {
+		return s.errorOut(err)
+	}
+
+	resp, err := s.HTTP.Post(s.ctx(), path, bytes.NewReader(request))
+	if err != nil {
+		return s.errorOut(err)
+	}
+	defer func() {
+		if cerr := resp.Body.Close(); cerr != nil {
+			err = multierr.Append(err, cerr)
+		}
+	}()
+
+	var run presenters.PipelineRunResource
+	return s.renderAPIResponse(resp, &run, "Pipeline dry run")
+}
+
+// JobSpecDiffPresenter wraps the JSONAPI job spec diff resource and adds rendering functionality
+type JobSpecDiffPresenter struct {
+	JAID
+	presenters.JobSpecDiffResource
+}
+
+// RenderTable implements TableRenderer
+func (p *JobSpecDiffPresenter) RenderTable(rt RendererTable) error {
+	if p.Empty {
+		fmt.Printf("No changes to job %s\n", p.JAID.ID)
+		return nil
+	}
+	renderSpecDiff(rt, p.SpecDiff)
+	return nil
+}
+
+// JobReplayPresenter wraps the JSONAPI job replay resource and adds rendering functionality
+type JobReplayPresenter struct {
+	JAID
+	presenters.JobReplayResource
+}
+
+// RenderTable implements TableRenderer
+func (p *JobReplayPresenter) RenderTable(rt RendererTable) error {
+	renderSpecDiff(rt, p.Diff)
+
+	table := rt.newTable([]string{"Input", "Current", "Proposed", "Match"})
+	for i, r := range p.Results {
+		table.Append([]string{
+			fmt.Sprint(i),
+			formatReplayRun(r.Current),
+			formatReplayRun(r.Proposed),
+			fmt.Sprint(r.Match),
+		})
+	}
+	render("Replayed Inputs", table)
+
+	fmt.Printf("Passed: %v, Promoted: %v\n", p.Passed, p.Promoted)
+	return nil
+}
+
+// JobCanaryPresenter wraps the JSONAPI job canary resource and adds rendering functionality
+type JobCanaryPresenter struct {
+	JAID
+	presenters.JobCanaryResource
+}
+
+// RenderTable implements TableRenderer
+func (p *JobCanaryPresenter) RenderTable(rt RendererTable) error {
+	if p.Diff != nil {
+		renderSpecDiff(rt, *p.Diff)
+	}
+
+	table := rt.newTable([]string{"Run", "Live", "Proposed", "Match"})
+	for i, r := range p.Results {
+		table.Append([]string{
+			fmt.Sprint(i),
+			formatReplayRun(r.Current),
+			formatReplayRun(r.Proposed),
+			fmt.Sprint(r.Match),
+		})
+	}
+	render("Shadowed Runs", table)
+
+	fmt.Printf("State: %s, Runs: %d/%d, Promote: %v\n", p.State, len(p.Results), p.Runs, p.Promote)
+	if p.Error != "" {
+		fmt.Printf("Error: %s\n", p.Error)
+	}
+	return nil
+}
+
+func renderSpecDiff(rt RendererTable, d job.SpecDiff) {
+	table := rt.newTable([]string{"Change", "Path", "Old", "New"})
+	for _, f := range d.Fields {
+		table.Append([]string{"field", f.Path, formatDiffValue(f.Old), formatDiffValue(f.New)})
+	}
+	for _, t := range d.Pipeline.AddedTasks {
+		table.Append([]string{"task added", t, "", ""})
+	}
+	for _, t := range d.Pipeline.RemovedTasks {
+		table.Append([]string{"task removed", t, "", ""})
+	}
+	for _, t := range d.Pipeline.ChangedTasks {
+		for _, a := range t.Attributes {
+			table.Append([]string{"task changed", t.DotID + "." + a.Key, a.Old, a.New})
+		}
+	}
+	for _, e := range d.Pipeline.AddedEdges {
+		table.Append([]string{"edge added", e, "", ""})
+	}
+	for _, e := range d.Pipeline.RemovedEdges {
+		table.Append([]string{"edge removed", e, "", ""})
+	}
+	render("Job Spec Changes", table)
+}
+
+func formatDiffValue(v interface{}) string {
+	if v == nil {
+		return ""
+	}
+	b, err := json.Marshal(v)
+	if err != nil {
+		return fmt.Sprint(v)
+	}
+	return string(b)
+}
+
+func formatReplayRun(r job.ReplayRun) string {
+	var parts []string
+	for _, o := range r.Outputs {
+		if o != nil {
+			parts = append(parts, *o)
+		}
+	}
+	for _, e := range r.FatalErrors {
+		if e != nil {
+			parts = append(parts, "error: "+*e)
+		}
+	}
+	return strings.Join(parts, ", ")
+}
+
+// PlanJob shows the changes between a job and a new TOML spec
+// Valid input is the job ID followed by a TOML string or a path to TOML file
+func (s *Shell) PlanJob(c *cli.Context) (err error) {
+	if c.NArg() != 2 {
+		return s.errorOut(errors.New("must pass the job id and TOML or filepath"))
+	}
+
+	tomlString, err := getTOMLString(c.Args().Get(1))
+	if err != nil {
+		return s.errorOut(err)
+	}
+
+	request, err := json.Marshal(web.PlanJobRequest{
+		TOML: tomlString,
+	})
+	if err != nil {
+		return s.errorOut(err)
+	}
+
+	resp, err := s.HTTP.Post(s.ctx(), "/v2/jobs/"+c.Args().First()+"/plan", bytes.NewReader(request))
+	if err != nil {
+		return s.errorOut(err)
+	}
+	defer func() {
+		if cerr := resp.Body.Close(); cerr != nil {
+			err = multierr.Append(err, cerr)
+		}
+	}()
+
+	return s.renderAPIResponse(resp, &JobSpecDiffPresenter{}, "Job plan")
+}
+
+// ReplayJob dry runs the given inputs against a job and a new TOML spec, and optionally promotes it
+// Valid input is the job ID followed by a TOML string or a path to TOML file
+func (s *Shell) ReplayJob(c *cli.Context) (err error) {
+	if c.NArg() != 2 {
+		return s.errorOut(errors.New("must pass the job id and TOML or filepath"))
+	}
+
+	tomlString, err := getTOMLString(c.Args().Get(1))
+	if err != nil {
+		return s.errorOut(err)
+	}
+
+	req := web.ReplayJobRequest{
+		TOML:    tomlString,
+		Promote: c.Bool("promote"),
+	}
+	if v := c.String("inputs"); v != "" {
+		if err = json.Unmarshal([]byte(v), &req.Inputs); err != nil {
+			return s.errorOut(errors.Wrap(err, "invalid inputs"))
+		}
+	}
+	if len(req.Inputs) == 0 {
+		return s.errorOut(errors.New("must pass at least one input"))
+	}
+	request, err := json.Marshal(req)
+	if err != nil {
+		return s.errorOut(err)
+	}
+
+	resp, err := s.HTTP.Post(s.ctx(), "/v2/jobs/"+c.Args().First()+"/replay", bytes.NewReader(request))
+	if err != nil {
+		return s.errorOut(err)
+	}
+	defer func() {
+		if cerr := resp.Body.Close(); cerr != nil {
+			err = multierr.Append(err, cerr)
+		}
+	}()
+
+	return s.renderAPIResponse(resp, &JobReplayPresenter{}, "Job replay")
+}
+
+// CanaryJob shadows the next live runs of a job with dry runs of a new TOML spec, and optionally promotes it
+// Valid input is the job ID followed by a TOML string or a path to TOML file
+func (s *Shell) CanaryJob(c *cli.Context) (err error) {
+	if c.NArg() != 2 {
+		return s.errorOut(errors.New("must pass the job id and TOML or filepath"))
+	}
+	if c.Int("runs") <= 0 {
+		return s.errorOut(errors.New("runs must be positive"))
+	}
+
+	tomlString, err := getTOMLString(c.Args().Get(1))
+	if err != nil {
+		return s.errorOut(err)
+	}
+
+	request, err := json.Marshal(web.CanaryJobRequest{
+		TOML:    tomlString,
+		Runs:    c.Int("runs"),
+		Promote: c.Bool("promote"),
+	})
+	if err != nil {
+		return s.errorOut(err)
+	}
+
+	resp, err := s.HTTP.Post(s.ctx(), "/v2/jobs/"+c.Args().First()+"/canary", bytes.NewReader(request))
+	if err != nil {
+		return s.errorOut(err)
+	}
+	defer func() {
+		if cerr := resp.Body.Close(); cerr != nil {
+			err = multierr.Append(err, cerr)
+		}
+	}()
+
+	return s.renderAPIResponse(resp, &JobCanaryPresenter{}, "Job canary started")
+}
+
+// ShowJobCanary shows the progress of the last canary of a job
+func (s *Shell) ShowJobCanary(c *cli.Context) (err error) {
+	if !c.Args().Present() {
+		return s.errorOut(errors.New("must pass the job id"))
+	}
+
+	resp, err := s.HTTP.Get(s.ctx(), "/v2/jobs/"+c.Args().First()+"/canary")
+	if err != nil {
+		return s.errorOut(err)
+	}
+	defer func() {
+		if cerr := resp.Body.Close(); cerr != nil {
+			err = multierr.Append(err, cerr)
+		}
+	}()
+
+	return s.renderAPIResponse(resp, &JobCanaryPresenter{}, "Job canary")
+}
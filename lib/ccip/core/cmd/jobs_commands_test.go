@@ -458,3 +458,52 @@ func requireJobsCount(t *testing.T, orm job.ORM, expected int) {
 	require.NoError(t, err)
 	require.Len(t, jobs, expected)
 }
+
+func TestShell_PlanJob_MissingArgs(t *testing.T) {
+	t.Parallel()
+
+	app := startNewApplicationV2(t, nil)
+	client, _ := app.NewShellAndRenderer()
+
+	set := flag.NewFlagSet("test", 0)
+	flagSetApplyFromAction(client.PlanJob, set, "")
+	require.NoError(t, set.Parse([]string{"1"}))
+
+	c := cli.NewContext(nil, set, nil)
+
+	assert.EqualError(t, client.PlanJob(c), "must pass the job id and TOML or filepath")
+}
+
+func TestShell_ReplayJob_InvalidInputs(t *testing.T) {
+	t.Parallel()
+
+	app := startNewApplicationV2(t, nil)
+	client, _ := app.NewShellAndRenderer()
+
+	set := flag.NewFlagSet("test", 0)
+	flagSetApplyFromAction(client.ReplayJob, set, "")
+
+	require.NoError(t, set.Set("inputs", "not json"))
+	require.NoError(t, set.Parse([]string{"1", getDirectRequestSpec()}))
+
+	c := cli.NewContext(nil, set, nil)
+
+	assert.ErrorContains(t, client.ReplayJob(c), "invalid inputs")
+}
+
+func TestShell_CanaryJob_InvalidRuns(t *testing.T) {
+	t.Parallel()
+
+	app := startNewApplicationV2(t, nil)
+	client, _ := app.NewShellAndRenderer()
+
+	set := flag.NewFlagSet("test", 0)
+	flagSetApplyFromAction(client.CanaryJob, set, "")
+
+	require.NoError(t, set.Set("runs", "0"))
+	require.NoError(t, set.Parse([]string{"1", getDirectRequestSpec()}))
+
+	c := cli.NewContext(nil, set, nil)
+
+	assert.EqualError(t, client.CanaryJob(c), "runs must be positive")
+}
@@ -644,6 +644,40 @@ func TestShell_RunOCRJob_JobNotFound(t *testing.T) {
 	assert.Contains(t, err.Error(), "findJob failed: failed to load job")
 }
 
+func TestShell_DryRunJob_MissingJobID(t *testing.T) {
+	t.Parallel()
+
+	app := startNewApplicationV2(t, nil)
+	client, _ := app.NewShellAndRenderer()
+
+	set := flag.NewFlagSet("test", 0)
+	flagSetApplyFromAction(client.RemoteLogin, set, "")
+
+	require.NoError(t, set.Set("bypass-version-check", "true"))
+
+	c := cli.NewContext(nil, set, nil)
+
+	require.NoError(t, client.RemoteLogin(c))
+	assert.EqualError(t, client.DryRunPipelineRun(c), "must pass the job id, or a TOML or filepath, to dry run")
+}
+
+func TestShell_DryRunJob_InvalidStubs(t *testing.T) {
+	t.Parallel()
+
+	app := startNewApplicationV2(t, nil)
+	client, _ := app.NewShellAndRenderer()
+
+	set := flag.NewFlagSet("test", 0)
+	flagSetApplyFromAction(client.DryRunPipelineRun, set, "")
+
+	require.NoError(t, set.Set("stubs", "not json"))
+	require.NoError(t, set.Parse([]string{"1"}))
+
+	c := cli.NewContext(nil, set, nil)
+
+	assert.ErrorContains(t, client.DryRunPipelineRun(c), "invalid stubs")
+}
+
 func TestShell_AutoLogin(t *testing.T) {
 	t.Parallel()
 	ctx := testutils.Context(t)
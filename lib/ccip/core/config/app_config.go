@@ -41,6 +41,8 @@ type AppConfig interface {
 	Feature() Feature
 	FluxMonitor() FluxMonitor
 	Insecure() Insecure
+	JobDistributor() JobDistributor
+	HeadReport() HeadReport
 	JobPipeline() JobPipeline
 	Keeper() Keeper
 	Log() Log
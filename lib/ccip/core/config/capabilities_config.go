@@ -26,9 +26,18 @@ type ConnectorGateway interface {
 	URL() string
 }
 
+type RemoteTarget interface {
+	CapabilityID() string
+	EncryptRequests() bool
+	RequireEncryptedRequests() bool
+	AllowedWorkflowOwners() []string
+	AllowedCallerDONs() []uint32
+}
+
 type Capabilities interface {
 	Peering() P2P
 	Dispatcher() Dispatcher
 	ExternalRegistry() CapabilitiesExternalRegistry
 	GatewayConnector() GatewayConnector
+	RemoteTargets() []RemoteTarget
 }
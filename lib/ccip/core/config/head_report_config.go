@@ -0,0 +1,14 @@
+package config
+
+import "net/url"
+
+type HeadReport interface {
+	EventSinks() []HeadEventSink
+}
+
+type HeadEventSink interface {
+	Name() string
+	Type() string
+	URL() *url.URL
+	Topic() string
+}
@@ -0,0 +1,13 @@
+package config
+
+type JobDistributor interface {
+	AutoApprovalPolicies() []AutoApprovalPolicy
+}
+
+type AutoApprovalPolicy interface {
+	Name() string
+	ManagerPublicKey() string
+	JobType() string
+	PluginType() string
+	AllowedFields() []string
+}
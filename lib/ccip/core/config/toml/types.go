@@ -1,6 +1,8 @@
 package toml
 
 import (
+	"crypto/ed25519"
+	"encoding/hex"
 	"errors"
 	"fmt"
 	"net"
@@ -60,6 +62,8 @@ type Core struct {
 	Mercury          Mercury          `toml:",omitempty"`
 	Capabilities     Capabilities     `toml:",omitempty"`
 	Telemetry        Telemetry        `toml:",omitempty"`
+	JobDistributor   JobDistributor   `toml:",omitempty"`
+	HeadReport       HeadReport       `toml:",omitempty"`
 }
 
 // SetFrom updates c with any non-nil values from f. (currently TOML field only!)
@@ -97,6 +101,8 @@ func (c *Core) SetFrom(f *Core) {
 	c.Insecure.setFrom(&f.Insecure)
 	c.Tracing.setFrom(&f.Tracing)
 	c.Telemetry.setFrom(&f.Telemetry)
+	c.JobDistributor.setFrom(&f.JobDistributor)
+	c.HeadReport.setFrom(&f.HeadReport)
 }
 
 func (c *Core) ValidateConfig() (err error) {
@@ -1538,6 +1544,7 @@ type Capabilities struct {
 	Dispatcher       Dispatcher       `toml:",omitempty"`
 	ExternalRegistry ExternalRegistry `toml:",omitempty"`
 	GatewayConnector GatewayConnector `toml:",omitempty"`
+	RemoteTargets    []RemoteTarget   `toml:",omitempty"`
 }
 
 func (c *Capabilities) setFrom(f *Capabilities) {
@@ -1545,6 +1552,157 @@ func (c *Capabilities) setFrom(f *Capabilities) {
 	c.ExternalRegistry.setFrom(&f.ExternalRegistry)
 	c.Dispatcher.setFrom(&f.Dispatcher)
 	c.GatewayConnector.setFrom(&f.GatewayConnector)
+	if f.RemoteTargets != nil {
+		c.RemoteTargets = f.RemoteTargets
+	}
+}
+
+func (c *Capabilities) ValidateConfig() (err error) {
+	ids := map[string]struct{}{}
+	for i, t := range c.RemoteTargets {
+		name := fmt.Sprintf("RemoteTargets[%d]", i)
+		if t.CapabilityID == nil || *t.CapabilityID == "" {
+			err = multierr.Append(err, configutils.ErrMissing{Name: name + ".CapabilityID", Msg: "must be set"})
+		} else if _, ok := ids[*t.CapabilityID]; ok {
+			err = multierr.Append(err, configutils.ErrInvalid{Name: name + ".CapabilityID", Value: *t.CapabilityID, Msg: "duplicate capability id"})
+		} else {
+			ids[*t.CapabilityID] = struct{}{}
+		}
+		if t.AllowedWorkflowOwners != nil {
+			for _, owner := range *t.AllowedWorkflowOwners {
+				if b, herr := hex.DecodeString(strings.TrimPrefix(owner, "0x")); herr != nil || len(b) == 0 {
+					err = multierr.Append(err, configutils.ErrInvalid{Name: name + ".AllowedWorkflowOwners", Value: owner, Msg: "must be a hex encoded address"})
+				}
+			}
+		}
+	}
+	return err
+}
+
+// RemoteTarget is the node local configuration of a remote target capability, on both the workflow DON calling it and
+// the capability DON serving it.
+type RemoteTarget struct {
+	CapabilityID *string
+	// EncryptRequests encrypts the request payloads sent by the workflow DON to the keys of the capability DON nodes.
+	EncryptRequests *bool
+	// RequireEncryptedRequests rejects the requests received by the capability DON with a plaintext payload.
+	RequireEncryptedRequests *bool
+	// AllowedWorkflowOwners are the workflow owners allowed to call the capability. All owners are allowed if unset.
+	AllowedWorkflowOwners *[]string
+	// AllowedCallerDONs are the IDs of the workflow DONs allowed to call the capability. All DONs are allowed if unset.
+	AllowedCallerDONs *[]uint32
+}
+
+type JobDistributor struct {
+	AutoApprovalPolicies []AutoApprovalPolicy `toml:",omitempty"`
+}
+
+// AutoApprovalPolicy approves the job proposal specs of a feeds manager which only change the allowed fields of the
+// approved spec.
+type AutoApprovalPolicy struct {
+	Name *string
+	// ManagerPublicKey is the hex encoded CSA public key of the feeds manager
+	ManagerPublicKey *string
+	JobType          *string
+	// PluginType restricts offchainreporting2 policies to a plugin type, e.g. ccip-commit
+	PluginType *string
+	// AllowedFields are the TOML paths of the spec fields the proposal may change, e.g. pluginConfig.sourceStartBlock
+	AllowedFields *[]string
+}
+
+func (j *JobDistributor) setFrom(f *JobDistributor) {
+	if v := f.AutoApprovalPolicies; v != nil {
+		j.AutoApprovalPolicies = v
+	}
+}
+
+func (j *JobDistributor) ValidateConfig() (err error) {
+	names := map[string]struct{}{}
+	for i, p := range j.AutoApprovalPolicies {
+		name := fmt.Sprintf("AutoApprovalPolicies[%d]", i)
+		if p.Name == nil || *p.Name == "" {
+			err = multierr.Append(err, configutils.ErrMissing{Name: name + ".Name", Msg: "must be set"})
+		} else if _, ok := names[*p.Name]; ok {
+			err = multierr.Append(err, configutils.ErrInvalid{Name: name + ".Name", Value: *p.Name, Msg: "duplicate policy name"})
+		} else {
+			names[*p.Name] = struct{}{}
+		}
+		if p.ManagerPublicKey == nil {
+			err = multierr.Append(err, configutils.ErrMissing{Name: name + ".ManagerPublicKey", Msg: "must be set"})
+		} else if b, herr := hex.DecodeString(*p.ManagerPublicKey); herr != nil || len(b) != ed25519.PublicKeySize {
+			err = multierr.Append(err, configutils.ErrInvalid{Name: name + ".ManagerPublicKey", Value: *p.ManagerPublicKey, Msg: "must be a hex encoded ed25519 public key"})
+		}
+		if p.JobType == nil || *p.JobType == "" {
+			err = multierr.Append(err, configutils.ErrMissing{Name: name + ".JobType", Msg: "must be set"})
+		}
+		if p.PluginType != nil && (p.JobType == nil || *p.JobType != "offchainreporting2") {
+			err = multierr.Append(err, configutils.ErrInvalid{Name: name + ".PluginType", Value: *p.PluginType, Msg: "only offchainreporting2 jobs have a plugin type"})
+		}
+		if p.AllowedFields == nil || len(*p.AllowedFields) == 0 {
+			err = multierr.Append(err, configutils.ErrMissing{Name: name + ".AllowedFields", Msg: "must list at least one field"})
+		}
+	}
+	return err
+}
+
+type HeadReport struct {
+	EventSinks []HeadEventSink `toml:",omitempty"`
+}
+
+// HeadEventSink publishes the new heads, finalized heads, reorgs and finality lag of the EVM chains as events.
+type HeadEventSink struct {
+	Name *string
+	// Type is one of webhook, nats or kafka-rest-proxy
+	Type *string
+	// URL is the webhook endpoint, the nats:// or tls:// URL of the NATS server, or the Kafka REST proxy
+	URL *commonconfig.URL
+	// Topic is the NATS subject prefix or the Kafka topic, unused by webhooks
+	Topic *string
+}
+
+func (h *HeadReport) setFrom(f *HeadReport) {
+	if v := f.EventSinks; v != nil {
+		h.EventSinks = v
+	}
+}
+
+func (h *HeadReport) ValidateConfig() (err error) {
+	names := map[string]struct{}{}
+	for i, s := range h.EventSinks {
+		name := fmt.Sprintf("EventSinks[%d]", i)
+		if s.Name == nil || *s.Name == "" {
+			err = multierr.Append(err, configutils.ErrMissing{Name: name + ".Name", Msg: "must be set"})
+		} else if _, ok := names[*s.Name]; ok {
+			err = multierr.Append(err, configutils.ErrInvalid{Name: name + ".Name", Value: *s.Name, Msg: "duplicate sink name"})
+		} else {
+			names[*s.Name] = struct{}{}
+		}
+		if s.URL == nil || s.URL.IsZero() {
+			err = multierr.Append(err, configutils.ErrMissing{Name: name + ".URL", Msg: "must be set"})
+		}
+		if s.Type == nil {
+			err = multierr.Append(err, configutils.ErrMissing{Name: name + ".Type", Msg: "must be set"})
+			continue
+		}
+		switch *s.Type {
+		case "webhook":
+		case "nats", "kafka-rest-proxy":
+			if s.Topic == nil || *s.Topic == "" {
+				err = multierr.Append(err, configutils.ErrMissing{Name: name + ".Topic", Msg: fmt.Sprintf("must be set for %s sinks", *s.Type)})
+			}
+		default:
+			err = multierr.Append(err, configutils.ErrInvalid{Name: name + ".Type", Value: *s.Type, Msg: "must be one of webhook, nats or kafka-rest-proxy"})
+		}
+		if s.URL != nil && !s.URL.IsZero() {
+			scheme := s.URL.URL().Scheme
+			if *s.Type == "nats" && scheme != "nats" && scheme != "tls" {
+				err = multierr.Append(err, configutils.ErrInvalid{Name: name + ".URL", Value: s.URL.String(), Msg: "must be a nats:// or tls:// URL"})
+			} else if *s.Type != "nats" && scheme != "http" && scheme != "https" {
+				err = multierr.Append(err, configutils.ErrInvalid{Name: name + ".URL", Value: s.URL.String(), Msg: "must be an http:// or https:// URL"})
+			}
+		}
+	}
+	return err
 }
 
 type ThresholdKeyShareSecrets struct {
@@ -532,6 +532,65 @@ func TestTracing_ValidateMode(t *testing.T) {
 	}
 }
 
+func TestHeadReport_ValidateConfig(t *testing.T) {
+	mustURL := func(s string) *commonconfig.URL {
+		u, err := commonconfig.ParseURL(s)
+		assert.NoError(t, err)
+		return u
+	}
+	tests := []struct {
+		name   string
+		sink   HeadEventSink
+		errMsg string
+	}{
+		{
+			name: "valid webhook",
+			sink: HeadEventSink{Name: ptr("ui"), Type: ptr("webhook"), URL: mustURL("https://bridge.example.com/heads")},
+		},
+		{
+			name: "valid nats",
+			sink: HeadEventSink{Name: ptr("ui"), Type: ptr("nats"), URL: mustURL("nats://localhost:4222"), Topic: ptr("heads")},
+		},
+		{
+			name: "valid nats over TLS",
+			sink: HeadEventSink{Name: ptr("ui"), Type: ptr("nats"), URL: mustURL("tls://nats.example.com:4222"), Topic: ptr("heads")},
+		},
+		{
+			name:   "kafka REST proxy without topic",
+			sink:   HeadEventSink{Name: ptr("ui"), Type: ptr("kafka-rest-proxy"), URL: mustURL("http://kafka-rest:8082")},
+			errMsg: "EventSinks[0].Topic: missing: must be set for kafka-rest-proxy sinks",
+		},
+		{
+			name:   "nats with http URL",
+			sink:   HeadEventSink{Name: ptr("ui"), Type: ptr("nats"), URL: mustURL("http://localhost:4222"), Topic: ptr("heads")},
+			errMsg: "EventSinks[0].URL: invalid value (http://localhost:4222): must be a nats:// or tls:// URL",
+		},
+		{
+			name:   "unknown type",
+			sink:   HeadEventSink{Name: ptr("ui"), Type: ptr("mqtt"), URL: mustURL("http://localhost")},
+			errMsg: "EventSinks[0].Type: invalid value (mqtt): must be one of webhook, nats or kafka-rest-proxy",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			headReport := &HeadReport{EventSinks: []HeadEventSink{tt.sink}}
+			err := headReport.ValidateConfig()
+			if tt.errMsg != "" {
+				assert.EqualError(t, err, tt.errMsg)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+
+	t.Run("duplicate names", func(t *testing.T) {
+		sink := HeadEventSink{Name: ptr("ui"), Type: ptr("webhook"), URL: mustURL("http://localhost")}
+		headReport := &HeadReport{EventSinks: []HeadEventSink{sink, sink}}
+		assert.EqualError(t, headReport.ValidateConfig(), "EventSinks[1].Name: invalid value (ui): duplicate sink name")
+	})
+}
+
 func TestMercuryTLS_ValidateTLSCertPath(t *testing.T) {
 	tests := []struct {
 		name        string
@@ -405,6 +405,7 @@ func NewLegacyChainsWithMockChainAndTxManager(t testing.TB, ethClient evmclient.
 	ch.On("ID").Return(scopedCfg.EVM().ChainID())
 	ch.On("Config").Return(scopedCfg)
 	ch.On("TxManager").Return(txm)
+	ch.On("SmartAccounts").Return(nil)
 
 	return NewLegacyChainsWithChain(ch, cfg)
 }
@@ -37,6 +37,8 @@ import (
 
 	sqlutil "github.com/smartcontractkit/chainlink-common/pkg/sqlutil"
 
+	store "github.com/smartcontractkit/chainlink/v2/core/services/workflows/store"
+
 	txmgr "github.com/smartcontractkit/chainlink/v2/core/chains/evm/txmgr"
 
 	types "github.com/smartcontractkit/chainlink/v2/core/chains/evm/types"
@@ -249,6 +251,66 @@ func (_c *Application_BridgeORM_Call) RunAndReturn(run func() bridges.ORM) *Appl
 	return _c
 }
 
+// CanaryJobV2 provides a mock function with given fields: ctx, jobID, proposed, runs, promote
+func (_m *Application) CanaryJobV2(ctx context.Context, jobID int32, proposed job.Job, runs int, promote bool) (job.CanaryStatus, error) {
+	ret := _m.Called(ctx, jobID, proposed, runs, promote)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CanaryJobV2")
+	}
+
+	var r0 job.CanaryStatus
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int32, job.Job, int, bool) (job.CanaryStatus, error)); ok {
+		return rf(ctx, jobID, proposed, runs, promote)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int32, job.Job, int, bool) job.CanaryStatus); ok {
+		r0 = rf(ctx, jobID, proposed, runs, promote)
+	} else {
+		r0 = ret.Get(0).(job.CanaryStatus)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int32, job.Job, int, bool) error); ok {
+		r1 = rf(ctx, jobID, proposed, runs, promote)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Application_CanaryJobV2_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CanaryJobV2'
+type Application_CanaryJobV2_Call struct {
+	*mock.Call
+}
+
+// CanaryJobV2 is a helper method to define mock.On call
+//   - ctx context.Context
+//   - jobID int32
+//   - proposed job.Job
+//   - runs int
+//   - promote bool
+func (_e *Application_Expecter) CanaryJobV2(ctx interface{}, jobID interface{}, proposed interface{}, runs interface{}, promote interface{}) *Application_CanaryJobV2_Call {
+	return &Application_CanaryJobV2_Call{Call: _e.mock.On("CanaryJobV2", ctx, jobID, proposed, runs, promote)}
+}
+
+func (_c *Application_CanaryJobV2_Call) Run(run func(ctx context.Context, jobID int32, proposed job.Job, runs int, promote bool)) *Application_CanaryJobV2_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int32), args[2].(job.Job), args[3].(int), args[4].(bool))
+	})
+	return _c
+}
+
+func (_c *Application_CanaryJobV2_Call) Return(_a0 job.CanaryStatus, _a1 error) *Application_CanaryJobV2_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *Application_CanaryJobV2_Call) RunAndReturn(run func(context.Context, int32, job.Job, int, bool) (job.CanaryStatus, error)) *Application_CanaryJobV2_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // DeleteJob provides a mock function with given fields: ctx, jobID
 func (_m *Application) DeleteJob(ctx context.Context, jobID int32) error {
 	ret := _m.Called(ctx, jobID)
@@ -344,6 +406,128 @@ func (_c *Application_DeleteLogPollerDataAfter_Call) RunAndReturn(run func(conte
 	return _c
 }
 
+// DryRunJobSpecV2 provides a mock function with given fields: ctx, jb, vars, stubs
+func (_m *Application) DryRunJobSpecV2(ctx context.Context, jb job.Job, vars map[string]interface{}, stubs pipeline.DryRunStubs) (*pipeline.Run, error) {
+	ret := _m.Called(ctx, jb, vars, stubs)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DryRunJobSpecV2")
+	}
+
+	var r0 *pipeline.Run
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, job.Job, map[string]interface{}, pipeline.DryRunStubs) (*pipeline.Run, error)); ok {
+		return rf(ctx, jb, vars, stubs)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, job.Job, map[string]interface{}, pipeline.DryRunStubs) *pipeline.Run); ok {
+		r0 = rf(ctx, jb, vars, stubs)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*pipeline.Run)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, job.Job, map[string]interface{}, pipeline.DryRunStubs) error); ok {
+		r1 = rf(ctx, jb, vars, stubs)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Application_DryRunJobSpecV2_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DryRunJobSpecV2'
+type Application_DryRunJobSpecV2_Call struct {
+	*mock.Call
+}
+
+// DryRunJobSpecV2 is a helper method to define mock.On call
+//   - ctx context.Context
+//   - jb job.Job
+//   - vars map[string]interface{}
+//   - stubs pipeline.DryRunStubs
+func (_e *Application_Expecter) DryRunJobSpecV2(ctx interface{}, jb interface{}, vars interface{}, stubs interface{}) *Application_DryRunJobSpecV2_Call {
+	return &Application_DryRunJobSpecV2_Call{Call: _e.mock.On("DryRunJobSpecV2", ctx, jb, vars, stubs)}
+}
+
+func (_c *Application_DryRunJobSpecV2_Call) Run(run func(ctx context.Context, jb job.Job, vars map[string]interface{}, stubs pipeline.DryRunStubs)) *Application_DryRunJobSpecV2_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(job.Job), args[2].(map[string]interface{}), args[3].(pipeline.DryRunStubs))
+	})
+	return _c
+}
+
+func (_c *Application_DryRunJobSpecV2_Call) Return(_a0 *pipeline.Run, _a1 error) *Application_DryRunJobSpecV2_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *Application_DryRunJobSpecV2_Call) RunAndReturn(run func(context.Context, job.Job, map[string]interface{}, pipeline.DryRunStubs) (*pipeline.Run, error)) *Application_DryRunJobSpecV2_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DryRunJobV2 provides a mock function with given fields: ctx, jobID, vars, stubs
+func (_m *Application) DryRunJobV2(ctx context.Context, jobID int32, vars map[string]interface{}, stubs pipeline.DryRunStubs) (*pipeline.Run, error) {
+	ret := _m.Called(ctx, jobID, vars, stubs)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DryRunJobV2")
+	}
+
+	var r0 *pipeline.Run
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int32, map[string]interface{}, pipeline.DryRunStubs) (*pipeline.Run, error)); ok {
+		return rf(ctx, jobID, vars, stubs)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int32, map[string]interface{}, pipeline.DryRunStubs) *pipeline.Run); ok {
+		r0 = rf(ctx, jobID, vars, stubs)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*pipeline.Run)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int32, map[string]interface{}, pipeline.DryRunStubs) error); ok {
+		r1 = rf(ctx, jobID, vars, stubs)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Application_DryRunJobV2_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DryRunJobV2'
+type Application_DryRunJobV2_Call struct {
+	*mock.Call
+}
+
+// DryRunJobV2 is a helper method to define mock.On call
+//   - ctx context.Context
+//   - jobID int32
+//   - vars map[string]interface{}
+//   - stubs pipeline.DryRunStubs
+func (_e *Application_Expecter) DryRunJobV2(ctx interface{}, jobID interface{}, vars interface{}, stubs interface{}) *Application_DryRunJobV2_Call {
+	return &Application_DryRunJobV2_Call{Call: _e.mock.On("DryRunJobV2", ctx, jobID, vars, stubs)}
+}
+
+func (_c *Application_DryRunJobV2_Call) Run(run func(ctx context.Context, jobID int32, vars map[string]interface{}, stubs pipeline.DryRunStubs)) *Application_DryRunJobV2_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int32), args[2].(map[string]interface{}), args[3].(pipeline.DryRunStubs))
+	})
+	return _c
+}
+
+func (_c *Application_DryRunJobV2_Call) Return(_a0 *pipeline.Run, _a1 error) *Application_DryRunJobV2_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *Application_DryRunJobV2_Call) RunAndReturn(run func(context.Context, int32, map[string]interface{}, pipeline.DryRunStubs) (*pipeline.Run, error)) *Application_DryRunJobV2_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // EVMORM provides a mock function with given fields:
 func (_m *Application) EVMORM() types.Configs {
 	ret := _m.Called()
@@ -1059,6 +1243,62 @@ func (_c *Application_ID_Call) RunAndReturn(run func() uuid.UUID) *Application_I
 	return _c
 }
 
+// JobCanaryStatusV2 provides a mock function with given fields: jobID
+func (_m *Application) JobCanaryStatusV2(jobID int32) (job.CanaryStatus, bool) {
+	ret := _m.Called(jobID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for JobCanaryStatusV2")
+	}
+
+	var r0 job.CanaryStatus
+	var r1 bool
+	if rf, ok := ret.Get(0).(func(int32) (job.CanaryStatus, bool)); ok {
+		return rf(jobID)
+	}
+	if rf, ok := ret.Get(0).(func(int32) job.CanaryStatus); ok {
+		r0 = rf(jobID)
+	} else {
+		r0 = ret.Get(0).(job.CanaryStatus)
+	}
+
+	if rf, ok := ret.Get(1).(func(int32) bool); ok {
+		r1 = rf(jobID)
+	} else {
+		r1 = ret.Get(1).(bool)
+	}
+
+	return r0, r1
+}
+
+// Application_JobCanaryStatusV2_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'JobCanaryStatusV2'
+type Application_JobCanaryStatusV2_Call struct {
+	*mock.Call
+}
+
+// JobCanaryStatusV2 is a helper method to define mock.On call
+//   - jobID int32
+func (_e *Application_Expecter) JobCanaryStatusV2(jobID interface{}) *Application_JobCanaryStatusV2_Call {
+	return &Application_JobCanaryStatusV2_Call{Call: _e.mock.On("JobCanaryStatusV2", jobID)}
+}
+
+func (_c *Application_JobCanaryStatusV2_Call) Run(run func(jobID int32)) *Application_JobCanaryStatusV2_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(int32))
+	})
+	return _c
+}
+
+func (_c *Application_JobCanaryStatusV2_Call) Return(_a0 job.CanaryStatus, _a1 bool) *Application_JobCanaryStatusV2_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *Application_JobCanaryStatusV2_Call) RunAndReturn(run func(int32) (job.CanaryStatus, bool)) *Application_JobCanaryStatusV2_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // JobORM provides a mock function with given fields:
 func (_m *Application) JobORM() job.ORM {
 	ret := _m.Called()
@@ -1248,6 +1488,65 @@ func (_c *Application_ReplayFromBlock_Call) RunAndReturn(run func(*big.Int, uint
 	return _c
 }
 
+// ReplayJobV2 provides a mock function with given fields: ctx, jobID, proposed, inputs
+func (_m *Application) ReplayJobV2(ctx context.Context, jobID int32, proposed job.Job, inputs []pipeline.DryRunRequest) (job.ReplayReport, error) {
+	ret := _m.Called(ctx, jobID, proposed, inputs)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReplayJobV2")
+	}
+
+	var r0 job.ReplayReport
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int32, job.Job, []pipeline.DryRunRequest) (job.ReplayReport, error)); ok {
+		return rf(ctx, jobID, proposed, inputs)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int32, job.Job, []pipeline.DryRunRequest) job.ReplayReport); ok {
+		r0 = rf(ctx, jobID, proposed, inputs)
+	} else {
+		r0 = ret.Get(0).(job.ReplayReport)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int32, job.Job, []pipeline.DryRunRequest) error); ok {
+		r1 = rf(ctx, jobID, proposed, inputs)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Application_ReplayJobV2_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReplayJobV2'
+type Application_ReplayJobV2_Call struct {
+	*mock.Call
+}
+
+// ReplayJobV2 is a helper method to define mock.On call
+//   - ctx context.Context
+//   - jobID int32
+//   - proposed job.Job
+//   - inputs []pipeline.DryRunRequest
+func (_e *Application_Expecter) ReplayJobV2(ctx interface{}, jobID interface{}, proposed interface{}, inputs interface{}) *Application_ReplayJobV2_Call {
+	return &Application_ReplayJobV2_Call{Call: _e.mock.On("ReplayJobV2", ctx, jobID, proposed, inputs)}
+}
+
+func (_c *Application_ReplayJobV2_Call) Run(run func(ctx context.Context, jobID int32, proposed job.Job, inputs []pipeline.DryRunRequest)) *Application_ReplayJobV2_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int32), args[2].(job.Job), args[3].([]pipeline.DryRunRequest))
+	})
+	return _c
+}
+
+func (_c *Application_ReplayJobV2_Call) Return(_a0 job.ReplayReport, _a1 error) *Application_ReplayJobV2_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *Application_ReplayJobV2_Call) RunAndReturn(run func(context.Context, int32, job.Job, []pipeline.DryRunRequest) (job.ReplayReport, error)) *Application_ReplayJobV2_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // ResumeJobV2 provides a mock function with given fields: ctx, taskID, result
 func (_m *Application) ResumeJobV2(ctx context.Context, taskID uuid.UUID, result pipeline.Result) error {
 	ret := _m.Called(ctx, taskID, result)
@@ -1676,6 +1975,53 @@ func (_c *Application_WakeSessionReaper_Call) RunAndReturn(run func()) *Applicat
 	return _c
 }
 
+// WorkflowORM provides a mock function with given fields:
+func (_m *Application) WorkflowORM() store.Store {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for WorkflowORM")
+	}
+
+	var r0 store.Store
+	if rf, ok := ret.Get(0).(func() store.Store); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(store.Store)
+		}
+	}
+
+	return r0
+}
+
+// Application_WorkflowORM_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'WorkflowORM'
+type Application_WorkflowORM_Call struct {
+	*mock.Call
+}
+
+// WorkflowORM is a helper method to define mock.On call
+func (_e *Application_Expecter) WorkflowORM() *Application_WorkflowORM_Call {
+	return &Application_WorkflowORM_Call{Call: _e.mock.On("WorkflowORM")}
+}
+
+func (_c *Application_WorkflowORM_Call) Run(run func()) *Application_WorkflowORM_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *Application_WorkflowORM_Call) Return(_a0 store.Store) *Application_WorkflowORM_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Application_WorkflowORM_Call) RunAndReturn(run func() store.Store) *Application_WorkflowORM_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // NewApplication creates a new instance of Application. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewApplication(t interface {
@@ -0,0 +1,481 @@
+package ccip
+
+import (
+	"bytes"
+	"cmp"
+	"context"
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"slices"
+	"strconv"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/pelletier/go-toml"
+
+	chainselectors "github.com/smartcontractkit/chain-selectors"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/services"
+	"github.com/smartcontractkit/chainlink-common/pkg/utils/hex"
+
+	evmclient "github.com/smartcontractkit/chainlink/v2/core/chains/evm/client"
+	"github.com/smartcontractkit/chainlink/v2/core/chains/legacyevm"
+	"github.com/smartcontractkit/chainlink/v2/core/gethwrappers/ccip/generated/commit_store"
+	"github.com/smartcontractkit/chainlink/v2/core/gethwrappers/ccip/generated/evm_2_evm_offramp"
+	"github.com/smartcontractkit/chainlink/v2/core/gethwrappers/ccip/generated/rmn_contract"
+	"github.com/smartcontractkit/chainlink/v2/core/gethwrappers/ccip/generated/router"
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	"github.com/smartcontractkit/chainlink/v2/core/services/gateway/api"
+	"github.com/smartcontractkit/chainlink/v2/core/services/gateway/connector"
+	gwccip "github.com/smartcontractkit/chainlink/v2/core/services/gateway/handlers/ccip"
+	"github.com/smartcontractkit/chainlink/v2/core/services/job"
+	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ccip/abihelpers"
+)
+
+const defaultMessageStatusLookbackBlocks = 10_000
+
+// executionStates are the names of the Internal.MessageExecutionState values of the OffRamp.
+var executionStates = []string{"UNTOUCHED", "IN_PROGRESS", "SUCCESS", "FAILURE"}
+
+// ConnectorHandlerConfig is the node level configuration of the CCIP gateway connector handler, from the standard
+// capabilities job spec. Chain selectors are strings, as they don't fit in TOML integers.
+//
+//	messageStatusLookbackBlocks = 10000
+//	enableSend = true
+//	[[routers]]
+//	chainSelector = "16015286601757825753"
+//	address = "0x..."
+type ConnectorHandlerConfig struct {
+	// Routers are the CCIP Routers of the chains served by the node. Lanes are discovered through them.
+	Routers []RouterConfig `toml:"routers"`
+	// MessageStatusLookbackBlocks bounds the destination chain blocks searched for the execution of a message.
+	MessageStatusLookbackBlocks uint64 `toml:"messageStatusLookbackBlocks"`
+	// EnableSend enables relaying signed ccipSend transactions to the source chain.
+	EnableSend bool `toml:"enableSend"`
+}
+
+type RouterConfig struct {
+	ChainSelector string `toml:"chainSelector"`
+	Address       string `toml:"address"`
+}
+
+// ChainClients returns the EVM clients of the chains served by the handler.
+type ChainClients interface {
+	Client(chainSelector uint64) (evmclient.Client, error)
+}
+
+type legacyChainClients struct {
+	chains legacyevm.LegacyChainContainer
+}
+
+// NewLegacyChainClients returns the clients of the EVM chains of the node.
+func NewLegacyChainClients(chains legacyevm.LegacyChainContainer) ChainClients {
+	return &legacyChainClients{chains: chains}
+}
+
+func (c *legacyChainClients) Client(chainSelector uint64) (evmclient.Client, error) {
+	chainID, err := chainselectors.ChainIdFromSelector(chainSelector)
+	if err != nil {
+		return nil, err
+	}
+	chain, err := c.chains.Get(strconv.FormatUint(chainID, 10))
+	if err != nil {
+		return nil, err
+	}
+	return chain.Client(), nil
+}
+
+type connectorHandler struct {
+	services.StateMachine
+
+	connector                   connector.GatewayConnector
+	clients                     ChainClients
+	routers                     map[uint64]common.Address
+	messageStatusLookbackBlocks uint64
+	enableSend                  bool
+	lggr                        logger.Logger
+}
+
+var _ connector.GatewayConnectorHandler = (*connectorHandler)(nil)
+
+// NewConnectorHandler returns a service answering the requests of the ccip Gateway handler: fee quotes, lane status
+// and message status queries, and optionally the relay of signed ccipSend transactions.
+func NewConnectorHandler(config string, clients ChainClients, connector connector.GatewayConnector, lggr logger.Logger) (job.ServiceCtx, error) {
+	if connector == nil {
+		return nil, errors.New("missing connector")
+	}
+	var cfg ConnectorHandlerConfig
+	if err := toml.Unmarshal([]byte(config), &cfg); err != nil {
+		return nil, fmt.Errorf("failed to parse config: %w", err)
+	}
+	if len(cfg.Routers) == 0 {
+		return nil, errors.New("at least one router is required")
+	}
+	routers := make(map[uint64]common.Address, len(cfg.Routers))
+	for _, r := range cfg.Routers {
+		selector, err := strconv.ParseUint(r.ChainSelector, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid router chain selector %q: %w", r.ChainSelector, err)
+		}
+		if !common.IsHexAddress(r.Address) {
+			return nil, fmt.Errorf("invalid router address %q", r.Address)
+		}
+		if _, ok := routers[selector]; ok {
+			return nil, fmt.Errorf("duplicate router for chain selector %d", selector)
+		}
+		routers[selector] = common.HexToAddress(r.Address)
+	}
+	if cfg.MessageStatusLookbackBlocks == 0 {
+		cfg.MessageStatusLookbackBlocks = defaultMessageStatusLookbackBlocks
+	}
+
+	return &connectorHandler{
+		connector:                   connector,
+		clients:                     clients,
+		routers:                     routers,
+		messageStatusLookbackBlocks: cfg.MessageStatusLookbackBlocks,
+		enableSend:                  cfg.EnableSend,
+		lggr:                        lggr.Named("CCIPConnectorHandler"),
+	}, nil
+}
+
+func (h *connectorHandler) Start(ctx context.Context) error {
+	return h.StartOnce("CCIPConnectorHandler", func() error {
+		methods := []string{gwccip.MethodGetFee, gwccip.MethodLaneStatus, gwccip.MethodMessageStatus}
+		if h.enableSend {
+			methods = append(methods, gwccip.MethodSend)
+		}
+		return h.connector.AddHandler(methods, h)
+	})
+}
+
+func (h *connectorHandler) Close() error {
+	return h.StopOnce("CCIPConnectorHandler", func() error { return nil })
+}
+
+func (h *connectorHandler) Name() string { return h.lggr.Name() }
+
+func (h *connectorHandler) HealthReport() map[string]error {
+	return map[string]error{h.Name(): h.Healthy()}
+}
+
+func (h *connectorHandler) HandleGatewayMessage(ctx context.Context, gatewayID string, msg *api.Message) {
+	body := &msg.Body
+	h.lggr.Debugw("handling gateway request", "id", gatewayID, "method", body.Method, "messageId", body.MessageId)
+
+	var (
+		result any
+		err    error
+	)
+	switch body.Method {
+	case gwccip.MethodGetFee:
+		var req gwccip.GetFeeRequest
+		if err = json.Unmarshal(body.Payload, &req); err == nil {
+			result, err = h.getFee(ctx, req)
+		}
+	case gwccip.MethodLaneStatus:
+		var req gwccip.LaneStatusRequest
+		if err = json.Unmarshal(body.Payload, &req); err == nil {
+			result, err = h.laneStatus(ctx, req)
+		}
+	case gwccip.MethodMessageStatus:
+		var req gwccip.MessageStatusRequest
+		if err = json.Unmarshal(body.Payload, &req); err == nil {
+			result, err = h.messageStatus(ctx, req)
+		}
+	case gwccip.MethodSend:
+		if !h.enableSend {
+			err = gwccip.ErrUnsupportedMethod
+			break
+		}
+		var req gwccip.SendRequest
+		if err = json.Unmarshal(body.Payload, &req); err == nil {
+			result, err = h.send(ctx, req)
+		}
+	default:
+		err = gwccip.ErrUnsupportedMethod
+	}
+
+	response := gwccip.NodeResponse{Success: err == nil}
+	if err != nil {
+		h.lggr.Debugw("failed to handle gateway request", "id", gatewayID, "method", body.Method, "messageId", body.MessageId, "err", err)
+		response.ErrorMessage = err.Error()
+	} else if response.Result, err = json.Marshal(result); err != nil {
+		h.lggr.Errorw("failed to marshal result", "id", gatewayID, "method", body.Method, "err", err)
+		return
+	}
+	h.sendResponse(ctx, gatewayID, body, response)
+}
+
+func (h *connectorHandler) sendResponse(ctx context.Context, gatewayID string, requestBody *api.MessageBody, response gwccip.NodeResponse) {
+	payload, err := json.Marshal(response)
+	if err != nil {
+		h.lggr.Errorw("failed to marshal response", "id", gatewayID, "err", err)
+		return
+	}
+	body := &api.MessageBody{
+		MessageId: requestBody.MessageId,
+		DonId:     requestBody.DonId,
+		Method:    requestBody.Method,
+		Receiver:  requestBody.Sender,
+		Payload:   payload,
+	}
+	if err = h.connector.SignAndSendToGateway(ctx, gatewayID, body); err != nil {
+		h.lggr.Errorw("failed to send response to gateway", "id", gatewayID, "err", err)
+	}
+}
+
+// chain returns the client and the Router of a chain served by the node.
+func (h *connectorHandler) chain(chainSelector uint64) (evmclient.Client, *router.Router, error) {
+	address, ok := h.routers[chainSelector]
+	if !ok {
+		return nil, nil, fmt.Errorf("chain selector %d is not served by this node", chainSelector)
+	}
+	client, err := h.clients.Client(chainSelector)
+	if err != nil {
+		return nil, nil, fmt.Errorf("no client for chain selector %d: %w", chainSelector, err)
+	}
+	r, err := router.NewRouter(address, client)
+	if err != nil {
+		return nil, nil, err
+	}
+	return client, r, nil
+}
+
+func (h *connectorHandler) getFee(ctx context.Context, req gwccip.GetFeeRequest) (*gwccip.GetFeeResult, error) {
+	_, sourceRouter, err := h.chain(req.SourceChainSelector)
+	if err != nil {
+		return nil, err
+	}
+	msg, err := evm2AnyMessage(req)
+	if err != nil {
+		return nil, err
+	}
+	fee, err := sourceRouter.GetFee(&bind.CallOpts{Context: ctx}, req.DestChainSelector, msg)
+	if err != nil {
+		return nil, fmt.Errorf("getFee failed: %w", err)
+	}
+	return &gwccip.GetFeeResult{Fee: fee.String()}, nil
+}
+
+func evm2AnyMessage(req gwccip.GetFeeRequest) (router.ClientEVM2AnyMessage, error) {
+	receiver, err := decodeOptionalHex(req.Receiver)
+	if err != nil || len(receiver) == 0 {
+		return router.ClientEVM2AnyMessage{}, fmt.Errorf("invalid receiver %q", req.Receiver)
+	}
+	if len(receiver) == common.AddressLength {
+		// EVM receivers are abi encoded
+		receiver = common.LeftPadBytes(receiver, 32)
+	}
+	data, err := decodeOptionalHex(req.Data)
+	if err != nil {
+		return router.ClientEVM2AnyMessage{}, fmt.Errorf("invalid data: %w", err)
+	}
+	extraArgs, err := decodeOptionalHex(req.ExtraArgs)
+	if err != nil {
+		return router.ClientEVM2AnyMessage{}, fmt.Errorf("invalid extra_args: %w", err)
+	}
+	var feeToken common.Address
+	if req.FeeToken != "" {
+		if !common.IsHexAddress(req.FeeToken) {
+			return router.ClientEVM2AnyMessage{}, fmt.Errorf("invalid fee_token %q", req.FeeToken)
+		}
+		feeToken = common.HexToAddress(req.FeeToken)
+	}
+	tokenAmounts := make([]router.ClientEVMTokenAmount, len(req.TokenAmounts))
+	for i, ta := range req.TokenAmounts {
+		amount, ok := new(big.Int).SetString(ta.Amount, 10)
+		if !common.IsHexAddress(ta.Token) || !ok || amount.Sign() <= 0 {
+			return router.ClientEVM2AnyMessage{}, fmt.Errorf("invalid token amount %d", i)
+		}
+		tokenAmounts[i] = router.ClientEVMTokenAmount{Token: common.HexToAddress(ta.Token), Amount: amount}
+	}
+	return router.ClientEVM2AnyMessage{
+		Receiver:     receiver,
+		Data:         data,
+		TokenAmounts: tokenAmounts,
+		FeeToken:     feeToken,
+		ExtraArgs:    extraArgs,
+	}, nil
+}
+
+func decodeOptionalHex(s string) ([]byte, error) {
+	if s == "" || s == "0x" {
+		return []byte{}, nil
+	}
+	return hex.DecodeString(s)
+}
+
+func (h *connectorHandler) laneStatus(ctx context.Context, req gwccip.LaneStatusRequest) (*gwccip.LaneStatusResult, error) {
+	_, sourceRouter, err := h.chain(req.SourceChainSelector)
+	if err != nil {
+		return nil, err
+	}
+	destClient, destRouter, err := h.chain(req.DestChainSelector)
+	if err != nil {
+		return nil, err
+	}
+	opts := &bind.CallOpts{Context: ctx}
+
+	onRamp, err := sourceRouter.GetOnRamp(opts, req.DestChainSelector)
+	if err != nil {
+		return nil, fmt.Errorf("getOnRamp failed: %w", err)
+	}
+	offRamps, err := h.offRamps(ctx, destRouter, req.SourceChainSelector)
+	if err != nil {
+		return nil, err
+	}
+	if onRamp == (common.Address{}) || len(offRamps) == 0 {
+		return &gwccip.LaneStatusResult{}, nil
+	}
+
+	// the last OffRamp added to the Router is the active one
+	offRamp, err := evm_2_evm_offramp.NewEVM2EVMOffRamp(offRamps[len(offRamps)-1], destClient)
+	if err != nil {
+		return nil, err
+	}
+	staticConfig, err := offRamp.GetStaticConfig(opts)
+	if err != nil {
+		return nil, fmt.Errorf("offRamp getStaticConfig failed: %w", err)
+	}
+	rmn, err := rmn_contract.NewRMNContract(staticConfig.RmnProxy, destClient)
+	if err != nil {
+		return nil, err
+	}
+	cursed, err := rmn.IsCursed(opts, curseSubject(req.SourceChainSelector))
+	if err != nil {
+		return nil, fmt.Errorf("isCursed failed: %w", err)
+	}
+	commitStore, err := commit_store.NewCommitStore(staticConfig.CommitStore, destClient)
+	if err != nil {
+		return nil, err
+	}
+	next, err := commitStore.GetExpectedNextSequenceNumber(opts)
+	if err != nil {
+		return nil, fmt.Errorf("getExpectedNextSequenceNumber failed: %w", err)
+	}
+	return &gwccip.LaneStatusResult{Enabled: true, Cursed: cursed, NextSequenceNumber: next}, nil
+}
+
+// curseSubject returns the RMN curse subject of a chain, bytes16(uint128(chainSelector)).
+func curseSubject(chainSelector uint64) [16]byte {
+	var subject [16]byte
+	binary.BigEndian.PutUint64(subject[8:], chainSelector)
+	return subject
+}
+
+// offRamps returns the OffRamps of the source chain registered on the destination Router, in the Router order.
+func (h *connectorHandler) offRamps(ctx context.Context, destRouter *router.Router, sourceChainSelector uint64) ([]common.Address, error) {
+	all, err := destRouter.GetOffRamps(&bind.CallOpts{Context: ctx})
+	if err != nil {
+		return nil, fmt.Errorf("getOffRamps failed: %w", err)
+	}
+	var offRamps []common.Address
+	for _, o := range all {
+		if o.SourceChainSelector == sourceChainSelector {
+			offRamps = append(offRamps, o.OffRamp)
+		}
+	}
+	return offRamps, nil
+}
+
+func (h *connectorHandler) messageStatus(ctx context.Context, req gwccip.MessageStatusRequest) (*gwccip.MessageStatusResult, error) {
+	messageID, err := hex.DecodeString(req.MessageID)
+	if err != nil || len(messageID) != 32 {
+		return nil, fmt.Errorf("invalid message_id %q", req.MessageID)
+	}
+	destClient, destRouter, err := h.chain(req.DestChainSelector)
+	if err != nil {
+		return nil, err
+	}
+	offRamps, err := h.offRamps(ctx, destRouter, req.SourceChainSelector)
+	if err != nil {
+		return nil, err
+	}
+	if len(offRamps) == 0 {
+		return nil, fmt.Errorf("no offRamp for source chain selector %d", req.SourceChainSelector)
+	}
+
+	latest, err := destClient.LatestBlockHeight(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get latest block: %w", err)
+	}
+	fromBlock := new(big.Int).Sub(latest, new(big.Int).SetUint64(h.messageStatusLookbackBlocks))
+	if fromBlock.Sign() < 0 {
+		fromBlock.SetInt64(0)
+	}
+	logs, err := destClient.FilterLogs(ctx, ethereum.FilterQuery{
+		FromBlock: fromBlock,
+		ToBlock:   latest,
+		Addresses: offRamps,
+		Topics:    [][]common.Hash{{evm_2_evm_offramp.EVM2EVMOffRampExecutionStateChanged{}.Topic()}, nil, {common.BytesToHash(messageID)}},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to filter logs: %w", err)
+	}
+	if len(logs) == 0 {
+		return &gwccip.MessageStatusResult{State: executionStates[0]}, nil
+	}
+
+	last := slices.MaxFunc(logs, func(a, b types.Log) int {
+		if c := cmp.Compare(a.BlockNumber, b.BlockNumber); c != 0 {
+			return c
+		}
+		return cmp.Compare(a.Index, b.Index)
+	})
+	offRamp, err := evm_2_evm_offramp.NewEVM2EVMOffRamp(last.Address, destClient)
+	if err != nil {
+		return nil, err
+	}
+	event, err := offRamp.ParseExecutionStateChanged(last)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse ExecutionStateChanged: %w", err)
+	}
+	if int(event.State) >= len(executionStates) {
+		return nil, fmt.Errorf("unknown execution state %d", event.State)
+	}
+	return &gwccip.MessageStatusResult{State: executionStates[event.State]}, nil
+}
+
+func (h *connectorHandler) send(ctx context.Context, req gwccip.SendRequest) (*gwccip.SendResult, error) {
+	raw, err := hex.DecodeString(req.SignedTx)
+	if err != nil {
+		return nil, fmt.Errorf("invalid signed_tx: %w", err)
+	}
+	tx := new(types.Transaction)
+	if err = tx.UnmarshalBinary(raw); err != nil {
+		return nil, fmt.Errorf("invalid signed_tx: %w", err)
+	}
+	client, _, err := h.chain(req.SourceChainSelector)
+	if err != nil {
+		return nil, err
+	}
+	// only ccipSend calls to the Router are relayed
+	if tx.To() == nil || *tx.To() != h.routers[req.SourceChainSelector] {
+		return nil, fmt.Errorf("transaction is not sent to the Router of chain selector %d", req.SourceChainSelector)
+	}
+	if !bytes.HasPrefix(tx.Data(), ccipSendSelector) {
+		return nil, errors.New("transaction does not call ccipSend")
+	}
+	if err = client.SendTransaction(ctx, tx); err != nil {
+		// every node of the DON relays the same transaction, it may already be pending or mined
+		sendErr := evmclient.NewSendError(err)
+		switch {
+		case sendErr.IsTransactionAlreadyInMempool(nil):
+		case sendErr.IsNonceTooLowError(nil):
+			if _, receiptErr := client.TransactionReceipt(ctx, tx.Hash()); receiptErr != nil {
+				return nil, fmt.Errorf("failed to send transaction: %w", err)
+			}
+		default:
+			return nil, fmt.Errorf("failed to send transaction: %w", err)
+		}
+	}
+	return &gwccip.SendResult{TxHash: tx.Hash().Hex()}, nil
+}
+
+var ccipSendSelector = abihelpers.MustParseABI(router.RouterMetaData.ABI).Methods["ccipSend"].ID
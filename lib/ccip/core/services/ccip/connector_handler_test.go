@@ -0,0 +1,268 @@
+package ccip_test
+
+import (
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/services/servicetest"
+
+	evmclient "github.com/smartcontractkit/chainlink/v2/core/chains/evm/client"
+	"github.com/smartcontractkit/chainlink/v2/core/gethwrappers/ccip/generated/router"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	"github.com/smartcontractkit/chainlink/v2/core/services/ccip"
+	"github.com/smartcontractkit/chainlink/v2/core/services/gateway/api"
+	gc "github.com/smartcontractkit/chainlink/v2/core/services/gateway/common"
+	"github.com/smartcontractkit/chainlink/v2/core/services/gateway/config"
+	"github.com/smartcontractkit/chainlink/v2/core/services/gateway/connector"
+	connectormocks "github.com/smartcontractkit/chainlink/v2/core/services/gateway/connector/mocks"
+	"github.com/smartcontractkit/chainlink/v2/core/services/gateway/handlers"
+	gwccip "github.com/smartcontractkit/chainlink/v2/core/services/gateway/handlers/ccip"
+	hc "github.com/smartcontractkit/chainlink/v2/core/services/gateway/handlers/common"
+	handlers_mocks "github.com/smartcontractkit/chainlink/v2/core/services/gateway/handlers/mocks"
+	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ccip/testhelpers"
+)
+
+const gatewayID = "gateway"
+
+type chainClients map[uint64]evmclient.Client
+
+func (c chainClients) Client(chainSelector uint64) (evmclient.Client, error) {
+	client, ok := c[chainSelector]
+	if !ok {
+		return nil, fmt.Errorf("unknown chain selector %d", chainSelector)
+	}
+	return client, nil
+}
+
+// ccipGateway is a Gateway ccip handler serving a DON of connector handlers, all of them reading the chains of the
+// CCIP contracts.
+type ccipGateway struct {
+	handler handlers.Handler
+	user    gc.TestNode
+	nextID  int
+}
+
+func newCCIPGateway(t *testing.T, c testhelpers.CCIPContracts, enableSend bool) *ccipGateway {
+	nodes := gc.NewTestNodes(t, 4)
+	donConfig := &config.DONConfig{DonId: "ccip", F: 1}
+	for i, n := range nodes {
+		donConfig.Members = append(donConfig.Members, config.NodeConfig{Name: fmt.Sprintf("node_%d", i), Address: n.Address})
+	}
+	clients := chainClients{
+		c.Source.ChainSelector: evmclient.NewSimulatedBackendClient(t, c.Source.Chain, testutils.SimulatedChainID),
+		c.Dest.ChainSelector:   evmclient.NewSimulatedBackendClient(t, c.Dest.Chain, testutils.SimulatedChainID),
+	}
+	handlerConfig := fmt.Sprintf(`
+enableSend = %t
+[[routers]]
+chainSelector = "%d"
+address = "%s"
+[[routers]]
+chainSelector = "%d"
+address = "%s"
+`, enableSend, c.Source.ChainSelector, c.Source.Router.Address(), c.Dest.ChainSelector, c.Dest.Router.Address())
+
+	gw := &ccipGateway{user: gc.NewTestNodes(t, 1)[0]}
+	nodeHandlers := make(map[string]connector.GatewayConnectorHandler, len(nodes))
+	for _, node := range nodes {
+		nodeConnector := connectormocks.NewGatewayConnector(t)
+		nodeConnector.On("AddHandler", mock.Anything, mock.Anything).Return(nil)
+		// the node responses go straight to the Gateway handler
+		nodeConnector.On("SignAndSendToGateway", mock.Anything, gatewayID, mock.Anything).Run(func(args mock.Arguments) {
+			msg := &api.Message{Body: *args.Get(2).(*api.MessageBody)}
+			assert.NoError(t, msg.Sign(node.PrivateKey))
+			// responses received after the quorum are rejected, as the request is completed
+			_ = gw.handler.HandleNodeMessage(testutils.Context(t), msg, node.Address)
+		}).Return(nil).Maybe()
+		nodeHandler, err := ccip.NewConnectorHandler(handlerConfig, clients, nodeConnector, logger.TestLogger(t))
+		require.NoError(t, err)
+		servicetest.Run(t, nodeHandler)
+		nodeHandlers[node.Address] = nodeHandler.(connector.GatewayConnectorHandler)
+	}
+
+	don := handlers_mocks.NewDON(t)
+	don.On("SendToNode", mock.Anything, mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+		nodeHandlers[args.Get(1).(string)].HandleGatewayMessage(testutils.Context(t), gatewayID, args.Get(2).(*api.Message))
+	}).Return(nil).Maybe()
+	cache := hc.NewRequestCache[gwccip.PendingRequest](time.Minute, 100)
+	gw.handler = gwccip.NewCCIPHandler(gwccip.CCIPHandlerConfig{EnableSend: enableSend}, donConfig, don, cache, nil, nil, logger.TestLogger(t))
+	servicetest.Run(t, gw.handler)
+	return gw
+}
+
+// request sends a user request to the Gateway and returns its aggregated response.
+func (g *ccipGateway) request(t *testing.T, method string, payload any) gwccip.CombinedResponse {
+	payloadJSON, err := json.Marshal(payload)
+	require.NoError(t, err)
+	g.nextID++
+	msg := api.Message{Body: api.MessageBody{MessageId: fmt.Sprint(g.nextID), Method: method, DonId: "ccip", Payload: payloadJSON}}
+	require.NoError(t, msg.Sign(g.user.PrivateKey))
+
+	callbackCh := make(chan handlers.UserCallbackPayload, 1)
+	require.NoError(t, g.handler.HandleUserMessage(testutils.Context(t), &msg, callbackCh))
+	select {
+	case callback := <-callbackCh:
+		require.Equal(t, api.NoError, callback.ErrCode)
+		var response gwccip.CombinedResponse
+		require.NoError(t, json.Unmarshal(callback.Msg.Body.Payload, &response))
+		return response
+	case <-time.After(testutils.WaitTimeout(t)):
+		require.FailNow(t, "no response from the Gateway")
+		return gwccip.CombinedResponse{}
+	}
+}
+
+func requireResult[T any](t *testing.T, response gwccip.CombinedResponse) T {
+	require.True(t, response.Success, response.ErrorMessage)
+	var result T
+	require.NoError(t, json.Unmarshal(response.Result, &result))
+	return result
+}
+
+func TestConnectorHandler_GatewayMethods(t *testing.T) {
+	c := testhelpers.SetupCCIPContracts(t, testhelpers.SourceChainID, testhelpers.SourceChainSelector, testhelpers.DestChainID, testhelpers.DestChainSelector, 1, 1)
+	gw := newCCIPGateway(t, c, true)
+	source, dest := c.Source.ChainSelector, c.Dest.ChainSelector
+
+	extraArgs, err := testhelpers.GetEVMExtraArgsV1(big.NewInt(200_000), false)
+	require.NoError(t, err)
+	msg := router.ClientEVM2AnyMessage{
+		Receiver:     testhelpers.MustEncodeAddress(t, c.Dest.User.From),
+		Data:         []byte("hello"),
+		TokenAmounts: []router.ClientEVMTokenAmount{},
+		FeeToken:     c.Source.LinkToken.Address(),
+		ExtraArgs:    extraArgs,
+	}
+	expectedFee, err := c.Source.Router.GetFee(nil, dest, msg)
+	require.NoError(t, err)
+
+	t.Run("get fee", func(t *testing.T) {
+		fee := requireResult[gwccip.GetFeeResult](t, gw.request(t, gwccip.MethodGetFee, gwccip.GetFeeRequest{
+			SourceChainSelector: source,
+			DestChainSelector:   dest,
+			Receiver:            c.Dest.User.From.Hex(),
+			Data:                hexutil.Encode(msg.Data),
+			FeeToken:            msg.FeeToken.Hex(),
+			ExtraArgs:           hexutil.Encode(extraArgs),
+		}))
+		require.Equal(t, expectedFee.String(), fee.Fee)
+
+		response := gw.request(t, gwccip.MethodGetFee, gwccip.GetFeeRequest{SourceChainSelector: source, DestChainSelector: 1, Receiver: "0x01"})
+		require.False(t, response.Success)
+		require.Contains(t, response.ErrorMessage, "no quorum on the result")
+	})
+
+	t.Run("send and message status", func(t *testing.T) {
+		_, err := c.Source.LinkToken.Approve(c.Source.User, c.Source.Router.Address(), expectedFee)
+		require.NoError(t, err)
+		c.Source.Chain.Commit()
+
+		opts := *c.Source.User
+		opts.NoSend = true
+		tx, err := c.Source.Router.CcipSend(&opts, dest, msg)
+		require.NoError(t, err)
+		signedTx, err := tx.MarshalBinary()
+		require.NoError(t, err)
+
+		sent := requireResult[gwccip.SendResult](t, gw.request(t, gwccip.MethodSend, gwccip.SendRequest{
+			SourceChainSelector: source,
+			SignedTx:            hexutil.Encode(signedTx),
+		}))
+		require.Equal(t, tx.Hash().Hex(), sent.TxHash)
+		c.Source.Chain.Commit()
+		receipt, err := c.Source.Chain.TransactionReceipt(testutils.Context(t), tx.Hash())
+		require.NoError(t, err)
+		require.Equal(t, types.ReceiptStatusSuccessful, receipt.Status)
+
+		var messageID [32]byte
+		for _, log := range receipt.Logs {
+			if requested, parseErr := c.Source.OnRamp.ParseCCIPSendRequested(*log); parseErr == nil {
+				messageID = requested.Message.MessageId
+			}
+		}
+		require.NotEqual(t, [32]byte{}, messageID)
+		status := requireResult[gwccip.MessageStatusResult](t, gw.request(t, gwccip.MethodMessageStatus, gwccip.MessageStatusRequest{
+			SourceChainSelector: source,
+			DestChainSelector:   dest,
+			MessageID:           hexutil.Encode(messageID[:]),
+		}))
+		require.Equal(t, "UNTOUCHED", status.State)
+
+		// only ccipSend transactions to the Router are relayed
+		approve, err := c.Source.LinkToken.Approve(&opts, c.Source.Router.Address(), big.NewInt(1))
+		require.NoError(t, err)
+		approveTx, err := approve.MarshalBinary()
+		require.NoError(t, err)
+		response := gw.request(t, gwccip.MethodSend, gwccip.SendRequest{SourceChainSelector: source, SignedTx: hexutil.Encode(approveTx)})
+		require.False(t, response.Success)
+		require.Contains(t, response.ErrorMessage, "transaction is not sent to the Router")
+	})
+
+	t.Run("lane status", func(t *testing.T) {
+		expectedNext, err := c.Dest.CommitStore.GetExpectedNextSequenceNumber(nil)
+		require.NoError(t, err)
+		status := requireResult[gwccip.LaneStatusResult](t, gw.request(t, gwccip.MethodLaneStatus, gwccip.LaneStatusRequest{
+			SourceChainSelector: source,
+			DestChainSelector:   dest,
+		}))
+		require.Equal(t, gwccip.LaneStatusResult{Enabled: true, NextSequenceNumber: expectedNext}, status)
+
+		// lanes without ramps are disabled
+		status = requireResult[gwccip.LaneStatusResult](t, gw.request(t, gwccip.MethodLaneStatus, gwccip.LaneStatusRequest{
+			SourceChainSelector: dest,
+			DestChainSelector:   source,
+		}))
+		require.False(t, status.Enabled)
+
+		var subject [16]byte
+		binary.BigEndian.PutUint64(subject[8:], source)
+		_, err = c.Dest.ARM.VoteToCurse0(c.Dest.User, [32]byte{1}, subject)
+		require.NoError(t, err)
+		c.Dest.Chain.Commit()
+		status = requireResult[gwccip.LaneStatusResult](t, gw.request(t, gwccip.MethodLaneStatus, gwccip.LaneStatusRequest{
+			SourceChainSelector: source,
+			DestChainSelector:   dest,
+		}))
+		require.True(t, status.Cursed)
+	})
+}
+
+func TestConnectorHandler_Config(t *testing.T) {
+	clients := chainClients{}
+	nodeConnector := connectormocks.NewGatewayConnector(t)
+	router := common.HexToAddress("0x1").Hex()
+
+	for _, tt := range []struct {
+		name   string
+		config string
+		err    string
+	}{
+		{"no routers", ``, "at least one router is required"},
+		{"invalid selector", fmt.Sprintf("[[routers]]\nchainSelector = \"x\"\naddress = \"%s\"", router), "invalid router chain selector"},
+		{"invalid address", "[[routers]]\nchainSelector = \"1\"\naddress = \"0x1\"", "invalid router address"},
+		{"duplicate router", fmt.Sprintf("[[routers]]\nchainSelector = \"1\"\naddress = \"%s\"\n[[routers]]\nchainSelector = \"1\"\naddress = \"%s\"", router, router), "duplicate router"},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := ccip.NewConnectorHandler(tt.config, clients, nodeConnector, logger.TestLogger(t))
+			require.ErrorContains(t, err, tt.err)
+		})
+	}
+
+	// send is only served when enabled
+	nodeConnector.On("AddHandler", []string{gwccip.MethodGetFee, gwccip.MethodLaneStatus, gwccip.MethodMessageStatus}, mock.Anything).Return(nil).Once()
+	handler, err := ccip.NewConnectorHandler(fmt.Sprintf("[[routers]]\nchainSelector = \"1\"\naddress = \"%s\"", router), clients, nodeConnector, logger.TestLogger(t))
+	require.NoError(t, err)
+	servicetest.Run(t, handler)
+}
@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"context"
 	"fmt"
+	"maps"
 	"math/big"
 	"net/http"
 	"sync"
@@ -105,12 +106,24 @@ type Application interface {
 	BasicAdminUsersORM() sessions.BasicAdminUsersORM
 	AuthenticationProvider() sessions.AuthenticationProvider
 	TxmStorageService() txmgr.EvmTxStore
+	WorkflowORM() workflowstore.Store
 	AddJobV2(ctx context.Context, job *job.Job) error
 	DeleteJob(ctx context.Context, jobID int32) error
 	RunWebhookJobV2(ctx context.Context, jobUUID uuid.UUID, requestBody string, meta jsonserializable.JSONSerializable) (int64, error)
 	ResumeJobV2(ctx context.Context, taskID uuid.UUID, result pipeline.Result) error
 	// Testing only
 	RunJobV2(ctx context.Context, jobID int32, meta map[string]interface{}) (int64, error)
+	// DryRunJobV2 executes the job pipeline without side effects, the returned run is not persisted.
+	DryRunJobV2(ctx context.Context, jobID int32, vars map[string]interface{}, stubs pipeline.DryRunStubs) (*pipeline.Run, error)
+	// DryRunJobSpecV2 executes the pipeline of a validated job spec, which doesn't need to exist, without side effects.
+	DryRunJobSpecV2(ctx context.Context, jb job.Job, vars map[string]interface{}, stubs pipeline.DryRunStubs) (*pipeline.Run, error)
+	// ReplayJobV2 compares dry runs of the current and proposed specs of a job, with caller supplied inputs.
+	ReplayJobV2(ctx context.Context, jobID int32, proposed job.Job, inputs []pipeline.DryRunRequest) (job.ReplayReport, error)
+	// CanaryJobV2 starts shadowing the next live runs of a job with dry runs of the proposed spec, and compares their
+	// outputs. The proposed spec is promoted once all runs match, if requested.
+	CanaryJobV2(ctx context.Context, jobID int32, proposed job.Job, runs int, promote bool) (job.CanaryStatus, error)
+	// JobCanaryStatusV2 returns the status of the last canary of a job, and false if it has none.
+	JobCanaryStatusV2(jobID int32) (job.CanaryStatus, bool)
 
 	// Feeds
 	GetFeedsService() feeds.Service
@@ -139,10 +152,12 @@ type ChainlinkApplication struct {
 	jobSpawner               job.Spawner
 	pipelineORM              pipeline.ORM
 	pipelineRunner           pipeline.Runner
+	canaries                 *job.Canaries
 	bridgeORM                bridges.ORM
 	localAdminUsersORM       sessions.BasicAdminUsersORM
 	authenticationProvider   sessions.AuthenticationProvider
 	txmStorageService        txmgr.EvmTxStore
+	workflowORM              workflowstore.Store
 	FeedsService             feeds.Service
 	webhookJobRunner         webhook.JobRunner
 	Config                   GeneralConfig
@@ -212,9 +227,11 @@ func NewApplication(opts ApplicationOpts) (Application, error) {
 	var externalPeerWrapper p2ptypes.PeerWrapper
 	if cfg.Capabilities().Peering().Enabled() {
 		var dispatcher remotetypes.Dispatcher
+		var decrypter p2ptypes.Decrypter
 		if opts.CapabilitiesDispatcher == nil {
 			externalPeer := externalp2p.NewExternalPeerWrapper(keyStore.P2P(), cfg.Capabilities().Peering(), opts.DS, globalLogger)
 			signer := externalPeer
+			decrypter = externalPeer
 			externalPeerWrapper = externalPeer
 			remoteDispatcher, err := remote.NewDispatcher(cfg.Capabilities().Dispatcher(), externalPeerWrapper, signer, opts.CapabilitiesRegistry, globalLogger)
 			if err != nil {
@@ -256,8 +273,10 @@ func NewApplication(opts ApplicationOpts) (Application, error) {
 			wfLauncher := capabilities.NewLauncher(
 				globalLogger,
 				externalPeerWrapper,
+				decrypter,
 				dispatcher,
 				opts.CapabilitiesRegistry,
+				cfg.Capabilities().RemoteTargets(),
 			)
 			registrySyncer.AddLauncher(wfLauncher)
 
@@ -389,7 +408,19 @@ func NewApplication(opts ApplicationOpts) (Application, error) {
 		chainIDs[i] = chain.ID()
 	}
 	telemReporter := headreporter.NewTelemetryReporter(telemetryManager, globalLogger, chainIDs...)
-	headReporter := headreporter.NewHeadReporterService(opts.DS, globalLogger, promReporter, telemReporter)
+	headReporters := []headreporter.HeadReporter{promReporter, telemReporter}
+	if sinkCfgs := cfg.HeadReport().EventSinks(); len(sinkCfgs) > 0 {
+		sinks := make([]headreporter.EventSink, len(sinkCfgs))
+		for i, sinkCfg := range sinkCfgs {
+			sink, err := headreporter.NewEventSink(globalLogger, sinkCfg)
+			if err != nil {
+				return nil, errors.Wrapf(err, "NewApplication: failed to initialize head event sink %s", sinkCfg.Name())
+			}
+			sinks[i] = sink
+		}
+		headReporters = append(headReporters, headreporter.NewEventReporter(globalLogger, sinks...))
+	}
+	headReporter := headreporter.NewHeadReporterService(opts.DS, globalLogger, headReporters...)
 	srvcs = append(srvcs, headReporter)
 	for _, chain := range legacyEVMChains.Slice() {
 		chain.HeadBroadcaster().Subscribe(headReporter)
@@ -460,6 +491,7 @@ func NewApplication(opts ApplicationOpts) (Application, error) {
 				telemetryManager,
 				pipelineRunner,
 				opts.RelayerChainInteroperators,
+				legacyEVMChains,
 				gatewayConnectorWrapper),
 		}
 		webhookJobRunner = delegates[job.Webhook].(*webhook.Delegate).WebhookJobRunner()
@@ -560,7 +592,11 @@ func NewApplication(opts ApplicationOpts) (Application, error) {
 		lbs = append(lbs, c.LogBroadcaster())
 	}
 	jobSpawner := job.NewSpawner(jobORM, cfg.Database(), healthChecker, delegates, globalLogger, lbs)
-	srvcs = append(srvcs, jobSpawner, pipelineRunner)
+	var app *ChainlinkApplication
+	canaries := job.NewCanaries(globalLogger, pipelineRunner, func(ctx context.Context, proposed *job.Job) error {
+		return app.replaceJob(ctx, proposed)
+	})
+	srvcs = append(srvcs, jobSpawner, pipelineRunner, canaries)
 
 	// We start the log poller after the job spawner
 	// so jobs have a chance to apply their initial log filters.
@@ -585,6 +621,7 @@ func NewApplication(opts ApplicationOpts) (Application, error) {
 			cfg.JobPipeline(),
 			cfg.OCR(),
 			cfg.OCR2(),
+			cfg.JobDistributor(),
 			legacyEVMChains,
 			globalLogger,
 			opts.Version,
@@ -603,16 +640,18 @@ func NewApplication(opts ApplicationOpts) (Application, error) {
 		}
 	}
 
-	return &ChainlinkApplication{
+	app = &ChainlinkApplication{
 		relayers:                 opts.RelayerChainInteroperators,
 		jobORM:                   jobORM,
 		jobSpawner:               jobSpawner,
 		pipelineRunner:           pipelineRunner,
+		canaries:                 canaries,
 		pipelineORM:              pipelineORM,
 		bridgeORM:                bridgeORM,
 		localAdminUsersORM:       localAdminUsersORM,
 		authenticationProvider:   authenticationProvider,
 		txmStorageService:        txmORM,
+		workflowORM:              workflowORM,
 		FeedsService:             feedsService,
 		Config:                   cfg,
 		webhookJobRunner:         webhookJobRunner,
@@ -632,7 +671,8 @@ func NewApplication(opts ApplicationOpts) (Application, error) {
 
 		// NOTE: Can keep things clean by putting more things in srvcs instead of manually start/closing
 		srvcs: srvcs,
-	}, nil
+	}
+	return app, nil
 }
 
 func (app *ChainlinkApplication) SetLogLevel(lvl zapcore.Level) error {
@@ -811,6 +851,10 @@ func (app *ChainlinkApplication) TxmStorageService() txmgr.EvmTxStore {
 	return app.txmStorageService
 }
 
+func (app *ChainlinkApplication) WorkflowORM() workflowstore.Store {
+	return app.workflowORM
+}
+
 func (app *ChainlinkApplication) GetExternalInitiatorManager() webhook.ExternalInitiatorManager {
 	return app.ExternalInitiatorManager
 }
@@ -839,9 +883,18 @@ func (app *ChainlinkApplication) DeleteJob(ctx context.Context, jobID int32) err
 		return errors.New("job must be deleted in the feeds manager")
 	}
 
+	app.canaries.Cancel(jobID)
 	return app.jobSpawner.DeleteJob(ctx, nil, jobID)
 }
 
+// replaceJob replaces a job with the proposed spec, which keeps the ID of the job.
+func (app *ChainlinkApplication) replaceJob(ctx context.Context, proposed *job.Job) error {
+	if err := app.DeleteJob(ctx, proposed.ID); err != nil {
+		return errors.Wrapf(err, "deleting job ID %v", proposed.ID)
+	}
+	return app.AddJobV2(ctx, proposed)
+}
+
 func (app *ChainlinkApplication) RunWebhookJobV2(ctx context.Context, jobUUID uuid.UUID, requestBody string, meta jsonserializable.JSONSerializable) (int64, error) {
 	return app.webhookJobRunner.RunJob(ctx, jobUUID, requestBody, meta)
 }
@@ -911,6 +964,142 @@ func (app *ChainlinkApplication) RunJobV2(
 	return runID, err
 }
 
+// DryRunJobV2 executes the job pipeline in-memory, simulating transactions and replacing http and bridge
+// task responses with stubs. It is supported on secure builds, since it has no side effects.
+func (app *ChainlinkApplication) DryRunJobV2(
+	ctx context.Context,
+	jobID int32,
+	vars map[string]interface{},
+	stubs pipeline.DryRunStubs,
+) (*pipeline.Run, error) {
+	jb, err := app.jobORM.FindJob(ctx, jobID)
+	if err != nil {
+		return nil, errors.Wrapf(err, "job ID %v", jobID)
+	}
+	if jb.PipelineSpec == nil || jb.PipelineSpec.DotDagSource == "" {
+		return nil, errors.Errorf("job ID %v has no pipeline to run", jobID)
+	}
+	return app.dryRunJob(ctx, jb, *jb.PipelineSpec, vars, stubs)
+}
+
+// DryRunJobSpecV2 executes the pipeline of a job spec like DryRunJobV2, for example a TOML spec which hasn't been
+// created yet.
+func (app *ChainlinkApplication) DryRunJobSpecV2(
+	ctx context.Context,
+	jb job.Job,
+	vars map[string]interface{},
+	stubs pipeline.DryRunStubs,
+) (*pipeline.Run, error) {
+	if jb.Pipeline.Source == "" {
+		return nil, errors.Errorf("%s job spec has no pipeline to run", jb.Type)
+	}
+	return app.dryRunJob(ctx, jb, dryRunPipelineSpec(jb), vars, stubs)
+}
+
+// ReplayJobV2 dry runs the current and proposed specs of a job with the same inputs, and compares their outputs.
+// Each input is executed once against both specs, nothing is persisted. The live triggers of the job are not observed.
+func (app *ChainlinkApplication) ReplayJobV2(
+	ctx context.Context,
+	jobID int32,
+	proposed job.Job,
+	inputs []pipeline.DryRunRequest,
+) (job.ReplayReport, error) {
+	current, err := app.jobORM.FindJob(ctx, jobID)
+	if err != nil {
+		return job.ReplayReport{}, errors.Wrapf(err, "job ID %v", jobID)
+	}
+	if current.PipelineSpec == nil || current.PipelineSpec.DotDagSource == "" || proposed.Pipeline.Source == "" {
+		return job.ReplayReport{}, errors.Errorf("job ID %v has no pipeline to run", jobID)
+	}
+	if current.Type != proposed.Type {
+		return job.ReplayReport{}, errors.Errorf("job type can't be changed from %s to %s", current.Type, proposed.Type)
+	}
+
+	proposed.ID = current.ID
+	proposedSpec := dryRunPipelineSpec(proposed)
+
+	var report job.ReplayReport
+	for i, input := range inputs {
+		currentRun, err := app.dryRunJob(ctx, current, *current.PipelineSpec, maps.Clone(input.Vars), input.Stubs)
+		if err != nil {
+			return job.ReplayReport{}, errors.Wrapf(err, "input %d: current spec", i)
+		}
+		proposedRun, err := app.dryRunJob(ctx, proposed, proposedSpec, maps.Clone(input.Vars), input.Stubs)
+		if err != nil {
+			return job.ReplayReport{}, errors.Wrapf(err, "input %d: proposed spec", i)
+		}
+		result, err := job.NewReplayResult(currentRun, proposedRun)
+		if err != nil {
+			return job.ReplayReport{}, errors.Wrapf(err, "input %d", i)
+		}
+		report.Results = append(report.Results, result)
+	}
+	return report, nil
+}
+
+// CanaryJobV2 shadows the next runs live runs of a job with dry runs of the proposed spec. Each dry run gets the vars of
+// the live run, and the responses of its http and bridge tasks. The canary is refused at the first run whose outputs
+// don't match, and promotes the proposed spec once all runs match, if promote is set.
+func (app *ChainlinkApplication) CanaryJobV2(
+	ctx context.Context,
+	jobID int32,
+	proposed job.Job,
+	runs int,
+	promote bool,
+) (job.CanaryStatus, error) {
+	current, err := app.jobORM.FindJob(ctx, jobID)
+	if err != nil {
+		return job.CanaryStatus{}, errors.Wrapf(err, "job ID %v", jobID)
+	}
+	if current.PipelineSpec == nil || current.PipelineSpec.DotDagSource == "" || proposed.Pipeline.Source == "" {
+		return job.CanaryStatus{}, errors.Errorf("job ID %v has no pipeline to run", jobID)
+	}
+	if current.Type != proposed.Type {
+		return job.CanaryStatus{}, errors.Errorf("job type can't be changed from %s to %s", current.Type, proposed.Type)
+	}
+
+	proposed.ID = current.ID
+	return app.canaries.Run(proposed, dryRunPipelineSpec(proposed), runs, promote)
+}
+
+// JobCanaryStatusV2 returns the status of the last canary of a job.
+func (app *ChainlinkApplication) JobCanaryStatusV2(jobID int32) (job.CanaryStatus, bool) {
+	return app.canaries.Status(jobID)
+}
+
+// dryRunPipelineSpec returns the pipeline spec of a job which hasn't been saved.
+func dryRunPipelineSpec(jb job.Job) pipeline.Spec {
+	var gasLimit *uint32
+	if jb.GasLimit.Valid {
+		gasLimit = &jb.GasLimit.Uint32
+	}
+	return pipeline.Spec{
+		DotDagSource:      jb.Pipeline.Source,
+		MaxTaskDuration:   jb.MaxTaskDuration,
+		GasLimit:          gasLimit,
+		ForwardingAllowed: jb.ForwardingAllowed,
+		JobID:             jb.ID,
+		JobName:           jb.Name.ValueOrZero(),
+		JobType:           string(jb.Type),
+	}
+}
+
+func (app *ChainlinkApplication) dryRunJob(ctx context.Context, jb job.Job, spec pipeline.Spec, vars map[string]interface{}, stubs pipeline.DryRunStubs) (*pipeline.Run, error) {
+	if vars == nil {
+		vars = map[string]interface{}{}
+	}
+	if _, ok := vars["jobSpec"]; !ok {
+		vars["jobSpec"] = map[string]interface{}{
+			"databaseID":    jb.ID,
+			"externalJobID": jb.ExternalJobID,
+			"name":          jb.Name.ValueOrZero(),
+		}
+	}
+
+	run, _, err := app.pipelineRunner.ExecuteDryRun(ctx, spec, pipeline.NewVarsFrom(vars), stubs)
+	return run, err
+}
+
 func (app *ChainlinkApplication) ResumeJobV2(
 	ctx context.Context,
 	taskID uuid.UUID,
@@ -134,3 +134,41 @@ func (c *connectorGateway) ID() string {
 func (c *connectorGateway) URL() string {
 	return *c.c.URL
 }
+
+func (c *capabilitiesConfig) RemoteTargets() []config.RemoteTarget {
+	var targets []config.RemoteTarget
+	for _, t := range c.c.RemoteTargets {
+		targets = append(targets, &remoteTarget{c: t})
+	}
+	return targets
+}
+
+type remoteTarget struct {
+	c toml.RemoteTarget
+}
+
+func (r *remoteTarget) CapabilityID() string {
+	return *r.c.CapabilityID
+}
+
+func (r *remoteTarget) EncryptRequests() bool {
+	return r.c.EncryptRequests != nil && *r.c.EncryptRequests
+}
+
+func (r *remoteTarget) RequireEncryptedRequests() bool {
+	return r.c.RequireEncryptedRequests != nil && *r.c.RequireEncryptedRequests
+}
+
+func (r *remoteTarget) AllowedWorkflowOwners() []string {
+	if r.c.AllowedWorkflowOwners == nil {
+		return nil
+	}
+	return *r.c.AllowedWorkflowOwners
+}
+
+func (r *remoteTarget) AllowedCallerDONs() []uint32 {
+	if r.c.AllowedCallerDONs == nil {
+		return nil
+	}
+	return *r.c.AllowedCallerDONs
+}
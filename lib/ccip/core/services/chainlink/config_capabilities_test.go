@@ -43,4 +43,12 @@ func TestCapabilitiesConfig(t *testing.T) {
 	assert.Equal(t, time.Minute, v2.DeltaDial().Duration())
 	assert.Equal(t, 2*time.Second, v2.DeltaReconcile().Duration())
 	assert.Equal(t, []string{"foo", "bar"}, v2.ListenAddresses())
+
+	targets := cfg.Capabilities().RemoteTargets()
+	require.Len(t, targets, 1)
+	assert.Equal(t, "write_ethereum-testnet-sepolia@1.0.0", targets[0].CapabilityID())
+	assert.True(t, targets[0].EncryptRequests())
+	assert.True(t, targets[0].RequireEncryptedRequests())
+	assert.Equal(t, []string{"0x68902d681c28119f9b2531473a417088bf008e59"}, targets[0].AllowedWorkflowOwners())
+	assert.Equal(t, []uint32{1, 2}, targets[0].AllowedCallerDONs())
 }
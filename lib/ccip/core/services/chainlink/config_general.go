@@ -528,4 +528,12 @@ func (g *generalConfig) Telemetry() coreconfig.Telemetry {
 	return &telemetryConfig{s: g.c.Telemetry}
 }
 
+func (g *generalConfig) JobDistributor() coreconfig.JobDistributor {
+	return &jobDistributorConfig{c: g.c.JobDistributor}
+}
+
+func (g *generalConfig) HeadReport() coreconfig.HeadReport {
+	return &headReportConfig{c: g.c.HeadReport}
+}
+
 var zeroSha256Hash = models.Sha256Hash{}
@@ -0,0 +1,47 @@
+package chainlink
+
+import (
+	"net/url"
+
+	"github.com/smartcontractkit/chainlink/v2/core/config"
+	"github.com/smartcontractkit/chainlink/v2/core/config/toml"
+)
+
+var _ config.HeadReport = (*headReportConfig)(nil)
+
+type headReportConfig struct {
+	c toml.HeadReport
+}
+
+type headEventSinkConfig struct {
+	c toml.HeadEventSink
+}
+
+func (h *headReportConfig) EventSinks() []config.HeadEventSink {
+	var sinks []config.HeadEventSink
+	for _, s := range h.c.EventSinks {
+		sinks = append(sinks, &headEventSinkConfig{
+			c: s,
+		})
+	}
+	return sinks
+}
+
+func (h *headEventSinkConfig) Name() string {
+	return *h.c.Name
+}
+
+func (h *headEventSinkConfig) Type() string {
+	return *h.c.Type
+}
+
+func (h *headEventSinkConfig) URL() *url.URL {
+	return h.c.URL.URL()
+}
+
+func (h *headEventSinkConfig) Topic() string {
+	if h.c.Topic == nil {
+		return ""
+	}
+	return *h.c.Topic
+}
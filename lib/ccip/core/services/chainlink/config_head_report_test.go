@@ -0,0 +1,23 @@
+package chainlink
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHeadReportConfig(t *testing.T) {
+	opts := GeneralConfigOpts{
+		ConfigStrings: []string{fullTOML},
+	}
+	cfg, err := opts.New()
+	require.NoError(t, err)
+
+	sinks := cfg.HeadReport().EventSinks()
+	require.Len(t, sinks, 1)
+	assert.Equal(t, "bridge-ui", sinks[0].Name())
+	assert.Equal(t, "nats", sinks[0].Type())
+	assert.Equal(t, "nats://nats.example.com:4222", sinks[0].URL().String())
+	assert.Equal(t, "chainlink.heads", sinks[0].Topic())
+}
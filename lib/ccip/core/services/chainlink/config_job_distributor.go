@@ -0,0 +1,49 @@
+package chainlink
+
+import (
+	"github.com/smartcontractkit/chainlink/v2/core/config"
+	"github.com/smartcontractkit/chainlink/v2/core/config/toml"
+)
+
+var _ config.JobDistributor = (*jobDistributorConfig)(nil)
+
+type jobDistributorConfig struct {
+	c toml.JobDistributor
+}
+
+type autoApprovalPolicyConfig struct {
+	c toml.AutoApprovalPolicy
+}
+
+func (j *jobDistributorConfig) AutoApprovalPolicies() []config.AutoApprovalPolicy {
+	var policies []config.AutoApprovalPolicy
+	for _, p := range j.c.AutoApprovalPolicies {
+		policies = append(policies, &autoApprovalPolicyConfig{
+			c: p,
+		})
+	}
+	return policies
+}
+
+func (a *autoApprovalPolicyConfig) Name() string {
+	return *a.c.Name
+}
+
+func (a *autoApprovalPolicyConfig) ManagerPublicKey() string {
+	return *a.c.ManagerPublicKey
+}
+
+func (a *autoApprovalPolicyConfig) JobType() string {
+	return *a.c.JobType
+}
+
+func (a *autoApprovalPolicyConfig) PluginType() string {
+	if a.c.PluginType == nil {
+		return ""
+	}
+	return *a.c.PluginType
+}
+
+func (a *autoApprovalPolicyConfig) AllowedFields() []string {
+	return *a.c.AllowedFields
+}
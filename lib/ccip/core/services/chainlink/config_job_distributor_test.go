@@ -0,0 +1,24 @@
+package chainlink
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestJobDistributorConfig(t *testing.T) {
+	opts := GeneralConfigOpts{
+		ConfigStrings: []string{fullTOML},
+	}
+	cfg, err := opts.New()
+	require.NoError(t, err)
+
+	policies := cfg.JobDistributor().AutoApprovalPolicies()
+	require.Len(t, policies, 1)
+	assert.Equal(t, "ccip-commit-start-blocks", policies[0].Name())
+	assert.Equal(t, "3b8e4f9e9b6a2d1c0f5e7d8c9b0a1f2e3d4c5b6a79880a1b2c3d4e5f60718293", policies[0].ManagerPublicKey())
+	assert.Equal(t, "offchainreporting2", policies[0].JobType())
+	assert.Equal(t, "ccip-commit", policies[0].PluginType())
+	assert.Equal(t, []string{"pluginConfig.sourceStartBlock", "pluginConfig.destStartBlock"}, policies[0].AllowedFields())
+}
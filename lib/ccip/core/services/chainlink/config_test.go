@@ -473,6 +473,13 @@ func TestConfig_Marshal(t *testing.T) {
 				{ID: ptr("example_gateway"), URL: ptr("wss://localhost:8081/node")},
 			},
 		},
+		RemoteTargets: []toml.RemoteTarget{{
+			CapabilityID:             ptr("write_ethereum-testnet-sepolia@1.0.0"),
+			EncryptRequests:          ptr(true),
+			RequireEncryptedRequests: ptr(true),
+			AllowedWorkflowOwners:    &[]string{"0x68902d681c28119f9b2531473a417088bf008e59"},
+			AllowedCallerDONs:        &[]uint32{1, 2},
+		}},
 	}
 	full.Keeper = toml.Keeper{
 		DefaultTransactionQueueDepth: ptr[uint32](17),
@@ -521,6 +528,23 @@ func TestConfig_Marshal(t *testing.T) {
 		ResourceAttributes: map[string]string{"Baz": "test", "Foo": "bar"},
 		TraceSampleRatio:   ptr(0.01),
 	}
+	full.JobDistributor = toml.JobDistributor{
+		AutoApprovalPolicies: []toml.AutoApprovalPolicy{{
+			Name:             ptr("ccip-commit-start-blocks"),
+			ManagerPublicKey: ptr("3b8e4f9e9b6a2d1c0f5e7d8c9b0a1f2e3d4c5b6a79880a1b2c3d4e5f60718293"),
+			JobType:          ptr("offchainreporting2"),
+			PluginType:       ptr("ccip-commit"),
+			AllowedFields:    &[]string{"pluginConfig.sourceStartBlock", "pluginConfig.destStartBlock"},
+		}},
+	}
+	full.HeadReport = toml.HeadReport{
+		EventSinks: []toml.HeadEventSink{{
+			Name:  ptr("bridge-ui"),
+			Type:  ptr("nats"),
+			URL:   mustURL("nats://nats.example.com:4222"),
+			Topic: ptr("chainlink.heads"),
+		}},
+	}
 	full.EVM = []*evmcfg.EVMConfig{
 		{
 			ChainID: ubig.NewI(1),
@@ -577,6 +601,23 @@ func TestConfig_Marshal(t *testing.T) {
 					FeeHistory: evmcfg.FeeHistoryEstimator{
 						CacheTimeout: &second,
 					},
+					FeePolicies: evmcfg.FeePolicies{
+						{
+							Name:               ptr("ccip_exec"),
+							MaxSpendPerHour:    assets.Ether(1),
+							MaxFeeValuePercent: ptr[uint16](5),
+							Urgency: []evmcfg.FeePolicyUrgency{
+								{After: commoncfg.MustNewDuration(5 * time.Minute), Bumps: ptr[uint16](2)},
+								{BeforeDeadline: commoncfg.MustNewDuration(10 * time.Minute), Bumps: ptr[uint16](3)},
+							},
+						},
+					},
+					BlobFees: evmcfg.BlobFees{
+						Enabled:       ptr(true),
+						PriceMax:      assets.GWei(100),
+						HistoryDepth:  ptr[uint16](10),
+						BufferPercent: ptr[uint16](20),
+					},
 				},
 
 				KeySpecific: []evmcfg.KeySpecific{
@@ -614,6 +655,18 @@ func TestConfig_Marshal(t *testing.T) {
 					AutoPurge: evmcfg.AutoPurgeConfig{
 						Enabled: ptr(false),
 					},
+					StuckTxRecovery: evmcfg.StuckTxRecoveryConfig{
+						Enabled:   ptr(true),
+						Threshold: ptr[uint32](20),
+					},
+					SmartAccounts: evmcfg.SmartAccounts{{
+						Key:          mustAddress("0xa0788FC17B1dEe36f057c42B6F373A34B014687e"),
+						Account:      mustAddress("0x538aAaB4ea120b2bC2fe5D296852D948F07D849e"),
+						Mode:         ptr(evmcfg.SmartAccountModeERC4337),
+						EntryPoint:   mustAddress("0x0000000071727De22E5E9d8BAf0edAc6f37da032"),
+						BundlerURL:   mustURL("https://bundler.example.com/rpc"),
+						PaymasterURL: mustURL("https://paymaster.example.com/rpc"),
+					}},
 				},
 
 				HeadTracker: evmcfg.HeadTracker{
@@ -652,6 +705,11 @@ func TestConfig_Marshal(t *testing.T) {
 						ServiceUnavailable:                ptr[string]("(: |^)service unavailable"),
 						TooManyResults:                    ptr[string]("(: |^)too many results"),
 					},
+					QuorumReads: evmcfg.QuorumReads{
+						Methods:   []string{"eth_call", "eth_getLogs"},
+						Nodes:     ptr[uint32](2),
+						Threshold: ptr[uint32](2),
+					},
 				},
 				OCR: evmcfg.OCR{
 					ContractConfirmations:              ptr[uint16](11),
@@ -856,6 +914,21 @@ URL = 'prom.test'
 ServerPubKey = 'test-pub-key'
 `},
 
+		{"JobDistributor", Config{Core: toml.Core{JobDistributor: full.JobDistributor}}, `[JobDistributor]
+[[JobDistributor.AutoApprovalPolicies]]
+Name = 'ccip-commit-start-blocks'
+ManagerPublicKey = '3b8e4f9e9b6a2d1c0f5e7d8c9b0a1f2e3d4c5b6a79880a1b2c3d4e5f60718293'
+JobType = 'offchainreporting2'
+PluginType = 'ccip-commit'
+AllowedFields = ['pluginConfig.sourceStartBlock', 'pluginConfig.destStartBlock']
+`},
+		{"HeadReport", Config{Core: toml.Core{HeadReport: full.HeadReport}}, `[HeadReport]
+[[HeadReport.EventSinks]]
+Name = 'bridge-ui'
+Type = 'nats'
+URL = 'nats://nats.example.com:4222'
+Topic = 'chainlink.heads'
+`},
 		{"Log", Config{Core: toml.Core{Log: full.Log}}, `[Log]
 Level = 'crit'
 JSONConsole = true
@@ -1054,6 +1127,18 @@ ResendAfterThreshold = '1h0m0s'
 [EVM.Transactions.AutoPurge]
 Enabled = false
 
+[EVM.Transactions.StuckTxRecovery]
+Enabled = true
+Threshold = 20
+
+[[EVM.Transactions.SmartAccounts]]
+Key = '0xa0788FC17B1dEe36f057c42B6F373A34B014687e'
+Account = '0x538aAaB4ea120b2bC2fe5D296852D948F07D849e'
+Mode = 'ERC4337'
+EntryPoint = '0x0000000071727De22E5E9d8BAf0edAc6f37da032'
+BundlerURL = 'https://bundler.example.com/rpc'
+PaymasterURL = 'https://paymaster.example.com/rpc'
+
 [EVM.BalanceMonitor]
 Enabled = true
 
@@ -1095,6 +1180,25 @@ TransactionPercentile = 15
 [EVM.GasEstimator.FeeHistory]
 CacheTimeout = '1s'
 
+[[EVM.GasEstimator.FeePolicies]]
+Name = 'ccip_exec'
+MaxSpendPerHour = '1 ether'
+MaxFeeValuePercent = 5
+
+[[EVM.GasEstimator.FeePolicies.Urgency]]
+After = '5m0s'
+Bumps = 2
+
+[[EVM.GasEstimator.FeePolicies.Urgency]]
+BeforeDeadline = '10m0s'
+Bumps = 3
+
+[EVM.GasEstimator.BlobFees]
+Enabled = true
+PriceMax = '100 gwei'
+HistoryDepth = 10
+BufferPercent = 20
+
 [EVM.HeadTracker]
 HistoryDepth = 15
 MaxBufferSize = 17
@@ -1137,6 +1241,11 @@ Fatal = '(: |^)fatal'
 ServiceUnavailable = '(: |^)service unavailable'
 TooManyResults = '(: |^)too many results'
 
+[EVM.NodePool.QuorumReads]
+Methods = ['eth_call', 'eth_getLogs']
+Nodes = 2
+Threshold = 2
+
 [EVM.OCR]
 ContractConfirmations = 11
 ContractTransmitterTransmitTimeout = '1m0s'
@@ -1311,6 +1420,18 @@ func TestConfig_full(t *testing.T) {
 		if got.EVM[c].Transactions.AutoPurge.DetectionApiUrl == nil {
 			got.EVM[c].Transactions.AutoPurge.DetectionApiUrl = new(commoncfg.URL)
 		}
+		// Urgency steps set either After or BeforeDeadline
+		for p := range got.EVM[c].GasEstimator.FeePolicies {
+			for u := range got.EVM[c].GasEstimator.FeePolicies[p].Urgency {
+				urgency := &got.EVM[c].GasEstimator.FeePolicies[p].Urgency[u]
+				if urgency.After == nil {
+					urgency.After = urgency.BeforeDeadline
+				}
+				if urgency.BeforeDeadline == nil {
+					urgency.BeforeDeadline = urgency.After
+				}
+			}
+		}
 	}
 
 	cfgtest.AssertFieldsNotNil(t, got)
@@ -741,6 +741,53 @@ func (_c *GeneralConfig_FluxMonitor_Call) RunAndReturn(run func() config.FluxMon
 	return _c
 }
 
+// HeadReport provides a mock function with given fields:
+func (_m *GeneralConfig) HeadReport() config.HeadReport {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for HeadReport")
+	}
+
+	var r0 config.HeadReport
+	if rf, ok := ret.Get(0).(func() config.HeadReport); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(config.HeadReport)
+		}
+	}
+
+	return r0
+}
+
+// GeneralConfig_HeadReport_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'HeadReport'
+type GeneralConfig_HeadReport_Call struct {
+	*mock.Call
+}
+
+// HeadReport is a helper method to define mock.On call
+func (_e *GeneralConfig_Expecter) HeadReport() *GeneralConfig_HeadReport_Call {
+	return &GeneralConfig_HeadReport_Call{Call: _e.mock.On("HeadReport")}
+}
+
+func (_c *GeneralConfig_HeadReport_Call) Run(run func()) *GeneralConfig_HeadReport_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *GeneralConfig_HeadReport_Call) Return(_a0 config.HeadReport) *GeneralConfig_HeadReport_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *GeneralConfig_HeadReport_Call) RunAndReturn(run func() config.HeadReport) *GeneralConfig_HeadReport_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Insecure provides a mock function with given fields:
 func (_m *GeneralConfig) Insecure() config.Insecure {
 	ret := _m.Called()
@@ -833,6 +880,53 @@ func (_c *GeneralConfig_InsecureFastScrypt_Call) RunAndReturn(run func() bool) *
 	return _c
 }
 
+// JobDistributor provides a mock function with given fields:
+func (_m *GeneralConfig) JobDistributor() config.JobDistributor {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for JobDistributor")
+	}
+
+	var r0 config.JobDistributor
+	if rf, ok := ret.Get(0).(func() config.JobDistributor); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(config.JobDistributor)
+		}
+	}
+
+	return r0
+}
+
+// GeneralConfig_JobDistributor_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'JobDistributor'
+type GeneralConfig_JobDistributor_Call struct {
+	*mock.Call
+}
+
+// JobDistributor is a helper method to define mock.On call
+func (_e *GeneralConfig_Expecter) JobDistributor() *GeneralConfig_JobDistributor_Call {
+	return &GeneralConfig_JobDistributor_Call{Call: _e.mock.On("JobDistributor")}
+}
+
+func (_c *GeneralConfig_JobDistributor_Call) Run(run func()) *GeneralConfig_JobDistributor_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *GeneralConfig_JobDistributor_Call) Return(_a0 config.JobDistributor) *GeneralConfig_JobDistributor_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *GeneralConfig_JobDistributor_Call) RunAndReturn(run func() config.JobDistributor) *GeneralConfig_JobDistributor_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // JobPipeline provides a mock function with given fields:
 func (_m *GeneralConfig) JobPipeline() config.JobPipeline {
 	ret := _m.Called()
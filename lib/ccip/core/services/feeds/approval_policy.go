@@ -0,0 +1,174 @@
+package feeds
+
+import (
+	"bytes"
+	"context"
+	"database/sql"
+	"encoding/hex"
+	"slices"
+
+	"github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+
+	coreconfig "github.com/smartcontractkit/chainlink/v2/core/config"
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	pb "github.com/smartcontractkit/chainlink/v2/core/services/feeds/proto"
+	"github.com/smartcontractkit/chainlink/v2/core/services/job"
+	"github.com/smartcontractkit/chainlink/v2/core/utils/crypto"
+)
+
+var (
+	promJobProposalDryRunFailures = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "feeds_job_proposal_dry_run_failures",
+		Help: "Metric to track job proposals which failed the dry run validation",
+	})
+
+	promJobProposalAutoApprovals = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "feeds_job_proposal_auto_approvals",
+		Help: "Metric to track job proposals approved by an approval policy",
+	}, []string{
+		// Approval policy name
+		"policy",
+	})
+)
+
+// DryRunResult is the result of the validation of a proposed spec, which is
+// reported back to the feeds manager.
+type DryRunResult struct {
+	// Valid is set when a job can be created from the spec on this node.
+	Valid bool
+	// Error is the reason the spec is invalid.
+	Error string
+	// AutoApproved is set when the spec was approved without operator review,
+	// by the approval policy Policy.
+	AutoApproved bool
+	Policy       string
+}
+
+func (r DryRunResult) toProto() *pb.DryRunResult {
+	return &pb.DryRunResult{
+		Valid:        r.Valid,
+		Error:        r.Error,
+		AutoApproved: r.AutoApproved,
+		Policy:       r.Policy,
+	}
+}
+
+// dryRunSpec generates the job of a spec and validates it against the node with
+// the delegate of its type, without creating it.
+func (s *service) dryRunSpec(ctx context.Context, spec string) (*job.Job, error) {
+	j, err := s.generateJob(ctx, spec)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = s.jobSpawner.ValidateJob(ctx, *j); err != nil {
+		return nil, err
+	}
+
+	return j, nil
+}
+
+// findApprovalPolicy returns the name of the auto approval policy which allows
+// the proposed job to replace the approved spec of the job proposal, or an empty
+// string if none does.
+//
+// Only updates of approved job proposals are auto approved, when the proposed job
+// differs from the approved one in the allowed fields of a policy of the feeds
+// manager.
+func (s *service) findApprovalPolicy(ctx context.Context, feedsManagerID int64, proposalID int64, proposed job.Job) (string, error) {
+	policies := s.jdCfg.AutoApprovalPolicies()
+	if len(policies) == 0 {
+		return "", nil
+	}
+
+	mgr, err := s.orm.GetManager(ctx, feedsManagerID)
+	if err != nil {
+		return "", errors.Wrap(err, "could not get feeds manager")
+	}
+
+	approvedSpec, err := s.orm.GetApprovedSpec(ctx, proposalID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return "", nil
+		}
+		return "", err
+	}
+
+	current, err := s.generateJob(ctx, approvedSpec.Definition)
+	if err != nil {
+		return "", errors.Wrap(err, "could not generate job from the approved spec")
+	}
+
+	diff, err := job.DiffSpecs(*current, proposed)
+	if err != nil {
+		// The job type changed, which no policy allows
+		return "", nil
+	}
+
+	for _, p := range policies {
+		if policyAllows(p, mgr.PublicKey, proposed, diff) {
+			return p.Name(), nil
+		}
+	}
+
+	return "", nil
+}
+
+// policyAllows checks that the policy applies to the feeds manager and the job,
+// and that it allows all the changes of the diff.
+func policyAllows(p coreconfig.AutoApprovalPolicy, mgrPublicKey crypto.PublicKey, proposed job.Job, diff job.SpecDiff) bool {
+	key, err := hex.DecodeString(p.ManagerPublicKey())
+	if err != nil || !bytes.Equal(key, mgrPublicKey) {
+		return false
+	}
+
+	if p.JobType() != string(proposed.Type) {
+		return false
+	}
+
+	if p.PluginType() != "" {
+		if proposed.OCR2OracleSpec == nil || string(proposed.OCR2OracleSpec.PluginType) != p.PluginType() {
+			return false
+		}
+	}
+
+	// Pipeline changes always require operator review
+	if !diff.Pipeline.IsEmpty() {
+		return false
+	}
+
+	allowed := p.AllowedFields()
+	for _, f := range diff.Fields {
+		if !slices.Contains(allowed, f.Path) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// autoApproveSpec approves a proposed spec when an approval policy allows it,
+// and returns the name of the policy. Failures are logged and leave the spec
+// pending for operator review.
+func (s *service) autoApproveSpec(ctx context.Context, lggr logger.Logger, args *ProposeJobArgs, proposalID int64, specID int64, proposed job.Job) (string, bool) {
+	policy, err := s.findApprovalPolicy(ctx, args.FeedsManagerID, proposalID, proposed)
+	if err != nil {
+		lggr.Errorw("Failed to check approval policies", "err", err)
+		return "", false
+	}
+	if policy == "" {
+		return "", false
+	}
+
+	if err = s.ApproveSpec(ctx, specID, true); err != nil {
+		lggr.Errorw("Failed to auto approve job proposal spec", "policy", policy, "err", err)
+		return "", false
+	}
+
+	lggr.Infow("Successful job proposal spec auto approval", "policy", policy)
+	promJobProposalAutoApprovals.WithLabelValues(policy).Inc()
+
+	return policy, true
+}
@@ -0,0 +1,128 @@
+package feeds
+
+import (
+	"encoding/hex"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/types"
+
+	"github.com/smartcontractkit/chainlink/v2/core/services/job"
+	"github.com/smartcontractkit/chainlink/v2/core/services/pipeline"
+	"github.com/smartcontractkit/chainlink/v2/core/utils/crypto"
+)
+
+type testApprovalPolicy struct {
+	name, managerPublicKey, jobType, pluginType string
+	allowedFields                               []string
+}
+
+func (p testApprovalPolicy) Name() string             { return p.name }
+func (p testApprovalPolicy) ManagerPublicKey() string { return p.managerPublicKey }
+func (p testApprovalPolicy) JobType() string          { return p.jobType }
+func (p testApprovalPolicy) PluginType() string       { return p.pluginType }
+func (p testApprovalPolicy) AllowedFields() []string  { return p.allowedFields }
+
+func Test_policyAllows(t *testing.T) {
+	t.Parallel()
+
+	var (
+		mgrKey   = crypto.PublicKey{1, 2, 3}
+		otherKey = crypto.PublicKey{4, 5, 6}
+		policy   = testApprovalPolicy{
+			name:             "ccip-commit-start-blocks",
+			managerPublicKey: hex.EncodeToString(mgrKey),
+			jobType:          string(job.OffchainReporting2),
+			pluginType:       string(types.CCIPCommit),
+			allowedFields:    []string{"pluginConfig.sourceStartBlock", "pluginConfig.destStartBlock"},
+		}
+		commitJob = job.Job{
+			Type:           job.OffchainReporting2,
+			OCR2OracleSpec: &job.OCR2OracleSpec{PluginType: types.CCIPCommit},
+		}
+		execJob = job.Job{
+			Type:           job.OffchainReporting2,
+			OCR2OracleSpec: &job.OCR2OracleSpec{PluginType: types.CCIPExecution},
+		}
+		startBlockDiff = job.SpecDiff{Fields: []job.FieldChange{
+			{Path: "pluginConfig.sourceStartBlock", Old: 1, New: 2},
+		}}
+	)
+
+	tests := []struct {
+		name     string
+		policy   testApprovalPolicy
+		mgrKey   crypto.PublicKey
+		proposed job.Job
+		diff     job.SpecDiff
+		want     bool
+	}{
+		{
+			name:     "allowed fields",
+			policy:   policy,
+			mgrKey:   mgrKey,
+			proposed: commitJob,
+			diff:     startBlockDiff,
+			want:     true,
+		},
+		{
+			name:     "unchanged spec",
+			policy:   policy,
+			mgrKey:   mgrKey,
+			proposed: commitJob,
+			want:     true,
+		},
+		{
+			name:     "other feeds manager",
+			policy:   policy,
+			mgrKey:   otherKey,
+			proposed: commitJob,
+			diff:     startBlockDiff,
+		},
+		{
+			name:     "other plugin type",
+			policy:   policy,
+			mgrKey:   mgrKey,
+			proposed: execJob,
+			diff:     startBlockDiff,
+		},
+		{
+			name:     "other job type",
+			policy:   policy,
+			mgrKey:   mgrKey,
+			proposed: job.Job{Type: job.Bootstrap},
+			diff:     startBlockDiff,
+		},
+		{
+			name:     "disallowed field",
+			policy:   policy,
+			mgrKey:   mgrKey,
+			proposed: commitJob,
+			diff: job.SpecDiff{Fields: []job.FieldChange{
+				{Path: "pluginConfig.sourceStartBlock", Old: 1, New: 2},
+				{Path: "contractID", Old: "0x1", New: "0x2"},
+			}},
+		},
+		{
+			name:     "pipeline change",
+			policy:   policy,
+			mgrKey:   mgrKey,
+			proposed: commitJob,
+			diff: job.SpecDiff{
+				Fields:   startBlockDiff.Fields,
+				Pipeline: pipeline.PipelineDiff{AddedTasks: []string{"ds2"}},
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, tc.want, policyAllows(tc.policy, tc.mgrKey, tc.proposed, tc.diff))
+		})
+	}
+}
@@ -39,3 +39,7 @@ type OCR2Config interface {
 	SimulateTransactions() bool
 	TraceLogging() bool
 }
+
+type JobDistributorConfig interface {
+	AutoApprovalPolicies() []coreconfig.AutoApprovalPolicy
+}
@@ -979,7 +979,7 @@ func (_c *Service_ListSpecsByJobProposalIDs_Call) RunAndReturn(run func(context.
 }
 
 // ProposeJob provides a mock function with given fields: ctx, args
-func (_m *Service) ProposeJob(ctx context.Context, args *feeds.ProposeJobArgs) (int64, error) {
+func (_m *Service) ProposeJob(ctx context.Context, args *feeds.ProposeJobArgs) (int64, feeds.DryRunResult, error) {
 	ret := _m.Called(ctx, args)
 
 	if len(ret) == 0 {
@@ -987,8 +987,9 @@ func (_m *Service) ProposeJob(ctx context.Context, args *feeds.ProposeJobArgs) (
 	}
 
 	var r0 int64
-	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, *feeds.ProposeJobArgs) (int64, error)); ok {
+	var r1 feeds.DryRunResult
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, *feeds.ProposeJobArgs) (int64, feeds.DryRunResult, error)); ok {
 		return rf(ctx, args)
 	}
 	if rf, ok := ret.Get(0).(func(context.Context, *feeds.ProposeJobArgs) int64); ok {
@@ -997,13 +998,19 @@ func (_m *Service) ProposeJob(ctx context.Context, args *feeds.ProposeJobArgs) (
 		r0 = ret.Get(0).(int64)
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, *feeds.ProposeJobArgs) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *feeds.ProposeJobArgs) feeds.DryRunResult); ok {
 		r1 = rf(ctx, args)
 	} else {
-		r1 = ret.Error(1)
+		r1 = ret.Get(1).(feeds.DryRunResult)
 	}
 
-	return r0, r1
+	if rf, ok := ret.Get(2).(func(context.Context, *feeds.ProposeJobArgs) error); ok {
+		r2 = rf(ctx, args)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
 }
 
 // Service_ProposeJob_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ProposeJob'
@@ -1025,12 +1032,12 @@ func (_c *Service_ProposeJob_Call) Run(run func(ctx context.Context, args *feeds
 	return _c
 }
 
-func (_c *Service_ProposeJob_Call) Return(_a0 int64, _a1 error) *Service_ProposeJob_Call {
-	_c.Call.Return(_a0, _a1)
+func (_c *Service_ProposeJob_Call) Return(_a0 int64, _a1 feeds.DryRunResult, _a2 error) *Service_ProposeJob_Call {
+	_c.Call.Return(_a0, _a1, _a2)
 	return _c
 }
 
-func (_c *Service_ProposeJob_Call) RunAndReturn(run func(context.Context, *feeds.ProposeJobArgs) (int64, error)) *Service_ProposeJob_Call {
+func (_c *Service_ProposeJob_Call) RunAndReturn(run func(context.Context, *feeds.ProposeJobArgs) (int64, feeds.DryRunResult, error)) *Service_ProposeJob_Call {
 	_c.Call.Return(run)
 	return _c
 }
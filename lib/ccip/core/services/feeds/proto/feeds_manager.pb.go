@@ -2,16 +2,15 @@
 // versions:
 // 	protoc-gen-go v1.34.1
 // 	protoc        v4.25.3
-// source: pkg/noderpc/proto/feeds_manager.proto
+// source: feeds_manager.proto
 
 package proto
 
 import (
-	reflect "reflect"
-	sync "sync"
-
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
 )
 
 const (
@@ -58,11 +57,11 @@ func (x JobType) String() string {
 }
 
 func (JobType) Descriptor() protoreflect.EnumDescriptor {
-	return file_pkg_noderpc_proto_feeds_manager_proto_enumTypes[0].Descriptor()
+	return file_feeds_manager_proto_enumTypes[0].Descriptor()
 }
 
 func (JobType) Type() protoreflect.EnumType {
-	return &file_pkg_noderpc_proto_feeds_manager_proto_enumTypes[0]
+	return &file_feeds_manager_proto_enumTypes[0]
 }
 
 func (x JobType) Number() protoreflect.EnumNumber {
@@ -71,7 +70,7 @@ func (x JobType) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use JobType.Descriptor instead.
 func (JobType) EnumDescriptor() ([]byte, []int) {
-	return file_pkg_noderpc_proto_feeds_manager_proto_rawDescGZIP(), []int{0}
+	return file_feeds_manager_proto_rawDescGZIP(), []int{0}
 }
 
 type ChainType int32
@@ -110,11 +109,11 @@ func (x ChainType) String() string {
 }
 
 func (ChainType) Descriptor() protoreflect.EnumDescriptor {
-	return file_pkg_noderpc_proto_feeds_manager_proto_enumTypes[1].Descriptor()
+	return file_feeds_manager_proto_enumTypes[1].Descriptor()
 }
 
 func (ChainType) Type() protoreflect.EnumType {
-	return &file_pkg_noderpc_proto_feeds_manager_proto_enumTypes[1]
+	return &file_feeds_manager_proto_enumTypes[1]
 }
 
 func (x ChainType) Number() protoreflect.EnumNumber {
@@ -123,7 +122,7 @@ func (x ChainType) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use ChainType.Descriptor instead.
 func (ChainType) EnumDescriptor() ([]byte, []int) {
-	return file_pkg_noderpc_proto_feeds_manager_proto_rawDescGZIP(), []int{1}
+	return file_feeds_manager_proto_rawDescGZIP(), []int{1}
 }
 
 type Chain struct {
@@ -138,7 +137,7 @@ type Chain struct {
 func (x *Chain) Reset() {
 	*x = Chain{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_noderpc_proto_feeds_manager_proto_msgTypes[0]
+		mi := &file_feeds_manager_proto_msgTypes[0]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -151,7 +150,7 @@ func (x *Chain) String() string {
 func (*Chain) ProtoMessage() {}
 
 func (x *Chain) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_noderpc_proto_feeds_manager_proto_msgTypes[0]
+	mi := &file_feeds_manager_proto_msgTypes[0]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -164,7 +163,7 @@ func (x *Chain) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Chain.ProtoReflect.Descriptor instead.
 func (*Chain) Descriptor() ([]byte, []int) {
-	return file_pkg_noderpc_proto_feeds_manager_proto_rawDescGZIP(), []int{0}
+	return file_feeds_manager_proto_rawDescGZIP(), []int{0}
 }
 
 func (x *Chain) GetId() string {
@@ -195,7 +194,7 @@ type Account struct {
 func (x *Account) Reset() {
 	*x = Account{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_noderpc_proto_feeds_manager_proto_msgTypes[1]
+		mi := &file_feeds_manager_proto_msgTypes[1]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -208,7 +207,7 @@ func (x *Account) String() string {
 func (*Account) ProtoMessage() {}
 
 func (x *Account) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_noderpc_proto_feeds_manager_proto_msgTypes[1]
+	mi := &file_feeds_manager_proto_msgTypes[1]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -221,7 +220,7 @@ func (x *Account) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Account.ProtoReflect.Descriptor instead.
 func (*Account) Descriptor() ([]byte, []int) {
-	return file_pkg_noderpc_proto_feeds_manager_proto_rawDescGZIP(), []int{1}
+	return file_feeds_manager_proto_rawDescGZIP(), []int{1}
 }
 
 func (x *Account) GetChainType() ChainType {
@@ -257,7 +256,7 @@ type FluxMonitorConfig struct {
 func (x *FluxMonitorConfig) Reset() {
 	*x = FluxMonitorConfig{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_noderpc_proto_feeds_manager_proto_msgTypes[2]
+		mi := &file_feeds_manager_proto_msgTypes[2]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -270,7 +269,7 @@ func (x *FluxMonitorConfig) String() string {
 func (*FluxMonitorConfig) ProtoMessage() {}
 
 func (x *FluxMonitorConfig) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_noderpc_proto_feeds_manager_proto_msgTypes[2]
+	mi := &file_feeds_manager_proto_msgTypes[2]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -283,7 +282,7 @@ func (x *FluxMonitorConfig) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use FluxMonitorConfig.ProtoReflect.Descriptor instead.
 func (*FluxMonitorConfig) Descriptor() ([]byte, []int) {
-	return file_pkg_noderpc_proto_feeds_manager_proto_rawDescGZIP(), []int{2}
+	return file_feeds_manager_proto_rawDescGZIP(), []int{2}
 }
 
 func (x *FluxMonitorConfig) GetEnabled() bool {
@@ -309,7 +308,7 @@ type OCR1Config struct {
 func (x *OCR1Config) Reset() {
 	*x = OCR1Config{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_noderpc_proto_feeds_manager_proto_msgTypes[3]
+		mi := &file_feeds_manager_proto_msgTypes[3]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -322,7 +321,7 @@ func (x *OCR1Config) String() string {
 func (*OCR1Config) ProtoMessage() {}
 
 func (x *OCR1Config) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_noderpc_proto_feeds_manager_proto_msgTypes[3]
+	mi := &file_feeds_manager_proto_msgTypes[3]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -335,7 +334,7 @@ func (x *OCR1Config) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use OCR1Config.ProtoReflect.Descriptor instead.
 func (*OCR1Config) Descriptor() ([]byte, []int) {
-	return file_pkg_noderpc_proto_feeds_manager_proto_rawDescGZIP(), []int{3}
+	return file_feeds_manager_proto_rawDescGZIP(), []int{3}
 }
 
 func (x *OCR1Config) GetEnabled() bool {
@@ -391,7 +390,7 @@ type OCR2Config struct {
 func (x *OCR2Config) Reset() {
 	*x = OCR2Config{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_noderpc_proto_feeds_manager_proto_msgTypes[4]
+		mi := &file_feeds_manager_proto_msgTypes[4]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -404,7 +403,7 @@ func (x *OCR2Config) String() string {
 func (*OCR2Config) ProtoMessage() {}
 
 func (x *OCR2Config) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_noderpc_proto_feeds_manager_proto_msgTypes[4]
+	mi := &file_feeds_manager_proto_msgTypes[4]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -417,7 +416,7 @@ func (x *OCR2Config) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use OCR2Config.ProtoReflect.Descriptor instead.
 func (*OCR2Config) Descriptor() ([]byte, []int) {
-	return file_pkg_noderpc_proto_feeds_manager_proto_rawDescGZIP(), []int{4}
+	return file_feeds_manager_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *OCR2Config) GetEnabled() bool {
@@ -490,7 +489,7 @@ type ChainConfig struct {
 func (x *ChainConfig) Reset() {
 	*x = ChainConfig{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_noderpc_proto_feeds_manager_proto_msgTypes[5]
+		mi := &file_feeds_manager_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -503,7 +502,7 @@ func (x *ChainConfig) String() string {
 func (*ChainConfig) ProtoMessage() {}
 
 func (x *ChainConfig) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_noderpc_proto_feeds_manager_proto_msgTypes[5]
+	mi := &file_feeds_manager_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -516,7 +515,7 @@ func (x *ChainConfig) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ChainConfig.ProtoReflect.Descriptor instead.
 func (*ChainConfig) Descriptor() ([]byte, []int) {
-	return file_pkg_noderpc_proto_feeds_manager_proto_rawDescGZIP(), []int{5}
+	return file_feeds_manager_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *ChainConfig) GetChain() *Chain {
@@ -588,7 +587,7 @@ type UpdateNodeRequest struct {
 func (x *UpdateNodeRequest) Reset() {
 	*x = UpdateNodeRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_noderpc_proto_feeds_manager_proto_msgTypes[6]
+		mi := &file_feeds_manager_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -601,7 +600,7 @@ func (x *UpdateNodeRequest) String() string {
 func (*UpdateNodeRequest) ProtoMessage() {}
 
 func (x *UpdateNodeRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_noderpc_proto_feeds_manager_proto_msgTypes[6]
+	mi := &file_feeds_manager_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -614,7 +613,7 @@ func (x *UpdateNodeRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateNodeRequest.ProtoReflect.Descriptor instead.
 func (*UpdateNodeRequest) Descriptor() ([]byte, []int) {
-	return file_pkg_noderpc_proto_feeds_manager_proto_rawDescGZIP(), []int{6}
+	return file_feeds_manager_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *UpdateNodeRequest) GetJobTypes() []JobType {
@@ -696,7 +695,7 @@ type UpdateNodeResponse struct {
 func (x *UpdateNodeResponse) Reset() {
 	*x = UpdateNodeResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_noderpc_proto_feeds_manager_proto_msgTypes[7]
+		mi := &file_feeds_manager_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -709,7 +708,7 @@ func (x *UpdateNodeResponse) String() string {
 func (*UpdateNodeResponse) ProtoMessage() {}
 
 func (x *UpdateNodeResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_noderpc_proto_feeds_manager_proto_msgTypes[7]
+	mi := &file_feeds_manager_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -722,7 +721,7 @@ func (x *UpdateNodeResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateNodeResponse.ProtoReflect.Descriptor instead.
 func (*UpdateNodeResponse) Descriptor() ([]byte, []int) {
-	return file_pkg_noderpc_proto_feeds_manager_proto_rawDescGZIP(), []int{7}
+	return file_feeds_manager_proto_rawDescGZIP(), []int{7}
 }
 
 type ApprovedJobRequest struct {
@@ -737,7 +736,7 @@ type ApprovedJobRequest struct {
 func (x *ApprovedJobRequest) Reset() {
 	*x = ApprovedJobRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_noderpc_proto_feeds_manager_proto_msgTypes[8]
+		mi := &file_feeds_manager_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -750,7 +749,7 @@ func (x *ApprovedJobRequest) String() string {
 func (*ApprovedJobRequest) ProtoMessage() {}
 
 func (x *ApprovedJobRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_noderpc_proto_feeds_manager_proto_msgTypes[8]
+	mi := &file_feeds_manager_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -763,7 +762,7 @@ func (x *ApprovedJobRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ApprovedJobRequest.ProtoReflect.Descriptor instead.
 func (*ApprovedJobRequest) Descriptor() ([]byte, []int) {
-	return file_pkg_noderpc_proto_feeds_manager_proto_rawDescGZIP(), []int{8}
+	return file_feeds_manager_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *ApprovedJobRequest) GetUuid() string {
@@ -789,7 +788,7 @@ type ApprovedJobResponse struct {
 func (x *ApprovedJobResponse) Reset() {
 	*x = ApprovedJobResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_noderpc_proto_feeds_manager_proto_msgTypes[9]
+		mi := &file_feeds_manager_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -802,7 +801,7 @@ func (x *ApprovedJobResponse) String() string {
 func (*ApprovedJobResponse) ProtoMessage() {}
 
 func (x *ApprovedJobResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_noderpc_proto_feeds_manager_proto_msgTypes[9]
+	mi := &file_feeds_manager_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -815,7 +814,7 @@ func (x *ApprovedJobResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ApprovedJobResponse.ProtoReflect.Descriptor instead.
 func (*ApprovedJobResponse) Descriptor() ([]byte, []int) {
-	return file_pkg_noderpc_proto_feeds_manager_proto_rawDescGZIP(), []int{9}
+	return file_feeds_manager_proto_rawDescGZIP(), []int{9}
 }
 
 type HealthcheckRequest struct {
@@ -827,7 +826,7 @@ type HealthcheckRequest struct {
 func (x *HealthcheckRequest) Reset() {
 	*x = HealthcheckRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_noderpc_proto_feeds_manager_proto_msgTypes[10]
+		mi := &file_feeds_manager_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -840,7 +839,7 @@ func (x *HealthcheckRequest) String() string {
 func (*HealthcheckRequest) ProtoMessage() {}
 
 func (x *HealthcheckRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_noderpc_proto_feeds_manager_proto_msgTypes[10]
+	mi := &file_feeds_manager_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -853,7 +852,7 @@ func (x *HealthcheckRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use HealthcheckRequest.ProtoReflect.Descriptor instead.
 func (*HealthcheckRequest) Descriptor() ([]byte, []int) {
-	return file_pkg_noderpc_proto_feeds_manager_proto_rawDescGZIP(), []int{10}
+	return file_feeds_manager_proto_rawDescGZIP(), []int{10}
 }
 
 type HealthcheckResponse struct {
@@ -865,7 +864,7 @@ type HealthcheckResponse struct {
 func (x *HealthcheckResponse) Reset() {
 	*x = HealthcheckResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_noderpc_proto_feeds_manager_proto_msgTypes[11]
+		mi := &file_feeds_manager_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -878,7 +877,7 @@ func (x *HealthcheckResponse) String() string {
 func (*HealthcheckResponse) ProtoMessage() {}
 
 func (x *HealthcheckResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_noderpc_proto_feeds_manager_proto_msgTypes[11]
+	mi := &file_feeds_manager_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -891,7 +890,7 @@ func (x *HealthcheckResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use HealthcheckResponse.ProtoReflect.Descriptor instead.
 func (*HealthcheckResponse) Descriptor() ([]byte, []int) {
-	return file_pkg_noderpc_proto_feeds_manager_proto_rawDescGZIP(), []int{11}
+	return file_feeds_manager_proto_rawDescGZIP(), []int{11}
 }
 
 type RejectedJobRequest struct {
@@ -906,7 +905,7 @@ type RejectedJobRequest struct {
 func (x *RejectedJobRequest) Reset() {
 	*x = RejectedJobRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_noderpc_proto_feeds_manager_proto_msgTypes[12]
+		mi := &file_feeds_manager_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -919,7 +918,7 @@ func (x *RejectedJobRequest) String() string {
 func (*RejectedJobRequest) ProtoMessage() {}
 
 func (x *RejectedJobRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_noderpc_proto_feeds_manager_proto_msgTypes[12]
+	mi := &file_feeds_manager_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -932,7 +931,7 @@ func (x *RejectedJobRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RejectedJobRequest.ProtoReflect.Descriptor instead.
 func (*RejectedJobRequest) Descriptor() ([]byte, []int) {
-	return file_pkg_noderpc_proto_feeds_manager_proto_rawDescGZIP(), []int{12}
+	return file_feeds_manager_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *RejectedJobRequest) GetUuid() string {
@@ -958,7 +957,7 @@ type RejectedJobResponse struct {
 func (x *RejectedJobResponse) Reset() {
 	*x = RejectedJobResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_noderpc_proto_feeds_manager_proto_msgTypes[13]
+		mi := &file_feeds_manager_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -971,7 +970,7 @@ func (x *RejectedJobResponse) String() string {
 func (*RejectedJobResponse) ProtoMessage() {}
 
 func (x *RejectedJobResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_noderpc_proto_feeds_manager_proto_msgTypes[13]
+	mi := &file_feeds_manager_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -984,7 +983,7 @@ func (x *RejectedJobResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RejectedJobResponse.ProtoReflect.Descriptor instead.
 func (*RejectedJobResponse) Descriptor() ([]byte, []int) {
-	return file_pkg_noderpc_proto_feeds_manager_proto_rawDescGZIP(), []int{13}
+	return file_feeds_manager_proto_rawDescGZIP(), []int{13}
 }
 
 type CancelledJobRequest struct {
@@ -999,7 +998,7 @@ type CancelledJobRequest struct {
 func (x *CancelledJobRequest) Reset() {
 	*x = CancelledJobRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_noderpc_proto_feeds_manager_proto_msgTypes[14]
+		mi := &file_feeds_manager_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1012,7 +1011,7 @@ func (x *CancelledJobRequest) String() string {
 func (*CancelledJobRequest) ProtoMessage() {}
 
 func (x *CancelledJobRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_noderpc_proto_feeds_manager_proto_msgTypes[14]
+	mi := &file_feeds_manager_proto_msgTypes[14]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1025,7 +1024,7 @@ func (x *CancelledJobRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CancelledJobRequest.ProtoReflect.Descriptor instead.
 func (*CancelledJobRequest) Descriptor() ([]byte, []int) {
-	return file_pkg_noderpc_proto_feeds_manager_proto_rawDescGZIP(), []int{14}
+	return file_feeds_manager_proto_rawDescGZIP(), []int{14}
 }
 
 func (x *CancelledJobRequest) GetUuid() string {
@@ -1051,7 +1050,7 @@ type CancelledJobResponse struct {
 func (x *CancelledJobResponse) Reset() {
 	*x = CancelledJobResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_noderpc_proto_feeds_manager_proto_msgTypes[15]
+		mi := &file_feeds_manager_proto_msgTypes[15]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1064,7 +1063,7 @@ func (x *CancelledJobResponse) String() string {
 func (*CancelledJobResponse) ProtoMessage() {}
 
 func (x *CancelledJobResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_noderpc_proto_feeds_manager_proto_msgTypes[15]
+	mi := &file_feeds_manager_proto_msgTypes[15]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1077,7 +1076,7 @@ func (x *CancelledJobResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CancelledJobResponse.ProtoReflect.Descriptor instead.
 func (*CancelledJobResponse) Descriptor() ([]byte, []int) {
-	return file_pkg_noderpc_proto_feeds_manager_proto_rawDescGZIP(), []int{15}
+	return file_feeds_manager_proto_rawDescGZIP(), []int{15}
 }
 
 type ProposeJobRequest struct {
@@ -1094,7 +1093,7 @@ type ProposeJobRequest struct {
 func (x *ProposeJobRequest) Reset() {
 	*x = ProposeJobRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_noderpc_proto_feeds_manager_proto_msgTypes[16]
+		mi := &file_feeds_manager_proto_msgTypes[16]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1107,7 +1106,7 @@ func (x *ProposeJobRequest) String() string {
 func (*ProposeJobRequest) ProtoMessage() {}
 
 func (x *ProposeJobRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_noderpc_proto_feeds_manager_proto_msgTypes[16]
+	mi := &file_feeds_manager_proto_msgTypes[16]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1120,7 +1119,7 @@ func (x *ProposeJobRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ProposeJobRequest.ProtoReflect.Descriptor instead.
 func (*ProposeJobRequest) Descriptor() ([]byte, []int) {
-	return file_pkg_noderpc_proto_feeds_manager_proto_rawDescGZIP(), []int{16}
+	return file_feeds_manager_proto_rawDescGZIP(), []int{16}
 }
 
 func (x *ProposeJobRequest) GetId() string {
@@ -1157,12 +1156,14 @@ type ProposeJobResponse struct {
 	unknownFields protoimpl.UnknownFields
 
 	Id string `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	// The result of validating the proposed spec on the node
+	DryRunResult *DryRunResult `protobuf:"bytes,3,opt,name=dry_run_result,json=dryRunResult,proto3" json:"dry_run_result,omitempty"`
 }
 
 func (x *ProposeJobResponse) Reset() {
 	*x = ProposeJobResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_noderpc_proto_feeds_manager_proto_msgTypes[17]
+		mi := &file_feeds_manager_proto_msgTypes[17]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1175,7 +1176,7 @@ func (x *ProposeJobResponse) String() string {
 func (*ProposeJobResponse) ProtoMessage() {}
 
 func (x *ProposeJobResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_noderpc_proto_feeds_manager_proto_msgTypes[17]
+	mi := &file_feeds_manager_proto_msgTypes[17]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1188,7 +1189,7 @@ func (x *ProposeJobResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ProposeJobResponse.ProtoReflect.Descriptor instead.
 func (*ProposeJobResponse) Descriptor() ([]byte, []int) {
-	return file_pkg_noderpc_proto_feeds_manager_proto_rawDescGZIP(), []int{17}
+	return file_feeds_manager_proto_rawDescGZIP(), []int{17}
 }
 
 func (x *ProposeJobResponse) GetId() string {
@@ -1198,6 +1199,13 @@ func (x *ProposeJobResponse) GetId() string {
 	return ""
 }
 
+func (x *ProposeJobResponse) GetDryRunResult() *DryRunResult {
+	if x != nil {
+		return x.DryRunResult
+	}
+	return nil
+}
+
 type DeleteJobRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -1209,7 +1217,7 @@ type DeleteJobRequest struct {
 func (x *DeleteJobRequest) Reset() {
 	*x = DeleteJobRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_noderpc_proto_feeds_manager_proto_msgTypes[18]
+		mi := &file_feeds_manager_proto_msgTypes[18]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1222,7 +1230,7 @@ func (x *DeleteJobRequest) String() string {
 func (*DeleteJobRequest) ProtoMessage() {}
 
 func (x *DeleteJobRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_noderpc_proto_feeds_manager_proto_msgTypes[18]
+	mi := &file_feeds_manager_proto_msgTypes[18]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1235,7 +1243,7 @@ func (x *DeleteJobRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteJobRequest.ProtoReflect.Descriptor instead.
 func (*DeleteJobRequest) Descriptor() ([]byte, []int) {
-	return file_pkg_noderpc_proto_feeds_manager_proto_rawDescGZIP(), []int{18}
+	return file_feeds_manager_proto_rawDescGZIP(), []int{18}
 }
 
 func (x *DeleteJobRequest) GetId() string {
@@ -1256,7 +1264,7 @@ type DeleteJobResponse struct {
 func (x *DeleteJobResponse) Reset() {
 	*x = DeleteJobResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_noderpc_proto_feeds_manager_proto_msgTypes[19]
+		mi := &file_feeds_manager_proto_msgTypes[19]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1269,7 +1277,7 @@ func (x *DeleteJobResponse) String() string {
 func (*DeleteJobResponse) ProtoMessage() {}
 
 func (x *DeleteJobResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_noderpc_proto_feeds_manager_proto_msgTypes[19]
+	mi := &file_feeds_manager_proto_msgTypes[19]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1282,7 +1290,7 @@ func (x *DeleteJobResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteJobResponse.ProtoReflect.Descriptor instead.
 func (*DeleteJobResponse) Descriptor() ([]byte, []int) {
-	return file_pkg_noderpc_proto_feeds_manager_proto_rawDescGZIP(), []int{19}
+	return file_feeds_manager_proto_rawDescGZIP(), []int{19}
 }
 
 func (x *DeleteJobResponse) GetId() string {
@@ -1303,7 +1311,7 @@ type RevokeJobRequest struct {
 func (x *RevokeJobRequest) Reset() {
 	*x = RevokeJobRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_noderpc_proto_feeds_manager_proto_msgTypes[20]
+		mi := &file_feeds_manager_proto_msgTypes[20]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1316,7 +1324,7 @@ func (x *RevokeJobRequest) String() string {
 func (*RevokeJobRequest) ProtoMessage() {}
 
 func (x *RevokeJobRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_noderpc_proto_feeds_manager_proto_msgTypes[20]
+	mi := &file_feeds_manager_proto_msgTypes[20]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1329,7 +1337,7 @@ func (x *RevokeJobRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RevokeJobRequest.ProtoReflect.Descriptor instead.
 func (*RevokeJobRequest) Descriptor() ([]byte, []int) {
-	return file_pkg_noderpc_proto_feeds_manager_proto_rawDescGZIP(), []int{20}
+	return file_feeds_manager_proto_rawDescGZIP(), []int{20}
 }
 
 func (x *RevokeJobRequest) GetId() string {
@@ -1350,7 +1358,7 @@ type RevokeJobResponse struct {
 func (x *RevokeJobResponse) Reset() {
 	*x = RevokeJobResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_noderpc_proto_feeds_manager_proto_msgTypes[21]
+		mi := &file_feeds_manager_proto_msgTypes[21]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1363,7 +1371,7 @@ func (x *RevokeJobResponse) String() string {
 func (*RevokeJobResponse) ProtoMessage() {}
 
 func (x *RevokeJobResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_noderpc_proto_feeds_manager_proto_msgTypes[21]
+	mi := &file_feeds_manager_proto_msgTypes[21]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1376,7 +1384,7 @@ func (x *RevokeJobResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RevokeJobResponse.ProtoReflect.Descriptor instead.
 func (*RevokeJobResponse) Descriptor() ([]byte, []int) {
-	return file_pkg_noderpc_proto_feeds_manager_proto_rawDescGZIP(), []int{21}
+	return file_feeds_manager_proto_rawDescGZIP(), []int{21}
 }
 
 func (x *RevokeJobResponse) GetId() string {
@@ -1386,6 +1394,83 @@ func (x *RevokeJobResponse) GetId() string {
 	return ""
 }
 
+// The result of the validation of a proposed spec by the node, before it is
+// approved
+type DryRunResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Whether the job can be created from the spec on the node
+	Valid bool `protobuf:"varint,1,opt,name=valid,proto3" json:"valid,omitempty"`
+	// The reason the spec is invalid
+	Error string `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+	// Whether the spec was approved by an approval policy of the node
+	AutoApproved bool `protobuf:"varint,3,opt,name=auto_approved,json=autoApproved,proto3" json:"auto_approved,omitempty"`
+	// The name of the policy which approved the spec
+	Policy string `protobuf:"bytes,4,opt,name=policy,proto3" json:"policy,omitempty"`
+}
+
+func (x *DryRunResult) Reset() {
+	*x = DryRunResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_feeds_manager_proto_msgTypes[22]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DryRunResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DryRunResult) ProtoMessage() {}
+
+func (x *DryRunResult) ProtoReflect() protoreflect.Message {
+	mi := &file_feeds_manager_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DryRunResult.ProtoReflect.Descriptor instead.
+func (*DryRunResult) Descriptor() ([]byte, []int) {
+	return file_feeds_manager_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *DryRunResult) GetValid() bool {
+	if x != nil {
+		return x.Valid
+	}
+	return false
+}
+
+func (x *DryRunResult) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *DryRunResult) GetAutoApproved() bool {
+	if x != nil {
+		return x.AutoApproved
+	}
+	return false
+}
+
+func (x *DryRunResult) GetPolicy() string {
+	if x != nil {
+		return x.Policy
+	}
+	return ""
+}
+
 type OCR1Config_P2PKeyBundle struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -1398,7 +1483,7 @@ type OCR1Config_P2PKeyBundle struct {
 func (x *OCR1Config_P2PKeyBundle) Reset() {
 	*x = OCR1Config_P2PKeyBundle{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_noderpc_proto_feeds_manager_proto_msgTypes[22]
+		mi := &file_feeds_manager_proto_msgTypes[23]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1411,7 +1496,7 @@ func (x *OCR1Config_P2PKeyBundle) String() string {
 func (*OCR1Config_P2PKeyBundle) ProtoMessage() {}
 
 func (x *OCR1Config_P2PKeyBundle) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_noderpc_proto_feeds_manager_proto_msgTypes[22]
+	mi := &file_feeds_manager_proto_msgTypes[23]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1424,7 +1509,7 @@ func (x *OCR1Config_P2PKeyBundle) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use OCR1Config_P2PKeyBundle.ProtoReflect.Descriptor instead.
 func (*OCR1Config_P2PKeyBundle) Descriptor() ([]byte, []int) {
-	return file_pkg_noderpc_proto_feeds_manager_proto_rawDescGZIP(), []int{3, 0}
+	return file_feeds_manager_proto_rawDescGZIP(), []int{3, 0}
 }
 
 func (x *OCR1Config_P2PKeyBundle) GetPeerId() string {
@@ -1455,7 +1540,7 @@ type OCR1Config_OCRKeyBundle struct {
 func (x *OCR1Config_OCRKeyBundle) Reset() {
 	*x = OCR1Config_OCRKeyBundle{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_noderpc_proto_feeds_manager_proto_msgTypes[23]
+		mi := &file_feeds_manager_proto_msgTypes[24]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1468,7 +1553,7 @@ func (x *OCR1Config_OCRKeyBundle) String() string {
 func (*OCR1Config_OCRKeyBundle) ProtoMessage() {}
 
 func (x *OCR1Config_OCRKeyBundle) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_noderpc_proto_feeds_manager_proto_msgTypes[23]
+	mi := &file_feeds_manager_proto_msgTypes[24]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1481,7 +1566,7 @@ func (x *OCR1Config_OCRKeyBundle) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use OCR1Config_OCRKeyBundle.ProtoReflect.Descriptor instead.
 func (*OCR1Config_OCRKeyBundle) Descriptor() ([]byte, []int) {
-	return file_pkg_noderpc_proto_feeds_manager_proto_rawDescGZIP(), []int{3, 1}
+	return file_feeds_manager_proto_rawDescGZIP(), []int{3, 1}
 }
 
 func (x *OCR1Config_OCRKeyBundle) GetBundleId() string {
@@ -1524,7 +1609,7 @@ type OCR2Config_P2PKeyBundle struct {
 func (x *OCR2Config_P2PKeyBundle) Reset() {
 	*x = OCR2Config_P2PKeyBundle{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_noderpc_proto_feeds_manager_proto_msgTypes[24]
+		mi := &file_feeds_manager_proto_msgTypes[25]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1537,7 +1622,7 @@ func (x *OCR2Config_P2PKeyBundle) String() string {
 func (*OCR2Config_P2PKeyBundle) ProtoMessage() {}
 
 func (x *OCR2Config_P2PKeyBundle) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_noderpc_proto_feeds_manager_proto_msgTypes[24]
+	mi := &file_feeds_manager_proto_msgTypes[25]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1550,7 +1635,7 @@ func (x *OCR2Config_P2PKeyBundle) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use OCR2Config_P2PKeyBundle.ProtoReflect.Descriptor instead.
 func (*OCR2Config_P2PKeyBundle) Descriptor() ([]byte, []int) {
-	return file_pkg_noderpc_proto_feeds_manager_proto_rawDescGZIP(), []int{4, 0}
+	return file_feeds_manager_proto_rawDescGZIP(), []int{4, 0}
 }
 
 func (x *OCR2Config_P2PKeyBundle) GetPeerId() string {
@@ -1581,7 +1666,7 @@ type OCR2Config_OCRKeyBundle struct {
 func (x *OCR2Config_OCRKeyBundle) Reset() {
 	*x = OCR2Config_OCRKeyBundle{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_noderpc_proto_feeds_manager_proto_msgTypes[25]
+		mi := &file_feeds_manager_proto_msgTypes[26]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1594,7 +1679,7 @@ func (x *OCR2Config_OCRKeyBundle) String() string {
 func (*OCR2Config_OCRKeyBundle) ProtoMessage() {}
 
 func (x *OCR2Config_OCRKeyBundle) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_noderpc_proto_feeds_manager_proto_msgTypes[25]
+	mi := &file_feeds_manager_proto_msgTypes[26]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1607,7 +1692,7 @@ func (x *OCR2Config_OCRKeyBundle) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use OCR2Config_OCRKeyBundle.ProtoReflect.Descriptor instead.
 func (*OCR2Config_OCRKeyBundle) Descriptor() ([]byte, []int) {
-	return file_pkg_noderpc_proto_feeds_manager_proto_rawDescGZIP(), []int{4, 1}
+	return file_feeds_manager_proto_rawDescGZIP(), []int{4, 1}
 }
 
 func (x *OCR2Config_OCRKeyBundle) GetBundleId() string {
@@ -1653,7 +1738,7 @@ type OCR2Config_Plugins struct {
 func (x *OCR2Config_Plugins) Reset() {
 	*x = OCR2Config_Plugins{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_noderpc_proto_feeds_manager_proto_msgTypes[26]
+		mi := &file_feeds_manager_proto_msgTypes[27]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1666,7 +1751,7 @@ func (x *OCR2Config_Plugins) String() string {
 func (*OCR2Config_Plugins) ProtoMessage() {}
 
 func (x *OCR2Config_Plugins) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_noderpc_proto_feeds_manager_proto_msgTypes[26]
+	mi := &file_feeds_manager_proto_msgTypes[27]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1679,7 +1764,7 @@ func (x *OCR2Config_Plugins) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use OCR2Config_Plugins.ProtoReflect.Descriptor instead.
 func (*OCR2Config_Plugins) Descriptor() ([]byte, []int) {
-	return file_pkg_noderpc_proto_feeds_manager_proto_rawDescGZIP(), []int{4, 2}
+	return file_feeds_manager_proto_rawDescGZIP(), []int{4, 2}
 }
 
 func (x *OCR2Config_Plugins) GetCommit() bool {
@@ -1717,197 +1802,207 @@ func (x *OCR2Config_Plugins) GetRebalancer() bool {
 	return false
 }
 
-var File_pkg_noderpc_proto_feeds_manager_proto protoreflect.FileDescriptor
-
-var file_pkg_noderpc_proto_feeds_manager_proto_rawDesc = []byte{
-	0x0a, 0x25, 0x70, 0x6b, 0x67, 0x2f, 0x6e, 0x6f, 0x64, 0x65, 0x72, 0x70, 0x63, 0x2f, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x2f, 0x66, 0x65, 0x65, 0x64, 0x73, 0x5f, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65,
-	0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x03, 0x63, 0x66, 0x6d, 0x22, 0x3b, 0x0a, 0x05,
-	0x43, 0x68, 0x61, 0x69, 0x6e, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x22, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x0e, 0x32, 0x0e, 0x2e, 0x63, 0x66, 0x6d, 0x2e, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x54,
-	0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x22, 0x6d, 0x0a, 0x07, 0x41, 0x63, 0x63,
-	0x6f, 0x75, 0x6e, 0x74, 0x12, 0x2d, 0x0a, 0x0a, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x5f, 0x74, 0x79,
-	0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0e, 0x2e, 0x63, 0x66, 0x6d, 0x2e, 0x43,
-	0x68, 0x61, 0x69, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x52, 0x09, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x54,
-	0x79, 0x70, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x5f, 0x69, 0x64, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x49, 0x64, 0x12, 0x18,
-	0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x22, 0x2d, 0x0a, 0x11, 0x46, 0x6c, 0x75, 0x78,
-	0x4d, 0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x18, 0x0a,
-	0x07, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07,
-	0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x22, 0xf9, 0x03, 0x0a, 0x0a, 0x4f, 0x43, 0x52, 0x31,
-	0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x18, 0x0a, 0x07, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65,
-	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64,
-	0x12, 0x21, 0x0a, 0x0c, 0x69, 0x73, 0x5f, 0x62, 0x6f, 0x6f, 0x74, 0x73, 0x74, 0x72, 0x61, 0x70,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x69, 0x73, 0x42, 0x6f, 0x6f, 0x74, 0x73, 0x74,
-	0x72, 0x61, 0x70, 0x12, 0x42, 0x0a, 0x0e, 0x70, 0x32, 0x70, 0x5f, 0x6b, 0x65, 0x79, 0x5f, 0x62,
-	0x75, 0x6e, 0x64, 0x6c, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x63, 0x66,
-	0x6d, 0x2e, 0x4f, 0x43, 0x52, 0x31, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x50, 0x32, 0x50,
-	0x4b, 0x65, 0x79, 0x42, 0x75, 0x6e, 0x64, 0x6c, 0x65, 0x52, 0x0c, 0x70, 0x32, 0x70, 0x4b, 0x65,
-	0x79, 0x42, 0x75, 0x6e, 0x64, 0x6c, 0x65, 0x12, 0x42, 0x0a, 0x0e, 0x6f, 0x63, 0x72, 0x5f, 0x6b,
-	0x65, 0x79, 0x5f, 0x62, 0x75, 0x6e, 0x64, 0x6c, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x1c, 0x2e, 0x63, 0x66, 0x6d, 0x2e, 0x4f, 0x43, 0x52, 0x31, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67,
-	0x2e, 0x4f, 0x43, 0x52, 0x4b, 0x65, 0x79, 0x42, 0x75, 0x6e, 0x64, 0x6c, 0x65, 0x52, 0x0c, 0x6f,
-	0x63, 0x72, 0x4b, 0x65, 0x79, 0x42, 0x75, 0x6e, 0x64, 0x6c, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x6d,
-	0x75, 0x6c, 0x74, 0x69, 0x61, 0x64, 0x64, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
-	0x6d, 0x75, 0x6c, 0x74, 0x69, 0x61, 0x64, 0x64, 0x72, 0x1a, 0x46, 0x0a, 0x0c, 0x50, 0x32, 0x50,
-	0x4b, 0x65, 0x79, 0x42, 0x75, 0x6e, 0x64, 0x6c, 0x65, 0x12, 0x17, 0x0a, 0x07, 0x70, 0x65, 0x65,
-	0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x70, 0x65, 0x65, 0x72,
-	0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x5f, 0x6b, 0x65, 0x79,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x4b, 0x65,
-	0x79, 0x1a, 0xbf, 0x01, 0x0a, 0x0c, 0x4f, 0x43, 0x52, 0x4b, 0x65, 0x79, 0x42, 0x75, 0x6e, 0x64,
-	0x6c, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x62, 0x75, 0x6e, 0x64, 0x6c, 0x65, 0x5f, 0x69, 0x64, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x62, 0x75, 0x6e, 0x64, 0x6c, 0x65, 0x49, 0x64, 0x12,
-	0x2a, 0x0a, 0x11, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x5f, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63,
-	0x5f, 0x6b, 0x65, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x63, 0x6f, 0x6e, 0x66,
-	0x69, 0x67, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x4b, 0x65, 0x79, 0x12, 0x2e, 0x0a, 0x13, 0x6f,
-	0x66, 0x66, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x5f, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x5f, 0x6b,
-	0x65, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x6f, 0x66, 0x66, 0x63, 0x68, 0x61,
-	0x69, 0x6e, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x4b, 0x65, 0x79, 0x12, 0x36, 0x0a, 0x17, 0x6f,
-	0x6e, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x5f, 0x73, 0x69, 0x67, 0x6e, 0x69, 0x6e, 0x67, 0x5f, 0x61,
-	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x15, 0x6f, 0x6e,
-	0x63, 0x68, 0x61, 0x69, 0x6e, 0x53, 0x69, 0x67, 0x6e, 0x69, 0x6e, 0x67, 0x41, 0x64, 0x64, 0x72,
-	0x65, 0x73, 0x73, 0x22, 0x84, 0x06, 0x0a, 0x0a, 0x4f, 0x43, 0x52, 0x32, 0x43, 0x6f, 0x6e, 0x66,
-	0x69, 0x67, 0x12, 0x18, 0x0a, 0x07, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x08, 0x52, 0x07, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x12, 0x21, 0x0a, 0x0c,
-	0x69, 0x73, 0x5f, 0x62, 0x6f, 0x6f, 0x74, 0x73, 0x74, 0x72, 0x61, 0x70, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x08, 0x52, 0x0b, 0x69, 0x73, 0x42, 0x6f, 0x6f, 0x74, 0x73, 0x74, 0x72, 0x61, 0x70, 0x12,
-	0x42, 0x0a, 0x0e, 0x70, 0x32, 0x70, 0x5f, 0x6b, 0x65, 0x79, 0x5f, 0x62, 0x75, 0x6e, 0x64, 0x6c,
-	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x63, 0x66, 0x6d, 0x2e, 0x4f, 0x43,
-	0x52, 0x32, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x50, 0x32, 0x50, 0x4b, 0x65, 0x79, 0x42,
-	0x75, 0x6e, 0x64, 0x6c, 0x65, 0x52, 0x0c, 0x70, 0x32, 0x70, 0x4b, 0x65, 0x79, 0x42, 0x75, 0x6e,
-	0x64, 0x6c, 0x65, 0x12, 0x42, 0x0a, 0x0e, 0x6f, 0x63, 0x72, 0x5f, 0x6b, 0x65, 0x79, 0x5f, 0x62,
-	0x75, 0x6e, 0x64, 0x6c, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x63, 0x66,
-	0x6d, 0x2e, 0x4f, 0x43, 0x52, 0x32, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x4f, 0x43, 0x52,
-	0x4b, 0x65, 0x79, 0x42, 0x75, 0x6e, 0x64, 0x6c, 0x65, 0x52, 0x0c, 0x6f, 0x63, 0x72, 0x4b, 0x65,
-	0x79, 0x42, 0x75, 0x6e, 0x64, 0x6c, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x6d, 0x75, 0x6c, 0x74, 0x69,
-	0x61, 0x64, 0x64, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6d, 0x75, 0x6c, 0x74,
-	0x69, 0x61, 0x64, 0x64, 0x72, 0x12, 0x31, 0x0a, 0x07, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x73,
-	0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x63, 0x66, 0x6d, 0x2e, 0x4f, 0x43, 0x52,
-	0x32, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x50, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x73, 0x52,
-	0x07, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x73, 0x12, 0x30, 0x0a, 0x11, 0x66, 0x6f, 0x72, 0x77,
-	0x61, 0x72, 0x64, 0x65, 0x72, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x07, 0x20,
-	0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x10, 0x66, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x65, 0x72,
-	0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x88, 0x01, 0x01, 0x1a, 0x46, 0x0a, 0x0c, 0x50, 0x32,
-	0x50, 0x4b, 0x65, 0x79, 0x42, 0x75, 0x6e, 0x64, 0x6c, 0x65, 0x12, 0x17, 0x0a, 0x07, 0x70, 0x65,
-	0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x70, 0x65, 0x65,
-	0x72, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x5f, 0x6b, 0x65,
-	0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x4b,
-	0x65, 0x79, 0x1a, 0xbf, 0x01, 0x0a, 0x0c, 0x4f, 0x43, 0x52, 0x4b, 0x65, 0x79, 0x42, 0x75, 0x6e,
-	0x64, 0x6c, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x62, 0x75, 0x6e, 0x64, 0x6c, 0x65, 0x5f, 0x69, 0x64,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x62, 0x75, 0x6e, 0x64, 0x6c, 0x65, 0x49, 0x64,
-	0x12, 0x2a, 0x0a, 0x11, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x5f, 0x70, 0x75, 0x62, 0x6c, 0x69,
-	0x63, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x63, 0x6f, 0x6e,
-	0x66, 0x69, 0x67, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x4b, 0x65, 0x79, 0x12, 0x2e, 0x0a, 0x13,
-	0x6f, 0x66, 0x66, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x5f, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x5f,
-	0x6b, 0x65, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x6f, 0x66, 0x66, 0x63, 0x68,
-	0x61, 0x69, 0x6e, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x4b, 0x65, 0x79, 0x12, 0x36, 0x0a, 0x17,
-	0x6f, 0x6e, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x5f, 0x73, 0x69, 0x67, 0x6e, 0x69, 0x6e, 0x67, 0x5f,
-	0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x15, 0x6f,
-	0x6e, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x53, 0x69, 0x67, 0x6e, 0x69, 0x6e, 0x67, 0x41, 0x64, 0x64,
-	0x72, 0x65, 0x73, 0x73, 0x1a, 0x8d, 0x01, 0x0a, 0x07, 0x50, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x73,
-	0x12, 0x16, 0x0a, 0x06, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08,
-	0x52, 0x06, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x65, 0x78, 0x65, 0x63,
-	0x75, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x65, 0x78, 0x65, 0x63, 0x75,
-	0x74, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x6e, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x08, 0x52, 0x06, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65,
-	0x72, 0x63, 0x75, 0x72, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x6d, 0x65, 0x72,
-	0x63, 0x75, 0x72, 0x79, 0x12, 0x1e, 0x0a, 0x0a, 0x72, 0x65, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63,
-	0x65, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x72, 0x65, 0x62, 0x61, 0x6c, 0x61,
-	0x6e, 0x63, 0x65, 0x72, 0x42, 0x14, 0x0a, 0x12, 0x5f, 0x66, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64,
-	0x65, 0x72, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x22, 0x8a, 0x03, 0x0a, 0x0b, 0x43,
-	0x68, 0x61, 0x69, 0x6e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x20, 0x0a, 0x05, 0x63, 0x68,
-	0x61, 0x69, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x63, 0x66, 0x6d, 0x2e,
-	0x43, 0x68, 0x61, 0x69, 0x6e, 0x52, 0x05, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x12, 0x27, 0x0a, 0x0f,
-	0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x41, 0x64,
-	0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x23, 0x0a, 0x0d, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x5f, 0x61,
-	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x61, 0x64,
-	0x6d, 0x69, 0x6e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x46, 0x0a, 0x13, 0x66, 0x6c,
-	0x75, 0x78, 0x5f, 0x6d, 0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69,
-	0x67, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x63, 0x66, 0x6d, 0x2e, 0x46, 0x6c,
-	0x75, 0x78, 0x4d, 0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52,
-	0x11, 0x66, 0x6c, 0x75, 0x78, 0x4d, 0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x43, 0x6f, 0x6e, 0x66,
-	0x69, 0x67, 0x12, 0x30, 0x0a, 0x0b, 0x6f, 0x63, 0x72, 0x31, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69,
-	0x67, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x63, 0x66, 0x6d, 0x2e, 0x4f, 0x43,
-	0x52, 0x31, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x0a, 0x6f, 0x63, 0x72, 0x31, 0x43, 0x6f,
-	0x6e, 0x66, 0x69, 0x67, 0x12, 0x30, 0x0a, 0x0b, 0x6f, 0x63, 0x72, 0x32, 0x5f, 0x63, 0x6f, 0x6e,
-	0x66, 0x69, 0x67, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x63, 0x66, 0x6d, 0x2e,
-	0x4f, 0x43, 0x52, 0x32, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x0a, 0x6f, 0x63, 0x72, 0x32,
-	0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x40, 0x0a, 0x1a, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e,
-	0x74, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x5f, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63,
-	0x5f, 0x6b, 0x65, 0x79, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x17, 0x61, 0x63,
-	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x50, 0x75, 0x62, 0x6c,
-	0x69, 0x63, 0x4b, 0x65, 0x79, 0x88, 0x01, 0x01, 0x42, 0x1d, 0x0a, 0x1b, 0x5f, 0x61, 0x63, 0x63,
-	0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x5f, 0x70, 0x75, 0x62,
-	0x6c, 0x69, 0x63, 0x5f, 0x6b, 0x65, 0x79, 0x22, 0x9f, 0x03, 0x0a, 0x11, 0x55, 0x70, 0x64, 0x61,
-	0x74, 0x65, 0x4e, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x29, 0x0a,
-	0x09, 0x6a, 0x6f, 0x62, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0e,
-	0x32, 0x0c, 0x2e, 0x63, 0x66, 0x6d, 0x2e, 0x4a, 0x6f, 0x62, 0x54, 0x79, 0x70, 0x65, 0x52, 0x08,
-	0x6a, 0x6f, 0x62, 0x54, 0x79, 0x70, 0x65, 0x73, 0x12, 0x19, 0x0a, 0x08, 0x63, 0x68, 0x61, 0x69,
-	0x6e, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x63, 0x68, 0x61, 0x69,
-	0x6e, 0x49, 0x64, 0x12, 0x2b, 0x0a, 0x11, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x61,
-	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x10,
-	0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x65, 0x73,
-	0x12, 0x2a, 0x0a, 0x11, 0x69, 0x73, 0x5f, 0x62, 0x6f, 0x6f, 0x74, 0x73, 0x74, 0x72, 0x61, 0x70,
-	0x5f, 0x70, 0x65, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0f, 0x69, 0x73, 0x42,
-	0x6f, 0x6f, 0x74, 0x73, 0x74, 0x72, 0x61, 0x70, 0x50, 0x65, 0x65, 0x72, 0x12, 0x2f, 0x0a, 0x13,
-	0x62, 0x6f, 0x6f, 0x74, 0x73, 0x74, 0x72, 0x61, 0x70, 0x5f, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x61,
-	0x64, 0x64, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x12, 0x62, 0x6f, 0x6f, 0x74, 0x73,
-	0x74, 0x72, 0x61, 0x70, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x61, 0x64, 0x64, 0x72, 0x12, 0x18, 0x0a,
-	0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
-	0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x1b, 0x0a, 0x09, 0x63, 0x68, 0x61, 0x69, 0x6e,
-	0x5f, 0x69, 0x64, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x03, 0x52, 0x08, 0x63, 0x68, 0x61, 0x69,
-	0x6e, 0x49, 0x64, 0x73, 0x12, 0x28, 0x0a, 0x08, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73,
-	0x18, 0x08, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x63, 0x66, 0x6d, 0x2e, 0x41, 0x63, 0x63,
-	0x6f, 0x75, 0x6e, 0x74, 0x52, 0x08, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x12, 0x22,
-	0x0a, 0x06, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x73, 0x18, 0x09, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0a,
-	0x2e, 0x63, 0x66, 0x6d, 0x2e, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x52, 0x06, 0x63, 0x68, 0x61, 0x69,
-	0x6e, 0x73, 0x12, 0x35, 0x0a, 0x0d, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x5f, 0x63, 0x6f, 0x6e, 0x66,
-	0x69, 0x67, 0x73, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x63, 0x66, 0x6d, 0x2e,
-	0x43, 0x68, 0x61, 0x69, 0x6e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x0c, 0x63, 0x68, 0x61,
-	0x69, 0x6e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x73, 0x22, 0x14, 0x0a, 0x12, 0x55, 0x70, 0x64,
-	0x61, 0x74, 0x65, 0x4e, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
-	0x42, 0x0a, 0x12, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x64, 0x4a, 0x6f, 0x62, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x75, 0x75, 0x69, 0x64, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x04, 0x75, 0x75, 0x69, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72,
-	0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73,
-	0x69, 0x6f, 0x6e, 0x22, 0x15, 0x0a, 0x13, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x64, 0x4a,
-	0x6f, 0x62, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x14, 0x0a, 0x12, 0x48, 0x65,
-	0x61, 0x6c, 0x74, 0x68, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x22, 0x15, 0x0a, 0x13, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x42, 0x0a, 0x12, 0x52, 0x65, 0x6a, 0x65, 0x63,
-	0x74, 0x65, 0x64, 0x4a, 0x6f, 0x62, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a,
-	0x04, 0x75, 0x75, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x75, 0x75, 0x69,
-	0x64, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x03, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x15, 0x0a, 0x13, 0x52,
-	0x65, 0x6a, 0x65, 0x63, 0x74, 0x65, 0x64, 0x4a, 0x6f, 0x62, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x22, 0x43, 0x0a, 0x13, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x6c, 0x65, 0x64, 0x4a,
-	0x6f, 0x62, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x75, 0x75, 0x69,
-	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x75, 0x75, 0x69, 0x64, 0x12, 0x18, 0x0a,
-	0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07,
-	0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x16, 0x0a, 0x14, 0x43, 0x61, 0x6e, 0x63, 0x65,
-	0x6c, 0x6c, 0x65, 0x64, 0x4a, 0x6f, 0x62, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
-	0x71, 0x0a, 0x11, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x65, 0x4a, 0x6f, 0x62, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x70, 0x65, 0x63, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x04, 0x73, 0x70, 0x65, 0x63, 0x12, 0x1e, 0x0a, 0x0a, 0x6d, 0x75, 0x6c, 0x74,
-	0x69, 0x61, 0x64, 0x64, 0x72, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0a, 0x6d, 0x75,
-	0x6c, 0x74, 0x69, 0x61, 0x64, 0x64, 0x72, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73,
-	0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69,
-	0x6f, 0x6e, 0x22, 0x24, 0x0a, 0x12, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x65, 0x4a, 0x6f, 0x62,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0x22, 0x0a, 0x10, 0x44, 0x65, 0x6c, 0x65,
-	0x74, 0x65, 0x4a, 0x6f, 0x62, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02,
+var File_feeds_manager_proto protoreflect.FileDescriptor
+
+var file_feeds_manager_proto_rawDesc = []byte{
+	0x0a, 0x13, 0x66, 0x65, 0x65, 0x64, 0x73, 0x5f, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x03, 0x63, 0x66, 0x6d, 0x22, 0x3b, 0x0a, 0x05, 0x43, 0x68,
+	0x61, 0x69, 0x6e, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x02, 0x69, 0x64, 0x12, 0x22, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x0e, 0x2e, 0x63, 0x66, 0x6d, 0x2e, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x54, 0x79, 0x70,
+	0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x22, 0x6d, 0x0a, 0x07, 0x41, 0x63, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x12, 0x2d, 0x0a, 0x0a, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x5f, 0x74, 0x79, 0x70, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0e, 0x2e, 0x63, 0x66, 0x6d, 0x2e, 0x43, 0x68, 0x61,
+	0x69, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x52, 0x09, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x54, 0x79, 0x70,
+	0x65, 0x12, 0x19, 0x0a, 0x08, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x49, 0x64, 0x12, 0x18, 0x0a, 0x07,
+	0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61,
+	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x22, 0x2d, 0x0a, 0x11, 0x46, 0x6c, 0x75, 0x78, 0x4d, 0x6f,
+	0x6e, 0x69, 0x74, 0x6f, 0x72, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x18, 0x0a, 0x07, 0x65,
+	0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x65, 0x6e,
+	0x61, 0x62, 0x6c, 0x65, 0x64, 0x22, 0xf9, 0x03, 0x0a, 0x0a, 0x4f, 0x43, 0x52, 0x31, 0x43, 0x6f,
+	0x6e, 0x66, 0x69, 0x67, 0x12, 0x18, 0x0a, 0x07, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x12, 0x21,
+	0x0a, 0x0c, 0x69, 0x73, 0x5f, 0x62, 0x6f, 0x6f, 0x74, 0x73, 0x74, 0x72, 0x61, 0x70, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x69, 0x73, 0x42, 0x6f, 0x6f, 0x74, 0x73, 0x74, 0x72, 0x61,
+	0x70, 0x12, 0x42, 0x0a, 0x0e, 0x70, 0x32, 0x70, 0x5f, 0x6b, 0x65, 0x79, 0x5f, 0x62, 0x75, 0x6e,
+	0x64, 0x6c, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x63, 0x66, 0x6d, 0x2e,
+	0x4f, 0x43, 0x52, 0x31, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x50, 0x32, 0x50, 0x4b, 0x65,
+	0x79, 0x42, 0x75, 0x6e, 0x64, 0x6c, 0x65, 0x52, 0x0c, 0x70, 0x32, 0x70, 0x4b, 0x65, 0x79, 0x42,
+	0x75, 0x6e, 0x64, 0x6c, 0x65, 0x12, 0x42, 0x0a, 0x0e, 0x6f, 0x63, 0x72, 0x5f, 0x6b, 0x65, 0x79,
+	0x5f, 0x62, 0x75, 0x6e, 0x64, 0x6c, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e,
+	0x63, 0x66, 0x6d, 0x2e, 0x4f, 0x43, 0x52, 0x31, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x4f,
+	0x43, 0x52, 0x4b, 0x65, 0x79, 0x42, 0x75, 0x6e, 0x64, 0x6c, 0x65, 0x52, 0x0c, 0x6f, 0x63, 0x72,
+	0x4b, 0x65, 0x79, 0x42, 0x75, 0x6e, 0x64, 0x6c, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x6d, 0x75, 0x6c,
+	0x74, 0x69, 0x61, 0x64, 0x64, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6d, 0x75,
+	0x6c, 0x74, 0x69, 0x61, 0x64, 0x64, 0x72, 0x1a, 0x46, 0x0a, 0x0c, 0x50, 0x32, 0x50, 0x4b, 0x65,
+	0x79, 0x42, 0x75, 0x6e, 0x64, 0x6c, 0x65, 0x12, 0x17, 0x0a, 0x07, 0x70, 0x65, 0x65, 0x72, 0x5f,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x70, 0x65, 0x65, 0x72, 0x49, 0x64,
+	0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x4b, 0x65, 0x79, 0x1a,
+	0xbf, 0x01, 0x0a, 0x0c, 0x4f, 0x43, 0x52, 0x4b, 0x65, 0x79, 0x42, 0x75, 0x6e, 0x64, 0x6c, 0x65,
+	0x12, 0x1b, 0x0a, 0x09, 0x62, 0x75, 0x6e, 0x64, 0x6c, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x08, 0x62, 0x75, 0x6e, 0x64, 0x6c, 0x65, 0x49, 0x64, 0x12, 0x2a, 0x0a,
+	0x11, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x5f, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x5f, 0x6b,
+	0x65, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67,
+	0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x4b, 0x65, 0x79, 0x12, 0x2e, 0x0a, 0x13, 0x6f, 0x66, 0x66,
+	0x63, 0x68, 0x61, 0x69, 0x6e, 0x5f, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x5f, 0x6b, 0x65, 0x79,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x6f, 0x66, 0x66, 0x63, 0x68, 0x61, 0x69, 0x6e,
+	0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x4b, 0x65, 0x79, 0x12, 0x36, 0x0a, 0x17, 0x6f, 0x6e, 0x63,
+	0x68, 0x61, 0x69, 0x6e, 0x5f, 0x73, 0x69, 0x67, 0x6e, 0x69, 0x6e, 0x67, 0x5f, 0x61, 0x64, 0x64,
+	0x72, 0x65, 0x73, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x15, 0x6f, 0x6e, 0x63, 0x68,
+	0x61, 0x69, 0x6e, 0x53, 0x69, 0x67, 0x6e, 0x69, 0x6e, 0x67, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73,
+	0x73, 0x22, 0x84, 0x06, 0x0a, 0x0a, 0x4f, 0x43, 0x52, 0x32, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67,
+	0x12, 0x18, 0x0a, 0x07, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x07, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x69, 0x73,
+	0x5f, 0x62, 0x6f, 0x6f, 0x74, 0x73, 0x74, 0x72, 0x61, 0x70, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x0b, 0x69, 0x73, 0x42, 0x6f, 0x6f, 0x74, 0x73, 0x74, 0x72, 0x61, 0x70, 0x12, 0x42, 0x0a,
+	0x0e, 0x70, 0x32, 0x70, 0x5f, 0x6b, 0x65, 0x79, 0x5f, 0x62, 0x75, 0x6e, 0x64, 0x6c, 0x65, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x63, 0x66, 0x6d, 0x2e, 0x4f, 0x43, 0x52, 0x32,
+	0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x50, 0x32, 0x50, 0x4b, 0x65, 0x79, 0x42, 0x75, 0x6e,
+	0x64, 0x6c, 0x65, 0x52, 0x0c, 0x70, 0x32, 0x70, 0x4b, 0x65, 0x79, 0x42, 0x75, 0x6e, 0x64, 0x6c,
+	0x65, 0x12, 0x42, 0x0a, 0x0e, 0x6f, 0x63, 0x72, 0x5f, 0x6b, 0x65, 0x79, 0x5f, 0x62, 0x75, 0x6e,
+	0x64, 0x6c, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x63, 0x66, 0x6d, 0x2e,
+	0x4f, 0x43, 0x52, 0x32, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x4f, 0x43, 0x52, 0x4b, 0x65,
+	0x79, 0x42, 0x75, 0x6e, 0x64, 0x6c, 0x65, 0x52, 0x0c, 0x6f, 0x63, 0x72, 0x4b, 0x65, 0x79, 0x42,
+	0x75, 0x6e, 0x64, 0x6c, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x61, 0x64,
+	0x64, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x61,
+	0x64, 0x64, 0x72, 0x12, 0x31, 0x0a, 0x07, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x73, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x63, 0x66, 0x6d, 0x2e, 0x4f, 0x43, 0x52, 0x32, 0x43,
+	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x50, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x73, 0x52, 0x07, 0x70,
+	0x6c, 0x75, 0x67, 0x69, 0x6e, 0x73, 0x12, 0x30, 0x0a, 0x11, 0x66, 0x6f, 0x72, 0x77, 0x61, 0x72,
+	0x64, 0x65, 0x72, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x09, 0x48, 0x00, 0x52, 0x10, 0x66, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x65, 0x72, 0x41, 0x64,
+	0x64, 0x72, 0x65, 0x73, 0x73, 0x88, 0x01, 0x01, 0x1a, 0x46, 0x0a, 0x0c, 0x50, 0x32, 0x50, 0x4b,
+	0x65, 0x79, 0x42, 0x75, 0x6e, 0x64, 0x6c, 0x65, 0x12, 0x17, 0x0a, 0x07, 0x70, 0x65, 0x65, 0x72,
+	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x70, 0x65, 0x65, 0x72, 0x49,
+	0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x5f, 0x6b, 0x65, 0x79, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x4b, 0x65, 0x79,
+	0x1a, 0xbf, 0x01, 0x0a, 0x0c, 0x4f, 0x43, 0x52, 0x4b, 0x65, 0x79, 0x42, 0x75, 0x6e, 0x64, 0x6c,
+	0x65, 0x12, 0x1b, 0x0a, 0x09, 0x62, 0x75, 0x6e, 0x64, 0x6c, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x62, 0x75, 0x6e, 0x64, 0x6c, 0x65, 0x49, 0x64, 0x12, 0x2a,
+	0x0a, 0x11, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x5f, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x5f,
+	0x6b, 0x65, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x63, 0x6f, 0x6e, 0x66, 0x69,
+	0x67, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x4b, 0x65, 0x79, 0x12, 0x2e, 0x0a, 0x13, 0x6f, 0x66,
+	0x66, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x5f, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x5f, 0x6b, 0x65,
+	0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x6f, 0x66, 0x66, 0x63, 0x68, 0x61, 0x69,
+	0x6e, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x4b, 0x65, 0x79, 0x12, 0x36, 0x0a, 0x17, 0x6f, 0x6e,
+	0x63, 0x68, 0x61, 0x69, 0x6e, 0x5f, 0x73, 0x69, 0x67, 0x6e, 0x69, 0x6e, 0x67, 0x5f, 0x61, 0x64,
+	0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x15, 0x6f, 0x6e, 0x63,
+	0x68, 0x61, 0x69, 0x6e, 0x53, 0x69, 0x67, 0x6e, 0x69, 0x6e, 0x67, 0x41, 0x64, 0x64, 0x72, 0x65,
+	0x73, 0x73, 0x1a, 0x8d, 0x01, 0x0a, 0x07, 0x50, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x73, 0x12, 0x16,
+	0x0a, 0x06, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06,
+	0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x65,
+	0x12, 0x16, 0x0a, 0x06, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x06, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x72, 0x63,
+	0x75, 0x72, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x6d, 0x65, 0x72, 0x63, 0x75,
+	0x72, 0x79, 0x12, 0x1e, 0x0a, 0x0a, 0x72, 0x65, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x72,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x72, 0x65, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63,
+	0x65, 0x72, 0x42, 0x14, 0x0a, 0x12, 0x5f, 0x66, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x65, 0x72,
+	0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x22, 0x8a, 0x03, 0x0a, 0x0b, 0x43, 0x68, 0x61,
+	0x69, 0x6e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x20, 0x0a, 0x05, 0x63, 0x68, 0x61, 0x69,
+	0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x63, 0x66, 0x6d, 0x2e, 0x43, 0x68,
+	0x61, 0x69, 0x6e, 0x52, 0x05, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x12, 0x27, 0x0a, 0x0f, 0x61, 0x63,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0e, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x41, 0x64, 0x64, 0x72,
+	0x65, 0x73, 0x73, 0x12, 0x23, 0x0a, 0x0d, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x5f, 0x61, 0x64, 0x64,
+	0x72, 0x65, 0x73, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x61, 0x64, 0x6d, 0x69,
+	0x6e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x46, 0x0a, 0x13, 0x66, 0x6c, 0x75, 0x78,
+	0x5f, 0x6d, 0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x63, 0x66, 0x6d, 0x2e, 0x46, 0x6c, 0x75, 0x78,
+	0x4d, 0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x11, 0x66,
+	0x6c, 0x75, 0x78, 0x4d, 0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67,
+	0x12, 0x30, 0x0a, 0x0b, 0x6f, 0x63, 0x72, 0x31, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x63, 0x66, 0x6d, 0x2e, 0x4f, 0x43, 0x52, 0x31,
+	0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x0a, 0x6f, 0x63, 0x72, 0x31, 0x43, 0x6f, 0x6e, 0x66,
+	0x69, 0x67, 0x12, 0x30, 0x0a, 0x0b, 0x6f, 0x63, 0x72, 0x32, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69,
+	0x67, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x63, 0x66, 0x6d, 0x2e, 0x4f, 0x43,
+	0x52, 0x32, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x0a, 0x6f, 0x63, 0x72, 0x32, 0x43, 0x6f,
+	0x6e, 0x66, 0x69, 0x67, 0x12, 0x40, 0x0a, 0x1a, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f,
+	0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x5f, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x5f, 0x6b,
+	0x65, 0x79, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x17, 0x61, 0x63, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63,
+	0x4b, 0x65, 0x79, 0x88, 0x01, 0x01, 0x42, 0x1d, 0x0a, 0x1b, 0x5f, 0x61, 0x63, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x5f, 0x70, 0x75, 0x62, 0x6c, 0x69,
+	0x63, 0x5f, 0x6b, 0x65, 0x79, 0x22, 0x9f, 0x03, 0x0a, 0x11, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x4e, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x29, 0x0a, 0x09, 0x6a,
+	0x6f, 0x62, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0e, 0x32, 0x0c,
+	0x2e, 0x63, 0x66, 0x6d, 0x2e, 0x4a, 0x6f, 0x62, 0x54, 0x79, 0x70, 0x65, 0x52, 0x08, 0x6a, 0x6f,
+	0x62, 0x54, 0x79, 0x70, 0x65, 0x73, 0x12, 0x19, 0x0a, 0x08, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x5f,
+	0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x49,
+	0x64, 0x12, 0x2b, 0x0a, 0x11, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x61, 0x64, 0x64,
+	0x72, 0x65, 0x73, 0x73, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x10, 0x61, 0x63,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x65, 0x73, 0x12, 0x2a,
+	0x0a, 0x11, 0x69, 0x73, 0x5f, 0x62, 0x6f, 0x6f, 0x74, 0x73, 0x74, 0x72, 0x61, 0x70, 0x5f, 0x70,
+	0x65, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0f, 0x69, 0x73, 0x42, 0x6f, 0x6f,
+	0x74, 0x73, 0x74, 0x72, 0x61, 0x70, 0x50, 0x65, 0x65, 0x72, 0x12, 0x2f, 0x0a, 0x13, 0x62, 0x6f,
+	0x6f, 0x74, 0x73, 0x74, 0x72, 0x61, 0x70, 0x5f, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x61, 0x64, 0x64,
+	0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x12, 0x62, 0x6f, 0x6f, 0x74, 0x73, 0x74, 0x72,
+	0x61, 0x70, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x61, 0x64, 0x64, 0x72, 0x12, 0x18, 0x0a, 0x07, 0x76,
+	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x76, 0x65,
+	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x1b, 0x0a, 0x09, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x5f, 0x69,
+	0x64, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x03, 0x52, 0x08, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x49,
+	0x64, 0x73, 0x12, 0x28, 0x0a, 0x08, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x18, 0x08,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x63, 0x66, 0x6d, 0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x52, 0x08, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x12, 0x22, 0x0a, 0x06,
+	0x63, 0x68, 0x61, 0x69, 0x6e, 0x73, 0x18, 0x09, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x63,
+	0x66, 0x6d, 0x2e, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x52, 0x06, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x73,
+	0x12, 0x35, 0x0a, 0x0d, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67,
+	0x73, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x63, 0x66, 0x6d, 0x2e, 0x43, 0x68,
+	0x61, 0x69, 0x6e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x0c, 0x63, 0x68, 0x61, 0x69, 0x6e,
+	0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x73, 0x22, 0x14, 0x0a, 0x12, 0x55, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x4e, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x42, 0x0a,
+	0x12, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x64, 0x4a, 0x6f, 0x62, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x75, 0x75, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x75, 0x75, 0x69, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69,
+	0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f,
+	0x6e, 0x22, 0x15, 0x0a, 0x13, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x64, 0x4a, 0x6f, 0x62,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x14, 0x0a, 0x12, 0x48, 0x65, 0x61, 0x6c,
+	0x74, 0x68, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x15,
+	0x0a, 0x13, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x42, 0x0a, 0x12, 0x52, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x65,
+	0x64, 0x4a, 0x6f, 0x62, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x75,
+	0x75, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x75, 0x75, 0x69, 0x64, 0x12,
+	0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x15, 0x0a, 0x13, 0x52, 0x65, 0x6a,
+	0x65, 0x63, 0x74, 0x65, 0x64, 0x4a, 0x6f, 0x62, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x43, 0x0a, 0x13, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x6c, 0x65, 0x64, 0x4a, 0x6f, 0x62,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x75, 0x75, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x75, 0x75, 0x69, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x76,
+	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x76, 0x65,
+	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x16, 0x0a, 0x14, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x6c,
+	0x65, 0x64, 0x4a, 0x6f, 0x62, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x71, 0x0a,
+	0x11, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x65, 0x4a, 0x6f, 0x62, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02,
+	0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x70, 0x65, 0x63, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x73, 0x70, 0x65, 0x63, 0x12, 0x1e, 0x0a, 0x0a, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x61,
+	0x64, 0x64, 0x72, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0a, 0x6d, 0x75, 0x6c, 0x74,
+	0x69, 0x61, 0x64, 0x64, 0x72, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f,
+	0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
+	0x22, 0x5d, 0x0a, 0x12, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x65, 0x4a, 0x6f, 0x62, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x37, 0x0a, 0x0e, 0x64, 0x72, 0x79, 0x5f, 0x72, 0x75,
+	0x6e, 0x5f, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11,
+	0x2e, 0x63, 0x66, 0x6d, 0x2e, 0x44, 0x72, 0x79, 0x52, 0x75, 0x6e, 0x52, 0x65, 0x73, 0x75, 0x6c,
+	0x74, 0x52, 0x0c, 0x64, 0x72, 0x79, 0x52, 0x75, 0x6e, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x22,
+	0x22, 0x0a, 0x10, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4a, 0x6f, 0x62, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x02, 0x69, 0x64, 0x22, 0x23, 0x0a, 0x11, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4a, 0x6f, 0x62,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0x22, 0x0a, 0x10, 0x52, 0x65, 0x76, 0x6f,
+	0x6b, 0x65, 0x4a, 0x6f, 0x62, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02,
 	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0x23, 0x0a, 0x11,
-	0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4a, 0x6f, 0x62, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x4a, 0x6f, 0x62, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
 	0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69,
-	0x64, 0x22, 0x22, 0x0a, 0x10, 0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x4a, 0x6f, 0x62, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0x23, 0x0a, 0x11, 0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x4a,
-	0x6f, 0x62, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x2a, 0x63, 0x0a, 0x07, 0x4a, 0x6f,
+	0x64, 0x22, 0x77, 0x0a, 0x0c, 0x44, 0x72, 0x79, 0x52, 0x75, 0x6e, 0x52, 0x65, 0x73, 0x75, 0x6c,
+	0x74, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x05, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x23, 0x0a,
+	0x0d, 0x61, 0x75, 0x74, 0x6f, 0x5f, 0x61, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x64, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x61, 0x75, 0x74, 0x6f, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76,
+	0x65, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x2a, 0x63, 0x0a, 0x07, 0x4a, 0x6f,
 	0x62, 0x54, 0x79, 0x70, 0x65, 0x12, 0x18, 0x0a, 0x14, 0x4a, 0x4f, 0x42, 0x5f, 0x54, 0x59, 0x50,
 	0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12,
 	0x19, 0x0a, 0x15, 0x4a, 0x4f, 0x42, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x46, 0x4c, 0x55, 0x58,
@@ -1963,20 +2058,20 @@ var file_pkg_noderpc_proto_feeds_manager_proto_rawDesc = []byte{
 }
 
 var (
-	file_pkg_noderpc_proto_feeds_manager_proto_rawDescOnce sync.Once
-	file_pkg_noderpc_proto_feeds_manager_proto_rawDescData = file_pkg_noderpc_proto_feeds_manager_proto_rawDesc
+	file_feeds_manager_proto_rawDescOnce sync.Once
+	file_feeds_manager_proto_rawDescData = file_feeds_manager_proto_rawDesc
 )
 
-func file_pkg_noderpc_proto_feeds_manager_proto_rawDescGZIP() []byte {
-	file_pkg_noderpc_proto_feeds_manager_proto_rawDescOnce.Do(func() {
-		file_pkg_noderpc_proto_feeds_manager_proto_rawDescData = protoimpl.X.CompressGZIP(file_pkg_noderpc_proto_feeds_manager_proto_rawDescData)
+func file_feeds_manager_proto_rawDescGZIP() []byte {
+	file_feeds_manager_proto_rawDescOnce.Do(func() {
+		file_feeds_manager_proto_rawDescData = protoimpl.X.CompressGZIP(file_feeds_manager_proto_rawDescData)
 	})
-	return file_pkg_noderpc_proto_feeds_manager_proto_rawDescData
+	return file_feeds_manager_proto_rawDescData
 }
 
-var file_pkg_noderpc_proto_feeds_manager_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
-var file_pkg_noderpc_proto_feeds_manager_proto_msgTypes = make([]protoimpl.MessageInfo, 27)
-var file_pkg_noderpc_proto_feeds_manager_proto_goTypes = []interface{}{
+var file_feeds_manager_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
+var file_feeds_manager_proto_msgTypes = make([]protoimpl.MessageInfo, 28)
+var file_feeds_manager_proto_goTypes = []interface{}{
 	(JobType)(0),                    // 0: cfm.JobType
 	(ChainType)(0),                  // 1: cfm.ChainType
 	(*Chain)(nil),                   // 2: cfm.Chain
@@ -2001,20 +2096,21 @@ var file_pkg_noderpc_proto_feeds_manager_proto_goTypes = []interface{}{
 	(*DeleteJobResponse)(nil),       // 21: cfm.DeleteJobResponse
 	(*RevokeJobRequest)(nil),        // 22: cfm.RevokeJobRequest
 	(*RevokeJobResponse)(nil),       // 23: cfm.RevokeJobResponse
-	(*OCR1Config_P2PKeyBundle)(nil), // 24: cfm.OCR1Config.P2PKeyBundle
-	(*OCR1Config_OCRKeyBundle)(nil), // 25: cfm.OCR1Config.OCRKeyBundle
-	(*OCR2Config_P2PKeyBundle)(nil), // 26: cfm.OCR2Config.P2PKeyBundle
-	(*OCR2Config_OCRKeyBundle)(nil), // 27: cfm.OCR2Config.OCRKeyBundle
-	(*OCR2Config_Plugins)(nil),      // 28: cfm.OCR2Config.Plugins
-}
-var file_pkg_noderpc_proto_feeds_manager_proto_depIdxs = []int32{
+	(*DryRunResult)(nil),            // 24: cfm.DryRunResult
+	(*OCR1Config_P2PKeyBundle)(nil), // 25: cfm.OCR1Config.P2PKeyBundle
+	(*OCR1Config_OCRKeyBundle)(nil), // 26: cfm.OCR1Config.OCRKeyBundle
+	(*OCR2Config_P2PKeyBundle)(nil), // 27: cfm.OCR2Config.P2PKeyBundle
+	(*OCR2Config_OCRKeyBundle)(nil), // 28: cfm.OCR2Config.OCRKeyBundle
+	(*OCR2Config_Plugins)(nil),      // 29: cfm.OCR2Config.Plugins
+}
+var file_feeds_manager_proto_depIdxs = []int32{
 	1,  // 0: cfm.Chain.type:type_name -> cfm.ChainType
 	1,  // 1: cfm.Account.chain_type:type_name -> cfm.ChainType
-	24, // 2: cfm.OCR1Config.p2p_key_bundle:type_name -> cfm.OCR1Config.P2PKeyBundle
-	25, // 3: cfm.OCR1Config.ocr_key_bundle:type_name -> cfm.OCR1Config.OCRKeyBundle
-	26, // 4: cfm.OCR2Config.p2p_key_bundle:type_name -> cfm.OCR2Config.P2PKeyBundle
-	27, // 5: cfm.OCR2Config.ocr_key_bundle:type_name -> cfm.OCR2Config.OCRKeyBundle
-	28, // 6: cfm.OCR2Config.plugins:type_name -> cfm.OCR2Config.Plugins
+	25, // 2: cfm.OCR1Config.p2p_key_bundle:type_name -> cfm.OCR1Config.P2PKeyBundle
+	26, // 3: cfm.OCR1Config.ocr_key_bundle:type_name -> cfm.OCR1Config.OCRKeyBundle
+	27, // 4: cfm.OCR2Config.p2p_key_bundle:type_name -> cfm.OCR2Config.P2PKeyBundle
+	28, // 5: cfm.OCR2Config.ocr_key_bundle:type_name -> cfm.OCR2Config.OCRKeyBundle
+	29, // 6: cfm.OCR2Config.plugins:type_name -> cfm.OCR2Config.Plugins
 	2,  // 7: cfm.ChainConfig.chain:type_name -> cfm.Chain
 	4,  // 8: cfm.ChainConfig.flux_monitor_config:type_name -> cfm.FluxMonitorConfig
 	5,  // 9: cfm.ChainConfig.ocr1_config:type_name -> cfm.OCR1Config
@@ -2023,36 +2119,37 @@ var file_pkg_noderpc_proto_feeds_manager_proto_depIdxs = []int32{
 	3,  // 12: cfm.UpdateNodeRequest.accounts:type_name -> cfm.Account
 	2,  // 13: cfm.UpdateNodeRequest.chains:type_name -> cfm.Chain
 	7,  // 14: cfm.UpdateNodeRequest.chain_configs:type_name -> cfm.ChainConfig
-	10, // 15: cfm.FeedsManager.ApprovedJob:input_type -> cfm.ApprovedJobRequest
-	12, // 16: cfm.FeedsManager.Healthcheck:input_type -> cfm.HealthcheckRequest
-	8,  // 17: cfm.FeedsManager.UpdateNode:input_type -> cfm.UpdateNodeRequest
-	14, // 18: cfm.FeedsManager.RejectedJob:input_type -> cfm.RejectedJobRequest
-	16, // 19: cfm.FeedsManager.CancelledJob:input_type -> cfm.CancelledJobRequest
-	18, // 20: cfm.NodeService.ProposeJob:input_type -> cfm.ProposeJobRequest
-	20, // 21: cfm.NodeService.DeleteJob:input_type -> cfm.DeleteJobRequest
-	22, // 22: cfm.NodeService.RevokeJob:input_type -> cfm.RevokeJobRequest
-	11, // 23: cfm.FeedsManager.ApprovedJob:output_type -> cfm.ApprovedJobResponse
-	13, // 24: cfm.FeedsManager.Healthcheck:output_type -> cfm.HealthcheckResponse
-	9,  // 25: cfm.FeedsManager.UpdateNode:output_type -> cfm.UpdateNodeResponse
-	15, // 26: cfm.FeedsManager.RejectedJob:output_type -> cfm.RejectedJobResponse
-	17, // 27: cfm.FeedsManager.CancelledJob:output_type -> cfm.CancelledJobResponse
-	19, // 28: cfm.NodeService.ProposeJob:output_type -> cfm.ProposeJobResponse
-	21, // 29: cfm.NodeService.DeleteJob:output_type -> cfm.DeleteJobResponse
-	23, // 30: cfm.NodeService.RevokeJob:output_type -> cfm.RevokeJobResponse
-	23, // [23:31] is the sub-list for method output_type
-	15, // [15:23] is the sub-list for method input_type
-	15, // [15:15] is the sub-list for extension type_name
-	15, // [15:15] is the sub-list for extension extendee
-	0,  // [0:15] is the sub-list for field type_name
-}
-
-func init() { file_pkg_noderpc_proto_feeds_manager_proto_init() }
-func file_pkg_noderpc_proto_feeds_manager_proto_init() {
-	if File_pkg_noderpc_proto_feeds_manager_proto != nil {
+	24, // 15: cfm.ProposeJobResponse.dry_run_result:type_name -> cfm.DryRunResult
+	10, // 16: cfm.FeedsManager.ApprovedJob:input_type -> cfm.ApprovedJobRequest
+	12, // 17: cfm.FeedsManager.Healthcheck:input_type -> cfm.HealthcheckRequest
+	8,  // 18: cfm.FeedsManager.UpdateNode:input_type -> cfm.UpdateNodeRequest
+	14, // 19: cfm.FeedsManager.RejectedJob:input_type -> cfm.RejectedJobRequest
+	16, // 20: cfm.FeedsManager.CancelledJob:input_type -> cfm.CancelledJobRequest
+	18, // 21: cfm.NodeService.ProposeJob:input_type -> cfm.ProposeJobRequest
+	20, // 22: cfm.NodeService.DeleteJob:input_type -> cfm.DeleteJobRequest
+	22, // 23: cfm.NodeService.RevokeJob:input_type -> cfm.RevokeJobRequest
+	11, // 24: cfm.FeedsManager.ApprovedJob:output_type -> cfm.ApprovedJobResponse
+	13, // 25: cfm.FeedsManager.Healthcheck:output_type -> cfm.HealthcheckResponse
+	9,  // 26: cfm.FeedsManager.UpdateNode:output_type -> cfm.UpdateNodeResponse
+	15, // 27: cfm.FeedsManager.RejectedJob:output_type -> cfm.RejectedJobResponse
+	17, // 28: cfm.FeedsManager.CancelledJob:output_type -> cfm.CancelledJobResponse
+	19, // 29: cfm.NodeService.ProposeJob:output_type -> cfm.ProposeJobResponse
+	21, // 30: cfm.NodeService.DeleteJob:output_type -> cfm.DeleteJobResponse
+	23, // 31: cfm.NodeService.RevokeJob:output_type -> cfm.RevokeJobResponse
+	24, // [24:32] is the sub-list for method output_type
+	16, // [16:24] is the sub-list for method input_type
+	16, // [16:16] is the sub-list for extension type_name
+	16, // [16:16] is the sub-list for extension extendee
+	0,  // [0:16] is the sub-list for field type_name
+}
+
+func init() { file_feeds_manager_proto_init() }
+func file_feeds_manager_proto_init() {
+	if File_feeds_manager_proto != nil {
 		return
 	}
 	if !protoimpl.UnsafeEnabled {
-		file_pkg_noderpc_proto_feeds_manager_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+		file_feeds_manager_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*Chain); i {
 			case 0:
 				return &v.state
@@ -2064,7 +2161,7 @@ func file_pkg_noderpc_proto_feeds_manager_proto_init() {
 				return nil
 			}
 		}
-		file_pkg_noderpc_proto_feeds_manager_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+		file_feeds_manager_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*Account); i {
 			case 0:
 				return &v.state
@@ -2076,7 +2173,7 @@ func file_pkg_noderpc_proto_feeds_manager_proto_init() {
 				return nil
 			}
 		}
-		file_pkg_noderpc_proto_feeds_manager_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+		file_feeds_manager_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*FluxMonitorConfig); i {
 			case 0:
 				return &v.state
@@ -2088,7 +2185,7 @@ func file_pkg_noderpc_proto_feeds_manager_proto_init() {
 				return nil
 			}
 		}
-		file_pkg_noderpc_proto_feeds_manager_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+		file_feeds_manager_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*OCR1Config); i {
 			case 0:
 				return &v.state
@@ -2100,7 +2197,7 @@ func file_pkg_noderpc_proto_feeds_manager_proto_init() {
 				return nil
 			}
 		}
-		file_pkg_noderpc_proto_feeds_manager_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+		file_feeds_manager_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*OCR2Config); i {
 			case 0:
 				return &v.state
@@ -2112,7 +2209,7 @@ func file_pkg_noderpc_proto_feeds_manager_proto_init() {
 				return nil
 			}
 		}
-		file_pkg_noderpc_proto_feeds_manager_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+		file_feeds_manager_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ChainConfig); i {
 			case 0:
 				return &v.state
@@ -2124,7 +2221,7 @@ func file_pkg_noderpc_proto_feeds_manager_proto_init() {
 				return nil
 			}
 		}
-		file_pkg_noderpc_proto_feeds_manager_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+		file_feeds_manager_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*UpdateNodeRequest); i {
 			case 0:
 				return &v.state
@@ -2136,7 +2233,7 @@ func file_pkg_noderpc_proto_feeds_manager_proto_init() {
 				return nil
 			}
 		}
-		file_pkg_noderpc_proto_feeds_manager_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+		file_feeds_manager_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*UpdateNodeResponse); i {
 			case 0:
 				return &v.state
@@ -2148,7 +2245,7 @@ func file_pkg_noderpc_proto_feeds_manager_proto_init() {
 				return nil
 			}
 		}
-		file_pkg_noderpc_proto_feeds_manager_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+		file_feeds_manager_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ApprovedJobRequest); i {
 			case 0:
 				return &v.state
@@ -2160,7 +2257,7 @@ func file_pkg_noderpc_proto_feeds_manager_proto_init() {
 				return nil
 			}
 		}
-		file_pkg_noderpc_proto_feeds_manager_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+		file_feeds_manager_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ApprovedJobResponse); i {
 			case 0:
 				return &v.state
@@ -2172,7 +2269,7 @@ func file_pkg_noderpc_proto_feeds_manager_proto_init() {
 				return nil
 			}
 		}
-		file_pkg_noderpc_proto_feeds_manager_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+		file_feeds_manager_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*HealthcheckRequest); i {
 			case 0:
 				return &v.state
@@ -2184,7 +2281,7 @@ func file_pkg_noderpc_proto_feeds_manager_proto_init() {
 				return nil
 			}
 		}
-		file_pkg_noderpc_proto_feeds_manager_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+		file_feeds_manager_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*HealthcheckResponse); i {
 			case 0:
 				return &v.state
@@ -2196,7 +2293,7 @@ func file_pkg_noderpc_proto_feeds_manager_proto_init() {
 				return nil
 			}
 		}
-		file_pkg_noderpc_proto_feeds_manager_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+		file_feeds_manager_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*RejectedJobRequest); i {
 			case 0:
 				return &v.state
@@ -2208,7 +2305,7 @@ func file_pkg_noderpc_proto_feeds_manager_proto_init() {
 				return nil
 			}
 		}
-		file_pkg_noderpc_proto_feeds_manager_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+		file_feeds_manager_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*RejectedJobResponse); i {
 			case 0:
 				return &v.state
@@ -2220,7 +2317,7 @@ func file_pkg_noderpc_proto_feeds_manager_proto_init() {
 				return nil
 			}
 		}
-		file_pkg_noderpc_proto_feeds_manager_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+		file_feeds_manager_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*CancelledJobRequest); i {
 			case 0:
 				return &v.state
@@ -2232,7 +2329,7 @@ func file_pkg_noderpc_proto_feeds_manager_proto_init() {
 				return nil
 			}
 		}
-		file_pkg_noderpc_proto_feeds_manager_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+		file_feeds_manager_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*CancelledJobResponse); i {
 			case 0:
 				return &v.state
@@ -2244,7 +2341,7 @@ func file_pkg_noderpc_proto_feeds_manager_proto_init() {
 				return nil
 			}
 		}
-		file_pkg_noderpc_proto_feeds_manager_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+		file_feeds_manager_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ProposeJobRequest); i {
 			case 0:
 				return &v.state
@@ -2256,7 +2353,7 @@ func file_pkg_noderpc_proto_feeds_manager_proto_init() {
 				return nil
 			}
 		}
-		file_pkg_noderpc_proto_feeds_manager_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+		file_feeds_manager_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ProposeJobResponse); i {
 			case 0:
 				return &v.state
@@ -2268,7 +2365,7 @@ func file_pkg_noderpc_proto_feeds_manager_proto_init() {
 				return nil
 			}
 		}
-		file_pkg_noderpc_proto_feeds_manager_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+		file_feeds_manager_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*DeleteJobRequest); i {
 			case 0:
 				return &v.state
@@ -2280,7 +2377,7 @@ func file_pkg_noderpc_proto_feeds_manager_proto_init() {
 				return nil
 			}
 		}
-		file_pkg_noderpc_proto_feeds_manager_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+		file_feeds_manager_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*DeleteJobResponse); i {
 			case 0:
 				return &v.state
@@ -2292,7 +2389,7 @@ func file_pkg_noderpc_proto_feeds_manager_proto_init() {
 				return nil
 			}
 		}
-		file_pkg_noderpc_proto_feeds_manager_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+		file_feeds_manager_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*RevokeJobRequest); i {
 			case 0:
 				return &v.state
@@ -2304,7 +2401,7 @@ func file_pkg_noderpc_proto_feeds_manager_proto_init() {
 				return nil
 			}
 		}
-		file_pkg_noderpc_proto_feeds_manager_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+		file_feeds_manager_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*RevokeJobResponse); i {
 			case 0:
 				return &v.state
@@ -2316,7 +2413,19 @@ func file_pkg_noderpc_proto_feeds_manager_proto_init() {
 				return nil
 			}
 		}
-		file_pkg_noderpc_proto_feeds_manager_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+		file_feeds_manager_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DryRunResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_feeds_manager_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*OCR1Config_P2PKeyBundle); i {
 			case 0:
 				return &v.state
@@ -2328,7 +2437,7 @@ func file_pkg_noderpc_proto_feeds_manager_proto_init() {
 				return nil
 			}
 		}
-		file_pkg_noderpc_proto_feeds_manager_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+		file_feeds_manager_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*OCR1Config_OCRKeyBundle); i {
 			case 0:
 				return &v.state
@@ -2340,7 +2449,7 @@ func file_pkg_noderpc_proto_feeds_manager_proto_init() {
 				return nil
 			}
 		}
-		file_pkg_noderpc_proto_feeds_manager_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+		file_feeds_manager_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*OCR2Config_P2PKeyBundle); i {
 			case 0:
 				return &v.state
@@ -2352,7 +2461,7 @@ func file_pkg_noderpc_proto_feeds_manager_proto_init() {
 				return nil
 			}
 		}
-		file_pkg_noderpc_proto_feeds_manager_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+		file_feeds_manager_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*OCR2Config_OCRKeyBundle); i {
 			case 0:
 				return &v.state
@@ -2364,7 +2473,7 @@ func file_pkg_noderpc_proto_feeds_manager_proto_init() {
 				return nil
 			}
 		}
-		file_pkg_noderpc_proto_feeds_manager_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+		file_feeds_manager_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*OCR2Config_Plugins); i {
 			case 0:
 				return &v.state
@@ -2377,25 +2486,25 @@ func file_pkg_noderpc_proto_feeds_manager_proto_init() {
 			}
 		}
 	}
-	file_pkg_noderpc_proto_feeds_manager_proto_msgTypes[4].OneofWrappers = []interface{}{}
-	file_pkg_noderpc_proto_feeds_manager_proto_msgTypes[5].OneofWrappers = []interface{}{}
+	file_feeds_manager_proto_msgTypes[4].OneofWrappers = []interface{}{}
+	file_feeds_manager_proto_msgTypes[5].OneofWrappers = []interface{}{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
-			RawDescriptor: file_pkg_noderpc_proto_feeds_manager_proto_rawDesc,
+			RawDescriptor: file_feeds_manager_proto_rawDesc,
 			NumEnums:      2,
-			NumMessages:   27,
+			NumMessages:   28,
 			NumExtensions: 0,
 			NumServices:   2,
 		},
-		GoTypes:           file_pkg_noderpc_proto_feeds_manager_proto_goTypes,
-		DependencyIndexes: file_pkg_noderpc_proto_feeds_manager_proto_depIdxs,
-		EnumInfos:         file_pkg_noderpc_proto_feeds_manager_proto_enumTypes,
-		MessageInfos:      file_pkg_noderpc_proto_feeds_manager_proto_msgTypes,
+		GoTypes:           file_feeds_manager_proto_goTypes,
+		DependencyIndexes: file_feeds_manager_proto_depIdxs,
+		EnumInfos:         file_feeds_manager_proto_enumTypes,
+		MessageInfos:      file_feeds_manager_proto_msgTypes,
 	}.Build()
-	File_pkg_noderpc_proto_feeds_manager_proto = out.File
-	file_pkg_noderpc_proto_feeds_manager_proto_rawDesc = nil
-	file_pkg_noderpc_proto_feeds_manager_proto_goTypes = nil
-	file_pkg_noderpc_proto_feeds_manager_proto_depIdxs = nil
+	File_feeds_manager_proto = out.File
+	file_feeds_manager_proto_rawDesc = nil
+	file_feeds_manager_proto_goTypes = nil
+	file_feeds_manager_proto_depIdxs = nil
 }
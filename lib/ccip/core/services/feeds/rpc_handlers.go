@@ -28,7 +28,7 @@ func (h *RPCHandlers) ProposeJob(ctx context.Context, req *pb.ProposeJobRequest)
 		return nil, err
 	}
 
-	_, err = h.svc.ProposeJob(ctx, &ProposeJobArgs{
+	_, result, err := h.svc.ProposeJob(ctx, &ProposeJobArgs{
 		Spec:           req.GetSpec(),
 		FeedsManagerID: h.feedsManagerID,
 		RemoteUUID:     remoteUUID,
@@ -39,7 +39,7 @@ func (h *RPCHandlers) ProposeJob(ctx context.Context, req *pb.ProposeJobRequest)
 		return nil, err
 	}
 
-	return &pb.ProposeJobResponse{}, nil
+	return &pb.ProposeJobResponse{DryRunResult: result.toProto()}, nil
 }
 
 // DeleteJob deletes a job proposal record.
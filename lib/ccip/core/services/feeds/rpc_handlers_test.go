@@ -50,14 +50,15 @@ func Test_RPCHandlers_ProposeJob(t *testing.T) {
 			Spec:           spec,
 			Version:        int32(version),
 		}).
-		Return(int64(1), nil)
+		Return(int64(1), feeds.DryRunResult{Valid: true}, nil)
 
-	_, err := h.ProposeJob(ctx, &pb.ProposeJobRequest{
+	res, err := h.ProposeJob(ctx, &pb.ProposeJobRequest{
 		Id:      jobID.String(),
 		Spec:    spec,
 		Version: version,
 	})
 	require.NoError(t, err)
+	require.True(t, res.GetDryRunResult().GetValid())
 }
 
 func Test_RPCHandlers_DeleteJob(t *testing.T) {
@@ -94,7 +94,7 @@ type Service interface {
 
 	DeleteJob(ctx context.Context, args *DeleteJobArgs) (int64, error)
 	IsJobManaged(ctx context.Context, jobID int64) (bool, error)
-	ProposeJob(ctx context.Context, args *ProposeJobArgs) (int64, error)
+	ProposeJob(ctx context.Context, args *ProposeJobArgs) (int64, DryRunResult, error)
 	RevokeJob(ctx context.Context, args *RevokeJobArgs) (int64, error)
 	SyncNodeInfo(ctx context.Context, id int64) error
 
@@ -130,6 +130,7 @@ type service struct {
 	jobCfg              JobConfig
 	ocrCfg              OCRConfig
 	ocr2cfg             OCR2Config
+	jdCfg               JobDistributorConfig
 	connMgr             ConnectionsManager
 	legacyChains        legacyevm.LegacyChainContainer
 	lggr                logger.Logger
@@ -150,6 +151,7 @@ func NewService(
 	jobCfg JobConfig,
 	ocrCfg OCRConfig,
 	ocr2Cfg OCR2Config,
+	jdCfg JobDistributorConfig,
 	legacyChains legacyevm.LegacyChainContainer,
 	lggr logger.Logger,
 	version string,
@@ -171,6 +173,7 @@ func NewService(
 		jobCfg:              jobCfg,
 		ocrCfg:              ocrCfg,
 		ocr2cfg:             ocr2Cfg,
+		jdCfg:               jdCfg,
 		connMgr:             newConnectionsManager(lggr),
 		legacyChains:        legacyChains,
 		lggr:                lggr,
@@ -544,20 +547,24 @@ type ProposeJobArgs struct {
 // ProposeJob creates a job proposal if it does not exist. If it already exists
 // and a new version is provided, a new spec is created.
 //
+// The spec is validated against the node with a dry run, and is auto approved
+// when an approval policy allows it. The result is returned to be reported to
+// the feeds manager.
+//
 // The feeds manager id check exists for support of multiple feeds managers in
 // the future so that in the (very slim) off chance that the same uuid is
 // generated by another feeds manager or they maliciously send an existing uuid
 // belonging to another feeds manager, we do not update it.
-func (s *service) ProposeJob(ctx context.Context, args *ProposeJobArgs) (int64, error) {
+func (s *service) ProposeJob(ctx context.Context, args *ProposeJobArgs) (int64, DryRunResult, error) {
 	// Validate the args
 	if err := s.validateProposeJobArgs(ctx, *args); err != nil {
-		return 0, err
+		return 0, DryRunResult{}, err
 	}
 
 	existing, err := s.orm.GetJobProposalByRemoteUUID(ctx, args.RemoteUUID)
 	if err != nil {
 		if !errors.Is(err, sql.ErrNoRows) {
-			return 0, errors.Wrap(err, "failed to check existence of job proposal")
+			return 0, DryRunResult{}, errors.Wrap(err, "failed to check existence of job proposal")
 		}
 	}
 
@@ -566,19 +573,19 @@ func (s *service) ProposeJob(ctx context.Context, args *ProposeJobArgs) (int64,
 		// Ensure that if the job proposal exists, that it belongs to the feeds
 		// manager which previously proposed a job using the remote UUID.
 		if args.FeedsManagerID != existing.FeedsManagerID {
-			return 0, errors.New("cannot update a job proposal belonging to another feeds manager")
+			return 0, DryRunResult{}, errors.New("cannot update a job proposal belonging to another feeds manager")
 		}
 
 		// Check the version being proposed has not been previously proposed.
 		var exists bool
 		exists, err = s.orm.ExistsSpecByJobProposalIDAndVersion(ctx, existing.ID, args.Version)
 		if err != nil {
-			return 0, errors.Wrap(err, "failed to check existence of spec")
+			return 0, DryRunResult{}, errors.Wrap(err, "failed to check existence of spec")
 		}
 
 		if exists {
 			// note: CLO auto-increments the version number on re-proposal, so this should never happen
-			return 0, errors.New("proposed job spec version already exists")
+			return 0, DryRunResult{}, errors.New("proposed job spec version already exists")
 		}
 	}
 
@@ -621,8 +628,20 @@ func (s *service) ProposeJob(ctx context.Context, args *ProposeJobArgs) (int64,
 		return nil
 	})
 	if err != nil {
-		return 0, err
+		return 0, DryRunResult{}, err
+	}
+
+	// Dry run the spec, an invalid spec is still stored for operator review
+	var result DryRunResult
+	proposed, err := s.dryRunSpec(ctx, args.Spec)
+	if err != nil {
+		promJobProposalDryRunFailures.Inc()
+		logger.Warnw("Job proposal spec failed the dry run", "id", id, "err", err)
+		result.Error = err.Error()
+	} else {
+		result.Valid = true
 	}
+
 	// auto approve workflow specs
 	if isWFSpec(logger, args.Spec) {
 		promWorkflowRequests.Inc()
@@ -630,20 +649,25 @@ func (s *service) ProposeJob(ctx context.Context, args *ProposeJobArgs) (int64,
 		if err != nil {
 			promWorkflowFailures.Inc()
 			logger.Errorw("Failed to auto approve workflow spec", "id", id, "err", err)
-			return 0, fmt.Errorf("failed to approve workflow spec %d: %w", id, err)
+			return 0, DryRunResult{}, fmt.Errorf("failed to approve workflow spec %d: %w", id, err)
 		}
 		logger.Infow("Successful workflow spec auto approval", "id", id)
 		promWorkflowApprovals.Inc()
+		result.AutoApproved = true
 	} else {
 		// Track the given job proposal request
 		promJobProposalRequest.Inc()
+
+		if result.Valid {
+			result.Policy, result.AutoApproved = s.autoApproveSpec(ctx, logger.With("id", id), args, id, specID, *proposed)
+		}
 	}
 
 	if err = s.observeJobProposalCounts(ctx); err != nil {
 		logger.Errorw("Failed to push metrics for propose job", "err", err)
 	}
 
-	return id, nil
+	return id, result, nil
 }
 
 func isWFSpec(lggr logger.Logger, spec string) bool {
@@ -1504,8 +1528,8 @@ func (ns NullService) ListJobProposals(ctx context.Context) ([]JobProposal, erro
 func (ns NullService) ListJobProposalsByManagersIDs(ctx context.Context, ids []int64) ([]JobProposal, error) {
 	return nil, ErrFeedsManagerDisabled
 }
-func (ns NullService) ProposeJob(ctx context.Context, args *ProposeJobArgs) (int64, error) {
-	return 0, ErrFeedsManagerDisabled
+func (ns NullService) ProposeJob(ctx context.Context, args *ProposeJobArgs) (int64, DryRunResult, error) {
+	return 0, DryRunResult{}, ErrFeedsManagerDisabled
 }
 func (ns NullService) DeleteJob(ctx context.Context, args *DeleteJobArgs) (int64, error) {
 	return 0, ErrFeedsManagerDisabled
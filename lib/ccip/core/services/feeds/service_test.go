@@ -5,6 +5,7 @@ import (
 	"database/sql"
 	"encoding/hex"
 	"fmt"
+	"strings"
 	"testing"
 	"time"
 
@@ -23,6 +24,7 @@ import (
 	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/types"
 	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/utils/big"
 	"github.com/smartcontractkit/chainlink/v2/core/chains/legacyevm"
+	"github.com/smartcontractkit/chainlink/v2/core/config/toml"
 	"github.com/smartcontractkit/chainlink/v2/core/internal/cltest"
 	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
 	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils/configtest"
@@ -194,7 +196,7 @@ func setupTestServiceCfg(t *testing.T, overrideCfg func(c *chainlink.Config, s *
 	keyStore.On("P2P").Return(p2pKeystore)
 	keyStore.On("OCR").Return(ocr1Keystore)
 	keyStore.On("OCR2").Return(ocr2Keystore)
-	svc := feeds.NewService(orm, jobORM, db, spawner, keyStore, gcfg, gcfg.Feature(), gcfg.Insecure(), gcfg.JobPipeline(), gcfg.OCR(), gcfg.OCR2(), legacyChains, lggr, "1.0.0", nil)
+	svc := feeds.NewService(orm, jobORM, db, spawner, keyStore, gcfg, gcfg.Feature(), gcfg.Insecure(), gcfg.JobPipeline(), gcfg.OCR(), gcfg.OCR2(), gcfg.JobDistributor(), legacyChains, lggr, "1.0.0", nil)
 	svc.SetConnectionsManager(connMgr)
 
 	return &TestService{
@@ -751,6 +753,37 @@ func Test_Service_ProposeJob(t *testing.T) {
 			JobProposalID: idOCR2,
 		}
 
+		// variables for an OCR2 spec update allowed by an approval policy
+		approvedSpecIDOCR2 = int64(103)
+		newSpecIDOCR2      = int64(104)
+		mgrPubKeyHex       = "0f17c3bf72de8beef6e2d17a14c0a972f5d7e0e66e70722373f12b88382d40f9"
+		argsOCR2Update     = &feeds.ProposeJobArgs{
+			FeedsManagerID: 1,
+			RemoteUUID:     remoteUUIDOCR2,
+			Spec:           ocr2Spec,
+			Version:        2,
+		}
+		approvedSpecOCR2 = feeds.JobProposalSpec{
+			ID:            approvedSpecIDOCR2,
+			Definition:    strings.Replace(ocr2Spec, `updateInterval = "1m"`, `updateInterval = "2m"`, 1),
+			Status:        feeds.SpecStatusApproved,
+			Version:       1,
+			JobProposalID: idOCR2,
+		}
+		specOCR2Update = feeds.JobProposalSpec{
+			Definition:    ocr2Spec,
+			Status:        feeds.SpecStatusPending,
+			Version:       argsOCR2Update.Version,
+			JobProposalID: idOCR2,
+		}
+		approvalPolicyOCR2 = toml.AutoApprovalPolicy{
+			Name:             testutils.Ptr("median-cache-interval"),
+			ManagerPublicKey: testutils.Ptr(mgrPubKeyHex),
+			JobType:          testutils.Ptr("offchainreporting2"),
+			PluginType:       testutils.Ptr("median"),
+			AllowedFields:    &[]string{"pluginConfig.juelsPerFeeCoinCache.updateInterval"},
+		}
+
 		idBootstrap         = int64(4)
 		remoteUUIDBootstrap = uuid.New()
 		bootstrapName       = uuid.New()
@@ -817,11 +850,14 @@ func Test_Service_ProposeJob(t *testing.T) {
 	)
 
 	testCases := []struct {
-		name    string
-		args    *feeds.ProposeJobArgs
-		before  func(svc *TestService)
-		wantID  int64
-		wantErr string
+		name          string
+		args          *feeds.ProposeJobArgs
+		before        func(svc *TestService)
+		policies      []toml.AutoApprovalPolicy
+		wantID        int64
+		wantErr       string
+		wantDryRunErr bool
+		wantPolicy    string
 	}{
 		{
 			name: "Auto approve new WF spec",
@@ -829,6 +865,7 @@ func Test_Service_ProposeJob(t *testing.T) {
 				svc.orm.On("GetJobProposalByRemoteUUID", mock.Anything, argsWF.RemoteUUID).Return(new(feeds.JobProposal), sql.ErrNoRows)
 				svc.orm.On("UpsertJobProposal", mock.Anything, &jpWF).Return(proposalIDWF, nil)
 				svc.orm.On("CreateSpec", mock.Anything, proposalSpecWF).Return(jobProposalSpecIdWF, nil)
+				svc.spawner.On("ValidateJob", mock.Anything, mock.Anything).Return(nil).Maybe()
 				svc.orm.On("CountJobProposalsByStatus", mock.Anything).Return(&feeds.JobProposalCounts{}, nil)
 				transactCall := svc.orm.On("Transact", mock.Anything, mock.Anything)
 				transactCall.Run(func(args mock.Arguments) {
@@ -882,6 +919,7 @@ func Test_Service_ProposeJob(t *testing.T) {
 				svc.orm.On("GetJobProposalByRemoteUUID", mock.Anything, argsWF.RemoteUUID).Return(new(feeds.JobProposal), sql.ErrNoRows)
 				svc.orm.On("UpsertJobProposal", mock.Anything, &jpWF).Return(proposalIDWF, nil)
 				svc.orm.On("CreateSpec", mock.Anything, proposalSpecWF).Return(jobProposalSpecIdWF, nil)
+				svc.spawner.On("ValidateJob", mock.Anything, mock.Anything).Return(nil).Maybe()
 				svc.orm.On("CountJobProposalsByStatus", mock.Anything).Return(&feeds.JobProposalCounts{}, nil)
 				transactCall := svc.orm.On("Transact", mock.Anything, mock.Anything)
 				transactCall.Run(func(args mock.Arguments) {
@@ -938,6 +976,7 @@ func Test_Service_ProposeJob(t *testing.T) {
 				svc.orm.On("GetJobProposalByRemoteUUID", mock.Anything, argsWF.RemoteUUID).Return(new(feeds.JobProposal), sql.ErrNoRows)
 				svc.orm.On("UpsertJobProposal", mock.Anything, &jpWF).Return(proposalIDWF, nil)
 				svc.orm.On("CreateSpec", mock.Anything, proposalSpecWF).Return(jobProposalSpecIdWF, nil)
+				svc.spawner.On("ValidateJob", mock.Anything, mock.Anything).Return(nil).Maybe()
 				transactCall := svc.orm.On("Transact", mock.Anything, mock.Anything)
 				transactCall.Run(func(args mock.Arguments) {
 					fn := args[1].(func(orm feeds.ORM) error)
@@ -979,6 +1018,7 @@ func Test_Service_ProposeJob(t *testing.T) {
 				svc.orm.On("GetJobProposalByRemoteUUID", mock.Anything, jpFluxMonitor.RemoteUUID).Return(new(feeds.JobProposal), sql.ErrNoRows)
 				svc.orm.On("UpsertJobProposal", mock.Anything, &jpFluxMonitor).Return(idFluxMonitor, nil)
 				svc.orm.On("CreateSpec", mock.Anything, specFluxMonitor).Return(int64(100), nil)
+				svc.spawner.On("ValidateJob", mock.Anything, mock.Anything).Return(nil).Maybe()
 				svc.orm.On("CountJobProposalsByStatus", mock.Anything).Return(&feeds.JobProposalCounts{}, nil)
 				transactCall := svc.orm.On("Transact", mock.Anything, mock.Anything)
 				transactCall.Run(func(args mock.Arguments) {
@@ -995,6 +1035,7 @@ func Test_Service_ProposeJob(t *testing.T) {
 				svc.orm.On("GetJobProposalByRemoteUUID", mock.Anything, jpOCR1.RemoteUUID).Return(new(feeds.JobProposal), sql.ErrNoRows)
 				svc.orm.On("UpsertJobProposal", mock.Anything, &jpOCR1).Return(idOCR1, nil)
 				svc.orm.On("CreateSpec", mock.Anything, specOCR1).Return(int64(100), nil)
+				svc.spawner.On("ValidateJob", mock.Anything, mock.Anything).Return(nil).Maybe()
 				svc.orm.On("CountJobProposalsByStatus", mock.Anything).Return(&feeds.JobProposalCounts{}, nil)
 				transactCall := svc.orm.On("Transact", mock.Anything, mock.Anything)
 				transactCall.Run(func(args mock.Arguments) {
@@ -1011,6 +1052,7 @@ func Test_Service_ProposeJob(t *testing.T) {
 				svc.orm.On("GetJobProposalByRemoteUUID", mock.Anything, jpOCR2.RemoteUUID).Return(new(feeds.JobProposal), sql.ErrNoRows)
 				svc.orm.On("UpsertJobProposal", mock.Anything, &jpOCR2).Return(idOCR2, nil)
 				svc.orm.On("CreateSpec", mock.Anything, specOCR2).Return(int64(100), nil)
+				svc.spawner.On("ValidateJob", mock.Anything, mock.Anything).Return(nil).Maybe()
 				svc.orm.On("CountJobProposalsByStatus", mock.Anything).Return(&feeds.JobProposalCounts{}, nil)
 				transactCall := svc.orm.On("Transact", mock.Anything, mock.Anything)
 				transactCall.Run(func(args mock.Arguments) {
@@ -1027,6 +1069,7 @@ func Test_Service_ProposeJob(t *testing.T) {
 				svc.orm.On("GetJobProposalByRemoteUUID", mock.Anything, jpBootstrap.RemoteUUID).Return(new(feeds.JobProposal), sql.ErrNoRows)
 				svc.orm.On("UpsertJobProposal", mock.Anything, &jpBootstrap).Return(idBootstrap, nil)
 				svc.orm.On("CreateSpec", mock.Anything, specBootstrap).Return(int64(102), nil)
+				svc.spawner.On("ValidateJob", mock.Anything, mock.Anything).Return(nil).Maybe()
 				svc.orm.On("CountJobProposalsByStatus", mock.Anything).Return(&feeds.JobProposalCounts{}, nil)
 				transactCall := svc.orm.On("Transact", mock.Anything, mock.Anything)
 				transactCall.Run(func(args mock.Arguments) {
@@ -1037,6 +1080,24 @@ func Test_Service_ProposeJob(t *testing.T) {
 			args:   argsBootstrap,
 			wantID: idBootstrap,
 		},
+		{
+			name: "Create success with a failed dry run",
+			before: func(svc *TestService) {
+				svc.orm.On("GetJobProposalByRemoteUUID", mock.Anything, jpOCR2.RemoteUUID).Return(new(feeds.JobProposal), sql.ErrNoRows)
+				svc.orm.On("UpsertJobProposal", mock.Anything, &jpOCR2).Return(idOCR2, nil)
+				svc.orm.On("CreateSpec", mock.Anything, specOCR2).Return(int64(100), nil)
+				svc.spawner.On("ValidateJob", mock.Anything, mock.Anything).Return(errors.New("relay evm is not enabled")).Maybe()
+				svc.orm.On("CountJobProposalsByStatus", mock.Anything).Return(&feeds.JobProposalCounts{}, nil)
+				transactCall := svc.orm.On("Transact", mock.Anything, mock.Anything)
+				transactCall.Run(func(args mock.Arguments) {
+					fn := args[1].(func(orm feeds.ORM) error)
+					transactCall.ReturnArguments = mock.Arguments{fn(svc.orm)}
+				})
+			},
+			args:          argsOCR2,
+			wantID:        idOCR2,
+			wantDryRunErr: true,
+		},
 		{
 			name: "Update success",
 			before: func(svc *TestService) {
@@ -1050,6 +1111,7 @@ func Test_Service_ProposeJob(t *testing.T) {
 				svc.orm.On("ExistsSpecByJobProposalIDAndVersion", mock.Anything, jpFluxMonitor.ID, argsFluxMonitor.Version).Return(false, nil)
 				svc.orm.On("UpsertJobProposal", mock.Anything, &jpFluxMonitor).Return(idFluxMonitor, nil)
 				svc.orm.On("CreateSpec", mock.Anything, specFluxMonitor).Return(int64(100), nil)
+				svc.spawner.On("ValidateJob", mock.Anything, mock.Anything).Return(nil).Maybe()
 				svc.orm.On("CountJobProposalsByStatus", mock.Anything).Return(&feeds.JobProposalCounts{}, nil)
 				transactCall := svc.orm.On("Transact", mock.Anything, mock.Anything)
 				transactCall.Run(func(args mock.Arguments) {
@@ -1060,6 +1122,85 @@ func Test_Service_ProposeJob(t *testing.T) {
 			args:   argsFluxMonitor,
 			wantID: idFluxMonitor,
 		},
+		{
+			name: "Update auto approved by an approval policy (OCR2)",
+			before: func(svc *TestService) {
+				mgrPubKey, err := hex.DecodeString(mgrPubKeyHex)
+				require.NoError(t, err)
+
+				svc.orm.
+					On("GetJobProposalByRemoteUUID", mock.Anything, jpOCR2.RemoteUUID).
+					Return(&feeds.JobProposal{
+						ID:             idOCR2,
+						FeedsManagerID: jpOCR2.FeedsManagerID,
+						RemoteUUID:     jpOCR2.RemoteUUID,
+						Status:         feeds.JobProposalStatusApproved,
+					}, nil)
+				svc.orm.On("ExistsSpecByJobProposalIDAndVersion", mock.Anything, idOCR2, argsOCR2Update.Version).Return(false, nil)
+				svc.orm.On("UpsertJobProposal", mock.Anything, &jpOCR2).Return(idOCR2, nil)
+				svc.orm.On("CreateSpec", mock.Anything, specOCR2Update).Return(newSpecIDOCR2, nil)
+				svc.spawner.On("ValidateJob", mock.Anything, mock.Anything).Return(nil)
+				svc.orm.On("CountJobProposalsByStatus", mock.Anything).Return(&feeds.JobProposalCounts{}, nil)
+				transactCall := svc.orm.On("Transact", mock.Anything, mock.Anything)
+				transactCall.Run(func(args mock.Arguments) {
+					fn := args[1].(func(orm feeds.ORM) error)
+					transactCall.ReturnArguments = mock.Arguments{fn(svc.orm)}
+				})
+
+				// The policy only allows the changed cache interval of the approved spec
+				svc.orm.On("GetManager", mock.Anything, argsOCR2Update.FeedsManagerID).
+					Return(&feeds.FeedsManager{ID: 1, PublicKey: crypto.PublicKey(mgrPubKey)}, nil)
+				svc.orm.On("GetApprovedSpec", mock.Anything, idOCR2).Return(&approvedSpecOCR2, nil)
+
+				// Auto approve is really a call to ApproveSpec, which replaces the running job
+				svc.connMgr.On("GetClient", argsOCR2Update.FeedsManagerID).Return(svc.fmsClient, nil)
+				svc.orm.On("GetSpec", mock.Anything, newSpecIDOCR2).Return(&feeds.JobProposalSpec{
+					ID:            newSpecIDOCR2,
+					Definition:    ocr2Spec,
+					Status:        feeds.SpecStatusPending,
+					Version:       argsOCR2Update.Version,
+					JobProposalID: idOCR2,
+				}, nil)
+				svc.orm.On("GetJobProposal", mock.Anything, idOCR2).Return(&feeds.JobProposal{
+					ID:             idOCR2,
+					FeedsManagerID: jpOCR2.FeedsManagerID,
+					RemoteUUID:     jpOCR2.RemoteUUID,
+					Status:         feeds.JobProposalStatusApproved,
+				}, nil)
+				svc.jobORM.On("AssertBridgesExist", mock.Anything, mock.IsType(pipeline.Pipeline{})).Return(nil)
+				svc.orm.On("WithDataSource", mock.Anything).Return(feeds.ORM(svc.orm))
+				svc.jobORM.On("WithDataSource", mock.Anything).Return(job.ORM(svc.jobORM))
+				svc.jobORM.On("FindJobByExternalJobID", mock.Anything, ocr2NameAndExternalJobID).Return(job.Job{ID: 7}, nil)
+				svc.orm.On("CancelSpec", mock.Anything, approvedSpecIDOCR2).Return(nil)
+				svc.spawner.On("DeleteJob", mock.Anything, mock.Anything, int32(7)).Return(nil)
+				svc.spawner.
+					On("CreateJob",
+						mock.Anything,
+						mock.Anything,
+						mock.MatchedBy(func(j *job.Job) bool {
+							return j.ExternalJobID == ocr2NameAndExternalJobID
+						}),
+					).
+					Run(func(args mock.Arguments) { (args.Get(2).(*job.Job)).ID = 8 }).
+					Return(nil)
+				svc.orm.On("ApproveSpec",
+					mock.Anything,
+					newSpecIDOCR2,
+					ocr2NameAndExternalJobID,
+				).Return(nil)
+				svc.fmsClient.On("ApprovedJob",
+					mock.MatchedBy(func(ctx context.Context) bool { return true }),
+					&proto.ApprovedJobRequest{
+						Uuid:    jpOCR2.RemoteUUID.String(),
+						Version: int64(argsOCR2Update.Version),
+					},
+				).Return(&proto.ApprovedJobResponse{}, nil)
+			},
+			policies:   []toml.AutoApprovalPolicy{approvalPolicyOCR2},
+			args:       argsOCR2Update,
+			wantID:     idOCR2,
+			wantPolicy: "median-cache-interval",
+		},
 		{
 			name:    "contains invalid job spec",
 			args:    &feeds.ProposeJobArgs{},
@@ -1143,12 +1284,13 @@ func Test_Service_ProposeJob(t *testing.T) {
 				c.JobPipeline.HTTPRequest.DefaultTimeout = &httpTimeout
 				c.OCR.Enabled = testutils.Ptr(true)
 				c.OCR2.Enabled = testutils.Ptr(true)
+				c.JobDistributor.AutoApprovalPolicies = tc.policies
 			})
 			if tc.before != nil {
 				tc.before(svc)
 			}
 
-			actual, err := svc.ProposeJob(testutils.Context(t), tc.args)
+			actual, result, err := svc.ProposeJob(testutils.Context(t), tc.args)
 
 			if tc.wantErr != "" {
 				require.Error(t, err)
@@ -1157,6 +1299,16 @@ func Test_Service_ProposeJob(t *testing.T) {
 				require.NoError(t, err)
 				assert.Equal(t, tc.wantID, actual)
 			}
+			if tc.wantDryRunErr {
+				assert.False(t, result.Valid)
+				assert.NotEmpty(t, result.Error)
+				assert.False(t, result.AutoApproved)
+			}
+			if tc.wantPolicy != "" {
+				assert.True(t, result.Valid)
+				assert.True(t, result.AutoApproved)
+				assert.Equal(t, tc.wantPolicy, result.Policy)
+			}
 		})
 	}
 }
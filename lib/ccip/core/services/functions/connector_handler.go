@@ -168,7 +168,7 @@ func (h *functionsConnectorHandler) Close() error {
 
 func (h *functionsConnectorHandler) handleSecretsList(ctx context.Context, gatewayId string, body *api.MessageBody, fromAddr ethCommon.Address) {
 	var response functions.SecretsListResponse
-	snapshot, err := h.storage.List(ctx, fromAddr)
+	snapshot, usage, err := h.storage.List(ctx, fromAddr)
 	if err == nil {
 		response.Success = true
 		response.Rows = make([]functions.SecretsListRow, len(snapshot))
@@ -179,6 +179,14 @@ func (h *functionsConnectorHandler) handleSecretsList(ctx context.Context, gatew
 				Expiration: row.Expiration,
 			}
 		}
+		if usage != nil {
+			response.Usage = &functions.SecretsUsage{
+				SlotsUsed: usage.SlotsUsed,
+				BytesUsed: usage.PayloadBytesUsed,
+				MaxSlots:  usage.Quota.MaxSlots,
+				MaxBytes:  usage.Quota.MaxTotalPayloadBytes,
+			}
+		}
 	} else {
 		response.ErrorMessage = fmt.Sprintf("Failed to list secrets: %v", err)
 	}
@@ -124,18 +124,19 @@ func TestFunctionsConnectorHandler(t *testing.T) {
 				{SlotId: 1, Version: 1, Expiration: 1},
 				{SlotId: 2, Version: 2, Expiration: 2},
 			}
-			storage.On("List", ctx, addr).Return(snapshot, nil).Once()
+			usage := &s4.Usage{SlotsUsed: 1, PayloadBytesUsed: 10, Quota: s4.Quota{MaxSlots: 5, MaxTotalPayloadBytes: 100}}
+			storage.On("List", ctx, addr).Return(snapshot, usage, nil).Once()
 			allowlist.On("Allow", addr).Return(true).Once()
 			connector.On("SendToGateway", ctx, "gw1", mock.Anything).Run(func(args mock.Arguments) {
 				msg, ok := args[2].(*api.Message)
 				require.True(t, ok)
-				require.Equal(t, `{"success":true,"rows":[{"slot_id":1,"version":1,"expiration":1},{"slot_id":2,"version":2,"expiration":2}]}`, string(msg.Body.Payload))
+				require.Equal(t, `{"success":true,"rows":[{"slot_id":1,"version":1,"expiration":1},{"slot_id":2,"version":2,"expiration":2}],"usage":{"slots_used":1,"bytes_used":10,"max_slots":5,"max_bytes":100}}`, string(msg.Body.Payload))
 			}).Return(nil).Once()
 
 			handler.HandleGatewayMessage(ctx, "gw1", &msg)
 
 			t.Run("orm error", func(t *testing.T) {
-				storage.On("List", ctx, addr).Return(nil, errors.New("boom")).Once()
+				storage.On("List", ctx, addr).Return(nil, nil, errors.New("boom")).Once()
 				allowlist.On("Allow", addr).Return(true).Once()
 				connector.On("SendToGateway", ctx, "gw1", mock.Anything).Run(func(args mock.Arguments) {
 					msg, ok := args[2].(*api.Message)
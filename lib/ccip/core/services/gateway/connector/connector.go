@@ -28,6 +28,8 @@ type GatewayConnector interface {
 
 	AddHandler(methods []string, handler GatewayConnectorHandler) error
 	SendToGateway(ctx context.Context, gatewayId string, msg *api.Message) error
+	// SignAndSendToGateway signs the message body with the node's key and sends it to the Gateway.
+	SignAndSendToGateway(ctx context.Context, gatewayId string, msg *api.MessageBody) error
 }
 
 // Signer implementation needs to be provided by a GatewayConnector user (node)
@@ -157,6 +159,18 @@ func (c *gatewayConnector) SendToGateway(ctx context.Context, gatewayId string,
 	return gateway.conn.Write(ctx, websocket.BinaryMessage, data)
 }
 
+func (c *gatewayConnector) SignAndSendToGateway(ctx context.Context, gatewayId string, body *api.MessageBody) error {
+	signature, err := c.signer.Sign(api.GetRawMessageBody(body)...)
+	if err != nil {
+		return err
+	}
+	msg := &api.Message{
+		Body:      *body,
+		Signature: utils.StringToHex(string(signature)),
+	}
+	return c.SendToGateway(ctx, gatewayId, msg)
+}
+
 func (c *gatewayConnector) readLoop(gatewayState *gatewayState) {
 	ctx, cancel := c.shutdownCh.NewCtx()
 	defer cancel()
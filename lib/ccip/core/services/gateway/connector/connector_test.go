@@ -12,7 +12,9 @@ import (
 	"github.com/stretchr/testify/require"
 
 	"github.com/smartcontractkit/chainlink-common/pkg/services/servicetest"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
 	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	"github.com/smartcontractkit/chainlink/v2/core/services/gateway/api"
 	"github.com/smartcontractkit/chainlink/v2/core/services/gateway/connector"
 	"github.com/smartcontractkit/chainlink/v2/core/services/gateway/connector/mocks"
 	"github.com/smartcontractkit/chainlink/v2/core/services/gateway/network"
@@ -194,6 +196,20 @@ func TestGatewayConnector_ChallengeResponse(t *testing.T) {
 	require.Equal(t, network.ErrAuthInvalidGateway, err)
 }
 
+func TestGatewayConnector_SignAndSendToGateway(t *testing.T) {
+	t.Parallel()
+
+	connector, signer, _ := newTestConnector(t, parseTOMLConfig(t, defaultConfig))
+	body := &api.MessageBody{MessageId: "1", Method: testMethod1, DonId: "example_don"}
+
+	signer.On("Sign", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil, errors.New("cannot sign")).Once()
+	require.ErrorContains(t, connector.SignAndSendToGateway(testutils.Context(t), "example_gateway", body), "cannot sign")
+
+	testSignature := make([]byte, api.MessageSignatureLen)
+	signer.On("Sign", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(testSignature, nil).Once()
+	require.ErrorContains(t, connector.SignAndSendToGateway(testutils.Context(t), "unknown_gateway", body), "invalid Gateway ID")
+}
+
 func TestGatewayConnector_AddHandler(t *testing.T) {
 	t.Parallel()
 
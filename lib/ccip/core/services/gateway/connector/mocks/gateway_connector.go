@@ -283,6 +283,54 @@ func (_c *GatewayConnector_SendToGateway_Call) RunAndReturn(run func(context.Con
 	return _c
 }
 
+// SignAndSendToGateway provides a mock function with given fields: ctx, gatewayId, msg
+func (_m *GatewayConnector) SignAndSendToGateway(ctx context.Context, gatewayId string, msg *api.MessageBody) error {
+	ret := _m.Called(ctx, gatewayId, msg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SignAndSendToGateway")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, *api.MessageBody) error); ok {
+		r0 = rf(ctx, gatewayId, msg)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// GatewayConnector_SignAndSendToGateway_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SignAndSendToGateway'
+type GatewayConnector_SignAndSendToGateway_Call struct {
+	*mock.Call
+}
+
+// SignAndSendToGateway is a helper method to define mock.On call
+//   - ctx context.Context
+//   - gatewayId string
+//   - msg *api.MessageBody
+func (_e *GatewayConnector_Expecter) SignAndSendToGateway(ctx interface{}, gatewayId interface{}, msg interface{}) *GatewayConnector_SignAndSendToGateway_Call {
+	return &GatewayConnector_SignAndSendToGateway_Call{Call: _e.mock.On("SignAndSendToGateway", ctx, gatewayId, msg)}
+}
+
+func (_c *GatewayConnector_SignAndSendToGateway_Call) Run(run func(ctx context.Context, gatewayId string, msg *api.MessageBody)) *GatewayConnector_SignAndSendToGateway_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(*api.MessageBody))
+	})
+	return _c
+}
+
+func (_c *GatewayConnector_SignAndSendToGateway_Call) Return(_a0 error) *GatewayConnector_SignAndSendToGateway_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *GatewayConnector_SignAndSendToGateway_Call) RunAndReturn(run func(context.Context, string, *api.MessageBody) error) *GatewayConnector_SignAndSendToGateway_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Start provides a mock function with given fields: _a0
 func (_m *GatewayConnector) Start(_a0 context.Context) error {
 	ret := _m.Called(_a0)
@@ -0,0 +1,83 @@
+package ccip
+
+import (
+	"encoding/json"
+
+	"github.com/smartcontractkit/chainlink/v2/core/services/gateway/api"
+)
+
+const (
+	MethodGetFee        = "ccip_get_fee"
+	MethodLaneStatus    = "ccip_lane_status"
+	MethodMessageStatus = "ccip_message_status"
+	// MethodSend relays a signed ccipSend transaction to the source chain through the DON nodes.
+	MethodSend = "ccip_send"
+)
+
+type TokenAmount struct {
+	Token  string `json:"token"`
+	Amount string `json:"amount"`
+}
+
+type GetFeeRequest struct {
+	SourceChainSelector uint64        `json:"source_chain_selector"`
+	DestChainSelector   uint64        `json:"dest_chain_selector"`
+	Receiver            string        `json:"receiver"`
+	Data                string        `json:"data"`
+	TokenAmounts        []TokenAmount `json:"token_amounts"`
+	FeeToken            string        `json:"fee_token"`
+	ExtraArgs           string        `json:"extra_args"`
+}
+
+type GetFeeResult struct {
+	Fee string `json:"fee"`
+}
+
+type LaneStatusRequest struct {
+	SourceChainSelector uint64 `json:"source_chain_selector"`
+	DestChainSelector   uint64 `json:"dest_chain_selector"`
+}
+
+type LaneStatusResult struct {
+	Enabled bool `json:"enabled"`
+	Cursed  bool `json:"cursed"`
+	// NextSequenceNumber is the sequence number of the next message to be committed on the destination chain.
+	NextSequenceNumber uint64 `json:"next_sequence_number"`
+}
+
+type MessageStatusRequest struct {
+	SourceChainSelector uint64 `json:"source_chain_selector"`
+	DestChainSelector   uint64 `json:"dest_chain_selector"`
+	MessageID           string `json:"message_id"`
+}
+
+type MessageStatusResult struct {
+	// State is one of UNTOUCHED, IN_PROGRESS, SUCCESS or FAILURE.
+	State string `json:"state"`
+}
+
+type SendRequest struct {
+	SourceChainSelector uint64 `json:"source_chain_selector"`
+	// SignedTx is the hex encoded, RLP serialized, signed ccipSend transaction.
+	SignedTx string `json:"signed_tx"`
+}
+
+type SendResult struct {
+	TxHash string `json:"tx_hash"`
+}
+
+// NodeResponse is the payload of a Node -> Gateway response. Result holds one of the *Result types.
+type NodeResponse struct {
+	Success      bool            `json:"success"`
+	ErrorMessage string          `json:"error_message,omitempty"`
+	Result       json.RawMessage `json:"result,omitempty"`
+}
+
+// CombinedResponse is the Gateway -> User response, aggregated from the responses of the DON nodes.
+// On success, Result is the result returned by at least F+1 nodes.
+type CombinedResponse struct {
+	Success       bool            `json:"success"`
+	ErrorMessage  string          `json:"error_message,omitempty"`
+	Result        json.RawMessage `json:"result,omitempty"`
+	NodeResponses []*api.Message  `json:"node_responses"`
+}
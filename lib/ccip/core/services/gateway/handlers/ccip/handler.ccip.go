@@ -0,0 +1,322 @@
+package ccip
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/services"
+	"github.com/smartcontractkit/chainlink-common/pkg/utils/hex"
+
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	"github.com/smartcontractkit/chainlink/v2/core/services/gateway/api"
+	"github.com/smartcontractkit/chainlink/v2/core/services/gateway/config"
+	"github.com/smartcontractkit/chainlink/v2/core/services/gateway/handlers"
+	hc "github.com/smartcontractkit/chainlink/v2/core/services/gateway/handlers/common"
+)
+
+// maxSignedTxLen bounds the size of relayed transactions, hex encoded.
+const maxSignedTxLen = 256 * 1024
+
+var (
+	ErrNotAllowlisted    = errors.New("sender not allowlisted")
+	ErrRateLimited       = errors.New("rate-limited")
+	ErrUnsupportedMethod = errors.New("unsupported method")
+	ErrInvalidRequest    = errors.New("invalid request")
+
+	promHandlerError = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "gateway_ccip_handler_error",
+		Help: "Metric to track ccip handler errors",
+	}, []string{"don_id", "error"})
+
+	promRequestResult = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "gateway_ccip_request_result",
+		Help: "Metric to track the aggregated results of ccip requests",
+	}, []string{"don_id", "method", "success"})
+)
+
+type CCIPHandlerConfig struct {
+	// Not specifying AllowedSenders allows all senders, whose messages are still required to be signed
+	AllowedSenders []string `json:"allowedSenders"`
+	// Not specifying RateLimiter config disables rate limiting
+	UserRateLimiter      *hc.RateLimiterConfig `json:"userRateLimiter"`
+	NodeRateLimiter      *hc.RateLimiterConfig `json:"nodeRateLimiter"`
+	MaxPendingRequests   uint32                `json:"maxPendingRequests"`
+	RequestTimeoutMillis int64                 `json:"requestTimeoutMillis"`
+	// EnableSend enables relaying signed ccipSend transactions through the DON nodes
+	EnableSend bool `json:"enableSend"`
+}
+
+type ccipHandler struct {
+	services.StateMachine
+
+	handlerConfig   CCIPHandlerConfig
+	donConfig       *config.DONConfig
+	don             handlers.DON
+	pendingRequests hc.RequestCache[PendingRequest]
+	allowedSenders  map[string]struct{}
+	userRateLimiter *hc.RateLimiter
+	nodeRateLimiter *hc.RateLimiter
+	lggr            logger.Logger
+}
+
+// PendingRequest aggregates node responses to a user request. Successful results are grouped by value, and the
+// request succeeds once F+1 nodes returned the same result.
+type PendingRequest struct {
+	request   *api.Message
+	responses map[string]*api.Message
+	results   map[string]int
+	errors    []string
+}
+
+var _ handlers.Handler = (*ccipHandler)(nil)
+
+func NewCCIPHandlerFromConfig(handlerConfig json.RawMessage, donConfig *config.DONConfig, don handlers.DON, lggr logger.Logger) (handlers.Handler, error) {
+	var cfg CCIPHandlerConfig
+	if err := json.Unmarshal(handlerConfig, &cfg); err != nil {
+		return nil, err
+	}
+	lggr = lggr.Named("CCIPHandler:" + donConfig.DonId)
+	var userRateLimiter, nodeRateLimiter *hc.RateLimiter
+	var err error
+	if cfg.UserRateLimiter != nil {
+		userRateLimiter, err = hc.NewRateLimiter(*cfg.UserRateLimiter)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if cfg.NodeRateLimiter != nil {
+		nodeRateLimiter, err = hc.NewRateLimiter(*cfg.NodeRateLimiter)
+		if err != nil {
+			return nil, err
+		}
+	}
+	pendingRequestsCache := hc.NewRequestCache[PendingRequest](time.Millisecond*time.Duration(cfg.RequestTimeoutMillis), cfg.MaxPendingRequests)
+	return NewCCIPHandler(cfg, donConfig, don, pendingRequestsCache, userRateLimiter, nodeRateLimiter, lggr), nil
+}
+
+func NewCCIPHandler(
+	cfg CCIPHandlerConfig,
+	donConfig *config.DONConfig,
+	don handlers.DON,
+	pendingRequestsCache hc.RequestCache[PendingRequest],
+	userRateLimiter *hc.RateLimiter,
+	nodeRateLimiter *hc.RateLimiter,
+	lggr logger.Logger) handlers.Handler {
+	var allowedSenders map[string]struct{}
+	if len(cfg.AllowedSenders) > 0 {
+		allowedSenders = make(map[string]struct{}, len(cfg.AllowedSenders))
+		for _, sender := range cfg.AllowedSenders {
+			allowedSenders[strings.ToLower(sender)] = struct{}{}
+		}
+	}
+	return &ccipHandler{
+		handlerConfig:   cfg,
+		donConfig:       donConfig,
+		don:             don,
+		pendingRequests: pendingRequestsCache,
+		allowedSenders:  allowedSenders,
+		userRateLimiter: userRateLimiter,
+		nodeRateLimiter: nodeRateLimiter,
+		lggr:            lggr,
+	}
+}
+
+func (h *ccipHandler) HandleUserMessage(ctx context.Context, msg *api.Message, callbackCh chan<- handlers.UserCallbackPayload) error {
+	if h.allowedSenders != nil {
+		if _, ok := h.allowedSenders[strings.ToLower(msg.Body.Sender)]; !ok {
+			h.lggr.Debugw("received a message from a non-allowlisted address", "sender", msg.Body.Sender)
+			promHandlerError.WithLabelValues(h.donConfig.DonId, ErrNotAllowlisted.Error()).Inc()
+			return ErrNotAllowlisted
+		}
+	}
+	if h.userRateLimiter != nil && !h.userRateLimiter.Allow(msg.Body.Sender) {
+		h.lggr.Debugw("rate-limited", "sender", msg.Body.Sender)
+		promHandlerError.WithLabelValues(h.donConfig.DonId, ErrRateLimited.Error()).Inc()
+		return ErrRateLimited
+	}
+	if err := h.validateRequest(msg); err != nil {
+		h.lggr.Debugw("invalid request", "sender", msg.Body.Sender, "method", msg.Body.Method, "err", err)
+		promHandlerError.WithLabelValues(h.donConfig.DonId, ErrInvalidRequest.Error()).Inc()
+		return err
+	}
+	return h.handleRequest(ctx, msg, callbackCh)
+}
+
+func (h *ccipHandler) validateRequest(msg *api.Message) error {
+	payload := msg.Body.Payload
+	switch msg.Body.Method {
+	case MethodGetFee:
+		var req GetFeeRequest
+		if err := json.Unmarshal(payload, &req); err != nil {
+			return fmt.Errorf("%w: %w", ErrInvalidRequest, err)
+		}
+		if req.SourceChainSelector == 0 || req.DestChainSelector == 0 || req.Receiver == "" {
+			return fmt.Errorf("%w: source_chain_selector, dest_chain_selector and receiver are required", ErrInvalidRequest)
+		}
+	case MethodLaneStatus:
+		var req LaneStatusRequest
+		if err := json.Unmarshal(payload, &req); err != nil {
+			return fmt.Errorf("%w: %w", ErrInvalidRequest, err)
+		}
+		if req.SourceChainSelector == 0 || req.DestChainSelector == 0 {
+			return fmt.Errorf("%w: source_chain_selector and dest_chain_selector are required", ErrInvalidRequest)
+		}
+	case MethodMessageStatus:
+		var req MessageStatusRequest
+		if err := json.Unmarshal(payload, &req); err != nil {
+			return fmt.Errorf("%w: %w", ErrInvalidRequest, err)
+		}
+		if req.SourceChainSelector == 0 || req.DestChainSelector == 0 || req.MessageID == "" {
+			return fmt.Errorf("%w: source_chain_selector, dest_chain_selector and message_id are required", ErrInvalidRequest)
+		}
+	case MethodSend:
+		if !h.handlerConfig.EnableSend {
+			return ErrUnsupportedMethod
+		}
+		var req SendRequest
+		if err := json.Unmarshal(payload, &req); err != nil {
+			return fmt.Errorf("%w: %w", ErrInvalidRequest, err)
+		}
+		if req.SourceChainSelector == 0 {
+			return fmt.Errorf("%w: source_chain_selector is required", ErrInvalidRequest)
+		}
+		if len(req.SignedTx) > maxSignedTxLen {
+			return fmt.Errorf("%w: signed_tx is too large", ErrInvalidRequest)
+		}
+		if tx, err := hex.DecodeString(req.SignedTx); err != nil || len(tx) == 0 {
+			return fmt.Errorf("%w: signed_tx must be a non-empty hex string", ErrInvalidRequest)
+		}
+	default:
+		h.lggr.Debugw("unsupported method", "method", msg.Body.Method)
+		return ErrUnsupportedMethod
+	}
+	return nil
+}
+
+func (h *ccipHandler) handleRequest(ctx context.Context, msg *api.Message, callbackCh chan<- handlers.UserCallbackPayload) error {
+	h.lggr.Debugw("handleRequest: processing message", "sender", msg.Body.Sender, "messageId", msg.Body.MessageId, "method", msg.Body.Method)
+	err := h.pendingRequests.NewRequest(msg, callbackCh, &PendingRequest{
+		request:   msg,
+		responses: make(map[string]*api.Message),
+		results:   make(map[string]int),
+	})
+	if err != nil {
+		h.lggr.Warnw("handleRequest: error adding new request", "sender", msg.Body.Sender, "err", err)
+		promHandlerError.WithLabelValues(h.donConfig.DonId, err.Error()).Inc()
+		return err
+	}
+	// Send to all nodes.
+	for _, member := range h.donConfig.Members {
+		err := h.don.SendToNode(ctx, member.Address, msg)
+		if err != nil {
+			h.lggr.Debugw("handleRequest: failed to send to a node", "node", member.Address, "err", err)
+		}
+	}
+	return nil
+}
+
+func (h *ccipHandler) HandleNodeMessage(ctx context.Context, msg *api.Message, nodeAddr string) error {
+	h.lggr.Debugw("HandleNodeMessage: processing message", "nodeAddr", nodeAddr, "receiver", msg.Body.Receiver, "id", msg.Body.MessageId)
+	if h.nodeRateLimiter != nil && !h.nodeRateLimiter.Allow(nodeAddr) {
+		h.lggr.Debugw("rate-limited", "sender", nodeAddr)
+		return ErrRateLimited
+	}
+	switch msg.Body.Method {
+	case MethodGetFee, MethodLaneStatus, MethodMessageStatus, MethodSend:
+		return h.pendingRequests.ProcessResponse(msg, h.processResponse)
+	default:
+		h.lggr.Debugw("unsupported method", "method", msg.Body.Method)
+		return ErrUnsupportedMethod
+	}
+}
+
+// Conforms to ResponseProcessor[*PendingRequest]
+func (h *ccipHandler) processResponse(response *api.Message, responseData *PendingRequest) (*handlers.UserCallbackPayload, *PendingRequest, error) {
+	if _, exists := responseData.responses[response.Body.Sender]; exists {
+		return nil, nil, errors.New("duplicate response")
+	}
+	if response.Body.Method != responseData.request.Body.Method {
+		return nil, responseData, errors.New("invalid method")
+	}
+	responseData.responses[response.Body.Sender] = response
+
+	var nodeResponse NodeResponse
+	if err := json.Unmarshal(response.Body.Payload, &nodeResponse); err != nil {
+		// keep track of the sender, but don't pass a malformed payload on to the user
+		responseData.responses[response.Body.Sender] = nil
+		responseData.errors = append(responseData.errors, "invalid node response")
+	} else if !nodeResponse.Success {
+		responseData.errors = append(responseData.errors, nodeResponse.ErrorMessage)
+	} else {
+		var result bytes.Buffer
+		if err = json.Compact(&result, nodeResponse.Result); err != nil {
+			responseData.errors = append(responseData.errors, "invalid node result")
+		} else {
+			key := result.String()
+			responseData.results[key]++
+			if responseData.results[key] >= h.donConfig.F+1 {
+				// F+1 nodes agree on the result
+				callbackPayload, err := h.newUserResponse(responseData, CombinedResponse{Success: true, Result: json.RawMessage(key)})
+				return callbackPayload, responseData, err
+			}
+		}
+	}
+
+	// Fail as soon as F+1 matching results can't be reached with the remaining responses
+	maxMatching := 0
+	for _, count := range responseData.results {
+		maxMatching = max(maxMatching, count)
+	}
+	remaining := len(h.donConfig.Members) - len(responseData.responses)
+	if maxMatching+remaining < h.donConfig.F+1 {
+		errMsg := "no quorum on the result"
+		if len(responseData.errors) > 0 {
+			errMsg = fmt.Sprintf("%s: %s", errMsg, strings.Join(responseData.errors, "; "))
+		}
+		callbackPayload, err := h.newUserResponse(responseData, CombinedResponse{Success: false, ErrorMessage: errMsg})
+		return callbackPayload, responseData, err
+	}
+	// not ready to be processed yet
+	return nil, responseData, nil
+}
+
+func (h *ccipHandler) newUserResponse(responseData *PendingRequest, payload CombinedResponse) (*handlers.UserCallbackPayload, error) {
+	request := responseData.request
+	promRequestResult.WithLabelValues(h.donConfig.DonId, request.Body.Method, fmt.Sprint(payload.Success)).Inc()
+
+	for _, response := range responseData.responses {
+		if response == nil {
+			continue
+		}
+		payload.NodeResponses = append(payload.NodeResponses, response)
+	}
+	payloadJSON, err := json.Marshal(payload)
+	if err != nil {
+		return nil, err
+	}
+	userResponse := *request
+	userResponse.Body.Receiver = request.Body.Sender
+	userResponse.Body.Payload = payloadJSON
+	return &handlers.UserCallbackPayload{Msg: &userResponse, ErrCode: api.NoError, ErrMsg: ""}, nil
+}
+
+func (h *ccipHandler) Start(ctx context.Context) error {
+	return h.StartOnce("CCIPHandler", func() error {
+		h.lggr.Info("starting CCIPHandler")
+		return nil
+	})
+}
+
+func (h *ccipHandler) Close() error {
+	return h.StopOnce("CCIPHandler", func() error {
+		return nil
+	})
+}
@@ -0,0 +1,190 @@
+package ccip_test
+
+import (
+	"crypto/ecdsa"
+	"encoding/json"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/services/servicetest"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	"github.com/smartcontractkit/chainlink/v2/core/services/gateway/api"
+	gc "github.com/smartcontractkit/chainlink/v2/core/services/gateway/common"
+	"github.com/smartcontractkit/chainlink/v2/core/services/gateway/config"
+	"github.com/smartcontractkit/chainlink/v2/core/services/gateway/handlers"
+	"github.com/smartcontractkit/chainlink/v2/core/services/gateway/handlers/ccip"
+	hc "github.com/smartcontractkit/chainlink/v2/core/services/gateway/handlers/common"
+	handlers_mocks "github.com/smartcontractkit/chainlink/v2/core/services/gateway/handlers/mocks"
+)
+
+const messageStatusRequest = `{"source_chain_selector":1,"dest_chain_selector":2,"message_id":"0x01"}`
+
+func newCCIPHandlerForATestDON(t *testing.T, nodes []gc.TestNode, cfg ccip.CCIPHandlerConfig) (handlers.Handler, *handlers_mocks.DON) {
+	donConfig := &config.DONConfig{
+		Members: []config.NodeConfig{},
+		F:       1,
+	}
+	for id, n := range nodes {
+		donConfig.Members = append(donConfig.Members, config.NodeConfig{
+			Name:    fmt.Sprintf("node_%d", id),
+			Address: n.Address,
+		})
+	}
+
+	don := handlers_mocks.NewDON(t)
+	userRateLimiter, err := hc.NewRateLimiter(hc.RateLimiterConfig{GlobalRPS: 100.0, GlobalBurst: 100, PerSenderRPS: 100.0, PerSenderBurst: 100})
+	require.NoError(t, err)
+	pendingRequestsCache := hc.NewRequestCache[ccip.PendingRequest](time.Hour*24, 1000)
+	handler := ccip.NewCCIPHandler(cfg, donConfig, don, pendingRequestsCache, userRateLimiter, nil, logger.TestLogger(t))
+	return handler, don
+}
+
+func newSignedMessage(t *testing.T, id string, method string, payload string, privateKey *ecdsa.PrivateKey) api.Message {
+	msg := api.Message{
+		Body: api.MessageBody{
+			MessageId: id,
+			Method:    method,
+			DonId:     "don_id",
+			Payload:   json.RawMessage(payload),
+		},
+	}
+	require.NoError(t, msg.Sign(privateKey))
+	return msg
+}
+
+func sendNodeResponses(t *testing.T, handler handlers.Handler, userRequestMsg api.Message, nodes []gc.TestNode, responses []string) {
+	for id, resp := range responses {
+		nodeResponseMsg := userRequestMsg
+		nodeResponseMsg.Body.Receiver = userRequestMsg.Body.Sender
+		nodeResponseMsg.Body.Payload = json.RawMessage(resp)
+		require.NoError(t, nodeResponseMsg.Sign(nodes[id].PrivateKey))
+		_ = handler.HandleNodeMessage(testutils.Context(t), &nodeResponseMsg, nodes[id].Address)
+	}
+}
+
+func TestCCIPHandler_CleanStartAndClose(t *testing.T) {
+	t.Parallel()
+
+	handler, err := ccip.NewCCIPHandlerFromConfig(json.RawMessage("{}"), &config.DONConfig{}, nil, logger.TestLogger(t))
+	require.NoError(t, err)
+
+	servicetest.Run(t, handler)
+}
+
+func TestCCIPHandler_HandleUserMessage_Quorum(t *testing.T) {
+	t.Parallel()
+
+	const (
+		success       = `{"success":true,"result":{"state":"SUCCESS"}}`
+		successSpaced = `{"success":true,"result":{ "state": "SUCCESS" }}`
+		inProgress    = `{"success":true,"result":{"state":"IN_PROGRESS"}}`
+		failure       = `{"success":false,"error_message":"rpc down"}`
+	)
+
+	tests := []struct {
+		name                     string
+		nodeResults              []string
+		expectedGatewayResult    bool
+		expectedResult           string
+		expectedNodeMessageCount int
+	}{
+		{"two matching", []string{success, successSpaced, failure, failure}, true, `{"state":"SUCCESS"}`, 2},
+		{"matching after a mismatch", []string{inProgress, success, failure, success}, true, `{"state":"SUCCESS"}`, 4},
+		{"no matching results", []string{success, inProgress, failure, failure}, false, "", 4},
+		{"all failed", []string{failure, failure, failure, failure}, false, "", 3},
+		{"invalid responses", []string{`not json`, `{"success":true,"result":"x`, failure, success}, false, "", 1},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			nodes, user := gc.NewTestNodes(t, 4), gc.NewTestNodes(t, 1)[0]
+			handler, don := newCCIPHandlerForATestDON(t, nodes, ccip.CCIPHandlerConfig{})
+			userRequestMsg := newSignedMessage(t, "1234", ccip.MethodMessageStatus, messageStatusRequest, user.PrivateKey)
+
+			callbackCh := make(chan handlers.UserCallbackPayload)
+			done := make(chan struct{})
+			go func() {
+				defer close(done)
+				// wait on a response from Gateway to the user
+				response := <-callbackCh
+				require.Equal(t, api.NoError, response.ErrCode)
+				require.Equal(t, userRequestMsg.Body.MessageId, response.Msg.Body.MessageId)
+				var payload ccip.CombinedResponse
+				require.NoError(t, json.Unmarshal(response.Msg.Body.Payload, &payload))
+				require.Equal(t, test.expectedGatewayResult, payload.Success)
+				if test.expectedGatewayResult {
+					require.JSONEq(t, test.expectedResult, string(payload.Result))
+				} else {
+					require.NotEmpty(t, payload.ErrorMessage)
+				}
+				require.Len(t, payload.NodeResponses, test.expectedNodeMessageCount)
+			}()
+
+			don.On("SendToNode", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+			require.NoError(t, handler.HandleUserMessage(testutils.Context(t), &userRequestMsg, callbackCh))
+			sendNodeResponses(t, handler, userRequestMsg, nodes, test.nodeResults)
+			<-done
+		})
+	}
+}
+
+func TestCCIPHandler_HandleUserMessage_InvalidRequests(t *testing.T) {
+	t.Parallel()
+
+	nodes, user := gc.NewTestNodes(t, 4), gc.NewTestNodes(t, 1)[0]
+	handler, _ := newCCIPHandlerForATestDON(t, nodes, ccip.CCIPHandlerConfig{})
+
+	tests := []struct {
+		name        string
+		method      string
+		payload     string
+		expectedErr error
+	}{
+		{"unsupported method", "ccip_drain_pool", `{}`, ccip.ErrUnsupportedMethod},
+		{"missing message id", ccip.MethodMessageStatus, `{"source_chain_selector":1,"dest_chain_selector":2}`, ccip.ErrInvalidRequest},
+		{"malformed payload", ccip.MethodGetFee, `[]`, ccip.ErrInvalidRequest},
+		{"missing lane", ccip.MethodLaneStatus, `{"source_chain_selector":1}`, ccip.ErrInvalidRequest},
+		{"send disabled", ccip.MethodSend, `{"source_chain_selector":1,"signed_tx":"0x01"}`, ccip.ErrUnsupportedMethod},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			msg := newSignedMessage(t, "1234", test.method, test.payload, user.PrivateKey)
+			err := handler.HandleUserMessage(testutils.Context(t), &msg, make(chan handlers.UserCallbackPayload))
+			require.ErrorIs(t, err, test.expectedErr)
+		})
+	}
+}
+
+func TestCCIPHandler_HandleUserMessage_Send(t *testing.T) {
+	t.Parallel()
+
+	nodes, user := gc.NewTestNodes(t, 4), gc.NewTestNodes(t, 1)[0]
+	handler, don := newCCIPHandlerForATestDON(t, nodes, ccip.CCIPHandlerConfig{EnableSend: true})
+
+	msg := newSignedMessage(t, "1234", ccip.MethodSend, `{"source_chain_selector":1,"signed_tx":"not hex"}`, user.PrivateKey)
+	require.ErrorIs(t, handler.HandleUserMessage(testutils.Context(t), &msg, make(chan handlers.UserCallbackPayload)), ccip.ErrInvalidRequest)
+
+	msg = newSignedMessage(t, "1235", ccip.MethodSend, `{"source_chain_selector":1,"signed_tx":"0x02f8"}`, user.PrivateKey)
+	don.On("SendToNode", mock.Anything, mock.Anything, mock.Anything).Return(nil).Times(len(nodes))
+	require.NoError(t, handler.HandleUserMessage(testutils.Context(t), &msg, make(chan handlers.UserCallbackPayload, 1)))
+}
+
+func TestCCIPHandler_HandleUserMessage_Allowlist(t *testing.T) {
+	t.Parallel()
+
+	nodes, users := gc.NewTestNodes(t, 4), gc.NewTestNodes(t, 2)
+	handler, don := newCCIPHandlerForATestDON(t, nodes, ccip.CCIPHandlerConfig{AllowedSenders: []string{users[0].Address}})
+
+	msg := newSignedMessage(t, "1234", ccip.MethodMessageStatus, messageStatusRequest, users[1].PrivateKey)
+	require.ErrorIs(t, handler.HandleUserMessage(testutils.Context(t), &msg, make(chan handlers.UserCallbackPayload)), ccip.ErrNotAllowlisted)
+
+	msg = newSignedMessage(t, "1234", ccip.MethodMessageStatus, messageStatusRequest, users[0].PrivateKey)
+	don.On("SendToNode", mock.Anything, mock.Anything, mock.Anything).Return(nil).Times(len(nodes))
+	require.NoError(t, handler.HandleUserMessage(testutils.Context(t), &msg, make(chan handlers.UserCallbackPayload, 1)))
+}
@@ -29,7 +29,8 @@ type SecretsSetResponse struct {
 
 type SecretsListResponse struct {
 	ResponseBase
-	Rows []SecretsListRow `json:"rows,omitempty"`
+	Rows  []SecretsListRow `json:"rows,omitempty"`
+	Usage *SecretsUsage    `json:"usage,omitempty"`
 }
 
 type SecretsListRow struct {
@@ -38,6 +39,15 @@ type SecretsListRow struct {
 	Expiration int64  `json:"expiration"`
 }
 
+// SecretsUsage reports the storage used by the sender and its quota.
+// MaxBytes is omitted when there is no limit.
+type SecretsUsage struct {
+	SlotsUsed uint   `json:"slots_used"`
+	BytesUsed uint64 `json:"bytes_used"`
+	MaxSlots  uint   `json:"max_slots"`
+	MaxBytes  uint64 `json:"max_bytes,omitempty"`
+}
+
 // Gateway -> User response, which combines responses from several nodes
 type CombinedResponse struct {
 	ResponseBase
@@ -0,0 +1,212 @@
+package headreporter
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"sync"
+	"time"
+
+	"go.uber.org/multierr"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/services"
+
+	evmtypes "github.com/smartcontractkit/chainlink/v2/core/chains/evm/types"
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+)
+
+type HeadEventType string
+
+const (
+	HeadEventNewHead       HeadEventType = "new_head"
+	HeadEventFinalizedHead HeadEventType = "finalized_head"
+	HeadEventReorg         HeadEventType = "reorg"
+)
+
+// HeadEvent is a structured event about the heads of a chain, published to the event sinks.
+type HeadEvent struct {
+	Type      HeadEventType `json:"type"`
+	ChainID   string        `json:"chainID"`
+	Number    int64         `json:"number"`
+	Hash      string        `json:"hash"`
+	Timestamp time.Time     `json:"timestamp"`
+	// FinalizedNumber and the finality lag are set on new_head events once a finalized head is known
+	FinalizedNumber    *int64   `json:"finalizedNumber,omitempty"`
+	FinalityLagBlocks  *int64   `json:"finalityLagBlocks,omitempty"`
+	FinalityLagSeconds *float64 `json:"finalityLagSeconds,omitempty"`
+	// ReorgDepth is the number of blocks of the previous chain that were replaced, set on reorg events
+	ReorgDepth int64 `json:"reorgDepth,omitempty"`
+	// PreviousHash is the hash of the replaced head, set on reorg events
+	PreviousHash string `json:"previousHash,omitempty"`
+}
+
+// EventSink publishes head events, e.g. to a message broker.
+type EventSink interface {
+	io.Closer
+	Name() string
+	Publish(ctx context.Context, event HeadEvent) error
+}
+
+// eventQueueSize is the number of events buffered for each sink. Events are dropped while the queue of a sink is full,
+// so that a slow sink does not delay the other reporters.
+const eventQueueSize = 100
+
+type chainHeads struct {
+	latest        *evmtypes.Head
+	finalizedHash string
+}
+
+// sinkQueue is the bounded queue of the events to publish to a sink.
+type sinkQueue struct {
+	sink   EventSink
+	events chan HeadEvent
+}
+
+type eventReporter struct {
+	lggr   logger.Logger
+	queues []sinkQueue
+	chains map[string]*chainHeads
+
+	chStop services.StopChan
+	wgDone sync.WaitGroup
+}
+
+// NewEventReporter returns a HeadReporter publishing the new heads, finalized heads, reorgs and finality lag of each
+// chain to the sinks. The events are published in the background, by a worker per sink, until the reporter is closed.
+func NewEventReporter(lggr logger.Logger, sinks ...EventSink) HeadReporter {
+	r := &eventReporter{
+		lggr:   lggr.Named("EventReporter"),
+		chains: make(map[string]*chainHeads),
+		chStop: make(chan struct{}),
+	}
+	for _, sink := range sinks {
+		q := sinkQueue{sink: sink, events: make(chan HeadEvent, eventQueueSize)}
+		r.queues = append(r.queues, q)
+		r.wgDone.Add(1)
+		go r.publishLoop(q)
+	}
+	return r
+}
+
+func (r *eventReporter) ReportNewHead(_ context.Context, head *evmtypes.Head) error {
+	chainID := head.EVMChainID.String()
+	heads, ok := r.chains[chainID]
+	if !ok {
+		heads = &chainHeads{}
+		r.chains[chainID] = heads
+	}
+
+	var events []HeadEvent
+	if heads.latest != nil {
+		if depth, reorged := reorgDepth(heads.latest, head); reorged {
+			event := newHeadEvent(HeadEventReorg, chainID, head)
+			event.ReorgDepth = depth
+			event.PreviousHash = heads.latest.Hash.Hex()
+			events = append(events, event)
+			r.lggr.Debugw("Reorg detected", "chainID", chainID, "head.number", head.Number, "reorgDepth", depth)
+		}
+	}
+	heads.latest = head
+
+	newHead := newHeadEvent(HeadEventNewHead, chainID, head)
+	finalized := head.LatestFinalizedHead()
+	if finalized != nil {
+		number := finalized.BlockNumber()
+		lagBlocks := head.Number - number
+		lagSeconds := head.Timestamp.Sub(finalized.GetTimestamp()).Seconds()
+		newHead.FinalizedNumber, newHead.FinalityLagBlocks, newHead.FinalityLagSeconds = &number, &lagBlocks, &lagSeconds
+	}
+	events = append(events, newHead)
+
+	if finalized != nil && finalized.BlockHash().Hex() != heads.finalizedHash {
+		heads.finalizedHash = finalized.BlockHash().Hex()
+		events = append(events, HeadEvent{
+			Type:      HeadEventFinalizedHead,
+			ChainID:   chainID,
+			Number:    finalized.BlockNumber(),
+			Hash:      finalized.BlockHash().Hex(),
+			Timestamp: finalized.GetTimestamp().UTC(),
+		})
+	}
+
+	var err error
+	for _, q := range r.queues {
+		var dropped int
+		for _, event := range events {
+			select {
+			case q.events <- event:
+			default:
+				dropped++
+			}
+		}
+		if dropped > 0 {
+			err = multierr.Append(err, fmt.Errorf("event queue of sink %s is full, dropped %d events", q.sink.Name(), dropped))
+		}
+	}
+	return err
+}
+
+func (r *eventReporter) ReportPeriodic(ctx context.Context) error {
+	return nil
+}
+
+// publishLoop publishes the queued events to the sink, in order, until the reporter is closed.
+func (r *eventReporter) publishLoop(q sinkQueue) {
+	defer r.wgDone.Done()
+	ctx, cancel := r.chStop.NewCtx()
+	defer cancel()
+	for {
+		select {
+		case event := <-q.events:
+			pctx, pcancel := context.WithTimeout(ctx, eventSinkTimeout)
+			err := q.sink.Publish(pctx, event)
+			pcancel()
+			if err != nil && ctx.Err() == nil {
+				r.lggr.Errorw("Failed to publish head event", "sink", q.sink.Name(), "type", event.Type, "chainID", event.ChainID, "number", event.Number, "err", err)
+			}
+		case <-r.chStop:
+			return
+		}
+	}
+}
+
+func (r *eventReporter) Close() (err error) {
+	close(r.chStop)
+	r.wgDone.Wait()
+	for _, q := range r.queues {
+		err = multierr.Append(err, q.sink.Close())
+	}
+	return err
+}
+
+func newHeadEvent(typ HeadEventType, chainID string, head *evmtypes.Head) HeadEvent {
+	return HeadEvent{
+		Type:      typ,
+		ChainID:   chainID,
+		Number:    head.Number,
+		Hash:      head.Hash.Hex(),
+		Timestamp: head.Timestamp.UTC(),
+	}
+}
+
+// reorgDepth returns the number of blocks of the chain of prev replaced by the chain of head, and whether head is not
+// a descendant of prev.
+func reorgDepth(prev, head *evmtypes.Head) (int64, bool) {
+	if prev.Hash == head.Hash {
+		return 0, false
+	}
+	if head.Number > prev.Number && prev.Number < head.EarliestInChain().Number {
+		// heads were skipped and the chain of head does not reach prev, assume it extends it
+		return 0, false
+	}
+	if head.HashAtHeight(prev.Number) == prev.Hash {
+		return 0, false
+	}
+	for cur := prev; cur != nil; cur = cur.Parent.Load() {
+		if cur.Number <= head.Number && head.HashAtHeight(cur.Number) == cur.Hash {
+			return prev.Number - cur.Number, true
+		}
+	}
+	// no common ancestor within the tracked heads, the whole chain of prev was replaced
+	return int64(prev.ChainLength()), true
+}
@@ -0,0 +1,147 @@
+package headreporter_test
+
+import (
+	"context"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	evmtypes "github.com/smartcontractkit/chainlink/v2/core/chains/evm/types"
+	ubig "github.com/smartcontractkit/chainlink/v2/core/chains/evm/utils/big"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	"github.com/smartcontractkit/chainlink/v2/core/services/headreporter"
+)
+
+// newChain returns the head of a chain of heads with the numbers, the first one finalized
+func newChain(chainID int64, fork byte, numbers ...int64) *evmtypes.Head {
+	var parent *evmtypes.Head
+	for i, n := range numbers {
+		head := &evmtypes.Head{
+			Number:     n,
+			EVMChainID: ubig.NewI(chainID),
+			Hash:       common.BytesToHash([]byte{fork, byte(n)}),
+			Timestamp:  time.Unix(1000+n*12, 0),
+		}
+		if parent != nil {
+			head.ParentHash = parent.Hash
+			head.Parent.Store(parent)
+		}
+		head.IsFinalized.Store(i == 0)
+		parent = head
+	}
+	return parent
+}
+
+func newEventReporter(t *testing.T, sinks ...headreporter.EventSink) headreporter.HeadReporter {
+	reporter := headreporter.NewEventReporter(logger.TestLogger(t), sinks...)
+	t.Cleanup(func() { assert.NoError(t, reporter.(io.Closer).Close()) })
+	return reporter
+}
+
+// waitForEvents waits for the sink to have received n events, which are published in the background
+func waitForEvents(t *testing.T, sink *headreporter.MemorySink, n int) []headreporter.HeadEvent {
+	require.Eventually(t, func() bool { return len(sink.Events()) >= n }, testutils.WaitTimeout(t), 10*time.Millisecond)
+	events := sink.Events()
+	require.Len(t, events, n)
+	return events
+}
+
+func Test_EventReporter_NewHead(t *testing.T) {
+	sink := headreporter.NewMemorySink()
+	reporter := newEventReporter(t, sink)
+	ctx := testutils.Context(t)
+
+	require.NoError(t, reporter.ReportNewHead(ctx, newChain(1, 0, 40, 41, 42)))
+	events := waitForEvents(t, sink, 2)
+	require.Len(t, events, 2)
+	assert.Equal(t, headreporter.HeadEventNewHead, events[0].Type)
+	assert.Equal(t, "1", events[0].ChainID)
+	assert.Equal(t, int64(42), events[0].Number)
+	assert.Equal(t, int64(40), *events[0].FinalizedNumber)
+	assert.Equal(t, int64(2), *events[0].FinalityLagBlocks)
+	assert.InDelta(t, 24, *events[0].FinalityLagSeconds, 0.001)
+	assert.Equal(t, headreporter.HeadEventFinalizedHead, events[1].Type)
+	assert.Equal(t, int64(40), events[1].Number)
+
+	// the finalized head is only reported when it changes
+	require.NoError(t, reporter.ReportNewHead(ctx, newChain(1, 0, 40, 41, 42, 43)))
+	events = waitForEvents(t, sink, 3)[2:]
+	assert.Equal(t, headreporter.HeadEventNewHead, events[0].Type)
+	assert.Equal(t, int64(3), *events[0].FinalityLagBlocks)
+
+	// chains are tracked separately
+	require.NoError(t, reporter.ReportNewHead(ctx, newChain(2, 0, 7)))
+	events = waitForEvents(t, sink, 5)[3:]
+	assert.Equal(t, "2", events[0].ChainID)
+	assert.Equal(t, int64(0), *events[0].FinalityLagBlocks)
+}
+
+func Test_EventReporter_Reorg(t *testing.T) {
+	sink := headreporter.NewMemorySink()
+	reporter := newEventReporter(t, sink)
+	ctx := testutils.Context(t)
+
+	require.NoError(t, reporter.ReportNewHead(ctx, newChain(1, 0, 40, 41, 42, 43)))
+	// the fork replaces the blocks after 41
+	fork := newChain(1, 1, 42, 43, 44)
+	forkStart := fork.EarliestInChain()
+	forkStart.IsFinalized.Store(false)
+	forkStart.Parent.Store(newChain(1, 0, 40, 41))
+	forkStart.ParentHash = forkStart.Parent.Load().Hash
+	require.NoError(t, reporter.ReportNewHead(ctx, fork))
+
+	events := waitForEvents(t, sink, 4)[2:]
+	assert.Equal(t, headreporter.HeadEventReorg, events[0].Type)
+	assert.Equal(t, int64(44), events[0].Number)
+	assert.Equal(t, int64(2), events[0].ReorgDepth)
+	assert.Equal(t, common.BytesToHash([]byte{0, 43}).Hex(), events[0].PreviousHash)
+	assert.Equal(t, headreporter.HeadEventNewHead, events[1].Type)
+
+	// skipped heads are not a reorg
+	require.NoError(t, reporter.ReportNewHead(ctx, newChain(1, 1, 50, 51)))
+	events = waitForEvents(t, sink, 6)[4:]
+	assert.Equal(t, headreporter.HeadEventNewHead, events[0].Type)
+	assert.Equal(t, headreporter.HeadEventFinalizedHead, events[1].Type)
+}
+
+// blockingSink blocks the publishes until it is released
+type blockingSink struct {
+	release chan struct{}
+}
+
+func (b *blockingSink) Name() string { return "blocking" }
+
+func (b *blockingSink) Publish(ctx context.Context, _ headreporter.HeadEvent) error {
+	select {
+	case <-b.release:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+func (b *blockingSink) Close() error { return nil }
+
+func Test_EventReporter_SlowSink(t *testing.T) {
+	slow := &blockingSink{release: make(chan struct{})}
+	defer close(slow.release)
+	sink := headreporter.NewMemorySink()
+	reporter := newEventReporter(t, slow, sink)
+	ctx := testutils.Context(t)
+
+	// the reporter does not wait for the slow sink, and drops its events once its queue is full, while the other
+	// sinks still receive them
+	var err error
+	for n := int64(1); n <= 200 && err == nil; n++ {
+		if err = reporter.ReportNewHead(ctx, newChain(1, 0, n)); err == nil {
+			waitForEvents(t, sink, int(2*n))
+		}
+	}
+	require.ErrorContains(t, err, "event queue of sink blocking is full, dropped")
+	assert.NotContains(t, err.Error(), "memory")
+}
@@ -0,0 +1,181 @@
+package headreporter
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/url"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/smartcontractkit/chainlink/v2/core/config"
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+)
+
+const eventSinkTimeout = 10 * time.Second
+
+// NewEventSink returns the webhook, NATS or Kafka REST proxy sink of the config.
+func NewEventSink(lggr logger.Logger, cfg config.HeadEventSink) (EventSink, error) {
+	switch cfg.Type() {
+	case "webhook":
+		return NewWebhookSink(cfg.Name(), cfg.URL()), nil
+	case "kafka-rest-proxy":
+		return NewKafkaRESTProxySink(cfg.Name(), cfg.URL(), cfg.Topic()), nil
+	case "nats":
+		return NewNATSSink(lggr, cfg.Name(), cfg.URL(), cfg.Topic()), nil
+	default:
+		return nil, errors.Errorf("unknown event sink type %q", cfg.Type())
+	}
+}
+
+// MemorySink keeps the published events in memory, for tests.
+type MemorySink struct {
+	mu     sync.Mutex
+	events []HeadEvent
+}
+
+func NewMemorySink() *MemorySink {
+	return &MemorySink{}
+}
+
+func (m *MemorySink) Name() string {
+	return "memory"
+}
+
+func (m *MemorySink) Publish(_ context.Context, event HeadEvent) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.events = append(m.events, event)
+	return nil
+}
+
+// Events returns the events published so far, in order.
+func (m *MemorySink) Events() []HeadEvent {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return append([]HeadEvent(nil), m.events...)
+}
+
+func (m *MemorySink) Close() error {
+	return nil
+}
+
+// webhookSink posts each event as JSON to the URL.
+type webhookSink struct {
+	name   string
+	url    string
+	client *http.Client
+}
+
+func NewWebhookSink(name string, u *url.URL) EventSink {
+	return &webhookSink{name: name, url: u.String(), client: &http.Client{Timeout: eventSinkTimeout}}
+}
+
+func (w *webhookSink) Name() string {
+	return w.name
+}
+
+func (w *webhookSink) Publish(ctx context.Context, event HeadEvent) error {
+	body, err := json.Marshal(event)
+	if err != nil {
+		return err
+	}
+	_, err = postJSON(ctx, w.client, w.url, "application/json", body)
+	return err
+}
+
+func (w *webhookSink) Close() error {
+	w.client.CloseIdleConnections()
+	return nil
+}
+
+// kafkaRESTProxySink produces the events to a Kafka topic through the v2 API of a Kafka REST proxy, keyed by chain ID so
+// that the events of a chain stay ordered within a partition. It does not talk to the Kafka brokers directly, a REST
+// proxy, e.g. the Confluent REST Proxy, must be deployed in front of the cluster.
+type kafkaRESTProxySink struct {
+	name   string
+	url    string
+	client *http.Client
+}
+
+func NewKafkaRESTProxySink(name string, proxy *url.URL, topic string) EventSink {
+	return &kafkaRESTProxySink{
+		name:   name,
+		url:    proxy.JoinPath("topics", topic).String(),
+		client: &http.Client{Timeout: eventSinkTimeout},
+	}
+}
+
+type kafkaRecords struct {
+	Records []kafkaRecord `json:"records"`
+}
+
+type kafkaRecord struct {
+	Key   string    `json:"key"`
+	Value HeadEvent `json:"value"`
+}
+
+type kafkaOffsets struct {
+	Offsets []struct {
+		ErrorCode *int    `json:"error_code"`
+		Error     *string `json:"error"`
+	} `json:"offsets"`
+}
+
+func (k *kafkaRESTProxySink) Name() string {
+	return k.name
+}
+
+func (k *kafkaRESTProxySink) Publish(ctx context.Context, event HeadEvent) error {
+	body, err := json.Marshal(kafkaRecords{Records: []kafkaRecord{{Key: event.ChainID, Value: event}}})
+	if err != nil {
+		return err
+	}
+	resp, err := postJSON(ctx, k.client, k.url, "application/vnd.kafka.json.v2+json", body)
+	if err != nil {
+		return err
+	}
+	var offsets kafkaOffsets
+	if err = json.Unmarshal(resp, &offsets); err != nil {
+		return errors.Wrap(err, "invalid Kafka REST proxy response")
+	}
+	for _, o := range offsets.Offsets {
+		if o.Error != nil {
+			return errors.Errorf("failed to produce record: %s", *o.Error)
+		}
+		if o.ErrorCode != nil {
+			return errors.Errorf("failed to produce record: error code %d", *o.ErrorCode)
+		}
+	}
+	return nil
+}
+
+func (k *kafkaRESTProxySink) Close() error {
+	k.client.CloseIdleConnections()
+	return nil
+}
+
+func postJSON(ctx context.Context, client *http.Client, endpoint string, contentType string, body []byte) ([]byte, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, endpoint, bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", contentType)
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	respBody, err := io.ReadAll(io.LimitReader(resp.Body, 1<<20))
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return nil, errors.Errorf("unexpected status %s: %s", resp.Status, respBody)
+	}
+	return respBody, nil
+}
@@ -0,0 +1,137 @@
+package headreporter_test
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	"github.com/smartcontractkit/chainlink/v2/core/services/headreporter"
+)
+
+var testEvent = headreporter.HeadEvent{Type: headreporter.HeadEventNewHead, ChainID: "10", Number: 42, Hash: "0x2a"}
+
+func Test_WebhookSink(t *testing.T) {
+	var received headreporter.HeadEvent
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "application/json", r.Header.Get("Content-Type"))
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&received))
+	}))
+	defer srv.Close()
+
+	sink := headreporter.NewWebhookSink("ui", mustParseURL(t, srv.URL))
+	require.NoError(t, sink.Publish(testutils.Context(t), testEvent))
+	assert.Equal(t, testEvent, received)
+	require.NoError(t, sink.Close())
+}
+
+func Test_KafkaRESTProxySink(t *testing.T) {
+	var body map[string]any
+	response := `{"offsets":[{"partition":0,"offset":1,"error_code":null,"error":null}]}`
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/topics/heads", r.URL.Path)
+		assert.Equal(t, "application/vnd.kafka.json.v2+json", r.Header.Get("Content-Type"))
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+		_, _ = io.WriteString(w, response)
+	}))
+	defer srv.Close()
+
+	sink := headreporter.NewKafkaRESTProxySink("kafka", mustParseURL(t, srv.URL), "heads")
+	require.NoError(t, sink.Publish(testutils.Context(t), testEvent))
+	records := body["records"].([]any)
+	require.Len(t, records, 1)
+	assert.Equal(t, "10", records[0].(map[string]any)["key"])
+
+	response = `{"offsets":[{"partition":null,"offset":null,"error_code":50301,"error":"topic not found"}]}`
+	require.ErrorContains(t, sink.Publish(testutils.Context(t), testEvent), "topic not found")
+}
+
+func Test_NATSSink(t *testing.T) {
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	defer l.Close()
+
+	published := make(chan string, 1)
+	go func() {
+		conn, aerr := l.Accept()
+		if aerr != nil {
+			return
+		}
+		defer conn.Close()
+		r := bufio.NewReader(conn)
+		_, _ = io.WriteString(conn, "INFO {\"server_id\":\"test\",\"max_payload\":1048576}\r\n")
+		for {
+			line, rerr := r.ReadString('\n')
+			if rerr != nil {
+				return
+			}
+			switch {
+			case strings.HasPrefix(line, "CONNECT"):
+				if !strings.Contains(line, `"user":"alice","pass":"secret"`) {
+					_, _ = io.WriteString(conn, "-ERR 'Authorization Violation'\r\n")
+				}
+			case strings.HasPrefix(line, "PUB"):
+				var subject string
+				var size int
+				_, _ = fmt.Sscanf(line, "PUB %s %d", &subject, &size)
+				payload := make([]byte, size+2)
+				if _, rerr = io.ReadFull(r, payload); rerr != nil {
+					return
+				}
+				// the server pings between the publish and the pong
+				_, _ = io.WriteString(conn, "PING\r\n")
+				published <- subject + " " + string(payload[:size])
+			case line == "PONG\r\n":
+			case line == "PING\r\n":
+				_, _ = io.WriteString(conn, "PONG\r\n")
+			}
+		}
+	}()
+
+	sink := headreporter.NewNATSSink(logger.TestLogger(t), "nats", mustParseURL(t, "nats://alice:secret@"+l.Addr().String()), "chainlink.heads")
+	require.NoError(t, sink.Publish(testutils.Context(t), testEvent))
+	msg := <-published
+	subject, payload, _ := strings.Cut(msg, " ")
+	assert.Equal(t, "chainlink.heads.10", subject)
+	var event headreporter.HeadEvent
+	require.NoError(t, json.Unmarshal([]byte(payload), &event))
+	assert.Equal(t, testEvent, event)
+	require.NoError(t, sink.Close())
+
+	t.Run("authorization error", func(t *testing.T) {
+		l, err := net.Listen("tcp", "127.0.0.1:0")
+		require.NoError(t, err)
+		defer l.Close()
+		go func() {
+			conn, aerr := l.Accept()
+			if aerr != nil {
+				return
+			}
+			defer conn.Close()
+			_, _ = io.WriteString(conn, "INFO {\"max_payload\":1048576}\r\n-ERR 'Authorization Violation'\r\n")
+			_, _ = io.Copy(io.Discard, conn)
+		}()
+
+		sink := headreporter.NewNATSSink(logger.TestLogger(t), "nats", mustParseURL(t, "nats://"+l.Addr().String()), "chainlink.heads")
+		err = sink.Publish(testutils.Context(t), testEvent)
+		require.Error(t, err)
+		assert.Contains(t, strings.ToLower(err.Error()), "authorization violation")
+	})
+}
+
+func mustParseURL(t *testing.T, s string) *url.URL {
+	u, err := url.Parse(s)
+	require.NoError(t, err)
+	return u
+}
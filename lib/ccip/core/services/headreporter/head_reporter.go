@@ -2,9 +2,12 @@ package headreporter
 
 import (
 	"context"
+	"io"
 	"sync"
 	"time"
 
+	"go.uber.org/multierr"
+
 	"github.com/smartcontractkit/chainlink-common/pkg/services"
 	"github.com/smartcontractkit/chainlink-common/pkg/sqlutil"
 	"github.com/smartcontractkit/chainlink-common/pkg/utils/mailbox"
@@ -61,7 +64,13 @@ func (hrd *HeadReporterService) Close() error {
 	return hrd.StopOnce(hrd.Name(), func() error {
 		close(hrd.chStop)
 		hrd.wgDone.Wait()
-		return nil
+		var err error
+		for _, reporter := range hrd.reporters {
+			if closer, ok := reporter.(io.Closer); ok {
+				err = multierr.Append(err, closer.Close())
+			}
+		}
+		return err
 	})
 }
 
@@ -0,0 +1,87 @@
+package headreporter
+
+import (
+	"context"
+	"encoding/json"
+	"net/url"
+	"sync"
+
+	"github.com/nats-io/nats.go"
+	"github.com/pkg/errors"
+
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+)
+
+// natsSink publishes the events to the NATS subject <topic>.<chainID>. Every publish is flushed, so that it returns
+// once the server processed the message. The connection is opened on the first publish, and is then kept and
+// re-established by the client. Credentials are read from the URL, and tls:// URLs, or servers requiring TLS, use a
+// TLS connection verified with the system root CAs.
+type natsSink struct {
+	lggr    logger.Logger
+	name    string
+	url     *url.URL
+	subject string
+
+	mu   sync.Mutex
+	conn *nats.Conn
+}
+
+func NewNATSSink(lggr logger.Logger, name string, u *url.URL, topic string) EventSink {
+	return &natsSink{lggr: lggr.Named("NATSSink"), name: name, url: u, subject: topic}
+}
+
+func (n *natsSink) Name() string {
+	return n.name
+}
+
+func (n *natsSink) Publish(ctx context.Context, event HeadEvent) error {
+	payload, err := json.Marshal(event)
+	if err != nil {
+		return err
+	}
+
+	conn, err := n.connection()
+	if err != nil {
+		return errors.Wrap(err, "failed to connect to NATS")
+	}
+	if err = conn.Publish(n.subject+"."+event.ChainID, payload); err != nil {
+		return err
+	}
+	return conn.FlushWithContext(ctx)
+}
+
+func (n *natsSink) Close() error {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	if n.conn != nil {
+		n.conn.Close()
+		n.conn = nil
+	}
+	return nil
+}
+
+func (n *natsSink) connection() (*nats.Conn, error) {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	if n.conn != nil {
+		return n.conn, nil
+	}
+
+	conn, err := nats.Connect(n.url.String(),
+		nats.Name("chainlink-head-reporter"),
+		nats.Timeout(eventSinkTimeout),
+		nats.MaxReconnects(-1),
+		nats.DisconnectErrHandler(func(_ *nats.Conn, err error) {
+			n.lggr.Warnw("Disconnected from NATS", "sink", n.name, "err", err)
+		}),
+		nats.ReconnectHandler(func(c *nats.Conn) {
+			n.lggr.Infow("Reconnected to NATS", "sink", n.name, "server", c.ConnectedUrlRedacted())
+		}),
+	)
+	if err != nil {
+		return nil, err
+	}
+	n.lggr.Debugw("Connected to NATS", "sink", n.name, "server", conn.ConnectedUrlRedacted())
+	n.conn = conn
+	return conn, nil
+}
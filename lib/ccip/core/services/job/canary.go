@@ -0,0 +1,253 @@
+package job
+
+import (
+	"context"
+	"sync"
+
+	pkgerrors "github.com/pkg/errors"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/services"
+
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	"github.com/smartcontractkit/chainlink/v2/core/services/pipeline"
+)
+
+// CanaryState is the state of the canary of a proposed job spec.
+type CanaryState string
+
+const (
+	// CanaryRunning canaries shadow the live runs of the job.
+	CanaryRunning CanaryState = "running"
+	// CanaryPassed canaries matched the outputs of all their live runs, without promoting the proposed spec.
+	CanaryPassed CanaryState = "passed"
+	// CanaryPromoted canaries matched the outputs of all their live runs, and replaced the job with the proposed spec.
+	CanaryPromoted CanaryState = "promoted"
+	// CanaryRefused canaries stopped at the first live run whose outputs didn't match, the job is left unchanged.
+	CanaryRefused CanaryState = "refused"
+	// CanaryErrored canaries failed to dry run the proposed spec, or to promote it.
+	CanaryErrored CanaryState = "errored"
+	// CanaryCancelled canaries were replaced by another canary, or their job was deleted.
+	CanaryCancelled CanaryState = "cancelled"
+)
+
+// CanaryStatus is the progress of the canary of a proposed job spec. Results compare each shadowed live run, as Current,
+// with the dry run of the proposed spec, as Proposed.
+type CanaryStatus struct {
+	JobID   int32          `json:"jobID"`
+	Runs    int            `json:"runs"`
+	Promote bool           `json:"promote"`
+	State   CanaryState    `json:"state"`
+	Results []ReplayResult `json:"results"`
+	Error   string         `json:"error,omitempty"`
+}
+
+// PromoteFunc replaces the job with the proposed spec, which has the ID of the job.
+type PromoteFunc func(ctx context.Context, proposed *Job) error
+
+// Canaries shadow the next live runs of jobs with dry runs of proposed specs. Each dry run gets the vars of the live run,
+// and the responses of its http and bridge tasks. A canary is refused at the first run whose outputs don't match the
+// live one. Once all runs match, the proposed spec is promoted if requested.
+type Canaries struct {
+	services.StateMachine
+	lggr    logger.Logger
+	runner  pipeline.Runner
+	promote PromoteFunc
+
+	mu       sync.Mutex
+	canaries map[int32]*canary
+
+	stopCh services.StopChan
+	wg     sync.WaitGroup
+}
+
+type canary struct {
+	proposed Job
+	spec     pipeline.Spec
+
+	mu       sync.Mutex
+	status   CanaryStatus
+	inFlight int
+}
+
+func NewCanaries(lggr logger.Logger, runner pipeline.Runner, promote PromoteFunc) *Canaries {
+	return &Canaries{
+		lggr:     lggr.Named("JobCanaries"),
+		runner:   runner,
+		promote:  promote,
+		canaries: make(map[int32]*canary),
+		stopCh:   make(services.StopChan),
+	}
+}
+
+func (c *Canaries) Start(context.Context) error {
+	return c.StartOnce("JobCanaries", func() error { return nil })
+}
+
+// Close cancels the running canaries, and waits for their dry runs.
+func (c *Canaries) Close() error {
+	return c.StopOnce("JobCanaries", func() error {
+		c.mu.Lock()
+		for jobID := range c.canaries {
+			c.cancelLocked(jobID)
+		}
+		c.mu.Unlock()
+		close(c.stopCh)
+		c.wg.Wait()
+		return nil
+	})
+}
+
+func (c *Canaries) Name() string {
+	return c.lggr.Name()
+}
+
+func (c *Canaries) HealthReport() map[string]error {
+	return map[string]error{c.Name(): c.Healthy()}
+}
+
+// Run starts the canary of the proposed spec of a job, over its next runs live runs. The pipeline spec is the one of the
+// proposed job. A canary already running for the job is cancelled.
+func (c *Canaries) Run(proposed Job, spec pipeline.Spec, runs int, promote bool) (CanaryStatus, error) {
+	if runs <= 0 {
+		return CanaryStatus{}, pkgerrors.New("canary must shadow at least one run")
+	}
+	cn := &canary{
+		proposed: proposed,
+		spec:     spec,
+		status:   CanaryStatus{JobID: proposed.ID, Runs: runs, Promote: promote, State: CanaryRunning},
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.cancelLocked(proposed.ID)
+	c.canaries[proposed.ID] = cn
+	c.runner.ShadowJobRuns(proposed.ID, func(run *pipeline.Run, vars pipeline.Vars) {
+		c.shadow(cn, run, vars)
+	})
+	c.lggr.Infow("Started canary of proposed job spec", "jobID", proposed.ID, "runs", runs, "promote", promote)
+	return cn.snapshot(), nil
+}
+
+// Status returns the status of the last canary of the job.
+func (c *Canaries) Status(jobID int32) (CanaryStatus, bool) {
+	c.mu.Lock()
+	cn, ok := c.canaries[jobID]
+	c.mu.Unlock()
+	if !ok {
+		return CanaryStatus{}, false
+	}
+	return cn.snapshot(), true
+}
+
+// Cancel stops the canary running for the job, if any.
+func (c *Canaries) Cancel(jobID int32) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.cancelLocked(jobID)
+}
+
+func (c *Canaries) cancelLocked(jobID int32) {
+	cn, ok := c.canaries[jobID]
+	if !ok {
+		return
+	}
+	cn.mu.Lock()
+	defer cn.mu.Unlock()
+	if cn.status.State == CanaryRunning {
+		c.runner.ShadowJobRuns(jobID, nil)
+		cn.status.State = CanaryCancelled
+	}
+}
+
+// shadow is called by the runner with a finished live run, it must not block.
+func (c *Canaries) shadow(cn *canary, run *pipeline.Run, vars pipeline.Vars) {
+	cn.mu.Lock()
+	if cn.status.State != CanaryRunning || len(cn.status.Results)+cn.inFlight >= cn.status.Runs {
+		cn.mu.Unlock()
+		return
+	}
+	cn.inFlight++
+	cn.mu.Unlock()
+
+	// copy what is compared before the run returns, the caller may still change it
+	current, err := NewReplayRun(run)
+	stubs := pipeline.StubsFromRun(run)
+
+	c.wg.Add(1)
+	go func() {
+		defer c.wg.Done()
+		ctx, cancel := c.stopCh.NewCtx()
+		defer cancel()
+
+		var result ReplayResult
+		if err == nil {
+			result, err = c.dryRun(ctx, cn, current, vars, stubs)
+		}
+		c.record(ctx, cn, result, err)
+	}()
+}
+
+func (c *Canaries) dryRun(ctx context.Context, cn *canary, current ReplayRun, vars pipeline.Vars, stubs pipeline.DryRunStubs) (ReplayResult, error) {
+	run, _, err := c.runner.ExecuteDryRun(ctx, cn.spec, vars, stubs)
+	if err != nil {
+		return ReplayResult{}, err
+	}
+	proposed, err := NewReplayRun(run)
+	if err != nil {
+		return ReplayResult{}, err
+	}
+	return compareReplayRuns(current, proposed), nil
+}
+
+// record adds the result of a shadowed run, and refuses, passes or promotes the canary once it is decided.
+func (c *Canaries) record(ctx context.Context, cn *canary, result ReplayResult, err error) {
+	jobID := cn.proposed.ID
+	cn.mu.Lock()
+	cn.inFlight--
+	if cn.status.State != CanaryRunning {
+		cn.mu.Unlock()
+		return
+	}
+	switch {
+	case err != nil:
+		cn.status.State, cn.status.Error = CanaryErrored, pkgerrors.Wrap(err, "dry run of proposed spec").Error()
+	case !result.Match:
+		cn.status.Results = append(cn.status.Results, result)
+		cn.status.State = CanaryRefused
+	default:
+		cn.status.Results = append(cn.status.Results, result)
+		if len(cn.status.Results) < cn.status.Runs {
+			cn.mu.Unlock()
+			return
+		}
+		cn.status.State = CanaryPassed
+	}
+	// the canary is decided, later runs of the job are not shadowed
+	c.runner.ShadowJobRuns(jobID, nil)
+	state, promote := cn.status.State, cn.status.Promote
+	cn.mu.Unlock()
+
+	c.lggr.Infow("Canary of proposed job spec finished", "jobID", jobID, "state", state, "promote", promote)
+	if state != CanaryPassed || !promote {
+		return
+	}
+	proposed := cn.proposed
+	err = c.promote(ctx, &proposed)
+
+	cn.mu.Lock()
+	defer cn.mu.Unlock()
+	if err != nil {
+		c.lggr.Errorw("Failed to promote proposed job spec", "jobID", jobID, "err", err)
+		cn.status.State, cn.status.Error = CanaryErrored, pkgerrors.Wrap(err, "promoting proposed spec").Error()
+		return
+	}
+	cn.status.State = CanaryPromoted
+}
+
+func (cn *canary) snapshot() CanaryStatus {
+	cn.mu.Lock()
+	defer cn.mu.Unlock()
+	status := cn.status
+	status.Results = append([]ReplayResult(nil), cn.status.Results...)
+	return status
+}
@@ -0,0 +1,130 @@
+package job_test
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/guregu/null.v4"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/services/servicetest"
+
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils/configtest"
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	"github.com/smartcontractkit/chainlink/v2/core/services/job"
+	"github.com/smartcontractkit/chainlink/v2/core/services/pipeline"
+)
+
+func TestCanaries(t *testing.T) {
+	t.Parallel()
+
+	const jobID = int32(7)
+	cfg := configtest.NewTestGeneralConfig(t)
+	newSpec := func(times string) pipeline.Spec {
+		return pipeline.Spec{JobID: jobID, DotDagSource: `b [type=multiply input="$(input)" times="` + times + `"];`}
+	}
+	current := newSpec("2")
+
+	type promoted struct {
+		mu   sync.Mutex
+		jobs []job.Job
+	}
+	newCanaries := func(t *testing.T) (*job.Canaries, pipeline.Runner, *promoted) {
+		r := pipeline.NewRunner(nil, nil, cfg.JobPipeline(), cfg.WebServer(), nil, nil, nil, logger.TestLogger(t), nil, nil)
+		p := &promoted{}
+		c := job.NewCanaries(logger.TestLogger(t), r, func(_ context.Context, proposed *job.Job) error {
+			p.mu.Lock()
+			defer p.mu.Unlock()
+			p.jobs = append(p.jobs, *proposed)
+			return nil
+		})
+		servicetest.Run(t, c)
+		return c, r, p
+	}
+	liveRun := func(t *testing.T, r pipeline.Runner, input int) {
+		_, _, err := r.ExecuteRun(testutils.Context(t), current, pipeline.NewVarsFrom(map[string]interface{}{"input": input}))
+		require.NoError(t, err)
+	}
+	awaitState := func(t *testing.T, c *job.Canaries, state job.CanaryState) job.CanaryStatus {
+		var status job.CanaryStatus
+		require.Eventually(t, func() bool {
+			status, _ = c.Status(jobID)
+			return status.State == state
+		}, testutils.WaitTimeout(t), 10*time.Millisecond)
+		return status
+	}
+
+	t.Run("promotes the proposed spec once all runs match", func(t *testing.T) {
+		c, r, p := newCanaries(t)
+		status, err := c.Run(job.Job{ID: jobID, Name: null.StringFrom("feed")}, newSpec("2.0"), 2, true)
+		require.NoError(t, err)
+		assert.Equal(t, job.CanaryRunning, status.State)
+
+		liveRun(t, r, 1)
+		require.Eventually(t, func() bool {
+			status, _ = c.Status(jobID)
+			return len(status.Results) == 1
+		}, testutils.WaitTimeout(t), 10*time.Millisecond)
+		assert.Equal(t, job.CanaryRunning, status.State)
+
+		liveRun(t, r, 2)
+		status = awaitState(t, c, job.CanaryPromoted)
+		require.Len(t, status.Results, 2)
+		assert.True(t, status.Results[1].Match)
+		require.NotNil(t, status.Results[1].Current.Outputs[0])
+		assert.Equal(t, "4", *status.Results[1].Current.Outputs[0])
+		p.mu.Lock()
+		require.Len(t, p.jobs, 1)
+		assert.Equal(t, jobID, p.jobs[0].ID)
+		p.mu.Unlock()
+
+		// later runs are not shadowed
+		liveRun(t, r, 3)
+		status, _ = c.Status(jobID)
+		assert.Len(t, status.Results, 2)
+	})
+
+	t.Run("refuses the proposed spec at the first mismatch", func(t *testing.T) {
+		c, r, p := newCanaries(t)
+		_, err := c.Run(job.Job{ID: jobID}, newSpec("3"), 3, true)
+		require.NoError(t, err)
+
+		liveRun(t, r, 1)
+		status := awaitState(t, c, job.CanaryRefused)
+		require.Len(t, status.Results, 1)
+		assert.False(t, status.Results[0].Match)
+		assert.Empty(t, p.jobs)
+	})
+
+	t.Run("passes without promoting", func(t *testing.T) {
+		c, r, p := newCanaries(t)
+		_, err := c.Run(job.Job{ID: jobID}, newSpec("2"), 1, false)
+		require.NoError(t, err)
+
+		liveRun(t, r, 1)
+		awaitState(t, c, job.CanaryPassed)
+		assert.Empty(t, p.jobs)
+	})
+
+	t.Run("cancels the running canary", func(t *testing.T) {
+		c, r, _ := newCanaries(t)
+		_, err := c.Run(job.Job{ID: jobID}, newSpec("2"), 1, false)
+		require.NoError(t, err)
+		c.Cancel(jobID)
+
+		liveRun(t, r, 1)
+		status, ok := c.Status(jobID)
+		require.True(t, ok)
+		assert.Equal(t, job.CanaryCancelled, status.State)
+		assert.Empty(t, status.Results)
+
+		_, ok = c.Status(jobID + 1)
+		assert.False(t, ok)
+		_, err = c.Run(job.Job{ID: jobID}, newSpec("2"), 0, false)
+		require.ErrorContains(t, err, "at least one run")
+	})
+}
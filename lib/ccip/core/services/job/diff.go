@@ -0,0 +1,178 @@
+package job
+
+import (
+	"bytes"
+	"encoding/json"
+	"reflect"
+	"sort"
+	"strings"
+	"unicode"
+
+	"github.com/pkg/errors"
+
+	"github.com/smartcontractkit/chainlink/v2/core/services/pipeline"
+)
+
+// FieldChange is a changed job spec field, identified by its TOML path.
+// Old is nil for added fields, and New is nil for removed fields.
+type FieldChange struct {
+	Path string      `json:"path"`
+	Old  interface{} `json:"old"`
+	New  interface{} `json:"new"`
+}
+
+// SpecDiff is the semantic difference between two versions of a job spec.
+type SpecDiff struct {
+	Fields   []FieldChange         `json:"fields"`
+	Pipeline pipeline.PipelineDiff `json:"pipeline"`
+}
+
+func (d SpecDiff) IsEmpty() bool {
+	return len(d.Fields) == 0 && d.Pipeline.IsEmpty()
+}
+
+// diffIgnoredFields are not set from the TOML spec, and always differ between a saved and a proposed job.
+var diffIgnoredFields = map[string]struct{}{
+	"ID":             {},
+	"CreatedAt":      {},
+	"UpdatedAt":      {},
+	"JobSpecErrors":  {},
+	"PipelineSpecID": {},
+	"PipelineSpec":   {},
+	"Pipeline":       {},
+}
+
+// DiffSpecs returns the difference between the current and proposed versions of a job.
+// Spec fields are compared by value, JSON configs like pluginConfig and relayConfig are compared key by key,
+// and the pipelines are compared task by task.
+func DiffSpecs(current, proposed Job) (SpecDiff, error) {
+	if current.Type != proposed.Type {
+		return SpecDiff{}, errors.Errorf("job type can't be changed from %s to %s", current.Type, proposed.Type)
+	}
+
+	var d SpecDiff
+	diffStructs("", reflect.ValueOf(current), reflect.ValueOf(proposed), &d.Fields)
+	sort.Slice(d.Fields, func(i, j int) bool {
+		return d.Fields[i].Path < d.Fields[j].Path
+	})
+
+	var err error
+	d.Pipeline, err = pipeline.DiffPipelines(current.dotDagSource(), proposed.dotDagSource())
+	if err != nil {
+		return SpecDiff{}, err
+	}
+	return d, nil
+}
+
+// dotDagSource returns the pipeline source of saved jobs, which have a pipeline spec, and of parsed TOML specs.
+func (j Job) dotDagSource() string {
+	if j.PipelineSpec != nil && j.PipelineSpec.DotDagSource != "" {
+		return j.PipelineSpec.DotDagSource
+	}
+	return j.Pipeline.Source
+}
+
+func diffStructs(prefix string, current, proposed reflect.Value, changes *[]FieldChange) {
+	typ := current.Type()
+	for i := 0; i < typ.NumField(); i++ {
+		field := typ.Field(i)
+		if !field.IsExported() || strings.HasSuffix(field.Name, "SpecID") {
+			continue
+		}
+		if _, ignored := diffIgnoredFields[field.Name]; ignored {
+			continue
+		}
+		tag := field.Tag.Get("toml")
+		if tag == "-" {
+			continue
+		}
+
+		cur, prop := current.Field(i), proposed.Field(i)
+		// Type specific specs are inlined in the TOML
+		if tag == "" && field.Type.Kind() == reflect.Ptr && field.Type.Elem().Kind() == reflect.Struct && strings.HasSuffix(field.Name, "Spec") {
+			if cur.IsNil() && prop.IsNil() {
+				continue
+			}
+			diffStructs(prefix, derefOrZero(cur), derefOrZero(prop), changes)
+			continue
+		}
+
+		name := strings.Split(tag, ",")[0]
+		if name == "" {
+			name = lowerCamel(field.Name)
+		}
+		diffValues(prefix+name, cur.Interface(), prop.Interface(), changes)
+	}
+}
+
+func diffValues(path string, current, proposed interface{}, changes *[]FieldChange) {
+	curMap, curIsMap := asStringMap(current)
+	propMap, propIsMap := asStringMap(proposed)
+	if curIsMap && propIsMap {
+		keys := make(map[string]struct{}, len(curMap)+len(propMap))
+		for k := range curMap {
+			keys[k] = struct{}{}
+		}
+		for k := range propMap {
+			keys[k] = struct{}{}
+		}
+		for k := range keys {
+			diffValues(path+"."+k, curMap[k], propMap[k], changes)
+		}
+		return
+	}
+
+	if !equalValues(current, proposed) {
+		*changes = append(*changes, FieldChange{Path: path, Old: current, New: proposed})
+	}
+}
+
+// equalValues compares values by their JSON encoding when they aren't deeply equal, since JSON configs
+// decoded from the database and from TOML use different number types.
+func equalValues(a, b interface{}) bool {
+	if reflect.DeepEqual(a, b) {
+		return true
+	}
+	aJSON, err := json.Marshal(a)
+	if err != nil {
+		return false
+	}
+	bJSON, err := json.Marshal(b)
+	if err != nil {
+		return false
+	}
+	return bytes.Equal(aJSON, bJSON)
+}
+
+func asStringMap(v interface{}) (map[string]interface{}, bool) {
+	switch m := v.(type) {
+	case JSONConfig:
+		return m, true
+	case map[string]interface{}:
+		return m, true
+	}
+	return nil, false
+}
+
+func derefOrZero(v reflect.Value) reflect.Value {
+	if v.IsNil() {
+		return reflect.New(v.Type().Elem()).Elem()
+	}
+	return v.Elem()
+}
+
+// lowerCamel converts a Go field name to its TOML key, e.g. MaxTaskDuration to maxTaskDuration and EVMChainID to evmChainID.
+func lowerCamel(s string) string {
+	runes := []rune(s)
+	for i := range runes {
+		if !unicode.IsUpper(runes[i]) {
+			break
+		}
+		// Keep the last upper case letter of an acronym followed by a lower case letter, e.g. the C of EVMChainID
+		if i > 0 && i+1 < len(runes) && unicode.IsLower(runes[i+1]) {
+			break
+		}
+		runes[i] = unicode.ToLower(runes[i])
+	}
+	return string(runes)
+}
@@ -0,0 +1,119 @@
+package job
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/guregu/null.v4"
+
+	"github.com/smartcontractkit/chainlink/v2/core/services/pipeline"
+)
+
+func TestDiffSpecs(t *testing.T) {
+	t.Parallel()
+
+	newJob := func(mutate func(jb *Job)) Job {
+		jb := Job{
+			ID:   1,
+			Type: OffchainReporting2,
+			Name: null.StringFrom("feed"),
+			OCR2OracleSpec: &OCR2OracleSpec{
+				ID:          2,
+				ContractID:  "0x1469877c88F19E273EFC7Ef3C9D944574583B8a0",
+				Relay:       "evm",
+				RelayConfig: JSONConfig{"chainID": float64(1)},
+				PluginConfig: JSONConfig{
+					"juelsPerFeeCoinSource": "ds1 -> ds1_parse",
+					"nested":                map[string]interface{}{"a": float64(1)},
+				},
+				ContractConfigConfirmations: 1,
+			},
+			PipelineSpec: &pipeline.Spec{DotDagSource: `ds1 [type=memo value=1];`},
+		}
+		if mutate != nil {
+			mutate(&jb)
+		}
+		return jb
+	}
+
+	t.Run("no changes", func(t *testing.T) {
+		d, err := DiffSpecs(newJob(nil), newJob(func(jb *Job) {
+			// Not set from TOML
+			jb.ID = 0
+			jb.OCR2OracleSpec.ID = 0
+			// Numbers decoded from TOML and JSON differ in type
+			jb.OCR2OracleSpec.RelayConfig = JSONConfig{"chainID": int64(1)}
+			// Proposed specs haven't been saved yet
+			jb.PipelineSpec = nil
+			jb.Pipeline = pipeline.Pipeline{Source: `ds1 [type=memo value=1];`}
+		}))
+		require.NoError(t, err)
+		assert.True(t, d.IsEmpty(), d)
+	})
+
+	t.Run("changed fields, plugin config and pipeline", func(t *testing.T) {
+		d, err := DiffSpecs(newJob(nil), newJob(func(jb *Job) {
+			jb.Name = null.StringFrom("feed v2")
+			jb.OCR2OracleSpec.ContractConfigConfirmations = 3
+			jb.OCR2OracleSpec.PluginConfig = JSONConfig{
+				"juelsPerFeeCoinSource": "ds1 -> ds1_parse",
+				"nested":                map[string]interface{}{"a": float64(2)},
+				"added":                 true,
+			}
+			jb.PipelineSpec = &pipeline.Spec{DotDagSource: `ds1 [type=memo value=2];`}
+		}))
+		require.NoError(t, err)
+		assert.Equal(t, []FieldChange{
+			{Path: "contractConfigConfirmations", Old: uint16(1), New: uint16(3)},
+			{Path: "name", Old: null.StringFrom("feed"), New: null.StringFrom("feed v2")},
+			{Path: "pluginConfig.added", Old: nil, New: true},
+			{Path: "pluginConfig.nested.a", Old: float64(1), New: float64(2)},
+		}, d.Fields)
+		assert.Equal(t, []pipeline.TaskDiff{
+			{DotID: "ds1", Attributes: []pipeline.AttributeChange{{Key: "value", Old: "1", New: "2"}}},
+		}, d.Pipeline.ChangedTasks)
+	})
+
+	t.Run("job type can't change", func(t *testing.T) {
+		_, err := DiffSpecs(newJob(nil), newJob(func(jb *Job) {
+			jb.Type = Bootstrap
+		}))
+		require.ErrorContains(t, err, "job type can't be changed")
+	})
+}
+
+func TestReplayReport(t *testing.T) {
+	t.Parallel()
+
+	run := func(output string, fatalErr null.String) *pipeline.Run {
+		r := &pipeline.Run{FatalErrors: pipeline.RunErrors{fatalErr}}
+		require.NoError(t, r.Outputs.UnmarshalJSON([]byte(`["`+output+`"]`)))
+		return r
+	}
+
+	matching, err := NewReplayResult(run("1", null.String{}), run("1", null.String{}))
+	require.NoError(t, err)
+	assert.True(t, matching.Match)
+
+	mismatching, err := NewReplayResult(run("1", null.String{}), run("2", null.String{}))
+	require.NoError(t, err)
+	assert.False(t, mismatching.Match)
+
+	erroring, err := NewReplayResult(run("1", null.String{}), run("1", null.StringFrom("boom")))
+	require.NoError(t, err)
+	assert.False(t, erroring.Match)
+
+	assert.False(t, ReplayReport{}.Passed())
+	assert.True(t, ReplayReport{Results: []ReplayResult{matching, matching}}.Passed())
+	assert.False(t, ReplayReport{Results: []ReplayResult{matching, mismatching}}.Passed())
+}
+
+func Test_lowerCamel(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, "maxTaskDuration", lowerCamel("MaxTaskDuration"))
+	assert.Equal(t, "evmChainID", lowerCamel("EVMChainID"))
+	assert.Equal(t, "id", lowerCamel("ID"))
+	assert.Equal(t, "name", lowerCamel("Name"))
+}
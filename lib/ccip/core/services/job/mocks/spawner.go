@@ -442,6 +442,53 @@ func (_c *Spawner_StartService_Call) RunAndReturn(run func(context.Context, job.
 	return _c
 }
 
+// ValidateJob provides a mock function with given fields: ctx, jb
+func (_m *Spawner) ValidateJob(ctx context.Context, jb job.Job) error {
+	ret := _m.Called(ctx, jb)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ValidateJob")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, job.Job) error); ok {
+		r0 = rf(ctx, jb)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Spawner_ValidateJob_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ValidateJob'
+type Spawner_ValidateJob_Call struct {
+	*mock.Call
+}
+
+// ValidateJob is a helper method to define mock.On call
+//   - ctx context.Context
+//   - jb job.Job
+func (_e *Spawner_Expecter) ValidateJob(ctx interface{}, jb interface{}) *Spawner_ValidateJob_Call {
+	return &Spawner_ValidateJob_Call{Call: _e.mock.On("ValidateJob", ctx, jb)}
+}
+
+func (_c *Spawner_ValidateJob_Call) Run(run func(ctx context.Context, jb job.Job)) *Spawner_ValidateJob_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(job.Job))
+	})
+	return _c
+}
+
+func (_c *Spawner_ValidateJob_Call) Return(_a0 error) *Spawner_ValidateJob_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Spawner_ValidateJob_Call) RunAndReturn(run func(context.Context, job.Job) error) *Spawner_ValidateJob_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // NewSpawner creates a new instance of Spawner. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewSpawner(t interface {
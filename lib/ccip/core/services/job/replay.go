@@ -0,0 +1,70 @@
+package job
+
+import (
+	"reflect"
+
+	"github.com/smartcontractkit/chainlink/v2/core/services/pipeline"
+)
+
+// ReplayRun holds the outputs of a dry run of one version of a job spec.
+type ReplayRun struct {
+	Outputs     []*string `json:"outputs"`
+	FatalErrors []*string `json:"fatalErrors"`
+}
+
+// ReplayResult compares dry runs of the current and proposed versions of a job spec, executed with the same input.
+type ReplayResult struct {
+	Current  ReplayRun `json:"current"`
+	Proposed ReplayRun `json:"proposed"`
+	Match    bool      `json:"match"`
+}
+
+// ReplayReport holds the results of replaying caller supplied inputs against the current and proposed versions of a job
+// spec, one per input. Replays are dry runs, they don't observe the live triggers of the job.
+type ReplayReport struct {
+	Results []ReplayResult `json:"results"`
+}
+
+// Passed returns true if at least one input was replayed, and the proposed spec produced the same outputs as the current
+// one for all inputs.
+func (r ReplayReport) Passed() bool {
+	if len(r.Results) == 0 {
+		return false
+	}
+	for _, result := range r.Results {
+		if !result.Match {
+			return false
+		}
+	}
+	return true
+}
+
+// NewReplayResult compares the outputs and fatal errors of the current and proposed runs.
+func NewReplayResult(current, proposed *pipeline.Run) (ReplayResult, error) {
+	currentRun, err := NewReplayRun(current)
+	if err != nil {
+		return ReplayResult{}, err
+	}
+	proposedRun, err := NewReplayRun(proposed)
+	if err != nil {
+		return ReplayResult{}, err
+	}
+	return compareReplayRuns(currentRun, proposedRun), nil
+}
+
+func compareReplayRuns(current, proposed ReplayRun) ReplayResult {
+	return ReplayResult{
+		Current:  current,
+		Proposed: proposed,
+		Match:    reflect.DeepEqual(current, proposed),
+	}
+}
+
+// NewReplayRun returns the outputs and fatal errors of a finished run.
+func NewReplayRun(run *pipeline.Run) (ReplayRun, error) {
+	outputs, err := run.StringOutputs()
+	if err != nil {
+		return ReplayRun{}, err
+	}
+	return ReplayRun{Outputs: outputs, FatalErrors: run.StringFatalErrors()}, nil
+}
@@ -28,6 +28,8 @@ type (
 		CreateJob(ctx context.Context, ds sqlutil.DataSource, jb *Job) (err error)
 		// DeleteJob deletes a job and stops any active services.
 		DeleteJob(ctx context.Context, ds sqlutil.DataSource, jobID int32) error
+		// ValidateJob checks that the job can be created on this node, without creating it.
+		ValidateJob(ctx context.Context, jb Job) error
 		// ActiveJobs returns a map of jobs with active services (started without error).
 		ActiveJobs() map[int32]Job
 
@@ -76,6 +78,12 @@ type (
 		OnDeleteJob(ctx context.Context, jb Job) error
 	}
 
+	// SpecValidator is implemented by delegates which can check a job against the node, e.g. that its chains and keys
+	// exist, before it is created.
+	SpecValidator interface {
+		ValidateSpec(ctx context.Context, jb Job) error
+	}
+
 	activeJob struct {
 		delegate Delegate
 		spec     Job
@@ -273,6 +281,18 @@ func (js *spawner) CreateJob(ctx context.Context, ds sqlutil.DataSource, jb *Job
 	return err
 }
 
+// ValidateJob checks that the job type is enabled and runs the validation of its delegate, if any.
+func (js *spawner) ValidateJob(ctx context.Context, jb Job) error {
+	delegate, exists := js.jobTypeDelegates[jb.Type]
+	if !exists {
+		return pkgerrors.Errorf("job type '%s' has not been registered with the job.Spawner", jb.Type)
+	}
+	if validator, ok := delegate.(SpecValidator); ok {
+		return validator.ValidateSpec(ctx, jb)
+	}
+	return nil
+}
+
 // Should not get called before Start()
 func (js *spawner) DeleteJob(ctx context.Context, ds sqlutil.DataSource, jobID int32) error {
 	if ds == nil {
@@ -9,7 +9,9 @@ import (
 	"testing"
 	"time"
 
+	"github.com/ethereum/go-ethereum/accounts"
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
@@ -357,6 +359,33 @@ func Test_EthKeyStore_SignTx(t *testing.T) {
 	require.NotEqual(t, tx, signed)
 }
 
+func Test_EthKeyStore_SignMessage(t *testing.T) {
+	t.Parallel()
+
+	ctx := testutils.Context(t)
+
+	db := pgtest.NewSqlxDB(t)
+	keyStore := cltest.NewKeyStore(t, db)
+	ethKeyStore := keyStore.Eth()
+
+	k, _ := cltest.MustInsertRandomKey(t, ethKeyStore)
+	message := []byte{1, 2, 3, 4}
+
+	_, err := ethKeyStore.SignMessage(ctx, testutils.NewAddress(), message)
+	require.EqualError(t, err, "Key not found")
+
+	sig, err := ethKeyStore.SignMessage(ctx, k.Address, message)
+	require.NoError(t, err)
+	require.Len(t, sig, 65)
+	require.Contains(t, []byte{27, 28}, sig[64])
+
+	// The signature recovers to the key like eth_sign signatures
+	sig[64] -= 27
+	pub, err := crypto.SigToPub(accounts.TextHash(message), sig)
+	require.NoError(t, err)
+	require.Equal(t, k.Address, crypto.PubkeyToAddress(*pub))
+}
+
 func Test_EthKeyStore_E2E(t *testing.T) {
 	t.Parallel()
 
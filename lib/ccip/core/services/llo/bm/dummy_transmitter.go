@@ -12,6 +12,7 @@ import (
 	ocr2types "github.com/smartcontractkit/libocr/offchainreporting2plus/types"
 
 	"github.com/smartcontractkit/chainlink/v2/core/services/llo/evm"
+	"github.com/smartcontractkit/chainlink/v2/core/services/llo/solana"
 
 	"github.com/smartcontractkit/chainlink-data-streams/llo"
 
@@ -96,6 +97,21 @@ func (t *transmitter) Transmit(
 					"report.Report.NativeFee", r.NativeFee,
 				)
 			}
+		case solana.ReportFormatSolanaBorsh:
+			r, err := (solana.ReportCodecSolanaBorsh{}).Decode(report.Report)
+			if err != nil {
+				lggr.Debugw(fmt.Sprintf("Failed to decode report with type %s", report.Info.ReportFormat), "err", err)
+			} else if r.ObservationsTimestamp > 0 {
+				lggr = logger.With(lggr,
+					"report.Report.FeedID", r.FeedID,
+					"report.Report.ObservationsTimestamp", r.ObservationsTimestamp,
+					"report.Report.Values", r.Values,
+					"report.Report.ValidFromTimestamp", r.ValidFromTimestamp,
+					"report.Report.ExpiresAt", r.ExpiresAt,
+					"report.Report.LinkFee", r.LinkFee,
+					"report.Report.NativeFee", r.NativeFee,
+				)
+			}
 		default:
 			err := fmt.Errorf("unhandled report format: %s", report.Info.ReportFormat)
 			lggr.Debugw(fmt.Sprintf("Failed to decode report with type %s", report.Info.ReportFormat), "err", err)
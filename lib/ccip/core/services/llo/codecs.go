@@ -1,10 +1,16 @@
 package llo
 
 import (
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"strconv"
+
 	llotypes "github.com/smartcontractkit/chainlink-common/pkg/types/llo"
 	"github.com/smartcontractkit/chainlink-data-streams/llo"
 
 	"github.com/smartcontractkit/chainlink/v2/core/services/llo/evm"
+	"github.com/smartcontractkit/chainlink/v2/core/services/llo/solana"
 )
 
 // NOTE: All supported codecs must be specified here
@@ -13,6 +19,123 @@ func NewCodecs() map[llotypes.ReportFormat]llo.ReportCodec {
 
 	codecs[llotypes.ReportFormatJSON] = llo.JSONReportCodec{}
 	codecs[llotypes.ReportFormatEVMPremiumLegacy] = evm.ReportCodecPremiumLegacy{}
+	codecs[evm.ReportFormatEVMABI] = evm.ReportCodecEVMABI{}
+	codecs[solana.ReportFormatSolanaBorsh] = solana.ReportCodecSolanaBorsh{}
 
 	return codecs
 }
+
+// localReportFormats are the report formats that are not defined in
+// chainlink-common yet, by name
+var localReportFormats = map[string]llotypes.ReportFormat{
+	"evm_abi":      evm.ReportFormatEVMABI,
+	"solana_borsh": solana.ReportFormatSolanaBorsh,
+}
+
+// ReportFormatFromString is like llotypes.ReportFormatFromString, but also
+// recognizes the report formats that are not defined in chainlink-common yet
+func ReportFormatFromString(s string) (llotypes.ReportFormat, error) {
+	if rf, ok := localReportFormats[s]; ok {
+		return rf, nil
+	}
+	rf, err := llotypes.ReportFormatFromString(s)
+	if err != nil {
+		return 0, fmt.Errorf("unknown report format: %q", s)
+	}
+	return rf, nil
+}
+
+// ReportFormatString is like llotypes.ReportFormat.String, but also names the
+// report formats that are not defined in chainlink-common yet
+func ReportFormatString(rf llotypes.ReportFormat) string {
+	for s, local := range localReportFormats {
+		if rf == local {
+			return s
+		}
+	}
+	return rf.String()
+}
+
+// ChannelDefinitions is llotypes.ChannelDefinitions, encoded to and decoded
+// from JSON with the report formats that are not defined in chainlink-common
+// yet. llotypes.ReportFormat marshals those as "unknown(N)", which it fails to
+// unmarshal, so channel definitions using them could not be loaded back once
+// persisted.
+type ChannelDefinitions llotypes.ChannelDefinitions
+
+type channelDefinitionJSON struct {
+	ReportFormat reportFormatJSON     `json:"reportFormat"`
+	Streams      []llotypes.Stream    `json:"streams"`
+	Opts         llotypes.ChannelOpts `json:"opts"`
+}
+
+type reportFormatJSON llotypes.ReportFormat
+
+func (rf reportFormatJSON) MarshalJSON() ([]byte, error) {
+	return json.Marshal(ReportFormatString(llotypes.ReportFormat(rf)))
+}
+
+func (rf *reportFormatJSON) UnmarshalJSON(data []byte) error {
+	var s string
+	if err := json.Unmarshal(data, &s); err == nil {
+		val, err := ReportFormatFromString(s)
+		if err != nil {
+			return err
+		}
+		*rf = reportFormatJSON(val)
+		return nil
+	}
+	var num uint32
+	if err := json.Unmarshal(data, &num); err != nil {
+		return fmt.Errorf("invalid JSON value for ReportFormat, expected number or string: %s", data)
+	}
+	*rf = reportFormatJSON(num)
+	return nil
+}
+
+func (c ChannelDefinitions) MarshalJSON() ([]byte, error) {
+	if c == nil {
+		return []byte("null"), nil
+	}
+	dfns := make(map[string]channelDefinitionJSON, len(c))
+	for id, cd := range c {
+		dfns[strconv.FormatUint(uint64(id), 10)] = channelDefinitionJSON{reportFormatJSON(cd.ReportFormat), cd.Streams, cd.Opts}
+	}
+	return json.Marshal(dfns)
+}
+
+func (c *ChannelDefinitions) UnmarshalJSON(data []byte) error {
+	var dfns map[llotypes.ChannelID]channelDefinitionJSON
+	if err := json.Unmarshal(data, &dfns); err != nil {
+		return err
+	}
+	if dfns == nil {
+		*c = nil
+		return nil
+	}
+	*c = make(ChannelDefinitions, len(dfns))
+	for id, cd := range dfns {
+		(*c)[id] = llotypes.ChannelDefinition{ReportFormat: llotypes.ReportFormat(cd.ReportFormat), Streams: cd.Streams, Opts: cd.Opts}
+	}
+	return nil
+}
+
+func (c *ChannelDefinitions) Scan(value interface{}) error {
+	b, ok := value.([]byte)
+	if !ok {
+		return fmt.Errorf("failed to scan Data: value is not []byte")
+	}
+	if b == nil {
+		*c = nil
+		return nil
+	}
+	if len(b) == 0 {
+		*c = ChannelDefinitions{}
+		return nil
+	}
+	return json.Unmarshal(b, c)
+}
+
+func (c ChannelDefinitions) Value() (driver.Value, error) {
+	return json.Marshal(c)
+}
@@ -1,11 +1,31 @@
 package llo
 
 import (
+	"crypto/sha256"
+	"encoding/json"
+	"fmt"
 	"testing"
 
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	bin "github.com/gagliardetto/binary"
+	"github.com/shopspring/decimal"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/libocr/commontypes"
+	"github.com/smartcontractkit/libocr/offchainreporting2plus/chains/evmutil"
+	"github.com/smartcontractkit/libocr/offchainreporting2plus/ocr3types"
+	"github.com/smartcontractkit/libocr/offchainreporting2plus/types"
 
 	llotypes "github.com/smartcontractkit/chainlink-common/pkg/types/llo"
+	"github.com/smartcontractkit/chainlink-data-streams/llo"
+
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	"github.com/smartcontractkit/chainlink/v2/core/services/keystore/chaintype"
+	"github.com/smartcontractkit/chainlink/v2/core/services/keystore/keys/ocr2key"
+	"github.com/smartcontractkit/chainlink/v2/core/services/llo/evm"
+	"github.com/smartcontractkit/chainlink/v2/core/services/llo/solana"
 )
 
 func Test_NewCodecs(t *testing.T) {
@@ -13,4 +33,157 @@ func Test_NewCodecs(t *testing.T) {
 
 	assert.Contains(t, c, llotypes.ReportFormatJSON, "expected JSON to be supported")
 	assert.Contains(t, c, llotypes.ReportFormatEVMPremiumLegacy, "expected EVMPremiumLegacy to be supported")
+	assert.Contains(t, c, evm.ReportFormatEVMABI, "expected EVMABI to be supported")
+	assert.Contains(t, c, solana.ReportFormatSolanaBorsh, "expected SolanaBorsh to be supported")
+}
+
+func Test_ReportFormatFromString(t *testing.T) {
+	for s, expected := range map[string]llotypes.ReportFormat{
+		"json":               llotypes.ReportFormatJSON,
+		"evm_premium_legacy": llotypes.ReportFormatEVMPremiumLegacy,
+		"evm_abi":            evm.ReportFormatEVMABI,
+		"solana_borsh":       solana.ReportFormatSolanaBorsh,
+	} {
+		rf, err := ReportFormatFromString(s)
+		require.NoError(t, err)
+		assert.Equal(t, expected, rf)
+	}
+
+	_, err := ReportFormatFromString("foo")
+	assert.EqualError(t, err, `unknown report format: "foo"`)
+}
+
+func Test_ChannelDefinitions_JSON(t *testing.T) {
+	dfns := llotypes.ChannelDefinitions{
+		1: {ReportFormat: llotypes.ReportFormatJSON, Streams: []llotypes.Stream{{StreamID: 1, Aggregator: llotypes.AggregatorMedian}}},
+		2: {ReportFormat: evm.ReportFormatEVMABI, Streams: []llotypes.Stream{{StreamID: 2, Aggregator: llotypes.AggregatorQuote}}, Opts: []byte(`{"foo":"bar"}`)},
+		3: {ReportFormat: solana.ReportFormatSolanaBorsh, Streams: []llotypes.Stream{{StreamID: 3, Aggregator: llotypes.AggregatorMode}}},
+	}
+
+	t.Run("round trips report formats not defined in chainlink-common by name", func(t *testing.T) {
+		b, err := json.Marshal(ChannelDefinitions(dfns))
+		require.NoError(t, err)
+		assert.Contains(t, string(b), `"reportFormat":"evm_abi"`)
+		assert.Contains(t, string(b), `"reportFormat":"solana_borsh"`)
+		assert.NotContains(t, string(b), "unknown")
+
+		var decoded ChannelDefinitions
+		require.NoError(t, json.Unmarshal(b, &decoded))
+		assert.Equal(t, dfns, llotypes.ChannelDefinitions(decoded))
+
+		// the channel definitions of other formats are encoded like llotypes.ChannelDefinitions
+		expected, err := json.Marshal(llotypes.ChannelDefinitions{1: dfns[1]})
+		require.NoError(t, err)
+		b, err = json.Marshal(ChannelDefinitions{1: dfns[1]})
+		require.NoError(t, err)
+		assert.JSONEq(t, string(expected), string(b))
+	})
+
+	t.Run("decodes numeric report formats", func(t *testing.T) {
+		var decoded ChannelDefinitions
+		require.NoError(t, json.Unmarshal([]byte(fmt.Sprintf(`{"2":{"reportFormat":%d,"streams":[{"streamId":2,"aggregator":"quote"}],"opts":{"foo":"bar"}}}`, evm.ReportFormatEVMABI)), &decoded))
+		assert.Equal(t, llotypes.ChannelDefinitions{2: dfns[2]}, llotypes.ChannelDefinitions(decoded))
+
+		err := json.Unmarshal([]byte(`{"2":{"reportFormat":"foo","streams":[]}}`), &decoded)
+		assert.EqualError(t, err, `unknown report format: "foo"`)
+	})
+
+	t.Run("Scan and Value", func(t *testing.T) {
+		v, err := ChannelDefinitions(dfns).Value()
+		require.NoError(t, err)
+		var scanned ChannelDefinitions
+		require.NoError(t, scanned.Scan(v))
+		assert.Equal(t, dfns, llotypes.ChannelDefinitions(scanned))
+
+		require.NoError(t, scanned.Scan([]byte{}))
+		assert.Equal(t, ChannelDefinitions{}, scanned)
+		require.NoError(t, scanned.Scan([]byte(nil)))
+		assert.Nil(t, scanned)
+	})
+}
+
+// Test_ReportCodecSolanaBorsh_Verifier signs Solana reports with the node
+// keyring and checks the payload the way the Solana OCR2 programs verify
+// reports: f+1 secp256k1 signatures from distinct signers of the config, over
+// sha256(len(report) as u8 || report || report context).
+func Test_ReportCodecSolanaBorsh_Verifier(t *testing.T) {
+	const n, f = 4, 1
+	keyrings := make([]LLOOnchainKeyring, n)
+	signers := make(map[common.Address]bool, n)
+	for i := range keyrings {
+		kb, err := ocr2key.New(chaintype.Solana)
+		require.NoError(t, err)
+		keyrings[i] = NewOnchainKeyring(logger.TestLogger(t), map[llotypes.ReportFormat]Key{solana.ReportFormatSolanaBorsh: kb})
+		signers[common.BytesToAddress(kb.PublicKey())] = true
+	}
+
+	digest := types.ConfigDigest{1, 2, 3}
+	seqNr := uint64(1000)
+	cd := llotypes.ChannelDefinition{
+		ReportFormat: solana.ReportFormatSolanaBorsh,
+		Opts:         []byte(`{"baseUSDFee":"1","expirationWindow":60,"feedID":"0x0003000000000000000000000000000000000000000000000000000000000001"}`),
+	}
+	report, err := NewCodecs()[solana.ReportFormatSolanaBorsh].Encode(llo.Report{
+		ConfigDigest:                digest,
+		SeqNr:                       seqNr,
+		ObservationTimestampSeconds: 100,
+		Values:                      []llo.StreamValue{llo.ToDecimal(decimal.NewFromInt(2000)), llo.ToDecimal(decimal.NewFromInt(15)), llo.ToDecimal(decimal.NewFromInt(42))},
+	}, cd)
+	require.NoError(t, err)
+
+	sigs := make([]types.AttributedOnchainSignature, f+1)
+	for i := range sigs {
+		sig, err2 := keyrings[i].Sign(digest, seqNr, ocr3types.ReportWithInfo[llotypes.ReportInfo]{Report: report, Info: llotypes.ReportInfo{ReportFormat: solana.ReportFormatSolanaBorsh}})
+		require.NoError(t, err2)
+		sigs[i] = types.AttributedOnchainSignature{Signature: sig, Signer: commontypes.OracleID(i)}
+	}
+
+	verify := func(packed []byte) error {
+		var p solana.Payload
+		if err := bin.NewBorshDecoder(packed).Decode(&p); err != nil {
+			return err
+		}
+		if len(p.Signatures) != f+1 {
+			return fmt.Errorf("wrong number of signatures: %d", len(p.Signatures))
+		}
+		rawReportContext := evmutil.RawReportContext(evm.LegacyReportContext(p.ConfigDigest, p.SeqNr))
+		h := sha256.New()
+		h.Write([]byte{uint8(len(p.Report))})
+		h.Write(p.Report)
+		for _, b := range rawReportContext {
+			h.Write(b[:])
+		}
+		hash := h.Sum(nil)
+		seen := map[common.Address]bool{}
+		for _, sig := range p.Signatures {
+			pub, err := crypto.SigToPub(hash, sig.Signature)
+			if err != nil {
+				return err
+			}
+			signer := crypto.PubkeyToAddress(*pub)
+			if !signers[signer] || seen[signer] {
+				return fmt.Errorf("unauthorized or duplicate signer %s", signer)
+			}
+			seen[signer] = true
+		}
+		return nil
+	}
+
+	t.Run("verifies reports signed by the DON", func(t *testing.T) {
+		packed, err := solana.ReportCodecSolanaBorsh{}.Pack(digest, seqNr, report, sigs)
+		require.NoError(t, err)
+		require.NoError(t, verify(packed))
+	})
+
+	t.Run("rejects reports signed for another sequence number", func(t *testing.T) {
+		packed, err := solana.ReportCodecSolanaBorsh{}.Pack(digest, seqNr+1, report, sigs)
+		require.NoError(t, err)
+		require.Error(t, verify(packed))
+	})
+
+	t.Run("rejects reports with too few signatures", func(t *testing.T) {
+		packed, err := solana.ReportCodecSolanaBorsh{}.Pack(digest, seqNr, report, sigs[:f])
+		require.NoError(t, err)
+		require.Error(t, verify(packed))
+	})
 }
@@ -0,0 +1,338 @@
+package evm
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/shopspring/decimal"
+
+	"github.com/smartcontractkit/libocr/offchainreporting2/types"
+	ocr2types "github.com/smartcontractkit/libocr/offchainreporting2plus/types"
+
+	llotypes "github.com/smartcontractkit/chainlink-common/pkg/types/llo"
+	"github.com/smartcontractkit/chainlink-data-streams/llo"
+
+	ubig "github.com/smartcontractkit/chainlink/v2/core/chains/evm/utils/big"
+)
+
+// ReportFormatEVMABI is the report format of ReportCodecEVMABI.
+//
+// chainlink-common allocates report formats sequentially from 1, and newer
+// versions already use 3 and 4. Until this format is defined there, it is
+// allocated from the top of the range, which chainlink-common does not use.
+//
+// TODO: Move to chainlink-common alongside the other report formats
+const ReportFormatEVMABI llotypes.ReportFormat = math.MaxUint32 - 1
+
+var (
+	_ llo.ReportCodec = ReportCodecEVMABI{}
+
+	// evmABIHeader is encoded at the start of every report. It matches the
+	// header of v3 reports, so that existing verifiers and fee managers can
+	// read the feed ID and fees.
+	evmABIHeader = abi.Arguments([]abi.Argument{
+		{Name: "feedId", Type: mustNewABIType("bytes32")},
+		{Name: "validFromTimestamp", Type: mustNewABIType("uint32")},
+		{Name: "observationsTimestamp", Type: mustNewABIType("uint32")},
+		{Name: "nativeFee", Type: mustNewABIType("uint192")},
+		{Name: "linkFee", Type: mustNewABIType("uint192")},
+		{Name: "expiresAt", Type: mustNewABIType("uint32")},
+	})
+)
+
+func mustNewABIType(t string) abi.Type {
+	result, err := abi.NewType(t, "", []abi.ArgumentMarshaling{})
+	if err != nil {
+		panic(fmt.Sprintf("Unexpected error during abi.NewType: %s", err))
+	}
+	return result
+}
+
+// ReportCodecEVMABI encodes reports whose layout is defined by the channel
+// opts. The first two streams of the channel must be the native and LINK
+// prices, used to compute the fees in the header. Every following stream is
+// ABI-encoded after the header, as described by its entry in opts.ABI.
+type ReportCodecEVMABI struct{}
+
+func NewReportCodecEVMABI() ReportCodecEVMABI {
+	return ReportCodecEVMABI{}
+}
+
+// ABIEncoder describes how a single stream value is encoded
+type ABIEncoder struct {
+	// Type is the ABI type of the encoded value, e.g. "int192" or "uint64".
+	// Only integer types are supported.
+	Type string `json:"type"`
+	// Multiplier is used to scale the value before it is encoded. If not
+	// specified, a multiplier of 1 is assumed.
+	Multiplier *ubig.Big `json:"multiplier"`
+	// QuoteFields are the fields of a quote that are encoded, in order, each
+	// of them with Type. Valid fields are "benchmark", "bid" and "ask". Must be
+	// specified for quotes, and left empty for decimals.
+	QuoteFields []string `json:"quoteFields,omitempty"`
+}
+
+type ReportFormatEVMABIOpts struct {
+	// BaseUSDFee is the cost on-chain of verifying a report
+	BaseUSDFee decimal.Decimal `json:"baseUSDFee"`
+	// Expiration window is the length of time in seconds the report is valid
+	// for, from the observation timestamp
+	ExpirationWindow uint32 `json:"expirationWindow"`
+	// FeedID is for compatibility with existing on-chain verifiers
+	FeedID common.Hash `json:"feedID"`
+	// ABI describes how the streams following the native and LINK prices are
+	// encoded, one entry per stream
+	ABI []ABIEncoder `json:"abi"`
+}
+
+func (r *ReportFormatEVMABIOpts) Decode(opts []byte) error {
+	if len(opts) == 0 {
+		return errors.New("opts are required")
+	}
+	return json.Unmarshal(opts, r)
+}
+
+// schema returns the ABI arguments the report is encoded with
+func (r *ReportFormatEVMABIOpts) schema() (abi.Arguments, error) {
+	args := append(abi.Arguments{}, evmABIHeader...)
+	for i, enc := range r.ABI {
+		t, err := abi.NewType(enc.Type, "", nil)
+		if err != nil {
+			return nil, fmt.Errorf("invalid type for stream %d; %w", i, err)
+		}
+		if t.T != abi.IntTy && t.T != abi.UintTy {
+			return nil, fmt.Errorf("invalid type for stream %d; expected an integer type, got: %s", i, enc.Type)
+		}
+		if enc.Multiplier != nil && enc.Multiplier.IsZero() {
+			return nil, fmt.Errorf("multiplier for stream %d, if specified, must be non-zero", i)
+		}
+		for _, field := range enc.QuoteFields {
+			if field != "benchmark" && field != "bid" && field != "ask" {
+				return nil, fmt.Errorf("invalid quote field for stream %d: %q", i, field)
+			}
+		}
+		n := max(len(enc.QuoteFields), 1)
+		for j := 0; j < n; j++ {
+			args = append(args, abi.Argument{Name: fmt.Sprintf("value%d_%d", i, j), Type: t})
+		}
+	}
+	return args, nil
+}
+
+func (r ReportCodecEVMABI) Encode(report llo.Report, cd llotypes.ChannelDefinition) ([]byte, error) {
+	if report.Specimen {
+		return nil, errors.New("ReportCodecEVMABI does not support encoding specimen reports")
+	}
+	opts := ReportFormatEVMABIOpts{}
+	if err := (&opts).Decode(cd.Opts); err != nil {
+		return nil, fmt.Errorf("failed to decode opts; got: '%s'; %w", cd.Opts, err)
+	}
+	if len(report.Values) != len(opts.ABI)+2 {
+		return nil, fmt.Errorf("ReportCodecEVMABI requires the native price, the LINK price and one value per ABI encoder (%d); got report.Values: %#v", len(opts.ABI), report.Values)
+	}
+	nativePrice, err := extractDecimal(report.Values[0])
+	if err != nil {
+		return nil, fmt.Errorf("ReportCodecEVMABI cannot encode native price; %w", err)
+	}
+	linkPrice, err := extractDecimal(report.Values[1])
+	if err != nil {
+		return nil, fmt.Errorf("ReportCodecEVMABI cannot encode LINK price; %w", err)
+	}
+	schema, err := opts.schema()
+	if err != nil {
+		return nil, fmt.Errorf("invalid ABI in opts; %w", err)
+	}
+
+	values := []interface{}{
+		[32]byte(opts.FeedID),
+		report.ValidAfterSeconds + 1,
+		report.ObservationTimestampSeconds,
+		CalculateFee(nativePrice, opts.BaseUSDFee),
+		CalculateFee(linkPrice, opts.BaseUSDFee),
+		report.ObservationTimestampSeconds + opts.ExpirationWindow,
+	}
+	for i, enc := range opts.ABI {
+		decimals, err := encoderValues(enc, report.Values[i+2])
+		if err != nil {
+			return nil, fmt.Errorf("ReportCodecEVMABI cannot encode stream %d; %w", i, err)
+		}
+		for _, d := range decimals {
+			v, err := toABIInteger(d.BigInt(), schema[len(values)].Type)
+			if err != nil {
+				return nil, fmt.Errorf("ReportCodecEVMABI cannot encode stream %d; %w", i, err)
+			}
+			values = append(values, v)
+		}
+	}
+	if len(values) != len(schema) {
+		return nil, fmt.Errorf("ReportCodecEVMABI got %d values for %d ABI arguments", len(values), len(schema))
+	}
+	return schema.Pack(values...)
+}
+
+// ReportEVMABI is a decoded ReportCodecEVMABI report
+type ReportEVMABI struct {
+	FeedID                [32]byte
+	ValidFromTimestamp    uint32
+	ObservationsTimestamp uint32
+	NativeFee             *big.Int
+	LinkFee               *big.Int
+	ExpiresAt             uint32
+	// Values are the encoded stream values, with quotes expanded
+	Values []*big.Int
+}
+
+func (r ReportCodecEVMABI) Decode(b []byte, cd llotypes.ChannelDefinition) (*ReportEVMABI, error) {
+	opts := ReportFormatEVMABIOpts{}
+	if err := (&opts).Decode(cd.Opts); err != nil {
+		return nil, fmt.Errorf("failed to decode opts; got: '%s'; %w", cd.Opts, err)
+	}
+	schema, err := opts.schema()
+	if err != nil {
+		return nil, fmt.Errorf("invalid ABI in opts; %w", err)
+	}
+	values, err := schema.Unpack(b)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode report; %w", err)
+	}
+	report := &ReportEVMABI{
+		FeedID:                values[0].([32]byte),
+		ValidFromTimestamp:    values[1].(uint32),
+		ObservationsTimestamp: values[2].(uint32),
+		NativeFee:             values[3].(*big.Int),
+		LinkFee:               values[4].(*big.Int),
+		ExpiresAt:             values[5].(uint32),
+	}
+	for _, v := range values[len(evmABIHeader):] {
+		report.Values = append(report.Values, fromABIInteger(v))
+	}
+	return report, nil
+}
+
+// Pack assembles the report values into a payload for verifying on-chain.
+// Reports are signed with the legacy report context, like premium legacy
+// reports, so they can be verified by the same contracts.
+func (r ReportCodecEVMABI) Pack(digest types.ConfigDigest, seqNr uint64, report ocr2types.Report, sigs []types.AttributedOnchainSignature) ([]byte, error) {
+	return ReportCodecPremiumLegacy{}.Pack(digest, seqNr, report, sigs)
+}
+
+func extractDecimal(v llo.StreamValue) (decimal.Decimal, error) {
+	d, is := v.(*llo.Decimal)
+	if !is {
+		return decimal.Zero, fmt.Errorf("expected value of type *Decimal; got: %T", v)
+	}
+	if d == nil {
+		// Missing price median will cause a zero fee
+		return decimal.Zero, nil
+	}
+	return d.Decimal(), nil
+}
+
+// encoderValues returns the scaled values to encode for a stream
+func encoderValues(enc ABIEncoder, v llo.StreamValue) ([]decimal.Decimal, error) {
+	multiplier := decimal.NewFromInt(1)
+	if enc.Multiplier != nil {
+		multiplier = decimal.NewFromBigInt(enc.Multiplier.ToInt(), 0)
+	}
+	switch v := v.(type) {
+	case *llo.Decimal:
+		if v == nil {
+			return nil, errors.New("missing value")
+		}
+		if len(enc.QuoteFields) > 0 {
+			return nil, errors.New("quote fields specified for a decimal value")
+		}
+		return []decimal.Decimal{v.Decimal().Mul(multiplier)}, nil
+	case *llo.Quote:
+		if v == nil {
+			return nil, errors.New("missing value")
+		}
+		if len(enc.QuoteFields) == 0 {
+			return nil, errors.New("quote fields must be specified for a quote value")
+		}
+		var values []decimal.Decimal
+		for _, field := range enc.QuoteFields {
+			switch field {
+			case "benchmark":
+				values = append(values, v.Benchmark.Mul(multiplier))
+			case "bid":
+				values = append(values, v.Bid.Mul(multiplier))
+			case "ask":
+				values = append(values, v.Ask.Mul(multiplier))
+			}
+		}
+		return values, nil
+	default:
+		return nil, fmt.Errorf("unsupported value type: %T", v)
+	}
+}
+
+// toABIInteger checks that i fits in t, and converts it to the Go type go-ethereum
+// expects for t
+func toABIInteger(i *big.Int, t abi.Type) (interface{}, error) {
+	var lo, hi *big.Int
+	if t.T == abi.UintTy {
+		lo = big.NewInt(0)
+		hi = new(big.Int).Lsh(big.NewInt(1), uint(t.Size))
+	} else {
+		hi = new(big.Int).Lsh(big.NewInt(1), uint(t.Size-1))
+		lo = new(big.Int).Neg(hi)
+	}
+	if i.Cmp(lo) < 0 || i.Cmp(hi) >= 0 {
+		return nil, fmt.Errorf("value %s does not fit in %s", i, t)
+	}
+	if t.Size > 64 {
+		return i, nil
+	}
+	switch {
+	case t.T == abi.UintTy && t.Size == 8:
+		return uint8(i.Uint64()), nil
+	case t.T == abi.UintTy && t.Size == 16:
+		return uint16(i.Uint64()), nil
+	case t.T == abi.UintTy && t.Size == 32:
+		return uint32(i.Uint64()), nil
+	case t.T == abi.UintTy && t.Size == 64:
+		return i.Uint64(), nil
+	case t.T == abi.IntTy && t.Size == 8:
+		return int8(i.Int64()), nil
+	case t.T == abi.IntTy && t.Size == 16:
+		return int16(i.Int64()), nil
+	case t.T == abi.IntTy && t.Size == 32:
+		return int32(i.Int64()), nil
+	case t.T == abi.IntTy && t.Size == 64:
+		return i.Int64(), nil
+	default:
+		// other sizes up to 64 bits are packed as *big.Int
+		return i, nil
+	}
+}
+
+func fromABIInteger(v interface{}) *big.Int {
+	switch v := v.(type) {
+	case *big.Int:
+		return v
+	case uint8:
+		return new(big.Int).SetUint64(uint64(v))
+	case uint16:
+		return new(big.Int).SetUint64(uint64(v))
+	case uint32:
+		return new(big.Int).SetUint64(uint64(v))
+	case uint64:
+		return new(big.Int).SetUint64(v)
+	case int8:
+		return big.NewInt(int64(v))
+	case int16:
+		return big.NewInt(int64(v))
+	case int32:
+		return big.NewInt(int64(v))
+	case int64:
+		return big.NewInt(v)
+	default:
+		panic(fmt.Sprintf("unexpected ABI integer type %T", v))
+	}
+}
@@ -0,0 +1,213 @@
+package evm
+
+import (
+	"fmt"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind/backends"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/libocr/commontypes"
+	"github.com/smartcontractkit/libocr/offchainreporting2plus/types"
+
+	llotypes "github.com/smartcontractkit/chainlink-common/pkg/types/llo"
+
+	"github.com/smartcontractkit/chainlink-data-streams/llo"
+
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/assets"
+	"github.com/smartcontractkit/chainlink/v2/core/gethwrappers/llo-feeds/generated/destination_verifier"
+	"github.com/smartcontractkit/chainlink/v2/core/gethwrappers/llo-feeds/generated/destination_verifier_proxy"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/services/keystore/chaintype"
+	"github.com/smartcontractkit/chainlink/v2/core/services/keystore/keys/ocr2key"
+)
+
+var evmABIFeedID = [32]uint8{0x1, 0x2, 0x3}
+
+func newEVMABIChannelDefinition() llotypes.ChannelDefinition {
+	return llotypes.ChannelDefinition{Opts: llotypes.ChannelOpts(fmt.Sprintf(`{"baseUSDFee":"10.50","expirationWindow":60,"feedID":"0x%x","abi":[{"type":"int192","multiplier":100},{"type":"int192","multiplier":10,"quoteFields":["benchmark","bid","ask"]},{"type":"uint32"}]}`, evmABIFeedID))}
+}
+
+func newValidEVMABIReport() llo.Report {
+	return llo.Report{
+		ConfigDigest:                types.ConfigDigest{1, 2, 3},
+		SeqNr:                       32,
+		ChannelID:                   llotypes.ChannelID(31),
+		ValidAfterSeconds:           28,
+		ObservationTimestampSeconds: 34,
+		Values: []llo.StreamValue{
+			llo.ToDecimal(decimal.NewFromInt(35)),
+			llo.ToDecimal(decimal.NewFromInt(36)),
+			llo.ToDecimal(decimal.RequireFromString("1.23")),
+			&llo.Quote{Bid: decimal.NewFromInt(37), Benchmark: decimal.NewFromInt(38), Ask: decimal.NewFromInt(39)},
+			llo.ToDecimal(decimal.NewFromInt(7)),
+		},
+		Specimen: false,
+	}
+}
+
+func Test_ReportCodecEVMABI(t *testing.T) {
+	rc := ReportCodecEVMABI{}
+	cd := newEVMABIChannelDefinition()
+
+	t.Run("Encode errors if opts are missing", func(t *testing.T) {
+		_, err := rc.Encode(newValidEVMABIReport(), llotypes.ChannelDefinition{})
+		assert.EqualError(t, err, "failed to decode opts; got: ''; opts are required")
+	})
+
+	t.Run("Encode errors if values do not match the ABI", func(t *testing.T) {
+		_, err := rc.Encode(llo.Report{}, cd)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "ReportCodecEVMABI requires the native price, the LINK price and one value per ABI encoder (3)")
+	})
+
+	t.Run("does not encode specimen reports", func(t *testing.T) {
+		report := newValidEVMABIReport()
+		report.Specimen = true
+
+		_, err := rc.Encode(report, cd)
+		assert.EqualError(t, err, "ReportCodecEVMABI does not support encoding specimen reports")
+	})
+
+	t.Run("Encode errors on invalid ABI", func(t *testing.T) {
+		for name, abi := range map[string]string{
+			"unsupported type":    `[{"type":"bytes32"},{"type":"int192","quoteFields":["benchmark"]},{"type":"uint32"}]`,
+			"zero multiplier":     `[{"type":"int192","multiplier":0},{"type":"int192","quoteFields":["benchmark"]},{"type":"uint32"}]`,
+			"invalid quote field": `[{"type":"int192"},{"type":"int192","quoteFields":["mid"]},{"type":"uint32"}]`,
+		} {
+			t.Run(name, func(t *testing.T) {
+				cd := llotypes.ChannelDefinition{Opts: llotypes.ChannelOpts(fmt.Sprintf(`{"abi":%s}`, abi))}
+				_, err := rc.Encode(newValidEVMABIReport(), cd)
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), "invalid ABI in opts")
+			})
+		}
+	})
+
+	t.Run("Encode errors if quote fields are missing for a quote", func(t *testing.T) {
+		cd := llotypes.ChannelDefinition{Opts: llotypes.ChannelOpts(`{"abi":[{"type":"int192"},{"type":"int192"},{"type":"uint32"}]}`)}
+		_, err := rc.Encode(newValidEVMABIReport(), cd)
+		assert.EqualError(t, err, "ReportCodecEVMABI cannot encode stream 1; quote fields must be specified for a quote value")
+	})
+
+	t.Run("Encode errors if a value does not fit in its type", func(t *testing.T) {
+		report := newValidEVMABIReport()
+		report.Values[4] = llo.ToDecimal(decimal.NewFromInt(-1))
+		_, err := rc.Encode(report, cd)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "ReportCodecEVMABI cannot encode stream 2")
+	})
+
+	t.Run("Encode constructs a report from observations", func(t *testing.T) {
+		encoded, err := rc.Encode(newValidEVMABIReport(), cd)
+		require.NoError(t, err)
+
+		// header and 5 values
+		assert.Len(t, encoded, 11*32)
+
+		t.Run("Decode decodes the report", func(t *testing.T) {
+			decoded, err := rc.Decode(encoded, cd)
+			require.NoError(t, err)
+
+			assert.Equal(t, &ReportEVMABI{
+				FeedID:                evmABIFeedID,
+				ValidFromTimestamp:    29,
+				ObservationsTimestamp: 34,
+				NativeFee:             big.NewInt(300000000000000000),
+				LinkFee:               big.NewInt(291666666666666667),
+				ExpiresAt:             94,
+				Values:                []*big.Int{big.NewInt(123), big.NewInt(380), big.NewInt(370), big.NewInt(390), big.NewInt(7)},
+			}, decoded)
+		})
+	})
+
+	t.Run("uses zero values if fees are missing", func(t *testing.T) {
+		report := newValidEVMABIReport()
+		report.Values[0] = (*llo.Decimal)(nil)
+		report.Values[1] = (*llo.Decimal)(nil)
+
+		encoded, err := rc.Encode(report, cd)
+		require.NoError(t, err)
+
+		decoded, err := rc.Decode(encoded, cd)
+		require.NoError(t, err)
+		assert.Equal(t, "0", decoded.NativeFee.String())
+		assert.Equal(t, "0", decoded.LinkFee.String())
+	})
+
+	t.Run("Decode errors on invalid report", func(t *testing.T) {
+		_, err := rc.Decode([]byte{1, 2, 3}, cd)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "failed to decode report")
+	})
+}
+
+func Test_ReportCodecEVMABI_Verifier(t *testing.T) {
+	ctx := testutils.Context(t)
+
+	key, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	steve, err := bind.NewKeyedTransactorWithChainID(key, big.NewInt(1337))
+	require.NoError(t, err)
+	backend := backends.NewSimulatedBackend(core.GenesisAlloc{steve.From: {Balance: assets.Ether(1000).ToInt()}}, 30e6)
+	t.Cleanup(func() { assert.NoError(t, backend.Close()) })
+	backend.Commit()
+
+	verifierProxyAddr, _, verifierProxy, err := destination_verifier_proxy.DeployDestinationVerifierProxy(steve, backend)
+	require.NoError(t, err)
+	verifierAddr, _, verifier, err := destination_verifier.DeployDestinationVerifier(steve, backend, verifierProxyAddr)
+	require.NoError(t, err)
+	_, err = verifierProxy.SetVerifier(steve, verifierAddr)
+	require.NoError(t, err)
+	backend.Commit()
+
+	const n, f = 4, 1
+	keys := make([]ocr2key.KeyBundle, n)
+	signers := make([]common.Address, n)
+	for i := range keys {
+		keys[i], err = ocr2key.New(chaintype.EVM)
+		require.NoError(t, err)
+		signers[i] = common.BytesToAddress(keys[i].PublicKey())
+	}
+	_, err = verifier.SetConfig(steve, signers, f, []destination_verifier.CommonAddressAndWeight{})
+	require.NoError(t, err)
+	backend.Commit()
+
+	head, err := backend.HeaderByNumber(ctx, nil)
+	require.NoError(t, err)
+
+	report := newValidEVMABIReport()
+	// reports are verified against the config active at the observation timestamp
+	report.ObservationTimestampSeconds = uint32(head.Time) + 1
+	report.ValidAfterSeconds = uint32(head.Time)
+	encoded, err := ReportCodecEVMABI{}.Encode(report, newEVMABIChannelDefinition())
+	require.NoError(t, err)
+
+	sigs := make([]types.AttributedOnchainSignature, f+1)
+	for i := range sigs {
+		sig, err2 := keys[i].Sign(LegacyReportContext(report.ConfigDigest, report.SeqNr), encoded)
+		require.NoError(t, err2)
+		sigs[i] = types.AttributedOnchainSignature{Signature: sig, Signer: commontypes.OracleID(i)}
+	}
+	payload, err := ReportCodecEVMABI{}.Pack(report.ConfigDigest, report.SeqNr, encoded, sigs)
+	require.NoError(t, err)
+
+	t.Run("verifies reports signed by the DON", func(t *testing.T) {
+		_, err := verifierProxy.Verify(steve, payload, []byte{})
+		require.NoError(t, err)
+	})
+
+	t.Run("rejects reports with too few signatures", func(t *testing.T) {
+		payload, err := ReportCodecEVMABI{}.Pack(report.ConfigDigest, report.SeqNr, encoded, sigs[:f])
+		require.NoError(t, err)
+		_, err = verifierProxy.Verify(steve, payload, []byte{})
+		require.Error(t, err)
+	})
+}
@@ -14,6 +14,7 @@ import (
 
 	"github.com/smartcontractkit/chainlink/v2/core/logger"
 	"github.com/smartcontractkit/chainlink/v2/core/services/llo/evm"
+	"github.com/smartcontractkit/chainlink/v2/core/services/llo/solana"
 )
 
 type LLOOnchainKeyring ocr3types.OnchainKeyring[llotypes.ReportInfo]
@@ -79,10 +80,11 @@ func (okr *onchainKeyring) MaxSignatureLength() (n int) {
 
 func (okr *onchainKeyring) Sign(digest types.ConfigDigest, seqNr uint64, r ocr3types.ReportWithInfo[llotypes.ReportInfo]) (signature []byte, err error) {
 	switch r.Info.ReportFormat {
-	case llotypes.ReportFormatEVMPremiumLegacy:
+	case llotypes.ReportFormatEVMPremiumLegacy, evm.ReportFormatEVMABI, solana.ReportFormatSolanaBorsh:
 		rf := r.Info.ReportFormat
 		if key, exists := okr.keys[rf]; exists {
-			// NOTE: Must use legacy Sign method for compatibility with v0.3 report verification
+			// NOTE: Must use legacy Sign method for compatibility with v0.3 report verification,
+			// and Solana keys only implement the legacy Sign method
 			rc := evm.LegacyReportContext(digest, seqNr)
 			return key.Sign(rc, r.Report)
 		}
@@ -97,7 +99,7 @@ func (okr *onchainKeyring) Sign(digest types.ConfigDigest, seqNr uint64, r ocr3t
 
 func (okr *onchainKeyring) Verify(key types.OnchainPublicKey, digest types.ConfigDigest, seqNr uint64, r ocr3types.ReportWithInfo[llotypes.ReportInfo], signature []byte) bool {
 	switch r.Info.ReportFormat {
-	case llotypes.ReportFormatEVMPremiumLegacy:
+	case llotypes.ReportFormatEVMPremiumLegacy, evm.ReportFormatEVMABI, solana.ReportFormatSolanaBorsh:
 		rf := r.Info.ReportFormat
 		if verifier, exists := okr.keys[rf]; exists {
 			// NOTE: Must use legacy Verify method for compatibility with v0.3 report verification
@@ -16,6 +16,7 @@ import (
 	llotypes "github.com/smartcontractkit/chainlink-common/pkg/types/llo"
 
 	"github.com/smartcontractkit/chainlink/v2/core/services/llo/evm"
+	"github.com/smartcontractkit/chainlink/v2/core/services/llo/solana"
 	"github.com/smartcontractkit/chainlink/v2/core/services/relay/evm/mercury/wsrpc"
 	"github.com/smartcontractkit/chainlink/v2/core/services/relay/evm/mercury/wsrpc/pb"
 	"github.com/smartcontractkit/chainlink/v2/core/utils"
@@ -223,6 +224,10 @@ func (s *server) transmit(ctx context.Context, t *Transmission) (*pb.TransmitRes
 		fallthrough
 	case llotypes.ReportFormatEVMPremiumLegacy:
 		payload, err = evm.ReportCodecPremiumLegacy{}.Pack(t.ConfigDigest, t.SeqNr, t.Report.Report, t.Sigs)
+	case evm.ReportFormatEVMABI:
+		payload, err = evm.ReportCodecEVMABI{}.Pack(t.ConfigDigest, t.SeqNr, t.Report.Report, t.Sigs)
+	case solana.ReportFormatSolanaBorsh:
+		payload, err = solana.ReportCodecSolanaBorsh{}.Pack(t.ConfigDigest, t.SeqNr, t.Report.Report, t.Sigs)
 	default:
 		return nil, fmt.Errorf("Transmit failed; unsupported report format: %q", t.Report.Info.ReportFormat)
 	}
@@ -152,7 +152,7 @@ func (c *channelDefinitionCache) Start(ctx context.Context) error {
 		if pd, err := c.orm.LoadChannelDefinitions(ctx, c.addr, c.donID); err != nil {
 			return err
 		} else if pd != nil {
-			c.definitions = pd.Definitions
+			c.definitions = llotypes.ChannelDefinitions(pd.Definitions)
 			c.initialBlockNum = pd.BlockNum + 1
 			c.definitionsVersion = pd.Version
 		} else {
@@ -410,13 +410,13 @@ func (c *channelDefinitionCache) fetchChannelDefinitions(ctx context.Context, ur
 		return nil, fmt.Errorf("SHA3 mismatch: expected %x, got %x", expectedSha, actualSha)
 	}
 
-	var cd llotypes.ChannelDefinitions
+	var cd ChannelDefinitions
 	decoder := json.NewDecoder(&buf)
 	if err := decoder.Decode(&cd); err != nil {
 		return nil, fmt.Errorf("failed to decode JSON: %w", err)
 	}
 
-	return cd, nil
+	return llotypes.ChannelDefinitions(cd), nil
 }
 
 ////////////////////////////////////////////////////////////////////
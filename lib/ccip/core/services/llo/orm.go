@@ -18,9 +18,9 @@ type ORM interface {
 }
 
 type PersistedDefinitions struct {
-	ChainSelector uint64                      `db:"chain_selector"`
-	Address       common.Address              `db:"addr"`
-	Definitions   llotypes.ChannelDefinitions `db:"definitions"`
+	ChainSelector uint64             `db:"chain_selector"`
+	Address       common.Address     `db:"addr"`
+	Definitions   ChannelDefinitions `db:"definitions"`
 	// The block number in which the log for this definitions was emitted
 	BlockNum  int64     `db:"block_num"`
 	DonID     uint32    `db:"don_id"`
@@ -60,7 +60,7 @@ VALUES ($1, $2, $3, $4, $5, $6, NOW())
 ON CONFLICT (chain_selector, addr, don_id) DO UPDATE
 SET definitions = $4, block_num = $5, version = $6, updated_at = NOW()
 WHERE EXCLUDED.version > channel_definitions.version
-`, o.chainSelector, addr, donID, dfns, blockNum, version)
+`, o.chainSelector, addr, donID, ChannelDefinitions(dfns), blockNum, version)
 	if err != nil {
 		return fmt.Errorf("StoreChannelDefinitions failed: %w", err)
 	}
@@ -12,6 +12,8 @@ import (
 
 	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
 	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils/pgtest"
+	"github.com/smartcontractkit/chainlink/v2/core/services/llo/evm"
+	"github.com/smartcontractkit/chainlink/v2/core/services/llo/solana"
 )
 
 func Test_ORM(t *testing.T) {
@@ -96,13 +98,13 @@ func Test_ORM(t *testing.T) {
 					ReportFormat: 43,
 					Streams:      []llotypes.Stream{{StreamID: 1, Aggregator: llotypes.AggregatorMedian}, {StreamID: 3, Aggregator: llotypes.AggregatorQuote}},
 				},
-			}, pd.Definitions)
+			}, llotypes.ChannelDefinitions(pd.Definitions))
 
 			// does not load erroneously for a different address
 			pd, err = orm.LoadChannelDefinitions(ctx, addr2, donID1)
 			require.NoError(t, err)
 
-			assert.Equal(t, llotypes.ChannelDefinitions{}, pd.Definitions)
+			assert.Equal(t, llotypes.ChannelDefinitions{}, llotypes.ChannelDefinitions(pd.Definitions))
 			assert.Equal(t, expectedBlockNum2, pd.BlockNum)
 
 			// does not load erroneously for a different don ID
@@ -140,7 +142,7 @@ func Test_ORM(t *testing.T) {
 			assert.Equal(t, expectedBlockNum, pd.BlockNum)
 			assert.Equal(t, donID1, pd.DonID)
 			assert.Equal(t, uint32(42), pd.Version)
-			assert.Equal(t, defs, pd.Definitions)
+			assert.Equal(t, defs, llotypes.ChannelDefinitions(pd.Definitions))
 		})
 		t.Run("does not update if version is older than the database persisted version", func(t *testing.T) {
 			// try to update with an older version
@@ -150,7 +152,25 @@ func Test_ORM(t *testing.T) {
 			pd, err := orm.LoadChannelDefinitions(ctx, addr1, donID1)
 			require.NoError(t, err)
 			assert.Equal(t, uint32(42), pd.Version)
-			assert.Equal(t, defs, pd.Definitions)
+			assert.Equal(t, defs, llotypes.ChannelDefinitions(pd.Definitions))
+		})
+		t.Run("loads back report formats not defined in chainlink-common", func(t *testing.T) {
+			localDefs := llotypes.ChannelDefinitions{
+				cid1: llotypes.ChannelDefinition{
+					ReportFormat: evm.ReportFormatEVMABI,
+					Streams:      []llotypes.Stream{{StreamID: 1, Aggregator: llotypes.AggregatorMedian}},
+				},
+				cid2: llotypes.ChannelDefinition{
+					ReportFormat: solana.ReportFormatSolanaBorsh,
+					Streams:      []llotypes.Stream{{StreamID: 1, Aggregator: llotypes.AggregatorMedian}},
+				},
+			}
+			err := orm.StoreChannelDefinitions(ctx, addr1, donID1, 43, localDefs, expectedBlockNum)
+			require.NoError(t, err)
+
+			pd, err := orm.LoadChannelDefinitions(ctx, addr1, donID1)
+			require.NoError(t, err)
+			assert.Equal(t, localDefs, llotypes.ChannelDefinitions(pd.Definitions))
 		})
 	})
 }
@@ -0,0 +1,232 @@
+package solana
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	bin "github.com/gagliardetto/binary"
+	"github.com/shopspring/decimal"
+
+	"github.com/smartcontractkit/libocr/offchainreporting2/types"
+	ocr2types "github.com/smartcontractkit/libocr/offchainreporting2plus/types"
+
+	llotypes "github.com/smartcontractkit/chainlink-common/pkg/types/llo"
+	"github.com/smartcontractkit/chainlink-data-streams/llo"
+
+	ubig "github.com/smartcontractkit/chainlink/v2/core/chains/evm/utils/big"
+	"github.com/smartcontractkit/chainlink/v2/core/services/llo/evm"
+)
+
+// ReportFormatSolanaBorsh is the report format of ReportCodecSolanaBorsh.
+// Like evm.ReportFormatEVMABI, it is allocated from the top of the range until
+// it is defined in chainlink-common.
+//
+// TODO: Move to chainlink-common alongside the other report formats
+const ReportFormatSolanaBorsh llotypes.ReportFormat = math.MaxUint32 - 2
+
+var _ llo.ReportCodec = ReportCodecSolanaBorsh{}
+
+// ReportCodecSolanaBorsh encodes reports with Borsh, so that they can be
+// deserialized by Solana programs. The first two streams of the channel must
+// be the native and LINK prices, used to compute the fees. Every following
+// stream is encoded as a value, quotes as their benchmark, bid and ask.
+type ReportCodecSolanaBorsh struct{}
+
+func NewReportCodecSolanaBorsh() ReportCodecSolanaBorsh {
+	return ReportCodecSolanaBorsh{}
+}
+
+type ReportFormatSolanaBorshOpts struct {
+	// BaseUSDFee is the cost on-chain of verifying a report
+	BaseUSDFee decimal.Decimal `json:"baseUSDFee"`
+	// Expiration window is the length of time in seconds the report is valid
+	// for, from the observation timestamp
+	ExpirationWindow uint32 `json:"expirationWindow"`
+	// FeedID identifies the feed on-chain
+	FeedID common.Hash `json:"feedID"`
+	// Multiplier is used to scale the values in the report. If not specified,
+	// or zero is used, a multiplier of 1 is assumed.
+	Multiplier *ubig.Big `json:"multiplier"`
+}
+
+func (r *ReportFormatSolanaBorshOpts) Decode(opts []byte) error {
+	if len(opts) == 0 {
+		// special case if opts are unspecified, just use the zero options rather than erroring
+		return nil
+	}
+	return json.Unmarshal(opts, r)
+}
+
+// Report is the Borsh schema of reports:
+//
+//	struct Report {
+//	    feed_id: [u8; 32],
+//	    valid_from_timestamp: u32,
+//	    observations_timestamp: u32,
+//	    native_fee: u128,
+//	    link_fee: u128,
+//	    expires_at: u32,
+//	    values: Vec<i128>,
+//	}
+type Report struct {
+	FeedID                [32]byte
+	ValidFromTimestamp    uint32
+	ObservationsTimestamp uint32
+	NativeFee             bin.Uint128
+	LinkFee               bin.Uint128
+	ExpiresAt             uint32
+	Values                []bin.Int128
+}
+
+func (r ReportCodecSolanaBorsh) Encode(report llo.Report, cd llotypes.ChannelDefinition) ([]byte, error) {
+	if report.Specimen {
+		return nil, errors.New("ReportCodecSolanaBorsh does not support encoding specimen reports")
+	}
+	if len(report.Values) < 2 {
+		return nil, fmt.Errorf("ReportCodecSolanaBorsh requires at least 2 values (NativePrice, LinkPrice, ...); got report.Values: %#v", report.Values)
+	}
+	opts := ReportFormatSolanaBorshOpts{}
+	if err := (&opts).Decode(cd.Opts); err != nil {
+		return nil, fmt.Errorf("failed to decode opts; got: '%s'; %w", cd.Opts, err)
+	}
+	multiplier := decimal.NewFromInt(1)
+	if opts.Multiplier != nil && !opts.Multiplier.IsZero() {
+		multiplier = decimal.NewFromBigInt(opts.Multiplier.ToInt(), 0)
+	}
+
+	var prices [2]decimal.Decimal
+	for i := range prices {
+		d, is := report.Values[i].(*llo.Decimal)
+		if !is {
+			return nil, fmt.Errorf("ReportCodecSolanaBorsh expects value %d to be of type *Decimal; got: %T", i, report.Values[i])
+		}
+		if d != nil {
+			// Missing price median will cause a zero fee
+			prices[i] = d.Decimal()
+		}
+	}
+	nativeFee, err := toUint128(evm.CalculateFee(prices[0], opts.BaseUSDFee))
+	if err != nil {
+		return nil, fmt.Errorf("ReportCodecSolanaBorsh cannot encode native fee; %w", err)
+	}
+	linkFee, err := toUint128(evm.CalculateFee(prices[1], opts.BaseUSDFee))
+	if err != nil {
+		return nil, fmt.Errorf("ReportCodecSolanaBorsh cannot encode LINK fee; %w", err)
+	}
+
+	r2 := Report{
+		FeedID:                opts.FeedID,
+		ValidFromTimestamp:    report.ValidAfterSeconds + 1,
+		ObservationsTimestamp: report.ObservationTimestampSeconds,
+		NativeFee:             nativeFee,
+		LinkFee:               linkFee,
+		ExpiresAt:             report.ObservationTimestampSeconds + opts.ExpirationWindow,
+		Values:                []bin.Int128{},
+	}
+	for i, v := range report.Values[2:] {
+		var decimals []decimal.Decimal
+		switch v := v.(type) {
+		case *llo.Decimal:
+			if v == nil {
+				return nil, fmt.Errorf("ReportCodecSolanaBorsh cannot encode stream %d; missing value", i+2)
+			}
+			decimals = []decimal.Decimal{v.Decimal()}
+		case *llo.Quote:
+			if v == nil {
+				return nil, fmt.Errorf("ReportCodecSolanaBorsh cannot encode stream %d; missing value", i+2)
+			}
+			decimals = []decimal.Decimal{v.Benchmark, v.Bid, v.Ask}
+		default:
+			return nil, fmt.Errorf("ReportCodecSolanaBorsh cannot encode stream %d; unsupported value type: %T", i+2, v)
+		}
+		for _, d := range decimals {
+			value, err := toInt128(d.Mul(multiplier).BigInt())
+			if err != nil {
+				return nil, fmt.Errorf("ReportCodecSolanaBorsh cannot encode stream %d; %w", i+2, err)
+			}
+			r2.Values = append(r2.Values, value)
+		}
+	}
+
+	buf := new(bytes.Buffer)
+	if err := bin.NewBorshEncoder(buf).Encode(r2); err != nil {
+		return nil, fmt.Errorf("failed to encode report; %w", err)
+	}
+	return buf.Bytes(), nil
+}
+
+func (r ReportCodecSolanaBorsh) Decode(b []byte) (*Report, error) {
+	report := new(Report)
+	if err := bin.NewBorshDecoder(b).Decode(report); err != nil {
+		return nil, fmt.Errorf("failed to decode report; %w", err)
+	}
+	return report, nil
+}
+
+// Payload is the Borsh schema of the payload transmitted for a report
+type Payload struct {
+	ConfigDigest [32]byte
+	SeqNr        uint64
+	Report       []byte
+	Signatures   []Signature
+}
+
+type Signature struct {
+	Signer    uint8
+	Signature []byte
+}
+
+// Pack assembles the report and its signatures into a payload for verifying
+// on-chain. Reports are signed like the reports of Solana OCR2 programs, with
+// the legacy report context of the config digest and sequence number (see
+// evm.LegacyReportContext), which verifiers derive from the payload.
+func (r ReportCodecSolanaBorsh) Pack(digest types.ConfigDigest, seqNr uint64, report ocr2types.Report, sigs []types.AttributedOnchainSignature) ([]byte, error) {
+	p := Payload{
+		ConfigDigest: digest,
+		SeqNr:        seqNr,
+		Report:       report,
+		Signatures:   make([]Signature, len(sigs)),
+	}
+	for i, sig := range sigs {
+		p.Signatures[i] = Signature{Signer: uint8(sig.Signer), Signature: sig.Signature}
+	}
+	buf := new(bytes.Buffer)
+	if err := bin.NewBorshEncoder(buf).Encode(p); err != nil {
+		return nil, fmt.Errorf("failed to encode payload; %w", err)
+	}
+	return buf.Bytes(), nil
+}
+
+// Borsh encodes 128 bit integers in little endian, which is the default byte
+// order of Uint128 and Int128 when Endianness is unset.
+var (
+	two128 = new(big.Int).Lsh(big.NewInt(1), 128)
+	two127 = new(big.Int).Lsh(big.NewInt(1), 127)
+)
+
+func toUint128(i *big.Int) (bin.Uint128, error) {
+	if i.Sign() < 0 || i.Cmp(two128) >= 0 {
+		return bin.Uint128{}, fmt.Errorf("value %s does not fit in u128", i)
+	}
+	return bin.Uint128{
+		Lo: new(big.Int).And(i, new(big.Int).SetUint64(^uint64(0))).Uint64(),
+		Hi: new(big.Int).Rsh(i, 64).Uint64(),
+	}, nil
+}
+
+func toInt128(i *big.Int) (bin.Int128, error) {
+	if i.Cmp(new(big.Int).Neg(two127)) < 0 || i.Cmp(two127) >= 0 {
+		return bin.Int128{}, fmt.Errorf("value %s does not fit in i128", i)
+	}
+	if i.Sign() < 0 {
+		// two's complement
+		i = new(big.Int).Add(i, two128)
+	}
+	u, err := toUint128(i)
+	return bin.Int128(u), err
+}
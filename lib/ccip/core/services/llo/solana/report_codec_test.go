@@ -0,0 +1,146 @@
+package solana
+
+import (
+	"encoding/binary"
+	"fmt"
+	"math/big"
+	"testing"
+
+	bin "github.com/gagliardetto/binary"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/libocr/offchainreporting2plus/types"
+
+	llotypes "github.com/smartcontractkit/chainlink-common/pkg/types/llo"
+
+	"github.com/smartcontractkit/chainlink-data-streams/llo"
+)
+
+func newValidSolanaBorshReport() llo.Report {
+	return llo.Report{
+		ConfigDigest:                types.ConfigDigest{1, 2, 3},
+		SeqNr:                       32,
+		ChannelID:                   llotypes.ChannelID(31),
+		ValidAfterSeconds:           28,
+		ObservationTimestampSeconds: 34,
+		Values: []llo.StreamValue{
+			llo.ToDecimal(decimal.NewFromInt(35)),
+			llo.ToDecimal(decimal.NewFromInt(36)),
+			llo.ToDecimal(decimal.RequireFromString("-1.23")),
+			&llo.Quote{Bid: decimal.NewFromInt(37), Benchmark: decimal.NewFromInt(38), Ask: decimal.NewFromInt(39)},
+		},
+		Specimen: false,
+	}
+}
+
+func Test_ReportCodecSolanaBorsh(t *testing.T) {
+	rc := ReportCodecSolanaBorsh{}
+
+	feedID := [32]uint8{0x1, 0x2, 0x3}
+	cd := llotypes.ChannelDefinition{Opts: llotypes.ChannelOpts(fmt.Sprintf(`{"baseUSDFee":"10.50","expirationWindow":60,"feedID":"0x%x","multiplier":100}`, feedID))}
+
+	t.Run("Encode errors if no values", func(t *testing.T) {
+		_, err := rc.Encode(llo.Report{}, cd)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "ReportCodecSolanaBorsh requires at least 2 values (NativePrice, LinkPrice, ...)")
+	})
+
+	t.Run("does not encode specimen reports", func(t *testing.T) {
+		report := newValidSolanaBorshReport()
+		report.Specimen = true
+
+		_, err := rc.Encode(report, cd)
+		assert.EqualError(t, err, "ReportCodecSolanaBorsh does not support encoding specimen reports")
+	})
+
+	t.Run("Encode errors on missing values", func(t *testing.T) {
+		report := newValidSolanaBorshReport()
+		report.Values[3] = (*llo.Quote)(nil)
+
+		_, err := rc.Encode(report, cd)
+		assert.EqualError(t, err, "ReportCodecSolanaBorsh cannot encode stream 3; missing value")
+	})
+
+	t.Run("Encode errors if a value does not fit in i128", func(t *testing.T) {
+		report := newValidSolanaBorshReport()
+		report.Values[2] = llo.ToDecimal(decimal.NewFromBigInt(new(big.Int).Lsh(big.NewInt(1), 127), 0))
+
+		_, err := rc.Encode(report, cd)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "does not fit in i128")
+	})
+
+	t.Run("Encode constructs a report from observations", func(t *testing.T) {
+		encoded, err := rc.Encode(newValidSolanaBorshReport(), cd)
+		require.NoError(t, err)
+
+		// feed_id, timestamps, fees, expires_at, then values length prefix and 4 values
+		require.Len(t, encoded, 32+4+4+16+16+4+4+4*16)
+		assert.Equal(t, feedID[:], encoded[:32])
+		assert.Equal(t, uint32(29), binary.LittleEndian.Uint32(encoded[32:]))
+		assert.Equal(t, uint32(34), binary.LittleEndian.Uint32(encoded[36:]))
+		assert.Equal(t, uint64(300000000000000000), binary.LittleEndian.Uint64(encoded[40:]))
+		assert.Equal(t, uint64(0), binary.LittleEndian.Uint64(encoded[48:]))
+		assert.Equal(t, uint64(291666666666666667), binary.LittleEndian.Uint64(encoded[56:]))
+		assert.Equal(t, uint32(94), binary.LittleEndian.Uint32(encoded[72:]))
+		assert.Equal(t, uint32(4), binary.LittleEndian.Uint32(encoded[76:]))
+		// -123 in two's complement
+		assert.Equal(t, uint64(0xffffffffffffff85), binary.LittleEndian.Uint64(encoded[80:]))
+		assert.Equal(t, uint64(0xffffffffffffffff), binary.LittleEndian.Uint64(encoded[88:]))
+
+		t.Run("Decode decodes the report", func(t *testing.T) {
+			decoded, err := rc.Decode(encoded)
+			require.NoError(t, err)
+
+			assert.Equal(t, feedID, decoded.FeedID)
+			assert.Equal(t, uint32(29), decoded.ValidFromTimestamp)
+			assert.Equal(t, uint32(34), decoded.ObservationsTimestamp)
+			assert.Equal(t, "300000000000000000", decoded.NativeFee.BigInt().String())
+			assert.Equal(t, "291666666666666667", decoded.LinkFee.BigInt().String())
+			assert.Equal(t, uint32(94), decoded.ExpiresAt)
+			values := make([]string, len(decoded.Values))
+			for i, v := range decoded.Values {
+				values[i] = v.BigInt().String()
+			}
+			assert.Equal(t, []string{"-123", "3800", "3700", "3900"}, values)
+		})
+	})
+
+	t.Run("uses zero values if fees are missing", func(t *testing.T) {
+		report := newValidSolanaBorshReport()
+		report.Values[0] = (*llo.Decimal)(nil)
+		report.Values[1] = (*llo.Decimal)(nil)
+
+		encoded, err := rc.Encode(report, cd)
+		require.NoError(t, err)
+
+		decoded, err := rc.Decode(encoded)
+		require.NoError(t, err)
+		assert.Equal(t, "0", decoded.NativeFee.BigInt().String())
+		assert.Equal(t, "0", decoded.LinkFee.BigInt().String())
+	})
+
+	t.Run("Decode errors on invalid report", func(t *testing.T) {
+		_, err := rc.Decode([]byte{1, 2, 3})
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "failed to decode report")
+	})
+
+	t.Run("Pack encodes the payload", func(t *testing.T) {
+		encoded, err := rc.Encode(newValidSolanaBorshReport(), cd)
+		require.NoError(t, err)
+		sigs := []types.AttributedOnchainSignature{{Signature: []byte{1, 2}, Signer: 1}, {Signature: []byte{3}, Signer: 3}}
+
+		packed, err := rc.Pack(types.ConfigDigest{1, 2, 3}, 32, encoded, sigs)
+		require.NoError(t, err)
+
+		var p Payload
+		require.NoError(t, bin.NewBorshDecoder(packed).Decode(&p))
+		assert.Equal(t, [32]byte{1, 2, 3}, p.ConfigDigest)
+		assert.Equal(t, uint64(32), p.SeqNr)
+		assert.Equal(t, encoded, p.Report)
+		assert.Equal(t, []Signature{{Signer: 1, Signature: []byte{1, 2}}, {Signer: 3, Signature: []byte{3}}}, p.Signatures)
+	})
+}
@@ -23,11 +23,11 @@ type staticCDC struct {
 }
 
 func NewStaticChannelDefinitionCache(lggr logger.Logger, dfnstr string) (llotypes.ChannelDefinitionCache, error) {
-	var definitions llotypes.ChannelDefinitions
+	var definitions ChannelDefinitions
 	if err := json.Unmarshal([]byte(dfnstr), &definitions); err != nil {
 		return nil, err
 	}
-	return &staticCDC{services.StateMachine{}, logger.Named(lggr, "StaticChannelDefinitionCache"), definitions}, nil
+	return &staticCDC{services.StateMachine{}, logger.Named(lggr, "StaticChannelDefinitionCache"), llotypes.ChannelDefinitions(definitions)}, nil
 }
 
 func (s *staticCDC) Start(context.Context) error {
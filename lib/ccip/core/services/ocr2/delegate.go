@@ -50,8 +50,11 @@ import (
 	"github.com/smartcontractkit/chainlink/v2/core/logger"
 	"github.com/smartcontractkit/chainlink/v2/core/services/job"
 	"github.com/smartcontractkit/chainlink/v2/core/services/keystore"
+	"github.com/smartcontractkit/chainlink/v2/core/services/keystore/chaintype"
 	"github.com/smartcontractkit/chainlink/v2/core/services/keystore/keys/ocr2key"
 	"github.com/smartcontractkit/chainlink/v2/core/services/llo"
+	lloevm "github.com/smartcontractkit/chainlink/v2/core/services/llo/evm"
+	llosolana "github.com/smartcontractkit/chainlink/v2/core/services/llo/solana"
 	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ccip/ccipcommit"
 	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ccip/ccipexec"
 	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/functions"
@@ -402,6 +405,47 @@ func (d *Delegate) cleanupEVM(ctx context.Context, jb job.Job, relayID types.Rel
 	return nil
 }
 
+// ValidateSpec checks that the chain and the key bundle of the job are available on this node, and that the plugin
+// config of the CCIP plugins is well-formed. It is used to dry run job proposals before they are approved.
+func (d *Delegate) ValidateSpec(ctx context.Context, jb job.Job) error {
+	spec := jb.OCR2OracleSpec
+	if spec == nil {
+		return errors.Errorf("offchainreporting2.Delegate expects an *job.OCR2OracleSpec to be present, got %v", jb)
+	}
+
+	rid, err := spec.RelayID()
+	if err != nil {
+		return ErrJobSpecNoRelayer{Err: err, PluginName: string(spec.PluginType)}
+	}
+	if _, err = d.RelayGetter.Get(rid); err != nil {
+		return ErrRelayNotEnabled{Err: err, Relay: spec.Relay, PluginName: string(spec.PluginType)}
+	}
+
+	var kbID string
+	if spec.OCRKeyBundleID.Valid {
+		kbID = spec.OCRKeyBundleID.String
+	} else if kbID, err = d.cfg.OCR2().KeyBundleID(); err != nil {
+		return err
+	}
+	if _, err = d.ks.Get(kbID); err != nil {
+		return errors.Wrapf(err, "OCR2 key bundle %s", kbID)
+	}
+
+	switch spec.PluginType {
+	case types.CCIPCommit:
+		var pluginJobSpecConfig ccipconfig.CommitPluginJobSpecConfig
+		if err = json.Unmarshal(spec.PluginConfig.Bytes(), &pluginJobSpecConfig); err != nil {
+			return errors.Wrap(err, "invalid CCIP commit plugin config")
+		}
+	case types.CCIPExecution:
+		var pluginJobSpecConfig ccipconfig.ExecPluginJobSpecConfig
+		if err = json.Unmarshal(spec.PluginConfig.Bytes(), &pluginJobSpecConfig); err != nil {
+			return errors.Wrap(err, "invalid CCIP execution plugin config")
+		}
+	}
+	return nil
+}
+
 // ServicesForSpec returns the OCR2 services that need to run for this job
 func (d *Delegate) ServicesForSpec(ctx context.Context, jb job.Job) ([]job.ServiceCtx, error) {
 	spec := jb.OCR2OracleSpec
@@ -987,7 +1031,7 @@ func (d *Delegate) newServicesLLO(
 		if err3 != nil {
 			return nil, fmt.Errorf("job %d (%s) specified key bundle ID %q for report format %s, but got error trying to load it: %w", jb.ID, jb.Name.ValueOrZero(), kbid, rfStr, err3)
 		}
-		rf, err4 := llotypes.ReportFormatFromString(rfStr)
+		rf, err4 := llo.ReportFormatFromString(rfStr)
 		if err4 != nil {
 			return nil, fmt.Errorf("job %d (%s) specified key bundle ID %q for report format %s, but it is not a recognized report format: %w", jb.ID, jb.Name.ValueOrZero(), kbid, rfStr, err4)
 		}
@@ -995,17 +1039,25 @@ func (d *Delegate) newServicesLLO(
 	}
 
 	// Use the default key bundle if not specified
-	// NOTE: Only JSON and EVMPremiumLegacy supported for now
+	// NOTE: Solana reports are only signed with a default key if a solana key
+	// bundle exists, otherwise the key bundle ID must be specified in the job spec
 	// https://smartcontract-it.atlassian.net/browse/MERC-3722
-	for _, rf := range []llotypes.ReportFormat{llotypes.ReportFormatJSON, llotypes.ReportFormatEVMPremiumLegacy} {
+	for _, rf := range []llotypes.ReportFormat{llotypes.ReportFormatJSON, llotypes.ReportFormatEVMPremiumLegacy, lloevm.ReportFormatEVMABI, llosolana.ReportFormatSolanaBorsh} {
 		if _, exists := kbm[rf]; !exists {
+			keyType := chaintype.EVM
+			if rf == llosolana.ReportFormatSolanaBorsh {
+				keyType = chaintype.Solana
+			}
 			// Use the first if unspecified
-			kbs, err3 := d.ks.GetAllOfType("evm")
+			kbs, err3 := d.ks.GetAllOfType(keyType)
 			if err3 != nil {
 				return nil, err3
 			}
 			if len(kbs) == 0 {
-				return nil, fmt.Errorf("no on-chain signing keys found for report format %s", "evm")
+				if keyType != chaintype.EVM {
+					continue
+				}
+				return nil, fmt.Errorf("no on-chain signing keys found for report format %s", keyType)
 			} else if len(kbs) > 1 {
 				lggr.Debugf("Multiple on-chain signing keys found for report format %s, using the first", rf.String())
 			}
@@ -9,6 +9,7 @@ import (
 	"github.com/stretchr/testify/require"
 	"gopkg.in/guregu/null.v4"
 
+	"github.com/smartcontractkit/chainlink-common/pkg/loop"
 	"github.com/smartcontractkit/chainlink-common/pkg/types"
 
 	evmcfg "github.com/smartcontractkit/chainlink/v2/core/chains/evm/config/toml"
@@ -24,8 +25,10 @@ import (
 	"github.com/smartcontractkit/chainlink/v2/core/services/job"
 	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2"
 	ocr2validate "github.com/smartcontractkit/chainlink/v2/core/services/ocr2/validate"
+	"github.com/smartcontractkit/chainlink/v2/core/services/relay"
 	evmrelay "github.com/smartcontractkit/chainlink/v2/core/services/relay/evm"
 	"github.com/smartcontractkit/chainlink/v2/core/testdata/testspecs"
+	"github.com/smartcontractkit/chainlink/v2/plugins"
 )
 
 func TestGetEVMEffectiveTransmitterID(t *testing.T) {
@@ -171,3 +174,103 @@ func TestGetEVMEffectiveTransmitterID(t *testing.T) {
 		require.Error(t, err)
 	})
 }
+
+type validateRelayGetter struct {
+	enabled types.RelayID
+}
+
+func (g validateRelayGetter) Get(id types.RelayID) (loop.Relayer, error) {
+	if id != g.enabled {
+		return nil, errors.Errorf("relayer %s not found", id)
+	}
+	return nil, nil
+}
+
+func (g validateRelayGetter) GetIDToRelayerMap() (map[types.RelayID]loop.Relayer, error) {
+	return map[types.RelayID]loop.Relayer{}, nil
+}
+
+func TestDelegate_ValidateSpec(t *testing.T) {
+	config := configtest.NewGeneralConfig(t, nil)
+	db := pgtest.NewSqlxDB(t)
+	keyStore := cltest.NewKeyStore(t, db)
+	require.NoError(t, keyStore.OCR2().Add(testutils.Context(t), cltest.DefaultOCR2Key))
+	lggr := logger.TestLogger(t)
+
+	processConfig := plugins.NewRegistrarConfig(loop.GRPCOpts{}, func(name string) (*plugins.RegisteredLoop, error) { return nil, nil }, func(loopId string) {})
+	ocr2DelegateConfig := ocr2.NewDelegateConfig(config.OCR2(), config.Mercury(), config.Threshold(), config.Insecure(), config.JobPipeline(), processConfig)
+	newDelegate := func(enabled types.RelayID) *ocr2.Delegate {
+		return ocr2.NewDelegate(nil, nil, nil, nil, nil, nil, nil, nil, nil, lggr, ocr2DelegateConfig,
+			keyStore.OCR2(), keyStore.Eth(), validateRelayGetter{enabled: enabled}, nil, nil)
+	}
+
+	evmRelayID := types.NewRelayID(relay.NetworkEVM, "0")
+
+	testCases := []struct {
+		name    string
+		relayID types.RelayID
+		update  func(jb *job.Job)
+		wantErr string
+	}{
+		{
+			name:    "valid spec",
+			relayID: evmRelayID,
+		},
+		{
+			name:    "missing OCR2 oracle spec",
+			relayID: evmRelayID,
+			update:  func(jb *job.Job) { jb.OCR2OracleSpec = nil },
+			wantErr: "expects an *job.OCR2OracleSpec to be present",
+		},
+		{
+			name:    "relay not enabled",
+			relayID: types.NewRelayID(relay.NetworkEVM, "1337"),
+			wantErr: "failed to get relay evm, is it enabled?",
+		},
+		{
+			name:    "missing key bundle",
+			relayID: evmRelayID,
+			update: func(jb *job.Job) {
+				jb.OCR2OracleSpec.OCRKeyBundleID = null.StringFrom("0000000000000000000000000000000000000000000000000000000000000000")
+			},
+			wantErr: "OCR2 key bundle",
+		},
+		{
+			name:    "invalid CCIP commit plugin config",
+			relayID: evmRelayID,
+			update: func(jb *job.Job) {
+				jb.OCR2OracleSpec.PluginType = types.CCIPCommit
+				jb.OCR2OracleSpec.PluginConfig = job.JSONConfig{"sourceStartBlock": "latest"}
+			},
+			wantErr: "invalid CCIP commit plugin config",
+		},
+		{
+			name:    "invalid CCIP execution plugin config",
+			relayID: evmRelayID,
+			update: func(jb *job.Job) {
+				jb.OCR2OracleSpec.PluginType = types.CCIPExecution
+				jb.OCR2OracleSpec.PluginConfig = job.JSONConfig{"sourceStartBlock": "latest"}
+			},
+			wantErr: "invalid CCIP execution plugin config",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx := testutils.Context(t)
+			jb, err := ocr2validate.ValidatedOracleSpecToml(ctx, config.OCR2(), config.Insecure(), testspecs.GetOCR2EVMSpecMinimal(), nil)
+			require.NoError(t, err)
+			jb.OCR2OracleSpec.OCRKeyBundleID = null.StringFrom(cltest.DefaultOCR2Key.ID())
+			if tc.update != nil {
+				tc.update(&jb)
+			}
+
+			err = newDelegate(tc.relayID).ValidateSpec(ctx, jb)
+			if tc.wantErr != "" {
+				require.ErrorContains(t, err, tc.wantErr)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
@@ -595,6 +595,7 @@ func (r *CommitReportingPlugin) buildReport(ctx context.Context, lggr logger.Log
 	if err != nil {
 		return cciptypes.CommitStoreReport{}, err
 	}
+	r.recordRootDeadline(ctx, lggr, tree.Root(), sendRequests[0])
 
 	return cciptypes.CommitStoreReport{
 		GasPrices:   gasPrices,
@@ -604,6 +605,18 @@ func (r *CommitReportingPlugin) buildReport(ctx context.Context, lggr logger.Log
 	}, nil
 }
 
+// recordRootDeadline records when the oldest message of the root becomes manually executable, which is the
+// deadline the fee policy of the commit tx bumps against. Roots without a deadline are bumped by age only.
+func (r *CommitReportingPlugin) recordRootDeadline(ctx context.Context, lggr logger.Logger, root [32]byte, oldest cciptypes.EVM2EVMMessageWithTxMeta) {
+	offRampConfig, err := r.offRampReader.OnchainConfig(ctx)
+	if err != nil {
+		lggr.Warnw("Failed to get the OffRamp config, the commit tx has no deadline", "err", err)
+		return
+	}
+	sentAt := time.UnixMilli(oldest.BlockTimestampUnixMilli)
+	ccipdata.CommitRootDeadlines.Set(root, sentAt.Add(offRampConfig.PermissionLessExecutionThresholdSeconds))
+}
+
 func (r *CommitReportingPlugin) ShouldAcceptFinalizedReport(ctx context.Context, reportTimestamp types.ReportTimestamp, report types.Report) (bool, error) {
 	parsedReport, err := r.commitStoreReader.DecodeCommitReport(ctx, report)
 	if err != nil {
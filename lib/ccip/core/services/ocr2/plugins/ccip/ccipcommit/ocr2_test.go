@@ -39,6 +39,7 @@ import (
 	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ccip/internal/cache"
 	ccipcachemocks "github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ccip/internal/cache/mocks"
 	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ccip/internal/ccipcalc"
+	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ccip/internal/ccipdata"
 	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ccip/internal/ccipdata/factory"
 	ccipdatamocks "github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ccip/internal/ccipdata/mocks"
 	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ccip/internal/ccipdata/v1_0_0"
@@ -231,6 +232,8 @@ func TestCommitReportingPlugin_Report(t *testing.T) {
 	var gasPrice = big.NewInt(1)
 	var gasPrice2 = big.NewInt(2)
 	gasPriceHeartBeat := *config.MustNewDuration(time.Hour)
+	sentAt := time.Now().Add(-time.Minute).Truncate(time.Millisecond)
+	permissionLessExecThreshold := 8 * time.Hour
 
 	t.Run("not enough observations", func(t *testing.T) {
 		p := &CommitReportingPlugin{}
@@ -263,6 +266,7 @@ func TestCommitReportingPlugin_Report(t *testing.T) {
 		sendRequests      []cciptypes.EVM2EVMMessageWithTxMeta
 		expCommitReport   *cciptypes.CommitStoreReport
 		expSeqNumRange    cciptypes.CommitStoreInterval
+		expRootDeadline   time.Time
 		expErr            bool
 	}{
 		{
@@ -274,6 +278,9 @@ func TestCommitReportingPlugin_Report(t *testing.T) {
 			f: 1,
 			sendRequests: []cciptypes.EVM2EVMMessageWithTxMeta{
 				{
+					TxMeta: cciptypes.TxMeta{
+						BlockTimestampUnixMilli: sentAt.UnixMilli(),
+					},
 					EVM2EVMMessage: cciptypes.EVM2EVMMessage{
 						SequenceNumber: 1,
 					},
@@ -297,7 +304,8 @@ func TestCommitReportingPlugin_Report(t *testing.T) {
 				TokenPrices: nil,
 				GasPrices:   []cciptypes.GasPrice{{DestChainSelector: sourceChainSelector, Value: gasPrice}},
 			},
-			expErr: false,
+			expRootDeadline: sentAt.Add(permissionLessExecThreshold),
+			expErr:          false,
 		},
 		{
 			name: "observations with mix gas price formats",
@@ -405,6 +413,9 @@ func TestCommitReportingPlugin_Report(t *testing.T) {
 				onRampReader.On("GetSendRequestsBetweenSeqNums", ctx, tc.expSeqNumRange.Min, tc.expSeqNumRange.Max, true).Return(tc.sendRequests, nil)
 			}
 
+			offRampReader := ccipdatamocks.NewOffRampReader(t)
+			offRampReader.On("OnchainConfig", ctx).Return(cciptypes.ExecOnchainConfig{PermissionLessExecutionThresholdSeconds: permissionLessExecThreshold}, nil).Maybe()
+
 			evmEstimator := mocks.NewEvmFeeEstimator(t)
 			evmEstimator.On("L1Oracle").Return(nil)
 
@@ -443,6 +454,7 @@ func TestCommitReportingPlugin_Report(t *testing.T) {
 			p.lggr = logger.TestLogger(t)
 			p.destPriceRegistryReader = destPriceRegistryReader
 			p.onRampReader = onRampReader
+			p.offRampReader = offRampReader
 			p.sourceChainSelector = sourceChainSelector
 			p.gasPriceEstimator = gasPriceEstimator
 			p.offchainConfig.GasPriceHeartBeat = gasPriceHeartBeat.Duration()
@@ -472,6 +484,12 @@ func TestCommitReportingPlugin_Report(t *testing.T) {
 				assert.NoError(t, err)
 				assert.Equal(t, types.Report(encodedExpectedReport), gotReport)
 			}
+
+			if !tc.expRootDeadline.IsZero() {
+				deadline, ok := ccipdata.CommitRootDeadlines.Get(tc.expCommitReport.MerkleRoot)
+				require.True(t, ok)
+				assert.True(t, tc.expRootDeadline.Equal(deadline), "expected deadline %s, got %s", tc.expRootDeadline, deadline)
+			}
 		})
 	}
 }
@@ -1973,6 +1991,14 @@ func TestCommitReportToEthTxMeta(t *testing.T) {
 			require.NoError(t, err)
 			require.NotNil(t, txMeta)
 			require.EqualValues(t, tc.expectedRange, txMeta.SeqNumbers)
+			require.Equal(t, factory.FeePolicyCommit, *txMeta.FeePolicy)
+
+			deadline := time.Now().Add(time.Hour).Truncate(time.Millisecond)
+			ccipdata.CommitRootDeadlines.Set(report.MerkleRoot, deadline)
+			txMeta, err = fn(out)
+			require.NoError(t, err)
+			require.NotNil(t, txMeta.Deadline)
+			require.True(t, deadline.Equal(*txMeta.Deadline))
 		})
 	}
 }
@@ -18,6 +18,8 @@ import (
 	"github.com/smartcontractkit/chainlink/v2/core/logger"
 	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ccip"
 	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ccip/abihelpers"
+	ccipconfig "github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ccip/config"
+	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ccip/internal/ccipcalc"
 	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ccip/internal/ccipdata"
 	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ccip/prices"
 	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ccip/tokendata"
@@ -26,8 +28,9 @@ import (
 
 // Batching strategies
 const (
-	BestEffortBatchingStrategyID = uint32(0)
-	ZKOverflowBatchingStrategyID = uint32(1)
+	BestEffortBatchingStrategyID    = uint32(0)
+	ZKOverflowBatchingStrategyID    = uint32(1)
+	PriorityLanesBatchingStrategyID = uint32(2)
 )
 
 type BatchContext struct {
@@ -49,6 +52,10 @@ type BatchContext struct {
 	gasPriceEstimator          prices.GasPriceEstimatorExec
 	destWrappedNative          cciptypes.Address
 	offchainConfig             cciptypes.ExecOffchainConfig
+	// tokenPoolRateLimits holds the remaining inbound capacity of the destination token pools, nil when unknown.
+	tokenPoolRateLimits tokenPoolRateLimits
+	// rateLimitDeferrals holds the messages waiting for the token pools to refill, nil disables the deferrals.
+	rateLimitDeferrals *rateLimitDeferralsContainer
 }
 
 type BatchingStrategy interface {
@@ -62,7 +69,18 @@ type ZKOverflowBatchingStrategy struct {
 	statuschecker statuschecker.CCIPTransactionStatusChecker
 }
 
-func NewBatchingStrategy(batchingStrategyID uint32, statusChecker statuschecker.CCIPTransactionStatusChecker) (BatchingStrategy, error) {
+type PriorityLanesBatchingStrategy struct {
+	senders                   mapset.Set[cciptypes.Address]
+	receivers                 mapset.Set[cciptypes.Address]
+	reservedGasPercent        uint64
+	reservedTokenValuePercent int64
+}
+
+func NewBatchingStrategy(
+	batchingStrategyID uint32,
+	statusChecker statuschecker.CCIPTransactionStatusChecker,
+	priorityLanesConfig ccipconfig.PriorityLanesConfig,
+) (BatchingStrategy, error) {
 	var batchingStrategy BatchingStrategy
 	switch batchingStrategyID {
 	case BestEffortBatchingStrategyID:
@@ -71,6 +89,16 @@ func NewBatchingStrategy(batchingStrategyID uint32, statusChecker statuschecker.
 		batchingStrategy = &ZKOverflowBatchingStrategy{
 			statuschecker: statusChecker,
 		}
+	case PriorityLanesBatchingStrategyID:
+		if err := priorityLanesConfig.ValidatePriorityLanesConfig(); err != nil {
+			return nil, err
+		}
+		batchingStrategy = &PriorityLanesBatchingStrategy{
+			senders:                   mapset.NewSet(ccipcalc.EvmAddrsToGeneric(priorityLanesConfig.Senders...)...),
+			receivers:                 mapset.NewSet(ccipcalc.EvmAddrsToGeneric(priorityLanesConfig.Receivers...)...),
+			reservedGasPercent:        uint64(priorityLanesConfig.ReservedGasPercent),
+			reservedTokenValuePercent: int64(priorityLanesConfig.ReservedTokenValuePercent),
+		}
 	default:
 		return nil, errors.Errorf("unknown batching strategy ID %d", batchingStrategyID)
 	}
@@ -182,6 +210,102 @@ func (bs ZKOverflowBatchingStrategy) BuildBatch(
 	return batchBuilder.batch, batchBuilder.statuses
 }
 
+func (s *PriorityLanesBatchingStrategy) GetBatchingStrategyID() uint32 {
+	return PriorityLanesBatchingStrategyID
+}
+
+// PriorityLanesBatchingStrategy is a batching strategy that reserves a share of the batch gas and aggregate token value budget
+// for messages sent from or to the configured addresses (priority messages).
+// Priority messages are batched first and can use the whole budget. The remaining messages are then batched best-effort,
+// but they can't use the part of the reservation that was not consumed by priority messages.
+// This way priority messages are not starved by the rest of the traffic when the lane is congested.
+func (s *PriorityLanesBatchingStrategy) BuildBatch(
+	ctx context.Context,
+	batchCtx *BatchContext,
+) ([]ccip.ObservedMessage, []messageExecStatus) {
+	reservedGas := batchCtx.availableGas * s.reservedGasPercent / 100
+	reservedTokenValue := big.NewInt(0).Mul(batchCtx.aggregateTokenLimit, big.NewInt(s.reservedTokenValuePercent))
+	reservedTokenValue.Quo(reservedTokenValue, big.NewInt(100))
+	if reservedTokenValue.Sign() < 0 {
+		reservedTokenValue.SetInt64(0)
+	}
+
+	statuses := make(map[uint64]messageStatus, len(batchCtx.report.sendRequestsWithMeta))
+	tokenDataBySeqNr := make(map[uint64][][]byte)
+
+	addIfValid := func(msg cciptypes.EVM2EVMOnRampCCIPSendRequestedWithMeta, msgLggr logger.Logger) (uint64, *big.Int, error) {
+		status, messageMaxGas, tokenData, msgValue, err := performCommonChecks(ctx, batchCtx, msg, msgLggr)
+		if err != nil {
+			return 0, nil, err
+		}
+		statuses[msg.SequenceNumber] = status
+		if status.shouldBeSkipped() {
+			return 0, big.NewInt(0), nil
+		}
+		updateBatchContext(batchCtx, msg, messageMaxGas, msgValue, msgLggr)
+		tokenDataBySeqNr[msg.SequenceNumber] = tokenData
+		return messageMaxGas, msgValue, nil
+	}
+
+	// Priority messages first, they are allowed to use the whole budget including the reservation.
+	for _, msg := range batchCtx.report.sendRequestsWithMeta {
+		if !s.isPriority(msg) {
+			continue
+		}
+		msgLggr := batchCtx.lggr.With("messageID", hexutil.Encode(msg.MessageID[:]), "seqNr", msg.SequenceNumber, "priority", true)
+		usedGas, usedValue, err := addIfValid(msg, msgLggr)
+		if err != nil {
+			return []ccip.ObservedMessage{}, []messageExecStatus{}
+		}
+		reservedGas -= min(reservedGas, usedGas)
+		reservedTokenValue.Sub(reservedTokenValue, usedValue)
+		if reservedTokenValue.Sign() < 0 {
+			reservedTokenValue.SetInt64(0)
+		}
+	}
+
+	// The rest of the messages can't use what is left of the reservation.
+	reservedGas = min(reservedGas, batchCtx.availableGas)
+	batchCtx.availableGas -= reservedGas
+	batchCtx.aggregateTokenLimit.Sub(batchCtx.aggregateTokenLimit, reservedTokenValue)
+	// The reservation is given back on every return, so that the budget is left as the batching used it.
+	defer func() {
+		batchCtx.availableGas += reservedGas
+		batchCtx.aggregateTokenLimit.Add(batchCtx.aggregateTokenLimit, reservedTokenValue)
+	}()
+	batchCtx.lggr.Infow("Batching non-priority messages", "reservedGas", reservedGas, "reservedTokenValue", reservedTokenValue)
+
+	for _, msg := range batchCtx.report.sendRequestsWithMeta {
+		if _, added := tokenDataBySeqNr[msg.SequenceNumber]; added {
+			continue
+		}
+		// Priority messages are evaluated again only when they were skipped because of a non-priority message
+		// of the same sender that had to be executed before them.
+		if status, evaluated := statuses[msg.SequenceNumber]; evaluated && status != InvalidNonce {
+			continue
+		}
+		msgLggr := batchCtx.lggr.With("messageID", hexutil.Encode(msg.MessageID[:]), "seqNr", msg.SequenceNumber, "priority", false)
+		if _, _, err := addIfValid(msg, msgLggr); err != nil {
+			return []ccip.ObservedMessage{}, []messageExecStatus{}
+		}
+	}
+
+	// Keep the batch ordered by sequence number, the report is built assuming increasing sequence numbers.
+	batchBuilder := newBatchBuildContainer(len(batchCtx.report.sendRequestsWithMeta))
+	for _, msg := range batchCtx.report.sendRequestsWithMeta {
+		if tokenData, added := tokenDataBySeqNr[msg.SequenceNumber]; added {
+			batchBuilder.addToBatch(msg, tokenData)
+			continue
+		}
+		batchBuilder.skip(msg, statuses[msg.SequenceNumber])
+	}
+	return batchBuilder.batch, batchBuilder.statuses
+}
+
+func (s *PriorityLanesBatchingStrategy) isPriority(msg cciptypes.EVM2EVMOnRampCCIPSendRequestedWithMeta) bool {
+	return s.senders.Contains(msg.Sender) || s.receivers.Contains(msg.Receiver)
+}
+
 func performCommonChecks(
 	ctx context.Context,
 	batchCtx *BatchContext,
@@ -193,6 +317,13 @@ func performCommonChecks(
 		return AlreadyExecuted, 0, nil, nil, nil
 	}
 
+	if batchCtx.rateLimitDeferrals != nil {
+		if until, deferred := batchCtx.rateLimitDeferrals.isDeferred(msg.SequenceNumber, time.Now()); deferred {
+			msgLggr.Infow("Skipping message - deferred until the token pool rate limit refills", "until", until)
+			return TokenPoolRateLimitDeferred, 0, nil, nil, nil
+		}
+	}
+
 	if len(msg.Data) > batchCtx.availableDataLen {
 		msgLggr.Infow("Skipping message - insufficient remaining batch data length", "msgDataLen", len(msg.Data), "availableBatchDataLen", batchCtx.availableDataLen)
 		return InsufficientRemainingBatchDataLength, 0, nil, nil, nil
@@ -243,6 +374,10 @@ func performCommonChecks(
 		return AggregateTokenLimitExceeded, 0, nil, nil, nil
 	}
 
+	if status, ok := checkTokenPoolRateLimits(batchCtx, msg, msgLggr); !ok {
+		return status, 0, nil, nil, nil
+	}
+
 	tokenData, elapsed, err1 := getTokenDataWithTimeout(ctx, msg, batchCtx.tokenDataRemainingDuration, batchCtx.tokenDataWorker)
 	batchCtx.tokenDataRemainingDuration -= elapsed
 	if err1 != nil {
@@ -399,6 +534,7 @@ func updateBatchContext(
 	batchCtx.availableGas -= messageMaxGas
 	batchCtx.availableDataLen -= len(msg.Data)
 	batchCtx.aggregateTokenLimit.Sub(batchCtx.aggregateTokenLimit, msgValue)
+	batchCtx.tokenPoolRateLimits.consume(msg.TokenAmounts, batchCtx.sourceToDestToken)
 	if msg.Nonce > 0 {
 		batchCtx.expectedNonces[msg.Sender] = msg.Nonce + 1
 	}
@@ -414,6 +550,38 @@ func updateBatchContext(
 	)
 }
 
+// checkTokenPoolRateLimits verifies that the destination token pools have enough inbound capacity for the message.
+// Messages that would fit once the pools refill are deferred until the predicted refill time, so that they are not
+// retried in every round.
+func checkTokenPoolRateLimits(
+	batchCtx *BatchContext,
+	msg cciptypes.EVM2EVMOnRampCCIPSendRequestedWithMeta,
+	msgLggr logger.Logger,
+) (messageStatus, bool) {
+	if len(batchCtx.tokenPoolRateLimits) == 0 || len(msg.TokenAmounts) == 0 {
+		return SuccesfullyValidated, true
+	}
+
+	amounts := destTokenAmounts(msg.TokenAmounts, batchCtx.sourceToDestToken)
+	delay, canRefill := batchCtx.tokenPoolRateLimits.refillDelay(amounts)
+	if !canRefill {
+		msgLggr.Warnw("Skipping message - token pool rate limit capacity exceeded")
+		return TokenPoolRateLimitExceeded, false
+	}
+	if delay == 0 {
+		return SuccesfullyValidated, true
+	}
+
+	if batchCtx.rateLimitDeferrals != nil {
+		until := time.Now().Add(delay)
+		batchCtx.rateLimitDeferrals.add(msg.SequenceNumber, until, amounts)
+		msgLggr.Infow("Skipping message - deferred until the token pool rate limit refills", "until", until)
+		return TokenPoolRateLimitDeferred, false
+	}
+	msgLggr.Warnw("Skipping message - token pool rate limit exceeded", "refillDelay", delay)
+	return TokenPoolRateLimitExceeded, false
+}
+
 func hasEnoughTokens(tokenLimit *big.Int, msgValue *big.Int, inflightValue *big.Int) (*big.Int, bool) {
 	tokensLeft := big.NewInt(0).Sub(tokenLimit, inflightValue)
 	return tokensLeft, tokensLeft.Cmp(msgValue) >= 0
@@ -500,6 +668,8 @@ const (
 	InvalidNonce                         messageStatus = "invalid_nonce"
 	AggregateTokenValueComputeError      messageStatus = "aggregate_token_value_compute_error"
 	AggregateTokenLimitExceeded          messageStatus = "aggregate_token_limit_exceeded"
+	TokenPoolRateLimitExceeded           messageStatus = "token_pool_rate_limit_exceeded"
+	TokenPoolRateLimitDeferred           messageStatus = "token_pool_rate_limit_deferred"
 	TokenDataNotReady                    messageStatus = "token_data_not_ready"
 	TokenDataFetchError                  messageStatus = "token_data_fetch_error"
 	TokenNotInDestTokenPrices            messageStatus = "token_not_in_dest_token_prices"
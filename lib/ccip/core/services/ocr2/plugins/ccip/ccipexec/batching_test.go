@@ -10,6 +10,8 @@ import (
 	"testing"
 	"time"
 
+	mapset "github.com/deckarep/golang-set/v2"
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/pkg/errors"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
@@ -19,6 +21,7 @@ import (
 	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
 	"github.com/smartcontractkit/chainlink/v2/core/logger"
 	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ccip"
+	ccipconfig "github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ccip/config"
 	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ccip/internal/ccipcalc"
 	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ccip/prices"
 	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ccip/tokendata"
@@ -37,22 +40,38 @@ type testCase struct {
 	expectedStates                                   []messageExecStatus
 	statuschecker                                    func(m *mockstatuschecker.CCIPTransactionStatusChecker)
 	skipGasPriceEstimator                            bool
+	tokenDataWorker                                  tokendata.Worker
 }
 
 func Test_NewBatchingStrategy(t *testing.T) {
 	t.Parallel()
 
 	mockStatusChecker := mockstatuschecker.NewCCIPTransactionStatusChecker(t)
+	priorityLanesConfig := ccipconfig.PriorityLanesConfig{
+		Senders:            []common.Address{common.HexToAddress("0xa")},
+		ReservedGasPercent: 50,
+	}
 
-	testCases := []int{0, 1, 2}
+	testCases := []struct {
+		batchingStrategyID  uint32
+		priorityLanesConfig ccipconfig.PriorityLanesConfig
+		expErr              bool
+	}{
+		{batchingStrategyID: 0},
+		{batchingStrategyID: 1},
+		{batchingStrategyID: 2, priorityLanesConfig: priorityLanesConfig},
+		{batchingStrategyID: 2, expErr: true},
+		{batchingStrategyID: 3, expErr: true},
+	}
 
-	for _, batchingStrategyId := range testCases {
-		factory, err := NewBatchingStrategy(uint32(batchingStrategyId), mockStatusChecker)
-		if batchingStrategyId == 2 {
+	for _, tc := range testCases {
+		factory, err := NewBatchingStrategy(tc.batchingStrategyID, mockStatusChecker, tc.priorityLanesConfig)
+		if tc.expErr {
 			assert.Error(t, err)
 		} else {
 			assert.NotNil(t, factory)
 			assert.NoError(t, err)
+			assert.Equal(t, tc.batchingStrategyID, factory.GetBatchingStrategyID())
 		}
 	}
 }
@@ -793,6 +812,180 @@ func TestBatchingStrategies(t *testing.T) {
 		}
 		runBatchingStrategyTests(t, strategy, 1_000_000, append(testCases, specificZkOverflowTestCases...))
 	})
+
+	t.Run("PriorityLanesBatchingStrategy without priority messages", func(t *testing.T) {
+		// Nothing matches the priority lanes, so the strategy must behave exactly as the best-effort one.
+		strategy := &PriorityLanesBatchingStrategy{
+			senders:            mapset.NewSet(ccipcalc.HexToAddress("0xdead")),
+			receivers:          mapset.NewSet[cciptypes.Address](),
+			reservedGasPercent: 0,
+		}
+		runBatchingStrategyTests(t, strategy, 1_000_000, append(testCases, bestEffortTestCases...))
+	})
+}
+
+func TestPriorityLanesBatchingStrategy(t *testing.T) {
+	spammer := ccipcalc.HexToAddress("0xa")
+	protocolSender := ccipcalc.HexToAddress("0xd")
+	vault := ccipcalc.HexToAddress("0xe")
+	srcNative := ccipcalc.HexToAddress("0xc")
+	destNative := ccipcalc.HexToAddress("0xb")
+
+	spamMsg1 := createTestMessage(1, spammer, 0, srcNative, big.NewInt(1e9), false, nil)
+	spamMsg2 := createTestMessage(2, spammer, 0, srcNative, big.NewInt(1e9), false, nil)
+	prioritySenderMsg := createTestMessage(3, protocolSender, 0, srcNative, big.NewInt(1e9), false, nil)
+	priorityReceiverMsg := createTestMessage(3, spammer, 0, srcNative, big.NewInt(1e9), false, nil)
+	priorityReceiverMsg.Receiver = vault
+
+	// Every message takes ~122k gas, with 300k gas per batch and 50% reserved only one
+	// non-priority message fits next to a priority message.
+	testCases := []testCase{
+		{
+			name:                   "priority sender is not queued behind other messages",
+			reqs:                   []cciptypes.EVM2EVMOnRampCCIPSendRequestedWithMeta{spamMsg1, spamMsg2, prioritySenderMsg},
+			inflight:               []InflightInternalExecutionReport{},
+			inflightAggregateValue: big.NewInt(0),
+			tokenLimit:             big.NewInt(0),
+			destGasPrice:           big.NewInt(10),
+			srcPrices:              map[cciptypes.Address]*big.Int{srcNative: big.NewInt(1)},
+			dstPrices:              map[cciptypes.Address]*big.Int{destNative: big.NewInt(1)},
+			offRampNoncesBySender:  map[cciptypes.Address]uint64{spammer: 0, protocolSender: 0},
+			expectedSeqNrs:         []ccip.ObservedMessage{{SeqNr: 1}, {SeqNr: 3}},
+			expectedStates: []messageExecStatus{
+				newMessageExecState(spamMsg1.SequenceNumber, spamMsg1.MessageID, AddedToBatch),
+				newMessageExecState(spamMsg2.SequenceNumber, spamMsg2.MessageID, InsufficientRemainingBatchGas),
+				newMessageExecState(prioritySenderMsg.SequenceNumber, prioritySenderMsg.MessageID, AddedToBatch),
+			},
+		},
+		{
+			name:                   "priority receiver is not queued behind other messages",
+			reqs:                   []cciptypes.EVM2EVMOnRampCCIPSendRequestedWithMeta{spamMsg1, spamMsg2, priorityReceiverMsg},
+			inflight:               []InflightInternalExecutionReport{},
+			inflightAggregateValue: big.NewInt(0),
+			tokenLimit:             big.NewInt(0),
+			destGasPrice:           big.NewInt(10),
+			srcPrices:              map[cciptypes.Address]*big.Int{srcNative: big.NewInt(1)},
+			dstPrices:              map[cciptypes.Address]*big.Int{destNative: big.NewInt(1)},
+			offRampNoncesBySender:  map[cciptypes.Address]uint64{spammer: 0},
+			expectedSeqNrs:         []ccip.ObservedMessage{{SeqNr: 1}, {SeqNr: 3}},
+			expectedStates: []messageExecStatus{
+				newMessageExecState(spamMsg1.SequenceNumber, spamMsg1.MessageID, AddedToBatch),
+				newMessageExecState(spamMsg2.SequenceNumber, spamMsg2.MessageID, InsufficientRemainingBatchGas),
+				newMessageExecState(priorityReceiverMsg.SequenceNumber, priorityReceiverMsg.MessageID, AddedToBatch),
+			},
+		},
+		{
+			name:                   "unused reservation is not given to other messages",
+			reqs:                   []cciptypes.EVM2EVMOnRampCCIPSendRequestedWithMeta{spamMsg1, spamMsg2},
+			inflight:               []InflightInternalExecutionReport{},
+			inflightAggregateValue: big.NewInt(0),
+			tokenLimit:             big.NewInt(0),
+			destGasPrice:           big.NewInt(10),
+			srcPrices:              map[cciptypes.Address]*big.Int{srcNative: big.NewInt(1)},
+			dstPrices:              map[cciptypes.Address]*big.Int{destNative: big.NewInt(1)},
+			offRampNoncesBySender:  map[cciptypes.Address]uint64{spammer: 0},
+			expectedSeqNrs:         []ccip.ObservedMessage{{SeqNr: 1}},
+			expectedStates: []messageExecStatus{
+				newMessageExecState(spamMsg1.SequenceNumber, spamMsg1.MessageID, AddedToBatch),
+				newMessageExecState(spamMsg2.SequenceNumber, spamMsg2.MessageID, InsufficientRemainingBatchGas),
+			},
+		},
+	}
+
+	strategy := &PriorityLanesBatchingStrategy{
+		senders:            mapset.NewSet(protocolSender),
+		receivers:          mapset.NewSet(vault),
+		reservedGasPercent: 50,
+	}
+	runBatchingStrategyTests(t, strategy, 300_000, testCases)
+
+	spamTokenMsg := createTestMessage(4, spammer, 0, srcNative, big.NewInt(1e9), false, nil)
+	spamTokenMsg.TokenAmounts = []cciptypes.TokenAmount{{Token: srcNative, Amount: big.NewInt(100)}}
+	priorityTokenMsg := createTestMessage(5, protocolSender, 0, srcNative, big.NewInt(1e9), false, nil)
+	priorityTokenMsg.TokenAmounts = []cciptypes.TokenAmount{{Token: srcNative, Amount: big.NewInt(100)}}
+
+	// Every token transfer is worth 100, with a limit of 150 and 50% reserved only priority messages can transfer it.
+	// Token transfers take more gas, so the batch gas is raised to fit one of them.
+	tokenValueTestCases := []testCase{
+		{
+			name:                   "other messages can't use the reserved token value",
+			reqs:                   []cciptypes.EVM2EVMOnRampCCIPSendRequestedWithMeta{spamTokenMsg},
+			inflight:               []InflightInternalExecutionReport{},
+			inflightAggregateValue: big.NewInt(0),
+			tokenLimit:             big.NewInt(150),
+			destGasPrice:           big.NewInt(1),
+			srcPrices:              map[cciptypes.Address]*big.Int{srcNative: big.NewInt(1e18)},
+			dstPrices:              map[cciptypes.Address]*big.Int{destNative: big.NewInt(1e18)},
+			srcToDestTokens:        map[cciptypes.Address]cciptypes.Address{srcNative: destNative},
+			offRampNoncesBySender:  map[cciptypes.Address]uint64{spammer: 0},
+			expectedStates:         []messageExecStatus{newMessageExecState(spamTokenMsg.SequenceNumber, spamTokenMsg.MessageID, AggregateTokenLimitExceeded)},
+			skipGasPriceEstimator:  true,
+		},
+		{
+			name:                   "priority messages can use the reserved token value",
+			reqs:                   []cciptypes.EVM2EVMOnRampCCIPSendRequestedWithMeta{spamTokenMsg, priorityTokenMsg},
+			inflight:               []InflightInternalExecutionReport{},
+			inflightAggregateValue: big.NewInt(0),
+			tokenLimit:             big.NewInt(150),
+			destGasPrice:           big.NewInt(1),
+			srcPrices:              map[cciptypes.Address]*big.Int{srcNative: big.NewInt(1e18)},
+			dstPrices:              map[cciptypes.Address]*big.Int{destNative: big.NewInt(1e18)},
+			srcToDestTokens:        map[cciptypes.Address]cciptypes.Address{srcNative: destNative},
+			offRampNoncesBySender:  map[cciptypes.Address]uint64{spammer: 0, protocolSender: 0},
+			expectedSeqNrs:         []ccip.ObservedMessage{{SeqNr: 5}},
+			expectedStates: []messageExecStatus{
+				newMessageExecState(spamTokenMsg.SequenceNumber, spamTokenMsg.MessageID, AggregateTokenLimitExceeded),
+				newMessageExecState(priorityTokenMsg.SequenceNumber, priorityTokenMsg.MessageID, AddedToBatch),
+			},
+			tokenDataWorker: delayedTokenDataWorker{},
+		},
+	}
+
+	tokenValueStrategy := &PriorityLanesBatchingStrategy{
+		senders:                   mapset.NewSet(protocolSender),
+		receivers:                 mapset.NewSet(vault),
+		reservedTokenValuePercent: 50,
+	}
+	runBatchingStrategyTests(t, tokenValueStrategy, 1_000_000, tokenValueTestCases)
+
+	t.Run("reservation is given back when batching fails", func(t *testing.T) {
+		gasPriceEstimator := prices.NewMockGasPriceEstimatorExec(t)
+		gasPriceEstimator.On("EstimateMsgCostUSD", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(big.NewInt(0), errors.New("error"))
+		batchContext := &BatchContext{
+			report:                     commitReportWithSendRequests{sendRequestsWithMeta: []cciptypes.EVM2EVMOnRampCCIPSendRequestedWithMeta{spamMsg1}},
+			inflightAggregateValue:     big.NewInt(0),
+			lggr:                       logger.TestLogger(t),
+			availableDataLen:           MaxDataLenPerBatch,
+			availableGas:               300_000,
+			expectedNonces:             make(map[cciptypes.Address]uint64),
+			sendersNonce:               map[cciptypes.Address]uint64{spammer: 0},
+			sourceTokenPricesUSD:       map[cciptypes.Address]*big.Int{srcNative: big.NewInt(1)},
+			destTokenPricesUSD:         map[cciptypes.Address]*big.Int{destNative: big.NewInt(1)},
+			gasPrice:                   big.NewInt(10),
+			aggregateTokenLimit:        big.NewInt(150),
+			tokenDataRemainingDuration: 5 * time.Second,
+			tokenDataWorker:            tokendata.NewBackgroundWorker(map[cciptypes.Address]tokendata.Reader{}, 10, 5*time.Second, time.Hour),
+			gasPriceEstimator:          gasPriceEstimator,
+			destWrappedNative:          destNative,
+			offchainConfig: cciptypes.ExecOffchainConfig{
+				DestOptimisticConfirmations: 1,
+				BatchGasLimit:               300_000,
+				RelativeBoostPerWaitHour:    1,
+			},
+		}
+		strategy := &PriorityLanesBatchingStrategy{
+			senders:                   mapset.NewSet(protocolSender),
+			receivers:                 mapset.NewSet(vault),
+			reservedGasPercent:        50,
+			reservedTokenValuePercent: 50,
+		}
+
+		seqNrs, execStates := strategy.BuildBatch(context.Background(), batchContext)
+		assert.Empty(t, seqNrs)
+		assert.Empty(t, execStates)
+		assert.Equal(t, uint64(300_000), batchContext.availableGas)
+		assert.Equal(t, big.NewInt(150), batchContext.aggregateTokenLimit)
+	})
 }
 
 // Function to set up and run tests for a given batching strategy
@@ -823,6 +1016,11 @@ func runBatchingStrategyTests(t *testing.T, strategy BatchingStrategy, available
 				tc.statuschecker(strategy.(*ZKOverflowBatchingStrategy).statuschecker.(*mockstatuschecker.CCIPTransactionStatusChecker))
 			}
 
+			tokenDataWorker := tc.tokenDataWorker
+			if tokenDataWorker == nil {
+				tokenDataWorker = tokendata.NewBackgroundWorker(map[cciptypes.Address]tokendata.Reader{}, 10, 5*time.Second, time.Hour)
+			}
+
 			batchContext := &BatchContext{
 				report:                     commitReportWithSendRequests{sendRequestsWithMeta: tc.reqs},
 				inflight:                   tc.inflight,
@@ -838,7 +1036,7 @@ func runBatchingStrategyTests(t *testing.T, strategy BatchingStrategy, available
 				sourceToDestToken:          tc.srcToDestTokens,
 				aggregateTokenLimit:        tc.tokenLimit,
 				tokenDataRemainingDuration: 5 * time.Second,
-				tokenDataWorker:            tokendata.NewBackgroundWorker(map[cciptypes.Address]tokendata.Reader{}, 10, 5*time.Second, time.Hour),
+				tokenDataWorker:            tokenDataWorker,
 				gasPriceEstimator:          gasPriceEstimator,
 				destWrappedNative:          destNative,
 				offchainConfig: cciptypes.ExecOffchainConfig{
@@ -870,9 +1068,12 @@ func runAssertions(t *testing.T, tc testCase, seqNrs []ccip.ObservedMessage, exe
 	}
 
 	batchingStratID := strategy.GetBatchingStrategyID()
-	if strategyType := reflect.TypeOf(strategy); strategyType == reflect.TypeOf(&BestEffortBatchingStrategy{}) {
+	switch strategy.(type) {
+	case *BestEffortBatchingStrategy:
 		assert.Equal(t, batchingStratID, uint32(0))
-	} else {
+	case *PriorityLanesBatchingStrategy:
+		assert.Equal(t, batchingStratID, uint32(2))
+	default:
 		assert.Equal(t, batchingStratID, uint32(1))
 	}
 }
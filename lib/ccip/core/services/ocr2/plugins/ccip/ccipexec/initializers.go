@@ -20,6 +20,7 @@ import (
 
 	"github.com/smartcontractkit/chainlink/v2/core/services/relay/evm/statuschecker"
 
+	txmgrtypes "github.com/smartcontractkit/chainlink/v2/common/txmgr/types"
 	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/txmgr"
 	"github.com/smartcontractkit/chainlink/v2/core/logger"
 	"github.com/smartcontractkit/chainlink/v2/core/services/job"
@@ -189,6 +190,7 @@ func NewExecServices(ctx context.Context, lggr logger.Logger, jb job.Job, srcPro
 		chainHealthcheck:              chainHealthcheck,
 		newReportingPluginRetryConfig: defaultNewReportingPluginRetryConfig,
 		txmStatusChecker:              statuschecker.NewTxmStatusChecker(dstProvider.GetTransactionStatus),
+		priorityLanesConfig:           pluginConfig.PriorityLanesConfig,
 	})
 
 	argsNoPlugin.ReportingPluginFactory = promwrapper.NewPromFactory(wrappedPluginFactory, "CCIPExecution", jb.OCR2OracleSpec.Relay, big.NewInt(0).SetInt64(dstChainID))
@@ -243,6 +245,11 @@ func UnregisterExecPluginLpFilters(srcProvider types.CCIPExecProvider, dstProvid
 	return multiErr
 }
 
+// ExecTransmitCheckerType returns the transmit checker of the execution reports of the offramp version.
+func ExecTransmitCheckerType(typ ccipconfig.ContractType, ver semver.Version) (txmgrtypes.TransmitCheckerType, error) {
+	return factory.ExecTransmitCheckerType(typ, ver)
+}
+
 // ExecReportToEthTxMeta generates a txmgr.EthTxMeta from the given report.
 // Only MessageIDs will be populated in the TxMeta.
 func ExecReportToEthTxMeta(ctx context.Context, typ ccipconfig.ContractType, ver semver.Version) (func(report []byte) (*txmgr.TxMeta, error), error) {
@@ -19,6 +19,7 @@ import (
 	cciptypes "github.com/smartcontractkit/chainlink-common/pkg/types/ccip"
 	"github.com/smartcontractkit/chainlink/v2/core/logger"
 	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ccip"
+	ccipconfig "github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ccip/config"
 	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ccip/internal/cache"
 	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ccip/internal/ccipcommon"
 	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ccip/internal/ccipdata"
@@ -64,6 +65,7 @@ type ExecutionPluginStaticConfig struct {
 	chainHealthcheck              cache.ChainHealthcheck
 	newReportingPluginRetryConfig ccipdata.RetryConfig
 	txmStatusChecker              statuschecker.CCIPTransactionStatusChecker
+	priorityLanesConfig           ccipconfig.PriorityLanesConfig
 }
 
 type ExecutionReportingPlugin struct {
@@ -92,9 +94,10 @@ type ExecutionReportingPlugin struct {
 	tokenPoolBatchedReader batchreader.TokenPoolBatchedReader
 
 	// State
-	inflightReports  *inflightExecReportsContainer
-	commitRootsCache cache.CommitsRootsCache
-	chainHealthcheck cache.ChainHealthcheck
+	inflightReports    *inflightExecReportsContainer
+	rateLimitDeferrals *rateLimitDeferralsContainer
+	commitRootsCache   cache.CommitsRootsCache
+	chainHealthcheck   cache.ChainHealthcheck
 }
 
 func (r *ExecutionReportingPlugin) Query(context.Context, types.ReportTimestamp) (types.Query, error) {
@@ -117,6 +120,8 @@ func (r *ExecutionReportingPlugin) Observation(ctx context.Context, timestamp ty
 	// Expire any inflight reports.
 	r.inflightReports.expire(lggr)
 	inFlight := r.inflightReports.getAll()
+	// Messages deferred until the token pools refill are considered again once their deferral expires.
+	r.rateLimitDeferrals.expire(time.Now())
 
 	executableObservations, err := r.getExecutableObservations(ctx, lggr, inFlight)
 	if err != nil {
@@ -137,6 +142,9 @@ func (r *ExecutionReportingPlugin) Observation(ctx context.Context, timestamp ty
 	}
 	executableObservations = executableObservations[:capped]
 	r.metricsCollector.NumberOfMessagesProcessed(ccip.Observation, len(executableObservations))
+	for token, amount := range r.rateLimitDeferrals.deferredVolume() {
+		r.metricsCollector.RateLimitDeferredTokenAmount(string(token), amount)
+	}
 	lggr.Infow("Observation", "executableMessages", executableObservations)
 	// Note can be empty
 	return ccip.NewExecutionObservation(executableObservations).Marshal()
@@ -219,7 +227,8 @@ func (r *ExecutionReportingPlugin) getExecutableObservations(ctx context.Context
 				tokenExecData.sourceTokenPrices,
 				tokenExecData.destTokenPrices,
 				tokenExecData.gasPrice,
-				tokenExecData.sourceToDestTokens)
+				tokenExecData.sourceToDestTokens,
+				tokenExecData.destPoolRateLimits)
 			if len(batch) != 0 {
 				lggr.Infow("Execution batch created", "batchSize", len(batch), "messageStates", msgExecStates)
 				return batch, nil
@@ -263,6 +272,7 @@ func (r *ExecutionReportingPlugin) buildBatch(
 	destTokenPricesUSD map[cciptypes.Address]*big.Int,
 	gasPrice *big.Int,
 	sourceToDestToken map[cciptypes.Address]cciptypes.Address,
+	destPoolRateLimits map[cciptypes.Address]cciptypes.TokenBucketRateLimit,
 ) ([]ccip.ObservedMessage, []messageExecStatus) {
 	// We assume that next observation will start after previous epoch transmission so nonces should be already updated onchain.
 	// Worst case scenario we will try to process the same message again, and it will be skipped but protocol would progress anyway.
@@ -300,6 +310,8 @@ func (r *ExecutionReportingPlugin) buildBatch(
 		r.gasPriceEstimator,
 		r.destWrappedNative,
 		r.offchainConfig,
+		newTokenPoolRateLimits(destPoolRateLimits, inflight, sourceToDestToken),
+		r.rateLimitDeferrals,
 	}
 
 	return r.batchingStrategy.BuildBatch(ctx, batchCtx)
@@ -725,6 +737,8 @@ type execTokenData struct {
 	destTokenPrices        map[cciptypes.Address]*big.Int
 	sourceToDestTokens     map[cciptypes.Address]cciptypes.Address
 	gasPrice               *big.Int
+	// destPoolRateLimits holds the inbound rate limits of the destination token pools, keyed by destination token.
+	destPoolRateLimits map[cciptypes.Address]cciptypes.TokenBucketRateLimit
 }
 
 // prepareTokenExecData gather all the pre-execution data needed for token execution into a single lazy call.
@@ -784,12 +798,23 @@ func (r *ExecutionReportingPlugin) prepareTokenExecData(ctx context.Context) (ex
 		return execTokenData{}, err
 	}
 
+	// Pool rate limits are only used to avoid building batches that would revert onchain,
+	// the batch is still built with the aggregate rate limit if they can't be fetched.
+	var destPoolRateLimits map[cciptypes.Address]cciptypes.TokenBucketRateLimit
+	if poolReader, ok := r.tokenPoolBatchedReader.(batchreader.DestTokenPoolRateLimitReader); ok {
+		destPoolRateLimits, err = poolReader.GetInboundTokenPoolRateLimitsByDestToken(ctx, destBridgedTokens)
+		if err != nil {
+			r.lggr.Warnw("Fetching destination token pool rate limits", "err", err)
+		}
+	}
+
 	return execTokenData{
 		rateLimiterTokenBucket: rateLimiterTokenBucket,
 		sourceTokenPrices:      sourceTokensPrices,
 		sourceToDestTokens:     sourceToDestTokens,
 		destTokenPrices:        destTokenPrices,
 		gasPrice:               gasPrice,
+		destPoolRateLimits:     destPoolRateLimits,
 	}, nil
 }
 
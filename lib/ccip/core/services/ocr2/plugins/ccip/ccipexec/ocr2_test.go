@@ -30,6 +30,7 @@ import (
 	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
 	"github.com/smartcontractkit/chainlink/v2/core/logger"
 	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ccip"
+	ccipconfig "github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ccip/config"
 	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ccip/internal/cache"
 	ccipcachemocks "github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ccip/internal/cache/mocks"
 	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ccip/internal/ccipcalc"
@@ -141,6 +142,7 @@ func TestExecutionReportingPlugin_Observation(t *testing.T) {
 			p.tokenDataWorker = tokendata.NewBackgroundWorker(
 				make(map[cciptypes.Address]tokendata.Reader), 10, 5*time.Second, time.Hour)
 			p.metricsCollector = ccip.NoopMetricsCollector
+			p.rateLimitDeferrals = newRateLimitDeferralsContainer()
 
 			commitStoreReader := ccipdatamocks.NewCommitStoreReader(t)
 			commitStoreReader.On("IsDown", mock.Anything).Return(tc.commitStorePaused, nil).Maybe()
@@ -286,7 +288,7 @@ func TestExecutionReportingPlugin_Report(t *testing.T) {
 			p := ExecutionReportingPlugin{}
 			p.lggr = logger.TestLogger(t)
 			p.F = tc.f
-			bs, err := NewBatchingStrategy(tc.batchingStrategyId, &statuschecker.TxmStatusChecker{})
+			bs, err := NewBatchingStrategy(tc.batchingStrategyId, &statuschecker.TxmStatusChecker{}, ccipconfig.PriorityLanesConfig{})
 			assert.NoError(t, err)
 			p.batchingStrategy = bs
 
@@ -1469,7 +1471,7 @@ func TestExecutionReportingPlugin_getConsensusThreshold(t *testing.T) {
 		t.Run(tc.name, func(t *testing.T) {
 			p := &ExecutionReportingPlugin{}
 			p.F = tc.F
-			bs, err := NewBatchingStrategy(tc.batchingStrategyID, &statuschecker.TxmStatusChecker{})
+			bs, err := NewBatchingStrategy(tc.batchingStrategyID, &statuschecker.TxmStatusChecker{}, ccipconfig.PriorityLanesConfig{})
 			assert.NoError(t, err)
 			p.batchingStrategy = bs
 
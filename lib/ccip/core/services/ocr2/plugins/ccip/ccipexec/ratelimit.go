@@ -0,0 +1,173 @@
+package ccipexec
+
+import (
+	"math/big"
+	"sync"
+	"time"
+
+	cciptypes "github.com/smartcontractkit/chainlink-common/pkg/types/ccip"
+)
+
+// tokenPoolBucket is the remaining inbound capacity of a destination token pool while a batch is being built.
+type tokenPoolBucket struct {
+	available *big.Int
+	capacity  *big.Int
+	rate      *big.Int
+}
+
+// tokenPoolRateLimits tracks the inbound rate limiters of the destination token pools, keyed by destination token.
+// Tokens without an enabled rate limiter are not tracked.
+type tokenPoolRateLimits map[cciptypes.Address]*tokenPoolBucket
+
+// newTokenPoolRateLimits builds the pool buckets from the onchain state, the token amounts of the inflight messages
+// are subtracted since they are not reflected onchain yet.
+func newTokenPoolRateLimits(
+	rateLimits map[cciptypes.Address]cciptypes.TokenBucketRateLimit,
+	inflight []InflightInternalExecutionReport,
+	sourceToDest map[cciptypes.Address]cciptypes.Address,
+) tokenPoolRateLimits {
+	buckets := make(tokenPoolRateLimits, len(rateLimits))
+	for token, rateLimit := range rateLimits {
+		if !rateLimit.IsEnabled || rateLimit.Tokens == nil || rateLimit.Capacity == nil || rateLimit.Rate == nil {
+			continue
+		}
+		buckets[token] = &tokenPoolBucket{
+			available: new(big.Int).Set(rateLimit.Tokens),
+			capacity:  new(big.Int).Set(rateLimit.Capacity),
+			rate:      new(big.Int).Set(rateLimit.Rate),
+		}
+	}
+
+	for _, rep := range inflight {
+		for _, message := range rep.messages {
+			buckets.consume(message.TokenAmounts, sourceToDest)
+		}
+	}
+	return buckets
+}
+
+// destTokenAmounts sums the message token amounts per destination token.
+func destTokenAmounts(tokenAmounts []cciptypes.TokenAmount, sourceToDest map[cciptypes.Address]cciptypes.Address) map[cciptypes.Address]*big.Int {
+	amounts := make(map[cciptypes.Address]*big.Int, len(tokenAmounts))
+	for _, tokenAmount := range tokenAmounts {
+		destToken, ok := sourceToDest[tokenAmount.Token]
+		if !ok || tokenAmount.Amount == nil {
+			continue
+		}
+		if _, exists := amounts[destToken]; !exists {
+			amounts[destToken] = big.NewInt(0)
+		}
+		amounts[destToken].Add(amounts[destToken], tokenAmount.Amount)
+	}
+	return amounts
+}
+
+// refillDelay returns how long the message has to wait for the pools to refill before it can be executed.
+// A zero delay means that the message fits the current capacity. When a pool capacity is lower than the message amount,
+// or the pool doesn't refill, the message can't be executed until the pool config changes and canRefill is false.
+func (t tokenPoolRateLimits) refillDelay(amounts map[cciptypes.Address]*big.Int) (delay time.Duration, canRefill bool) {
+	canRefill = true
+	for token, amount := range amounts {
+		bucket, ok := t[token]
+		if !ok || amount.Cmp(bucket.available) <= 0 {
+			continue
+		}
+		if amount.Cmp(bucket.capacity) > 0 || bucket.rate.Sign() <= 0 {
+			canRefill = false
+			continue
+		}
+		// ceil((amount - available) / rate) seconds
+		missing := new(big.Int).Sub(amount, bucket.available)
+		seconds, remainder := new(big.Int).QuoRem(missing, bucket.rate, new(big.Int))
+		if remainder.Sign() > 0 {
+			seconds.Add(seconds, big.NewInt(1))
+		}
+		if tokenDelay := time.Duration(seconds.Int64()) * time.Second; tokenDelay > delay {
+			delay = tokenDelay
+		}
+	}
+	if !canRefill {
+		return 0, false
+	}
+	return delay, true
+}
+
+func (t tokenPoolRateLimits) consume(tokenAmounts []cciptypes.TokenAmount, sourceToDest map[cciptypes.Address]cciptypes.Address) {
+	for token, amount := range destTokenAmounts(tokenAmounts, sourceToDest) {
+		if bucket, ok := t[token]; ok {
+			bucket.available.Sub(bucket.available, amount)
+		}
+	}
+}
+
+type rateLimitDeferral struct {
+	until   time.Time
+	amounts map[cciptypes.Address]*big.Int
+}
+
+// rateLimitDeferralsContainer holds the messages deferred because of the destination token pool rate limits,
+// they are not considered for execution until the predicted refill time.
+// It provides a thread-safe access as it is called from multiple goroutines.
+type rateLimitDeferralsContainer struct {
+	locker    sync.RWMutex
+	deferrals map[uint64]rateLimitDeferral
+	// tokens keeps every token that had deferred volume, so that the volume can be reported as zero once the deferrals expire.
+	tokens map[cciptypes.Address]struct{}
+}
+
+func newRateLimitDeferralsContainer() *rateLimitDeferralsContainer {
+	return &rateLimitDeferralsContainer{
+		locker:    sync.RWMutex{},
+		deferrals: make(map[uint64]rateLimitDeferral),
+		tokens:    make(map[cciptypes.Address]struct{}),
+	}
+}
+
+func (container *rateLimitDeferralsContainer) isDeferred(seqNr uint64, now time.Time) (time.Time, bool) {
+	container.locker.RLock()
+	defer container.locker.RUnlock()
+
+	deferral, ok := container.deferrals[seqNr]
+	if !ok || !now.Before(deferral.until) {
+		return time.Time{}, false
+	}
+	return deferral.until, true
+}
+
+func (container *rateLimitDeferralsContainer) add(seqNr uint64, until time.Time, amounts map[cciptypes.Address]*big.Int) {
+	container.locker.Lock()
+	defer container.locker.Unlock()
+
+	container.deferrals[seqNr] = rateLimitDeferral{until: until, amounts: amounts}
+	for token := range amounts {
+		container.tokens[token] = struct{}{}
+	}
+}
+
+func (container *rateLimitDeferralsContainer) expire(now time.Time) {
+	container.locker.Lock()
+	defer container.locker.Unlock()
+
+	for seqNr, deferral := range container.deferrals {
+		if !now.Before(deferral.until) {
+			delete(container.deferrals, seqNr)
+		}
+	}
+}
+
+// deferredVolume returns the total deferred amount per destination token.
+func (container *rateLimitDeferralsContainer) deferredVolume() map[cciptypes.Address]*big.Int {
+	container.locker.RLock()
+	defer container.locker.RUnlock()
+
+	volume := make(map[cciptypes.Address]*big.Int, len(container.tokens))
+	for token := range container.tokens {
+		volume[token] = big.NewInt(0)
+	}
+	for _, deferral := range container.deferrals {
+		for token, amount := range deferral.amounts {
+			volume[token].Add(volume[token], amount)
+		}
+	}
+	return volume
+}
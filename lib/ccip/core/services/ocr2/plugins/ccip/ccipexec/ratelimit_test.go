@@ -0,0 +1,142 @@
+package ccipexec
+
+import (
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	cciptypes "github.com/smartcontractkit/chainlink-common/pkg/types/ccip"
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+)
+
+func TestTokenPoolRateLimits_refillDelay(t *testing.T) {
+	srcToken := cciptypes.Address("0xsrc")
+	destToken := cciptypes.Address("0xdest")
+	sourceToDest := map[cciptypes.Address]cciptypes.Address{srcToken: destToken}
+
+	rateLimits := map[cciptypes.Address]cciptypes.TokenBucketRateLimit{
+		destToken: {Tokens: big.NewInt(100), Capacity: big.NewInt(1000), Rate: big.NewInt(30), IsEnabled: true},
+	}
+	inflight := []InflightInternalExecutionReport{{
+		messages: []cciptypes.EVM2EVMMessage{
+			{SequenceNumber: 1, TokenAmounts: []cciptypes.TokenAmount{{Token: srcToken, Amount: big.NewInt(40)}}},
+		},
+	}}
+
+	tests := []struct {
+		name          string
+		amount        int64
+		expectedDelay time.Duration
+		canRefill     bool
+	}{
+		{name: "fits the remaining capacity", amount: 60, canRefill: true},
+		{name: "waits for a partial refill", amount: 61, expectedDelay: time.Second, canRefill: true},
+		{name: "waits for multiple refills", amount: 150, expectedDelay: 3 * time.Second, canRefill: true},
+		{name: "exceeds the capacity", amount: 1001, canRefill: false},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			buckets := newTokenPoolRateLimits(rateLimits, inflight, sourceToDest)
+			amounts := destTokenAmounts([]cciptypes.TokenAmount{{Token: srcToken, Amount: big.NewInt(tc.amount)}}, sourceToDest)
+
+			delay, canRefill := buckets.refillDelay(amounts)
+			assert.Equal(t, tc.canRefill, canRefill)
+			assert.Equal(t, tc.expectedDelay, delay)
+		})
+	}
+
+	t.Run("disabled rate limits are not tracked", func(t *testing.T) {
+		buckets := newTokenPoolRateLimits(map[cciptypes.Address]cciptypes.TokenBucketRateLimit{
+			destToken: {Tokens: big.NewInt(0), Capacity: big.NewInt(0), Rate: big.NewInt(0), IsEnabled: false},
+		}, nil, sourceToDest)
+		assert.Empty(t, buckets)
+	})
+
+	t.Run("consumed amounts are subtracted", func(t *testing.T) {
+		buckets := newTokenPoolRateLimits(rateLimits, inflight, sourceToDest)
+		buckets.consume([]cciptypes.TokenAmount{{Token: srcToken, Amount: big.NewInt(50)}}, sourceToDest)
+		assert.Equal(t, big.NewInt(10), buckets[destToken].available)
+		// rateLimits must not be modified
+		assert.Equal(t, big.NewInt(100), rateLimits[destToken].Tokens)
+	})
+}
+
+func TestRateLimitDeferralsContainer(t *testing.T) {
+	token1 := cciptypes.Address("0x1")
+	token2 := cciptypes.Address("0x2")
+	now := time.Now()
+
+	container := newRateLimitDeferralsContainer()
+	container.add(1, now.Add(time.Minute), map[cciptypes.Address]*big.Int{token1: big.NewInt(10)})
+	container.add(2, now.Add(time.Second), map[cciptypes.Address]*big.Int{token1: big.NewInt(5), token2: big.NewInt(7)})
+
+	until, deferred := container.isDeferred(1, now)
+	require.True(t, deferred)
+	assert.Equal(t, now.Add(time.Minute), until)
+	_, deferred = container.isDeferred(3, now)
+	assert.False(t, deferred)
+	_, deferred = container.isDeferred(2, now.Add(time.Second))
+	assert.False(t, deferred)
+
+	assert.Equal(t, map[cciptypes.Address]*big.Int{token1: big.NewInt(15), token2: big.NewInt(7)}, container.deferredVolume())
+
+	container.expire(now.Add(2 * time.Second))
+	assert.Equal(t, map[cciptypes.Address]*big.Int{token1: big.NewInt(10), token2: big.NewInt(0)}, container.deferredVolume())
+}
+
+func Test_checkTokenPoolRateLimits(t *testing.T) {
+	srcToken := cciptypes.Address("0xsrc")
+	destToken := cciptypes.Address("0xdest")
+	sourceToDest := map[cciptypes.Address]cciptypes.Address{srcToken: destToken}
+	rateLimits := map[cciptypes.Address]cciptypes.TokenBucketRateLimit{
+		destToken: {Tokens: big.NewInt(100), Capacity: big.NewInt(1000), Rate: big.NewInt(10), IsEnabled: true},
+	}
+
+	newMsg := func(seqNr uint64, amount int64) cciptypes.EVM2EVMOnRampCCIPSendRequestedWithMeta {
+		msg := cciptypes.EVM2EVMOnRampCCIPSendRequestedWithMeta{}
+		msg.SequenceNumber = seqNr
+		msg.TokenAmounts = []cciptypes.TokenAmount{{Token: srcToken, Amount: big.NewInt(amount)}}
+		return msg
+	}
+
+	tests := []struct {
+		name           string
+		amount         int64
+		withDeferrals  bool
+		expectedStatus messageStatus
+		expectDeferral bool
+	}{
+		{name: "within limits", amount: 100, withDeferrals: true, expectedStatus: SuccesfullyValidated},
+		{name: "deferred until refill", amount: 200, withDeferrals: true, expectedStatus: TokenPoolRateLimitDeferred, expectDeferral: true},
+		{name: "exceeded without deferrals", amount: 200, expectedStatus: TokenPoolRateLimitExceeded},
+		{name: "exceeds the pool capacity", amount: 2000, withDeferrals: true, expectedStatus: TokenPoolRateLimitExceeded},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			batchCtx := &BatchContext{
+				sourceToDestToken:   sourceToDest,
+				tokenPoolRateLimits: newTokenPoolRateLimits(rateLimits, nil, sourceToDest),
+			}
+			if tc.withDeferrals {
+				batchCtx.rateLimitDeferrals = newRateLimitDeferralsContainer()
+			}
+
+			status, ok := checkTokenPoolRateLimits(batchCtx, newMsg(1, tc.amount), logger.TestLogger(t))
+			assert.Equal(t, tc.expectedStatus, status)
+			assert.Equal(t, tc.expectedStatus == SuccesfullyValidated, ok)
+
+			if tc.withDeferrals {
+				until, deferred := batchCtx.rateLimitDeferrals.isDeferred(1, time.Now())
+				assert.Equal(t, tc.expectDeferral, deferred)
+				if deferred {
+					assert.WithinDuration(t, time.Now().Add(10*time.Second), until, time.Second)
+				}
+			}
+		})
+	}
+}
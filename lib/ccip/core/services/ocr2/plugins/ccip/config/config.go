@@ -109,6 +109,7 @@ type ExecPluginJobSpecConfig struct {
 	SourceStartBlock, DestStartBlock uint64 // Only for first time job add.
 	USDCConfig                       USDCConfig
 	LBTCConfig                       LBTCConfig
+	PriorityLanesConfig              PriorityLanesConfig
 }
 
 type USDCConfig struct {
@@ -128,6 +129,19 @@ type LBTCConfig struct {
 	AttestationAPIIntervalMilliseconds int
 }
 
+// PriorityLanesConfig configures the priority lanes batching strategy. Messages sent by one of the Senders or to
+// one of the Receivers are batched first and have a share of the batch gas and aggregate token value budget reserved for them.
+// It is only used when the OffRamp offchain config selects the priority lanes batching strategy, and it should be the same
+// across all the nodes of the DON, otherwise the nodes will not reach consensus on the prioritized messages.
+type PriorityLanesConfig struct {
+	Senders   []common.Address
+	Receivers []common.Address
+	// ReservedGasPercent is the share of the batch gas limit that is held back for priority messages.
+	ReservedGasPercent uint8
+	// ReservedTokenValuePercent is the share of the aggregate rate limiter capacity that is held back for priority messages.
+	ReservedTokenValuePercent uint8
+}
+
 type ExecPluginConfig struct {
 	SourceStartBlock, DestStartBlock uint64 // Only for first time job add.
 	IsSourceProvider                 bool
@@ -173,3 +187,26 @@ func (lc *LBTCConfig) ValidateLBTCConfig() error {
 	}
 	return nil
 }
+
+func (pc *PriorityLanesConfig) ValidatePriorityLanesConfig() error {
+	if len(pc.Senders) == 0 && len(pc.Receivers) == 0 {
+		return errors.New("PriorityLanesConfig: at least one of Senders or Receivers is required")
+	}
+	if pc.ReservedGasPercent > 100 {
+		return errors.New("PriorityLanesConfig: ReservedGasPercent must be between 0 and 100")
+	}
+	if pc.ReservedTokenValuePercent > 100 {
+		return errors.New("PriorityLanesConfig: ReservedTokenValuePercent must be between 0 and 100")
+	}
+	for _, addr := range pc.Senders {
+		if addr == utils.ZeroAddress {
+			return errors.New("PriorityLanesConfig: Senders must not contain the zero address")
+		}
+	}
+	for _, addr := range pc.Receivers {
+		if addr == utils.ZeroAddress {
+			return errors.New("PriorityLanesConfig: Receivers must not contain the zero address")
+		}
+	}
+	return nil
+}
@@ -205,6 +205,66 @@ func TestUSDCValidate(t *testing.T) {
 	}
 }
 
+func TestPriorityLanesValidate(t *testing.T) {
+	testcases := []struct {
+		config PriorityLanesConfig
+		err    string
+	}{
+		{
+			config: PriorityLanesConfig{},
+			err:    "at least one of Senders or Receivers is required",
+		},
+		{
+			config: PriorityLanesConfig{
+				Senders:            []common.Address{common.HexToAddress("0x1")},
+				ReservedGasPercent: 101,
+			},
+			err: "ReservedGasPercent must be between 0 and 100",
+		},
+		{
+			config: PriorityLanesConfig{
+				Receivers:                 []common.Address{common.HexToAddress("0x1")},
+				ReservedTokenValuePercent: 101,
+			},
+			err: "ReservedTokenValuePercent must be between 0 and 100",
+		},
+		{
+			config: PriorityLanesConfig{
+				Senders: []common.Address{utils.ZeroAddress},
+			},
+			err: "Senders must not contain the zero address",
+		},
+		{
+			config: PriorityLanesConfig{
+				Senders:   []common.Address{common.HexToAddress("0x1")},
+				Receivers: []common.Address{utils.ZeroAddress},
+			},
+			err: "Receivers must not contain the zero address",
+		},
+		{
+			config: PriorityLanesConfig{
+				Senders:                   []common.Address{common.HexToAddress("0x1")},
+				Receivers:                 []common.Address{common.HexToAddress("0x2")},
+				ReservedGasPercent:        30,
+				ReservedTokenValuePercent: 100,
+			},
+		},
+	}
+
+	for _, tc := range testcases {
+		tc := tc
+		t.Run(fmt.Sprintf("error = %s", tc.err), func(t *testing.T) {
+			t.Parallel()
+			err := tc.config.ValidatePriorityLanesConfig()
+			if tc.err != "" {
+				require.ErrorContains(t, err, tc.err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
 func TestUnmarshallDynamicPriceConfig(t *testing.T) {
 	jsonCfg := `
 {
@@ -12,6 +12,9 @@ import (
 
 	cciptypes "github.com/smartcontractkit/chainlink-common/pkg/types/ccip"
 
+	"github.com/smartcontractkit/chainlink/v2/core/gethwrappers/ccip/generated/evm_2_evm_offramp"
+	"github.com/smartcontractkit/chainlink/v2/core/gethwrappers/ccip/generated/evm_2_evm_offramp_1_2_0"
+	"github.com/smartcontractkit/chainlink/v2/core/gethwrappers/ccip/generated/token_admin_registry"
 	type_and_version "github.com/smartcontractkit/chainlink/v2/core/gethwrappers/generated/type_and_version_interface_wrapper"
 	"github.com/smartcontractkit/chainlink/v2/core/logger"
 	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ccip/abihelpers"
@@ -24,7 +27,10 @@ import (
 )
 
 var (
-	typeAndVersionABI = abihelpers.MustParseABI(type_and_version.TypeAndVersionInterfaceABI)
+	typeAndVersionABI     = abihelpers.MustParseABI(type_and_version.TypeAndVersionInterfaceABI)
+	offRampABI            = abihelpers.MustParseABI(evm_2_evm_offramp.EVM2EVMOffRampABI)
+	offRampV1_2_0ABI      = abihelpers.MustParseABI(evm_2_evm_offramp_1_2_0.EVM2EVMOffRampABI)
+	tokenAdminRegistryABI = abihelpers.MustParseABI(token_admin_registry.TokenAdminRegistryABI)
 )
 
 type EVMTokenPoolBatchedReader struct {
@@ -35,13 +41,29 @@ type EVMTokenPoolBatchedReader struct {
 
 	tokenPoolReaders  map[cciptypes.Address]ccipdata.TokenPoolReader
 	tokenPoolReaderMu sync.RWMutex
+
+	// offRampVersion and tokenAdminRegistry are loaded on the first pool lookup by destination token.
+	offRampVersion     string
+	tokenAdminRegistry common.Address
+	offRampMu          sync.Mutex
 }
 
 type TokenPoolBatchedReader interface {
 	cciptypes.TokenPoolBatchedReader
 }
 
-var _ TokenPoolBatchedReader = (*EVMTokenPoolBatchedReader)(nil)
+// DestTokenPoolRateLimitReader reads the inbound rate limiter state of the destination token pools
+// without requiring the caller to know the pool addresses.
+type DestTokenPoolRateLimitReader interface {
+	// GetInboundTokenPoolRateLimitsByDestToken returns the inbound rate limiter state of the pools of the given destination tokens,
+	// keyed by destination token. Tokens without a pool are not part of the result.
+	GetInboundTokenPoolRateLimitsByDestToken(ctx context.Context, destTokens []cciptypes.Address) (map[cciptypes.Address]cciptypes.TokenBucketRateLimit, error)
+}
+
+var (
+	_ TokenPoolBatchedReader       = (*EVMTokenPoolBatchedReader)(nil)
+	_ DestTokenPoolRateLimitReader = (*EVMTokenPoolBatchedReader)(nil)
+)
 
 func NewEVMTokenPoolBatchedReader(lggr logger.Logger, remoteChainSelector uint64, offRampAddress cciptypes.Address, evmBatchCaller rpclib.EvmBatchCaller) (*EVMTokenPoolBatchedReader, error) {
 	offRampAddrEvm, err := ccipcalc.GenericAddrToEvm(offRampAddress)
@@ -105,6 +127,135 @@ func (br *EVMTokenPoolBatchedReader) GetInboundTokenPoolRateLimits(ctx context.C
 	return resultsParsed, nil
 }
 
+func (br *EVMTokenPoolBatchedReader) GetInboundTokenPoolRateLimitsByDestToken(ctx context.Context, destTokens []cciptypes.Address) (map[cciptypes.Address]cciptypes.TokenBucketRateLimit, error) {
+	rateLimits := make(map[cciptypes.Address]cciptypes.TokenBucketRateLimit, len(destTokens))
+	if len(destTokens) == 0 {
+		return rateLimits, nil
+	}
+
+	pools, err := br.getDestTokenPools(ctx, destTokens)
+	if err != nil {
+		return nil, fmt.Errorf("get destination token pools: %w", err)
+	}
+
+	tokensWithPool := make([]cciptypes.Address, 0, len(destTokens))
+	poolAddresses := make([]cciptypes.Address, 0, len(destTokens))
+	for i, pool := range pools {
+		if pool == (common.Address{}) {
+			continue
+		}
+		tokensWithPool = append(tokensWithPool, destTokens[i])
+		poolAddresses = append(poolAddresses, ccipcalc.EvmAddrToGeneric(pool))
+	}
+
+	poolRateLimits, err := br.GetInboundTokenPoolRateLimits(ctx, poolAddresses)
+	if err != nil {
+		return nil, err
+	}
+	if len(poolRateLimits) != len(tokensWithPool) {
+		return nil, fmt.Errorf("got %d rate limits for %d token pools", len(poolRateLimits), len(tokensWithPool))
+	}
+
+	for i, token := range tokensWithPool {
+		rateLimits[token] = poolRateLimits[i]
+	}
+	return rateLimits, nil
+}
+
+// getDestTokenPools returns the pool of each of the given destination tokens, the zero address is returned for tokens without a pool.
+// 1.5 offRamps resolve the pools through the TokenAdminRegistry, older offRamps keep the token to pool mapping themselves.
+func (br *EVMTokenPoolBatchedReader) getDestTokenPools(ctx context.Context, destTokens []cciptypes.Address) ([]common.Address, error) {
+	evmTokens, err := ccipcalc.GenericAddrsToEvm(destTokens...)
+	if err != nil {
+		return nil, err
+	}
+
+	tokenAdminRegistry, err := br.getTokenAdminRegistry(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if tokenAdminRegistry != (common.Address{}) {
+		results, err2 := br.evmBatchCaller.BatchCall(ctx, 0, []rpclib.EvmCall{
+			rpclib.NewEvmCall(tokenAdminRegistryABI, "getPools", tokenAdminRegistry, evmTokens),
+		})
+		if err2 != nil {
+			return nil, fmt.Errorf("batch call get pools: %w", err2)
+		}
+		if len(results) != 1 {
+			return nil, fmt.Errorf("expected 1 result, got %d", len(results))
+		}
+		pools, err2 := rpclib.ParseOutput[[]common.Address](results[0], 0)
+		if err2 != nil {
+			return nil, fmt.Errorf("parse get pools output: %w", err2)
+		}
+		if len(pools) != len(evmTokens) {
+			return nil, fmt.Errorf("got %d pools for %d tokens", len(pools), len(evmTokens))
+		}
+		return pools, nil
+	}
+
+	evmCalls := make([]rpclib.EvmCall, 0, len(evmTokens))
+	for _, token := range evmTokens {
+		evmCalls = append(evmCalls, rpclib.NewEvmCall(offRampV1_2_0ABI, "getPoolByDestToken", br.offRampAddress, token))
+	}
+	results, err := br.evmBatchCaller.BatchCall(ctx, 0, evmCalls)
+	if err != nil {
+		return nil, fmt.Errorf("batch call get pool by dest token: %w", err)
+	}
+
+	return rpclib.ParseOutputs[common.Address](results, func(d rpclib.DataAndErr) (common.Address, error) {
+		pool, err1 := rpclib.ParseOutput[common.Address](d, 0)
+		if err1 != nil {
+			// The offRamp reverts with UnsupportedToken for tokens it doesn't have a pool for.
+			if ccipcommon.IsTxRevertError(err1) {
+				return common.Address{}, nil
+			}
+			return common.Address{}, err1
+		}
+		return pool, nil
+	})
+}
+
+// getTokenAdminRegistry returns the TokenAdminRegistry used by the offRamp,
+// or the zero address if the offRamp predates the TokenAdminRegistry (< 1.5).
+func (br *EVMTokenPoolBatchedReader) getTokenAdminRegistry(ctx context.Context) (common.Address, error) {
+	br.offRampMu.Lock()
+	defer br.offRampMu.Unlock()
+
+	if br.offRampVersion != "" {
+		return br.tokenAdminRegistry, nil
+	}
+
+	typeAndVersions, err := getBatchedTypeAndVersion(ctx, br.evmBatchCaller, []common.Address{br.offRampAddress})
+	if err != nil {
+		return common.Address{}, fmt.Errorf("get offRamp type and version: %w", err)
+	}
+	_, version, err := ccipconfig.ParseTypeAndVersion(typeAndVersions[0])
+	if err != nil {
+		return common.Address{}, err
+	}
+
+	if version == ccipdata.V1_5_0 {
+		results, err2 := br.evmBatchCaller.BatchCall(ctx, 0, []rpclib.EvmCall{
+			rpclib.NewEvmCall(offRampABI, "getStaticConfig", br.offRampAddress),
+		})
+		if err2 != nil {
+			return common.Address{}, fmt.Errorf("batch call get offRamp static config: %w", err2)
+		}
+		if len(results) != 1 {
+			return common.Address{}, fmt.Errorf("expected 1 result, got %d", len(results))
+		}
+		staticConfig, err2 := rpclib.ParseOutput[evm_2_evm_offramp.EVM2EVMOffRampStaticConfig](results[0], 0)
+		if err2 != nil {
+			return common.Address{}, fmt.Errorf("parse offRamp static config: %w", err2)
+		}
+		br.tokenAdminRegistry = staticConfig.TokenAdminRegistry
+	}
+	br.offRampVersion = version
+	return br.tokenAdminRegistry, nil
+}
+
 // loadTokenPoolReaders loads the token pools into the factory's cache
 func (br *EVMTokenPoolBatchedReader) loadTokenPoolReaders(ctx context.Context, tokenPoolAddresses []cciptypes.Address) error {
 	var missingTokens []common.Address
@@ -9,12 +9,14 @@ import (
 	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ccip/internal/rpclib"
 	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ccip/internal/rpclib/rpclibmocks"
 
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 
 	cciptypes "github.com/smartcontractkit/chainlink-common/pkg/types/ccip"
 	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/utils"
+	"github.com/smartcontractkit/chainlink/v2/core/gethwrappers/ccip/generated/evm_2_evm_offramp"
 	"github.com/smartcontractkit/chainlink/v2/core/logger"
 	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ccip/internal/ccipcalc"
 	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ccip/internal/ccipdata"
@@ -84,3 +86,66 @@ func TestTokenPoolFactory(t *testing.T) {
 		}
 	}
 }
+
+func TestTokenPoolRateLimitsByDestToken(t *testing.T) {
+	lggr := logger.TestLogger(t)
+	ctx := context.Background()
+	remoteChainSelector := uint64(2000)
+
+	destToken1 := ccipcalc.EvmAddrToGeneric(utils.RandomAddress())
+	destToken2 := ccipcalc.EvmAddrToGeneric(utils.RandomAddress())
+	pool1 := utils.RandomAddress()
+
+	rateLimits := cciptypes.TokenBucketRateLimit{
+		Tokens:      big.NewInt(1000),
+		LastUpdated: 10,
+		IsEnabled:   true,
+		Capacity:    big.NewInt(2000),
+		Rate:        big.NewInt(10),
+	}
+
+	t.Run("1.5 offRamp resolves pools through the token admin registry", func(t *testing.T) {
+		batchCallerMock := rpclibmocks.NewEvmBatchCaller(t)
+		tokenPoolBatchReader, err := NewEVMTokenPoolBatchedReader(lggr, remoteChainSelector, ccipcalc.EvmAddrToGeneric(utils.RandomAddress()), batchCallerMock)
+		require.NoError(t, err)
+
+		// offRamp typeAndVersion, offRamp static config, token admin registry pools, pool typeAndVersion and pool rate limits.
+		batchCallerMock.On("BatchCall", ctx, uint64(0), mock.Anything).Return([]rpclib.DataAndErr{{Outputs: []any{"EVM2EVMOffRamp " + ccipdata.V1_5_0}}}, nil).Once()
+		batchCallerMock.On("BatchCall", ctx, uint64(0), mock.Anything).Return([]rpclib.DataAndErr{{Outputs: []any{evm_2_evm_offramp.EVM2EVMOffRampStaticConfig{TokenAdminRegistry: utils.RandomAddress()}}}}, nil).Once()
+		batchCallerMock.On("BatchCall", ctx, uint64(0), mock.Anything).Return([]rpclib.DataAndErr{{Outputs: []any{[]common.Address{pool1, {}}}}}, nil).Once()
+		batchCallerMock.On("BatchCall", ctx, uint64(0), mock.Anything).Return([]rpclib.DataAndErr{{Outputs: []any{"BurnMintTokenPool " + ccipdata.V1_4_0}}}, nil).Once()
+		batchCallerMock.On("BatchCall", ctx, uint64(0), mock.Anything).Return([]rpclib.DataAndErr{{Outputs: []any{rateLimits}}}, nil).Once()
+
+		gotRateLimits, err := tokenPoolBatchReader.GetInboundTokenPoolRateLimitsByDestToken(ctx, []cciptypes.Address{destToken1, destToken2})
+		require.NoError(t, err)
+		assert.Equal(t, map[cciptypes.Address]cciptypes.TokenBucketRateLimit{destToken1: rateLimits}, gotRateLimits)
+	})
+
+	t.Run("1.2 offRamp resolves pools by itself", func(t *testing.T) {
+		batchCallerMock := rpclibmocks.NewEvmBatchCaller(t)
+		tokenPoolBatchReader, err := NewEVMTokenPoolBatchedReader(lggr, remoteChainSelector, ccipcalc.EvmAddrToGeneric(utils.RandomAddress()), batchCallerMock)
+		require.NoError(t, err)
+
+		// offRamp typeAndVersion, offRamp pools, pool typeAndVersion and pool rate limits.
+		batchCallerMock.On("BatchCall", ctx, uint64(0), mock.Anything).Return([]rpclib.DataAndErr{{Outputs: []any{"EVM2EVMOffRamp " + ccipdata.V1_2_0}}}, nil).Once()
+		batchCallerMock.On("BatchCall", ctx, uint64(0), mock.Anything).Return([]rpclib.DataAndErr{
+			{Outputs: []any{pool1}},
+			{Err: fmt.Errorf("execution reverted")},
+		}, nil).Once()
+		batchCallerMock.On("BatchCall", ctx, uint64(0), mock.Anything).Return([]rpclib.DataAndErr{{Outputs: []any{"BurnMintTokenPool " + ccipdata.V1_2_0}}}, nil).Once()
+		batchCallerMock.On("BatchCall", ctx, uint64(0), mock.Anything).Return([]rpclib.DataAndErr{{Outputs: []any{rateLimits}}}, nil).Once()
+
+		gotRateLimits, err := tokenPoolBatchReader.GetInboundTokenPoolRateLimitsByDestToken(ctx, []cciptypes.Address{destToken1, destToken2})
+		require.NoError(t, err)
+		assert.Equal(t, map[cciptypes.Address]cciptypes.TokenBucketRateLimit{destToken1: rateLimits}, gotRateLimits)
+	})
+
+	t.Run("no tokens", func(t *testing.T) {
+		tokenPoolBatchReader, err := NewEVMTokenPoolBatchedReader(lggr, remoteChainSelector, ccipcalc.EvmAddrToGeneric(utils.RandomAddress()), rpclibmocks.NewEvmBatchCaller(t))
+		require.NoError(t, err)
+
+		gotRateLimits, err := tokenPoolBatchReader.GetInboundTokenPoolRateLimitsByDestToken(ctx, nil)
+		require.NoError(t, err)
+		assert.Empty(t, gotRateLimits)
+	})
+}
@@ -0,0 +1,45 @@
+package ccipdata
+
+import (
+	"sync"
+	"time"
+)
+
+// rootDeadlineRetention is how long a deadline is kept after it has passed, roots which are still being
+// transmitted by then are bumped by age only.
+const rootDeadlineRetention = time.Hour
+
+// CommitRootDeadlines holds the deadlines of the merkle roots built by the commit plugin. The deadline of a root is
+// when its oldest message becomes manually executable, after the PermissionLessExecutionThresholdSeconds of the
+// OffRamp, which is when the DON has missed its window to commit and execute it. The commit transmitter sets it as the
+// TxMeta.Deadline of the commit tx, so fee policy urgency steps bump roots close to it faster.
+//
+// The plugin and the transmitter only share it when the relayer runs in the node process, otherwise commit txes have
+// no deadline.
+var CommitRootDeadlines = &RootDeadlines{deadlines: make(map[[32]byte]time.Time)}
+
+type RootDeadlines struct {
+	mu        sync.Mutex
+	deadlines map[[32]byte]time.Time
+}
+
+// Set records the deadline of the root, and drops the deadlines which have passed more than rootDeadlineRetention ago
+func (d *RootDeadlines) Set(root [32]byte, deadline time.Time) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	now := time.Now()
+	for r, dl := range d.deadlines {
+		if now.Sub(dl) > rootDeadlineRetention {
+			delete(d.deadlines, r)
+		}
+	}
+	d.deadlines[root] = deadline
+}
+
+// Get returns the deadline of the root, if the commit plugin of this node built it
+func (d *RootDeadlines) Get(root [32]byte) (time.Time, bool) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	deadline, ok := d.deadlines[root]
+	return deadline, ok
+}
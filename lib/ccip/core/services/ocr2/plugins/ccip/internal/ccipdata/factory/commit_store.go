@@ -107,15 +107,25 @@ func CommitReportToEthTxMeta(typ ccipconfig.ContractType, ver semver.Version) (f
 	}
 }
 
+// FeePolicyCommit is the fee policy of commit report txes, configured with EVM.GasEstimator.FeePolicies
+const FeePolicyCommit = "ccip_commit"
+
 // CommitReportToEthTxMeta generates a txmgr.EthTxMeta from the given commit report.
-// sequence numbers of the committed messages will be added to tx metadata
+// sequence numbers of the committed messages will be added to tx metadata, along with
+// the deadline of the merkle root when the commit plugin of this node built it
 func commitReportToEthTxMeta(commitReport cciptypes.CommitStoreReport) (*txmgr.TxMeta, error) {
 	n := (commitReport.Interval.Max - commitReport.Interval.Min) + 1
 	seqRange := make([]uint64, n)
 	for i := uint64(0); i < n; i++ {
 		seqRange[i] = i + commitReport.Interval.Min
 	}
-	return &txmgr.TxMeta{
+	feePolicy := FeePolicyCommit
+	meta := &txmgr.TxMeta{
 		SeqNumbers: seqRange,
-	}, nil
+		FeePolicy:  &feePolicy,
+	}
+	if deadline, ok := ccipdata.CommitRootDeadlines.Get(commitReport.MerkleRoot); ok {
+		meta.Deadline = &deadline
+	}
+	return meta, nil
 }
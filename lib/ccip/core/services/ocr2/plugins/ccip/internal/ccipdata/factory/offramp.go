@@ -10,6 +10,7 @@ import (
 
 	cciptypes "github.com/smartcontractkit/chainlink-common/pkg/types/ccip"
 
+	txmgrtypes "github.com/smartcontractkit/chainlink/v2/common/txmgr/types"
 	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/client"
 	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/gas"
 	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/logpoller"
@@ -85,6 +86,22 @@ func initOrCloseOffRampReader(lggr logger.Logger, versionFinder VersionFinder, a
 	// TODO can validate it pointing to the correct version
 }
 
+// ExecTransmitCheckerType returns the transmit checker dropping the executed messages of the execution reports of
+// the offramp version.
+func ExecTransmitCheckerType(typ ccipconfig.ContractType, ver semver.Version) (txmgrtypes.TransmitCheckerType, error) {
+	if typ != ccipconfig.EVM2EVMOffRamp {
+		return "", errors.Errorf("expected %v got %v", ccipconfig.EVM2EVMOffRamp, typ)
+	}
+	switch ver.String() {
+	case ccipdata.V1_0_0, ccipdata.V1_1_0:
+		return txmgr.TransmitCheckerTypeCCIPExecV1_0, nil
+	case ccipdata.V1_2_0, ccipdata.V1_5_0:
+		return txmgr.TransmitCheckerTypeCCIPExec, nil
+	default:
+		return "", errors.Errorf("got unexpected version %v", ver.String())
+	}
+}
+
 func ExecReportToEthTxMeta(ctx context.Context, typ ccipconfig.ContractType, ver semver.Version) (func(report []byte) (*txmgr.TxMeta, error), error) {
 	if typ != ccipconfig.EVM2EVMOffRamp {
 		return nil, errors.Errorf("expected %v got %v", ccipconfig.EVM2EVMOffRamp, typ)
@@ -113,13 +130,18 @@ func ExecReportToEthTxMeta(ctx context.Context, typ ccipconfig.ContractType, ver
 	}
 }
 
+// FeePolicyExec is the fee policy of execution report txes, configured with EVM.GasEstimator.FeePolicies
+const FeePolicyExec = "ccip_exec"
+
 func execReportToEthTxMeta(execReport cciptypes.ExecReport) (*txmgr.TxMeta, error) {
 	msgIDs := make([]string, len(execReport.Messages))
 	for i, msg := range execReport.Messages {
 		msgIDs[i] = hexutil.Encode(msg.MessageID[:])
 	}
 
+	feePolicy := FeePolicyExec
 	return &txmgr.TxMeta{
 		MessageIDs: msgIDs,
+		FeePolicy:  &feePolicy,
 	}, nil
 }
@@ -1,6 +1,7 @@
 package ccip
 
 import (
+	"math/big"
 	"strconv"
 
 	"github.com/prometheus/client_golang/prometheus"
@@ -24,6 +25,10 @@ var (
 		Name: "ccip_new_reporting_plugin_error_counter",
 		Help: "The count of the number of errors when calling NewReportingPlugin",
 	}, []string{"plugin"})
+	rateLimitDeferredTokenAmount = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "ccip_exec_rate_limit_deferred_token_amount",
+		Help: "Token amount of the messages deferred until the destination token pool rate limit refills",
+	}, []string{"plugin", "source", "dest", "token"})
 )
 
 type ocrPhase string
@@ -40,6 +45,7 @@ type PluginMetricsCollector interface {
 	UnexpiredCommitRoots(count int)
 	SequenceNumber(phase ocrPhase, seqNr uint64)
 	NewReportingPluginError()
+	RateLimitDeferredTokenAmount(token string, amount *big.Int)
 }
 
 type pluginMetricsCollector struct {
@@ -90,6 +96,13 @@ func (p *pluginMetricsCollector) NewReportingPluginError() {
 		Inc()
 }
 
+func (p *pluginMetricsCollector) RateLimitDeferredTokenAmount(token string, amount *big.Int) {
+	value, _ := new(big.Float).SetInt(amount).Float64()
+	rateLimitDeferredTokenAmount.
+		WithLabelValues(p.pluginName, p.source, p.dest, token).
+		Set(value)
+}
+
 var (
 	// NoopMetricsCollector is a no-op implementation of PluginMetricsCollector
 	NoopMetricsCollector PluginMetricsCollector = noop{}
@@ -111,3 +124,6 @@ func (d noop) SequenceNumber(ocrPhase, uint64) {
 
 func (d noop) NewReportingPluginError() {
 }
+
+func (d noop) RateLimitDeferredTokenAmount(string, *big.Int) {
+}
@@ -1,6 +1,7 @@
 package ccip
 
 import (
+	"math/big"
 	"testing"
 
 	"github.com/prometheus/client_golang/prometheus/testutil"
@@ -45,3 +46,13 @@ func Test_UnexpiredCommitRoots(t *testing.T) {
 	collector.UnexpiredCommitRoots(5)
 	assert.Equal(t, float64(5), testutil.ToFloat64(unexpiredCommitRoots.WithLabelValues("test", "1337", "2337")))
 }
+
+func Test_RateLimitDeferredTokenAmount(t *testing.T) {
+	collector := NewPluginMetricsCollector("test", sourceChainId, destChainId)
+
+	collector.RateLimitDeferredTokenAmount("0x1", big.NewInt(1e18))
+	assert.Equal(t, float64(1e18), testutil.ToFloat64(rateLimitDeferredTokenAmount.WithLabelValues("test", "1337", "2337", "0x1")))
+
+	collector.RateLimitDeferredTokenAmount("0x1", big.NewInt(0))
+	assert.Equal(t, float64(0), testutil.ToFloat64(rateLimitDeferredTokenAmount.WithLabelValues("test", "1337", "2337", "0x1")))
+}
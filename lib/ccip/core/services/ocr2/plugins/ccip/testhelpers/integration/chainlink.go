@@ -677,7 +677,7 @@ func (c *CCIPIntegrationTestHarness) ApproveJobSpecs(t *testing.T, jobParams CCI
 			utils.RandomAddress().String(),
 			utils.RandomAddress().String(),
 		)
-		execId, err := f.ProposeJob(ctx, &execSpec)
+		execId, _, err := f.ProposeJob(ctx, &execSpec)
 		require.NoError(t, err)
 
 		err = f.ApproveSpec(ctx, execId, true)
@@ -710,7 +710,7 @@ func (c *CCIPIntegrationTestHarness) ApproveJobSpecs(t *testing.T, jobParams CCI
 			)
 		}
 
-		commitId, err := f.ProposeJob(ctx, &commitSpec)
+		commitId, _, err := f.ProposeJob(ctx, &commitSpec)
 		require.NoError(t, err)
 
 		err = f.ApproveSpec(ctx, commitId, true)
@@ -0,0 +1,822 @@
+package testhelpers
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"math"
+	"math/big"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind/backends"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/hashutil"
+	"github.com/smartcontractkit/chainlink-common/pkg/merklemulti"
+
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/utils"
+	"github.com/smartcontractkit/chainlink/v2/core/gethwrappers/ccip/generated/burn_mint_token_pool"
+	"github.com/smartcontractkit/chainlink/v2/core/gethwrappers/ccip/generated/burn_with_from_mint_rebasing_token_pool"
+	"github.com/smartcontractkit/chainlink/v2/core/gethwrappers/ccip/generated/commit_store"
+	"github.com/smartcontractkit/chainlink/v2/core/gethwrappers/ccip/generated/commit_store_helper"
+	"github.com/smartcontractkit/chainlink/v2/core/gethwrappers/ccip/generated/evm_2_evm_offramp"
+	"github.com/smartcontractkit/chainlink/v2/core/gethwrappers/ccip/generated/evm_2_evm_onramp"
+	"github.com/smartcontractkit/chainlink/v2/core/gethwrappers/ccip/generated/mock_rmn_contract"
+	"github.com/smartcontractkit/chainlink/v2/core/gethwrappers/ccip/generated/price_registry_1_2_0"
+	"github.com/smartcontractkit/chainlink/v2/core/gethwrappers/ccip/generated/rmn_proxy_contract"
+	"github.com/smartcontractkit/chainlink/v2/core/gethwrappers/ccip/generated/router"
+	"github.com/smartcontractkit/chainlink/v2/core/gethwrappers/ccip/generated/token_admin_registry"
+	"github.com/smartcontractkit/chainlink/v2/core/gethwrappers/ccip/generated/weth9"
+	"github.com/smartcontractkit/chainlink/v2/core/gethwrappers/generated/link_token_interface"
+	"github.com/smartcontractkit/chainlink/v2/core/gethwrappers/shared/generated/burn_mint_erc677"
+	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ccip/abihelpers"
+	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ccip/internal/ccipdata/v1_5_0"
+)
+
+var (
+	commitReportArgs    = abihelpers.MustGetEventInputs("ReportAccepted", abihelpers.MustParseABI(commit_store.CommitStoreABI))
+	executionReportArgs = abihelpers.MustGetMethodInputs("manuallyExecute", abihelpers.MustParseABI(evm_2_evm_offramp.EVM2EVMOffRampABI))[:1]
+)
+
+const executionGasLimit = 10_000_000
+
+// TokenPoolDeployer deploys a token and its pool on every chain of a MultiChainHarness, and connects the pools of all
+// the chains. Tests implement it to plug their own pools into the harness.
+type TokenPoolDeployer interface {
+	// Name identifies the token in HarnessChain.Tokens
+	Name() string
+	// Deploy deploys the token and its pool on chain, and funds the user of chain with tokens to send.
+	// The harness registers the pool in the TokenAdminRegistry of chain.
+	Deploy(t *testing.T, chain *HarnessChain) (token, pool common.Address)
+	// ConnectRemotes allows the pool on chain to send tokens to and receive tokens from the pools on remotes
+	ConnectRemotes(t *testing.T, chain *HarnessChain, remotes []*HarnessChain)
+}
+
+// InterestAccruer is implemented by the TokenPoolDeployers of rebasing tokens. The harness calls Accrue on every chain
+// after advancing time.
+type InterestAccruer interface {
+	Accrue(t *testing.T, chain *HarnessChain, elapsed time.Duration)
+}
+
+// MultiChainHarnessConfig is the config of SetupMultiChainHarness
+type MultiChainHarnessConfig struct {
+	// NumChains is the number of chains, a lane is set up between every ordered pair of chains
+	NumChains int
+	// Pools are deployed on every chain
+	Pools []TokenPoolDeployer
+	// MaxTimeAdvance is how far the clocks of the chains can be advanced with AdvanceTime
+	MaxTimeAdvance time.Duration
+}
+
+// HarnessToken is a token of a HarnessChain and its pool
+type HarnessToken struct {
+	Token common.Address
+	Pool  common.Address
+}
+
+// HarnessChain is a chain of a MultiChainHarness. All the contracts of the chain are owned by User.
+type HarnessChain struct {
+	ChainSelector      uint64
+	User               *bind.TransactOpts
+	Chain              *backends.SimulatedBackend
+	ARM                *mock_rmn_contract.MockRMNContract
+	ARMProxy           *rmn_proxy_contract.RMNProxyContract
+	TokenAdminRegistry *token_admin_registry.TokenAdminRegistry
+	LinkToken          *link_token_interface.LinkToken
+	WrappedNative      *weth9.WETH9
+	Router             *router.Router
+	PriceRegistry      *price_registry_1_2_0.PriceRegistry
+	// Tokens are the tokens of the pools of the harness, by name of their TokenPoolDeployer
+	Tokens map[string]HarnessToken
+}
+
+// TokenBalance returns the balance of addr of the token of the pool name
+func (c *HarnessChain) TokenBalance(t *testing.T, name string, addr common.Address) *big.Int {
+	token, ok := c.Tokens[name]
+	require.True(t, ok, "no token %s on chain %d", name, c.ChainSelector)
+	return GetBalance(t, c.Chain, token.Token, addr)
+}
+
+// HarnessLane is the lane from Source to Dest of a MultiChainHarness
+type HarnessLane struct {
+	Source            *HarnessChain
+	Dest              *HarnessChain
+	OnRamp            *evm_2_evm_onramp.EVM2EVMOnRamp
+	CommitStore       *commit_store.CommitStore
+	CommitStoreHelper *commit_store_helper.CommitStoreHelper
+	OffRamp           *evm_2_evm_offramp.EVM2EVMOffRamp
+
+	// sent are the CCIPSendRequested logs of the messages which have not been committed yet
+	sent []types.Log
+	// committed are the batches of CCIPSendRequested logs which have been committed but not executed yet
+	committed [][]types.Log
+	// epochAndRound of the last report transmitted to the dest chain
+	epochAndRound int64
+}
+
+// MultiChainHarness is an in-process CCIP deployment over NumChains simulated chains, with a lane between every pair of
+// chains. It stands in for the DONs: Commit reports the merkle roots of the sent messages through the CommitStoreHelper
+// and Execute transmits the execution reports to the OffRamps, without OCR signatures.
+type MultiChainHarness struct {
+	Chains []*HarnessChain
+
+	lanes      map[[2]uint64]*HarnessLane
+	pools      []TokenPoolDeployer
+	timeBudget time.Duration
+}
+
+// SetupMultiChainHarness deploys the CCIP contracts and the pools of cfg on cfg.NumChains simulated chains
+func SetupMultiChainHarness(t *testing.T, cfg MultiChainHarnessConfig) *MultiChainHarness {
+	require.GreaterOrEqual(t, cfg.NumChains, 2, "the harness needs at least two chains")
+	h := &MultiChainHarness{
+		lanes:      make(map[[2]uint64]*HarnessLane),
+		pools:      cfg.Pools,
+		timeBudget: cfg.MaxTimeAdvance,
+	}
+	for i := 0; i < cfg.NumChains; i++ {
+		// selectors only need to be unique within the harness, all the chains run on chain ID 1337
+		h.Chains = append(h.Chains, setupHarnessChain(t, SourceChainSelector+uint64(i), FirstBlockAge+cfg.MaxTimeAdvance))
+	}
+	for _, chain := range h.Chains {
+		var remotes []uint64
+		for _, remote := range h.Chains {
+			if remote != chain {
+				remotes = append(remotes, remote.ChainSelector)
+			}
+		}
+		setHarnessGasPrices(t, chain, remotes)
+	}
+
+	for _, pool := range cfg.Pools {
+		for _, chain := range h.Chains {
+			token, poolAddress := pool.Deploy(t, chain)
+			chain.Chain.Commit()
+			SetAdminAndRegisterPool(t, chain.Chain, chain.User, chain.TokenAdminRegistry, token, poolAddress)
+			chain.Tokens[pool.Name()] = HarnessToken{Token: token, Pool: poolAddress}
+		}
+		for _, chain := range h.Chains {
+			var remotes []*HarnessChain
+			for _, remote := range h.Chains {
+				if remote != chain {
+					remotes = append(remotes, remote)
+				}
+			}
+			pool.ConnectRemotes(t, chain, remotes)
+			chain.Chain.Commit()
+		}
+	}
+
+	for _, source := range h.Chains {
+		for _, dest := range h.Chains {
+			if source != dest {
+				h.lanes[[2]uint64{source.ChainSelector, dest.ChainSelector}] = setupHarnessLane(t, source, dest)
+			}
+		}
+	}
+	return h
+}
+
+func setupHarnessChain(t *testing.T, chainSelector uint64, firstBlockAge time.Duration) *HarnessChain {
+	chain, user := SetupChainWithFirstBlockAge(t, firstBlockAge)
+
+	armAddress, _, _, err := mock_rmn_contract.DeployMockRMNContract(user, chain)
+	require.NoError(t, err)
+	arm, err := mock_rmn_contract.NewMockRMNContract(armAddress, chain)
+	require.NoError(t, err)
+	armProxyAddress, _, _, err := rmn_proxy_contract.DeployRMNProxyContract(user, chain, armAddress)
+	require.NoError(t, err)
+	armProxy, err := rmn_proxy_contract.NewRMNProxyContract(armProxyAddress, chain)
+	require.NoError(t, err)
+
+	tokenAdminRegistryAddress, _, _, err := token_admin_registry.DeployTokenAdminRegistry(user, chain)
+	require.NoError(t, err)
+	tokenAdminRegistry, err := token_admin_registry.NewTokenAdminRegistry(tokenAdminRegistryAddress, chain)
+	require.NoError(t, err)
+
+	linkTokenAddress, _, _, err := link_token_interface.DeployLinkToken(user, chain)
+	require.NoError(t, err)
+	linkToken, err := link_token_interface.NewLinkToken(linkTokenAddress, chain)
+	require.NoError(t, err)
+	weth9Address, _, _, err := weth9.DeployWETH9(user, chain)
+	require.NoError(t, err)
+	wrappedNative, err := weth9.NewWETH9(weth9Address, chain)
+	require.NoError(t, err)
+	chain.Commit()
+
+	routerAddress, _, _, err := router.DeployRouter(user, chain, weth9Address, armProxyAddress)
+	require.NoError(t, err)
+	sourceRouter, err := router.NewRouter(routerAddress, chain)
+	require.NoError(t, err)
+
+	// The harness can advance the clock by a lot, the prices must not go stale in the meantime
+	priceRegistryAddress, _, _, err := price_registry_1_2_0.DeployPriceRegistry(
+		user,
+		chain,
+		nil,
+		[]common.Address{linkTokenAddress, weth9Address},
+		math.MaxUint32,
+	)
+	require.NoError(t, err)
+	priceRegistry, err := price_registry_1_2_0.NewPriceRegistry(priceRegistryAddress, chain)
+	require.NoError(t, err)
+	chain.Commit()
+
+	return &HarnessChain{
+		ChainSelector:      chainSelector,
+		User:               user,
+		Chain:              chain,
+		ARM:                arm,
+		ARMProxy:           armProxy,
+		TokenAdminRegistry: tokenAdminRegistry,
+		LinkToken:          linkToken,
+		WrappedNative:      wrappedNative,
+		Router:             sourceRouter,
+		PriceRegistry:      priceRegistry,
+		Tokens:             make(map[string]HarnessToken),
+	}
+}
+
+func setHarnessGasPrices(t *testing.T, chain *HarnessChain, remotes []uint64) {
+	var gasPrices []price_registry_1_2_0.InternalGasPriceUpdate
+	for _, remote := range remotes {
+		gasPrices = append(gasPrices, price_registry_1_2_0.InternalGasPriceUpdate{
+			DestChainSelector: remote,
+			UsdPerUnitGas:     big.NewInt(20000e9),
+		})
+	}
+	_, err := chain.PriceRegistry.UpdatePrices(chain.User, price_registry_1_2_0.InternalPriceUpdates{
+		TokenPriceUpdates: []price_registry_1_2_0.InternalTokenPriceUpdate{
+			{
+				SourceToken: chain.LinkToken.Address(),
+				UsdPerToken: new(big.Int).Mul(big.NewInt(1e18), big.NewInt(20)),
+			},
+			{
+				SourceToken: chain.WrappedNative.Address(),
+				UsdPerToken: new(big.Int).Mul(big.NewInt(1e18), big.NewInt(2000)),
+			},
+		},
+		GasPriceUpdates: gasPrices,
+	})
+	require.NoError(t, err)
+	chain.Chain.Commit()
+}
+
+func setupHarnessLane(t *testing.T, source, dest *HarnessChain) *HarnessLane {
+	onRampAddress, _, _, err := evm_2_evm_onramp.DeployEVM2EVMOnRamp(
+		source.User,
+		source.Chain,
+		evm_2_evm_onramp.EVM2EVMOnRampStaticConfig{
+			LinkToken:          source.LinkToken.Address(),
+			ChainSelector:      source.ChainSelector,
+			DestChainSelector:  dest.ChainSelector,
+			DefaultTxGasLimit:  200_000,
+			MaxNopFeesJuels:    big.NewInt(0).Mul(big.NewInt(100_000_000), big.NewInt(1e18)),
+			PrevOnRamp:         common.Address{},
+			RmnProxy:           source.ARMProxy.Address(),
+			TokenAdminRegistry: source.TokenAdminRegistry.Address(),
+		},
+		evm_2_evm_onramp.EVM2EVMOnRampDynamicConfig{
+			Router:                            source.Router.Address(),
+			MaxNumberOfTokensPerMsg:           5,
+			DestGasOverhead:                   350_000,
+			DestGasPerPayloadByte:             16,
+			DestDataAvailabilityOverheadGas:   33_596,
+			DestGasPerDataAvailabilityByte:    16,
+			DestDataAvailabilityMultiplierBps: 6840, // 0.684
+			PriceRegistry:                     source.PriceRegistry.Address(),
+			MaxDataBytes:                      1e5,
+			MaxPerMsgGasLimit:                 4_000_000,
+			DefaultTokenFeeUSDCents:           50,
+			DefaultTokenDestGasOverhead:       DefaultTokenDestGasOverhead,
+		},
+		evm_2_evm_onramp.RateLimiterConfig{
+			IsEnabled: true,
+			Capacity:  LinkUSDValue(100),
+			Rate:      LinkUSDValue(1),
+		},
+		[]evm_2_evm_onramp.EVM2EVMOnRampFeeTokenConfigArgs{
+			{
+				Token:                      source.LinkToken.Address(),
+				NetworkFeeUSDCents:         1_00,
+				GasMultiplierWeiPerEth:     1e18,
+				PremiumMultiplierWeiPerEth: 9e17,
+				Enabled:                    true,
+			},
+		},
+		[]evm_2_evm_onramp.EVM2EVMOnRampTokenTransferFeeConfigArgs{},
+		[]evm_2_evm_onramp.EVM2EVMOnRampNopAndWeight{},
+	)
+	require.NoError(t, err)
+	onRamp, err := evm_2_evm_onramp.NewEVM2EVMOnRamp(onRampAddress, source.Chain)
+	require.NoError(t, err)
+	_, err = source.Router.ApplyRampUpdates(source.User, []router.RouterOnRamp{{DestChainSelector: dest.ChainSelector, OnRamp: onRampAddress}}, nil, nil)
+	require.NoError(t, err)
+	source.Chain.Commit()
+
+	commitStoreAddress, _, _, err := commit_store_helper.DeployCommitStoreHelper(
+		dest.User,
+		dest.Chain,
+		commit_store_helper.CommitStoreStaticConfig{
+			ChainSelector:       dest.ChainSelector,
+			SourceChainSelector: source.ChainSelector,
+			OnRamp:              onRampAddress,
+			RmnProxy:            dest.ARMProxy.Address(),
+		},
+	)
+	require.NoError(t, err)
+	dest.Chain.Commit()
+	commitStore, err := commit_store.NewCommitStore(commitStoreAddress, dest.Chain)
+	require.NoError(t, err)
+	commitStoreHelper, err := commit_store_helper.NewCommitStoreHelper(commitStoreAddress, dest.Chain)
+	require.NoError(t, err)
+
+	offRampAddress, _, _, err := evm_2_evm_offramp.DeployEVM2EVMOffRamp(
+		dest.User,
+		dest.Chain,
+		evm_2_evm_offramp.EVM2EVMOffRampStaticConfig{
+			CommitStore:         commitStoreAddress,
+			ChainSelector:       dest.ChainSelector,
+			SourceChainSelector: source.ChainSelector,
+			OnRamp:              onRampAddress,
+			PrevOffRamp:         common.Address{},
+			RmnProxy:            dest.ARMProxy.Address(),
+			TokenAdminRegistry:  dest.TokenAdminRegistry.Address(),
+		},
+		evm_2_evm_offramp.RateLimiterConfig{
+			IsEnabled: true,
+			Capacity:  LinkUSDValue(100),
+			Rate:      LinkUSDValue(1),
+		},
+	)
+	require.NoError(t, err)
+	offRamp, err := evm_2_evm_offramp.NewEVM2EVMOffRamp(offRampAddress, dest.Chain)
+	require.NoError(t, err)
+	dest.Chain.Commit()
+
+	// The OffRamp does not check the signatures of the reports, so the user transmits them as one of the transmitters of
+	// a DON with random other members.
+	execOnchainConfig, err := abihelpers.EncodeAbiStruct(v1_5_0.ExecOnchainConfig{
+		PermissionLessExecutionThresholdSeconds: PermissionLessExecutionThresholdSeconds,
+		Router:                                  dest.Router.Address(),
+		PriceRegistry:                           dest.PriceRegistry.Address(),
+		MaxDataBytes:                            1e5,
+		MaxNumberOfTokensPerMsg:                 5,
+	})
+	require.NoError(t, err)
+	transmitters := []common.Address{dest.User.From, utils.RandomAddress(), utils.RandomAddress(), utils.RandomAddress()}
+	_, err = offRamp.SetOCR2Config(dest.User, transmitters, transmitters, 1, execOnchainConfig, 2, []byte{})
+	require.NoError(t, err)
+	_, err = dest.Router.ApplyRampUpdates(dest.User, nil, nil, []router.RouterOffRamp{{SourceChainSelector: source.ChainSelector, OffRamp: offRampAddress}})
+	require.NoError(t, err)
+	dest.Chain.Commit()
+
+	return &HarnessLane{
+		Source:            source,
+		Dest:              dest,
+		OnRamp:            onRamp,
+		CommitStore:       commitStore,
+		CommitStoreHelper: commitStoreHelper,
+		OffRamp:           offRamp,
+	}
+}
+
+// Lane returns the lane from the chain with selector source to the chain with selector dest
+func (h *MultiChainHarness) Lane(t *testing.T, source, dest uint64) *HarnessLane {
+	lane, ok := h.lanes[[2]uint64{source, dest}]
+	require.True(t, ok, "no lane from %d to %d", source, dest)
+	return lane
+}
+
+// Send sends msg from the chain with selector source to the chain with selector dest, paying the fee in LINK. The user
+// of the source chain approves the fee and the tokens of msg to the router.
+func (h *MultiChainHarness) Send(t *testing.T, source, dest uint64, msg router.ClientEVM2AnyMessage) evm_2_evm_onramp.InternalEVM2EVMMessage {
+	lane := h.Lane(t, source, dest)
+	src := lane.Source
+	msg.FeeToken = src.LinkToken.Address()
+
+	fee, err := src.Router.GetFee(&bind.CallOpts{Context: context.Background()}, dest, msg)
+	require.NoError(t, err)
+	_, err = src.LinkToken.Approve(src.User, src.Router.Address(), fee)
+	require.NoError(t, err)
+	for _, tokenAmount := range msg.TokenAmounts {
+		token, err2 := burn_mint_erc677.NewBurnMintERC677(tokenAmount.Token, src.Chain)
+		require.NoError(t, err2)
+		_, err2 = token.Approve(src.User, src.Router.Address(), tokenAmount.Amount)
+		require.NoError(t, err2)
+	}
+	src.Chain.Commit()
+
+	tx, err := src.Router.CcipSend(src.User, dest, msg)
+	require.NoError(t, err)
+	src.Chain.Commit()
+	rec, err := bind.WaitMined(context.Background(), src.Chain, tx)
+	require.NoError(t, err)
+	require.Equal(t, types.ReceiptStatusSuccessful, rec.Status, "ccipSend failed")
+
+	for _, log := range rec.Logs {
+		if log.Address != lane.OnRamp.Address() || log.Topics[0] != (evm_2_evm_onramp.EVM2EVMOnRampCCIPSendRequested{}).Topic() {
+			continue
+		}
+		sent, err := lane.OnRamp.ParseCCIPSendRequested(*log)
+		require.NoError(t, err)
+		lane.sent = append(lane.sent, *log)
+		return sent.Message
+	}
+	require.FailNow(t, "no CCIPSendRequested in the ccipSend receipt")
+	return evm_2_evm_onramp.InternalEVM2EVMMessage{}
+}
+
+// Commit commits the merkle root of all the messages sent on the lane since the last commit, and returns the report
+func (l *HarnessLane) Commit(t *testing.T) commit_store.CommitStoreCommitReport {
+	require.NotEmpty(t, l.sent, "no messages to commit")
+	msgs := l.parseSent(t, l.sent)
+	tree := l.tree(t, l.sent)
+
+	report := commit_store.CommitStoreCommitReport{
+		Interval:   commit_store.CommitStoreInterval{Min: msgs[0].SequenceNumber, Max: msgs[len(msgs)-1].SequenceNumber},
+		MerkleRoot: tree.Root(),
+	}
+	encoded, err := commitReportArgs.PackValues([]interface{}{report})
+	require.NoError(t, err)
+	l.epochAndRound++
+	_, err = l.CommitStoreHelper.Report(l.Dest.User, encoded, big.NewInt(l.epochAndRound))
+	require.NoError(t, err)
+	l.Dest.Chain.Commit()
+
+	ts, err := l.CommitStore.GetMerkleRoot(&bind.CallOpts{Context: context.Background()}, report.MerkleRoot)
+	require.NoError(t, err)
+	require.NotZero(t, ts.Int64(), "merkle root was not committed")
+
+	l.committed = append(l.committed, l.sent)
+	l.sent = nil
+	return report
+}
+
+// Execute executes every committed batch of messages of the lane which has not been executed yet, and returns the
+// execution state changes of the messages.
+func (l *HarnessLane) Execute(t *testing.T) []*evm_2_evm_offramp.EVM2EVMOffRampExecutionStateChanged {
+	require.NotEmpty(t, l.committed, "no messages to execute")
+	details, err := l.OffRamp.LatestConfigDetails(&bind.CallOpts{Context: context.Background()})
+	require.NoError(t, err)
+
+	var changes []*evm_2_evm_offramp.EVM2EVMOffRampExecutionStateChanged
+	for _, batch := range l.committed {
+		tree := l.tree(t, batch)
+		indices := make([]int, len(batch))
+		for i := range indices {
+			indices[i] = i
+		}
+		proof, err := tree.Prove(indices)
+		require.NoError(t, err)
+
+		report := evm_2_evm_offramp.InternalExecutionReport{
+			Proofs:        proof.Hashes,
+			ProofFlagBits: abihelpers.ProofFlagsToBits(proof.SourceFlags),
+		}
+		for _, msg := range l.parseSent(t, batch) {
+			report.Messages = append(report.Messages, toOffRampMessage(msg))
+			report.OffchainTokenData = append(report.OffchainTokenData, make([][]byte, len(msg.TokenAmounts)))
+		}
+		encoded, err := executionReportArgs.PackValues([]interface{}{&report})
+		require.NoError(t, err)
+
+		l.epochAndRound++
+		reportContext := [3][32]byte{details.ConfigDigest, common.BigToHash(big.NewInt(l.epochAndRound << 8))}
+		// The OffRamp catches the failures of the messages, so the estimated gas would be enough for the failures only
+		opts := *l.Dest.User
+		opts.GasLimit = executionGasLimit
+		tx, err := l.OffRamp.Transmit(&opts, reportContext, encoded, nil, nil, [32]byte{})
+		require.NoError(t, err)
+		l.Dest.Chain.Commit()
+		rec, err := bind.WaitMined(context.Background(), l.Dest.Chain, tx)
+		require.NoError(t, err)
+		require.Equal(t, types.ReceiptStatusSuccessful, rec.Status, "execution report was not accepted")
+
+		for _, log := range rec.Logs {
+			if log.Address != l.OffRamp.Address() || log.Topics[0] != (evm_2_evm_offramp.EVM2EVMOffRampExecutionStateChanged{}).Topic() {
+				continue
+			}
+			change, err := l.OffRamp.ParseExecutionStateChanged(*log)
+			require.NoError(t, err)
+			changes = append(changes, change)
+		}
+	}
+	l.committed = nil
+	return changes
+}
+
+func (l *HarnessLane) parseSent(t *testing.T, logs []types.Log) []evm_2_evm_onramp.InternalEVM2EVMMessage {
+	msgs := make([]evm_2_evm_onramp.InternalEVM2EVMMessage, len(logs))
+	for i, log := range logs {
+		sent, err := l.OnRamp.ParseCCIPSendRequested(log)
+		require.NoError(t, err)
+		msgs[i] = sent.Message
+	}
+	return msgs
+}
+
+func (l *HarnessLane) tree(t *testing.T, logs []types.Log) *merklemulti.Tree[[32]byte] {
+	hasher := hashutil.NewKeccak()
+	leafHasher := v1_5_0.NewLeafHasher(l.Source.ChainSelector, l.Dest.ChainSelector, l.OnRamp.Address(), hasher, l.OnRamp)
+	leaves := make([][32]byte, len(logs))
+	for i, log := range logs {
+		leaf, err := leafHasher.HashLeaf(log)
+		require.NoError(t, err)
+		leaves[i] = leaf
+	}
+	tree, err := merklemulti.NewTree(hasher, leaves)
+	require.NoError(t, err)
+	return tree
+}
+
+func toOffRampMessage(msg evm_2_evm_onramp.InternalEVM2EVMMessage) evm_2_evm_offramp.InternalEVM2EVMMessage {
+	tokenAmounts := make([]evm_2_evm_offramp.ClientEVMTokenAmount, len(msg.TokenAmounts))
+	for i, tokenAmount := range msg.TokenAmounts {
+		tokenAmounts[i] = evm_2_evm_offramp.ClientEVMTokenAmount{Token: tokenAmount.Token, Amount: tokenAmount.Amount}
+	}
+	return evm_2_evm_offramp.InternalEVM2EVMMessage{
+		SourceChainSelector: msg.SourceChainSelector,
+		Sender:              msg.Sender,
+		Receiver:            msg.Receiver,
+		SequenceNumber:      msg.SequenceNumber,
+		GasLimit:            msg.GasLimit,
+		Strict:              msg.Strict,
+		Nonce:               msg.Nonce,
+		FeeToken:            msg.FeeToken,
+		FeeTokenAmount:      msg.FeeTokenAmount,
+		Data:                msg.Data,
+		TokenAmounts:        tokenAmounts,
+		SourceTokenData:     msg.SourceTokenData,
+		MessageId:           msg.MessageId,
+	}
+}
+
+// Deliver commits and executes the messages sent on every lane, and returns the execution state changes of the
+// messages by lane
+func (h *MultiChainHarness) Deliver(t *testing.T) map[*HarnessLane][]*evm_2_evm_offramp.EVM2EVMOffRampExecutionStateChanged {
+	changes := make(map[*HarnessLane][]*evm_2_evm_offramp.EVM2EVMOffRampExecutionStateChanged)
+	for _, lane := range h.lanes {
+		if len(lane.sent) == 0 {
+			continue
+		}
+		lane.Commit(t)
+		changes[lane] = lane.Execute(t)
+	}
+	return changes
+}
+
+// AdvanceTime moves the clocks of all the chains forward by d, then lets the pools which implement InterestAccruer
+// accrue the interest of d on every chain
+func (h *MultiChainHarness) AdvanceTime(t *testing.T, d time.Duration) {
+	require.LessOrEqual(t, d, h.timeBudget, "the chains can only be advanced by %s more, see MultiChainHarnessConfig.MaxTimeAdvance", h.timeBudget)
+	h.timeBudget -= d
+	for _, chain := range h.Chains {
+		require.NoError(t, chain.Chain.AdjustTime(d))
+		chain.Chain.Commit()
+	}
+	for _, pool := range h.pools {
+		accruer, ok := pool.(InterestAccruer)
+		if !ok {
+			continue
+		}
+		for _, chain := range h.Chains {
+			accruer.Accrue(t, chain, d)
+			chain.Chain.Commit()
+		}
+	}
+}
+
+// SourceTokenData is the data of a token transfer which the source pool passes to the dest pool
+type SourceTokenData struct {
+	SourcePoolAddress []byte
+	DestTokenAddress  []byte
+	ExtraData         []byte
+	DestGasAmount     uint32
+}
+
+func (d SourceTokenData) AbiString() string {
+	return `[{
+		"components": [
+			{"name": "sourcePoolAddress", "type": "bytes"},
+			{"name": "destTokenAddress", "type": "bytes"},
+			{"name": "extraData", "type": "bytes"},
+			{"name": "destGasAmount", "type": "uint32"}
+		],
+		"type": "tuple"
+	}]`
+}
+
+func (d SourceTokenData) Validate() error {
+	return nil
+}
+
+// DecodeSourceTokenData decodes the SourceTokenData of every token transfer of msg
+func DecodeSourceTokenData(t *testing.T, msg evm_2_evm_onramp.InternalEVM2EVMMessage) []SourceTokenData {
+	data := make([]SourceTokenData, len(msg.SourceTokenData))
+	for i, encoded := range msg.SourceTokenData {
+		decoded, err := abihelpers.DecodeAbiStruct[SourceTokenData](encoded)
+		require.NoError(t, err)
+		data[i] = decoded
+	}
+	return data
+}
+
+// RebasingTokenPoolDeployer deploys a BurnWithFromMintRebasingTokenPool on every chain. Its token is a BurnMintERC677
+// which Accrue rebases by minting the interest of the elapsed time to every holder, as the keeper of a rebasing token
+// would.
+type RebasingTokenPoolDeployer struct {
+	TokenName string
+	// InitialBalance is minted to the user of every chain
+	InitialBalance *big.Int
+	// InterestRateBps is the yearly interest of the holders of the token, in basis points
+	InterestRateBps int64
+}
+
+var _ InterestAccruer = RebasingTokenPoolDeployer{}
+
+func (d RebasingTokenPoolDeployer) Name() string {
+	return d.TokenName
+}
+
+func (d RebasingTokenPoolDeployer) Deploy(t *testing.T, chain *HarnessChain) (common.Address, common.Address) {
+	tokenAddress, _, token, err := burn_mint_erc677.DeployBurnMintERC677(chain.User, chain.Chain, d.TokenName, d.TokenName, TokenDecimals, big.NewInt(0))
+	require.NoError(t, err)
+	chain.Chain.Commit()
+	poolAddress, _, _, err := burn_with_from_mint_rebasing_token_pool.DeployBurnWithFromMintRebasingTokenPool(
+		chain.User,
+		chain.Chain,
+		tokenAddress,
+		[]common.Address{},
+		chain.ARMProxy.Address(),
+		chain.Router.Address(),
+	)
+	require.NoError(t, err)
+	_, err = token.GrantMintAndBurnRoles(chain.User, poolAddress)
+	require.NoError(t, err)
+	_, err = token.GrantMintAndBurnRoles(chain.User, chain.User.From)
+	require.NoError(t, err)
+	chain.Chain.Commit()
+	_, err = token.Mint(chain.User, chain.User.From, d.InitialBalance)
+	require.NoError(t, err)
+	return tokenAddress, poolAddress
+}
+
+func (d RebasingTokenPoolDeployer) ConnectRemotes(t *testing.T, chain *HarnessChain, remotes []*HarnessChain) {
+	pool, err := burn_with_from_mint_rebasing_token_pool.NewBurnWithFromMintRebasingTokenPool(chain.Tokens[d.TokenName].Pool, chain.Chain)
+	require.NoError(t, err)
+	var updates []burn_with_from_mint_rebasing_token_pool.TokenPoolChainUpdate
+	for _, remote := range remotes {
+		remoteToken := remote.Tokens[d.TokenName]
+		updates = append(updates, burn_with_from_mint_rebasing_token_pool.TokenPoolChainUpdate{
+			RemoteChainSelector: remote.ChainSelector,
+			Allowed:             true,
+			RemotePoolAddress:   MustEncodeAddress(t, remoteToken.Pool),
+			RemoteTokenAddress:  MustEncodeAddress(t, remoteToken.Token),
+			OutboundRateLimiterConfig: burn_with_from_mint_rebasing_token_pool.RateLimiterConfig{
+				Capacity: big.NewInt(0),
+				Rate:     big.NewInt(0),
+			},
+			InboundRateLimiterConfig: burn_with_from_mint_rebasing_token_pool.RateLimiterConfig{
+				Capacity: big.NewInt(0),
+				Rate:     big.NewInt(0),
+			},
+		})
+	}
+	_, err = pool.ApplyChainUpdates(chain.User, updates)
+	require.NoError(t, err)
+}
+
+// Accrue mints the interest of elapsed to every account which has received the token on chain
+func (d RebasingTokenPoolDeployer) Accrue(t *testing.T, chain *HarnessChain, elapsed time.Duration) {
+	token, err := burn_mint_erc677.NewBurnMintERC677(chain.Tokens[d.TokenName].Token, chain.Chain)
+	require.NoError(t, err)
+	it, err := token.FilterTransfer(&bind.FilterOpts{Context: context.Background()}, nil, nil)
+	require.NoError(t, err)
+	holders := make(map[common.Address]struct{})
+	for it.Next() {
+		holders[it.Event.To] = struct{}{}
+	}
+	require.NoError(t, it.Close())
+
+	year := big.NewInt(int64(365 * 24 * time.Hour / time.Second))
+	for holder := range holders {
+		balance, err := token.BalanceOf(&bind.CallOpts{Context: context.Background()}, holder)
+		require.NoError(t, err)
+		interest := new(big.Int).Mul(balance, big.NewInt(d.InterestRateBps))
+		interest.Mul(interest, big.NewInt(int64(elapsed/time.Second)))
+		interest.Div(interest, new(big.Int).Mul(year, big.NewInt(10_000)))
+		if interest.Sign() == 0 {
+			continue
+		}
+		_, err = token.Mint(chain.User, holder, interest)
+		require.NoError(t, err)
+	}
+}
+
+// RebaseTokenPoolDeployer deploys the RebaseToken and RebaseTokenPool of this repo on every chain, from the artifacts
+// of `forge build`. The token accrues the interest of every holder on chain as time passes, so it needs no Accrue. Tests
+// using it are skipped when the artifacts have not been built.
+type RebaseTokenPoolDeployer struct {
+	TokenName string
+	// ArtifactsDir is the foundry out directory, holding RebaseToken.sol/RebaseToken.json and
+	// RebaseTokenPool.sol/RebaseTokenPool.json
+	ArtifactsDir string
+	// InitialBalance is minted to the user of every chain, at the interest rate of the token
+	InitialBalance *big.Int
+}
+
+func (d RebaseTokenPoolDeployer) Name() string {
+	return d.TokenName
+}
+
+func (d RebaseTokenPoolDeployer) Deploy(t *testing.T, chain *HarnessChain) (common.Address, common.Address) {
+	tokenABI, tokenBin := LoadFoundryArtifact(t, d.ArtifactsDir, "RebaseToken")
+	poolABI, poolBin := LoadFoundryArtifact(t, d.ArtifactsDir, "RebaseTokenPool")
+
+	tokenAddress, _, token, err := bind.DeployContract(chain.User, tokenABI, tokenBin, chain.Chain)
+	require.NoError(t, err)
+	chain.Chain.Commit()
+	poolAddress, _, _, err := bind.DeployContract(chain.User, poolABI, poolBin, chain.Chain,
+		tokenAddress,
+		[]common.Address{},
+		chain.ARMProxy.Address(),
+		chain.Router.Address(),
+	)
+	require.NoError(t, err)
+	_, err = token.Transact(chain.User, "grantMintAndBurnRole", poolAddress)
+	require.NoError(t, err)
+	_, err = token.Transact(chain.User, "grantMintAndBurnRole", chain.User.From)
+	require.NoError(t, err)
+	chain.Chain.Commit()
+
+	var rate []interface{}
+	require.NoError(t, token.Call(&bind.CallOpts{Context: context.Background()}, &rate, "getInterestRate"))
+	_, err = token.Transact(chain.User, "mint", chain.User.From, d.InitialBalance, rate[0])
+	require.NoError(t, err)
+	return tokenAddress, poolAddress
+}
+
+// ConnectRemotes configures the remotes through the TokenPool interface the RebaseTokenPool inherits, which is the one
+// of the BurnMintTokenPool wrapper
+func (d RebaseTokenPoolDeployer) ConnectRemotes(t *testing.T, chain *HarnessChain, remotes []*HarnessChain) {
+	pool, err := burn_mint_token_pool.NewBurnMintTokenPool(chain.Tokens[d.TokenName].Pool, chain.Chain)
+	require.NoError(t, err)
+	var updates []burn_mint_token_pool.TokenPoolChainUpdate
+	for _, remote := range remotes {
+		updates = append(updates, burn_mint_token_pool.TokenPoolChainUpdate{
+			RemoteChainSelector: remote.ChainSelector,
+			RemotePoolAddresses: [][]byte{MustEncodeAddress(t, remote.Tokens[d.TokenName].Pool)},
+			RemoteTokenAddress:  MustEncodeAddress(t, remote.Tokens[d.TokenName].Token),
+			OutboundRateLimiterConfig: burn_mint_token_pool.RateLimiterConfig{
+				Capacity: big.NewInt(0),
+				Rate:     big.NewInt(0),
+			},
+			InboundRateLimiterConfig: burn_mint_token_pool.RateLimiterConfig{
+				Capacity: big.NewInt(0),
+				Rate:     big.NewInt(0),
+			},
+		})
+	}
+	_, err = pool.ApplyChainUpdates(chain.User, []uint64{}, updates)
+	require.NoError(t, err)
+}
+
+// UserInterestRate returns the interest rate of addr for the RebaseToken of chain
+func (d RebaseTokenPoolDeployer) UserInterestRate(t *testing.T, chain *HarnessChain, addr common.Address) *big.Int {
+	tokenABI, _ := LoadFoundryArtifact(t, d.ArtifactsDir, "RebaseToken")
+	token := bind.NewBoundContract(chain.Tokens[d.TokenName].Token, tokenABI, chain.Chain, chain.Chain, chain.Chain)
+	var rate []interface{}
+	require.NoError(t, token.Call(&bind.CallOpts{Context: context.Background()}, &rate, "getUserInterestRate", addr))
+	return rate[0].(*big.Int)
+}
+
+// LoadFoundryArtifact returns the ABI and bytecode of contract from the artifacts of `forge build` in dir, and skips the
+// test if they have not been built
+func LoadFoundryArtifact(t *testing.T, dir, contract string) (abi.ABI, []byte) {
+	path := filepath.Join(dir, contract+".sol", contract+".json")
+	bz, err := os.ReadFile(path)
+	if os.IsNotExist(err) {
+		t.Skipf("no artifact for %s at %s, run forge build", contract, path)
+	}
+	require.NoError(t, err)
+	var artifact struct {
+		ABI      json.RawMessage `json:"abi"`
+		Bytecode struct {
+			Object string `json:"object"`
+		} `json:"bytecode"`
+	}
+	require.NoError(t, json.Unmarshal(bz, &artifact))
+	parsed, err := abi.JSON(bytes.NewReader(artifact.ABI))
+	require.NoError(t, err)
+	bin, err := hexutil.Decode(artifact.Bytecode.Object)
+	require.NoError(t, err)
+	return parsed, bin
+}
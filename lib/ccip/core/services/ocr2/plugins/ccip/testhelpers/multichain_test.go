@@ -0,0 +1,203 @@
+package testhelpers
+
+import (
+	"math/big"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/utils"
+	"github.com/smartcontractkit/chainlink/v2/core/gethwrappers/ccip/generated/burn_mint_token_pool"
+	"github.com/smartcontractkit/chainlink/v2/core/gethwrappers/ccip/generated/evm_2_evm_offramp"
+	"github.com/smartcontractkit/chainlink/v2/core/gethwrappers/ccip/generated/router"
+	"github.com/smartcontractkit/chainlink/v2/core/gethwrappers/shared/generated/burn_mint_erc677"
+)
+
+// burnMintPoolDeployer plugs a BurnMintTokenPool into the harness, the way tests plug in their own pools
+type burnMintPoolDeployer struct {
+	name string
+}
+
+func (d burnMintPoolDeployer) Name() string {
+	return d.name
+}
+
+func (d burnMintPoolDeployer) Deploy(t *testing.T, chain *HarnessChain) (common.Address, common.Address) {
+	tokenAddress, _, token, err := burn_mint_erc677.DeployBurnMintERC677(chain.User, chain.Chain, d.name, d.name, TokenDecimals, big.NewInt(0))
+	require.NoError(t, err)
+	chain.Chain.Commit()
+	poolAddress, _, _, err := burn_mint_token_pool.DeployBurnMintTokenPool(chain.User, chain.Chain, tokenAddress, TokenDecimals, []common.Address{}, chain.ARMProxy.Address(), chain.Router.Address())
+	require.NoError(t, err)
+	_, err = token.GrantMintAndBurnRoles(chain.User, poolAddress)
+	require.NoError(t, err)
+	_, err = token.GrantMintAndBurnRoles(chain.User, chain.User.From)
+	require.NoError(t, err)
+	chain.Chain.Commit()
+	_, err = token.Mint(chain.User, chain.User.From, HundredLink)
+	require.NoError(t, err)
+	return tokenAddress, poolAddress
+}
+
+func (d burnMintPoolDeployer) ConnectRemotes(t *testing.T, chain *HarnessChain, remotes []*HarnessChain) {
+	pool, err := burn_mint_token_pool.NewBurnMintTokenPool(chain.Tokens[d.name].Pool, chain.Chain)
+	require.NoError(t, err)
+	var updates []burn_mint_token_pool.TokenPoolChainUpdate
+	for _, remote := range remotes {
+		updates = append(updates, burn_mint_token_pool.TokenPoolChainUpdate{
+			RemoteChainSelector: remote.ChainSelector,
+			RemotePoolAddresses: [][]byte{MustEncodeAddress(t, remote.Tokens[d.name].Pool)},
+			RemoteTokenAddress:  MustEncodeAddress(t, remote.Tokens[d.name].Token),
+			OutboundRateLimiterConfig: burn_mint_token_pool.RateLimiterConfig{
+				Capacity: big.NewInt(0),
+				Rate:     big.NewInt(0),
+			},
+			InboundRateLimiterConfig: burn_mint_token_pool.RateLimiterConfig{
+				Capacity: big.NewInt(0),
+				Rate:     big.NewInt(0),
+			},
+		})
+	}
+	_, err = pool.ApplyChainUpdates(chain.User, []uint64{}, updates)
+	require.NoError(t, err)
+}
+
+func transferMessage(t *testing.T, receiver, token common.Address, amount *big.Int) router.ClientEVM2AnyMessage {
+	extraArgs, err := GetEVMExtraArgsV2(big.NewInt(0), false)
+	require.NoError(t, err)
+	return router.ClientEVM2AnyMessage{
+		Receiver:     MustEncodeAddress(t, receiver),
+		TokenAmounts: []router.ClientEVMTokenAmount{{Token: token, Amount: amount}},
+		ExtraArgs:    extraArgs,
+	}
+}
+
+func requireExecuted(t *testing.T, changes []*evm_2_evm_offramp.EVM2EVMOffRampExecutionStateChanged, n int) {
+	require.Len(t, changes, n)
+	for _, change := range changes {
+		require.Equal(t, uint8(ExecutionStateSuccess), change.State, "message %d failed: %x", change.SequenceNumber, change.ReturnData)
+	}
+}
+
+// foundryOutDir returns the out directory of the foundry project the module is vendored in
+func foundryOutDir(t *testing.T) string {
+	dir, err := os.Getwd()
+	require.NoError(t, err)
+	for {
+		if _, err = os.Stat(filepath.Join(dir, "foundry.toml")); err == nil {
+			return filepath.Join(dir, "out")
+		}
+		parent := filepath.Dir(dir)
+		if parent == dir {
+			t.Skip("not in a foundry project")
+		}
+		dir = parent
+	}
+}
+
+func TestMultiChainHarness(t *testing.T) {
+	const rebasing, custom = "rebasing", "custom"
+	h := SetupMultiChainHarness(t, MultiChainHarnessConfig{
+		NumChains: 3,
+		Pools: []TokenPoolDeployer{
+			RebasingTokenPoolDeployer{TokenName: rebasing, InitialBalance: HundredLink, InterestRateBps: 1_000},
+			burnMintPoolDeployer{name: custom},
+		},
+		MaxTimeAdvance: 365 * 24 * time.Hour,
+	})
+	a, b, c := h.Chains[0], h.Chains[1], h.Chains[2]
+	receiver := utils.RandomAddress()
+
+	// rebasing tokens from a to b, custom tokens from b to c
+	msgAB := h.Send(t, a.ChainSelector, b.ChainSelector, transferMessage(t, receiver, a.Tokens[rebasing].Token, Link(10)))
+	msgBC := h.Send(t, b.ChainSelector, c.ChainSelector, transferMessage(t, receiver, b.Tokens[custom].Token, Link(5)))
+
+	t.Run("pool data", func(t *testing.T) {
+		data := DecodeSourceTokenData(t, msgAB)
+		require.Len(t, data, 1)
+		assert.Equal(t, MustEncodeAddress(t, a.Tokens[rebasing].Pool), data[0].SourcePoolAddress)
+		assert.Equal(t, MustEncodeAddress(t, b.Tokens[rebasing].Token), data[0].DestTokenAddress)
+		assert.Equal(t, uint32(DefaultTokenDestGasOverhead), data[0].DestGasAmount)
+
+		data = DecodeSourceTokenData(t, msgBC)
+		require.Len(t, data, 1)
+		assert.Equal(t, MustEncodeAddress(t, b.Tokens[custom].Pool), data[0].SourcePoolAddress)
+		assert.Equal(t, MustEncodeAddress(t, c.Tokens[custom].Token), data[0].DestTokenAddress)
+		// the pool passes its decimals to the dest pool
+		assert.Equal(t, common.BigToHash(big.NewInt(int64(TokenDecimals))).Bytes(), data[0].ExtraData)
+	})
+
+	changes := h.Deliver(t)
+	require.Len(t, changes, 2)
+	requireExecuted(t, changes[h.Lane(t, a.ChainSelector, b.ChainSelector)], 1)
+	requireExecuted(t, changes[h.Lane(t, b.ChainSelector, c.ChainSelector)], 1)
+
+	assert.Equal(t, Link(90), a.TokenBalance(t, rebasing, a.User.From))
+	assert.Equal(t, Link(10), b.TokenBalance(t, rebasing, receiver))
+	assert.Equal(t, Link(95), b.TokenBalance(t, custom, b.User.From))
+	assert.Equal(t, Link(5), c.TokenBalance(t, custom, receiver))
+	// burn and mint pools hold no tokens
+	assert.Zero(t, a.TokenBalance(t, rebasing, a.Tokens[rebasing].Pool).Sign())
+	assert.Zero(t, b.TokenBalance(t, custom, b.Tokens[custom].Pool).Sign())
+
+	// a year at 10% accrues 1 token on the 10 tokens received on b, the custom token does not rebase
+	h.AdvanceTime(t, 365*24*time.Hour)
+	assert.Equal(t, Link(11), b.TokenBalance(t, rebasing, receiver))
+	assert.Equal(t, Link(99), a.TokenBalance(t, rebasing, a.User.From))
+	assert.Equal(t, Link(5), c.TokenBalance(t, custom, receiver))
+
+	// the accrued tokens of the user of b are bridged to c
+	msgBC = h.Send(t, b.ChainSelector, c.ChainSelector, transferMessage(t, receiver, b.Tokens[rebasing].Token, Link(110)))
+	assert.Equal(t, MustEncodeAddress(t, c.Tokens[rebasing].Token), DecodeSourceTokenData(t, msgBC)[0].DestTokenAddress)
+	lane := h.Lane(t, b.ChainSelector, c.ChainSelector)
+	report := lane.Commit(t)
+	assert.Equal(t, msgBC.SequenceNumber, report.Interval.Min)
+	assert.Equal(t, msgBC.SequenceNumber, report.Interval.Max)
+	requireExecuted(t, lane.Execute(t), 1)
+
+	assert.Zero(t, b.TokenBalance(t, rebasing, b.User.From).Sign())
+	assert.Equal(t, Link(110), c.TokenBalance(t, rebasing, receiver))
+}
+
+func TestMultiChainHarness_RebaseTokenPool(t *testing.T) {
+	const rebase = "rebase"
+	deployer := RebaseTokenPoolDeployer{TokenName: rebase, ArtifactsDir: foundryOutDir(t), InitialBalance: HundredLink}
+	h := SetupMultiChainHarness(t, MultiChainHarnessConfig{
+		NumChains:      2,
+		Pools:          []TokenPoolDeployer{deployer},
+		MaxTimeAdvance: 365 * 24 * time.Hour,
+	})
+	a, b := h.Chains[0], h.Chains[1]
+	receiver := utils.RandomAddress()
+
+	msg := h.Send(t, a.ChainSelector, b.ChainSelector, transferMessage(t, receiver, a.Tokens[rebase].Token, Link(10)))
+	// the source pool passes the interest rate of the sender to the dest pool
+	rate := deployer.UserInterestRate(t, a, a.User.From)
+	require.Positive(t, rate.Sign())
+	data := DecodeSourceTokenData(t, msg)
+	require.Len(t, data, 1)
+	assert.Equal(t, MustEncodeAddress(t, a.Tokens[rebase].Pool), data[0].SourcePoolAddress)
+	assert.Equal(t, MustEncodeAddress(t, b.Tokens[rebase].Token), data[0].DestTokenAddress)
+	assert.Equal(t, common.BigToHash(rate).Bytes(), data[0].ExtraData)
+
+	changes := h.Deliver(t)
+	requireExecuted(t, changes[h.Lane(t, a.ChainSelector, b.ChainSelector)], 1)
+	assert.Equal(t, rate, deployer.UserInterestRate(t, b, receiver))
+	assert.Equal(t, Link(10), b.TokenBalance(t, rebase, receiver))
+	assert.Zero(t, a.TokenBalance(t, rebase, a.Tokens[rebase].Pool).Sign())
+
+	// the token accrues the interest of the receiver on chain, rate per second over a year
+	h.AdvanceTime(t, 365*24*time.Hour)
+	expected := new(big.Int).Mul(rate, big.NewInt(int64(365*24*time.Hour/time.Second)))
+	expected.Add(expected, big.NewInt(1e18))
+	expected.Mul(expected, Link(10))
+	expected.Div(expected, big.NewInt(1e18))
+	balance := b.TokenBalance(t, rebase, receiver)
+	assert.GreaterOrEqual(t, balance.Cmp(expected), 0, "balance %s, expected at least %s", balance, expected)
+	// blocks committed after the time advance only add seconds of interest
+	assert.Negative(t, balance.Cmp(new(big.Int).Add(expected, Link(1))), "balance %s, expected about %s", balance, expected)
+}
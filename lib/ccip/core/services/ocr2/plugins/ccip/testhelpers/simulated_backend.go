@@ -22,6 +22,12 @@ import (
 const FirstBlockAge = 24 * time.Hour
 
 func SetupChain(t *testing.T) (*backends.SimulatedBackend, *bind.TransactOpts) {
+	return SetupChainWithFirstBlockAge(t, FirstBlockAge)
+}
+
+// SetupChainWithFirstBlockAge is SetupChain with the first block firstBlockAge in the past. Tests which advance the clock
+// of the chain with AdjustTime need an older first block, so that the advanced blocks are not in the future.
+func SetupChainWithFirstBlockAge(t *testing.T, firstBlockAge time.Duration) (*backends.SimulatedBackend, *bind.TransactOpts) {
 	key, err := crypto.GenerateKey()
 	require.NoError(t, err)
 	user, err := bind.NewKeyedTransactorWithChainID(key, big.NewInt(1337))
@@ -35,7 +41,7 @@ func SetupChain(t *testing.T) (*backends.SimulatedBackend, *bind.TransactOpts) {
 	// if you mine more than "X hours" transactions, SimulatedBackend will panic because generated timestamps will be in the future.
 	// IMPORTANT: Any adjustments to FirstBlockAge will automatically update PermissionLessExecutionThresholdSeconds in tests
 	blockTime := time.UnixMilli(int64(chain.Blockchain().CurrentHeader().Time))
-	err = chain.AdjustTime(time.Since(blockTime) - FirstBlockAge)
+	err = chain.AdjustTime(time.Since(blockTime) - firstBlockAge)
 	require.NoError(t, err)
 	chain.Commit()
 	return chain, user
@@ -668,7 +668,7 @@ func (c *CCIPIntegrationTestHarness) ApproveJobSpecs(t *testing.T, jobParams int
 			utils.RandomAddress().String(),
 			utils.RandomAddress().String(),
 		)
-		execId, err := f.ProposeJob(ctx, &execSpec)
+		execId, _, err := f.ProposeJob(ctx, &execSpec)
 		require.NoError(t, err)
 
 		err = f.ApproveSpec(ctx, execId, true)
@@ -701,7 +701,7 @@ func (c *CCIPIntegrationTestHarness) ApproveJobSpecs(t *testing.T, jobParams int
 			)
 		}
 
-		commitId, err := f.ProposeJob(ctx, &commitSpec)
+		commitId, _, err := f.ProposeJob(ctx, &commitSpec)
 		require.NoError(t, err)
 
 		err = f.ApproveSpec(ctx, commitId, true)
@@ -48,6 +48,8 @@ type PluginConfig struct {
 	OnchainSubscriptions                     *subscriptions.OnchainSubscriptionsConfig `json:"onchainSubscriptions"`
 	RateLimiter                              *common.RateLimiterConfig                 `json:"rateLimiter"`
 	S4Constraints                            *s4.Constraints                           `json:"s4Constraints"`
+	S4ObjectStore                            *s4.ObjectStoreConfig                     `json:"s4ObjectStore"`
+	S4ExpirySweeper                          *s4.ExpirySweeperConfig                   `json:"s4ExpirySweeper"`
 	DecryptionQueueConfig                    *DecryptionQueueConfig                    `json:"decryptionQueueConfig"`
 	ExternalAdapterMaxRetries                *uint32                                   `json:"externalAdapterMaxRetries"`
 	ExternalAdapterExponentialBackoffBaseSec *uint32                                   `json:"externalAdapterExponentialBackoffBaseSec"`
@@ -79,6 +81,14 @@ func ValidatePluginConfig(config PluginConfig) error {
 			return errors.New("missing or invalid decryptionQueueConfig decryptRequestTimeoutSec")
 		}
 	}
+	if config.S4ObjectStore != nil {
+		if err := config.S4ObjectStore.Validate(); err != nil {
+			return fmt.Errorf("invalid s4ObjectStore: %w", err)
+		}
+	}
+	if config.S4ExpirySweeper != nil && config.S4ExpirySweeper.IntervalSec == 0 {
+		return errors.New("missing or invalid s4ExpirySweeper intervalSec")
+	}
 	return nil
 }
 
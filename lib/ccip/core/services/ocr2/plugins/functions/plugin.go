@@ -63,7 +63,6 @@ const (
 // Create all OCR2 plugin Oracles and all extra services needed to run a Functions job.
 func NewFunctionsServices(ctx context.Context, functionsOracleArgs, thresholdOracleArgs, s4OracleArgs *libocr2.OCR2OracleArgs, conf *FunctionsServicesConfig) ([]job.ServiceCtx, error) {
 	pluginORM := functions.NewORM(conf.DS, common.HexToAddress(conf.ContractID))
-	s4ORM := s4.NewCachedORMWrapper(s4.NewPostgresORM(conf.DS, s4.SharedTableName, FunctionsS4Namespace), conf.Logger)
 
 	var pluginConfig config.PluginConfig
 	if err := json.Unmarshal(conf.Job.OCR2OracleSpec.PluginConfig.Bytes(), &pluginConfig); err != nil {
@@ -75,6 +74,26 @@ func NewFunctionsServices(ctx context.Context, functionsOracleArgs, thresholdOra
 
 	allServices := []job.ServiceCtx{}
 
+	var s4PostgresORM s4.ORM
+	if pluginConfig.S4ObjectStore != nil {
+		objectStore, err := s4.NewObjectStore(*pluginConfig.S4ObjectStore)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to create S4 object store")
+		}
+		s4PostgresORM = s4.NewPostgresObjectStoreORM(conf.DS, s4.SharedTableName, FunctionsS4Namespace, objectStore)
+	} else {
+		s4PostgresORM = s4.NewPostgresORM(conf.DS, s4.SharedTableName, FunctionsS4Namespace)
+	}
+	s4ORM := s4.NewCachedORMWrapper(s4PostgresORM, conf.Logger)
+
+	if pluginConfig.S4ExpirySweeper != nil {
+		sweeper, err := s4.NewExpirySweeper(conf.Logger, *pluginConfig.S4ExpirySweeper, s4ORM, clockwork.NewRealClock(), FunctionsS4Namespace)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to create S4 expiry sweeper")
+		}
+		allServices = append(allServices, sweeper)
+	}
+
 	var decryptor threshold.Decryptor
 	// thresholdOracleArgs nil check will be removed once the Threshold plugin is fully integrated w/ Functions
 	if len(conf.ThresholdKeyShare) > 0 && thresholdOracleArgs != nil && pluginConfig.DecryptionQueueConfig != nil {
@@ -0,0 +1,56 @@
+package p2p
+
+import (
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/sha512"
+	"errors"
+	"fmt"
+
+	"filippo.io/edwards25519"
+	"golang.org/x/crypto/curve25519"
+	"golang.org/x/crypto/nacl/box"
+
+	p2ptypes "github.com/smartcontractkit/chainlink/v2/core/services/p2p/types"
+)
+
+// Encrypt seals msg anonymously to the peer, using the X25519 counterpart of the ed25519 public key of its peer ID,
+// so that the peer can decrypt it with its p2p key.
+func Encrypt(recipient p2ptypes.PeerID, msg []byte) ([]byte, error) {
+	point, err := new(edwards25519.Point).SetBytes(recipient[:])
+	if err != nil {
+		return nil, fmt.Errorf("invalid public key of peer %s: %w", recipient, err)
+	}
+
+	var publicKey [32]byte
+	copy(publicKey[:], point.BytesMontgomery())
+	return box.SealAnonymous(nil, msg, &publicKey, rand.Reader)
+}
+
+// Decrypt opens a message sealed by Encrypt to the peer ID of privateKey.
+func Decrypt(privateKey ed25519.PrivateKey, sealed []byte) ([]byte, error) {
+	if len(privateKey) != ed25519.PrivateKeySize {
+		return nil, errors.New("invalid private key")
+	}
+
+	// The X25519 private key is the clamped scalar of the ed25519 key, see RFC 8032 section 5.1.5.
+	h := sha512.Sum512(privateKey.Seed())
+	var scalar [32]byte
+	copy(scalar[:], h[:32])
+	scalar[0] &= 248
+	scalar[31] &= 127
+	scalar[31] |= 64
+
+	pub, err := curve25519.X25519(scalar[:], curve25519.Basepoint)
+	if err != nil {
+		return nil, fmt.Errorf("failed to derive public key: %w", err)
+	}
+	var publicKey [32]byte
+	copy(publicKey[:], pub)
+
+	msg, ok := box.OpenAnonymous(nil, sealed, &publicKey, &scalar)
+	if !ok {
+		return nil, errors.New("failed to decrypt message")
+	}
+	return msg, nil
+}
@@ -0,0 +1,37 @@
+package p2p_test
+
+import (
+	"crypto/ed25519"
+	"crypto/rand"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/v2/core/services/p2p"
+	p2ptypes "github.com/smartcontractkit/chainlink/v2/core/services/p2p/types"
+)
+
+func TestEncryptDecrypt(t *testing.T) {
+	pub, priv, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+	var peerID p2ptypes.PeerID
+	copy(peerID[:], pub)
+
+	sealed, err := p2p.Encrypt(peerID, []byte("hello"))
+	require.NoError(t, err)
+	assert.NotContains(t, string(sealed), "hello")
+
+	msg, err := p2p.Decrypt(priv, sealed)
+	require.NoError(t, err)
+	assert.Equal(t, []byte("hello"), msg)
+
+	_, otherPriv, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+	_, err = p2p.Decrypt(otherPriv, sealed)
+	require.Error(t, err)
+
+	sealed[len(sealed)-1] ^= 1
+	_, err = p2p.Decrypt(priv, sealed)
+	require.Error(t, err)
+}
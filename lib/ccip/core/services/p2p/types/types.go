@@ -28,6 +28,10 @@ type Signer interface {
 	Sign(data []byte) ([]byte, error)
 }
 
+type Decrypter interface {
+	Decrypt(ciphertext []byte) ([]byte, error)
+}
+
 type Message struct {
 	Sender  PeerID
 	Payload []byte
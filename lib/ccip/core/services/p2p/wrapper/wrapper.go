@@ -31,6 +31,7 @@ type peerWrapper struct {
 
 var _ types.PeerWrapper = &peerWrapper{}
 var _ types.Signer = &peerWrapper{}
+var _ types.Decrypter = &peerWrapper{}
 
 func NewExternalPeerWrapper(keystoreP2P keystore.P2P, p2pConfig config.P2P, ds sqlutil.DataSource, lggr logger.Logger) *peerWrapper {
 	return &peerWrapper{
@@ -135,3 +136,10 @@ func (e *peerWrapper) Sign(msg []byte) ([]byte, error) {
 	}
 	return ed25519.Sign(e.privateKey, msg), nil
 }
+
+func (e *peerWrapper) Decrypt(ciphertext []byte) ([]byte, error) {
+	if e.privateKey == nil {
+		return nil, fmt.Errorf("private key not set")
+	}
+	return p2p.Decrypt(e.privateKey, ciphertext)
+}
@@ -295,38 +295,41 @@ func (t TaskType) String() string {
 }
 
 const (
-	TaskTypeAny              TaskType = "any"
-	TaskTypeBase64Decode     TaskType = "base64decode"
-	TaskTypeBase64Encode     TaskType = "base64encode"
-	TaskTypeBridge           TaskType = "bridge"
-	TaskTypeCBORParse        TaskType = "cborparse"
-	TaskTypeConditional      TaskType = "conditional"
-	TaskTypeDivide           TaskType = "divide"
-	TaskTypeETHABIDecode     TaskType = "ethabidecode"
-	TaskTypeETHABIDecodeLog  TaskType = "ethabidecodelog"
-	TaskTypeETHABIEncode     TaskType = "ethabiencode"
-	TaskTypeETHABIEncode2    TaskType = "ethabiencode2"
-	TaskTypeETHCall          TaskType = "ethcall"
-	TaskTypeETHTx            TaskType = "ethtx"
-	TaskTypeEstimateGasLimit TaskType = "estimategaslimit"
-	TaskTypeHTTP             TaskType = "http"
-	TaskTypeHexDecode        TaskType = "hexdecode"
-	TaskTypeHexEncode        TaskType = "hexencode"
-	TaskTypeJSONParse        TaskType = "jsonparse"
-	TaskTypeLength           TaskType = "length"
-	TaskTypeLessThan         TaskType = "lessthan"
-	TaskTypeLookup           TaskType = "lookup"
-	TaskTypeLowercase        TaskType = "lowercase"
-	TaskTypeMean             TaskType = "mean"
-	TaskTypeMedian           TaskType = "median"
-	TaskTypeMerge            TaskType = "merge"
-	TaskTypeMode             TaskType = "mode"
-	TaskTypeMultiply         TaskType = "multiply"
-	TaskTypeSum              TaskType = "sum"
-	TaskTypeUppercase        TaskType = "uppercase"
-	TaskTypeVRF              TaskType = "vrf"
-	TaskTypeVRFV2            TaskType = "vrfv2"
-	TaskTypeVRFV2Plus        TaskType = "vrfv2plus"
+	TaskTypeAny               TaskType = "any"
+	TaskTypeBase64Decode      TaskType = "base64decode"
+	TaskTypeBase64Encode      TaskType = "base64encode"
+	TaskTypeBridge            TaskType = "bridge"
+	TaskTypeCBORParse         TaskType = "cborparse"
+	TaskTypeCCIPGetFee        TaskType = "ccipgetfee"
+	TaskTypeCCIPMessageStatus TaskType = "ccipmessagestatus"
+	TaskTypeCCIPSend          TaskType = "ccipsend"
+	TaskTypeConditional       TaskType = "conditional"
+	TaskTypeDivide            TaskType = "divide"
+	TaskTypeETHABIDecode      TaskType = "ethabidecode"
+	TaskTypeETHABIDecodeLog   TaskType = "ethabidecodelog"
+	TaskTypeETHABIEncode      TaskType = "ethabiencode"
+	TaskTypeETHABIEncode2     TaskType = "ethabiencode2"
+	TaskTypeETHCall           TaskType = "ethcall"
+	TaskTypeETHTx             TaskType = "ethtx"
+	TaskTypeEstimateGasLimit  TaskType = "estimategaslimit"
+	TaskTypeHTTP              TaskType = "http"
+	TaskTypeHexDecode         TaskType = "hexdecode"
+	TaskTypeHexEncode         TaskType = "hexencode"
+	TaskTypeJSONParse         TaskType = "jsonparse"
+	TaskTypeLength            TaskType = "length"
+	TaskTypeLessThan          TaskType = "lessthan"
+	TaskTypeLookup            TaskType = "lookup"
+	TaskTypeLowercase         TaskType = "lowercase"
+	TaskTypeMean              TaskType = "mean"
+	TaskTypeMedian            TaskType = "median"
+	TaskTypeMerge             TaskType = "merge"
+	TaskTypeMode              TaskType = "mode"
+	TaskTypeMultiply          TaskType = "multiply"
+	TaskTypeSum               TaskType = "sum"
+	TaskTypeUppercase         TaskType = "uppercase"
+	TaskTypeVRF               TaskType = "vrf"
+	TaskTypeVRFV2             TaskType = "vrfv2"
+	TaskTypeVRFV2Plus         TaskType = "vrfv2plus"
 
 	// Testing only.
 	TaskTypePanic TaskType = "panic"
@@ -391,6 +394,12 @@ func UnmarshalTaskFromMap(taskType TaskType, taskMap interface{}, ID int, dotID
 		task = &ETHCallTask{BaseTask: BaseTask{id: ID, dotID: dotID}}
 	case TaskTypeETHTx:
 		task = &ETHTxTask{BaseTask: BaseTask{id: ID, dotID: dotID}}
+	case TaskTypeCCIPGetFee:
+		task = &CCIPGetFeeTask{BaseTask: BaseTask{id: ID, dotID: dotID}}
+	case TaskTypeCCIPSend:
+		task = &CCIPSendTask{BaseTask: BaseTask{id: ID, dotID: dotID}}
+	case TaskTypeCCIPMessageStatus:
+		task = &CCIPMessageStatusTask{BaseTask: BaseTask{id: ID, dotID: dotID}}
 	case TaskTypeETHABIEncode:
 		task = &ETHABIEncodeTask{BaseTask: BaseTask{id: ID, dotID: dotID}}
 	case TaskTypeETHABIEncode2:
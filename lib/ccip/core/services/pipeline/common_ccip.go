@@ -0,0 +1,131 @@
+package pipeline
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/pkg/errors"
+	"go.uber.org/multierr"
+
+	evmclient "github.com/smartcontractkit/chainlink/v2/core/chains/evm/client"
+	"github.com/smartcontractkit/chainlink/v2/core/gethwrappers/ccip/generated/router"
+	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ccip/abihelpers"
+)
+
+var routerABI = abihelpers.MustParseABI(router.RouterMetaData.ABI)
+
+// ccipMessageParams holds the unresolved task params of a Client.EVM2AnyMessage.
+type ccipMessageParams struct {
+	router            string
+	destChainSelector string
+	receiver          string
+	data              string
+	tokenAmounts      string
+	feeToken          string
+	extraArgs         string
+}
+
+type ccipMessage struct {
+	router            common.Address
+	destChainSelector uint64
+	message           router.ClientEVM2AnyMessage
+}
+
+func (p ccipMessageParams) resolve(vars Vars) (ccipMessage, error) {
+	var (
+		routerAddr        AddressParam
+		destChainSelector Uint64Param
+		receiver          AddressParam
+		data              BytesParam
+		tokenAmounts      SliceParam
+		feeToken          AddressParam
+		extraArgs         BytesParam
+	)
+	err := multierr.Combine(
+		errors.Wrap(ResolveParam(&routerAddr, From(VarExpr(p.router, vars), NonemptyString(p.router))), "router"),
+		errors.Wrap(ResolveParam(&destChainSelector, From(VarExpr(p.destChainSelector, vars), NonemptyString(p.destChainSelector))), "destChainSelector"),
+		errors.Wrap(ResolveParam(&receiver, From(VarExpr(p.receiver, vars), NonemptyString(p.receiver))), "receiver"),
+		errors.Wrap(ResolveParam(&data, From(VarExpr(p.data, vars), NonemptyString(p.data), []byte{})), "data"),
+		errors.Wrap(ResolveParam(&tokenAmounts, From(VarExpr(p.tokenAmounts, vars), JSONWithVarExprs(p.tokenAmounts, vars, false), nil)), "tokenAmounts"),
+		errors.Wrap(ResolveParam(&feeToken, From(VarExpr(p.feeToken, vars), NonemptyString(p.feeToken), common.Address{})), "feeToken"),
+		errors.Wrap(ResolveParam(&extraArgs, From(VarExpr(p.extraArgs, vars), NonemptyString(p.extraArgs), []byte{})), "extraArgs"),
+	)
+	if err != nil {
+		return ccipMessage{}, err
+	}
+
+	evmTokenAmounts, err := decodeCCIPTokenAmounts(tokenAmounts)
+	if err != nil {
+		return ccipMessage{}, errors.Wrap(err, "tokenAmounts")
+	}
+
+	return ccipMessage{
+		router:            common.Address(routerAddr),
+		destChainSelector: uint64(destChainSelector),
+		message: router.ClientEVM2AnyMessage{
+			// EVM receivers are abi encoded
+			Receiver:     common.LeftPadBytes(receiver[:], 32),
+			Data:         data,
+			TokenAmounts: evmTokenAmounts,
+			FeeToken:     common.Address(feeToken),
+			ExtraArgs:    extraArgs,
+		},
+	}, nil
+}
+
+func decodeCCIPTokenAmounts(tokenAmounts SliceParam) ([]router.ClientEVMTokenAmount, error) {
+	evmTokenAmounts := make([]router.ClientEVMTokenAmount, 0, len(tokenAmounts))
+	for i, item := range tokenAmounts {
+		var tokenAmount MapParam
+		if err := tokenAmount.UnmarshalPipelineParam(item); err != nil {
+			return nil, errors.Wrapf(err, "token amount %d", i)
+		}
+
+		var (
+			token  AddressParam
+			amount MaybeBigIntParam
+		)
+		err := multierr.Combine(
+			errors.Wrap(token.UnmarshalPipelineParam(tokenAmount["token"]), "token"),
+			errors.Wrap(amount.UnmarshalPipelineParam(tokenAmount["amount"]), "amount"),
+		)
+		if err != nil {
+			return nil, errors.Wrapf(err, "token amount %d", i)
+		}
+		if amount.BigInt() == nil || amount.BigInt().Sign() <= 0 {
+			return nil, errors.Wrapf(ErrBadInput, "token amount %d: amount must be positive", i)
+		}
+
+		evmTokenAmounts = append(evmTokenAmounts, router.ClientEVMTokenAmount{
+			Token:  common.Address(token),
+			Amount: amount.BigInt(),
+		})
+	}
+	return evmTokenAmounts, nil
+}
+
+// getCCIPFee returns the fee charged by the Router for the message, denominated in the message fee token.
+func getCCIPFee(ctx context.Context, client evmclient.Client, msg ccipMessage) (*big.Int, error) {
+	payload, err := routerABI.Pack("getFee", msg.destChainSelector, msg.message)
+	if err != nil {
+		return nil, errors.Wrapf(ErrBadInput, "packing getFee: %v", err)
+	}
+
+	resp, err := client.CallContract(ctx, ethereum.CallMsg{To: &msg.router, Data: payload}, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	out, err := routerABI.Unpack("getFee", resp)
+	if err != nil {
+		return nil, fmt.Errorf("unpacking getFee: %w", err)
+	}
+	fee, ok := out[0].(*big.Int)
+	if !ok {
+		return nil, fmt.Errorf("unexpected getFee result type %T", out[0])
+	}
+	return fee, nil
+}
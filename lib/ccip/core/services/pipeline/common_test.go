@@ -158,6 +158,9 @@ func TestUnmarshalTaskFromMap(t *testing.T) {
 		{pipeline.TaskTypeEstimateGasLimit, &pipeline.EstimateGasLimitTask{}},
 		{pipeline.TaskTypeETHCall, &pipeline.ETHCallTask{}},
 		{pipeline.TaskTypeETHTx, &pipeline.ETHTxTask{}},
+		{pipeline.TaskTypeCCIPGetFee, &pipeline.CCIPGetFeeTask{}},
+		{pipeline.TaskTypeCCIPSend, &pipeline.CCIPSendTask{}},
+		{pipeline.TaskTypeCCIPMessageStatus, &pipeline.CCIPMessageStatusTask{}},
 		{pipeline.TaskTypeETHABIEncode, &pipeline.ETHABIEncodeTask{}},
 		{pipeline.TaskTypeETHABIEncode2, &pipeline.ETHABIEncodeTask2{}},
 		{pipeline.TaskTypeETHABIDecode, &pipeline.ETHABIDecodeTask{}},
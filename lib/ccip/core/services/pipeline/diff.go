@@ -0,0 +1,129 @@
+package pipeline
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// PipelineDiff is the difference between two pipeline DAGs, tasks are identified by their DOT ID.
+type PipelineDiff struct {
+	AddedTasks   []string   `json:"addedTasks"`
+	RemovedTasks []string   `json:"removedTasks"`
+	ChangedTasks []TaskDiff `json:"changedTasks"`
+	AddedEdges   []string   `json:"addedEdges"`
+	RemovedEdges []string   `json:"removedEdges"`
+}
+
+// TaskDiff lists the changed attributes of a task present in both pipelines.
+type TaskDiff struct {
+	DotID      string            `json:"dotId"`
+	Attributes []AttributeChange `json:"attributes"`
+}
+
+// AttributeChange is a changed task attribute. Old is empty for added attributes and New is empty for removed ones.
+type AttributeChange struct {
+	Key string `json:"key"`
+	Old string `json:"old"`
+	New string `json:"new"`
+}
+
+func (d PipelineDiff) IsEmpty() bool {
+	return len(d.AddedTasks) == 0 && len(d.RemovedTasks) == 0 && len(d.ChangedTasks) == 0 &&
+		len(d.AddedEdges) == 0 && len(d.RemovedEdges) == 0
+}
+
+// DiffPipelines compares two DOT DAG sources. Only explicit edges are compared, implicit edges
+// are derived from task attributes and are reported as attribute changes instead.
+func DiffPipelines(current, proposed string) (PipelineDiff, error) {
+	currentNodes, currentEdges, err := parseForDiff(current)
+	if err != nil {
+		return PipelineDiff{}, fmt.Errorf("current pipeline: %w", err)
+	}
+	proposedNodes, proposedEdges, err := parseForDiff(proposed)
+	if err != nil {
+		return PipelineDiff{}, fmt.Errorf("proposed pipeline: %w", err)
+	}
+
+	var d PipelineDiff
+	for dotID, attrs := range proposedNodes {
+		currentAttrs, ok := currentNodes[dotID]
+		if !ok {
+			d.AddedTasks = append(d.AddedTasks, dotID)
+			continue
+		}
+		if changes := diffAttributes(currentAttrs, attrs); len(changes) > 0 {
+			d.ChangedTasks = append(d.ChangedTasks, TaskDiff{DotID: dotID, Attributes: changes})
+		}
+	}
+	for dotID := range currentNodes {
+		if _, ok := proposedNodes[dotID]; !ok {
+			d.RemovedTasks = append(d.RemovedTasks, dotID)
+		}
+	}
+	for edge := range proposedEdges {
+		if _, ok := currentEdges[edge]; !ok {
+			d.AddedEdges = append(d.AddedEdges, edge)
+		}
+	}
+	for edge := range currentEdges {
+		if _, ok := proposedEdges[edge]; !ok {
+			d.RemovedEdges = append(d.RemovedEdges, edge)
+		}
+	}
+
+	sort.Strings(d.AddedTasks)
+	sort.Strings(d.RemovedTasks)
+	sort.Strings(d.AddedEdges)
+	sort.Strings(d.RemovedEdges)
+	sort.Slice(d.ChangedTasks, func(i, j int) bool {
+		return d.ChangedTasks[i].DotID < d.ChangedTasks[j].DotID
+	})
+	return d, nil
+}
+
+// parseForDiff returns the node attributes by DOT ID, and the set of explicit edges of a DOT DAG source.
+func parseForDiff(source string) (map[string]map[string]string, map[string]struct{}, error) {
+	nodes := make(map[string]map[string]string)
+	edges := make(map[string]struct{})
+	if strings.TrimSpace(source) == "" {
+		return nodes, edges, nil
+	}
+
+	g := NewGraph()
+	if err := g.UnmarshalText([]byte(source)); err != nil {
+		return nil, nil, err
+	}
+	for it := g.Nodes(); it.Next(); {
+		node := it.Node().(*GraphNode)
+		nodes[node.DOTID()] = node.attrs
+	}
+	for it := g.Edges(); it.Next(); {
+		edge := it.Edge().(*GraphEdge)
+		if edge.IsImplicit() {
+			continue
+		}
+		from := edge.From().(*GraphNode)
+		to := edge.To().(*GraphNode)
+		edges[from.DOTID()+" -> "+to.DOTID()] = struct{}{}
+	}
+	return nodes, edges, nil
+}
+
+func diffAttributes(current, proposed map[string]string) []AttributeChange {
+	var changes []AttributeChange
+	for k, v := range proposed {
+		if old, ok := current[k]; !ok || old != v {
+			changes = append(changes, AttributeChange{Key: k, Old: old, New: v})
+		}
+	}
+	for k, v := range current {
+		if _, ok := proposed[k]; !ok {
+			changes = append(changes, AttributeChange{Key: k, Old: v})
+		}
+	}
+	sort.Slice(changes, func(i, j int) bool {
+		return changes[i].Key < changes[j].Key
+	})
+	return changes
+}
@@ -0,0 +1,86 @@
+package pipeline_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/v2/core/services/pipeline"
+)
+
+func TestDiffPipelines(t *testing.T) {
+	t.Parallel()
+
+	current := `
+ds1          [type=http method=GET url="https://example.com/a"];
+ds1_parse    [type=jsonparse path="USD"];
+ds2          [type=http method=GET url="https://example.com/b"];
+ds2_parse    [type=jsonparse path="USD"];
+answer       [type=median];
+
+ds1 -> ds1_parse -> answer;
+ds2 -> ds2_parse -> answer;
+`
+
+	t.Run("no changes", func(t *testing.T) {
+		d, err := pipeline.DiffPipelines(current, current)
+		require.NoError(t, err)
+		assert.True(t, d.IsEmpty())
+	})
+
+	t.Run("changed, added and removed tasks and edges", func(t *testing.T) {
+		proposed := `
+ds1          [type=http method=GET url="https://example.com/a2"];
+ds1_parse    [type=jsonparse path="USD" lax=true];
+ds3          [type=bridge name="foo"];
+ds3_parse    [type=jsonparse path="data,result"];
+answer       [type=median];
+
+ds1 -> ds1_parse -> answer;
+ds3 -> ds3_parse -> answer;
+`
+		d, err := pipeline.DiffPipelines(current, proposed)
+		require.NoError(t, err)
+		assert.False(t, d.IsEmpty())
+		assert.Equal(t, []string{"ds3", "ds3_parse"}, d.AddedTasks)
+		assert.Equal(t, []string{"ds2", "ds2_parse"}, d.RemovedTasks)
+		assert.Equal(t, []string{"ds3 -> ds3_parse", "ds3_parse -> answer"}, d.AddedEdges)
+		assert.Equal(t, []string{"ds2 -> ds2_parse", "ds2_parse -> answer"}, d.RemovedEdges)
+		assert.Equal(t, []pipeline.TaskDiff{
+			{DotID: "ds1", Attributes: []pipeline.AttributeChange{{Key: "url", Old: "https://example.com/a", New: "https://example.com/a2"}}},
+			{DotID: "ds1_parse", Attributes: []pipeline.AttributeChange{{Key: "lax", New: "true"}}},
+		}, d.ChangedTasks)
+	})
+
+	t.Run("ignores implicit edges", func(t *testing.T) {
+		proposed := `
+ds1          [type=http method=GET url="https://example.com/a"];
+ds1_parse    [type=jsonparse path="USD" data="$(ds2)"];
+ds2          [type=http method=GET url="https://example.com/b"];
+ds2_parse    [type=jsonparse path="USD"];
+answer       [type=median];
+
+ds1 -> ds1_parse -> answer;
+ds2 -> ds2_parse -> answer;
+`
+		d, err := pipeline.DiffPipelines(current, proposed)
+		require.NoError(t, err)
+		assert.Empty(t, d.AddedEdges)
+		assert.Equal(t, []pipeline.TaskDiff{
+			{DotID: "ds1_parse", Attributes: []pipeline.AttributeChange{{Key: "data", New: "$(ds2)"}}},
+		}, d.ChangedTasks)
+	})
+
+	t.Run("empty pipelines", func(t *testing.T) {
+		d, err := pipeline.DiffPipelines("", current)
+		require.NoError(t, err)
+		assert.Len(t, d.AddedTasks, 5)
+		assert.Len(t, d.AddedEdges, 4)
+	})
+
+	t.Run("invalid pipeline", func(t *testing.T) {
+		_, err := pipeline.DiffPipelines(current, "ds1 -> [")
+		require.ErrorContains(t, err, "proposed pipeline")
+	})
+}
@@ -0,0 +1,182 @@
+package pipeline
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/pkg/errors"
+
+	evmclient "github.com/smartcontractkit/chainlink/v2/core/chains/evm/client"
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/txmgr"
+	evmtypes "github.com/smartcontractkit/chainlink/v2/core/chains/evm/types"
+	"github.com/smartcontractkit/chainlink/v2/core/gethwrappers/generated/authorized_forwarder"
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+)
+
+var (
+	// ErrDryRunStubMissing is returned by stubbed tasks when the dry run has no response for them.
+	ErrDryRunStubMissing = errors.New("no stubbed response for dry run")
+	// ErrDryRunUnsupported is returned by tasks whose type has no dry run mode.
+	ErrDryRunUnsupported = errors.New("task type does not support dry runs")
+)
+
+// DryRunStubs maps task DOT IDs to the responses returned by stubbed tasks during a dry run.
+// String values are returned as is, other values are JSON encoded, like an HTTP response body.
+type DryRunStubs map[string]interface{}
+
+// DryRunRequest holds the inputs of a job dry run.
+type DryRunRequest struct {
+	// Vars are the run variables, for example {"jobRun": {"requestBody": "..."}}
+	Vars  map[string]interface{} `json:"vars"`
+	Stubs DryRunStubs            `json:"stubs"`
+}
+
+type dryRunCtxKey struct{}
+
+type dryRunMode int
+
+const (
+	// dryRunExecute tasks have no side effects, and run as usual.
+	dryRunExecute dryRunMode = iota
+	// dryRunSimulate tasks send transactions, and simulate them with eth_call instead.
+	dryRunSimulate
+	// dryRunStub tasks reach external systems, and return their stubbed response instead of running.
+	dryRunStub
+)
+
+// dryRunTaskModes defines how each task type is executed during a dry run. Tasks of a type missing here fail with
+// ErrDryRunUnsupported, so that a new task type can't have side effects in dry runs until it is classified.
+var dryRunTaskModes = map[TaskType]dryRunMode{
+	TaskTypeAny:               dryRunExecute,
+	TaskTypeBase64Decode:      dryRunExecute,
+	TaskTypeBase64Encode:      dryRunExecute,
+	TaskTypeBridge:            dryRunStub,
+	TaskTypeCBORParse:         dryRunExecute,
+	TaskTypeCCIPGetFee:        dryRunExecute,
+	TaskTypeCCIPMessageStatus: dryRunExecute,
+	TaskTypeCCIPSend:          dryRunSimulate,
+	TaskTypeConditional:       dryRunExecute,
+	TaskTypeDivide:            dryRunExecute,
+	TaskTypeETHABIDecode:      dryRunExecute,
+	TaskTypeETHABIDecodeLog:   dryRunExecute,
+	TaskTypeETHABIEncode:      dryRunExecute,
+	TaskTypeETHABIEncode2:     dryRunExecute,
+	TaskTypeETHCall:           dryRunExecute,
+	TaskTypeETHTx:             dryRunSimulate,
+	TaskTypeEstimateGasLimit:  dryRunExecute,
+	TaskTypeHTTP:              dryRunStub,
+	TaskTypeHexDecode:         dryRunExecute,
+	TaskTypeHexEncode:         dryRunExecute,
+	TaskTypeJSONParse:         dryRunExecute,
+	TaskTypeLength:            dryRunExecute,
+	TaskTypeLessThan:          dryRunExecute,
+	TaskTypeLookup:            dryRunExecute,
+	TaskTypeLowercase:         dryRunExecute,
+	TaskTypeMean:              dryRunExecute,
+	TaskTypeMedian:            dryRunExecute,
+	TaskTypeMerge:             dryRunExecute,
+	TaskTypeMode:              dryRunExecute,
+	TaskTypeMultiply:          dryRunExecute,
+	TaskTypeSum:               dryRunExecute,
+	TaskTypeUppercase:         dryRunExecute,
+	TaskTypeVRF:               dryRunExecute,
+	TaskTypeVRFV2:             dryRunExecute,
+	TaskTypeVRFV2Plus:         dryRunExecute,
+	TaskTypePanic:             dryRunExecute,
+	TaskTypeMemo:              dryRunExecute,
+	TaskTypeFail:              dryRunExecute,
+}
+
+func withDryRun(ctx context.Context, stubs DryRunStubs) context.Context {
+	if stubs == nil {
+		stubs = DryRunStubs{}
+	}
+	return context.WithValue(ctx, dryRunCtxKey{}, stubs)
+}
+
+func dryRunFromContext(ctx context.Context) (DryRunStubs, bool) {
+	stubs, ok := ctx.Value(dryRunCtxKey{}).(DryRunStubs)
+	return stubs, ok
+}
+
+// isDryRun returns true if the task is being executed as part of a dry run, and must not have side effects.
+func isDryRun(ctx context.Context) bool {
+	_, ok := dryRunFromContext(ctx)
+	return ok
+}
+
+// stubbedResult returns the stubbed response for task.
+func (s DryRunStubs) stubbedResult(task Task) Result {
+	stub, ok := s[task.DotID()]
+	if !ok {
+		return Result{Error: errors.Wrapf(ErrDryRunStubMissing, "task %s of type %s", task.DotID(), task.Type())}
+	}
+	if str, isString := stub.(string); isString {
+		return Result{Value: str}
+	}
+	b, err := json.Marshal(stub)
+	if err != nil {
+		return Result{Error: errors.Wrapf(ErrBadInput, "encoding stubbed response for task %s: %v", task.DotID(), err)}
+	}
+	return Result{Value: string(b)}
+}
+
+// StubsFromRun returns the responses of the stubbed tasks of a finished run, so that a dry run of another version of
+// the pipeline gets the same responses for the tasks with the same DOT IDs.
+func StubsFromRun(run *Run) DryRunStubs {
+	stubs := DryRunStubs{}
+	for _, taskRun := range run.PipelineTaskRuns {
+		if dryRunTaskModes[taskRun.Type] != dryRunStub || taskRun.Error.Valid || !taskRun.Output.Valid {
+			continue
+		}
+		stubs[taskRun.DotID] = taskRun.Output.Val
+	}
+	return stubs
+}
+
+// runDryRunTask executes task according to its dry run mode.
+func runDryRunTask(ctx context.Context, lggr logger.Logger, stubs DryRunStubs, task Task, vars Vars, inputs []Result) (Result, RunInfo) {
+	mode, ok := dryRunTaskModes[task.Type()]
+	switch {
+	case !ok:
+		return Result{Error: errors.Wrapf(ErrDryRunUnsupported, "task %s of type %s", task.DotID(), task.Type())}, RunInfo{}
+	case mode == dryRunStub:
+		return stubs.stubbedResult(task), RunInfo{}
+	default:
+		return task.Run(ctx, lggr, vars, inputs)
+	}
+}
+
+var forwardABI = evmtypes.MustGetABI(authorized_forwarder.AuthorizedForwarderABI).Methods["forward"]
+
+// simulateTx simulates the transaction of txRequest with eth_call against the latest block, in place of creating it.
+// Forwarded transactions are simulated through their forwarder, so that the target sees the same sender.
+// The call return data is used as the task result.
+func simulateTx(ctx context.Context, lggr logger.Logger, client evmclient.Client, txRequest txmgr.TxRequest) (Result, RunInfo) {
+	to := txRequest.ToAddress
+	data := txRequest.EncodedPayload
+	if txRequest.ForwarderAddress != (common.Address{}) {
+		args, err := forwardABI.Inputs.Pack(txRequest.ToAddress, txRequest.EncodedPayload)
+		if err != nil {
+			return Result{Error: errors.Wrapf(ErrBadInput, "packing forwarder payload: %v", err)}, RunInfo{}
+		}
+		to = txRequest.ForwarderAddress
+		data = append(append([]byte{}, forwardABI.ID...), args...)
+	}
+
+	resp, err := client.CallContract(ctx, ethereum.CallMsg{
+		From:  txRequest.FromAddress,
+		To:    &to,
+		Gas:   txRequest.FeeLimit,
+		Value: &txRequest.Value,
+		Data:  data,
+	}, nil)
+	if err != nil {
+		return Result{Error: errors.Wrapf(ErrTaskRunFailed, "simulating transaction: %v", err)}, RunInfo{}
+	}
+	lggr.Infow("Simulated transaction for dry run", "from", txRequest.FromAddress, "to", txRequest.ToAddress,
+		"forwarder", txRequest.ForwarderAddress, "value", txRequest.Value.String(), "gasLimit", txRequest.FeeLimit)
+	return Result{Value: resp}, RunInfo{}
+}
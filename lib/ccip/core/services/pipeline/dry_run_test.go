@@ -0,0 +1,203 @@
+package pipeline_test
+
+import (
+	"bytes"
+	"fmt"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/guregu/null.v4"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/utils/jsonserializable"
+
+	evmclimocks "github.com/smartcontractkit/chainlink/v2/core/chains/evm/client/mocks"
+	txmmocks "github.com/smartcontractkit/chainlink/v2/core/chains/evm/txmgr/mocks"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/cltest"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils/configtest"
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	keystoremocks "github.com/smartcontractkit/chainlink/v2/core/services/keystore/mocks"
+	"github.com/smartcontractkit/chainlink/v2/core/services/pipeline"
+)
+
+func Test_PipelineRunner_ExecuteDryRun(t *testing.T) {
+	t.Parallel()
+
+	from := testutils.NewAddress()
+	to := testutils.NewAddress()
+	spec := pipeline.Spec{DotDagSource: fmt.Sprintf(`
+fetch  [type=http method=GET url="http://localhost:1/price"];
+parse  [type=jsonparse path="price"];
+submit [type=ethtx to="%s" data="0xbeef" minConfirmations=2 evmChainID="0"];
+
+fetch -> parse -> submit;
+`, to.Hex())}
+
+	newRunner := func(t *testing.T) (pipeline.Runner, *evmclimocks.Client, *keystoremocks.Eth, *txmmocks.MockEvmTxManager) {
+		cfg := configtest.NewTestGeneralConfig(t)
+		ethClient := evmclimocks.NewClient(t)
+		keyStore := keystoremocks.NewEth(t)
+		// No expectations are set on the TXM, transactions must not be created
+		txManager := txmmocks.NewMockEvmTxManager(t)
+		legacyChains := cltest.NewLegacyChainsWithMockChainAndTxManager(t, ethClient, cfg, txManager)
+		r := pipeline.NewRunner(nil, nil, cfg.JobPipeline(), cfg.WebServer(), legacyChains, keyStore, nil, logger.TestLogger(t), nil, nil)
+		return r, ethClient, keyStore, txManager
+	}
+
+	t.Run("simulates transactions and stubs http responses", func(t *testing.T) {
+		r, ethClient, keyStore, _ := newRunner(t)
+		keyStore.On("GetRoundRobinAddress", mock.Anything, testutils.FixtureChainID).Return(from, nil)
+		ethClient.On("CallContract", mock.Anything, mock.MatchedBy(func(msg ethereum.CallMsg) bool {
+			return msg.From == from && *msg.To == to && string(msg.Data) == "\xbe\xef" && msg.Value != nil && msg.Value.Sign() == 0
+		}), (*big.Int)(nil)).Return([]byte{0x01}, nil).Once()
+
+		run, trrs, err := r.ExecuteDryRun(testutils.Context(t), spec, pipeline.NewVarsFrom(nil), pipeline.DryRunStubs{
+			"fetch": map[string]interface{}{"price": 123},
+		})
+		require.NoError(t, err)
+		require.Len(t, trrs, 3)
+		for _, trr := range trrs {
+			require.NoError(t, trr.Result.Error, trr.Task.DotID())
+		}
+		assert.Equal(t, pipeline.RunStatusCompleted, run.State)
+		assert.False(t, run.Pending)
+		assert.Zero(t, run.ID)
+		assert.Len(t, run.PipelineTaskRuns, 3)
+
+		for _, trr := range trrs {
+			switch trr.Task.DotID() {
+			case "fetch":
+				assert.Equal(t, `{"price":123}`, trr.Result.Value)
+			case "submit":
+				assert.Equal(t, []byte{0x01}, trr.Result.Value)
+			}
+		}
+	})
+
+	t.Run("errors tasks without stubs", func(t *testing.T) {
+		r, _, _, _ := newRunner(t)
+
+		run, trrs, err := r.ExecuteDryRun(testutils.Context(t), spec, pipeline.NewVarsFrom(nil), nil)
+		require.NoError(t, err)
+		assert.Equal(t, pipeline.RunStatusErrored, run.State)
+		for _, trr := range trrs {
+			if trr.Task.DotID() == "fetch" {
+				assert.Equal(t, pipeline.ErrDryRunStubMissing, errors.Cause(trr.Result.Error))
+			}
+		}
+	})
+
+	t.Run("simulates forwarded transactions through the forwarder", func(t *testing.T) {
+		r, ethClient, keyStore, txManager := newRunner(t)
+		forwarder := testutils.NewAddress()
+		keyStore.On("GetRoundRobinAddress", mock.Anything, testutils.FixtureChainID).Return(from, nil)
+		txManager.On("GetForwarderForEOA", mock.Anything, from).Return(forwarder, nil).Once()
+		ethClient.On("CallContract", mock.Anything, mock.MatchedBy(func(msg ethereum.CallMsg) bool {
+			return msg.From == from && *msg.To == forwarder && bytes.HasPrefix(msg.Data, crypto.Keccak256([]byte("forward(address,bytes)"))[:4])
+		}), (*big.Int)(nil)).Return([]byte{}, nil).Once()
+
+		forwardedSpec := spec
+		forwardedSpec.ForwardingAllowed = true
+		run, _, err := r.ExecuteDryRun(testutils.Context(t), forwardedSpec, pipeline.NewVarsFrom(nil), pipeline.DryRunStubs{
+			"fetch": `{"price": 123}`,
+		})
+		require.NoError(t, err)
+		assert.Equal(t, pipeline.RunStatusCompleted, run.State)
+	})
+
+	t.Run("reports simulation reverts", func(t *testing.T) {
+		r, ethClient, keyStore, _ := newRunner(t)
+		keyStore.On("GetRoundRobinAddress", mock.Anything, testutils.FixtureChainID).Return(from, nil)
+		ethClient.On("CallContract", mock.Anything, mock.Anything, (*big.Int)(nil)).Return(nil, errors.New("execution reverted")).Once()
+
+		run, trrs, err := r.ExecuteDryRun(testutils.Context(t), spec, pipeline.NewVarsFrom(nil), pipeline.DryRunStubs{
+			"fetch": `{"price": 123}`,
+		})
+		require.NoError(t, err)
+		assert.Equal(t, pipeline.RunStatusErrored, run.State)
+		for _, trr := range trrs {
+			if trr.Task.DotID() == "submit" {
+				assert.Equal(t, pipeline.ErrTaskRunFailed, errors.Cause(trr.Result.Error))
+				assert.Contains(t, trr.Result.Error.Error(), "execution reverted")
+			}
+		}
+	})
+}
+
+func Test_DryRunTaskModes(t *testing.T) {
+	t.Parallel()
+
+	// Every task type must declare how it is dry run, see dryRunTaskModes
+	taskTypes := pipeline.AllTaskTypes()
+	require.NotEmpty(t, taskTypes)
+	for _, taskType := range taskTypes {
+		assert.True(t, pipeline.HasDryRunMode(taskType), "task type %s has no dry run mode", taskType)
+	}
+}
+
+func Test_PipelineRunner_ShadowJobRuns(t *testing.T) {
+	t.Parallel()
+
+	cfg := configtest.NewTestGeneralConfig(t)
+	r := pipeline.NewRunner(nil, nil, cfg.JobPipeline(), cfg.WebServer(), nil, nil, nil, logger.TestLogger(t), nil, nil)
+	spec := pipeline.Spec{JobID: 7, DotDagSource: `
+a [type=memo value="2"];
+b [type=multiply input="$(a)" times="$(times)"];
+
+a -> b;
+`}
+
+	var shadowed []*pipeline.Run
+	var shadowedVars []pipeline.Vars
+	r.ShadowJobRuns(spec.JobID, func(run *pipeline.Run, vars pipeline.Vars) {
+		shadowed = append(shadowed, run)
+		shadowedVars = append(shadowedVars, vars)
+	})
+
+	run, _, err := r.ExecuteRun(testutils.Context(t), spec, pipeline.NewVarsFrom(map[string]interface{}{"times": 3}))
+	require.NoError(t, err)
+	require.Len(t, shadowed, 1)
+	assert.Same(t, run, shadowed[0])
+	// the vars the run started with, without the task results
+	times, err := shadowedVars[0].Get("times")
+	require.NoError(t, err)
+	assert.Equal(t, 3, times)
+	_, err = shadowedVars[0].Get("a")
+	require.ErrorIs(t, err, pipeline.ErrKeypathNotFound)
+
+	// dry runs and the runs of other jobs are not shadowed
+	_, _, err = r.ExecuteDryRun(testutils.Context(t), spec, pipeline.NewVarsFrom(map[string]interface{}{"times": 3}), nil)
+	require.NoError(t, err)
+	otherJob := spec
+	otherJob.JobID = 8
+	_, _, err = r.ExecuteRun(testutils.Context(t), otherJob, pipeline.NewVarsFrom(map[string]interface{}{"times": 3}))
+	require.NoError(t, err)
+	assert.Len(t, shadowed, 1)
+
+	r.ShadowJobRuns(spec.JobID, nil)
+	_, _, err = r.ExecuteRun(testutils.Context(t), spec, pipeline.NewVarsFrom(map[string]interface{}{"times": 3}))
+	require.NoError(t, err)
+	assert.Len(t, shadowed, 1)
+}
+
+func Test_StubsFromRun(t *testing.T) {
+	t.Parallel()
+
+	output := func(v interface{}) jsonserializable.JSONSerializable {
+		return jsonserializable.JSONSerializable{Val: v, Valid: true}
+	}
+	run := &pipeline.Run{PipelineTaskRuns: []pipeline.TaskRun{
+		{Type: pipeline.TaskTypeHTTP, DotID: "fetch", Output: output(`{"price":1}`)},
+		{Type: pipeline.TaskTypeBridge, DotID: "bridge", Output: output("2")},
+		{Type: pipeline.TaskTypeBridge, DotID: "failed", Error: null.StringFrom("timeout")},
+		{Type: pipeline.TaskTypeJSONParse, DotID: "parse", Output: output("1")},
+	}}
+
+	assert.Equal(t, pipeline.DryRunStubs{"fetch": `{"price":1}`, "bridge": "2"}, pipeline.StubsFromRun(run))
+}
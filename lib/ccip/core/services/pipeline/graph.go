@@ -190,7 +190,7 @@ func (p *Pipeline) RequiresPreInsert() bool {
 			if task.(*BridgeTask).Async == "true" {
 				return true
 			}
-		case TaskTypeETHTx:
+		case TaskTypeETHTx, TaskTypeCCIPSend:
 			// we want to pre-insert pipeline_task_runs always
 			return true
 		default:
@@ -2,7 +2,11 @@ package pipeline
 
 import (
 	"context"
+	"go/ast"
+	"go/parser"
+	"go/token"
 	"net/http"
+	"strconv"
 
 	"github.com/google/uuid"
 
@@ -65,4 +69,49 @@ func (t *ETHTxTask) HelperSetDependencies(legacyChains legacyevm.LegacyChainCont
 	t.jobType = jobType
 }
 
+func (t *CCIPGetFeeTask) HelperSetDependencies(legacyChains legacyevm.LegacyChainContainer) {
+	t.legacyChains = legacyChains
+}
+
+func (t *CCIPSendTask) HelperSetDependencies(legacyChains legacyevm.LegacyChainContainer, keyStore ETHKeyStore, specGasLimit *uint32, jobType string) {
+	t.legacyChains = legacyChains
+	t.keyStore = keyStore
+	t.specGasLimit = specGasLimit
+	t.jobType = jobType
+}
+
+func (t *CCIPMessageStatusTask) HelperSetDependencies(legacyChains legacyevm.LegacyChainContainer) {
+	t.legacyChains = legacyChains
+}
+
 func (o *orm) Prune(ctx context.Context, pipelineSpecID int32) { o.prune(ctx, o.ds, pipelineSpecID) }
+
+// AllTaskTypes returns the TaskType constants declared in common.go.
+func AllTaskTypes() (taskTypes []TaskType) {
+	f, err := parser.ParseFile(token.NewFileSet(), "common.go", nil, 0)
+	if err != nil {
+		panic(err)
+	}
+	ast.Inspect(f, func(n ast.Node) bool {
+		spec, ok := n.(*ast.ValueSpec)
+		if !ok {
+			return true
+		}
+		if ident, isIdent := spec.Type.(*ast.Ident); !isIdent || ident.Name != "TaskType" {
+			return false
+		}
+		for _, v := range spec.Values {
+			if lit, isLit := v.(*ast.BasicLit); isLit && lit.Kind == token.STRING {
+				s, _ := strconv.Unquote(lit.Value)
+				taskTypes = append(taskTypes, TaskType(s))
+			}
+		}
+		return false
+	})
+	return
+}
+
+func HasDryRunMode(taskType TaskType) bool {
+	_, ok := dryRunTaskModes[taskType]
+	return ok
+}
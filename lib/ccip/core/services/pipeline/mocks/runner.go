@@ -139,6 +139,76 @@ func (_c *Runner_ExecuteAndInsertFinishedRun_Call) RunAndReturn(run func(context
 	return _c
 }
 
+// ExecuteDryRun provides a mock function with given fields: ctx, spec, vars, stubs
+func (_m *Runner) ExecuteDryRun(ctx context.Context, spec pipeline.Spec, vars pipeline.Vars, stubs pipeline.DryRunStubs) (*pipeline.Run, pipeline.TaskRunResults, error) {
+	ret := _m.Called(ctx, spec, vars, stubs)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ExecuteDryRun")
+	}
+
+	var r0 *pipeline.Run
+	var r1 pipeline.TaskRunResults
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, pipeline.Spec, pipeline.Vars, pipeline.DryRunStubs) (*pipeline.Run, pipeline.TaskRunResults, error)); ok {
+		return rf(ctx, spec, vars, stubs)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, pipeline.Spec, pipeline.Vars, pipeline.DryRunStubs) *pipeline.Run); ok {
+		r0 = rf(ctx, spec, vars, stubs)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*pipeline.Run)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, pipeline.Spec, pipeline.Vars, pipeline.DryRunStubs) pipeline.TaskRunResults); ok {
+		r1 = rf(ctx, spec, vars, stubs)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(pipeline.TaskRunResults)
+		}
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, pipeline.Spec, pipeline.Vars, pipeline.DryRunStubs) error); ok {
+		r2 = rf(ctx, spec, vars, stubs)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// Runner_ExecuteDryRun_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ExecuteDryRun'
+type Runner_ExecuteDryRun_Call struct {
+	*mock.Call
+}
+
+// ExecuteDryRun is a helper method to define mock.On call
+//   - ctx context.Context
+//   - spec pipeline.Spec
+//   - vars pipeline.Vars
+//   - stubs pipeline.DryRunStubs
+func (_e *Runner_Expecter) ExecuteDryRun(ctx interface{}, spec interface{}, vars interface{}, stubs interface{}) *Runner_ExecuteDryRun_Call {
+	return &Runner_ExecuteDryRun_Call{Call: _e.mock.On("ExecuteDryRun", ctx, spec, vars, stubs)}
+}
+
+func (_c *Runner_ExecuteDryRun_Call) Run(run func(ctx context.Context, spec pipeline.Spec, vars pipeline.Vars, stubs pipeline.DryRunStubs)) *Runner_ExecuteDryRun_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(pipeline.Spec), args[2].(pipeline.Vars), args[3].(pipeline.DryRunStubs))
+	})
+	return _c
+}
+
+func (_c *Runner_ExecuteDryRun_Call) Return(run *pipeline.Run, trrs pipeline.TaskRunResults, err error) *Runner_ExecuteDryRun_Call {
+	_c.Call.Return(run, trrs, err)
+	return _c
+}
+
+func (_c *Runner_ExecuteDryRun_Call) RunAndReturn(run func(context.Context, pipeline.Spec, pipeline.Vars, pipeline.DryRunStubs) (*pipeline.Run, pipeline.TaskRunResults, error)) *Runner_ExecuteDryRun_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // ExecuteRun provides a mock function with given fields: ctx, spec, vars
 func (_m *Runner) ExecuteRun(ctx context.Context, spec pipeline.Spec, vars pipeline.Vars) (*pipeline.Run, pipeline.TaskRunResults, error) {
 	ret := _m.Called(ctx, spec, vars)
@@ -642,6 +712,40 @@ func (_c *Runner_Run_Call) RunAndReturn(run func(context.Context, *pipeline.Run,
 	return _c
 }
 
+// ShadowJobRuns provides a mock function with given fields: jobID, fn
+func (_m *Runner) ShadowJobRuns(jobID int32, fn pipeline.ShadowFunc) {
+	_m.Called(jobID, fn)
+}
+
+// Runner_ShadowJobRuns_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ShadowJobRuns'
+type Runner_ShadowJobRuns_Call struct {
+	*mock.Call
+}
+
+// ShadowJobRuns is a helper method to define mock.On call
+//   - jobID int32
+//   - fn pipeline.ShadowFunc
+func (_e *Runner_Expecter) ShadowJobRuns(jobID interface{}, fn interface{}) *Runner_ShadowJobRuns_Call {
+	return &Runner_ShadowJobRuns_Call{Call: _e.mock.On("ShadowJobRuns", jobID, fn)}
+}
+
+func (_c *Runner_ShadowJobRuns_Call) Run(run func(jobID int32, fn pipeline.ShadowFunc)) *Runner_ShadowJobRuns_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(int32), args[1].(pipeline.ShadowFunc))
+	})
+	return _c
+}
+
+func (_c *Runner_ShadowJobRuns_Call) Return() *Runner_ShadowJobRuns_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *Runner_ShadowJobRuns_Call) RunAndReturn(run func(int32, pipeline.ShadowFunc)) *Runner_ShadowJobRuns_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Start provides a mock function with given fields: _a0
 func (_m *Runner) Start(_a0 context.Context) error {
 	ret := _m.Called(_a0)
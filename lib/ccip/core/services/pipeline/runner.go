@@ -40,6 +40,11 @@ type Runner interface {
 	// ExecuteRun executes a new run in-memory according to a spec and returns the results.
 	// We expect spec.JobID and spec.JobName to be set for logging/prometheus.
 	ExecuteRun(ctx context.Context, spec Spec, vars Vars) (run *Run, trrs TaskRunResults, err error)
+	// ExecuteDryRun executes a new run in-memory like ExecuteRun, without side effects.
+	// Transactions are simulated with eth_call, and http and bridge tasks return their response from stubs, keyed by DOT ID.
+	// Tasks of a type without dry run support fail with ErrDryRunUnsupported.
+	// The run is never persisted.
+	ExecuteDryRun(ctx context.Context, spec Spec, vars Vars, stubs DryRunStubs) (run *Run, trrs TaskRunResults, err error)
 	// InsertFinishedRun saves the run results in the database.
 	// ds is an optional override, for example when executing a transaction.
 	InsertFinishedRun(ctx context.Context, ds sqlutil.DataSource, run *Run, saveSuccessfulTaskRuns bool) error
@@ -53,8 +58,14 @@ type Runner interface {
 
 	OnRunFinished(func(*Run))
 	InitializePipeline(spec Spec) (*Pipeline, error)
+	// ShadowJobRuns calls fn with every live run of the job once it finishes, and with the vars the run started with.
+	// Dry runs are not shadowed. fn is called before the run returns, so it must not block. A nil fn stops shadowing.
+	ShadowJobRuns(jobID int32, fn ShadowFunc)
 }
 
+// ShadowFunc receives a finished live run, and the vars it started with. The run must not be modified.
+type ShadowFunc func(run *Run, vars Vars)
+
 type runner struct {
 	services.StateMachine
 	orm                    ORM
@@ -72,6 +83,9 @@ type runner struct {
 	// test helper
 	runFinished func(*Run)
 
+	shadowsMu sync.RWMutex
+	shadows   map[int32]ShadowFunc
+
 	chStop services.StopChan
 	wgDone sync.WaitGroup
 }
@@ -130,6 +144,7 @@ func NewRunner(
 		chStop:                 make(chan struct{}),
 		wgDone:                 sync.WaitGroup{},
 		runFinished:            func(*Run) {},
+		shadows:                make(map[int32]ShadowFunc),
 		lggr:                   lggr,
 		httpClient:             httpClient,
 		unrestrictedHTTPClient: unrestrictedHTTPClient,
@@ -253,6 +268,22 @@ func (r *runner) OnRunFinished(fn func(*Run)) {
 	r.runFinished = fn
 }
 
+func (r *runner) ShadowJobRuns(jobID int32, fn ShadowFunc) {
+	r.shadowsMu.Lock()
+	defer r.shadowsMu.Unlock()
+	if fn == nil {
+		delete(r.shadows, jobID)
+		return
+	}
+	r.shadows[jobID] = fn
+}
+
+func (r *runner) shadowOf(jobID int32) ShadowFunc {
+	r.shadowsMu.RLock()
+	defer r.shadowsMu.RUnlock()
+	return r.shadows[jobID]
+}
+
 var (
 	// github.com/smartcontractkit/libocr/offchainreporting2plus/internal/protocol.ReportingPluginTimeoutWarningGracePeriod
 	overtime           = 100 * time.Millisecond
@@ -322,6 +353,12 @@ func (r *runner) ExecuteRun(ctx context.Context, spec Spec, vars Vars) (*Run, Ta
 	return run, taskRunResults, nil
 }
 
+func (r *runner) ExecuteDryRun(ctx context.Context, spec Spec, vars Vars, stubs DryRunStubs) (*Run, TaskRunResults, error) {
+	// Always initialize a fresh pipeline, task UUIDs of a pre-initialized one may be shared with real runs
+	spec.Pipeline = nil
+	return r.ExecuteRun(withDryRun(ctx, stubs), spec, vars)
+}
+
 func (r *runner) InitializePipeline(spec Spec) (pipeline *Pipeline, err error) {
 	pipeline, err = spec.GetOrParsePipeline()
 	if err != nil {
@@ -368,6 +405,15 @@ func (r *runner) InitializePipeline(spec Spec) (pipeline *Pipeline, err error) {
 			task.(*ETHTxTask).specGasLimit = spec.GasLimit
 			task.(*ETHTxTask).jobType = spec.JobType
 			task.(*ETHTxTask).forwardingAllowed = spec.ForwardingAllowed
+		case TaskTypeCCIPGetFee:
+			task.(*CCIPGetFeeTask).legacyChains = r.legacyEVMChains
+		case TaskTypeCCIPSend:
+			task.(*CCIPSendTask).keyStore = r.ethKeyStore
+			task.(*CCIPSendTask).legacyChains = r.legacyEVMChains
+			task.(*CCIPSendTask).specGasLimit = spec.GasLimit
+			task.(*CCIPSendTask).jobType = spec.JobType
+		case TaskTypeCCIPMessageStatus:
+			task.(*CCIPMessageStatusTask).legacyChains = r.legacyEVMChains
 		default:
 		}
 	}
@@ -376,9 +422,19 @@ func (r *runner) InitializePipeline(spec Spec) (pipeline *Pipeline, err error) {
 }
 
 func (r *runner) run(ctx context.Context, pipeline *Pipeline, run *Run, vars Vars) TaskRunResults {
-	l := r.lggr.With("run.ID", run.ID, "executionID", uuid.New(), "specID", run.PipelineSpecID, "jobID", run.PipelineSpec.JobID, "jobName", run.PipelineSpec.JobName)
+	dryRun := isDryRun(ctx)
+	l := r.lggr.With("run.ID", run.ID, "executionID", uuid.New(), "specID", run.PipelineSpecID, "jobID", run.PipelineSpec.JobID, "jobName", run.PipelineSpec.JobName, "dryRun", dryRun)
 	l.Debug("Initiating tasks for pipeline run of spec")
 
+	var shadow ShadowFunc
+	var shadowVars Vars
+	if !dryRun {
+		// the scheduler sets the task results on vars, keep the vars the run started with
+		if shadow = r.shadowOf(run.PipelineSpec.JobID); shadow != nil {
+			shadowVars = vars.Copy()
+		}
+	}
+
 	scheduler := newScheduler(pipeline, run, vars, l)
 	go scheduler.Run()
 
@@ -398,7 +454,9 @@ func (r *runner) run(ctx context.Context, pipeline *Pipeline, run *Run, vars Var
 		go recovery.WrapRecoverHandle(l, func() {
 			result := r.executeTaskRun(ctx, run.PipelineSpec, taskRun, l)
 
-			logTaskRunToPrometheus(result, run.PipelineSpec)
+			if !dryRun {
+				logTaskRunToPrometheus(result, run.PipelineSpec)
+			}
 
 			scheduler.report(reportCtx, result)
 		}, func(err interface{}) {
@@ -425,7 +483,9 @@ func (r *runner) run(ctx context.Context, pipeline *Pipeline, run *Run, vars Var
 
 		// NOTE: runTime can be very long now because it'll include suspend
 		runTime = run.FinishedAt.Time.Sub(run.CreatedAt)
-		PromPipelineRunTotalTimeToCompletion.WithLabelValues(fmt.Sprintf("%d", run.PipelineSpec.JobID), run.PipelineSpec.JobName).Set(float64(runTime))
+		if !dryRun {
+			PromPipelineRunTotalTimeToCompletion.WithLabelValues(fmt.Sprintf("%d", run.PipelineSpec.JobID), run.PipelineSpec.JobName).Set(float64(runTime))
+		}
 	}
 
 	// Update run results
@@ -475,7 +535,9 @@ func (r *runner) run(ctx context.Context, pipeline *Pipeline, run *Run, vars Var
 
 		if run.HasFatalErrors() {
 			run.State = RunStatusErrored
-			PromPipelineRunErrors.WithLabelValues(fmt.Sprintf("%d", run.PipelineSpec.JobID), run.PipelineSpec.JobName).Inc()
+			if !dryRun {
+				PromPipelineRunErrors.WithLabelValues(fmt.Sprintf("%d", run.PipelineSpec.JobID), run.PipelineSpec.JobName).Inc()
+			}
 		} else {
 			run.State = RunStatusCompleted
 		}
@@ -522,6 +584,10 @@ func (r *runner) run(ctx context.Context, pipeline *Pipeline, run *Run, vars Var
 		l.Debugw("Completed pipeline run successfully")
 	}
 
+	if shadow != nil && run.FinishedAt.Valid {
+		shadow(run, shadowVars)
+	}
+
 	return taskRunResults
 }
 
@@ -552,7 +618,14 @@ func (r *runner) executeTaskRun(ctx context.Context, spec Spec, taskRun *memoryT
 		defer cancel()
 	}
 
-	result, runInfo := taskRun.task.Run(ctx, l, taskRun.vars, taskRun.inputs)
+	var result Result
+	var runInfo RunInfo
+	stubs, dryRun := dryRunFromContext(ctx)
+	if dryRun {
+		result, runInfo = runDryRunTask(ctx, l, stubs, taskRun.task, taskRun.vars, taskRun.inputs)
+	} else {
+		result, runInfo = taskRun.task.Run(ctx, l, taskRun.vars, taskRun.inputs)
+	}
 	loggerFields := []interface{}{"runInfo", runInfo,
 		"resultValue", result.Value,
 		"resultError", result.Error,
@@ -649,7 +722,7 @@ func (r *runner) Run(ctx context.Context, run *Run, saveSuccessfulTaskRuns bool,
 			// initialize certain task params
 			for _, task := range pipeline.Tasks {
 				switch task.Type() {
-				case TaskTypeETHTx:
+				case TaskTypeETHTx, TaskTypeCCIPSend:
 					run.PipelineTaskRuns = append(run.PipelineTaskRuns, TaskRun{
 						ID:            task.Base().uuid,
 						PipelineRunID: run.ID,
@@ -0,0 +1,88 @@
+package pipeline
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/pkg/errors"
+
+	"github.com/smartcontractkit/chainlink/v2/core/chains/legacyevm"
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+)
+
+// CCIPGetFeeTask calls getFee on the CCIP Router for the given message.
+//
+// Return types:
+//
+//	*big.Int
+type CCIPGetFeeTask struct {
+	BaseTask          `mapstructure:",squash"`
+	Router            string `json:"router"`
+	DestChainSelector string `json:"destChainSelector"`
+	Receiver          string `json:"receiver"`
+	Data              string `json:"data"`
+	// TokenAmounts is a JSON list of objects with the "token" and "amount" keys
+	TokenAmounts string `json:"tokenAmounts"`
+	// FeeToken is the token used to pay the fee, the fee is paid in native when unset
+	FeeToken   string `json:"feeToken"`
+	ExtraArgs  string `json:"extraArgs"`
+	EVMChainID string `json:"evmChainID" mapstructure:"evmChainID"`
+
+	legacyChains legacyevm.LegacyChainContainer
+}
+
+var _ Task = (*CCIPGetFeeTask)(nil)
+
+func (t *CCIPGetFeeTask) Type() TaskType {
+	return TaskTypeCCIPGetFee
+}
+
+func (t *CCIPGetFeeTask) getEvmChainID() string {
+	if t.EVMChainID == "" {
+		t.EVMChainID = "$(jobSpec.evmChainID)"
+	}
+	return t.EVMChainID
+}
+
+func (t *CCIPGetFeeTask) Run(ctx context.Context, lggr logger.Logger, vars Vars, inputs []Result) (result Result, runInfo RunInfo) {
+	_, err := CheckInputs(inputs, -1, -1, 0)
+	if err != nil {
+		return Result{Error: errors.Wrap(err, "task inputs")}, runInfo
+	}
+
+	var chainID StringParam
+	err = errors.Wrap(ResolveParam(&chainID, From(VarExpr(t.getEvmChainID(), vars), NonemptyString(t.getEvmChainID()), "")), "evmChainID")
+	if err != nil {
+		return Result{Error: err}, runInfo
+	}
+
+	msg, err := ccipMessageParams{
+		router:            t.Router,
+		destChainSelector: t.DestChainSelector,
+		receiver:          t.Receiver,
+		data:              t.Data,
+		tokenAmounts:      t.TokenAmounts,
+		feeToken:          t.FeeToken,
+		extraArgs:         t.ExtraArgs,
+	}.resolve(vars)
+	if err != nil {
+		return Result{Error: err}, runInfo
+	}
+
+	chain, err := t.legacyChains.Get(string(chainID))
+	if err != nil {
+		err = fmt.Errorf("%w: %s: %w", ErrInvalidEVMChainID, chainID, err)
+		return Result{Error: err}, runInfo
+	}
+
+	fee, err := getCCIPFee(ctx, chain.Client(), msg)
+	if err != nil {
+		if errors.Is(err, ErrBadInput) {
+			return Result{Error: err}, runInfo
+		}
+		return Result{Error: errors.Wrap(err, "while calling getFee")}, retryableRunInfo()
+	}
+
+	lggr.Debugw("CCIP fee", "router", msg.router, "destChainSelector", msg.destChainSelector, "feeToken", msg.message.FeeToken, "fee", fee)
+	return Result{Value: fee}, runInfo
+}
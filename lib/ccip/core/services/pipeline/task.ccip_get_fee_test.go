@@ -0,0 +1,144 @@
+package pipeline_test
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	evmclimocks "github.com/smartcontractkit/chainlink/v2/core/chains/evm/client/mocks"
+	"github.com/smartcontractkit/chainlink/v2/core/gethwrappers/ccip/generated/router"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/cltest"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils/configtest"
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	"github.com/smartcontractkit/chainlink/v2/core/services/pipeline"
+)
+
+const (
+	testCCIPRouter            = "0xDeaDbeefdEAdbeefdEadbEEFdeadbeEFdEaDbeeF"
+	testCCIPReceiver          = "0x882969652440ccf14a5dbb9bd53eb21cb1e11e5c"
+	testCCIPToken             = "0x1111111111111111111111111111111111111111"
+	testCCIPDestChainSelector = "16015286601757825753"
+)
+
+func encodeCCIPFee(t *testing.T, fee *big.Int) []byte {
+	routerABI, err := router.RouterMetaData.GetAbi()
+	require.NoError(t, err)
+	encoded, err := routerABI.Methods["getFee"].Outputs.Pack(fee)
+	require.NoError(t, err)
+	return encoded
+}
+
+func TestCCIPGetFeeTask(t *testing.T) {
+	t.Parallel()
+
+	routerAddr := common.HexToAddress(testCCIPRouter)
+	routerABI, err := router.RouterMetaData.GetAbi()
+	require.NoError(t, err)
+
+	tests := []struct {
+		name                  string
+		tokenAmounts          string
+		feeToken              string
+		vars                  pipeline.Vars
+		setupClientMocks      func(ethClient *evmclimocks.Client)
+		expected              interface{}
+		expectedErrorCause    error
+		expectedErrorContains string
+		expectedRunInfo       pipeline.RunInfo
+	}{
+		{
+			"native fee with token amounts",
+			`[{"token": $(token), "amount": "1000"}]`,
+			"",
+			pipeline.NewVarsFrom(map[string]interface{}{
+				"token": testCCIPToken,
+			}),
+			func(ethClient *evmclimocks.Client) {
+				destChainSelector, _ := new(big.Int).SetString(testCCIPDestChainSelector, 10)
+				payload, err := routerABI.Pack("getFee", destChainSelector.Uint64(), router.ClientEVM2AnyMessage{
+					Receiver:     common.LeftPadBytes(common.HexToAddress(testCCIPReceiver).Bytes(), 32),
+					Data:         []byte{},
+					TokenAmounts: []router.ClientEVMTokenAmount{{Token: common.HexToAddress(testCCIPToken), Amount: big.NewInt(1000)}},
+					ExtraArgs:    []byte{},
+				})
+				require.NoError(t, err)
+				ethClient.
+					On("CallContract", mock.Anything, ethereum.CallMsg{To: &routerAddr, Data: payload}, (*big.Int)(nil)).
+					Return(encodeCCIPFee(t, big.NewInt(42)), nil)
+			},
+			big.NewInt(42), nil, "", pipeline.RunInfo{},
+		},
+		{
+			"rpc error",
+			"",
+			testCCIPToken,
+			pipeline.NewVarsFrom(nil),
+			func(ethClient *evmclimocks.Client) {
+				ethClient.
+					On("CallContract", mock.Anything, mock.Anything, (*big.Int)(nil)).
+					Return(nil, errors.New("connection refused"))
+			},
+			nil, nil, "connection refused", pipeline.RunInfo{IsRetryable: true},
+		},
+		{
+			"invalid token amount",
+			`[{"token": "0x1", "amount": "1000"}]`,
+			"",
+			pipeline.NewVarsFrom(nil),
+			func(ethClient *evmclimocks.Client) {},
+			nil, pipeline.ErrBadInput, "tokenAmounts", pipeline.RunInfo{},
+		},
+		{
+			"zero token amount",
+			`[{"token": "` + testCCIPToken + `", "amount": "0"}]`,
+			"",
+			pipeline.NewVarsFrom(nil),
+			func(ethClient *evmclimocks.Client) {},
+			nil, pipeline.ErrBadInput, "amount must be positive", pipeline.RunInfo{},
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			task := pipeline.CCIPGetFeeTask{
+				BaseTask:          pipeline.NewBaseTask(0, "ccipgetfee", nil, nil, 0),
+				Router:            testCCIPRouter,
+				DestChainSelector: testCCIPDestChainSelector,
+				Receiver:          testCCIPReceiver,
+				TokenAmounts:      test.tokenAmounts,
+				FeeToken:          test.feeToken,
+				EVMChainID:        "0",
+			}
+
+			ethClient := evmclimocks.NewClient(t)
+			test.setupClientMocks(ethClient)
+
+			cfg := configtest.NewTestGeneralConfig(t)
+			task.HelperSetDependencies(cltest.NewLegacyChainsWithMockChain(t, ethClient, cfg))
+
+			result, runInfo := task.Run(testutils.Context(t), logger.TestLogger(t), test.vars, nil)
+			assert.Equal(t, test.expectedRunInfo, runInfo)
+
+			if test.expectedErrorCause != nil || test.expectedErrorContains != "" {
+				require.Nil(t, result.Value)
+				if test.expectedErrorCause != nil {
+					require.Equal(t, test.expectedErrorCause, errors.Cause(result.Error))
+				}
+				if test.expectedErrorContains != "" {
+					require.Contains(t, result.Error.Error(), test.expectedErrorContains)
+				}
+			} else {
+				require.NoError(t, result.Error)
+				require.Equal(t, test.expected, result.Value)
+			}
+		})
+	}
+}
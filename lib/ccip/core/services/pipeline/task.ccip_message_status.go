@@ -0,0 +1,164 @@
+package pipeline
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/pkg/errors"
+	"go.uber.org/multierr"
+
+	"github.com/smartcontractkit/chainlink/v2/core/chains/legacyevm"
+	"github.com/smartcontractkit/chainlink/v2/core/gethwrappers/ccip/generated/evm_2_evm_offramp"
+	"github.com/smartcontractkit/chainlink/v2/core/gethwrappers/ccip/generated/offramp"
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ccip/abihelpers"
+)
+
+// CCIP message execution states, as defined by Internal.MessageExecutionState.
+const (
+	CCIPMessageStateUntouched  = "UNTOUCHED"
+	CCIPMessageStateInProgress = "IN_PROGRESS"
+	CCIPMessageStateSuccess    = "SUCCESS"
+	CCIPMessageStateFailure    = "FAILURE"
+)
+
+var (
+	ccipMessageStates = []string{CCIPMessageStateUntouched, CCIPMessageStateInProgress, CCIPMessageStateSuccess, CCIPMessageStateFailure}
+
+	evm2EVMOffRampABI = abihelpers.MustParseABI(evm_2_evm_offramp.EVM2EVMOffRampMetaData.ABI)
+	offRampABI        = abihelpers.MustParseABI(offramp.OffRampMetaData.ABI)
+)
+
+// CCIPMessageStatusTask resolves a CCIP message ID to its execution state on the destination OffRamp.
+// Both the EVM2EVMOffRamp (up to 1.5) and the multi-source OffRamp (1.6) events are supported.
+// Messages without execution attempts are reported as UNTOUCHED.
+//
+// FromBlock is required, and should be a block of the destination chain shortly before the message was sent, it
+// bounds the range of the logs queries, which RPC nodes reject or time out when it spans the whole chain.
+//
+// Return types:
+//
+//	string
+type CCIPMessageStatusTask struct {
+	BaseTask   `mapstructure:",squash"`
+	OffRamp    string `json:"offRamp"`
+	MessageID  string `json:"messageID" mapstructure:"messageID"`
+	FromBlock  string `json:"fromBlock"`
+	EVMChainID string `json:"evmChainID" mapstructure:"evmChainID"`
+
+	legacyChains legacyevm.LegacyChainContainer
+}
+
+var _ Task = (*CCIPMessageStatusTask)(nil)
+
+func (t *CCIPMessageStatusTask) Type() TaskType {
+	return TaskTypeCCIPMessageStatus
+}
+
+func (t *CCIPMessageStatusTask) getEvmChainID() string {
+	if t.EVMChainID == "" {
+		t.EVMChainID = "$(jobSpec.evmChainID)"
+	}
+	return t.EVMChainID
+}
+
+func (t *CCIPMessageStatusTask) Run(ctx context.Context, lggr logger.Logger, vars Vars, inputs []Result) (result Result, runInfo RunInfo) {
+	_, err := CheckInputs(inputs, -1, -1, 0)
+	if err != nil {
+		return Result{Error: errors.Wrap(err, "task inputs")}, runInfo
+	}
+
+	var (
+		offRampAddr AddressParam
+		messageID   BytesParam
+		fromBlock   Uint64Param
+		chainID     StringParam
+	)
+	err = multierr.Combine(
+		errors.Wrap(ResolveParam(&offRampAddr, From(VarExpr(t.OffRamp, vars), NonemptyString(t.OffRamp))), "offRamp"),
+		errors.Wrap(ResolveParam(&messageID, From(VarExpr(t.MessageID, vars), NonemptyString(t.MessageID))), "messageID"),
+		errors.Wrap(ResolveParam(&fromBlock, From(VarExpr(t.FromBlock, vars), NonemptyString(t.FromBlock))), "fromBlock"),
+		errors.Wrap(ResolveParam(&chainID, From(VarExpr(t.getEvmChainID(), vars), NonemptyString(t.getEvmChainID()), "")), "evmChainID"),
+	)
+	if err != nil {
+		return Result{Error: err}, runInfo
+	} else if len(messageID) != common.HashLength {
+		return Result{Error: errors.Wrapf(ErrBadInput, "messageID must be %d bytes, got %d", common.HashLength, len(messageID))}, runInfo
+	}
+	msgID := common.BytesToHash(messageID)
+
+	chain, err := t.legacyChains.Get(string(chainID))
+	if err != nil {
+		err = fmt.Errorf("%w: %s: %w", ErrInvalidEVMChainID, chainID, err)
+		return Result{Error: err}, runInfo
+	}
+
+	addresses := []common.Address{common.Address(offRampAddr)}
+	from := new(big.Int).SetUint64(uint64(fromBlock))
+	queries := []ethereum.FilterQuery{
+		{
+			// ExecutionStateChanged(uint64 indexed sequenceNumber, bytes32 indexed messageId, ...)
+			FromBlock: from,
+			Addresses: addresses,
+			Topics:    [][]common.Hash{{evm_2_evm_offramp.EVM2EVMOffRampExecutionStateChanged{}.Topic()}, nil, {msgID}},
+		},
+		{
+			// ExecutionStateChanged(uint64 indexed sourceChainSelector, uint64 indexed sequenceNumber, bytes32 indexed messageId, ...)
+			FromBlock: from,
+			Addresses: addresses,
+			Topics:    [][]common.Hash{{offramp.OffRampExecutionStateChanged{}.Topic()}, nil, nil, {msgID}},
+		},
+	}
+
+	var latest *types.Log
+	for _, query := range queries {
+		logs, err := chain.Client().FilterLogs(ctx, query)
+		if err != nil {
+			return Result{Error: errors.Wrap(err, "while filtering ExecutionStateChanged logs")}, retryableRunInfo()
+		}
+		for i := range logs {
+			if latest == nil || isLaterLog(logs[i], *latest) {
+				latest = &logs[i]
+			}
+		}
+	}
+
+	if latest == nil {
+		return Result{Value: CCIPMessageStateUntouched}, runInfo
+	}
+
+	state, err := decodeCCIPExecutionState(*latest)
+	if err != nil {
+		return Result{Error: err}, runInfo
+	}
+	lggr.Debugw("CCIP message status", "messageID", msgID, "state", state, "txHash", latest.TxHash)
+	return Result{Value: state}, runInfo
+}
+
+func isLaterLog(a, b types.Log) bool {
+	if a.BlockNumber != b.BlockNumber {
+		return a.BlockNumber > b.BlockNumber
+	}
+	return a.Index > b.Index
+}
+
+func decodeCCIPExecutionState(log types.Log) (string, error) {
+	eventABI := evm2EVMOffRampABI
+	if log.Topics[0] == (offramp.OffRampExecutionStateChanged{}.Topic()) {
+		eventABI = offRampABI
+	}
+
+	fields := map[string]interface{}{}
+	if err := eventABI.UnpackIntoMap(fields, "ExecutionStateChanged", log.Data); err != nil {
+		return "", fmt.Errorf("unpacking ExecutionStateChanged: %w", err)
+	}
+	state, ok := fields["state"].(uint8)
+	if !ok || int(state) >= len(ccipMessageStates) {
+		return "", fmt.Errorf("unexpected execution state %v", fields["state"])
+	}
+	return ccipMessageStates[state], nil
+}
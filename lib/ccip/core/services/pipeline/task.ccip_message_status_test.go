@@ -0,0 +1,155 @@
+package pipeline_test
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	evmclimocks "github.com/smartcontractkit/chainlink/v2/core/chains/evm/client/mocks"
+	"github.com/smartcontractkit/chainlink/v2/core/gethwrappers/ccip/generated/evm_2_evm_offramp"
+	"github.com/smartcontractkit/chainlink/v2/core/gethwrappers/ccip/generated/offramp"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/cltest"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils/configtest"
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	"github.com/smartcontractkit/chainlink/v2/core/services/pipeline"
+)
+
+func TestCCIPMessageStatusTask(t *testing.T) {
+	t.Parallel()
+
+	offRampAddr := common.HexToAddress("0xDeaDbeefdEAdbeefdEadbEEFdeadbeEFdEaDbeeF")
+	messageID := common.HexToHash("0x5198616554d738d9485d1a7cf53b2f33e09c3bbc8fe9ac0020bd672cd2bc15d2")
+
+	evm2EVMOffRampABI, err := evm_2_evm_offramp.EVM2EVMOffRampMetaData.GetAbi()
+	require.NoError(t, err)
+	offRampABI, err := offramp.OffRampMetaData.GetAbi()
+	require.NoError(t, err)
+
+	evm2EVMOffRampLog := func(blockNumber uint64, state uint8) types.Log {
+		data, err2 := evm2EVMOffRampABI.Events["ExecutionStateChanged"].Inputs.NonIndexed().Pack(state, []byte{})
+		require.NoError(t, err2)
+		return types.Log{
+			Address:     offRampAddr,
+			Topics:      []common.Hash{evm_2_evm_offramp.EVM2EVMOffRampExecutionStateChanged{}.Topic(), common.BigToHash(big.NewInt(1)), messageID},
+			Data:        data,
+			BlockNumber: blockNumber,
+		}
+	}
+	offRampLog := func(blockNumber uint64, state uint8) types.Log {
+		data, err2 := offRampABI.Events["ExecutionStateChanged"].Inputs.NonIndexed().Pack(common.Hash{}, state, []byte{}, big.NewInt(0))
+		require.NoError(t, err2)
+		return types.Log{
+			Address:     offRampAddr,
+			Topics:      []common.Hash{offramp.OffRampExecutionStateChanged{}.Topic(), common.BigToHash(big.NewInt(1)), common.BigToHash(big.NewInt(1)), messageID},
+			Data:        data,
+			BlockNumber: blockNumber,
+		}
+	}
+	isEVM2EVMOffRampQuery := func(q ethereum.FilterQuery) bool {
+		return q.Topics[0][0] == evm_2_evm_offramp.EVM2EVMOffRampExecutionStateChanged{}.Topic() && q.FromBlock.Int64() == 100
+	}
+	isOffRampQuery := func(q ethereum.FilterQuery) bool {
+		return q.Topics[0][0] == offramp.OffRampExecutionStateChanged{}.Topic() && q.FromBlock.Int64() == 100
+	}
+
+	tests := []struct {
+		name                  string
+		messageID             string
+		noFromBlock           bool
+		evm2EVMOffRampLogs    []types.Log
+		offRampLogs           []types.Log
+		filterErr             error
+		expected              interface{}
+		expectedErrorCause    error
+		expectedErrorContains string
+		expectedRunInfo       pipeline.RunInfo
+	}{
+		{
+			name:      "untouched message",
+			messageID: messageID.Hex(),
+			expected:  pipeline.CCIPMessageStateUntouched,
+		},
+		{
+			name:               "1.5 offRamp uses the latest state",
+			messageID:          messageID.Hex(),
+			evm2EVMOffRampLogs: []types.Log{evm2EVMOffRampLog(11, 3), evm2EVMOffRampLog(10, 1)},
+			expected:           pipeline.CCIPMessageStateFailure,
+		},
+		{
+			name:        "1.6 offRamp",
+			messageID:   messageID.Hex(),
+			offRampLogs: []types.Log{offRampLog(10, 2)},
+			expected:    pipeline.CCIPMessageStateSuccess,
+		},
+		{
+			name:                  "filter error",
+			messageID:             messageID.Hex(),
+			filterErr:             errors.New("connection refused"),
+			expectedErrorContains: "connection refused",
+			expectedRunInfo:       pipeline.RunInfo{IsRetryable: true},
+		},
+		{
+			name:                  "missing fromBlock",
+			messageID:             messageID.Hex(),
+			noFromBlock:           true,
+			expectedErrorCause:    pipeline.ErrParameterEmpty,
+			expectedErrorContains: "fromBlock",
+		},
+		{
+			name:                  "invalid message id",
+			messageID:             "0x1234",
+			expectedErrorCause:    pipeline.ErrBadInput,
+			expectedErrorContains: "messageID must be 32 bytes",
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			fromBlock := "100"
+			if test.noFromBlock {
+				fromBlock = ""
+			}
+			task := pipeline.CCIPMessageStatusTask{
+				BaseTask:   pipeline.NewBaseTask(0, "ccipmessagestatus", nil, nil, 0),
+				OffRamp:    offRampAddr.Hex(),
+				MessageID:  test.messageID,
+				FromBlock:  fromBlock,
+				EVMChainID: "0",
+			}
+
+			ethClient := evmclimocks.NewClient(t)
+			ethClient.On("FilterLogs", mock.Anything, mock.MatchedBy(isEVM2EVMOffRampQuery)).
+				Return(test.evm2EVMOffRampLogs, test.filterErr).Maybe()
+			ethClient.On("FilterLogs", mock.Anything, mock.MatchedBy(isOffRampQuery)).
+				Return(test.offRampLogs, test.filterErr).Maybe()
+
+			cfg := configtest.NewTestGeneralConfig(t)
+			task.HelperSetDependencies(cltest.NewLegacyChainsWithMockChain(t, ethClient, cfg))
+
+			result, runInfo := task.Run(testutils.Context(t), logger.TestLogger(t), pipeline.NewVarsFrom(nil), nil)
+			assert.Equal(t, test.expectedRunInfo, runInfo)
+
+			if test.expectedErrorCause != nil || test.expectedErrorContains != "" {
+				require.Nil(t, result.Value)
+				if test.expectedErrorCause != nil {
+					require.Equal(t, test.expectedErrorCause, errors.Cause(result.Error))
+				}
+				if test.expectedErrorContains != "" {
+					require.Contains(t, result.Error.Error(), test.expectedErrorContains)
+				}
+			} else {
+				require.NoError(t, result.Error)
+				require.Equal(t, test.expected, result.Value)
+			}
+		})
+	}
+}
@@ -0,0 +1,203 @@
+package pipeline
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/pkg/errors"
+	"go.uber.org/multierr"
+	"gopkg.in/guregu/null.v4"
+
+	clnull "github.com/smartcontractkit/chainlink-common/pkg/utils/null"
+
+	txmgrcommon "github.com/smartcontractkit/chainlink/v2/common/txmgr"
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/txmgr"
+	"github.com/smartcontractkit/chainlink/v2/core/chains/legacyevm"
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+)
+
+// CCIPSendTask builds a Client.EVM2AnyMessage and submits Router.ccipSend through the TXM.
+// The Router must be allowed to spend the message tokens, and the fee token when the fee isn't paid in native.
+//
+// Return types:
+//
+//	nil
+//	[]byte (dry runs, the eth_call return data)
+type CCIPSendTask struct {
+	BaseTask          `mapstructure:",squash"`
+	From              string `json:"from"`
+	Router            string `json:"router"`
+	DestChainSelector string `json:"destChainSelector"`
+	Receiver          string `json:"receiver"`
+	Data              string `json:"data"`
+	// TokenAmounts is a JSON list of objects with the "token" and "amount" keys
+	TokenAmounts string `json:"tokenAmounts"`
+	// FeeToken is the token used to pay the fee, the fee is paid in native when unset
+	FeeToken  string `json:"feeToken"`
+	ExtraArgs string `json:"extraArgs"`
+	// MaxFee, if set, will error the task if the Router fee is higher. The error is not retried, the fee is not
+	// expected to drop within the retries of a run.
+	MaxFee           string `json:"maxFee"`
+	GasLimit         string `json:"gasLimit"`
+	TxMeta           string `json:"txMeta"`
+	MinConfirmations string `json:"minConfirmations"`
+	// FailOnRevert, if set, will error the task if the transaction reverted on-chain
+	// If unset, the receipt will be passed as output
+	// It has no effect if minConfirmations == 0
+	FailOnRevert string `json:"failOnRevert"`
+	EVMChainID   string `json:"evmChainID" mapstructure:"evmChainID"`
+
+	specGasLimit *uint32
+	keyStore     ETHKeyStore
+	legacyChains legacyevm.LegacyChainContainer
+	jobType      string
+}
+
+var _ Task = (*CCIPSendTask)(nil)
+
+func (t *CCIPSendTask) Type() TaskType {
+	return TaskTypeCCIPSend
+}
+
+func (t *CCIPSendTask) getEvmChainID() string {
+	if t.EVMChainID == "" {
+		t.EVMChainID = "$(jobSpec.evmChainID)"
+	}
+	return t.EVMChainID
+}
+
+func (t *CCIPSendTask) Run(ctx context.Context, lggr logger.Logger, vars Vars, inputs []Result) (result Result, runInfo RunInfo) {
+	var chainID StringParam
+	err := errors.Wrap(ResolveParam(&chainID, From(VarExpr(t.getEvmChainID(), vars), NonemptyString(t.getEvmChainID()), "")), "evmChainID")
+	if err != nil {
+		return Result{Error: err}, runInfo
+	}
+
+	chain, err := t.legacyChains.Get(string(chainID))
+	if err != nil {
+		err = fmt.Errorf("%w: %s: %w", ErrInvalidEVMChainID, chainID, err)
+		return Result{Error: err}, retryableRunInfo()
+	}
+
+	cfg := chain.Config().EVM()
+	txManager := chain.TxManager()
+	_, err = CheckInputs(inputs, -1, -1, 0)
+	if err != nil {
+		return Result{Error: errors.Wrap(err, "task inputs")}, runInfo
+	}
+
+	maximumGasLimit := SelectGasLimit(cfg.GasEstimator(), t.jobType, t.specGasLimit)
+
+	var (
+		fromAddrs             AddressSliceParam
+		maxFee                MaybeBigIntParam
+		gasLimit              Uint64Param
+		txMetaMap             MapParam
+		maybeMinConfirmations MaybeUint64Param
+		failOnRevert          BoolParam
+	)
+	err = multierr.Combine(
+		errors.Wrap(ResolveParam(&fromAddrs, From(VarExpr(t.From, vars), JSONWithVarExprs(t.From, vars, false), NonemptyString(t.From), nil)), "from"),
+		errors.Wrap(ResolveParam(&maxFee, From(VarExpr(t.MaxFee, vars), t.MaxFee)), "maxFee"),
+		errors.Wrap(ResolveParam(&gasLimit, From(VarExpr(t.GasLimit, vars), NonemptyString(t.GasLimit), maximumGasLimit)), "gasLimit"),
+		errors.Wrap(ResolveParam(&txMetaMap, From(VarExpr(t.TxMeta, vars), JSONWithVarExprs(t.TxMeta, vars, false), MapParam{})), "txMeta"),
+		errors.Wrap(ResolveParam(&maybeMinConfirmations, From(VarExpr(t.MinConfirmations, vars), NonemptyString(t.MinConfirmations), "")), "minConfirmations"),
+		errors.Wrap(ResolveParam(&failOnRevert, From(NonemptyString(t.FailOnRevert), false)), "failOnRevert"),
+	)
+	if err != nil {
+		return Result{Error: err}, runInfo
+	}
+
+	msg, err := ccipMessageParams{
+		router:            t.Router,
+		destChainSelector: t.DestChainSelector,
+		receiver:          t.Receiver,
+		data:              t.Data,
+		tokenAmounts:      t.TokenAmounts,
+		feeToken:          t.FeeToken,
+		extraArgs:         t.ExtraArgs,
+	}.resolve(vars)
+	if err != nil {
+		return Result{Error: err}, runInfo
+	}
+
+	var minOutgoingConfirmations uint64
+	if min, isSet := maybeMinConfirmations.Uint64(); isSet {
+		minOutgoingConfirmations = min
+	} else {
+		minOutgoingConfirmations = uint64(cfg.FinalityDepth())
+	}
+
+	txMeta, err := decodeMeta(txMetaMap)
+	if err != nil {
+		return Result{Error: err}, runInfo
+	}
+	txMeta.FailOnRevert = null.BoolFrom(bool(failOnRevert))
+	setJobIDOnMeta(lggr, vars, txMeta)
+
+	fee, err := getCCIPFee(ctx, chain.Client(), msg)
+	if err != nil {
+		if errors.Is(err, ErrBadInput) {
+			return Result{Error: err}, runInfo
+		}
+		return Result{Error: errors.Wrap(err, "while calling getFee")}, retryableRunInfo()
+	}
+	if maxFee.BigInt() != nil && fee.Cmp(maxFee.BigInt()) > 0 {
+		return Result{Error: errors.Wrapf(ErrTaskRunFailed, "fee %s exceeds maxFee %s", fee, maxFee.BigInt())}, runInfo
+	}
+
+	payload, err := routerABI.Pack("ccipSend", msg.destChainSelector, msg.message)
+	if err != nil {
+		return Result{Error: errors.Wrapf(ErrBadInput, "packing ccipSend: %v", err)}, runInfo
+	}
+
+	// The fee is sent as value when paid in native
+	value := big.NewInt(0)
+	if msg.message.FeeToken == (common.Address{}) {
+		value = fee
+	}
+
+	fromAddr, err := t.keyStore.GetRoundRobinAddress(ctx, chain.ID(), fromAddrs...)
+	if err != nil {
+		err = errors.Wrap(err, "CCIPSendTask failed to get fromAddress")
+		lggr.Error(err)
+		return Result{Error: errors.Wrapf(ErrTaskRunFailed, "while querying keystore: %v", err)}, retryableRunInfo()
+	}
+
+	// Forwarders are not used, the Router fee is taken from the sender and forwarders can't pass native value.
+	txRequest := txmgr.TxRequest{
+		FromAddress:    fromAddr,
+		ToAddress:      msg.router,
+		EncodedPayload: payload,
+		Value:          *value,
+		FeeLimit:       uint64(gasLimit),
+		Meta:           txMeta,
+		Strategy:       txmgrcommon.NewSendEveryStrategy(),
+		SignalCallback: true,
+	}
+
+	if minOutgoingConfirmations > 0 {
+		// Store the task run ID, so we can resume the pipeline when tx is confirmed
+		txRequest.PipelineTaskRunID = &t.uuid
+		txRequest.MinConfirmations = clnull.Uint32From(uint32(minOutgoingConfirmations))
+	}
+
+	if isDryRun(ctx) {
+		return simulateTx(ctx, lggr, chain.Client(), txRequest)
+	}
+
+	_, err = txManager.CreateTransaction(ctx, txRequest)
+	if err != nil {
+		return Result{Error: errors.Wrapf(ErrTaskRunFailed, "while creating transaction: %v", err)}, retryableRunInfo()
+	}
+
+	lggr.Infow("CCIP send transaction created", "router", msg.router, "destChainSelector", msg.destChainSelector, "fee", fee, "from", fromAddr)
+
+	if minOutgoingConfirmations > 0 {
+		return Result{}, pendingRunInfo()
+	}
+
+	return Result{Value: nil}, runInfo
+}
@@ -0,0 +1,149 @@
+package pipeline_test
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/guregu/null.v4"
+
+	txmgrcommon "github.com/smartcontractkit/chainlink/v2/common/txmgr"
+	evmclimocks "github.com/smartcontractkit/chainlink/v2/core/chains/evm/client/mocks"
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/txmgr"
+	txmmocks "github.com/smartcontractkit/chainlink/v2/core/chains/evm/txmgr/mocks"
+	"github.com/smartcontractkit/chainlink/v2/core/gethwrappers/ccip/generated/router"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/cltest"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils/configtest"
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	keystoremocks "github.com/smartcontractkit/chainlink/v2/core/services/keystore/mocks"
+	"github.com/smartcontractkit/chainlink/v2/core/services/pipeline"
+)
+
+func TestCCIPSendTask(t *testing.T) {
+	t.Parallel()
+
+	from := common.HexToAddress("0x882969652440ccf14a5dbb9bd53eb21cb1e11e5c")
+	routerAddr := common.HexToAddress(testCCIPRouter)
+	feeToken := common.HexToAddress(testCCIPToken)
+	const gasLimit uint64 = 500_000
+
+	routerABI, err := router.RouterMetaData.GetAbi()
+	require.NoError(t, err)
+	destChainSelector, _ := new(big.Int).SetString(testCCIPDestChainSelector, 10)
+
+	ccipSendPayload := func(t *testing.T, feeToken common.Address) []byte {
+		payload, err2 := routerABI.Pack("ccipSend", destChainSelector.Uint64(), router.ClientEVM2AnyMessage{
+			Receiver:     common.LeftPadBytes(common.HexToAddress(testCCIPReceiver).Bytes(), 32),
+			Data:         []byte("hello"),
+			TokenAmounts: []router.ClientEVMTokenAmount{},
+			FeeToken:     feeToken,
+			ExtraArgs:    []byte{},
+		})
+		require.NoError(t, err2)
+		return payload
+	}
+
+	tests := []struct {
+		name                  string
+		feeToken              string
+		maxFee                string
+		setupMocks            func(t *testing.T, ethClient *evmclimocks.Client, keyStore *keystoremocks.Eth, txManager *txmmocks.MockEvmTxManager)
+		expectedErrorCause    error
+		expectedErrorContains string
+		expectedRunInfo       pipeline.RunInfo
+	}{
+		{
+			name: "fee paid in native",
+			setupMocks: func(t *testing.T, ethClient *evmclimocks.Client, keyStore *keystoremocks.Eth, txManager *txmmocks.MockEvmTxManager) {
+				ethClient.On("CallContract", mock.Anything, mock.Anything, (*big.Int)(nil)).Return(encodeCCIPFee(t, big.NewInt(42)), nil)
+				keyStore.On("GetRoundRobinAddress", mock.Anything, testutils.FixtureChainID, from).Return(from, nil)
+				txManager.On("CreateTransaction", mock.Anything, txmgr.TxRequest{
+					FromAddress:    from,
+					ToAddress:      routerAddr,
+					EncodedPayload: ccipSendPayload(t, common.Address{}),
+					Value:          *big.NewInt(42),
+					FeeLimit:       gasLimit,
+					Meta:           &txmgr.TxMeta{FailOnRevert: null.BoolFrom(false)},
+					Strategy:       txmgrcommon.NewSendEveryStrategy(),
+					SignalCallback: true,
+				}).Return(txmgr.Tx{}, nil)
+			},
+		},
+		{
+			name:     "fee paid in fee token",
+			feeToken: testCCIPToken,
+			setupMocks: func(t *testing.T, ethClient *evmclimocks.Client, keyStore *keystoremocks.Eth, txManager *txmmocks.MockEvmTxManager) {
+				ethClient.On("CallContract", mock.Anything, mock.Anything, (*big.Int)(nil)).Return(encodeCCIPFee(t, big.NewInt(42)), nil)
+				keyStore.On("GetRoundRobinAddress", mock.Anything, testutils.FixtureChainID, from).Return(from, nil)
+				txManager.On("CreateTransaction", mock.Anything, txmgr.TxRequest{
+					FromAddress:    from,
+					ToAddress:      routerAddr,
+					EncodedPayload: ccipSendPayload(t, feeToken),
+					Value:          *big.NewInt(0),
+					FeeLimit:       gasLimit,
+					Meta:           &txmgr.TxMeta{FailOnRevert: null.BoolFrom(false)},
+					Strategy:       txmgrcommon.NewSendEveryStrategy(),
+					SignalCallback: true,
+				}).Return(txmgr.Tx{}, nil)
+			},
+		},
+		{
+			name:   "fee above maxFee",
+			maxFee: "41",
+			setupMocks: func(t *testing.T, ethClient *evmclimocks.Client, keyStore *keystoremocks.Eth, txManager *txmmocks.MockEvmTxManager) {
+				ethClient.On("CallContract", mock.Anything, mock.Anything, (*big.Int)(nil)).Return(encodeCCIPFee(t, big.NewInt(42)), nil)
+			},
+			expectedErrorCause:    pipeline.ErrTaskRunFailed,
+			expectedErrorContains: "exceeds maxFee",
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			task := pipeline.CCIPSendTask{
+				BaseTask:          pipeline.NewBaseTask(0, "ccipsend", nil, nil, 0),
+				From:              `[ "` + from.Hex() + `" ]`,
+				Router:            testCCIPRouter,
+				DestChainSelector: testCCIPDestChainSelector,
+				Receiver:          testCCIPReceiver,
+				Data:              "hello",
+				FeeToken:          test.feeToken,
+				MaxFee:            test.maxFee,
+				GasLimit:          "500000",
+				MinConfirmations:  "0",
+				EVMChainID:        "0",
+			}
+
+			ethClient := evmclimocks.NewClient(t)
+			keyStore := keystoremocks.NewEth(t)
+			txManager := txmmocks.NewMockEvmTxManager(t)
+			test.setupMocks(t, ethClient, keyStore, txManager)
+
+			cfg := configtest.NewTestGeneralConfig(t)
+			legacyChains := cltest.NewLegacyChainsWithMockChainAndTxManager(t, ethClient, cfg, txManager)
+			task.HelperSetDependencies(legacyChains, keyStore, nil, pipeline.DirectRequestJobType)
+
+			result, runInfo := task.Run(testutils.Context(t), logger.TestLogger(t), pipeline.NewVarsFrom(nil), nil)
+			assert.Equal(t, test.expectedRunInfo, runInfo)
+
+			if test.expectedErrorCause != nil || test.expectedErrorContains != "" {
+				require.Nil(t, result.Value)
+				if test.expectedErrorCause != nil {
+					require.Equal(t, test.expectedErrorCause, errors.Cause(result.Error))
+				}
+				if test.expectedErrorContains != "" {
+					require.Contains(t, result.Error.Error(), test.expectedErrorContains)
+				}
+			} else {
+				require.NoError(t, result.Error)
+				require.Nil(t, result.Value)
+			}
+		})
+	}
+}
@@ -25,6 +25,7 @@ import (
 // Return types:
 //
 //	nil
+//	[]byte (dry runs, the eth_call return data)
 type ETHTxTask struct {
 	BaseTask         `mapstructure:",squash"`
 	From             string `json:"from"`
@@ -165,6 +166,16 @@ func (t *ETHTxTask) Run(ctx context.Context, lggr logger.Logger, vars Vars, inpu
 		txRequest.MinConfirmations = clnull.Uint32From(uint32(minOutgoingConfirmations))
 	}
 
+	if isDryRun(ctx) {
+		// the transactions of ERC4337 keys are sent as user operations, which call from their account
+		if smartAccounts := chain.SmartAccounts(); smartAccounts != nil {
+			if account, ok := smartAccounts.UserOperationAccount(fromAddr); ok {
+				txRequest.FromAddress = account
+			}
+		}
+		return simulateTx(ctx, lggr, chain.Client(), txRequest)
+	}
+
 	_, err = txManager.CreateTransaction(ctx, txRequest)
 	if err != nil {
 		return Result{Error: errors.Wrapf(ErrTaskRunFailed, "while creating transaction: %v", err)}, retryableRunInfo()
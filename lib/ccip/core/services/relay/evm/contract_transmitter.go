@@ -12,6 +12,10 @@ import (
 	"github.com/ethereum/go-ethereum/common"
 	gethcommon "github.com/ethereum/go-ethereum/common"
 	"github.com/pkg/errors"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
 
 	"github.com/smartcontractkit/libocr/offchainreporting2plus/chains/evmutil"
 	ocrtypes "github.com/smartcontractkit/libocr/offchainreporting2plus/types"
@@ -124,7 +128,24 @@ func NewOCRContractTransmitter(
 }
 
 // Transmit sends the report to the on-chain smart contract's Transmit method.
+// Transmit creates the transmit tx of the report in a span, which the span of its broadcast continues
 func (oc *contractTransmitter) Transmit(ctx context.Context, reportCtx ocrtypes.ReportContext, report ocrtypes.Report, signatures []ocrtypes.AttributedOnchainSignature) error {
+	ctx, span := otel.Tracer("").Start(ctx, "ContractTransmitter.Transmit", trace.WithAttributes(
+		attribute.String("contractAddress", oc.contractAddress.String()),
+		attribute.String("configDigest", reportCtx.ConfigDigest.Hex()),
+		attribute.Int64("epoch", int64(reportCtx.Epoch)),
+		attribute.Int("round", int(reportCtx.Round)),
+	))
+	defer span.End()
+	err := oc.transmit(ctx, reportCtx, report, signatures)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+	return err
+}
+
+func (oc *contractTransmitter) transmit(ctx context.Context, reportCtx ocrtypes.ReportContext, report ocrtypes.Report, signatures []ocrtypes.AttributedOnchainSignature) error {
 	var rs [][32]byte
 	var ss [][32]byte
 	var vs [32]byte
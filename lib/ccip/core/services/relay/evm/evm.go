@@ -37,6 +37,7 @@ import (
 	"github.com/smartcontractkit/chainlink/v2/core/logger"
 
 	txmgrcommon "github.com/smartcontractkit/chainlink/v2/common/txmgr"
+	txmgrtypes "github.com/smartcontractkit/chainlink/v2/common/txmgr/types"
 	txm "github.com/smartcontractkit/chainlink/v2/core/chains/evm/txmgr"
 	evmtypes "github.com/smartcontractkit/chainlink/v2/core/chains/evm/types"
 	"github.com/smartcontractkit/chainlink/v2/core/chains/legacyevm"
@@ -609,9 +610,14 @@ func (r *Relayer) NewCCIPExecProvider(rargs commontypes.RelayArgs, pargs commont
 	if err != nil {
 		return nil, err
 	}
+	execChecker, err := ccipexec.ExecTransmitCheckerType(typ, ver)
+	if err != nil {
+		return nil, err
+	}
 	subjectID := chainToUUID(configWatcher.chain.ID())
 	contractTransmitter, err := newOnChainContractTransmitter(ctx, r.lggr, rargs, r.ks.Eth(), configWatcher, configTransmitterOpts{
-		subjectID: &subjectID,
+		subjectID:       &subjectID,
+		ccipExecChecker: execChecker,
 	}, OCR2AggregatorTransmissionContractABI, WithReportToEthMetadata(fn), WithRetention(0), WithExcludeSignatures())
 	if err != nil {
 		return nil, err
@@ -856,6 +862,8 @@ type configTransmitterOpts struct {
 	pluginGasLimit *uint32
 	// subjectID overrides the queueing subject id (the job external id will be used by default).
 	subjectID *uuid.UUID
+	// ccipExecChecker is the transmit checker of the offramp version of CCIP execution jobs.
+	ccipExecChecker txmgrtypes.TransmitCheckerType
 }
 
 // newOnChainContractTransmitter creates a new contract transmitter.
@@ -943,6 +951,11 @@ func generateTransmitterFrom(ctx context.Context, rargs commontypes.RelayArgs, e
 			ethKeystore,
 		)
 	case commontypes.CCIPExecution:
+		// Unless transactions are simulated or the job opts out, drop messages that another node executed while the
+		// report was queued. The checker costs an eth_call per message of the report.
+		if checker.CheckerType == "" && !relayConfig.DisableCCIPExecChecker {
+			checker.CheckerType = opts.ccipExecChecker
+		}
 		transmitter, err = cciptransmitter.NewTransmitterWithStatusChecker(
 			configWatcher.chain.TxManager(),
 			fromAddresses,
@@ -186,6 +186,9 @@ type RelayConfig struct {
 
 	DefaultTransactionQueueDepth uint32 `json:"defaultTransactionQueueDepth"`
 	SimulateTransactions         bool   `json:"simulateTransactions"`
+	// DisableCCIPExecChecker disables the transmit checker of CCIP execution jobs, which reads the execution state of
+	// every message of a report before sending it, to drop the messages executed by other nodes.
+	DisableCCIPExecChecker bool `json:"disableCCIPExecChecker"`
 
 	// Contract-specific
 	SendingKeys pq.StringArray `json:"sendingKeys"`
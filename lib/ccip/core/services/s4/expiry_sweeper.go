@@ -0,0 +1,155 @@
+package s4
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/jonboulle/clockwork"
+	"github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/services"
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+)
+
+const (
+	defaultSweepBatchSize = 1000
+	defaultSweepTimeout   = 30 * time.Second
+)
+
+var (
+	promSweeperDeletedRows = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "s4_expiry_sweeper_deleted_rows",
+		Help: "Number of expired S4 rows deleted by the expiry sweeper",
+	}, []string{"namespace"})
+	promSweeperErrors = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "s4_expiry_sweeper_errors",
+		Help: "Number of failed S4 expiry sweeps",
+	}, []string{"namespace"})
+	promSweeperDuration = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "s4_expiry_sweeper_duration_ms",
+		Help: "Duration of the last S4 expiry sweep in milliseconds",
+	}, []string{"namespace"})
+)
+
+// ExpirySweeperConfig configures the periodic deletion of the expired rows.
+type ExpirySweeperConfig struct {
+	// IntervalSec is the time between sweeps
+	IntervalSec uint32 `json:"intervalSec"`
+	// BatchSize limits the number of rows deleted by a single ORM call, defaults to 1000
+	BatchSize uint32 `json:"batchSize"`
+}
+
+// ExpirySweeper periodically deletes the expired rows, and their payload objects, from the ORM.
+type ExpirySweeper interface {
+	services.Service
+
+	// Sweep deletes all the rows expired at the current time, in batches.
+	// Returns the number of deleted rows.
+	Sweep(ctx context.Context) (int64, error)
+}
+
+type expirySweeper struct {
+	services.StateMachine
+
+	lggr      logger.Logger
+	orm       ORM
+	clock     clockwork.Clock
+	namespace string
+	interval  time.Duration
+	batchSize uint
+
+	closeWait sync.WaitGroup
+	stopCh    services.StopChan
+}
+
+var _ ExpirySweeper = (*expirySweeper)(nil)
+
+// NewExpirySweeper returns an ExpirySweeper for the ORM. The namespace is only used to label the metrics.
+func NewExpirySweeper(lggr logger.Logger, config ExpirySweeperConfig, orm ORM, clock clockwork.Clock, namespace string) (ExpirySweeper, error) {
+	if config.IntervalSec == 0 {
+		return nil, errors.New("intervalSec must be positive")
+	}
+	batchSize := uint(config.BatchSize)
+	if batchSize == 0 {
+		batchSize = defaultSweepBatchSize
+	}
+	return &expirySweeper{
+		lggr:      lggr.Named("S4ExpirySweeper"),
+		orm:       orm,
+		clock:     clock,
+		namespace: namespace,
+		interval:  time.Duration(config.IntervalSec) * time.Second,
+		batchSize: batchSize,
+		stopCh:    make(services.StopChan),
+	}, nil
+}
+
+func (s *expirySweeper) Start(context.Context) error {
+	return s.StartOnce("S4ExpirySweeper", func() error {
+		s.closeWait.Add(1)
+		go s.run()
+		return nil
+	})
+}
+
+func (s *expirySweeper) Close() error {
+	return s.StopOnce("S4ExpirySweeper", func() error {
+		close(s.stopCh)
+		s.closeWait.Wait()
+		return nil
+	})
+}
+
+func (s *expirySweeper) Name() string {
+	return s.lggr.Name()
+}
+
+func (s *expirySweeper) HealthReport() map[string]error {
+	return map[string]error{s.Name(): s.Healthy()}
+}
+
+func (s *expirySweeper) run() {
+	defer s.closeWait.Done()
+	ticker := s.clock.NewTicker(s.interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-s.stopCh:
+			return
+		case <-ticker.Chan():
+			ctx, cancel := s.stopCh.CtxCancel(context.WithTimeout(context.Background(), defaultSweepTimeout))
+			deleted, err := s.Sweep(ctx)
+			cancel()
+			if err != nil {
+				s.lggr.Errorw("failed to delete expired rows", "err", err, "deleted", deleted)
+			} else if deleted > 0 {
+				s.lggr.Debugw("deleted expired rows", "deleted", deleted)
+			}
+		}
+	}
+}
+
+func (s *expirySweeper) Sweep(ctx context.Context) (int64, error) {
+	start := time.Now()
+	defer func() {
+		promSweeperDuration.WithLabelValues(s.namespace).Set(float64(time.Since(start).Milliseconds()))
+	}()
+
+	now := s.clock.Now().UTC()
+	var total int64
+	for {
+		deleted, err := s.orm.DeleteExpired(ctx, s.batchSize, now)
+		if err != nil {
+			promSweeperErrors.WithLabelValues(s.namespace).Inc()
+			return total, err
+		}
+		total += deleted
+		promSweeperDeletedRows.WithLabelValues(s.namespace).Add(float64(deleted))
+		if deleted < int64(s.batchSize) {
+			return total, nil
+		}
+	}
+}
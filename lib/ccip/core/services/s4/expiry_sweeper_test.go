@@ -0,0 +1,81 @@
+package s4_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/jonboulle/clockwork"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/utils/big"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	"github.com/smartcontractkit/chainlink/v2/core/services/s4"
+)
+
+func TestExpirySweeper_Sweep(t *testing.T) {
+	t.Parallel()
+	ctx := testutils.Context(t)
+
+	orm := s4.NewInMemoryORM()
+	clock := clockwork.NewFakeClockAt(time.Now())
+	// the first 20 rows are expired
+	for i := 0; i < 25; i++ {
+		var address common.Address
+		address[0] = byte(i)
+		expiration := clock.Now().Add(time.Duration(i-20) * time.Minute)
+		require.NoError(t, orm.Update(ctx, &s4.Row{
+			Address:    big.New(address.Big()),
+			SlotId:     1,
+			Payload:    []byte{},
+			Version:    1,
+			Expiration: expiration.Add(time.Second).UnixMilli(),
+			Signature:  []byte{},
+		}))
+	}
+
+	sweeper, err := s4.NewExpirySweeper(logger.TestLogger(t), s4.ExpirySweeperConfig{IntervalSec: 1, BatchSize: 3}, orm, clock, "test")
+	require.NoError(t, err)
+
+	deleted, err := sweeper.Sweep(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, int64(20), deleted)
+
+	rows, err := orm.GetUnconfirmedRows(ctx, 100)
+	require.NoError(t, err)
+	assert.Len(t, rows, 5)
+
+	_, err = s4.NewExpirySweeper(logger.TestLogger(t), s4.ExpirySweeperConfig{}, orm, clock, "test")
+	assert.ErrorContains(t, err, "intervalSec must be positive")
+}
+
+func TestExpirySweeper_StartClose(t *testing.T) {
+	t.Parallel()
+	ctx := testutils.Context(t)
+
+	orm := s4.NewInMemoryORM()
+	clock := clockwork.NewFakeClockAt(time.Now())
+	address := big.New(testutils.NewAddress().Big())
+	require.NoError(t, orm.Update(ctx, &s4.Row{
+		Address:    address,
+		Payload:    []byte{},
+		Version:    1,
+		Expiration: clock.Now().Add(30 * time.Second).UnixMilli(),
+		Signature:  []byte{},
+	}))
+
+	sweeper, err := s4.NewExpirySweeper(logger.TestLogger(t), s4.ExpirySweeperConfig{IntervalSec: 60}, orm, clock, "test")
+	require.NoError(t, err)
+	require.NoError(t, sweeper.Start(ctx))
+	t.Cleanup(func() { assert.NoError(t, sweeper.Close()) })
+
+	clock.BlockUntil(1)
+	clock.Advance(time.Minute)
+	require.Eventually(t, func() bool {
+		_, err := orm.Get(ctx, address, 0)
+		return errors.Is(err, s4.ErrNotFound)
+	}, testutils.WaitTimeout(t), 10*time.Millisecond)
+}
@@ -103,11 +103,12 @@ func (o *inMemoryOrm) GetSnapshot(ctx context.Context, _ *AddressRange) ([]*Snap
 	for _, mrow := range o.rows {
 		if mrow.Row.Expiration > now {
 			rows = append(rows, &SnapshotRow{
-				Address:    big.New(mrow.Row.Address.ToInt()),
-				SlotId:     mrow.Row.SlotId,
-				Version:    mrow.Row.Version,
-				Expiration: mrow.Row.Expiration,
-				Confirmed:  mrow.Row.Confirmed,
+				Address:     big.New(mrow.Row.Address.ToInt()),
+				SlotId:      mrow.Row.SlotId,
+				Version:     mrow.Row.Version,
+				Expiration:  mrow.Row.Expiration,
+				Confirmed:   mrow.Row.Confirmed,
+				PayloadSize: uint64(len(mrow.Row.Payload)),
 			})
 		}
 	}
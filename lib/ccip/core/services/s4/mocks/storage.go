@@ -139,7 +139,7 @@ func (_c *Storage_Get_Call) RunAndReturn(run func(context.Context, *s4.Key) (*s4
 }
 
 // List provides a mock function with given fields: ctx, address
-func (_m *Storage) List(ctx context.Context, address common.Address) ([]*s4.SnapshotRow, error) {
+func (_m *Storage) List(ctx context.Context, address common.Address) ([]*s4.SnapshotRow, *s4.Usage, error) {
 	ret := _m.Called(ctx, address)
 
 	if len(ret) == 0 {
@@ -147,8 +147,9 @@ func (_m *Storage) List(ctx context.Context, address common.Address) ([]*s4.Snap
 	}
 
 	var r0 []*s4.SnapshotRow
-	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, common.Address) ([]*s4.SnapshotRow, error)); ok {
+	var r1 *s4.Usage
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, common.Address) ([]*s4.SnapshotRow, *s4.Usage, error)); ok {
 		return rf(ctx, address)
 	}
 	if rf, ok := ret.Get(0).(func(context.Context, common.Address) []*s4.SnapshotRow); ok {
@@ -159,13 +160,21 @@ func (_m *Storage) List(ctx context.Context, address common.Address) ([]*s4.Snap
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, common.Address) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, common.Address) *s4.Usage); ok {
 		r1 = rf(ctx, address)
 	} else {
-		r1 = ret.Error(1)
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*s4.Usage)
+		}
 	}
 
-	return r0, r1
+	if rf, ok := ret.Get(2).(func(context.Context, common.Address) error); ok {
+		r2 = rf(ctx, address)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
 }
 
 // Storage_List_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'List'
@@ -187,12 +196,12 @@ func (_c *Storage_List_Call) Run(run func(ctx context.Context, address common.Ad
 	return _c
 }
 
-func (_c *Storage_List_Call) Return(_a0 []*s4.SnapshotRow, _a1 error) *Storage_List_Call {
-	_c.Call.Return(_a0, _a1)
+func (_c *Storage_List_Call) Return(_a0 []*s4.SnapshotRow, _a1 *s4.Usage, _a2 error) *Storage_List_Call {
+	_c.Call.Return(_a0, _a1, _a2)
 	return _c
 }
 
-func (_c *Storage_List_Call) RunAndReturn(run func(context.Context, common.Address) ([]*s4.SnapshotRow, error)) *Storage_List_Call {
+func (_c *Storage_List_Call) RunAndReturn(run func(context.Context, common.Address) ([]*s4.SnapshotRow, *s4.Usage, error)) *Storage_List_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -0,0 +1,193 @@
+package s4
+
+import (
+	"context"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+
+	"github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+const (
+	ObjectStoreTypeFilesystem = "filesystem"
+	ObjectStoreTypeS3         = "s3"
+)
+
+var promObjectStoreErrors = promauto.NewCounterVec(prometheus.CounterOpts{
+	Name: "s4_object_store_errors",
+	Help: "Number of failed S4 object store operations",
+}, []string{"namespace", "operation"})
+
+// ObjectStore persists the payloads of the rows outside of the database.
+// All functions are thread-safe.
+type ObjectStore interface {
+	// Put writes the object, replacing any existing object with the same key.
+	Put(ctx context.Context, key string, data []byte) error
+
+	// Get reads the object.
+	// If such object does not exist, ErrNotFound is returned.
+	Get(ctx context.Context, key string) ([]byte, error)
+
+	// Delete removes the object. Deleting a missing object is not an error.
+	Delete(ctx context.Context, key string) error
+}
+
+// ObjectStoreConfig specifies where the payloads are stored.
+// S3 credentials are read from the standard AWS_ACCESS_KEY_ID, AWS_SECRET_ACCESS_KEY
+// and AWS_SESSION_TOKEN environment variables.
+type ObjectStoreConfig struct {
+	// Type is either "filesystem" or "s3"
+	Type string `json:"type"`
+	// Dir is the root directory of the filesystem store
+	Dir string `json:"dir"`
+	// Endpoint is the URL of the S3-compatible service, e.g. https://s3.us-east-1.amazonaws.com
+	Endpoint string `json:"endpoint"`
+	Region   string `json:"region"`
+	Bucket   string `json:"bucket"`
+	// Prefix is prepended to all object keys
+	Prefix string `json:"prefix"`
+}
+
+func (c ObjectStoreConfig) Validate() error {
+	switch c.Type {
+	case ObjectStoreTypeFilesystem:
+		if c.Dir == "" {
+			return errors.New("dir is required for the filesystem object store")
+		}
+	case ObjectStoreTypeS3:
+		if c.Endpoint == "" || c.Bucket == "" || c.Region == "" {
+			return errors.New("endpoint, region and bucket are required for the s3 object store")
+		}
+	default:
+		return errors.Errorf("unknown object store type %q", c.Type)
+	}
+	return nil
+}
+
+// NewObjectStore returns the filesystem or S3 store of the config.
+func NewObjectStore(config ObjectStoreConfig) (ObjectStore, error) {
+	if err := config.Validate(); err != nil {
+		return nil, err
+	}
+	if config.Type == ObjectStoreTypeFilesystem {
+		return NewFilesystemObjectStore(config.Dir)
+	}
+	return NewS3ObjectStore(config.Endpoint, config.Region, config.Bucket, config.Prefix, S3Credentials{
+		AccessKeyID:     os.Getenv("AWS_ACCESS_KEY_ID"),
+		SecretAccessKey: os.Getenv("AWS_SECRET_ACCESS_KEY"),
+		SessionToken:    os.Getenv("AWS_SESSION_TOKEN"),
+	})
+}
+
+type filesystemObjectStore struct {
+	dir string
+}
+
+var _ ObjectStore = (*filesystemObjectStore)(nil)
+
+// NewFilesystemObjectStore returns an ObjectStore keeping each object in a file under dir.
+func NewFilesystemObjectStore(dir string) (ObjectStore, error) {
+	if err := os.MkdirAll(dir, 0700); err != nil {
+		return nil, errors.Wrap(err, "failed to create object store directory")
+	}
+	return &filesystemObjectStore{dir: dir}, nil
+}
+
+func (f *filesystemObjectStore) path(key string) (string, error) {
+	if key == "" || strings.Contains(key, "..") || strings.HasPrefix(key, "/") {
+		return "", errors.Errorf("invalid object key %q", key)
+	}
+	return filepath.Join(f.dir, filepath.FromSlash(key)), nil
+}
+
+func (f *filesystemObjectStore) Put(_ context.Context, key string, data []byte) error {
+	path, err := f.path(key)
+	if err != nil {
+		return err
+	}
+	if err = os.MkdirAll(filepath.Dir(path), 0700); err != nil {
+		return err
+	}
+	// write to a temporary file first, so that readers never see a partial object
+	tmp, err := os.CreateTemp(filepath.Dir(path), ".tmp-*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+	if _, err = tmp.Write(data); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err = tmp.Close(); err != nil {
+		return err
+	}
+	return os.Rename(tmp.Name(), path)
+}
+
+func (f *filesystemObjectStore) Get(_ context.Context, key string) ([]byte, error) {
+	path, err := f.path(key)
+	if err != nil {
+		return nil, err
+	}
+	data, err := os.ReadFile(path)
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, ErrNotFound
+	}
+	return data, err
+}
+
+func (f *filesystemObjectStore) Delete(_ context.Context, key string) error {
+	path, err := f.path(key)
+	if err != nil {
+		return err
+	}
+	if err = os.Remove(path); err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return err
+	}
+	return nil
+}
+
+type inMemoryObjectStore struct {
+	objects map[string][]byte
+	mu      sync.RWMutex
+}
+
+var _ ObjectStore = (*inMemoryObjectStore)(nil)
+
+func NewInMemoryObjectStore() ObjectStore {
+	return &inMemoryObjectStore{
+		objects: make(map[string][]byte),
+	}
+}
+
+func (m *inMemoryObjectStore) Put(_ context.Context, key string, data []byte) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.objects[key] = append([]byte(nil), data...)
+	return nil
+}
+
+func (m *inMemoryObjectStore) Get(_ context.Context, key string) ([]byte, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	data, ok := m.objects[key]
+	if !ok {
+		return nil, ErrNotFound
+	}
+	return append([]byte(nil), data...), nil
+}
+
+func (m *inMemoryObjectStore) Delete(_ context.Context, key string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	delete(m.objects, key)
+	return nil
+}
@@ -0,0 +1,121 @@
+package s4_test
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/services/s4"
+)
+
+func testObjectStore(t *testing.T, store s4.ObjectStore) {
+	ctx := testutils.Context(t)
+
+	_, err := store.Get(ctx, "functions/0x1/1/1")
+	assert.ErrorIs(t, err, s4.ErrNotFound)
+
+	require.NoError(t, store.Put(ctx, "functions/0x1/1/1", []byte("foo")))
+	require.NoError(t, store.Put(ctx, "functions/0x1/1/2", []byte("bar")))
+	data, err := store.Get(ctx, "functions/0x1/1/1")
+	require.NoError(t, err)
+	assert.Equal(t, []byte("foo"), data)
+
+	require.NoError(t, store.Put(ctx, "functions/0x1/1/1", []byte("baz")))
+	data, err = store.Get(ctx, "functions/0x1/1/1")
+	require.NoError(t, err)
+	assert.Equal(t, []byte("baz"), data)
+
+	require.NoError(t, store.Delete(ctx, "functions/0x1/1/1"))
+	_, err = store.Get(ctx, "functions/0x1/1/1")
+	assert.ErrorIs(t, err, s4.ErrNotFound)
+	require.NoError(t, store.Delete(ctx, "functions/0x1/1/1"))
+
+	data, err = store.Get(ctx, "functions/0x1/1/2")
+	require.NoError(t, err)
+	assert.Equal(t, []byte("bar"), data)
+}
+
+func TestObjectStore_InMemory(t *testing.T) {
+	t.Parallel()
+
+	testObjectStore(t, s4.NewInMemoryObjectStore())
+}
+
+func TestObjectStore_Filesystem(t *testing.T) {
+	t.Parallel()
+
+	store, err := s4.NewFilesystemObjectStore(t.TempDir())
+	require.NoError(t, err)
+	testObjectStore(t, store)
+
+	_, err = store.Get(testutils.Context(t), "../outside")
+	assert.ErrorContains(t, err, "invalid object key")
+}
+
+func TestObjectStore_S3(t *testing.T) {
+	t.Parallel()
+
+	var mu sync.Mutex
+	objects := make(map[string][]byte)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if !strings.HasPrefix(r.Header.Get("Authorization"), "AWS4-HMAC-SHA256 Credential=key/") {
+			w.WriteHeader(http.StatusForbidden)
+			return
+		}
+		mu.Lock()
+		defer mu.Unlock()
+		switch r.Method {
+		case http.MethodPut:
+			body, err := io.ReadAll(r.Body)
+			assert.NoError(t, err)
+			objects[r.URL.Path] = body
+		case http.MethodGet:
+			data, ok := objects[r.URL.Path]
+			if !ok {
+				w.WriteHeader(http.StatusNotFound)
+				return
+			}
+			_, _ = w.Write(data)
+		case http.MethodDelete:
+			delete(objects, r.URL.Path)
+			w.WriteHeader(http.StatusNoContent)
+		}
+	}))
+	defer srv.Close()
+
+	store, err := s4.NewS3ObjectStore(srv.URL, "us-east-1", "bucket", "s4/", s4.S3Credentials{AccessKeyID: "key", SecretAccessKey: "secret"})
+	require.NoError(t, err)
+	testObjectStore(t, store)
+
+	ctx := testutils.Context(t)
+	require.NoError(t, store.Put(ctx, "functions/0x1/2/1", []byte("foo")))
+	mu.Lock()
+	assert.Equal(t, []byte("foo"), objects["/bucket/s4/functions/0x1/2/1"])
+	mu.Unlock()
+
+	t.Run("forbidden", func(t *testing.T) {
+		store, err := s4.NewS3ObjectStore(srv.URL, "us-east-1", "bucket", "", s4.S3Credentials{AccessKeyID: "other", SecretAccessKey: "secret"})
+		require.NoError(t, err)
+		assert.ErrorContains(t, store.Put(ctx, "key", []byte("foo")), "403 Forbidden")
+	})
+}
+
+func TestObjectStoreConfig_Validate(t *testing.T) {
+	t.Parallel()
+
+	assert.NoError(t, s4.ObjectStoreConfig{Type: "filesystem", Dir: "/tmp/s4"}.Validate())
+	assert.NoError(t, s4.ObjectStoreConfig{Type: "s3", Endpoint: "https://s3.amazonaws.com", Region: "us-east-1", Bucket: "s4"}.Validate())
+	assert.ErrorContains(t, s4.ObjectStoreConfig{Type: "filesystem"}.Validate(), "dir is required")
+	assert.ErrorContains(t, s4.ObjectStoreConfig{Type: "s3", Endpoint: "https://s3.amazonaws.com"}.Validate(), "bucket are required")
+	assert.ErrorContains(t, s4.ObjectStoreConfig{Type: "gcs"}.Validate(), "unknown object store type")
+
+	_, err := s4.NewS3ObjectStore("https://s3.amazonaws.com", "us-east-1", "s4", "", s4.S3Credentials{})
+	assert.ErrorContains(t, err, "credentials are not set")
+}
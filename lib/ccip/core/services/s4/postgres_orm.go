@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/google/uuid"
 	"github.com/pkg/errors"
 
 	"github.com/smartcontractkit/chainlink-common/pkg/sqlutil"
@@ -21,6 +22,14 @@ type orm struct {
 	ds        sqlutil.DataSource
 	tableName string
 	namespace string
+	// store keeps the payloads when set, the table only has the object keys then
+	store ObjectStore
+}
+
+// objectRow is a Row with the key of the payload in the object store, if any.
+type objectRow struct {
+	Row
+	PayloadObject sql.NullString
 }
 
 var _ ORM = (*orm)(nil)
@@ -33,10 +42,21 @@ func NewPostgresORM(ds sqlutil.DataSource, tableName, namespace string) ORM {
 	}
 }
 
+// NewPostgresObjectStoreORM returns an ORM keeping the row metadata in the table and the payloads in the store.
+// Rows written before the store was configured keep their payloads in the table and remain readable.
+func NewPostgresObjectStoreORM(ds sqlutil.DataSource, tableName, namespace string, store ObjectStore) ORM {
+	return &orm{
+		ds:        ds,
+		tableName: fmt.Sprintf(`"%s".%s`, s4PostgresSchema, tableName),
+		namespace: namespace,
+		store:     store,
+	}
+}
+
 func (o *orm) Get(ctx context.Context, address *big.Big, slotId uint) (*Row, error) {
-	row := &Row{}
+	row := &objectRow{}
 
-	stmt := fmt.Sprintf(`SELECT address, slot_id, version, expiration, confirmed, payload, signature FROM %s 
+	stmt := fmt.Sprintf(`SELECT address, slot_id, version, expiration, confirmed, payload, signature, payload_object FROM %s 
 WHERE namespace=$1 AND address=$2 AND slot_id=$3;`, o.tableName)
 	if err := o.ds.GetContext(ctx, row, stmt, o.namespace, address, slotId); err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
@@ -44,10 +64,17 @@ WHERE namespace=$1 AND address=$2 AND slot_id=$3;`, o.tableName)
 		}
 		return nil, err
 	}
-	return row, nil
+	if err := o.loadPayload(ctx, row); err != nil {
+		return nil, err
+	}
+	return &row.Row, nil
 }
 
 func (o *orm) Update(ctx context.Context, row *Row) error {
+	if o.store != nil {
+		return o.updateObject(ctx, row)
+	}
+
 	// This query inserts or updates a row, depending on whether the version is higher than the existing one.
 	// We only allow the same version when the row is confirmed.
 	// We never transition back from unconfirmed to confirmed state.
@@ -59,6 +86,8 @@ expiration = EXCLUDED.expiration,
 confirmed = EXCLUDED.confirmed,
 payload = EXCLUDED.payload,
 signature = EXCLUDED.signature,
+payload_object = NULL,
+payload_size = NULL,
 updated_at = NOW()
 WHERE (t.version < EXCLUDED.version) OR (t.version <= EXCLUDED.version AND EXCLUDED.confirmed IS TRUE)
 RETURNING id;`, o.tableName)
@@ -70,8 +99,95 @@ RETURNING id;`, o.tableName)
 	return err
 }
 
+// updateObject writes the payload to the store under a key unique to this write, and only then upserts the row
+// referencing it. The object is never shared with another write, so a rejected update cannot touch the payload of
+// the stored version: the new object is simply deleted. The object of the replaced version is deleted once the row
+// is updated.
+func (o *orm) updateObject(ctx context.Context, row *Row) error {
+	key := o.objectKey(row)
+	if err := o.store.Put(ctx, key, row.Payload); err != nil {
+		promObjectStoreErrors.WithLabelValues(o.namespace, "put").Inc()
+		return errors.Wrap(err, "failed to write payload object")
+	}
+
+	stmt := fmt.Sprintf(`WITH previous AS (SELECT payload_object FROM %s WHERE namespace=$1 AND address=$2 AND slot_id=$3 FOR UPDATE)
+INSERT INTO %s as t (namespace, address, slot_id, version, expiration, confirmed, payload, signature, payload_object, payload_size, updated_at)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, NOW())
+ON CONFLICT (namespace, address, slot_id)
+DO UPDATE SET version = EXCLUDED.version,
+expiration = EXCLUDED.expiration,
+confirmed = EXCLUDED.confirmed,
+payload = EXCLUDED.payload,
+signature = EXCLUDED.signature,
+payload_object = EXCLUDED.payload_object,
+payload_size = EXCLUDED.payload_size,
+updated_at = NOW()
+WHERE (t.version < EXCLUDED.version) OR (t.version <= EXCLUDED.version AND EXCLUDED.confirmed IS TRUE)
+RETURNING (SELECT payload_object FROM previous);`, o.tableName, o.tableName)
+	var previous sql.NullString
+	err := o.ds.GetContext(ctx, &previous, stmt, o.namespace, row.Address, row.SlotId, row.Version, row.Expiration, row.Confirmed, []byte{}, row.Signature, key, len(row.Payload))
+	if err != nil {
+		o.deleteObject(ctx, key)
+		if errors.Is(err, sql.ErrNoRows) {
+			return ErrVersionTooLow
+		}
+		return err
+	}
+	if previous.Valid {
+		o.deleteObject(ctx, previous.String)
+	}
+	return nil
+}
+
+// objectKey returns a new key for the payload of row. The key ends with a random nonce, so that writes of the same
+// version never overwrite each other's objects.
+func (o *orm) objectKey(row *Row) string {
+	key := fmt.Sprintf("%s/%d/%d/%s", row.Address.Hex(), row.SlotId, row.Version, uuid.NewString())
+	if o.namespace == "" {
+		return key
+	}
+	return o.namespace + "/" + key
+}
+
+func (o *orm) loadPayload(ctx context.Context, row *objectRow) error {
+	if !row.PayloadObject.Valid {
+		return nil
+	}
+	if o.store == nil {
+		return errors.Errorf("payload of slot %d is in the object store, which is not configured", row.SlotId)
+	}
+	payload, err := o.store.Get(ctx, row.PayloadObject.String)
+	if err != nil {
+		promObjectStoreErrors.WithLabelValues(o.namespace, "get").Inc()
+		return errors.Wrapf(err, "failed to read payload object %s", row.PayloadObject.String)
+	}
+	row.Payload = payload
+	return nil
+}
+
+// deleteObject deletes an object no longer referenced by any row. Failures leave an orphaned object behind and are
+// only counted, as the row update already succeeded.
+func (o *orm) deleteObject(ctx context.Context, key string) {
+	if err := o.store.Delete(ctx, key); err != nil {
+		promObjectStoreErrors.WithLabelValues(o.namespace, "delete").Inc()
+	}
+}
+
 func (o *orm) DeleteExpired(ctx context.Context, limit uint, utcNow time.Time) (int64, error) {
 	with := fmt.Sprintf(`WITH rows AS (SELECT id FROM %s WHERE namespace = $1 AND expiration < $2 LIMIT $3)`, o.tableName)
+	if o.store != nil {
+		var objects []sql.NullString
+		stmt := fmt.Sprintf(`%s DELETE FROM %s WHERE id IN (SELECT id FROM rows) RETURNING payload_object;`, with, o.tableName)
+		if err := o.ds.SelectContext(ctx, &objects, stmt, o.namespace, utcNow.UnixMilli(), limit); err != nil {
+			return 0, err
+		}
+		for _, object := range objects {
+			if object.Valid {
+				o.deleteObject(ctx, object.String)
+			}
+		}
+		return int64(len(objects)), nil
+	}
 	stmt := fmt.Sprintf(`%s DELETE FROM %s WHERE id IN (SELECT id FROM rows);`, with, o.tableName)
 	result, err := o.ds.ExecContext(ctx, stmt, o.namespace, utcNow.UnixMilli(), limit)
 	if err != nil {
@@ -83,7 +199,7 @@ func (o *orm) DeleteExpired(ctx context.Context, limit uint, utcNow time.Time) (
 func (o *orm) GetSnapshot(ctx context.Context, addressRange *AddressRange) ([]*SnapshotRow, error) {
 	rows := make([]*SnapshotRow, 0)
 
-	stmt := fmt.Sprintf(`SELECT address, slot_id, version, expiration, confirmed, octet_length(payload) AS payload_size FROM %s WHERE namespace = $1 AND address >= $2 AND address <= $3;`, o.tableName)
+	stmt := fmt.Sprintf(`SELECT address, slot_id, version, expiration, confirmed, COALESCE(payload_size, octet_length(payload)) AS payload_size FROM %s WHERE namespace = $1 AND address >= $2 AND address <= $3;`, o.tableName)
 	if err := o.ds.SelectContext(ctx, &rows, stmt, o.namespace, addressRange.MinAddress, addressRange.MaxAddress); err != nil {
 		if !errors.Is(err, sql.ErrNoRows) {
 			return nil, err
@@ -93,14 +209,21 @@ func (o *orm) GetSnapshot(ctx context.Context, addressRange *AddressRange) ([]*S
 }
 
 func (o *orm) GetUnconfirmedRows(ctx context.Context, limit uint) ([]*Row, error) {
-	rows := make([]*Row, 0)
+	objectRows := make([]*objectRow, 0)
 
-	stmt := fmt.Sprintf(`SELECT address, slot_id, version, expiration, confirmed, payload, signature FROM %s
+	stmt := fmt.Sprintf(`SELECT address, slot_id, version, expiration, confirmed, payload, signature, payload_object FROM %s
 WHERE namespace = $1 AND confirmed IS FALSE ORDER BY updated_at LIMIT $2;`, o.tableName)
-	if err := o.ds.SelectContext(ctx, &rows, stmt, o.namespace, limit); err != nil {
+	if err := o.ds.SelectContext(ctx, &objectRows, stmt, o.namespace, limit); err != nil {
 		if !errors.Is(err, sql.ErrNoRows) {
 			return nil, err
 		}
 	}
+	rows := make([]*Row, len(objectRows))
+	for i, row := range objectRows {
+		if err := o.loadPayload(ctx, row); err != nil {
+			return nil, err
+		}
+		rows[i] = &row.Row
+	}
 	return rows, nil
 }
@@ -2,7 +2,9 @@ package s4_test
 
 import (
 	"errors"
+	"io/fs"
 	"math"
+	"path/filepath"
 	"testing"
 	"time"
 
@@ -13,6 +15,7 @@ import (
 	"github.com/smartcontractkit/chainlink/v2/core/services/s4"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func setupORM(t *testing.T, namespace string) s4.ORM {
@@ -285,3 +288,102 @@ func TestPostgresORM_BigIntVersion(t *testing.T) {
 	assert.NoError(t, err)
 	assert.Equal(t, row, gotRow)
 }
+
+func TestPostgresORM_ObjectStore(t *testing.T) {
+	t.Parallel()
+	ctx := testutils.Context(t)
+
+	db := pgtest.NewSqlxDB(t)
+	dir := t.TempDir()
+	store, err := s4.NewFilesystemObjectStore(dir)
+	require.NoError(t, err)
+	orm := s4.NewPostgresObjectStoreORM(db, s4.SharedTableName, "objects", store)
+
+	countObjects := func() int {
+		count := 0
+		require.NoError(t, filepath.WalkDir(dir, func(_ string, d fs.DirEntry, err error) error {
+			if err == nil && !d.IsDir() {
+				count++
+			}
+			return err
+		}))
+		return count
+	}
+
+	rows := generateTestRows(t, 4)
+	for _, row := range rows {
+		require.NoError(t, orm.Update(ctx, row))
+	}
+	assert.Equal(t, 4, countObjects())
+
+	t.Run("payloads are read from the store", func(t *testing.T) {
+		gotRow, err := orm.Get(ctx, rows[0].Address, rows[0].SlotId)
+		require.NoError(t, err)
+		assert.Equal(t, rows[0], gotRow)
+
+		unconfirmed, err := orm.GetUnconfirmedRows(ctx, 10)
+		require.NoError(t, err)
+		require.Len(t, unconfirmed, 2)
+		assert.Equal(t, rows[1].Payload, unconfirmed[0].Payload)
+
+		var payload []byte
+		require.NoError(t, db.GetContext(ctx, &payload, `SELECT payload FROM "s4".shared WHERE namespace = 'objects' AND address = $1`, rows[0].Address))
+		assert.Empty(t, payload)
+
+		snapshot, err := orm.GetSnapshot(ctx, s4.NewFullAddressRange())
+		require.NoError(t, err)
+		require.Len(t, snapshot, 4)
+		for _, row := range snapshot {
+			assert.Equal(t, uint64(32), row.PayloadSize)
+		}
+	})
+
+	t.Run("replaced versions are deleted", func(t *testing.T) {
+		row := rows[1].Clone()
+		row.Version++
+		row.Payload = []byte("new payload")
+		require.NoError(t, orm.Update(ctx, row))
+		assert.Equal(t, 4, countObjects())
+
+		gotRow, err := orm.Get(ctx, row.Address, row.SlotId)
+		require.NoError(t, err)
+		assert.Equal(t, row, gotRow)
+
+		// a rejected update does not leave an object behind, nor deletes the stored one
+		stale := rows[1].Clone()
+		assert.ErrorIs(t, orm.Update(ctx, stale), s4.ErrVersionTooLow)
+		row.Confirmed = true
+		require.NoError(t, orm.Update(ctx, row))
+		row.Confirmed = false
+		assert.ErrorIs(t, orm.Update(ctx, row), s4.ErrVersionTooLow)
+		assert.Equal(t, 4, countObjects())
+		gotRow, err = orm.Get(ctx, row.Address, row.SlotId)
+		require.NoError(t, err)
+		assert.Equal(t, []byte("new payload"), gotRow.Payload)
+	})
+
+	t.Run("a rejected update of the same version keeps the stored payload", func(t *testing.T) {
+		row := rows[2].Clone()
+		row.Version++
+		row.Payload = []byte("payload A")
+		row.Signature = []byte("signature A")
+		require.NoError(t, orm.Update(ctx, row))
+
+		other := row.Clone()
+		other.Payload = []byte("payload B")
+		other.Signature = []byte("signature B")
+		assert.ErrorIs(t, orm.Update(ctx, other), s4.ErrVersionTooLow)
+		assert.Equal(t, 4, countObjects())
+
+		gotRow, err := orm.Get(ctx, row.Address, row.SlotId)
+		require.NoError(t, err)
+		assert.Equal(t, row, gotRow)
+	})
+
+	t.Run("expired objects are deleted", func(t *testing.T) {
+		deleted, err := orm.DeleteExpired(ctx, 10, time.Now().Add(2*time.Hour).UTC())
+		require.NoError(t, err)
+		assert.Equal(t, int64(4), deleted)
+		assert.Equal(t, 0, countObjects())
+	})
+}
@@ -16,6 +16,44 @@ type Constraints struct {
 	MaxPayloadSizeBytes    uint   `json:"maxPayloadSizeBytes"`
 	MaxSlotsPerUser        uint   `json:"maxSlotsPerUser"`
 	MaxExpirationLengthSec uint64 `json:"maxExpirationLengthSec"`
+	// MaxTotalPayloadBytesPerUser limits the total size of the non-expired payloads of a user, zero means no limit.
+	MaxTotalPayloadBytesPerUser uint64 `json:"maxTotalPayloadBytesPerUser"`
+	// Quotas override the per-user limits for individual addresses.
+	Quotas map[common.Address]Quota `json:"quotas,omitempty"`
+}
+
+// Quota specifies the storage limits of an address.
+// Zero values fall back to the per-user Constraints.
+type Quota struct {
+	MaxSlots             uint   `json:"maxSlots"`
+	MaxTotalPayloadBytes uint64 `json:"maxTotalPayloadBytes"`
+}
+
+// QuotaFor returns the effective quota of the address.
+func (c Constraints) QuotaFor(address common.Address) Quota {
+	quota := Quota{
+		MaxSlots:             c.MaxSlotsPerUser,
+		MaxTotalPayloadBytes: c.MaxTotalPayloadBytesPerUser,
+	}
+	if override, ok := c.Quotas[address]; ok {
+		if override.MaxSlots > 0 {
+			quota.MaxSlots = override.MaxSlots
+		}
+		if override.MaxTotalPayloadBytes > 0 {
+			quota.MaxTotalPayloadBytes = override.MaxTotalPayloadBytes
+		}
+	}
+	return quota
+}
+
+// Usage is the storage used by an address.
+type Usage struct {
+	// SlotsUsed is the number of non-expired slots
+	SlotsUsed uint
+	// PayloadBytesUsed is the total size of the non-expired payloads
+	PayloadBytesUsed uint64
+	// Quota is the effective quota of the address
+	Quota Quota
 }
 
 // Key identifies a versioned user record.
@@ -59,9 +97,9 @@ type Storage interface {
 	// For signature calculation see envelope.go
 	Put(ctx context.Context, key *Key, record *Record, signature []byte) error
 
-	// List returns a snapshot for the specified address.
+	// List returns a snapshot for the specified address, and its usage.
 	// Slots having no data are not returned.
-	List(ctx context.Context, address common.Address) ([]*SnapshotRow, error)
+	List(ctx context.Context, address common.Address) ([]*SnapshotRow, *Usage, error)
 }
 
 type storage struct {
@@ -87,7 +125,7 @@ func (s *storage) Constraints() Constraints {
 }
 
 func (s *storage) Get(ctx context.Context, key *Key) (*Record, *Metadata, error) {
-	if key.SlotId >= s.contraints.MaxSlotsPerUser {
+	if key.SlotId >= s.contraints.QuotaFor(key.Address).MaxSlots {
 		return nil, nil, ErrSlotIdTooBig
 	}
 
@@ -116,7 +154,23 @@ func (s *storage) Get(ctx context.Context, key *Key) (*Record, *Metadata, error)
 	return record, metadata, nil
 }
 
-func (s *storage) List(ctx context.Context, address common.Address) ([]*SnapshotRow, error) {
+func (s *storage) List(ctx context.Context, address common.Address) ([]*SnapshotRow, *Usage, error) {
+	rows, err := s.snapshot(ctx, address)
+	if err != nil {
+		return nil, nil, err
+	}
+	usage := &Usage{Quota: s.contraints.QuotaFor(address)}
+	now := s.clock.Now().UnixMilli()
+	for _, row := range rows {
+		if row.Expiration > now {
+			usage.SlotsUsed++
+			usage.PayloadBytesUsed += row.PayloadSize
+		}
+	}
+	return rows, usage, nil
+}
+
+func (s *storage) snapshot(ctx context.Context, address common.Address) ([]*SnapshotRow, error) {
 	bigAddress := big.New(address.Big())
 	sar, err := NewSingleAddressRange(bigAddress)
 	if err != nil {
@@ -126,7 +180,8 @@ func (s *storage) List(ctx context.Context, address common.Address) ([]*Snapshot
 }
 
 func (s *storage) Put(ctx context.Context, key *Key, record *Record, signature []byte) error {
-	if key.SlotId >= s.contraints.MaxSlotsPerUser {
+	quota := s.contraints.QuotaFor(key.Address)
+	if key.SlotId >= quota.MaxSlots {
 		return ErrSlotIdTooBig
 	}
 	if len(record.Payload) > int(s.contraints.MaxPayloadSizeBytes) {
@@ -146,6 +201,12 @@ func (s *storage) Put(ctx context.Context, key *Key, record *Record, signature [
 		return ErrWrongSignature
 	}
 
+	if quota.MaxTotalPayloadBytes > 0 {
+		if err = s.checkPayloadQuota(ctx, key, uint64(len(record.Payload)), quota.MaxTotalPayloadBytes); err != nil {
+			return err
+		}
+	}
+
 	row := &Row{
 		Address:    big.New(key.Address.Big()),
 		SlotId:     key.SlotId,
@@ -160,3 +221,24 @@ func (s *storage) Put(ctx context.Context, key *Key, record *Record, signature [
 
 	return s.orm.Update(ctx, row)
 }
+
+// checkPayloadQuota returns ErrQuotaExceeded if storing the payload in the slot would take the total size of the
+// non-expired payloads of the address over the limit. The payload currently stored in the slot is replaced, so it does
+// not count.
+func (s *storage) checkPayloadQuota(ctx context.Context, key *Key, payloadSize uint64, limit uint64) error {
+	rows, err := s.snapshot(ctx, key.Address)
+	if err != nil {
+		return err
+	}
+	total := payloadSize
+	now := s.clock.Now().UnixMilli()
+	for _, row := range rows {
+		if row.SlotId != key.SlotId && row.Expiration > now {
+			total += row.PayloadSize
+		}
+	}
+	if total > limit {
+		return ErrQuotaExceeded
+	}
+	return nil
+}
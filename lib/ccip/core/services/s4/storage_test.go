@@ -4,6 +4,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/jonboulle/clockwork"
 
 	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/utils/big"
@@ -223,9 +224,10 @@ func TestStorage_List(t *testing.T) {
 	assert.NoError(t, err)
 	ormMock.On("GetSnapshot", mock.Anything, addressRange).Return(ormRows, nil)
 
-	rows, err := storage.List(testutils.Context(t), address)
+	rows, usage, err := storage.List(testutils.Context(t), address)
 	require.NoError(t, err)
 	assert.Len(t, rows, 2)
+	assert.Equal(t, &s4.Usage{Quota: s4.Quota{MaxSlots: constraints.MaxSlotsPerUser}}, usage)
 	for _, row := range rows {
 		if row.SlotId == ormRows[0].SlotId {
 			assert.Equal(t, ormRows[0], row)
@@ -235,3 +237,43 @@ func TestStorage_List(t *testing.T) {
 		}
 	}
 }
+
+func TestStorage_Quota(t *testing.T) {
+	t.Parallel()
+	ctx := testutils.Context(t)
+
+	privateKey, address := testutils.NewPrivateKeyAndAddress(t)
+	quotaConstraints := constraints
+	quotaConstraints.MaxTotalPayloadBytesPerUser = 40
+	quotaConstraints.Quotas = map[common.Address]s4.Quota{address: {MaxSlots: 3}}
+	now := time.Now()
+	storage := s4.NewStorage(logger.TestLogger(t), quotaConstraints, s4.NewInMemoryORM(), clockwork.NewFakeClockAt(now))
+
+	put := func(slotId uint, version uint64, payloadSize int) error {
+		key := &s4.Key{Address: address, SlotId: slotId, Version: version}
+		record := &s4.Record{Payload: make([]byte, payloadSize), Expiration: now.Add(time.Minute).UnixMilli()}
+		signature, err := s4.NewEnvelopeFromRecord(key, record).Sign(privateKey)
+		require.NoError(t, err)
+		return storage.Put(ctx, key, record, signature)
+	}
+
+	require.NoError(t, put(0, 1, 20))
+	require.NoError(t, put(1, 1, 15))
+	assert.ErrorIs(t, put(2, 1, 10), s4.ErrQuotaExceeded)
+	// the payload of the replaced slot version does not count
+	require.NoError(t, put(1, 2, 20))
+	// the address quota overrides MaxSlotsPerUser
+	assert.ErrorIs(t, put(3, 1, 0), s4.ErrSlotIdTooBig)
+
+	rows, usage, err := storage.List(ctx, address)
+	require.NoError(t, err)
+	assert.Len(t, rows, 2)
+	assert.Equal(t, &s4.Usage{
+		SlotsUsed:        2,
+		PayloadBytesUsed: 40,
+		Quota:            s4.Quota{MaxSlots: 3, MaxTotalPayloadBytes: 40},
+	}, usage)
+
+	other := testutils.NewAddress()
+	assert.Equal(t, s4.Quota{MaxSlots: 5, MaxTotalPayloadBytes: 40}, quotaConstraints.QuotaFor(other))
+}
@@ -14,7 +14,9 @@ import (
 	"github.com/smartcontractkit/chainlink-common/pkg/types/core"
 	gatewayconnector "github.com/smartcontractkit/chainlink/v2/core/capabilities/gateway_connector"
 	"github.com/smartcontractkit/chainlink/v2/core/capabilities/webapi"
+	"github.com/smartcontractkit/chainlink/v2/core/chains/legacyevm"
 	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	"github.com/smartcontractkit/chainlink/v2/core/services/ccip"
 	"github.com/smartcontractkit/chainlink/v2/core/services/job"
 	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/generic"
 	"github.com/smartcontractkit/chainlink/v2/core/services/pipeline"
@@ -36,6 +38,7 @@ type Delegate struct {
 	monitoringEndpointGen   telemetry.MonitoringEndpointGenerator
 	pipelineRunner          pipeline.Runner
 	relayers                RelayGetter
+	legacyEVMChains         legacyevm.LegacyChainContainer
 	gatewayConnectorWrapper *gatewayconnector.ServiceWrapper
 
 	isNewlyCreatedJob bool
@@ -43,13 +46,14 @@ type Delegate struct {
 
 const (
 	commandOverrideForWebAPITrigger = "__builtin_web-api-trigger"
+	commandOverrideForCCIPGateway   = "__builtin_ccip-gateway"
 )
 
 func NewDelegate(logger logger.Logger, ds sqlutil.DataSource, jobORM job.ORM, registry core.CapabilitiesRegistry,
 	cfg plugins.RegistrarConfig, monitoringEndpointGen telemetry.MonitoringEndpointGenerator, pipelineRunner pipeline.Runner,
-	relayers RelayGetter, gatewayConnectorWrapper *gatewayconnector.ServiceWrapper) *Delegate {
+	relayers RelayGetter, legacyEVMChains legacyevm.LegacyChainContainer, gatewayConnectorWrapper *gatewayconnector.ServiceWrapper) *Delegate {
 	return &Delegate{logger: logger, ds: ds, jobORM: jobORM, registry: registry, cfg: cfg, monitoringEndpointGen: monitoringEndpointGen, pipelineRunner: pipelineRunner,
-		relayers: relayers, isNewlyCreatedJob: false, gatewayConnectorWrapper: gatewayConnectorWrapper}
+		relayers: relayers, legacyEVMChains: legacyEVMChains, isNewlyCreatedJob: false, gatewayConnectorWrapper: gatewayConnectorWrapper}
 }
 
 func (d *Delegate) JobType() job.Type {
@@ -87,6 +91,18 @@ func (d *Delegate) ServicesForSpec(ctx context.Context, spec job.Job) ([]job.Ser
 		return []job.ServiceCtx{triggerSrvc}, nil
 	}
 
+	if spec.StandardCapabilitiesSpec.Command == commandOverrideForCCIPGateway {
+		if d.gatewayConnectorWrapper == nil {
+			return nil, errors.New("gateway connector is required for the CCIP gateway handler")
+		}
+		connector := d.gatewayConnectorWrapper.GetGatewayConnector()
+		handlerSrvc, err := ccip.NewConnectorHandler(spec.StandardCapabilitiesSpec.Config, ccip.NewLegacyChainClients(d.legacyEVMChains), connector, log)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create a CCIP gateway handler service: %w", err)
+		}
+		return []job.ServiceCtx{handlerSrvc}, nil
+	}
+
 	standardCapability := newStandardCapabilities(log, spec.StandardCapabilitiesSpec, d.cfg, telemetryService, kvStore, d.registry, errorLog,
 		pr, relayerSet)
 
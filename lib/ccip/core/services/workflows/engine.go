@@ -129,6 +129,18 @@ func (e *Engine) resolveWorkflowCapabilities(ctx context.Context) error {
 				}}
 		}
 
+		if s.compensation != nil {
+			err = e.initializeCapability(ctx, s.compensation)
+			if err != nil {
+				return &workflowError{err: err, reason: "failed to initialize compensation capability for step",
+					labels: map[string]string{
+						wIDKey: e.workflow.id,
+						sIDKey: s.compensation.ID,
+						sRKey:  s.Ref,
+					}}
+			}
+		}
+
 		return nil
 	})
 
@@ -286,6 +298,15 @@ func (e *Engine) resumeInProgressExecutions(ctx context.Context) error {
 	// they won't change.
 	refToDeps := map[string][]*step{}
 	for _, execution := range wipExecutions {
+		// A failed execution is still unfinished while its completed steps are compensated, which resumes with the
+		// next compensation.
+		if _, failed := e.failureStatus(execution); failed {
+			if err = e.compensateOrFinish(ctx, execution); err != nil {
+				return err
+			}
+			continue
+		}
+
 		for _, step := range execution.Steps {
 			// NOTE: In order to determine what tasks need to be enqueued,
 			// we look at any completed steps, and for each dependent,
@@ -506,8 +527,20 @@ func (e *Engine) handleStepUpdate(ctx context.Context, stepUpdate store.Workflow
 	}
 	l := e.logger.With(eIDKey, state.ExecutionID, sRKey, stepUpdate.Ref)
 
+	if isCompensationRef(stepUpdate.Ref) {
+		if stepUpdate.Status != store.StatusCompleted {
+			l.Errorf("compensation %s", stepUpdate.Status)
+		}
+		return e.compensateOrFinish(ctx, state)
+	}
+
 	switch stepUpdate.Status {
 	case store.StatusCompleted:
+		// Once a step failed, the execution only runs compensations, which finish it.
+		if _, failed := e.failureStatus(state); failed {
+			return nil
+		}
+
 		stepDependents, err := e.workflow.dependents(stepUpdate.Ref)
 		if err != nil {
 			return err
@@ -529,7 +562,7 @@ func (e *Engine) handleStepUpdate(ctx context.Context, stepUpdate store.Workflow
 				}
 
 				switch step.Status {
-				case store.StatusCompleted, store.StatusErrored, store.StatusCompletedEarlyExit:
+				case store.StatusCompleted, store.StatusErrored, store.StatusTimeout, store.StatusCompletedEarlyExit:
 				default:
 					workflowCompleted = false
 				}
@@ -566,17 +599,81 @@ func (e *Engine) handleStepUpdate(ctx context.Context, stepUpdate store.Workflow
 		if err != nil {
 			return err
 		}
-	case store.StatusErrored:
-		l.Info("execution errored")
-		err := e.finishExecution(ctx, state.ExecutionID, store.StatusErrored)
-		if err != nil {
-			return err
+	case store.StatusErrored, store.StatusTimeout:
+		l.Infof("execution %s", stepUpdate.Status)
+		// Only the first failing step starts compensating, the compensations are already running otherwise.
+		if state.Status != store.StatusStarted || e.failedSteps(state) > 1 {
+			return nil
 		}
+		return e.compensateOrFinish(ctx, state)
 	}
 
 	return nil
 }
 
+// failedSteps returns the number of steps of the execution which errored or timed out, compensations excluded.
+func (e *Engine) failedSteps(state store.WorkflowExecution) int {
+	var failed int
+	for ref, s := range state.Steps {
+		if !isCompensationRef(ref) && (s.Status == store.StatusErrored || s.Status == store.StatusTimeout) {
+			failed++
+		}
+	}
+	return failed
+}
+
+// failureStatus returns the status of the first step of the execution, in the order of the workflow graph, which
+// errored or timed out, and whether there is one.
+func (e *Engine) failureStatus(state store.WorkflowExecution) (string, bool) {
+	var status string
+	_ = e.workflow.walkDo(workflows.KeywordTrigger, func(s *step) error {
+		if stepState, ok := state.Steps[s.Ref]; ok && status == "" &&
+			(stepState.Status == store.StatusErrored || stepState.Status == store.StatusTimeout) {
+			status = stepState.Status
+		}
+		return nil
+	})
+	return status, status != ""
+}
+
+// compensateOrFinish enqueues the next compensation of the failed execution, or finishes it with the status of the
+// failed step once there is nothing left to compensate. The compensations of the completed steps run one at a time on
+// the workers, in the reverse order of the workflow graph. A failing compensation is recorded in the execution state,
+// and doesn't prevent the other ones from running.
+func (e *Engine) compensateOrFinish(ctx context.Context, state store.WorkflowExecution) error {
+	var next *step
+	err := e.workflow.walkDo(workflows.KeywordTrigger, func(s *step) error {
+		if s.compensation == nil {
+			return nil
+		}
+		if stepState, ok := state.Steps[s.Ref]; !ok || stepState.Status != store.StatusCompleted {
+			return nil
+		}
+		if _, ok := state.Steps[s.compensation.Ref]; !ok {
+			next = s.compensation
+		}
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("failed to find steps to compensate: %w", err)
+	}
+
+	if next != nil {
+		e.logger.With(eIDKey, state.ExecutionID, sRKey, next.Ref).Debug("compensation enqueued")
+		e.pendingStepRequests <- stepRequest{
+			state:   copyState(state),
+			stepRef: next.Ref,
+		}
+		return nil
+	}
+
+	status, ok := e.failureStatus(state)
+	if !ok {
+		status = store.StatusErrored
+	}
+	return e.finishExecution(ctx, state.ExecutionID, status)
+}
+
 func (e *Engine) queueIfReady(state store.WorkflowExecution, step *step) {
 	// Check if all dependencies are completed for the current step
 	var waitingOnDependencies bool
@@ -645,17 +742,14 @@ func (e *Engine) workerForStepRequest(ctx context.Context, msg stepRequest) {
 	}
 
 	inputs, outputs, err := e.executeStep(ctx, msg)
-	var stepStatus string
-	switch {
-	case errors.Is(capabilities.ErrStopExecution, err):
+	stepStatus := stepStatusFor(err)
+	switch stepStatus {
+	case store.StatusCompletedEarlyExit:
 		l.Info("step executed successfully with a termination")
-		stepStatus = store.StatusCompletedEarlyExit
-	case err != nil:
+	case store.StatusErrored, store.StatusTimeout:
 		l.Errorf("error executing step request: %s", err)
-		stepStatus = store.StatusErrored
 	default:
 		l.With("outputs", outputs).Info("step executed successfully")
-		stepStatus = store.StatusCompleted
 	}
 
 	stepState.Status = stepStatus
@@ -676,6 +770,20 @@ func (e *Engine) workerForStepRequest(ctx context.Context, msg stepRequest) {
 	}
 }
 
+// stepStatusFor returns the status of a step which returned err.
+func stepStatusFor(err error) string {
+	switch {
+	case errors.Is(capabilities.ErrStopExecution, err):
+		return store.StatusCompletedEarlyExit
+	case errors.Is(err, errStepTimeout):
+		return store.StatusTimeout
+	case err != nil:
+		return store.StatusErrored
+	default:
+		return store.StatusCompleted
+	}
+}
+
 func merge(baseConfig *values.Map, overrideConfig *values.Map) *values.Map {
 	m := values.EmptyMap()
 
@@ -721,11 +829,27 @@ func (e *Engine) configForStep(ctx context.Context, executionID string, step *st
 
 // executeStep executes the referenced capability within a step and returns the result.
 func (e *Engine) executeStep(ctx context.Context, msg stepRequest) (*values.Map, values.Value, error) {
+	if isCompensationRef(msg.stepRef) {
+		compensated, err := e.workflow.Vertex(compensatedRef(msg.stepRef))
+		if err != nil {
+			return nil, nil, err
+		}
+		if compensated.compensation == nil {
+			return nil, nil, fmt.Errorf("step %s has no compensation", compensated.Ref)
+		}
+		return e.executeCapability(ctx, msg.state, compensated.compensation)
+	}
+
 	step, err := e.workflow.Vertex(msg.stepRef)
 	if err != nil {
 		return nil, nil, err
 	}
 
+	return e.executeCapability(ctx, msg.state, step)
+}
+
+// executeCapability executes the capability of the step against the execution state, according to the step policy.
+func (e *Engine) executeCapability(ctx context.Context, state store.WorkflowExecution, step *step) (*values.Map, values.Value, error) {
 	var inputs any
 	if step.Inputs.OutputRef != "" {
 		inputs = step.Inputs.OutputRef
@@ -733,7 +857,7 @@ func (e *Engine) executeStep(ctx context.Context, msg stepRequest) (*values.Map,
 		inputs = step.Inputs.Mapping
 	}
 
-	i, err := exec.FindAndInterpolateAllKeys(inputs, msg.state)
+	i, err := exec.FindAndInterpolateAllKeys(inputs, state)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -743,7 +867,7 @@ func (e *Engine) executeStep(ctx context.Context, msg stepRequest) (*values.Map,
 		return nil, nil, err
 	}
 
-	config, err := e.configForStep(ctx, msg.state.ExecutionID, step)
+	config, err := e.configForStep(ctx, state.ExecutionID, step)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -752,17 +876,22 @@ func (e *Engine) executeStep(ctx context.Context, msg stepRequest) (*values.Map,
 		Inputs: inputsMap,
 		Config: config,
 		Metadata: capabilities.RequestMetadata{
-			WorkflowID:               msg.state.WorkflowID,
-			WorkflowExecutionID:      msg.state.ExecutionID,
+			WorkflowID:               state.WorkflowID,
+			WorkflowExecutionID:      state.ExecutionID,
 			WorkflowOwner:            e.workflow.owner,
 			WorkflowName:             e.workflow.name,
 			WorkflowDonID:            e.localNode.WorkflowDON.ID,
 			WorkflowDonConfigVersion: e.localNode.WorkflowDON.ConfigVersion,
-			ReferenceID:              msg.stepRef,
+			ReferenceID:              step.Ref,
 		},
 	}
 
-	output, err := step.capability.Execute(ctx, tr)
+	var output capabilities.CapabilityResponse
+	err = step.policy.run(ctx, e.clock, func(ctx context.Context) error {
+		var innerErr error
+		output, innerErr = step.capability.Execute(ctx, tr)
+		return innerErr
+	})
 	if err != nil {
 		return inputsMap, nil, err
 	}
@@ -817,32 +946,12 @@ func (e *Engine) Close() error {
 				return nil
 			}
 
-			reg := capabilities.UnregisterFromWorkflowRequest{
-				Metadata: capabilities.RegistrationMetadata{
-					WorkflowID: e.workflow.id,
-				},
-				Config: s.config,
-			}
-
-			// if capability is nil, then we haven't initialized
-			// the workflow yet and can safely consider it deregistered
-			// with no further action.
-			if s.capability == nil {
-				return nil
-			}
-
-			innerErr := s.capability.UnregisterFromWorkflow(ctx, reg)
-			if innerErr != nil {
-				return &workflowError{err: innerErr,
-					reason: fmt.Sprintf("failed to unregister capability from workflow: %+v", reg),
-					labels: map[string]string{
-						wIDKey: e.workflow.id,
-						sIDKey: s.ID,
-						sRKey:  s.Ref,
-					}}
+			if s.compensation != nil {
+				if innerErr := e.unregisterStep(ctx, s.compensation); innerErr != nil {
+					return innerErr
+				}
 			}
-
-			return nil
+			return e.unregisterStep(ctx, s)
 		})
 		if err != nil {
 			return err
@@ -852,6 +961,35 @@ func (e *Engine) Close() error {
 	})
 }
 
+func (e *Engine) unregisterStep(ctx context.Context, s *step) error {
+	reg := capabilities.UnregisterFromWorkflowRequest{
+		Metadata: capabilities.RegistrationMetadata{
+			WorkflowID: e.workflow.id,
+		},
+		Config: s.config,
+	}
+
+	// if capability is nil, then we haven't initialized
+	// the workflow yet and can safely consider it deregistered
+	// with no further action.
+	if s.capability == nil {
+		return nil
+	}
+
+	innerErr := s.capability.UnregisterFromWorkflow(ctx, reg)
+	if innerErr != nil {
+		return &workflowError{err: innerErr,
+			reason: fmt.Sprintf("failed to unregister capability from workflow: %+v", reg),
+			labels: map[string]string{
+				wIDKey: e.workflow.id,
+				sIDKey: s.ID,
+				sRKey:  s.Ref,
+			}}
+	}
+
+	return nil
+}
+
 type Config struct {
 	Workflow             sdk.WorkflowSpec
 	WorkflowID           string
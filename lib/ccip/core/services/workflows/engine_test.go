@@ -1128,3 +1128,210 @@ func TestEngine_HandlesNilConfigOnchain(t *testing.T) {
 	// The write target config contains three keys
 	assert.Len(t, m.(map[string]any), 3)
 }
+
+const policyWorkflow = `
+triggers:
+  - id: "mercury-trigger@1.0.0"
+    config:
+      feedlist:
+        - "0x1111111111111111111100000000000000000000000000000000000000000000" # ETHUSD
+
+consensus:
+  - id: "offchain_reporting@1.0.0"
+    ref: "evm_median"
+    inputs:
+      observations:
+        - "$(trigger.outputs)"
+    config:
+      aggregation_method: "data_feeds_2_0"
+      executionPolicy:
+        timeout: "200ms"
+        maxRetries: 1
+        compensation:
+          id: "rollback@1.0.0"
+          inputs:
+            report: "$(evm_median.outputs.report)"
+
+targets:
+  - id: "write_polygon-testnet-mumbai@1.0.0"
+    inputs:
+      report: "$(evm_median.outputs.report)"
+    config:
+      address: "0x3F3554832c636721F1fD1822Ccca0354576741Ef"
+      params: ["$(report)"]
+      abi: "receive(report bytes)"
+`
+
+func mockRollback() (*mockCapability, chan *values.Map) {
+	received := make(chan *values.Map, 10)
+	return newMockCapability(
+		capabilities.MustNewCapabilityInfo(
+			"rollback@1.0.0",
+			capabilities.CapabilityTypeAction,
+			"an action undoing a report",
+		),
+		func(req capabilities.CapabilityRequest) (capabilities.CapabilityResponse, error) {
+			received <- req.Inputs
+			return capabilities.CapabilityResponse{}, nil
+		},
+	), received
+}
+
+func TestEngine_RetriesStepsAccordingToPolicy(t *testing.T) {
+	t.Parallel()
+	ctx := testutils.Context(t)
+	reg := coreCap.NewRegistry(logger.TestLogger(t))
+
+	trigger, _ := mockTrigger(t)
+	require.NoError(t, reg.Add(ctx, trigger))
+
+	consensus := mockConsensus()
+	transform := consensus.transform
+	attempts := 0
+	consensus.transform = func(req capabilities.CapabilityRequest) (capabilities.CapabilityResponse, error) {
+		attempts++
+		if attempts == 1 {
+			return capabilities.CapabilityResponse{}, errors.New("transient consensus error")
+		}
+		return transform(req)
+	}
+	require.NoError(t, reg.Add(ctx, consensus))
+	require.NoError(t, reg.Add(ctx, mockTarget()))
+	rollback, _ := mockRollback()
+	require.NoError(t, reg.Add(ctx, rollback))
+
+	eng, hooks := newTestEngine(t, reg, policyWorkflow)
+	servicetest.Run(t, eng)
+
+	eid := getExecutionId(t, eng, hooks)
+	state, err := eng.executionStates.Get(ctx, eid)
+	require.NoError(t, err)
+
+	assert.Equal(t, store.StatusCompleted, state.Status)
+	assert.Equal(t, store.StatusCompleted, state.Steps["evm_median"].Status)
+	assert.Equal(t, 2, attempts)
+	assert.NotContains(t, state.Steps, "compensate:evm_median")
+}
+
+func TestEngine_TimesOutStepsAccordingToPolicy(t *testing.T) {
+	t.Parallel()
+	ctx := testutils.Context(t)
+	reg := coreCap.NewRegistry(logger.TestLogger(t))
+
+	trigger, _ := mockTrigger(t)
+	require.NoError(t, reg.Add(ctx, trigger))
+	require.NoError(t, reg.Add(ctx, &blockingCapability{mockCapability: mockConsensus()}))
+	require.NoError(t, reg.Add(ctx, mockTarget()))
+	rollback, received := mockRollback()
+	require.NoError(t, reg.Add(ctx, rollback))
+
+	eng, hooks := newTestEngine(t, reg, policyWorkflow)
+	servicetest.Run(t, eng)
+
+	eid := getExecutionId(t, eng, hooks)
+	state, err := eng.executionStates.Get(ctx, eid)
+	require.NoError(t, err)
+
+	assert.Equal(t, store.StatusTimeout, state.Status)
+	assert.Equal(t, store.StatusTimeout, state.Steps["evm_median"].Status)
+	// the step never completed, so there is nothing to compensate
+	assert.NotContains(t, state.Steps, "compensate:evm_median")
+	assert.Empty(t, received)
+}
+
+func TestEngine_CompensatesCompletedStepsOnFailure(t *testing.T) {
+	t.Parallel()
+	ctx := testutils.Context(t)
+	reg := coreCap.NewRegistry(logger.TestLogger(t))
+
+	trigger, _ := mockTrigger(t)
+	require.NoError(t, reg.Add(ctx, trigger))
+	require.NoError(t, reg.Add(ctx, mockConsensus()))
+	target := mockTarget()
+	target.transform = func(req capabilities.CapabilityRequest) (capabilities.CapabilityResponse, error) {
+		return capabilities.CapabilityResponse{}, errors.New("fatal target error")
+	}
+	require.NoError(t, reg.Add(ctx, target))
+	rollback, received := mockRollback()
+	require.NoError(t, reg.Add(ctx, rollback))
+
+	eng, hooks := newTestEngine(t, reg, policyWorkflow)
+	servicetest.Run(t, eng)
+
+	eid := getExecutionId(t, eng, hooks)
+	state, err := eng.executionStates.Get(ctx, eid)
+	require.NoError(t, err)
+
+	assert.Equal(t, store.StatusErrored, state.Status)
+	assert.Equal(t, store.StatusCompleted, state.Steps["evm_median"].Status)
+	require.Contains(t, state.Steps, "compensate:evm_median")
+	assert.Equal(t, store.StatusCompleted, state.Steps["compensate:evm_median"].Status)
+
+	inputs := <-received
+	assert.Equal(t, state.Steps["evm_median"].Outputs.Value.(*values.Map).Underlying["report"], inputs.Underlying["report"])
+}
+
+func TestEngine_ResumesCompensationsOfFailedExecutions(t *testing.T) {
+	t.Parallel()
+	ctx := testutils.Context(t)
+	reg := coreCap.NewRegistry(logger.TestLogger(t))
+
+	require.NoError(t, reg.Add(ctx, mockNoopTrigger(t)))
+	require.NoError(t, reg.Add(ctx, mockConsensus()))
+	require.NoError(t, reg.Add(ctx, mockTarget()))
+	rollback, received := mockRollback()
+	require.NoError(t, reg.Add(ctx, rollback))
+
+	report, err := values.NewMap(map[string]any{"report": []byte{0x01}})
+	require.NoError(t, err)
+	dbstore := newTestDBStore(t, clockwork.NewFakeClock())
+	// the engine stopped after the target failed, before the consensus step was compensated
+	ec := &store.WorkflowExecution{
+		Steps: map[string]*store.WorkflowExecutionStep{
+			workflows.KeywordTrigger: {
+				Status:      store.StatusCompleted,
+				ExecutionID: "<execution-ID>",
+				Ref:         workflows.KeywordTrigger,
+			},
+			"evm_median": {
+				Outputs:     store.StepOutput{Value: report},
+				Status:      store.StatusCompleted,
+				ExecutionID: "<execution-ID>",
+				Ref:         "evm_median",
+			},
+			"write_polygon-testnet-mumbai@1.0.0": {
+				Outputs:     store.StepOutput{Err: errors.New("fatal target error")},
+				Status:      store.StatusErrored,
+				ExecutionID: "<execution-ID>",
+				Ref:         "write_polygon-testnet-mumbai@1.0.0",
+			},
+		},
+		WorkflowID:  testWorkflowId,
+		ExecutionID: "<execution-ID>",
+		Status:      store.StatusStarted,
+	}
+	require.NoError(t, dbstore.Add(ctx, ec))
+
+	eng, hooks := newTestEngine(t, reg, policyWorkflow, func(c *Config) { c.Store = dbstore })
+	servicetest.Run(t, eng)
+
+	eid := getExecutionId(t, eng, hooks)
+	state, err := dbstore.Get(ctx, eid)
+	require.NoError(t, err)
+	assert.Equal(t, store.StatusErrored, state.Status)
+	require.Contains(t, state.Steps, "compensate:evm_median")
+	assert.Equal(t, store.StatusCompleted, state.Steps["compensate:evm_median"].Status)
+
+	inputs := <-received
+	assert.Equal(t, report.Underlying["report"], inputs.Underlying["report"])
+}
+
+// blockingCapability never responds before the request context is done.
+type blockingCapability struct {
+	*mockCapability
+}
+
+func (b *blockingCapability) Execute(ctx context.Context, req capabilities.CapabilityRequest) (capabilities.CapabilityResponse, error) {
+	<-ctx.Done()
+	return capabilities.CapabilityResponse{}, ctx.Err()
+}
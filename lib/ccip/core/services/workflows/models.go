@@ -83,6 +83,10 @@ type step struct {
 	capability capabilities.ExecutableCapability
 	info       capabilities.CapabilityInfo
 	config     *values.Map
+	// policy controls the timeout and retries of the step, see executionPolicy
+	policy stepPolicy
+	// compensation is run when a step executed after this one fails; nil if the step has none
+	compensation *step
 }
 
 type triggerCapability struct {
@@ -140,7 +144,13 @@ func createWorkflow(wf2 *workflows.DependencyGraph) (*workflow, error) {
 		if innerErr != nil {
 			return nil, fmt.Errorf("failed to retrieve vertex for %s: %w", vertexRef, innerErr)
 		}
-		innerErr = g.AddVertex(&step{Vertex: *v})
+		policy, compensation, config, innerErr := parseExecutionPolicy(*v)
+		if innerErr != nil {
+			return nil, innerErr
+		}
+		s := &step{Vertex: *v, policy: policy, compensation: compensation}
+		s.Config = config
+		innerErr = g.AddVertex(s)
 		if innerErr != nil {
 			return nil, fmt.Errorf("failed to add vertex to executable workflow %s: %w", vertexRef, innerErr)
 		}
@@ -0,0 +1,202 @@
+package workflows
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/jonboulle/clockwork"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/capabilities"
+	"github.com/smartcontractkit/chainlink-common/pkg/workflows"
+	"github.com/smartcontractkit/chainlink-common/pkg/workflows/sdk"
+)
+
+const (
+	// executionPolicyKey is the reserved step config key holding the execution policy of the step.
+	// It is removed from the config passed to the capability.
+	executionPolicyKey = "executionPolicy"
+	// compensationRefPrefix prefixes the ref of the compensation of a step in the execution state.
+	compensationRefPrefix = "compensate:"
+)
+
+// executionPolicy is the execution policy of a step, e.g.
+//
+//	config:
+//	  executionPolicy:
+//	    timeout: 30s
+//	    maxRetries: 2
+//	    retryInterval: 1s
+//	    compensation:
+//	      id: "rollback@1.0.0"
+//	      inputs:
+//	        report: $(evm_median.outputs.report)
+//	      config: {}
+//
+// The compensation runs when a step executed after this one fails, with the same timeout and retries. Its inputs can
+// only reference the outputs of the trigger, of the step itself and of the steps it depends on, which are all completed
+// when it runs.
+type executionPolicy struct {
+	Timeout       string        `json:"timeout"`
+	MaxRetries    int           `json:"maxRetries"`
+	RetryInterval string        `json:"retryInterval"`
+	Compensation  *compensation `json:"compensation"`
+}
+
+type compensation struct {
+	ID     string         `json:"id"`
+	Inputs map[string]any `json:"inputs"`
+	Config map[string]any `json:"config"`
+}
+
+// stepPolicy is the parsed execution policy of a step. The zero value runs the step once, without a timeout.
+type stepPolicy struct {
+	timeout       time.Duration
+	maxRetries    int
+	retryInterval time.Duration
+}
+
+// errStepTimeout is returned when the last attempt to execute a step timed out.
+var errStepTimeout = errors.New("step timed out")
+
+// parseExecutionPolicy returns the policy and compensation of the step, and a copy of its config without the policy.
+func parseExecutionPolicy(s workflows.Vertex) (stepPolicy, *step, map[string]any, error) {
+	raw, ok := s.Config[executionPolicyKey]
+	if !ok {
+		return stepPolicy{}, nil, s.Config, nil
+	}
+
+	config := make(map[string]any, len(s.Config)-1)
+	for k, v := range s.Config {
+		if k != executionPolicyKey {
+			config[k] = v
+		}
+	}
+
+	b, err := json.Marshal(raw)
+	if err != nil {
+		return stepPolicy{}, nil, nil, fmt.Errorf("invalid %s of step %s: %w", executionPolicyKey, s.Ref, err)
+	}
+	var ep executionPolicy
+	if err = json.Unmarshal(b, &ep); err != nil {
+		return stepPolicy{}, nil, nil, fmt.Errorf("invalid %s of step %s: %w", executionPolicyKey, s.Ref, err)
+	}
+
+	var policy stepPolicy
+	if ep.Timeout != "" {
+		if policy.timeout, err = time.ParseDuration(ep.Timeout); err != nil || policy.timeout <= 0 {
+			return stepPolicy{}, nil, nil, fmt.Errorf("invalid timeout %q of step %s", ep.Timeout, s.Ref)
+		}
+	}
+	if ep.MaxRetries < 0 {
+		return stepPolicy{}, nil, nil, fmt.Errorf("invalid maxRetries %d of step %s", ep.MaxRetries, s.Ref)
+	}
+	policy.maxRetries = ep.MaxRetries
+	if ep.RetryInterval != "" {
+		if policy.retryInterval, err = time.ParseDuration(ep.RetryInterval); err != nil || policy.retryInterval < 0 {
+			return stepPolicy{}, nil, nil, fmt.Errorf("invalid retryInterval %q of step %s", ep.RetryInterval, s.Ref)
+		}
+	}
+
+	var comp *step
+	if ep.Compensation != nil {
+		if ep.Compensation.ID == "" {
+			return stepPolicy{}, nil, nil, fmt.Errorf("missing compensation id of step %s", s.Ref)
+		}
+		comp = &step{
+			Vertex: workflows.Vertex{
+				StepDefinition: sdk.StepDefinition{
+					ID:     ep.Compensation.ID,
+					Ref:    compensationRefPrefix + s.Ref,
+					Inputs: sdk.StepInputs{Mapping: ep.Compensation.Inputs},
+					Config: ep.Compensation.Config,
+				},
+			},
+			policy: policy,
+		}
+		if comp.Inputs.Mapping == nil {
+			comp.Inputs.Mapping = map[string]any{}
+		}
+		if err = validateCompensationRefs(s, ep.Compensation.Inputs); err != nil {
+			return stepPolicy{}, nil, nil, err
+		}
+	}
+
+	return policy, comp, config, nil
+}
+
+// validateCompensationRefs checks that the compensation inputs of step s only reference steps which are completed
+// whenever the compensation runs.
+func validateCompensationRefs(s workflows.Vertex, inputs map[string]any) error {
+	allowed := map[string]bool{workflows.KeywordTrigger: true, s.Ref: true}
+	for _, dep := range s.Dependencies {
+		allowed[dep] = true
+	}
+	_, err := workflows.DeepMap(inputs, func(el any) (any, error) {
+		str, ok := el.(string)
+		if !ok {
+			return el, nil
+		}
+		matches := workflows.InterpolationTokenRe.FindStringSubmatch(str)
+		if len(matches) < 2 {
+			return el, nil
+		}
+		ref, _, _ := strings.Cut(matches[1], ".")
+		if !allowed[ref] {
+			return nil, fmt.Errorf("compensation of step %s references step %s, which is neither the step itself nor one of its dependencies", s.Ref, ref)
+		}
+		return el, nil
+	})
+	return err
+}
+
+// isCompensationRef returns whether ref is the ref of a compensation in the execution state.
+func isCompensationRef(ref string) bool {
+	return strings.HasPrefix(ref, compensationRefPrefix)
+}
+
+// compensatedRef returns the ref of the step compensated by the compensation ref.
+func compensatedRef(ref string) string {
+	return strings.TrimPrefix(ref, compensationRefPrefix)
+}
+
+// run calls fn until it succeeds, stops the execution, or the retries are exhausted, with the policy timeout for each
+// attempt. If the last attempt timed out, the returned error wraps errStepTimeout.
+func (p stepPolicy) run(ctx context.Context, clock clockwork.Clock, fn func(ctx context.Context) error) error {
+	var err error
+	for attempt := 0; attempt <= p.maxRetries; attempt++ {
+		if attempt > 0 && p.retryInterval > 0 {
+			select {
+			case <-ctx.Done():
+				return ctx.Err()
+			case <-clock.After(p.retryInterval):
+			}
+		}
+
+		err = p.attempt(ctx, fn)
+		if err == nil || errors.Is(err, capabilities.ErrStopExecution) || capabilities.ErrStopExecution.Error() == err.Error() {
+			return err
+		}
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+	}
+	return err
+}
+
+func (p stepPolicy) attempt(ctx context.Context, fn func(ctx context.Context) error) error {
+	if p.timeout == 0 {
+		return fn(ctx)
+	}
+
+	attemptCtx, cancel := context.WithTimeout(ctx, p.timeout)
+	defer cancel()
+	err := fn(attemptCtx)
+	if err != nil && ctx.Err() == nil && errors.Is(attemptCtx.Err(), context.DeadlineExceeded) {
+		return fmt.Errorf("%w after %s: %w", errStepTimeout, p.timeout, err)
+	}
+	return err
+}
@@ -0,0 +1,142 @@
+package workflows
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/jonboulle/clockwork"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/capabilities"
+	"github.com/smartcontractkit/chainlink-common/pkg/workflows"
+	"github.com/smartcontractkit/chainlink-common/pkg/workflows/sdk"
+
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+)
+
+func vertexWithConfig(config map[string]any) workflows.Vertex {
+	return workflows.Vertex{StepDefinition: sdk.StepDefinition{ID: "offchain_reporting@1.0.0", Ref: "evm_median", Config: config}}
+}
+
+func TestParseExecutionPolicy(t *testing.T) {
+	t.Run("no policy", func(t *testing.T) {
+		config := map[string]any{"encoder": "EVM"}
+		policy, comp, got, err := parseExecutionPolicy(vertexWithConfig(config))
+		require.NoError(t, err)
+		assert.Equal(t, stepPolicy{}, policy)
+		assert.Nil(t, comp)
+		assert.Equal(t, config, got)
+	})
+
+	t.Run("policy with compensation", func(t *testing.T) {
+		policy, comp, got, err := parseExecutionPolicy(vertexWithConfig(map[string]any{
+			"encoder": "EVM",
+			executionPolicyKey: map[string]any{
+				"timeout":       "30s",
+				"maxRetries":    float64(2),
+				"retryInterval": "1s",
+				"compensation": map[string]any{
+					"id":     "rollback@1.0.0",
+					"inputs": map[string]any{"report": "$(evm_median.outputs.report)"},
+				},
+			},
+		}))
+		require.NoError(t, err)
+		assert.Equal(t, stepPolicy{timeout: 30 * time.Second, maxRetries: 2, retryInterval: time.Second}, policy)
+		assert.Equal(t, map[string]any{"encoder": "EVM"}, got)
+		require.NotNil(t, comp)
+		assert.Equal(t, "rollback@1.0.0", comp.ID)
+		assert.Equal(t, "compensate:evm_median", comp.Ref)
+		assert.True(t, isCompensationRef(comp.Ref))
+		assert.Equal(t, map[string]any{"report": "$(evm_median.outputs.report)"}, comp.Inputs.Mapping)
+		assert.Equal(t, policy, comp.policy)
+	})
+
+	t.Run("compensation referencing the trigger and dependencies", func(t *testing.T) {
+		v := vertexWithConfig(map[string]any{executionPolicyKey: map[string]any{
+			"compensation": map[string]any{
+				"id": "rollback@1.0.0",
+				"inputs": map[string]any{
+					"event":        "$(trigger.outputs)",
+					"observations": []any{"$(fetch.outputs)"},
+					"report":       "$(evm_median.outputs.report)",
+				},
+			},
+		}})
+		v.Dependencies = []string{"fetch"}
+		_, comp, _, err := parseExecutionPolicy(v)
+		require.NoError(t, err)
+		require.NotNil(t, comp)
+	})
+
+	for name, raw := range map[string]any{
+		"invalid timeout":      map[string]any{"timeout": "soon"},
+		"negative timeout":     map[string]any{"timeout": "-1s"},
+		"negative retries":     map[string]any{"maxRetries": float64(-1)},
+		"invalid interval":     map[string]any{"retryInterval": "1 second"},
+		"missing compensation": map[string]any{"compensation": map[string]any{}},
+		"compensation references a later step": map[string]any{"compensation": map[string]any{
+			"id":     "rollback@1.0.0",
+			"inputs": map[string]any{"tx": []any{"$(write_target.outputs.tx)"}},
+		}},
+		"invalid policy format": "30s",
+	} {
+		t.Run(name, func(t *testing.T) {
+			_, _, _, err := parseExecutionPolicy(vertexWithConfig(map[string]any{executionPolicyKey: raw}))
+			require.Error(t, err)
+		})
+	}
+}
+
+func TestStepPolicy_Run(t *testing.T) {
+	ctx := testutils.Context(t)
+	clock := clockwork.NewRealClock()
+
+	t.Run("retries until success", func(t *testing.T) {
+		attempts := 0
+		err := stepPolicy{maxRetries: 2}.run(ctx, clock, func(context.Context) error {
+			attempts++
+			if attempts < 3 {
+				return errors.New("transient error")
+			}
+			return nil
+		})
+		require.NoError(t, err)
+		assert.Equal(t, 3, attempts)
+	})
+
+	t.Run("returns the last error", func(t *testing.T) {
+		attempts := 0
+		err := stepPolicy{maxRetries: 1}.run(ctx, clock, func(context.Context) error {
+			attempts++
+			return errors.New("fatal error")
+		})
+		require.ErrorContains(t, err, "fatal error")
+		assert.False(t, errors.Is(err, errStepTimeout))
+		assert.Equal(t, 2, attempts)
+	})
+
+	t.Run("times out each attempt", func(t *testing.T) {
+		attempts := 0
+		err := stepPolicy{timeout: 10 * time.Millisecond, maxRetries: 1}.run(ctx, clock, func(ctx context.Context) error {
+			attempts++
+			<-ctx.Done()
+			return ctx.Err()
+		})
+		require.ErrorIs(t, err, errStepTimeout)
+		assert.Equal(t, 2, attempts)
+	})
+
+	t.Run("does not retry early exits", func(t *testing.T) {
+		attempts := 0
+		err := stepPolicy{maxRetries: 3}.run(ctx, clock, func(context.Context) error {
+			attempts++
+			return errors.New(capabilities.ErrStopExecution.Error())
+		})
+		require.EqualError(t, err, capabilities.ErrStopExecution.Error())
+		assert.Equal(t, 1, attempts)
+	})
+}
@@ -10,6 +10,7 @@ type Store interface {
 	UpdateStatus(ctx context.Context, executionID string, status string) error
 	Get(ctx context.Context, executionID string) (WorkflowExecution, error)
 	GetUnfinished(ctx context.Context, offset, limit int) ([]WorkflowExecution, error)
+	GetByWorkflowID(ctx context.Context, workflowID string, offset, limit int) ([]WorkflowExecution, int, error)
 }
 
 var _ Store = (*DBStore)(nil)
@@ -10,6 +10,7 @@ import (
 
 	"github.com/jmoiron/sqlx"
 	"github.com/jonboulle/clockwork"
+	"github.com/lib/pq"
 
 	"github.com/smartcontractkit/chainlink-common/pkg/sqlutil"
 	"github.com/smartcontractkit/chainlink-common/pkg/values"
@@ -381,6 +382,66 @@ func (d *DBStore) GetUnfinished(ctx context.Context, offset, limit int) ([]Workf
 	return states, nil
 }
 
+// `GetByWorkflowID` returns a page of the executions of the given workflow, most recent first,
+// along with the total number of executions of the workflow.
+func (d *DBStore) GetByWorkflowID(ctx context.Context, workflowID string, offset, limit int) ([]WorkflowExecution, int, error) {
+	var count int
+	err := d.db.GetContext(ctx, &count, `SELECT count(*) FROM workflow_executions WHERE workflow_id = $1`, workflowID)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	wexs := []workflowExecutionRow{}
+	err = d.db.SelectContext(ctx, &wexs, `SELECT * FROM workflow_executions WHERE workflow_id = $1
+	ORDER BY created_at DESC, id
+	LIMIT $2
+	OFFSET $3`, workflowID, limit, offset)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	ids := make([]string, 0, len(wexs))
+	for _, wex := range wexs {
+		ids = append(ids, wex.ID)
+	}
+	ws := []workflowStepRow{}
+	err = d.db.SelectContext(ctx, &ws, `SELECT * FROM workflow_steps WHERE workflow_execution_id = ANY($1)`, pq.Array(ids))
+	if err != nil {
+		return nil, 0, err
+	}
+
+	idToSteps := map[string]map[string]*WorkflowExecutionStep{}
+	for _, s := range ws {
+		ss, err := stepToState(s)
+		if err != nil {
+			return nil, 0, err
+		}
+		if _, ok := idToSteps[s.WorkflowExecutionID]; !ok {
+			idToSteps[s.WorkflowExecutionID] = map[string]*WorkflowExecutionStep{}
+		}
+		idToSteps[s.WorkflowExecutionID][s.Ref] = ss
+	}
+
+	executions := make([]WorkflowExecution, 0, len(wexs))
+	for _, wex := range wexs {
+		steps, ok := idToSteps[wex.ID]
+		if !ok {
+			steps = map[string]*WorkflowExecutionStep{}
+		}
+		executions = append(executions, WorkflowExecution{
+			ExecutionID: wex.ID,
+			WorkflowID:  workflowID,
+			Status:      wex.Status,
+			Steps:       steps,
+			CreatedAt:   wex.CreatedAt,
+			UpdatedAt:   wex.UpdatedAt,
+			FinishedAt:  wex.FinishedAt,
+		})
+	}
+
+	return executions, count, nil
+}
+
 func NewDBStore(ds sqlutil.DataSource, lggr logger.Logger, clock clockwork.Clock) *DBStore {
 	return &DBStore{db: ds, lggr: lggr.Named("WorkflowDBStore"), clock: clock}
 }
@@ -276,3 +276,51 @@ func Test_StoreDB_GetUnfinishedSteps(t *testing.T) {
 	states[0].CreatedAt = nil
 	assert.Equal(t, es, states[0])
 }
+
+func Test_StoreDB_GetByWorkflowID(t *testing.T) {
+	store := newTestDBStore(t)
+	ctx := tests.Context(t)
+
+	workflowID := randomID()
+	_, err := store.db.ExecContext(ctx, `INSERT INTO workflow_specs (workflow_id, workflow, workflow_owner, workflow_name, created_at, updated_at)
+	VALUES ($1, '', 'owner', $1, NOW(), NOW())`, workflowID)
+	require.NoError(t, err)
+
+	ids := []string{}
+	for i := 0; i < 3; i++ {
+		id := randomID()
+		ids = append(ids, id)
+		err = store.Add(ctx, &WorkflowExecution{
+			ExecutionID: id,
+			WorkflowID:  workflowID,
+			Status:      StatusStarted,
+			Steps: map[string]*WorkflowExecutionStep{
+				"step1": {ExecutionID: id, Ref: "step1", Status: StatusCompleted},
+			},
+		})
+		require.NoError(t, err)
+	}
+	err = store.Add(ctx, &WorkflowExecution{ExecutionID: randomID(), Status: StatusStarted, Steps: map[string]*WorkflowExecutionStep{}})
+	require.NoError(t, err)
+
+	executions, count, err := store.GetByWorkflowID(ctx, workflowID, 0, 2)
+	require.NoError(t, err)
+	assert.Equal(t, 3, count)
+	require.Len(t, executions, 2)
+	for _, ex := range executions {
+		assert.Contains(t, ids, ex.ExecutionID)
+		assert.Equal(t, workflowID, ex.WorkflowID)
+		require.Contains(t, ex.Steps, "step1")
+		assert.Equal(t, StatusCompleted, ex.Steps["step1"].Status)
+	}
+
+	executions, count, err = store.GetByWorkflowID(ctx, workflowID, 2, 2)
+	require.NoError(t, err)
+	assert.Equal(t, 3, count)
+	assert.Len(t, executions, 1)
+
+	executions, count, err = store.GetByWorkflowID(ctx, randomID(), 0, 2)
+	require.NoError(t, err)
+	assert.Equal(t, 0, count)
+	assert.Empty(t, executions)
+}
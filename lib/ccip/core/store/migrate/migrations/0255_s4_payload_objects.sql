@@ -0,0 +1,9 @@
+-- +goose Up
+
+ALTER TABLE "s4".shared ADD COLUMN IF NOT EXISTS payload_object TEXT;
+ALTER TABLE "s4".shared ADD COLUMN IF NOT EXISTS payload_size BIGINT;
+
+-- +goose Down
+
+ALTER TABLE "s4".shared DROP COLUMN IF EXISTS payload_size;
+ALTER TABLE "s4".shared DROP COLUMN IF EXISTS payload_object;
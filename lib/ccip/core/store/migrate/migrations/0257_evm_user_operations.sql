@@ -0,0 +1,30 @@
+-- +goose Up
+
+CREATE TABLE evm.user_operations (
+	evm_chain_id numeric(78,0) NOT NULL,
+	hash bytea NOT NULL,
+	key_address bytea NOT NULL,
+	account bytea NOT NULL,
+	nonce numeric(78,0) NOT NULL,
+	state text NOT NULL,
+	receipt jsonb,
+	sent_at timestamp with time zone NOT NULL,
+	updated_at timestamp with time zone NOT NULL,
+	idempotency_key text,
+	pipeline_task_run_id uuid,
+	min_confirmations bigint NOT NULL DEFAULT 0,
+	signal_callback boolean NOT NULL DEFAULT FALSE,
+	fail_on_revert boolean NOT NULL DEFAULT FALSE,
+	callback_completed boolean NOT NULL DEFAULT FALSE,
+	PRIMARY KEY (evm_chain_id, hash),
+	CONSTRAINT chk_hash_length CHECK (octet_length(hash) = 32),
+	CONSTRAINT chk_state CHECK (state IN ('pending', 'succeeded', 'reverted', 'dropped'))
+);
+
+CREATE INDEX idx_user_operations_pending ON evm.user_operations (evm_chain_id, account) WHERE state = 'pending';
+CREATE UNIQUE INDEX idx_user_operations_idempotency_key ON evm.user_operations (evm_chain_id, idempotency_key) WHERE idempotency_key IS NOT NULL;
+CREATE INDEX idx_user_operations_pending_callback ON evm.user_operations (evm_chain_id) WHERE signal_callback AND NOT callback_completed;
+
+-- +goose Down
+
+DROP TABLE evm.user_operations;
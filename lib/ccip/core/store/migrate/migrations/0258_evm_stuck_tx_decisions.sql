@@ -0,0 +1,21 @@
+-- +goose Up
+
+CREATE TABLE evm.stuck_tx_decisions (
+	id bigserial PRIMARY KEY,
+	evm_chain_id numeric(78,0) NOT NULL,
+	eth_tx_id bigint NOT NULL REFERENCES evm.txes (id) ON DELETE CASCADE,
+	from_address bytea NOT NULL,
+	nonce bigint NOT NULL,
+	reason text NOT NULL,
+	remedy text NOT NULL,
+	block_num bigint NOT NULL,
+	error text,
+	decided_at timestamp with time zone NOT NULL
+);
+
+CREATE INDEX idx_stuck_tx_decisions_from_address ON evm.stuck_tx_decisions (evm_chain_id, from_address, id);
+CREATE INDEX idx_stuck_tx_decisions_eth_tx_id ON evm.stuck_tx_decisions (eth_tx_id);
+
+-- +goose Down
+
+DROP TABLE evm.stuck_tx_decisions;
@@ -0,0 +1,16 @@
+-- +goose Up
+
+CREATE TABLE evm.fee_policy_spend (
+	evm_chain_id numeric(78,0) NOT NULL,
+	eth_tx_id bigint NOT NULL,
+	policy text NOT NULL,
+	spend numeric(78,0) NOT NULL,
+	spent_at timestamp with time zone NOT NULL,
+	PRIMARY KEY (evm_chain_id, eth_tx_id)
+);
+
+CREATE INDEX idx_fee_policy_spend_policy ON evm.fee_policy_spend (evm_chain_id, policy, spent_at);
+
+-- +goose Down
+
+DROP TABLE evm.fee_policy_spend;
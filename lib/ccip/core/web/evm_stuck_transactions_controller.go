@@ -0,0 +1,103 @@
+package web
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/txmgr"
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/utils/big"
+	"github.com/smartcontractkit/chainlink/v2/core/chains/legacyevm"
+	"github.com/smartcontractkit/chainlink/v2/core/services/chainlink"
+	"github.com/smartcontractkit/chainlink/v2/core/web/presenters"
+)
+
+// StuckTransactionsController displays the stuck transactions of the EVM keys and how they were recovered.
+type StuckTransactionsController struct {
+	App chainlink.Application
+}
+
+// Index returns the transactions of the chain that are currently stuck, with the latest remedy applied to each of them
+// Example:
+//
+//	"<application>/v2/stuck_transactions/evm?evmChainID=1"
+func (stc *StuckTransactionsController) Index(c *gin.Context) {
+	chain, ok := stc.getChain(c)
+	if !ok {
+		return
+	}
+
+	ctx := c.Request.Context()
+	txs, err := chain.TxManager().FindStuckTransactions(ctx)
+	if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	chainID := big.New(chain.ID())
+	resources := []presenters.EthStuckTxResource{}
+	for _, tx := range txs {
+		history, err := chain.TxManager().StuckTxHistory(ctx, tx.FromAddress)
+		if err != nil {
+			jsonAPIError(c, http.StatusInternalServerError, err)
+			return
+		}
+		var last *txmgr.StuckTxDecision
+		for i := len(history) - 1; i >= 0; i-- {
+			if history[i].TxID == tx.ID {
+				last = &history[i]
+				break
+			}
+		}
+		resources = append(resources, presenters.NewEthStuckTxResource(chainID, tx, last))
+	}
+
+	jsonAPIResponse(c, resources, "stuck_transactions")
+}
+
+// History returns the stuck transaction recovery decisions of each enabled key of the chain, oldest first
+// Example:
+//
+//	"<application>/v2/stuck_transactions/evm/history?evmChainID=1"
+func (stc *StuckTransactionsController) History(c *gin.Context) {
+	chain, ok := stc.getChain(c)
+	if !ok {
+		return
+	}
+
+	ctx := c.Request.Context()
+	addresses, err := stc.App.GetKeyStore().Eth().EnabledAddressesForChain(ctx, chain.ID())
+	if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	chainID := big.New(chain.ID())
+	resources := []presenters.EthStuckTxDecisionResource{}
+	for _, address := range addresses {
+		history, err := chain.TxManager().StuckTxHistory(ctx, address)
+		if err != nil {
+			jsonAPIError(c, http.StatusInternalServerError, err)
+			return
+		}
+		for _, d := range history {
+			resources = append(resources, presenters.NewEthStuckTxDecisionResource(chainID, d))
+		}
+	}
+
+	jsonAPIResponse(c, resources, "stuck_transaction_decisions")
+}
+
+func (stc *StuckTransactionsController) getChain(c *gin.Context) (legacyevm.Chain, bool) {
+	chain, err := getChain(stc.App.GetRelayers().LegacyEVMChains(), c.Query("evmChainID"))
+	if err != nil {
+		if errors.Is(err, ErrInvalidChainID) || errors.Is(err, ErrMultipleChains) || errors.Is(err, ErrMissingChainID) {
+			jsonAPIError(c, http.StatusUnprocessableEntity, err)
+			return nil, false
+		}
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return nil, false
+	}
+	return chain, true
+}
@@ -0,0 +1,55 @@
+package web_test
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/v2/core/internal/cltest"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/web/presenters"
+)
+
+func TestStuckTransactionsController_Index(t *testing.T) {
+	t.Parallel()
+
+	ethClient := cltest.NewEthMocksWithStartupAssertions(t)
+	app := cltest.NewApplicationWithKey(t, ethClient)
+	require.NoError(t, app.Start(testutils.Context(t)))
+	client := app.NewHTTPClient(nil)
+
+	resp, cleanup := client.Get("/v2/stuck_transactions/evm?evmChainID=" + testutils.FixtureChainID.String())
+	t.Cleanup(cleanup)
+	cltest.AssertServerResponse(t, resp, http.StatusOK)
+
+	var txs []presenters.EthStuckTxResource
+	require.NoError(t, cltest.ParseJSONAPIResponse(t, resp, &txs))
+	assert.Empty(t, txs)
+
+	resp, cleanup = client.Get("/v2/stuck_transactions/evm?evmChainID=42")
+	t.Cleanup(cleanup)
+	cltest.AssertServerResponse(t, resp, http.StatusUnprocessableEntity)
+}
+
+func TestStuckTransactionsController_History(t *testing.T) {
+	t.Parallel()
+
+	ethClient := cltest.NewEthMocksWithStartupAssertions(t)
+	app := cltest.NewApplicationWithKey(t, ethClient)
+	require.NoError(t, app.Start(testutils.Context(t)))
+	client := app.NewHTTPClient(nil)
+
+	resp, cleanup := client.Get("/v2/stuck_transactions/evm/history?evmChainID=" + testutils.FixtureChainID.String())
+	t.Cleanup(cleanup)
+	cltest.AssertServerResponse(t, resp, http.StatusOK)
+
+	var decisions []presenters.EthStuckTxDecisionResource
+	require.NoError(t, cltest.ParseJSONAPIResponse(t, resp, &decisions))
+	assert.Empty(t, decisions)
+
+	resp, cleanup = client.Get("/v2/stuck_transactions/evm/history?evmChainID=42")
+	t.Cleanup(cleanup)
+	cltest.AssertServerResponse(t, resp, http.StatusUnprocessableEntity)
+}
@@ -27,6 +27,7 @@ import (
 	"github.com/smartcontractkit/chainlink/v2/core/services/ocr"
 	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/validate"
 	"github.com/smartcontractkit/chainlink/v2/core/services/ocrbootstrap"
+	"github.com/smartcontractkit/chainlink/v2/core/services/pipeline"
 	"github.com/smartcontractkit/chainlink/v2/core/services/standardcapabilities"
 	"github.com/smartcontractkit/chainlink/v2/core/services/streams"
 	"github.com/smartcontractkit/chainlink/v2/core/services/vrf/vrfcommon"
@@ -188,32 +189,242 @@ func (jc *JobsController) Update(c *gin.Context) {
 		return
 	}
 
+	if !jc.replaceJob(c, &jb) {
+		return
+	}
+
+	jsonAPIResponse(c, presenters.NewJobResource(jb), jb.Type.String())
+}
+
+// replaceJob deletes the job with the ID of jb and saves and starts jb in its place. It writes the
+// error response and returns false if either step fails.
+func (jc *JobsController) replaceJob(c *gin.Context, jb *job.Job) bool {
 	ctx, cancel := context.WithTimeout(c.Request.Context(), 5*time.Second)
 	defer cancel()
 
 	// If the provided job id is not matching any job, delete will fail with 404 leaving state unchanged.
-	err = jc.App.DeleteJob(ctx, jb.ID)
+	err := jc.App.DeleteJob(ctx, jb.ID)
 	// Error can be either come from ORM or from the activeJobs map.
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) || strings.Contains(err.Error(), "job not found") {
 			jsonAPIError(c, http.StatusNotFound, errors.Wrap(err, "failed to update job"))
-			return
+			return false
 		}
 		jsonAPIError(c, http.StatusInternalServerError, err)
-		return
+		return false
 	}
 
-	err = jc.App.AddJobV2(ctx, &jb)
+	err = jc.App.AddJobV2(ctx, jb)
 	if err != nil {
 		if errors.Is(errors.Cause(err), job.ErrNoSuchKeyBundle) || errors.As(err, &keystore.KeyNotFoundError{}) || errors.Is(errors.Cause(err), job.ErrNoSuchTransmitterKey) || errors.Is(errors.Cause(err), job.ErrNoSuchSendingKey) {
 			jsonAPIError(c, http.StatusBadRequest, err)
-			return
+			return false
 		}
 		jsonAPIError(c, http.StatusInternalServerError, err)
+		return false
+	}
+	return true
+}
+
+// PlanJobRequest represents a request to preview the changes of updating a job with new toml.
+type PlanJobRequest struct {
+	TOML string `json:"toml"`
+}
+
+// Plan validates a new TOML for an existing job and returns its difference with the running spec, without changing the job.
+// Example:
+// "POST <application>/jobs/:ID/plan"
+func (jc *JobsController) Plan(c *gin.Context) {
+	request := PlanJobRequest{}
+	if err := c.ShouldBindJSON(&request); err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
 		return
 	}
 
-	jsonAPIResponse(c, presenters.NewJobResource(jb), jb.Type.String())
+	proposed, current, ok := jc.loadProposedJob(c, request.TOML)
+	if !ok {
+		return
+	}
+
+	diff, err := job.DiffSpecs(current, proposed)
+	if err != nil {
+		jsonAPIError(c, http.StatusBadRequest, err)
+		return
+	}
+
+	jsonAPIResponse(c, presenters.NewJobSpecDiffResource(current.ID, diff), "jobSpecDiff")
+}
+
+// DryRunJobRequest represents a request to dry run a TOML spec, without creating the job.
+type DryRunJobRequest struct {
+	TOML string `json:"toml"`
+	pipeline.DryRunRequest
+}
+
+// DryRun validates a TOML spec and executes its pipeline without side effects. Neither the job nor the run is saved.
+// Example:
+// "POST <application>/jobs/dry-run"
+func (jc *JobsController) DryRun(c *gin.Context) {
+	request := DryRunJobRequest{}
+	if err := c.ShouldBindJSON(&request); err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+
+	jb, status, err := jc.validateJobSpec(c.Request.Context(), request.TOML)
+	if err != nil {
+		jsonAPIError(c, status, err)
+		return
+	}
+
+	run, err := jc.App.DryRunJobSpecV2(c.Request.Context(), jb, request.Vars, request.Stubs)
+	if err != nil {
+		jsonAPIError(c, http.StatusBadRequest, err)
+		return
+	}
+
+	jsonAPIResponse(c, presenters.NewPipelineRunResource(*run, jc.App.GetLogger()), "pipelineRun")
+}
+
+// ReplayJobRequest represents a request to replay inputs against a new TOML for an existing job and the running spec,
+// and optionally promote it when their outputs match.
+type ReplayJobRequest struct {
+	TOML    string                   `json:"toml"`
+	Inputs  []pipeline.DryRunRequest `json:"inputs"`
+	Promote bool                     `json:"promote"`
+}
+
+// Replay dry runs the current and proposed specs of a job once per input, and compares their outputs. The inputs are
+// supplied by the caller, the live triggers of the job are not shadowed.
+// If promote is set and all outputs match, the job is updated to the proposed spec.
+// Example:
+// "POST <application>/jobs/:ID/replay"
+func (jc *JobsController) Replay(c *gin.Context) {
+	request := ReplayJobRequest{}
+	if err := c.ShouldBindJSON(&request); err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+	if len(request.Inputs) == 0 {
+		jsonAPIError(c, http.StatusUnprocessableEntity, errors.New("at least one input is required"))
+		return
+	}
+
+	proposed, current, ok := jc.loadProposedJob(c, request.TOML)
+	if !ok {
+		return
+	}
+
+	diff, err := job.DiffSpecs(current, proposed)
+	if err != nil {
+		jsonAPIError(c, http.StatusBadRequest, err)
+		return
+	}
+
+	report, err := jc.App.ReplayJobV2(c.Request.Context(), current.ID, proposed, request.Inputs)
+	if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	promoted := false
+	if request.Promote && report.Passed() {
+		if !jc.replaceJob(c, &proposed) {
+			return
+		}
+		promoted = true
+	}
+
+	jsonAPIResponse(c, presenters.NewJobReplayResource(current.ID, diff, report, promoted), "jobReplay")
+}
+
+// CanaryJobRequest represents a request to shadow the next live runs of an existing job with a new TOML, and
+// optionally promote it when their outputs match.
+type CanaryJobRequest struct {
+	TOML    string `json:"toml"`
+	Runs    int    `json:"runs"`
+	Promote bool   `json:"promote"`
+}
+
+// Canary starts shadowing the next live runs of a job with dry runs of a new TOML, and compares their outputs. The
+// canary is refused at the first run whose outputs don't match. If promote is set and the outputs of all runs match,
+// the job is updated to the new TOML. The progress is returned by ShowCanary.
+// Example:
+// "POST <application>/jobs/:ID/canary"
+func (jc *JobsController) Canary(c *gin.Context) {
+	request := CanaryJobRequest{}
+	if err := c.ShouldBindJSON(&request); err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+	if request.Runs <= 0 {
+		jsonAPIError(c, http.StatusUnprocessableEntity, errors.New("runs must be positive"))
+		return
+	}
+
+	proposed, current, ok := jc.loadProposedJob(c, request.TOML)
+	if !ok {
+		return
+	}
+
+	diff, err := job.DiffSpecs(current, proposed)
+	if err != nil {
+		jsonAPIError(c, http.StatusBadRequest, err)
+		return
+	}
+
+	status, err := jc.App.CanaryJobV2(c.Request.Context(), current.ID, proposed, request.Runs, request.Promote)
+	if err != nil {
+		jsonAPIError(c, http.StatusBadRequest, err)
+		return
+	}
+
+	jsonAPIResponseWithStatus(c, presenters.NewJobCanaryResource(status, &diff), "jobCanary", http.StatusAccepted)
+}
+
+// ShowCanary returns the status of the last canary of a job.
+// Example:
+// "GET <application>/jobs/:ID/canary"
+func (jc *JobsController) ShowCanary(c *gin.Context) {
+	jb := job.Job{}
+	if err := jb.SetID(c.Param("ID")); err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+
+	status, ok := jc.App.JobCanaryStatusV2(jb.ID)
+	if !ok {
+		jsonAPIError(c, http.StatusNotFound, errors.New("job has no canary"))
+		return
+	}
+
+	jsonAPIResponse(c, presenters.NewJobCanaryResource(status, nil), "jobCanary")
+}
+
+// loadProposedJob validates a new TOML for the job identified by the ID param, and loads the current version of the job.
+// It writes the error response and returns false if either step fails.
+func (jc *JobsController) loadProposedJob(c *gin.Context, tomlString string) (proposed job.Job, current job.Job, ok bool) {
+	proposed, status, err := jc.validateJobSpec(c.Request.Context(), tomlString)
+	if err != nil {
+		jsonAPIError(c, status, err)
+		return
+	}
+
+	if err = proposed.SetID(c.Param("ID")); err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+
+	current, err = jc.App.JobORM().FindJob(c.Request.Context(), proposed.ID)
+	if err != nil {
+		if errors.Is(errors.Cause(err), sql.ErrNoRows) {
+			jsonAPIError(c, http.StatusNotFound, errors.New("job not found"))
+		} else {
+			jsonAPIError(c, http.StatusInternalServerError, err)
+		}
+		return
+	}
+	return proposed, current, true
 }
 
 func (jc *JobsController) validateJobSpec(ctx context.Context, tomlString string) (jb job.Job, statusCode int, err error) {
@@ -10,7 +10,9 @@ import (
 	"math/big"
 	"net/http"
 	"net/url"
+	"regexp"
 	"strconv"
+	"strings"
 	"sync"
 	"testing"
 	"time"
@@ -37,6 +39,8 @@ import (
 	"github.com/smartcontractkit/chainlink/v2/core/services/job"
 	"github.com/smartcontractkit/chainlink/v2/core/services/keystore/keys/p2pkey"
 	"github.com/smartcontractkit/chainlink/v2/core/services/keystore/keys/vrfkey"
+	"github.com/smartcontractkit/chainlink/v2/core/services/pipeline"
+	"github.com/smartcontractkit/chainlink/v2/core/services/webhook"
 	"github.com/smartcontractkit/chainlink/v2/core/testdata/testspecs"
 	"github.com/smartcontractkit/chainlink/v2/core/utils/tomlutils"
 	"github.com/smartcontractkit/chainlink/v2/core/web"
@@ -742,6 +746,213 @@ func TestJobsController_Update_NonExistentID(t *testing.T) {
 	cltest.AssertServerResponse(t, response, http.StatusNotFound)
 }
 
+func setupWebhookJobForPlan(t *testing.T) (*cltest.TestApplication, cltest.HTTPClientCleaner, job.Job, string) {
+	ctx := testutils.Context(t)
+	app := cltest.NewApplicationEVMDisabled(t)
+	require.NoError(t, app.Start(ctx))
+
+	_, bridge := cltest.MustCreateBridge(t, app.GetDB(), cltest.BridgeOpts{})
+	tomlStr := fmt.Sprintf(`
+type            = "webhook"
+schemaVersion   = 1
+externalJobID   = "%s"
+observationSource   = """
+    fetch          [type=bridge name="%s"]
+    parse_request  [type=jsonparse path="data,result"];
+    multiply       [type=multiply times="100"];
+
+    fetch -> parse_request -> multiply;
+"""
+`, uuid.New(), bridge.Name.String())
+	jb, err := webhook.ValidatedWebhookSpec(ctx, tomlStr, app.GetExternalInitiatorManager())
+	require.NoError(t, err)
+	require.NoError(t, app.AddJobV2(ctx, &jb))
+
+	return app, app.NewHTTPClient(nil), jb, tomlStr
+}
+
+func TestJobsController_Plan(t *testing.T) {
+	t.Parallel()
+
+	app, client, jb, tomlStr := setupWebhookJobForPlan(t)
+
+	t.Run("no changes", func(t *testing.T) {
+		body, _ := json.Marshal(web.PlanJobRequest{TOML: tomlStr})
+		response, cleanup := client.Post(fmt.Sprintf("/v2/jobs/%d/plan", jb.ID), bytes.NewReader(body))
+		t.Cleanup(cleanup)
+		cltest.AssertServerResponse(t, response, http.StatusOK)
+
+		var resource presenters.JobSpecDiffResource
+		require.NoError(t, web.ParseJSONAPIResponse(cltest.ParseResponseBody(t, response), &resource))
+		assert.True(t, resource.Empty)
+	})
+
+	t.Run("changed pipeline", func(t *testing.T) {
+		proposed := strings.Replace(tomlStr, `times="100"`, `times="1000"`, 1)
+		body, _ := json.Marshal(web.PlanJobRequest{TOML: proposed})
+		response, cleanup := client.Post(fmt.Sprintf("/v2/jobs/%d/plan", jb.ID), bytes.NewReader(body))
+		t.Cleanup(cleanup)
+		cltest.AssertServerResponse(t, response, http.StatusOK)
+
+		var resource presenters.JobSpecDiffResource
+		require.NoError(t, web.ParseJSONAPIResponse(cltest.ParseResponseBody(t, response), &resource))
+		assert.False(t, resource.Empty)
+		assert.Equal(t, []pipeline.TaskDiff{
+			{DotID: "multiply", Attributes: []pipeline.AttributeChange{{Key: "times", Old: "100", New: "1000"}}},
+		}, resource.Pipeline.ChangedTasks)
+
+		// The job is left unchanged
+		dbJb, err := app.JobORM().FindJob(testutils.Context(t), jb.ID)
+		require.NoError(t, err)
+		assert.Equal(t, jb.PipelineSpec.DotDagSource, dbJb.PipelineSpec.DotDagSource)
+	})
+
+	t.Run("non existent job", func(t *testing.T) {
+		body, _ := json.Marshal(web.PlanJobRequest{TOML: tomlStr})
+		response, cleanup := client.Post("/v2/jobs/99999/plan", bytes.NewReader(body))
+		t.Cleanup(cleanup)
+		cltest.AssertServerResponse(t, response, http.StatusNotFound)
+	})
+}
+
+func TestJobsController_DryRun(t *testing.T) {
+	t.Parallel()
+
+	app, client, _, tomlStr := setupWebhookJobForPlan(t)
+	// A spec which isn't created, the external job ID must be unique
+	proposed := strings.Replace(tomlStr, `times="100"`, `times="1000"`, 1)
+	proposed = regexp.MustCompile(`externalJobID\s*= ".*"`).ReplaceAllString(proposed, fmt.Sprintf(`externalJobID = "%s"`, uuid.New()))
+
+	t.Run("runs the spec pipeline", func(t *testing.T) {
+		body, _ := json.Marshal(web.DryRunJobRequest{TOML: proposed, DryRunRequest: pipeline.DryRunRequest{
+			Stubs: pipeline.DryRunStubs{"fetch": map[string]interface{}{"data": map[string]interface{}{"result": "1.5"}}},
+		}})
+		response, cleanup := client.Post("/v2/jobs/dry-run", bytes.NewReader(body))
+		t.Cleanup(cleanup)
+		cltest.AssertServerResponse(t, response, http.StatusOK)
+
+		var resource presenters.PipelineRunResource
+		require.NoError(t, web.ParseJSONAPIResponse(cltest.ParseResponseBody(t, response), &resource))
+		require.Len(t, resource.TaskRuns, 3)
+		for _, tr := range resource.TaskRuns {
+			assert.Nil(t, tr.Error, tr.DotID)
+			if tr.DotID == "multiply" {
+				require.NotNil(t, tr.Output)
+				assert.Equal(t, `"1500"`, *tr.Output)
+			}
+		}
+
+		// The job isn't created
+		jobs, _, err := app.JobORM().FindJobs(testutils.Context(t), 0, 10)
+		require.NoError(t, err)
+		assert.Len(t, jobs, 1)
+	})
+
+	t.Run("invalid spec", func(t *testing.T) {
+		body, _ := json.Marshal(web.DryRunJobRequest{TOML: "not toml"})
+		response, cleanup := client.Post("/v2/jobs/dry-run", bytes.NewReader(body))
+		t.Cleanup(cleanup)
+		cltest.AssertServerResponse(t, response, http.StatusUnprocessableEntity)
+	})
+}
+
+func TestJobsController_Replay(t *testing.T) {
+	t.Parallel()
+
+	app, client, jb, tomlStr := setupWebhookJobForPlan(t)
+	inputs := []pipeline.DryRunRequest{
+		{Stubs: pipeline.DryRunStubs{"fetch": map[string]interface{}{"data": map[string]interface{}{"result": "1.5"}}}},
+		{Stubs: pipeline.DryRunStubs{"fetch": map[string]interface{}{"data": map[string]interface{}{"result": "2"}}}},
+	}
+
+	t.Run("mismatching outputs aren't promoted", func(t *testing.T) {
+		proposed := strings.Replace(tomlStr, `times="100"`, `times="1000"`, 1)
+		body, _ := json.Marshal(web.ReplayJobRequest{TOML: proposed, Inputs: inputs, Promote: true})
+		response, cleanup := client.Post(fmt.Sprintf("/v2/jobs/%d/replay", jb.ID), bytes.NewReader(body))
+		t.Cleanup(cleanup)
+		cltest.AssertServerResponse(t, response, http.StatusOK)
+
+		var resource presenters.JobReplayResource
+		require.NoError(t, web.ParseJSONAPIResponse(cltest.ParseResponseBody(t, response), &resource))
+		require.Len(t, resource.Results, 2)
+		assert.False(t, resource.Results[0].Match)
+		assert.False(t, resource.Passed)
+		assert.False(t, resource.Promoted)
+
+		dbJb, err := app.JobORM().FindJob(testutils.Context(t), jb.ID)
+		require.NoError(t, err)
+		assert.Equal(t, jb.PipelineSpec.DotDagSource, dbJb.PipelineSpec.DotDagSource)
+	})
+
+	t.Run("matching outputs are promoted", func(t *testing.T) {
+		// Equivalent multiplier, written differently
+		proposed := strings.Replace(tomlStr, `times="100"`, `times="100.0"`, 1)
+		body, _ := json.Marshal(web.ReplayJobRequest{TOML: proposed, Inputs: inputs, Promote: true})
+		response, cleanup := client.Post(fmt.Sprintf("/v2/jobs/%d/replay", jb.ID), bytes.NewReader(body))
+		t.Cleanup(cleanup)
+		cltest.AssertServerResponse(t, response, http.StatusOK)
+
+		var resource presenters.JobReplayResource
+		require.NoError(t, web.ParseJSONAPIResponse(cltest.ParseResponseBody(t, response), &resource))
+		assert.True(t, resource.Passed)
+		assert.True(t, resource.Promoted)
+
+		dbJb, err := app.JobORM().FindJob(testutils.Context(t), jb.ID)
+		require.NoError(t, err)
+		assert.Contains(t, dbJb.PipelineSpec.DotDagSource, `times="100.0"`)
+	})
+
+	t.Run("inputs are required", func(t *testing.T) {
+		body, _ := json.Marshal(web.ReplayJobRequest{TOML: tomlStr})
+		response, cleanup := client.Post(fmt.Sprintf("/v2/jobs/%d/replay", jb.ID), bytes.NewReader(body))
+		t.Cleanup(cleanup)
+		cltest.AssertServerResponse(t, response, http.StatusUnprocessableEntity)
+	})
+}
+
+func TestJobsController_Canary(t *testing.T) {
+	t.Parallel()
+
+	_, client, jb, tomlStr := setupWebhookJobForPlan(t)
+	proposed := strings.Replace(tomlStr, `times="100"`, `times="1000"`, 1)
+
+	t.Run("job without canary", func(t *testing.T) {
+		response, cleanup := client.Get(fmt.Sprintf("/v2/jobs/%d/canary", jb.ID))
+		t.Cleanup(cleanup)
+		cltest.AssertServerResponse(t, response, http.StatusNotFound)
+	})
+
+	t.Run("runs are required", func(t *testing.T) {
+		body, _ := json.Marshal(web.CanaryJobRequest{TOML: proposed})
+		response, cleanup := client.Post(fmt.Sprintf("/v2/jobs/%d/canary", jb.ID), bytes.NewReader(body))
+		t.Cleanup(cleanup)
+		cltest.AssertServerResponse(t, response, http.StatusUnprocessableEntity)
+	})
+
+	t.Run("starts shadowing the live runs", func(t *testing.T) {
+		body, _ := json.Marshal(web.CanaryJobRequest{TOML: proposed, Runs: 3, Promote: true})
+		response, cleanup := client.Post(fmt.Sprintf("/v2/jobs/%d/canary", jb.ID), bytes.NewReader(body))
+		t.Cleanup(cleanup)
+		cltest.AssertServerResponse(t, response, http.StatusAccepted)
+
+		var resource presenters.JobCanaryResource
+		require.NoError(t, web.ParseJSONAPIResponse(cltest.ParseResponseBody(t, response), &resource))
+		assert.Equal(t, job.CanaryRunning, resource.State)
+		assert.Equal(t, 3, resource.Runs)
+		require.NotNil(t, resource.Diff)
+		require.Len(t, resource.Diff.Pipeline.ChangedTasks, 1)
+
+		response, cleanup = client.Get(fmt.Sprintf("/v2/jobs/%d/canary", jb.ID))
+		t.Cleanup(cleanup)
+		cltest.AssertServerResponse(t, response, http.StatusOK)
+		resource = presenters.JobCanaryResource{}
+		require.NoError(t, web.ParseJSONAPIResponse(cltest.ParseResponseBody(t, response), &resource))
+		assert.Equal(t, job.CanaryRunning, resource.State)
+		assert.Nil(t, resource.Diff)
+		assert.Empty(t, resource.Results)
+	})
+}
+
 func runOCRJobSpecAssertions(t *testing.T, ocrJobSpecFromFileDB job.Job, ocrJobSpecFromServer presenters.JobResource) {
 	ocrJobSpecFromFile := ocrJobSpecFromFileDB.OCROracleSpec
 	assert.Equal(t, ocrJobSpecFromFile.ContractAddress, ocrJobSpecFromServer.OffChainReportingSpec.ContractAddress)
@@ -1,6 +1,7 @@
 package web
 
 import (
+	"database/sql"
 	"encoding/json"
 	"io"
 	"net/http"
@@ -155,6 +156,37 @@ func (prc *PipelineRunsController) Create(c *gin.Context) {
 	jsonAPIError(c, http.StatusUnprocessableEntity, errors.New("bad job ID"))
 }
 
+// DryRun executes a job pipeline without side effects and returns the run, which is not persisted.
+// Example:
+// "POST <application>/jobs/:ID/runs/dry-run"
+func (prc *PipelineRunsController) DryRun(c *gin.Context) {
+	jobID64, err := strconv.ParseInt(c.Param("ID"), 10, 32)
+	if err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, errors.New("bad job ID"))
+		return
+	}
+
+	var req pipeline.DryRunRequest
+	if c.Request.ContentLength != 0 {
+		if err = json.NewDecoder(c.Request.Body).Decode(&req); err != nil {
+			jsonAPIError(c, http.StatusUnprocessableEntity, errors.Wrap(err, "failed to unmarshal JSON body"))
+			return
+		}
+	}
+
+	run, err := prc.App.DryRunJobV2(c.Request.Context(), int32(jobID64), req.Vars, req.Stubs)
+	if errors.Is(err, sql.ErrNoRows) {
+		jsonAPIError(c, http.StatusNotFound, err)
+		return
+	} else if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	res := presenters.NewPipelineRunResource(*run, prc.App.GetLogger())
+	jsonAPIResponse(c, res, "pipelineRun")
+}
+
 // Resume finishes a task and resumes the pipeline run.
 // Example:
 // "PATCH <application>/jobs/:ID/runs/:runID"
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"io"
 	"net/http"
+	"net/http/httptest"
 	"net/url"
 	"strconv"
 	"strings"
@@ -147,6 +148,87 @@ func TestPipelineRunsController_CreateNoBody_HappyPath(t *testing.T) {
 	}
 }
 
+func TestPipelineRunsController_DryRun_HappyPath(t *testing.T) {
+	t.Parallel()
+
+	ctx := testutils.Context(t)
+	ethClient := cltest.NewEthMocksWithStartupAssertions(t)
+	app := cltest.NewApplicationWithConfig(t, configtest.NewGeneralConfig(t, nil), ethClient)
+	require.NoError(t, app.Start(ctx))
+
+	// The bridges must never be called, their responses are stubbed
+	mockServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		t.Errorf("unexpected bridge request to %s during dry run", r.URL)
+	}))
+	t.Cleanup(mockServer.Close)
+	_, bridge := cltest.MustCreateBridge(t, app.GetDB(), cltest.BridgeOpts{URL: mockServer.URL})
+	_, submitBridge := cltest.MustCreateBridge(t, app.GetDB(), cltest.BridgeOpts{URL: mockServer.URL})
+
+	tomlStr := testspecs.GetWebhookSpecNoBody(uuid.New(), bridge.Name.String(), submitBridge.Name.String())
+	jb, err := webhook.ValidatedWebhookSpec(ctx, tomlStr, app.GetExternalInitiatorManager())
+	require.NoError(t, err)
+	require.NoError(t, app.AddJobV2(ctx, &jb))
+
+	client := app.NewHTTPClient(nil)
+	body := strings.NewReader(`{"stubs":{"fetch":{"data":{"result":"123.45"}},"submit":"{}"}}`)
+	response, cleanup := client.Post(fmt.Sprintf("/v2/jobs/%d/runs/dry-run", jb.ID), body)
+	defer cleanup()
+	cltest.AssertServerResponse(t, response, http.StatusOK)
+
+	var parsedResponse presenters.PipelineRunResource
+	require.NoError(t, web.ParseJSONAPIResponse(cltest.ParseResponseBody(t, response), &parsedResponse))
+	require.Len(t, parsedResponse.TaskRuns, 4)
+	for _, tr := range parsedResponse.TaskRuns {
+		assert.Nil(t, tr.Error, tr.DotID)
+		if tr.DotID == "multiply" {
+			require.NotNil(t, tr.Output)
+			assert.Equal(t, `"12345"`, *tr.Output)
+		}
+	}
+
+	// The run isn't persisted
+	_, count, err := app.JobORM().PipelineRuns(ctx, &jb.ID, 0, 10)
+	require.NoError(t, err)
+	assert.Zero(t, count)
+}
+
+func TestPipelineRunsController_DryRun_MissingStub(t *testing.T) {
+	t.Parallel()
+
+	ctx := testutils.Context(t)
+	app := cltest.NewApplicationEVMDisabled(t)
+	require.NoError(t, app.Start(ctx))
+
+	_, bridge := cltest.MustCreateBridge(t, app.GetDB(), cltest.BridgeOpts{})
+	_, submitBridge := cltest.MustCreateBridge(t, app.GetDB(), cltest.BridgeOpts{})
+	tomlStr := testspecs.GetWebhookSpecNoBody(uuid.New(), bridge.Name.String(), submitBridge.Name.String())
+	jb, err := webhook.ValidatedWebhookSpec(ctx, tomlStr, app.GetExternalInitiatorManager())
+	require.NoError(t, err)
+	require.NoError(t, app.AddJobV2(ctx, &jb))
+
+	client := app.NewHTTPClient(nil)
+	response, cleanup := client.Post(fmt.Sprintf("/v2/jobs/%d/runs/dry-run", jb.ID), nil)
+	defer cleanup()
+	cltest.AssertServerResponse(t, response, http.StatusOK)
+
+	var parsedResponse presenters.PipelineRunResource
+	require.NoError(t, web.ParseJSONAPIResponse(cltest.ParseResponseBody(t, response), &parsedResponse))
+	require.NotEmpty(t, parsedResponse.TaskRuns)
+	require.NotNil(t, parsedResponse.TaskRuns[0].Error)
+	assert.Contains(t, *parsedResponse.TaskRuns[0].Error, "no stubbed response for dry run")
+}
+
+func TestPipelineRunsController_DryRun_NotFound(t *testing.T) {
+	t.Parallel()
+	app := cltest.NewApplicationEVMDisabled(t)
+	require.NoError(t, app.Start(testutils.Context(t)))
+	client := app.NewHTTPClient(nil)
+
+	response, cleanup := client.Post("/v2/jobs/999999/runs/dry-run", nil)
+	defer cleanup()
+	cltest.AssertServerResponse(t, response, http.StatusNotFound)
+}
+
 func TestPipelineRunsController_Index_GlobalHappyPath(t *testing.T) {
 	client, jobID, runIDs := setupPipelineRunsControllerTests(t)
 
@@ -2,6 +2,7 @@ package presenters
 
 import (
 	"strconv"
+	"time"
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
@@ -77,3 +78,90 @@ func NewEthTxResourceFromAttempt(txa txmgr.TxAttempt) EthTxResource {
 	}
 	return r
 }
+
+// EthStuckTxDecisionResource represents how a stuck Ethereum Transaction was recovered
+type EthStuckTxDecisionResource struct {
+	JAID
+	TxID       string         `json:"txID"`
+	From       common.Address `json:"from"`
+	Nonce      string         `json:"nonce"`
+	Reason     string         `json:"reason"`
+	Remedy     string         `json:"remedy"`
+	BlockNum   string         `json:"blockNum"`
+	DecidedAt  time.Time      `json:"decidedAt"`
+	Error      string         `json:"error"`
+	EVMChainID big.Big        `json:"evmChainID"`
+}
+
+// GetName implements the api2go EntityNamer interface
+func (EthStuckTxDecisionResource) GetName() string {
+	return "evm_stuck_transaction_decisions"
+}
+
+// NewEthStuckTxDecisionResource generates a EthStuckTxDecisionResource from a txmgr.StuckTxDecision.
+func NewEthStuckTxDecisionResource(chainID *big.Big, d txmgr.StuckTxDecision) EthStuckTxDecisionResource {
+	txID := strconv.FormatInt(d.TxID, 10)
+	blockNum := strconv.FormatInt(d.BlockNum, 10)
+	return EthStuckTxDecisionResource{
+		JAID:       NewPrefixedJAID(txID+"-"+blockNum, chainID.String()),
+		TxID:       txID,
+		From:       d.FromAddress,
+		Nonce:      d.Sequence.String(),
+		Reason:     string(d.Reason),
+		Remedy:     string(d.Remedy),
+		BlockNum:   blockNum,
+		DecidedAt:  d.DecidedAt,
+		Error:      d.Error,
+		EVMChainID: *chainID,
+	}
+}
+
+// EthStuckTxResource represents an Ethereum Transaction that has been waiting for confirmation for too long
+type EthStuckTxResource struct {
+	JAID
+	TxID     string         `json:"txID"`
+	From     common.Address `json:"from"`
+	Nonce    string         `json:"nonce"`
+	Hash     common.Hash    `json:"hash"`
+	Attempts int            `json:"attempts"`
+	// SentAt is the block the oldest attempt was broadcast before
+	SentAt string `json:"sentAt"`
+	// LastRemedy is the latest remedy applied to the transaction, if any
+	LastReason    string     `json:"lastReason"`
+	LastRemedy    string     `json:"lastRemedy"`
+	LastDecidedAt *time.Time `json:"lastDecidedAt"`
+	EVMChainID    big.Big    `json:"evmChainID"`
+}
+
+// GetName implements the api2go EntityNamer interface
+func (EthStuckTxResource) GetName() string {
+	return "evm_stuck_transactions"
+}
+
+// NewEthStuckTxResource generates a EthStuckTxResource from a stuck txmgr.Tx and the latest decision taken to recover it, if any.
+func NewEthStuckTxResource(chainID *big.Big, tx txmgr.Tx, last *txmgr.StuckTxDecision) EthStuckTxResource {
+	txID := strconv.FormatInt(tx.ID, 10)
+	r := EthStuckTxResource{
+		JAID:       NewPrefixedJAID(txID, chainID.String()),
+		TxID:       txID,
+		From:       tx.FromAddress,
+		Attempts:   len(tx.TxAttempts),
+		EVMChainID: *chainID,
+	}
+	if tx.Sequence != nil {
+		r.Nonce = tx.Sequence.String()
+	}
+	if len(tx.TxAttempts) > 0 {
+		// Attempts are loaded from newest to oldest
+		r.Hash = tx.TxAttempts[0].Hash
+		if oldest := tx.TxAttempts[len(tx.TxAttempts)-1]; oldest.BroadcastBeforeBlockNum != nil {
+			r.SentAt = strconv.FormatInt(*oldest.BroadcastBeforeBlockNum, 10)
+		}
+	}
+	if last != nil {
+		r.LastReason = string(last.Reason)
+		r.LastRemedy = string(last.Remedy)
+		r.LastDecidedAt = &last.DecidedAt
+	}
+	return r
+}
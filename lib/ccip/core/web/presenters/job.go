@@ -602,3 +602,78 @@ func NewJobResource(j job.Job) *JobResource {
 func (r JobResource) GetName() string {
 	return "jobs"
 }
+
+// JobSpecDiffResource represents the difference between the running and a proposed spec of a job
+type JobSpecDiffResource struct {
+	JAID
+	job.SpecDiff
+	Empty bool `json:"empty"`
+}
+
+// NewJobSpecDiffResource initializes a new JSONAPI job spec diff resource
+func NewJobSpecDiffResource(jobID int32, diff job.SpecDiff) *JobSpecDiffResource {
+	return &JobSpecDiffResource{
+		JAID:     NewJAIDInt32(jobID),
+		SpecDiff: diff,
+		Empty:    diff.IsEmpty(),
+	}
+}
+
+// GetName implements the api2go EntityNamer interface
+func (r JobSpecDiffResource) GetName() string {
+	return "jobSpecDiffs"
+}
+
+// JobReplayResource represents the results of replaying inputs against a proposed spec of a job and the running one
+type JobReplayResource struct {
+	JAID
+	Diff     job.SpecDiff       `json:"diff"`
+	Results  []job.ReplayResult `json:"results"`
+	Passed   bool               `json:"passed"`
+	Promoted bool               `json:"promoted"`
+}
+
+// NewJobReplayResource initializes a new JSONAPI job replay resource
+func NewJobReplayResource(jobID int32, diff job.SpecDiff, report job.ReplayReport, promoted bool) *JobReplayResource {
+	return &JobReplayResource{
+		JAID:     NewJAIDInt32(jobID),
+		Diff:     diff,
+		Results:  report.Results,
+		Passed:   report.Passed(),
+		Promoted: promoted,
+	}
+}
+
+// GetName implements the api2go EntityNamer interface
+func (r JobReplayResource) GetName() string {
+	return "jobReplays"
+}
+
+// JobCanaryResource represents the progress of shadowing the live runs of a job with a proposed spec
+type JobCanaryResource struct {
+	JAID
+	Diff    *job.SpecDiff      `json:"diff,omitempty"`
+	Runs    int                `json:"runs"`
+	Promote bool               `json:"promote"`
+	State   job.CanaryState    `json:"state"`
+	Results []job.ReplayResult `json:"results"`
+	Error   string             `json:"error,omitempty"`
+}
+
+// NewJobCanaryResource initializes a new JSONAPI job canary resource. The diff is only set when the canary is started.
+func NewJobCanaryResource(status job.CanaryStatus, diff *job.SpecDiff) *JobCanaryResource {
+	return &JobCanaryResource{
+		JAID:    NewJAIDInt32(status.JobID),
+		Diff:    diff,
+		Runs:    status.Runs,
+		Promote: status.Promote,
+		State:   status.State,
+		Results: status.Results,
+		Error:   status.Error,
+	}
+}
+
+// GetName implements the api2go EntityNamer interface
+func (r JobCanaryResource) GetName() string {
+	return "jobCanaries"
+}
@@ -0,0 +1,89 @@
+package presenters
+
+import (
+	"sort"
+	"time"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/values"
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	"github.com/smartcontractkit/chainlink/v2/core/services/workflows/store"
+)
+
+// WorkflowExecutionResource represents a workflow execution JSONAPI resource.
+type WorkflowExecutionResource struct {
+	JAID
+	WorkflowID string                          `json:"workflowID"`
+	Status     string                          `json:"status"`
+	Steps      []WorkflowExecutionStepResource `json:"steps"`
+	CreatedAt  *time.Time                      `json:"createdAt"`
+	UpdatedAt  *time.Time                      `json:"updatedAt"`
+	FinishedAt *time.Time                      `json:"finishedAt"`
+}
+
+// GetName implements the api2go EntityNamer interface
+func (r WorkflowExecutionResource) GetName() string {
+	return "workflowExecution"
+}
+
+// WorkflowExecutionStepResource represents a step of a workflow execution.
+// The compensation of a step is reported with the "compensate:" prefix in front of the ref of the step.
+type WorkflowExecutionStepResource struct {
+	Ref     string  `json:"ref"`
+	Status  string  `json:"status"`
+	Inputs  any     `json:"inputs"`
+	Outputs any     `json:"outputs"`
+	Error   *string `json:"error"`
+}
+
+// NewWorkflowExecutionResource constructs a new WorkflowExecutionResource, with the steps sorted by ref.
+func NewWorkflowExecutionResource(ex store.WorkflowExecution, lggr logger.Logger) WorkflowExecutionResource {
+	steps := make([]WorkflowExecutionStepResource, 0, len(ex.Steps))
+	for _, s := range ex.Steps {
+		steps = append(steps, NewWorkflowExecutionStepResource(s, lggr))
+	}
+	sort.Slice(steps, func(i, j int) bool { return steps[i].Ref < steps[j].Ref })
+
+	return WorkflowExecutionResource{
+		JAID:       NewJAID(ex.ExecutionID),
+		WorkflowID: ex.WorkflowID,
+		Status:     ex.Status,
+		Steps:      steps,
+		CreatedAt:  ex.CreatedAt,
+		UpdatedAt:  ex.UpdatedAt,
+		FinishedAt: ex.FinishedAt,
+	}
+}
+
+// NewWorkflowExecutionStepResource constructs a new WorkflowExecutionStepResource.
+func NewWorkflowExecutionStepResource(s *store.WorkflowExecutionStep, lggr logger.Logger) WorkflowExecutionStepResource {
+	r := WorkflowExecutionStepResource{
+		Ref:    s.Ref,
+		Status: s.Status,
+	}
+
+	var err error
+	if s.Inputs != nil {
+		if r.Inputs, err = s.Inputs.Unwrap(); err != nil {
+			lggr.Errorw("failed to unwrap step inputs", "executionID", s.ExecutionID, "ref", s.Ref, "err", err)
+		}
+	}
+	if r.Outputs, err = values.Unwrap(s.Outputs.Value); err != nil {
+		lggr.Errorw("failed to unwrap step outputs", "executionID", s.ExecutionID, "ref", s.Ref, "err", err)
+	}
+	if s.Outputs.Err != nil {
+		errString := s.Outputs.Err.Error()
+		r.Error = &errString
+	}
+
+	return r
+}
+
+// NewWorkflowExecutionResources constructs a slice of JSONAPI workflow execution resources.
+func NewWorkflowExecutionResources(executions []store.WorkflowExecution, lggr logger.Logger) []WorkflowExecutionResource {
+	rs := []WorkflowExecutionResource{}
+	for _, ex := range executions {
+		rs = append(rs, NewWorkflowExecutionResource(ex, lggr))
+	}
+
+	return rs
+}
@@ -267,3 +267,55 @@ func (r *RunJobCannotRunErrorResolver) Code() ErrorCode {
 func (r *RunJobCannotRunErrorResolver) Message() string {
 	return r.message
 }
+
+// -- DryRunJob Mutation --
+
+type DryRunJobPayloadResolver struct {
+	run       *pipeline.Run
+	app       chainlink.Application
+	inputErrs map[string]string
+	NotFoundErrorUnionType
+}
+
+func NewDryRunJobPayload(run *pipeline.Run, app chainlink.Application, inputErrs map[string]string, err error) *DryRunJobPayloadResolver {
+	var e NotFoundErrorUnionType
+	if err != nil {
+		e = NotFoundErrorUnionType{err: err, message: "job not found", isExpectedErrorFn: nil}
+	}
+
+	return &DryRunJobPayloadResolver{run: run, app: app, inputErrs: inputErrs, NotFoundErrorUnionType: e}
+}
+
+func (r *DryRunJobPayloadResolver) ToDryRunJobSuccess() (*DryRunJobSuccessResolver, bool) {
+	if r.run == nil {
+		return nil, false
+	}
+
+	return NewDryRunJobSuccess(*r.run, r.app), true
+}
+
+func (r *DryRunJobPayloadResolver) ToInputErrors() (*InputErrorsResolver, bool) {
+	if r.inputErrs == nil {
+		return nil, false
+	}
+
+	var errs []*InputErrorResolver
+	for path, message := range r.inputErrs {
+		errs = append(errs, NewInputError(path, message))
+	}
+
+	return NewInputErrors(errs), true
+}
+
+type DryRunJobSuccessResolver struct {
+	run pipeline.Run
+	app chainlink.Application
+}
+
+func NewDryRunJobSuccess(run pipeline.Run, app chainlink.Application) *DryRunJobSuccessResolver {
+	return &DryRunJobSuccessResolver{run: run, app: app}
+}
+
+func (r *DryRunJobSuccessResolver) JobRun() *JobRunResolver {
+	return NewJobRun(r.run, r.app)
+}
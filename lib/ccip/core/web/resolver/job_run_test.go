@@ -399,3 +399,136 @@ func TestResolver_RunJob(t *testing.T) {
 
 	RunGQLTests(t, testCases)
 }
+
+func TestResolver_DryRunJob(t *testing.T) {
+	t.Parallel()
+
+	mutation := `
+		mutation DryRunJob($id: ID!, $input: DryRunJobInput) {
+			dryRunJob(id: $id, input: $input) {
+				... on DryRunJobSuccess {
+					jobRun {
+						id
+						fatalErrors
+						outputs
+						status
+					}
+				}
+				... on NotFoundError {
+					code
+					message
+				}
+				... on InputErrors {
+					errors {
+						path
+						message
+						code
+					}
+				}
+			}
+		}`
+	id := int32(12)
+	idStr := stringutils.FromInt32(id)
+
+	outputs := jsonserializable.JSONSerializable{}
+	err := outputs.UnmarshalJSON([]byte(`["12345"]`))
+	require.NoError(t, err)
+
+	gError := errors.New("error")
+
+	testCases := []GQLTestCase{
+		unauthorizedTestCase(GQLTestCase{query: mutation, variables: map[string]interface{}{"id": idStr}}, "dryRunJob"),
+		{
+			name:          "success",
+			authenticated: true,
+			before: func(ctx context.Context, f *gqlTestFramework) {
+				f.App.On("DryRunJobV2", mock.Anything, id,
+					map[string]interface{}{"jobRun": map[string]interface{}{"requestBody": "foo"}},
+					pipeline.DryRunStubs{"fetch": "{}"},
+				).Return(&pipeline.Run{
+					PipelineSpecID: 5,
+					CreatedAt:      f.Timestamp(),
+					FinishedAt:     null.TimeFrom(f.Timestamp()),
+					FatalErrors:    pipeline.RunErrors{null.String{}},
+					Outputs:        outputs,
+					State:          pipeline.RunStatusCompleted,
+				}, nil)
+			},
+			query: mutation,
+			variables: map[string]interface{}{
+				"id": idStr,
+				"input": map[string]interface{}{
+					"vars":  `{"jobRun": {"requestBody": "foo"}}`,
+					"stubs": `{"fetch": "{}"}`,
+				},
+			},
+			result: `
+				{
+					"dryRunJob": {
+						"jobRun": {
+							"id": "0",
+							"fatalErrors": [],
+							"outputs": ["12345"],
+							"status": "COMPLETED"
+						}
+					}
+				}`,
+		},
+		{
+			name:          "invalid stubs",
+			authenticated: true,
+			query:         mutation,
+			variables: map[string]interface{}{
+				"id":    idStr,
+				"input": map[string]interface{}{"stubs": "not json"},
+			},
+			result: `
+				{
+					"dryRunJob": {
+						"errors": [{
+							"path": "input/stubs",
+							"message": "invalid JSON object",
+							"code": "INVALID_INPUT"
+						}]
+					}
+				}`,
+		},
+		{
+			name:          "not found job error",
+			authenticated: true,
+			before: func(ctx context.Context, f *gqlTestFramework) {
+				f.App.On("DryRunJobV2", mock.Anything, id, (map[string]interface{})(nil), (pipeline.DryRunStubs)(nil)).
+					Return(nil, errors.Wrap(sql.ErrNoRows, "job ID 12"))
+			},
+			query:     mutation,
+			variables: map[string]interface{}{"id": idStr},
+			result: `
+				{
+					"dryRunJob": {
+						"code": "NOT_FOUND",
+						"message": "job not found"
+					}
+				}`,
+		},
+		{
+			name:          "generic error on DryRunJobV2",
+			authenticated: true,
+			before: func(ctx context.Context, f *gqlTestFramework) {
+				f.App.On("DryRunJobV2", mock.Anything, id, (map[string]interface{})(nil), (pipeline.DryRunStubs)(nil)).Return(nil, gError)
+			},
+			query:     mutation,
+			variables: map[string]interface{}{"id": idStr},
+			result:    `null`,
+			errors: []*gqlerrors.QueryError{
+				{
+					Extensions:    nil,
+					ResolverError: gError,
+					Path:          []interface{}{"dryRunJob"},
+					Message:       gError.Error(),
+				},
+			},
+		},
+	}
+
+	RunGQLTests(t, testCases)
+}
@@ -37,6 +37,7 @@ import (
 	"github.com/smartcontractkit/chainlink/v2/core/services/ocr"
 	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/validate"
 	"github.com/smartcontractkit/chainlink/v2/core/services/ocrbootstrap"
+	"github.com/smartcontractkit/chainlink/v2/core/services/pipeline"
 	"github.com/smartcontractkit/chainlink/v2/core/services/standardcapabilities"
 	"github.com/smartcontractkit/chainlink/v2/core/services/streams"
 	"github.com/smartcontractkit/chainlink/v2/core/services/vrf/vrfcommon"
@@ -1190,6 +1191,52 @@ func (r *Resolver) RunJob(ctx context.Context, args struct {
 	return NewRunJobPayload(&plnRun, r.App, nil), nil
 }
 
+func (r *Resolver) DryRunJob(ctx context.Context, args struct {
+	ID    graphql.ID
+	Input *struct {
+		Vars  *string
+		Stubs *string
+	}
+}) (*DryRunJobPayloadResolver, error) {
+	if err := authenticateUserCanRun(ctx); err != nil {
+		return nil, err
+	}
+
+	jobID, err := stringutils.ToInt32(string(args.ID))
+	if err != nil {
+		return nil, err
+	}
+
+	var req pipeline.DryRunRequest
+	if args.Input != nil {
+		inputErrs := map[string]string{}
+		if args.Input.Vars != nil {
+			if err = json.Unmarshal([]byte(*args.Input.Vars), &req.Vars); err != nil {
+				inputErrs["input/vars"] = "invalid JSON object"
+			}
+		}
+		if args.Input.Stubs != nil {
+			if err = json.Unmarshal([]byte(*args.Input.Stubs), &req.Stubs); err != nil {
+				inputErrs["input/stubs"] = "invalid JSON object"
+			}
+		}
+		if len(inputErrs) > 0 {
+			return NewDryRunJobPayload(nil, r.App, inputErrs, nil), nil
+		}
+	}
+
+	run, err := r.App.DryRunJobV2(ctx, jobID, req.Vars, req.Stubs)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return NewDryRunJobPayload(nil, r.App, nil, err), nil
+		}
+
+		return nil, err
+	}
+
+	return NewDryRunJobPayload(run, r.App, nil, nil), nil
+}
+
 func (r *Resolver) SetGlobalLogLevel(ctx context.Context, args struct {
 	Level LogLevel
 }) (*SetGlobalLogLevelPayloadResolver, error) {
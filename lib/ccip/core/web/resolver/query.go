@@ -575,3 +575,24 @@ func (r *Resolver) OCR2KeyBundles(ctx context.Context) (*OCR2KeyBundlesPayloadRe
 
 	return NewOCR2KeyBundlesPayload(ekbs), nil
 }
+
+// WorkflowExecutions resolves a page of the executions of a workflow, most recent first
+func (r *Resolver) WorkflowExecutions(ctx context.Context, args struct {
+	WorkflowID graphql.ID
+	Offset     *int32
+	Limit      *int32
+}) (*WorkflowExecutionsPayloadResolver, error) {
+	if err := authenticateUser(ctx); err != nil {
+		return nil, err
+	}
+
+	limit := pageLimit(args.Limit)
+	offset := pageOffset(args.Offset)
+
+	executions, count, err := r.App.WorkflowORM().GetByWorkflowID(ctx, string(args.WorkflowID), offset, limit)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewWorkflowExecutionsPayload(executions, int32(count)), nil
+}
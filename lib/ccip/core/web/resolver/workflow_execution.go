@@ -0,0 +1,148 @@
+package resolver
+
+import (
+	"encoding/json"
+	"sort"
+
+	"github.com/graph-gophers/graphql-go"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/values"
+	"github.com/smartcontractkit/chainlink/v2/core/services/workflows/store"
+)
+
+// WorkflowExecutionResolver resolves the WorkflowExecution type.
+type WorkflowExecutionResolver struct {
+	execution store.WorkflowExecution
+}
+
+func NewWorkflowExecution(execution store.WorkflowExecution) *WorkflowExecutionResolver {
+	return &WorkflowExecutionResolver{execution: execution}
+}
+
+func NewWorkflowExecutions(executions []store.WorkflowExecution) []*WorkflowExecutionResolver {
+	var resolvers []*WorkflowExecutionResolver
+	for _, ex := range executions {
+		resolvers = append(resolvers, NewWorkflowExecution(ex))
+	}
+
+	return resolvers
+}
+
+func (r *WorkflowExecutionResolver) ID() graphql.ID {
+	return graphql.ID(r.execution.ExecutionID)
+}
+
+func (r *WorkflowExecutionResolver) WorkflowID() string {
+	return r.execution.WorkflowID
+}
+
+func (r *WorkflowExecutionResolver) Status() string {
+	return r.execution.Status
+}
+
+// Steps returns the steps of the execution, sorted by ref.
+func (r *WorkflowExecutionResolver) Steps() []*WorkflowExecutionStepResolver {
+	steps := make([]*WorkflowExecutionStepResolver, 0, len(r.execution.Steps))
+	for _, s := range r.execution.Steps {
+		steps = append(steps, &WorkflowExecutionStepResolver{step: s})
+	}
+	sort.Slice(steps, func(i, j int) bool { return steps[i].step.Ref < steps[j].step.Ref })
+
+	return steps
+}
+
+func (r *WorkflowExecutionResolver) CreatedAt() *graphql.Time {
+	if r.execution.CreatedAt == nil {
+		return nil
+	}
+	return &graphql.Time{Time: *r.execution.CreatedAt}
+}
+
+func (r *WorkflowExecutionResolver) UpdatedAt() *graphql.Time {
+	if r.execution.UpdatedAt == nil {
+		return nil
+	}
+	return &graphql.Time{Time: *r.execution.UpdatedAt}
+}
+
+func (r *WorkflowExecutionResolver) FinishedAt() *graphql.Time {
+	if r.execution.FinishedAt == nil {
+		return nil
+	}
+	return &graphql.Time{Time: *r.execution.FinishedAt}
+}
+
+// WorkflowExecutionStepResolver resolves the WorkflowExecutionStep type.
+type WorkflowExecutionStepResolver struct {
+	step *store.WorkflowExecutionStep
+}
+
+func (r *WorkflowExecutionStepResolver) Ref() string {
+	return r.step.Ref
+}
+
+func (r *WorkflowExecutionStepResolver) Status() string {
+	return r.step.Status
+}
+
+func (r *WorkflowExecutionStepResolver) Inputs() *string {
+	if r.step.Inputs == nil {
+		return nil
+	}
+	return marshalValue(r.step.Inputs)
+}
+
+func (r *WorkflowExecutionStepResolver) Outputs() *string {
+	if r.step.Outputs.Value == nil {
+		return nil
+	}
+	return marshalValue(r.step.Outputs.Value)
+}
+
+func (r *WorkflowExecutionStepResolver) Error() *string {
+	if r.step.Outputs.Err == nil {
+		return nil
+	}
+	err := r.step.Outputs.Err.Error()
+	return &err
+}
+
+func marshalValue(v values.Value) *string {
+	unwrapped, err := v.Unwrap()
+	if err != nil {
+		msg := "error: unable to unwrap value"
+		return &msg
+	}
+	b, err := json.Marshal(unwrapped)
+	if err != nil {
+		msg := "error: unable to marshal value"
+		return &msg
+	}
+	s := string(b)
+	return &s
+}
+
+// -- WorkflowExecutions query --
+
+// WorkflowExecutionsPayloadResolver resolves a page of workflow executions
+type WorkflowExecutionsPayloadResolver struct {
+	executions []store.WorkflowExecution
+	total      int32
+}
+
+func NewWorkflowExecutionsPayload(executions []store.WorkflowExecution, total int32) *WorkflowExecutionsPayloadResolver {
+	return &WorkflowExecutionsPayloadResolver{
+		executions: executions,
+		total:      total,
+	}
+}
+
+// Results returns the workflow executions.
+func (r *WorkflowExecutionsPayloadResolver) Results() []*WorkflowExecutionResolver {
+	return NewWorkflowExecutions(r.executions)
+}
+
+// Metadata returns the pagination metadata.
+func (r *WorkflowExecutionsPayloadResolver) Metadata() *PaginationMetadataResolver {
+	return NewPaginationMetadata(r.total)
+}
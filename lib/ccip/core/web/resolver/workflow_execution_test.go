@@ -0,0 +1,128 @@
+package resolver
+
+import (
+	"context"
+	"testing"
+
+	gqlerrors "github.com/graph-gophers/graphql-go/errors"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/values"
+	"github.com/smartcontractkit/chainlink/v2/core/services/workflows/store"
+)
+
+func TestQuery_WorkflowExecutions(t *testing.T) {
+	t.Parallel()
+
+	query := `
+		query GetWorkflowExecutions($workflowID: ID!) {
+			workflowExecutions(workflowID: $workflowID) {
+				results {
+					id
+					workflowID
+					status
+					steps {
+						ref
+						status
+						inputs
+						outputs
+						error
+					}
+				}
+				metadata {
+					total
+				}
+			}
+		}`
+	variables := map[string]interface{}{
+		"workflowID": "workflow-1",
+	}
+
+	inputs, err := values.NewMap(map[string]any{"report": "0x01"})
+	require.NoError(t, err)
+	gError := errors.New("error")
+
+	testCases := []GQLTestCase{
+		unauthorizedTestCase(GQLTestCase{query: query, variables: variables}, "workflowExecutions"),
+		{
+			name:          "success",
+			authenticated: true,
+			before: func(ctx context.Context, f *gqlTestFramework) {
+				f.Mocks.workflowORM.On("GetByWorkflowID", mock.Anything, "workflow-1", PageDefaultOffset, PageDefaultLimit).Return([]store.WorkflowExecution{
+					{
+						ExecutionID: "execution-1",
+						WorkflowID:  "workflow-1",
+						Status:      store.StatusErrored,
+						Steps: map[string]*store.WorkflowExecutionStep{
+							"write": {
+								Ref:     "write",
+								Status:  store.StatusErrored,
+								Inputs:  inputs,
+								Outputs: store.StepOutput{Err: errors.New("fatal target error")},
+							},
+							"compensate:evm_median": {
+								Ref:    "compensate:evm_median",
+								Status: store.StatusCompleted,
+								Outputs: store.StepOutput{
+									Value: inputs,
+								},
+							},
+						},
+					},
+				}, 1, nil)
+				f.App.On("WorkflowORM").Return(f.Mocks.workflowORM)
+			},
+			query:     query,
+			variables: variables,
+			result: `
+				{
+					"workflowExecutions": {
+						"results": [{
+							"id": "execution-1",
+							"workflowID": "workflow-1",
+							"status": "errored",
+							"steps": [{
+								"ref": "compensate:evm_median",
+								"status": "completed",
+								"inputs": null,
+								"outputs": "{\"report\":\"0x01\"}",
+								"error": null
+							}, {
+								"ref": "write",
+								"status": "errored",
+								"inputs": "{\"report\":\"0x01\"}",
+								"outputs": null,
+								"error": "fatal target error"
+							}]
+						}],
+						"metadata": {
+							"total": 1
+						}
+					}
+				}`,
+		},
+		{
+			name:          "generic error on GetByWorkflowID()",
+			authenticated: true,
+			before: func(ctx context.Context, f *gqlTestFramework) {
+				f.Mocks.workflowORM.On("GetByWorkflowID", mock.Anything, "workflow-1", PageDefaultOffset, PageDefaultLimit).Return(nil, 0, gError)
+				f.App.On("WorkflowORM").Return(f.Mocks.workflowORM)
+			},
+			query:     query,
+			variables: variables,
+			result:    `null`,
+			errors: []*gqlerrors.QueryError{
+				{
+					Extensions:    nil,
+					ResolverError: gError,
+					Path:          []interface{}{"workflowExecutions"},
+					Message:       gError.Error(),
+				},
+			},
+		},
+	}
+
+	RunGQLTests(t, testCases)
+}
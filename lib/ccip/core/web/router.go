@@ -288,6 +288,9 @@ func v2Routes(app chainlink.Application, r *gin.RouterGroup) {
 		authv2.GET("/transactions/evm/:TxHash", txs.Show)
 		authv2.GET("/transactions", paginatedRequest(txs.Index))
 		authv2.GET("/transactions/:TxHash", txs.Show)
+		stc := StuckTransactionsController{app}
+		authv2.GET("/stuck_transactions/evm", stc.Index)
+		authv2.GET("/stuck_transactions/evm/history", stc.History)
 
 		rc := ReplayController{app}
 		authv2.POST("/replay_from_block/:number", auth.RequiresRunRole(rc.ReplayFromBlock))
@@ -370,13 +373,23 @@ func v2Routes(app chainlink.Application, r *gin.RouterGroup) {
 		authv2.GET("/jobs", paginatedRequest(jc.Index))
 		authv2.GET("/jobs/:ID", jc.Show)
 		authv2.POST("/jobs", auth.RequiresEditRole(jc.Create))
+		authv2.POST("/jobs/dry-run", auth.RequiresRunRole(jc.DryRun))
 		authv2.PUT("/jobs/:ID", auth.RequiresEditRole(jc.Update))
 		authv2.DELETE("/jobs/:ID", auth.RequiresEditRole(jc.Delete))
+		authv2.POST("/jobs/:ID/plan", auth.RequiresEditRole(jc.Plan))
+		authv2.POST("/jobs/:ID/replay", auth.RequiresEditRole(jc.Replay))
+		authv2.POST("/jobs/:ID/canary", auth.RequiresEditRole(jc.Canary))
+		authv2.GET("/jobs/:ID/canary", jc.ShowCanary)
 
 		// PipelineRunsController
 		authv2.GET("/pipeline/runs", paginatedRequest(prc.Index))
 		authv2.GET("/jobs/:ID/runs", paginatedRequest(prc.Index))
 		authv2.GET("/jobs/:ID/runs/:runID", prc.Show)
+		authv2.POST("/jobs/:ID/runs/dry-run", auth.RequiresRunRole(prc.DryRun))
+
+		// WorkflowExecutionsController
+		wec := WorkflowExecutionsController{app}
+		authv2.GET("/workflows/:ID/executions", paginatedRequest(wec.Index))
 
 		// FeaturesController
 		fc := FeaturesController{app}
@@ -0,0 +1,36 @@
+package web
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/pkg/errors"
+
+	"github.com/smartcontractkit/chainlink/v2/core/services/chainlink"
+	"github.com/smartcontractkit/chainlink/v2/core/web/presenters"
+)
+
+// WorkflowExecutionsController manages workflow execution history requests.
+type WorkflowExecutionsController struct {
+	App chainlink.Application
+}
+
+// Index returns the executions of a workflow, most recent first, with the inputs and outputs of each step.
+// Example:
+// "GET <application>/workflows/:ID/executions"
+func (wec *WorkflowExecutionsController) Index(c *gin.Context, size, page, offset int) {
+	workflowID := c.Param("ID")
+	if workflowID == "" {
+		jsonAPIError(c, http.StatusUnprocessableEntity, errors.New("missing workflow ID"))
+		return
+	}
+
+	executions, count, err := wec.App.WorkflowORM().GetByWorkflowID(c.Request.Context(), workflowID, offset, size)
+	if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	res := presenters.NewWorkflowExecutionResources(executions, wec.App.GetLogger())
+	paginatedResponse(c, "workflowExecution", size, page, res, count, err)
+}
@@ -0,0 +1,69 @@
+package web_test
+
+import (
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/values"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/cltest"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/services/workflows/store"
+	"github.com/smartcontractkit/chainlink/v2/core/web"
+	"github.com/smartcontractkit/chainlink/v2/core/web/presenters"
+)
+
+func TestWorkflowExecutionsController_Index(t *testing.T) {
+	t.Parallel()
+
+	ctx := testutils.Context(t)
+	app := cltest.NewApplication(t)
+	require.NoError(t, app.Start(ctx))
+	client := app.NewHTTPClient(nil)
+
+	workflowID := "15c631d295ef5e32deb99a10ee6804bc4af1385568f9b3363f6552ac6dbb2cef"
+	_, err := app.GetDB().ExecContext(ctx, `INSERT INTO workflow_specs (workflow_id, workflow, workflow_owner, workflow_name, created_at, updated_at)
+	VALUES ($1, '', 'owner', 'name', NOW(), NOW())`, workflowID)
+	require.NoError(t, err)
+
+	inputs, err := values.NewMap(map[string]any{"report": "0x01"})
+	require.NoError(t, err)
+	for _, id := range []string{"execution-1", "execution-2"} {
+		require.NoError(t, app.WorkflowORM().Add(ctx, &store.WorkflowExecution{
+			ExecutionID: id,
+			WorkflowID:  workflowID,
+			Status:      store.StatusErrored,
+			Steps: map[string]*store.WorkflowExecutionStep{
+				"write": {
+					ExecutionID: id,
+					Ref:         "write",
+					Status:      store.StatusErrored,
+					Inputs:      inputs,
+					Outputs:     store.StepOutput{Err: errors.New("fatal target error")},
+				},
+			},
+		}))
+	}
+
+	resp, cleanup := client.Get("/v2/workflows/" + workflowID + "/executions?size=1")
+	t.Cleanup(cleanup)
+	cltest.AssertServerResponse(t, resp, http.StatusOK)
+
+	body := cltest.ParseResponseBody(t, resp)
+	assert.Contains(t, string(body), `"meta":{"count":2}`)
+
+	var executions []presenters.WorkflowExecutionResource
+	require.NoError(t, web.ParseJSONAPIResponse(body, &executions))
+	require.Len(t, executions, 1)
+	assert.Equal(t, workflowID, executions[0].WorkflowID)
+	assert.Equal(t, store.StatusErrored, executions[0].Status)
+	require.Len(t, executions[0].Steps, 1)
+	step := executions[0].Steps[0]
+	assert.Equal(t, "write", step.Ref)
+	assert.Equal(t, map[string]any{"report": "0x01"}, step.Inputs)
+	require.NotNil(t, step.Error)
+	assert.Equal(t, "fatal target error", *step.Error)
+}
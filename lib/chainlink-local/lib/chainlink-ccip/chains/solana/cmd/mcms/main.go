@@ -0,0 +1,235 @@
+// Command mcms authors, signs and builds the instructions of mcm proposals for Solana programs.
+//
+//	mcms root -proposal proposal.yaml -mcm-program <id> -chain-id <id>
+//	mcms sign -proposal proposal.yaml -key-file signer.key -mcm-program <id> -chain-id <id> > signature.json
+//	mcms instructions -proposal proposal.yaml -authority <pubkey> -signature a.json -signature b.json -mcm-program <id> -chain-id <id>
+//
+// Proposals are YAML files following mcms.ProposalFile. Every command rebuilds the merkle tree from the proposal, so
+// signers can check the root they sign against the root printed by the proposer.
+package main
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/gagliardetto/solana-go"
+
+	"github.com/smartcontractkit/chainlink-ccip/chains/solana/utils/eth"
+	"github.com/smartcontractkit/chainlink-ccip/chains/solana/utils/mcms"
+)
+
+const usage = `usage: mcms <command> [flags]
+
+commands:
+  root          print the root, signed hash and op proofs of a proposal
+  sign          sign the root of a proposal with an EVM private key
+  instructions  verify signatures and print the preload signatures, set_root and execute instructions
+`
+
+type rootOutput struct {
+	Root          string     `json:"root"`
+	EthMsgHash    string     `json:"ethMsgHash"`
+	ValidUntil    uint32     `json:"validUntil"`
+	PreOpCount    uint64     `json:"preOpCount"`
+	PostOpCount   uint64     `json:"postOpCount"`
+	MetadataProof []string   `json:"metadataProof"`
+	Operations    []opOutput `json:"operations"`
+}
+
+type opOutput struct {
+	Nonce  uint64   `json:"nonce"`
+	To     string   `json:"to"`
+	Hash   string   `json:"hash"`
+	Proofs []string `json:"proofs"`
+}
+
+type instructionsOutput struct {
+	PreloadSignatures []mcms.ProposalInstruction `json:"preloadSignatures"`
+	SetRoot           mcms.ProposalInstruction   `json:"setRoot"`
+	Execute           []mcms.ProposalInstruction `json:"execute"`
+}
+
+type stringsFlag []string
+
+func (s *stringsFlag) String() string {
+	return strings.Join(*s, ",")
+}
+
+func (s *stringsFlag) Set(v string) error {
+	*s = append(*s, v)
+	return nil
+}
+
+func main() {
+	if err := run(os.Args[1:], os.Stdout); err != nil {
+		fmt.Fprintf(os.Stderr, "mcms: %s\n", err)
+		os.Exit(1)
+	}
+}
+
+func run(args []string, out io.Writer) error {
+	if len(args) == 0 {
+		return errors.New(usage)
+	}
+
+	flags := flag.NewFlagSet(args[0], flag.ContinueOnError)
+	proposalPath := flags.String("proposal", "", "path to the proposal YAML file")
+	chainID := flags.Uint64("chain-id", 0, "mcm chain id of the Solana network, required")
+	mcmProgram := flags.String("mcm-program", "", "mcm program id, required")
+	keyFile := flags.String("key-file", "", "sign: file holding the hex encoded EVM private key of the signer")
+	authority := flags.String("authority", "", "instructions: public key sending the instructions")
+	var signatureFiles stringsFlag
+	flags.Var(&signatureFiles, "signature", "instructions: signature file produced by sign, repeatable")
+
+	if err := flags.Parse(args[1:]); err != nil {
+		return err
+	}
+	if *chainID == 0 {
+		return errors.New("-chain-id is required")
+	}
+	if *mcmProgram == "" {
+		return errors.New("-mcm-program is required")
+	}
+	program, err := solana.PublicKeyFromBase58(*mcmProgram)
+	if err != nil {
+		return fmt.Errorf("invalid -mcm-program %q: %w", *mcmProgram, err)
+	}
+	proposal, err := readProposal(*proposalPath, *chainID, program)
+	if err != nil {
+		return err
+	}
+
+	switch args[0] {
+	case "root":
+		return printRoot(proposal, out)
+	case "sign":
+		return sign(proposal, *keyFile, out)
+	case "instructions":
+		return printInstructions(proposal, *authority, signatureFiles, out)
+	default:
+		return fmt.Errorf("unknown command %q\n%s", args[0], usage)
+	}
+}
+
+func readProposal(path string, chainID uint64, mcmProgram solana.PublicKey) (mcms.Proposal, error) {
+	if path == "" {
+		return mcms.Proposal{}, errors.New("-proposal is required")
+	}
+	f, err := os.Open(path)
+	if err != nil {
+		return mcms.Proposal{}, err
+	}
+	defer f.Close()
+	return mcms.ParseProposal(f, chainID, mcmProgram)
+}
+
+func printRoot(proposal mcms.Proposal, out io.Writer) error {
+	rootData, err := proposal.RootData()
+	if err != nil {
+		return err
+	}
+	output := rootOutput{
+		Root:        hex.EncodeToString(rootData.Root[:]),
+		EthMsgHash:  hex.EncodeToString(rootData.EthMsgHash),
+		ValidUntil:  proposal.ValidUntil,
+		PreOpCount:  rootData.Metadata.PreOpCount,
+		PostOpCount: rootData.Metadata.PostOpCount,
+	}
+	for _, proof := range rootData.MetadataProof {
+		output.MetadataProof = append(output.MetadataProof, hex.EncodeToString(proof[:]))
+	}
+	for _, op := range proposal.Operations {
+		proofs, proofsErr := op.Proofs()
+		if proofsErr != nil {
+			return proofsErr
+		}
+		hash := op.Hash()
+		opOut := opOutput{Nonce: op.Nonce, To: op.To.String(), Hash: hex.EncodeToString(hash[:])}
+		for _, proof := range proofs {
+			opOut.Proofs = append(opOut.Proofs, hex.EncodeToString(proof[:]))
+		}
+		output.Operations = append(output.Operations, opOut)
+	}
+	return writeJSON(out, output)
+}
+
+func sign(proposal mcms.Proposal, keyFile string, out io.Writer) error {
+	if keyFile == "" {
+		return errors.New("-key-file is required")
+	}
+	key, err := os.ReadFile(keyFile)
+	if err != nil {
+		return err
+	}
+	signer, err := eth.GetSignerFromPk(strings.TrimPrefix(strings.TrimSpace(string(key)), "0x"))
+	if err != nil {
+		return err
+	}
+	signature, err := proposal.Sign(signer)
+	if err != nil {
+		return err
+	}
+	return writeJSON(out, signature)
+}
+
+func printInstructions(proposal mcms.Proposal, authority string, signatureFiles []string, out io.Writer) error {
+	authorityKey, err := solana.PublicKeyFromBase58(authority)
+	if err != nil {
+		return fmt.Errorf("invalid -authority %q: %w", authority, err)
+	}
+	if len(signatureFiles) == 0 {
+		return errors.New("at least one -signature is required")
+	}
+	signatures := make([]mcms.ProposalSignature, 0, len(signatureFiles))
+	for _, path := range signatureFiles {
+		data, readErr := os.ReadFile(path)
+		if readErr != nil {
+			return readErr
+		}
+		var signature mcms.ProposalSignature
+		if err = json.Unmarshal(data, &signature); err != nil {
+			return fmt.Errorf("invalid signature file %s: %w", path, err)
+		}
+		signatures = append(signatures, signature)
+	}
+
+	ixs, err := proposal.Instructions(signatures, authorityKey)
+	if err != nil {
+		return err
+	}
+	var output instructionsOutput
+	if output.PreloadSignatures, err = encodeInstructions(ixs.PreloadSignatures); err != nil {
+		return err
+	}
+	if output.SetRoot, err = mcms.NewProposalInstruction(ixs.SetRoot); err != nil {
+		return err
+	}
+	if output.Execute, err = encodeInstructions(ixs.Execute); err != nil {
+		return err
+	}
+	return writeJSON(out, output)
+}
+
+func encodeInstructions(ixs []solana.Instruction) ([]mcms.ProposalInstruction, error) {
+	encoded := make([]mcms.ProposalInstruction, 0, len(ixs))
+	for _, ix := range ixs {
+		instruction, err := mcms.NewProposalInstruction(ix)
+		if err != nil {
+			return nil, err
+		}
+		encoded = append(encoded, instruction)
+	}
+	return encoded, nil
+}
+
+func writeJSON(out io.Writer, v any) error {
+	encoder := json.NewEncoder(out)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(v)
+}
@@ -9,4 +9,7 @@ do
   anchor-go -src "${idl_path_str}" -dst ./gobindings/"${idl_name}" -codec borsh
 done
 
+# events are not supported by anchor-go, the event and error registry is generated from the IDLs
+go generate ./utils/events
+
 go fmt ./...
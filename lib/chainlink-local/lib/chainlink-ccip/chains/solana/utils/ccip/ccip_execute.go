@@ -0,0 +1,502 @@
+package ccip
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+
+	bin "github.com/gagliardetto/binary"
+	"github.com/gagliardetto/solana-go"
+	"github.com/gagliardetto/solana-go/rpc"
+
+	"github.com/smartcontractkit/chainlink-ccip/chains/solana/gobindings/ccip_common"
+	"github.com/smartcontractkit/chainlink-ccip/chains/solana/gobindings/ccip_offramp"
+	"github.com/smartcontractkit/chainlink-ccip/chains/solana/utils/common"
+	"github.com/smartcontractkit/chainlink-ccip/chains/solana/utils/state"
+	"github.com/smartcontractkit/chainlink-ccip/chains/solana/utils/tokens"
+)
+
+// MaxSolanaTxSize is the maximum size of a serialized transaction accepted by the network
+const MaxSolanaTxSize = 1232
+
+// MaxLookupTableAddresses is the maximum number of addresses a lookup table can hold
+const MaxLookupTableAddresses = 256
+
+// ExtendLookupTableChunkSize is the number of addresses added by a single extend transaction, so that it stays under MaxSolanaTxSize
+const ExtendLookupTableChunkSize = 20
+
+// lookupTableCost is the size of a lookup in a v0 message, excluding the indexes: the table address and two length prefixes
+const lookupTableCost = solana.PublicKeyLength + 2
+
+var ErrExecuteTxTooLarge = errors.New("execute transaction exceeds the max transaction size")
+
+// ExecuteMessage is an Any2SVM message ready to be executed, along with what is needed to build the execute transaction
+type ExecuteMessage struct {
+	Report        ccip_offramp.ExecutionReportSingleChain
+	ReportContext [2][32]byte
+	// Root is the merkle root of the commit report that contains the message
+	Root [32]byte
+	// LogicReceiver is the program receiving the message data, left empty for token only messages
+	LogicReceiver solana.PublicKey
+	// ReceiverAccounts are the accounts passed to the LogicReceiver, their writability is set by the IsWritableBitmap of the message
+	ReceiverAccounts solana.PublicKeySlice
+}
+
+// ExecuteTokenPool are the accounts of the pool releasing or minting a token on execution, as registered in the TokenAdminRegistry
+type ExecuteTokenPool struct {
+	Mint         solana.PublicKey
+	TokenProgram solana.PublicKey
+	PoolProgram  solana.PublicKey
+	LookupTable  solana.PublicKey
+	// LookupTableEntries are the entries of the pool lookup table, with writability from the registry WritableIndexes
+	LookupTableEntries solana.AccountMetaSlice
+}
+
+// ExecuteTransactions are the transactions needed to execute a message, in the order they must be sent. They all share
+// the same blockhash, they must be rebuilt if it expires before Execute is sent.
+type ExecuteTransactions struct {
+	// ExtendLookupTables add the accounts of Execute missing from every lookup table to the extended table. The new
+	// entries are only usable from the slot after the one they land in: wait for common.AwaitLookupTableActivation
+	// before sending Execute
+	ExtendLookupTables []*solana.Transaction
+	Execute            *solana.Transaction
+	// LookupTables are the lookup tables used by Execute, including the entries added by ExtendLookupTables
+	LookupTables map[solana.PublicKey]solana.PublicKeySlice
+	// Sizes reports the size of each transaction, ExtendLookupTables first
+	Sizes []TxSize
+}
+
+// TxSize is the size breakdown of a transaction
+type TxSize struct {
+	Name string
+	// Size is the serialized size of the signed transaction
+	Size int
+	// StaticAccounts is the number of accounts stored in full in the message, LookupAccounts the ones resolved from LookupTables
+	StaticAccounts int
+	LookupAccounts int
+	LookupTables   int
+}
+
+func (s TxSize) String() string {
+	return fmt.Sprintf("%s: %d/%d bytes, %d static accounts, %d accounts from %d lookup tables", s.Name, s.Size, MaxSolanaTxSize, s.StaticAccounts, s.LookupAccounts, s.LookupTables)
+}
+
+// ExecuteTxBuilder builds the transactions executing a message on the offramp, resolving all accounts and managing the
+// lookup tables needed to fit the execute transaction under MaxSolanaTxSize
+type ExecuteTxBuilder struct {
+	Client      *rpc.Client
+	Commitment  rpc.CommitmentType
+	Offramp     solana.PublicKey
+	Transmitter solana.PublicKey
+	// LookupTables are lookup tables available in addition to the offramp and pool tables, which are always considered
+	LookupTables solana.PublicKeySlice
+	// ExtendLookupTable, when set, is a lookup table owned by the Transmitter that is extended with the accounts that
+	// are missing from every table when the execute transaction does not fit otherwise
+	ExtendLookupTable solana.PublicKey
+	Modifiers         []common.TxModifier
+}
+
+// Build resolves the accounts of the message and returns the transactions to sign and send to execute it. Messages that
+// don't fit in a transaction even with lookup tables, like messages with a large report, fail with ErrExecuteTxTooLarge.
+func (b ExecuteTxBuilder) Build(ctx context.Context, msg ExecuteMessage) (ExecuteTransactions, error) {
+	referenceAddressesPDA, _, err := state.FindOfframpReferenceAddressesPDA(b.Offramp)
+	if err != nil {
+		return ExecuteTransactions{}, err
+	}
+	var referenceAddresses ccip_offramp.ReferenceAddresses
+	if err = common.GetAccountDataBorshInto(ctx, b.Client, referenceAddressesPDA, b.Commitment, &referenceAddresses); err != nil {
+		return ExecuteTransactions{}, fmt.Errorf("failed to get offramp reference addresses: %w", err)
+	}
+
+	pools := make([]ExecuteTokenPool, 0, len(msg.Report.Message.TokenAmounts))
+	for _, tokenAmount := range msg.Report.Message.TokenAmounts {
+		pool, poolErr := GetExecuteTokenPool(ctx, b.Client, b.Commitment, referenceAddresses.Router, tokenAmount.DestTokenAddress)
+		if poolErr != nil {
+			return ExecuteTransactions{}, poolErr
+		}
+		pools = append(pools, pool)
+	}
+
+	ix, err := NewExecuteInstruction(msg, b.Offramp, b.Transmitter, referenceAddresses, pools)
+	if err != nil {
+		return ExecuteTransactions{}, err
+	}
+
+	tables := make(map[solana.PublicKey]solana.PublicKeySlice)
+	tableKeys := append(solana.PublicKeySlice{referenceAddresses.OfframpLookupTable}, b.LookupTables...)
+	for _, pool := range pools {
+		tableKeys = append(tableKeys, pool.LookupTable)
+	}
+	if !b.ExtendLookupTable.IsZero() {
+		tableKeys = append(tableKeys, b.ExtendLookupTable)
+	}
+	for _, key := range tableKeys {
+		if key.IsZero() {
+			continue
+		}
+		if _, ok := tables[key]; ok {
+			continue
+		}
+		entries, tableErr := common.GetAddressLookupTable(ctx, b.Client, key)
+		if tableErr != nil {
+			return ExecuteTransactions{}, fmt.Errorf("failed to get lookup table %s: %w", key, tableErr)
+		}
+		tables[key] = entries
+	}
+
+	hashRes, err := b.Client.GetLatestBlockhash(ctx, rpc.CommitmentFinalized)
+	if err != nil {
+		return ExecuteTransactions{}, err
+	}
+	return PlanExecuteTransactions(ix, b.Transmitter, hashRes.Value.Blockhash, tables, b.ExtendLookupTable, b.Modifiers...)
+}
+
+// GetExecuteTokenPool reads the pool of a mint from its TokenAdminRegistry and the pool lookup table
+func GetExecuteTokenPool(ctx context.Context, client *rpc.Client, commitment rpc.CommitmentType, router, mint solana.PublicKey) (ExecuteTokenPool, error) {
+	registryPDA, _, err := state.FindTokenAdminRegistryPDA(mint, router)
+	if err != nil {
+		return ExecuteTokenPool{}, err
+	}
+	var registry ccip_common.TokenAdminRegistry
+	if err = common.GetAccountDataBorshInto(ctx, client, registryPDA, commitment, &registry); err != nil {
+		return ExecuteTokenPool{}, fmt.Errorf("failed to get token admin registry of %s: %w", mint, err)
+	}
+	entries, err := common.GetAddressLookupTable(ctx, client, registry.LookupTable)
+	if err != nil {
+		return ExecuteTokenPool{}, fmt.Errorf("failed to get pool lookup table of %s: %w", mint, err)
+	}
+	return NewExecuteTokenPool(mint, registry, entries)
+}
+
+// NewExecuteTokenPool builds the pool accounts from the TokenAdminRegistry of the mint and the entries of its lookup table,
+// which follow the order of tokens.TokenPool.ToTokenPoolEntries
+func NewExecuteTokenPool(mint solana.PublicKey, registry ccip_common.TokenAdminRegistry, entries solana.PublicKeySlice) (ExecuteTokenPool, error) {
+	// lookup table, token admin registry, pool program, pool config, pool token account, pool signer, token program, ...
+	if len(entries) < 7 {
+		return ExecuteTokenPool{}, fmt.Errorf("pool lookup table of %s has %d entries, expected at least 7", mint, len(entries))
+	}
+
+	writableBytes := append(registry.WritableIndexes[0].Bytes(), registry.WritableIndexes[1].Bytes()...)
+	metas := make(solana.AccountMetaSlice, 0, len(entries))
+	for i, entry := range entries {
+		meta := solana.Meta(entry)
+		// indexes are read most significant bit first
+		if i < len(writableBytes)*8 && writableBytes[i/8]&(0x80>>(i%8)) != 0 {
+			meta = meta.WRITE()
+		}
+		metas = append(metas, meta)
+	}
+
+	return ExecuteTokenPool{
+		Mint:               mint,
+		TokenProgram:       entries[6],
+		PoolProgram:        entries[2],
+		LookupTable:        registry.LookupTable,
+		LookupTableEntries: metas,
+	}, nil
+}
+
+// NewExecuteInstruction builds the offramp execute instruction of a message, with the receiver accounts first and then the
+// accounts of each token pool, in the order of the token transfers of the message
+func NewExecuteInstruction(msg ExecuteMessage, offramp, transmitter solana.PublicKey, referenceAddresses ccip_offramp.ReferenceAddresses, pools []ExecuteTokenPool) (solana.Instruction, error) {
+	report := msg.Report
+	sourceChainSelector := report.SourceChainSelector
+	if len(pools) != len(report.Message.TokenAmounts) {
+		return nil, fmt.Errorf("got %d token pools for %d token transfers", len(pools), len(report.Message.TokenAmounts))
+	}
+	if len(report.OffchainTokenData) != len(report.Message.TokenAmounts) {
+		return nil, fmt.Errorf("got %d offchain token data for %d token transfers", len(report.OffchainTokenData), len(report.Message.TokenAmounts))
+	}
+
+	remainingAccounts, tokenIndexes, err := executeRemainingAccounts(msg, offramp, referenceAddresses.FeeQuoter, pools)
+	if err != nil {
+		return nil, err
+	}
+
+	rawReport, err := bin.MarshalBorsh(report)
+	if err != nil {
+		return nil, err
+	}
+	configPDA, _, err := state.FindOfframpConfigPDA(offramp)
+	if err != nil {
+		return nil, err
+	}
+	referenceAddressesPDA, _, err := state.FindOfframpReferenceAddressesPDA(offramp)
+	if err != nil {
+		return nil, err
+	}
+	sourceChainPDA, _, err := state.FindOfframpSourceChainPDA(sourceChainSelector, offramp)
+	if err != nil {
+		return nil, err
+	}
+	commitReportPDA, err := state.FindOfframpCommitReportPDA(sourceChainSelector, msg.Root, offramp)
+	if err != nil {
+		return nil, err
+	}
+	allowedOfframpPDA, err := state.FindAllowedOfframpPDA(sourceChainSelector, offramp, referenceAddresses.Router)
+	if err != nil {
+		return nil, err
+	}
+	rmnRemoteCursesPDA, _, err := state.FindRMNRemoteCursesPDA(referenceAddresses.RmnRemote)
+	if err != nil {
+		return nil, err
+	}
+	rmnRemoteConfigPDA, _, err := state.FindRMNRemoteConfigPDA(referenceAddresses.RmnRemote)
+	if err != nil {
+		return nil, err
+	}
+
+	raw := ccip_offramp.NewExecuteInstruction(
+		rawReport,
+		msg.ReportContext,
+		tokenIndexes,
+		configPDA,
+		referenceAddressesPDA,
+		sourceChainPDA,
+		commitReportPDA,
+		offramp,
+		allowedOfframpPDA,
+		transmitter,
+		solana.SystemProgramID,
+		solana.SysVarInstructionsPubkey,
+		referenceAddresses.RmnRemote,
+		rmnRemoteCursesPDA,
+		rmnRemoteConfigPDA,
+	)
+	raw.AccountMetaSlice = append(raw.AccountMetaSlice, remainingAccounts...)
+	return raw.ValidateAndBuild()
+}
+
+func executeRemainingAccounts(msg ExecuteMessage, offramp, feeQuoter solana.PublicKey, pools []ExecuteTokenPool) (solana.AccountMetaSlice, []byte, error) {
+	sourceChainSelector := msg.Report.SourceChainSelector
+	var accounts solana.AccountMetaSlice
+
+	if !msg.LogicReceiver.IsZero() {
+		externalExecutionConfigPDA, _, err := state.FindExternalExecutionConfigPDA(msg.LogicReceiver, offramp)
+		if err != nil {
+			return nil, nil, err
+		}
+		accounts = append(accounts, solana.Meta(msg.LogicReceiver), solana.Meta(externalExecutionConfigPDA))
+		bitmap := msg.Report.Message.ExtraArgs.IsWritableBitmap
+		for i, account := range msg.ReceiverAccounts {
+			meta := solana.Meta(account)
+			if i < 64 && bitmap&(1<<i) != 0 {
+				meta = meta.WRITE()
+			}
+			accounts = append(accounts, meta)
+		}
+	} else if len(msg.ReceiverAccounts) > 0 {
+		return nil, nil, errors.New("receiver accounts require a logic receiver")
+	}
+
+	tokenIndexes := make([]byte, 0, len(pools))
+	for _, pool := range pools {
+		if len(accounts) > 255 {
+			return nil, nil, fmt.Errorf("token accounts of %s start at index %d, max is 255", pool.Mint, len(accounts))
+		}
+		tokenIndexes = append(tokenIndexes, byte(len(accounts)))
+
+		offrampPoolSigner, _, err := state.FindExternalTokenPoolsSignerPDA(pool.PoolProgram, offramp)
+		if err != nil {
+			return nil, nil, err
+		}
+		receiverTokenAccount, _, err := tokens.FindAssociatedTokenAddress(pool.TokenProgram, pool.Mint, msg.Report.Message.TokenReceiver)
+		if err != nil {
+			return nil, nil, err
+		}
+		tokenBillingConfig, _, err := state.FindFqPerChainPerTokenConfigPDA(sourceChainSelector, pool.Mint, feeQuoter)
+		if err != nil {
+			return nil, nil, err
+		}
+		poolChainConfig, _, err := tokens.TokenPoolChainConfigPDA(sourceChainSelector, pool.Mint, pool.PoolProgram)
+		if err != nil {
+			return nil, nil, err
+		}
+		accounts = append(accounts,
+			solana.Meta(offrampPoolSigner),
+			solana.Meta(receiverTokenAccount).WRITE(),
+			solana.Meta(tokenBillingConfig),
+			solana.Meta(poolChainConfig).WRITE(),
+		)
+		accounts = append(accounts, pool.LookupTableEntries...)
+	}
+	return accounts, tokenIndexes, nil
+}
+
+// PlanExecuteTransactions picks the lookup tables that shrink the execute transaction the most. When it still exceeds
+// MaxSolanaTxSize and extendTable is set, the accounts missing from every table are added to extendTable by extend
+// transactions of ExtendLookupTableChunkSize accounts each. extendTable must be one of tables, with its current entries.
+// The returned transactions are not signed, the payer is their only signer.
+func PlanExecuteTransactions(ix solana.Instruction, payer solana.PublicKey, blockhash solana.Hash, tables map[solana.PublicKey]solana.PublicKeySlice, extendTable solana.PublicKey, modifiers ...common.TxModifier) (ExecuteTransactions, error) {
+	accounts := lookupCandidates(ix)
+	selected := selectLookupTables(accounts, tables)
+
+	execute, size, err := newSizedTx("execute", []solana.Instruction{ix}, payer, blockhash, selected, modifiers)
+	if err != nil {
+		return ExecuteTransactions{}, err
+	}
+	if size.Size <= MaxSolanaTxSize || extendTable.IsZero() {
+		txs := ExecuteTransactions{Execute: execute, LookupTables: selected, Sizes: []TxSize{size}}
+		if size.Size > MaxSolanaTxSize {
+			return txs, fmt.Errorf("%w: %s", ErrExecuteTxTooLarge, size)
+		}
+		return txs, nil
+	}
+
+	existing, ok := tables[extendTable]
+	if !ok {
+		return ExecuteTransactions{}, fmt.Errorf("lookup table %s to extend is not in the lookup tables", extendTable)
+	}
+	covered := make(map[solana.PublicKey]struct{})
+	for _, entries := range selected {
+		for _, entry := range entries {
+			covered[entry] = struct{}{}
+		}
+	}
+	for _, entry := range existing {
+		covered[entry] = struct{}{}
+	}
+	var missing solana.PublicKeySlice
+	for _, account := range accounts {
+		if _, ok := covered[account]; !ok {
+			missing = append(missing, account)
+		}
+	}
+	if len(existing)+len(missing) > MaxLookupTableAddresses {
+		return ExecuteTransactions{}, fmt.Errorf("lookup table %s has %d entries, %d more exceed the max of %d", extendTable, len(existing), len(missing), MaxLookupTableAddresses)
+	}
+
+	var txs ExecuteTransactions
+	chunks := (len(missing) + ExtendLookupTableChunkSize - 1) / ExtendLookupTableChunkSize
+	for i := 0; i < len(missing); i += ExtendLookupTableChunkSize {
+		end := i + ExtendLookupTableChunkSize
+		if end > len(missing) {
+			end = len(missing)
+		}
+		extendIx := common.NewExtendLookupTableInstruction(extendTable, payer, payer, missing[i:end])
+		name := fmt.Sprintf("extendLookupTable %d/%d", i/ExtendLookupTableChunkSize+1, chunks)
+		tx, extendSize, extendErr := newSizedTx(name, []solana.Instruction{extendIx}, payer, blockhash, nil, modifiers)
+		if extendErr != nil {
+			return ExecuteTransactions{}, extendErr
+		}
+		if extendSize.Size > MaxSolanaTxSize {
+			return ExecuteTransactions{}, fmt.Errorf("lookup table extension exceeds the max transaction size: %s", extendSize)
+		}
+		txs.ExtendLookupTables = append(txs.ExtendLookupTables, tx)
+		txs.Sizes = append(txs.Sizes, extendSize)
+	}
+
+	extended := make(solana.PublicKeySlice, 0, len(existing)+len(missing))
+	extended = append(extended, existing...)
+	extended = append(extended, missing...)
+	txs.LookupTables = make(map[solana.PublicKey]solana.PublicKeySlice, len(selected)+1)
+	for key, entries := range selected {
+		txs.LookupTables[key] = entries
+	}
+	txs.LookupTables[extendTable] = extended
+
+	txs.Execute, size, err = newSizedTx("execute", []solana.Instruction{ix}, payer, blockhash, txs.LookupTables, modifiers)
+	if err != nil {
+		return ExecuteTransactions{}, err
+	}
+	txs.Sizes = append(txs.Sizes, size)
+	if size.Size > MaxSolanaTxSize {
+		return txs, fmt.Errorf("%w: %s", ErrExecuteTxTooLarge, size)
+	}
+	return txs, nil
+}
+
+// lookupCandidates are the accounts of the instruction that can be loaded from a lookup table: signers and invoked
+// programs must be static accounts
+func lookupCandidates(ix solana.Instruction) solana.PublicKeySlice {
+	var accounts solana.PublicKeySlice
+	for _, meta := range ix.Accounts() {
+		if meta.IsSigner || meta.PublicKey.Equals(ix.ProgramID()) {
+			continue
+		}
+		accounts.UniqueAppend(meta.PublicKey)
+	}
+	return accounts
+}
+
+// selectLookupTables greedily picks the tables covering the most accounts not covered yet, while using a table saves
+// more bytes than it costs
+func selectLookupTables(accounts solana.PublicKeySlice, tables map[solana.PublicKey]solana.PublicKeySlice) map[solana.PublicKey]solana.PublicKeySlice {
+	keys := make(solana.PublicKeySlice, 0, len(tables))
+	for key := range tables {
+		keys = append(keys, key)
+	}
+	// deterministic tie breaking
+	sort.Slice(keys, func(i, j int) bool { return keys[i].String() < keys[j].String() })
+
+	uncovered := make(map[solana.PublicKey]struct{}, len(accounts))
+	for _, account := range accounts {
+		uncovered[account] = struct{}{}
+	}
+
+	selected := make(map[solana.PublicKey]solana.PublicKeySlice)
+	for {
+		var best solana.PublicKey
+		bestCount := 0
+		for _, key := range keys {
+			if _, ok := selected[key]; ok {
+				continue
+			}
+			count := 0
+			for _, entry := range uniqueEntries(tables[key]) {
+				if _, ok := uncovered[entry]; ok {
+					count++
+				}
+			}
+			if count > bestCount {
+				best, bestCount = key, count
+			}
+		}
+		// each account from a table takes an index byte instead of a full address
+		if bestCount*(solana.PublicKeyLength-1) <= lookupTableCost {
+			return selected
+		}
+		selected[best] = tables[best]
+		for _, entry := range tables[best] {
+			delete(uncovered, entry)
+		}
+	}
+}
+
+func uniqueEntries(entries solana.PublicKeySlice) solana.PublicKeySlice {
+	var unique solana.PublicKeySlice
+	for _, entry := range entries {
+		unique.UniqueAppend(entry)
+	}
+	return unique
+}
+
+func newSizedTx(name string, ixs []solana.Instruction, payer solana.PublicKey, blockhash solana.Hash, tables map[solana.PublicKey]solana.PublicKeySlice, modifiers []common.TxModifier) (*solana.Transaction, TxSize, error) {
+	tx, err := solana.NewTransaction(ixs, blockhash, solana.TransactionPayer(payer), solana.TransactionAddressTables(tables))
+	if err != nil {
+		return nil, TxSize{}, err
+	}
+	for _, modifier := range modifiers {
+		if err = modifier(tx, map[solana.PublicKey]solana.PrivateKey{}); err != nil {
+			return nil, TxSize{}, err
+		}
+	}
+
+	message, err := tx.Message.MarshalBinary()
+	if err != nil {
+		return nil, TxSize{}, err
+	}
+	// signatures are prefixed by their compact-u16 count, a single byte below 128 signatures
+	signatures := int(tx.Message.Header.NumRequiredSignatures)
+	size := TxSize{
+		Name:           name,
+		Size:           1 + signatures*64 + len(message),
+		StaticAccounts: len(tx.Message.AccountKeys),
+		LookupTables:   len(tx.Message.AddressTableLookups),
+	}
+	for _, lookup := range tx.Message.AddressTableLookups {
+		size.LookupAccounts += len(lookup.WritableIndexes) + len(lookup.ReadonlyIndexes)
+	}
+	return tx, size, nil
+}
@@ -0,0 +1,202 @@
+package ccip
+
+import (
+	"testing"
+
+	bin "github.com/gagliardetto/binary"
+	"github.com/gagliardetto/solana-go"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink-ccip/chains/solana/gobindings/ccip_common"
+	"github.com/smartcontractkit/chainlink-ccip/chains/solana/gobindings/ccip_offramp"
+	"github.com/smartcontractkit/chainlink-ccip/chains/solana/utils/state"
+)
+
+func randomPubkeys(t *testing.T, n int) solana.PublicKeySlice {
+	keys := make(solana.PublicKeySlice, n)
+	for i := range keys {
+		k, err := solana.NewRandomPrivateKey()
+		require.NoError(t, err)
+		keys[i] = k.PublicKey()
+	}
+	return keys
+}
+
+func TestExecuteTxBuilder(t *testing.T) {
+	t.Parallel()
+
+	offramp, transmitter := randomPubkeys(t, 1)[0], randomPubkeys(t, 1)[0]
+	referenceAddresses := ccip_offramp.ReferenceAddresses{
+		Router:             randomPubkeys(t, 1)[0],
+		FeeQuoter:          randomPubkeys(t, 1)[0],
+		OfframpLookupTable: randomPubkeys(t, 1)[0],
+		RmnRemote:          randomPubkeys(t, 1)[0],
+	}
+
+	newPool := func(t *testing.T) ExecuteTokenPool {
+		entries := randomPubkeys(t, 9)
+		// pool config, pool token account and mint are writable
+		writable := bin.Uint128{Hi: 0b0001_1001 << 56}
+		pool, err := NewExecuteTokenPool(entries[7], ccip_common.TokenAdminRegistry{
+			LookupTable:     entries[0],
+			WritableIndexes: [2]bin.Uint128{writable, {}},
+		}, entries)
+		require.NoError(t, err)
+		return pool
+	}
+
+	newMessage := func(t *testing.T, pools ...ExecuteTokenPool) ExecuteMessage {
+		msg := ExecuteMessage{
+			Report: ccip_offramp.ExecutionReportSingleChain{
+				SourceChainSelector: 1,
+				Message: ccip_offramp.Any2SVMRampMessage{
+					Header:        ccip_offramp.RampMessageHeader{SourceChainSelector: 1, DestChainSelector: 2, SequenceNumber: 3},
+					Sender:        make([]byte, 20),
+					TokenReceiver: randomPubkeys(t, 1)[0],
+					ExtraArgs:     ccip_offramp.Any2SVMRampExtraArgs{IsWritableBitmap: GenerateBitMapForIndexes([]int{1})},
+				},
+				OffchainTokenData: [][]byte{},
+				Proofs:            [][32]uint8{},
+			},
+			LogicReceiver:    randomPubkeys(t, 1)[0],
+			ReceiverAccounts: randomPubkeys(t, 3),
+		}
+		for _, pool := range pools {
+			msg.Report.Message.TokenAmounts = append(msg.Report.Message.TokenAmounts, ccip_offramp.Any2SVMTokenTransfer{
+				SourcePoolAddress: make([]byte, 20),
+				DestTokenAddress:  pool.Mint,
+				ExtraData:         []byte{},
+			})
+			msg.Report.OffchainTokenData = append(msg.Report.OffchainTokenData, []byte{})
+		}
+		return msg
+	}
+
+	t.Run("pool writable indexes", func(t *testing.T) {
+		t.Parallel()
+		pool := newPool(t)
+		require.Equal(t, pool.LookupTableEntries[2].PublicKey, pool.PoolProgram)
+		require.Equal(t, pool.LookupTableEntries[6].PublicKey, pool.TokenProgram)
+		for i, meta := range pool.LookupTableEntries {
+			require.Equal(t, i == 3 || i == 4 || i == 7, meta.IsWritable, "entry %d", i)
+		}
+	})
+
+	t.Run("remaining accounts", func(t *testing.T) {
+		t.Parallel()
+		pools := []ExecuteTokenPool{newPool(t), newPool(t)}
+		msg := newMessage(t, pools...)
+
+		accounts, tokenIndexes, err := executeRemainingAccounts(msg, offramp, referenceAddresses.FeeQuoter, pools)
+		require.NoError(t, err)
+		// logic receiver, external execution config and 3 receiver accounts, then 4 + 9 accounts per token
+		require.Equal(t, []byte{5, 18}, tokenIndexes)
+		require.Len(t, accounts, 31)
+
+		externalExecutionConfig, _, err := state.FindExternalExecutionConfigPDA(msg.LogicReceiver, offramp)
+		require.NoError(t, err)
+		require.Equal(t, msg.LogicReceiver, accounts[0].PublicKey)
+		require.Equal(t, externalExecutionConfig, accounts[1].PublicKey)
+		require.False(t, accounts[2].IsWritable)
+		require.True(t, accounts[3].IsWritable)
+		require.False(t, accounts[4].IsWritable)
+
+		for i, pool := range pools {
+			start := int(tokenIndexes[i])
+			signer, _, err := state.FindExternalTokenPoolsSignerPDA(pool.PoolProgram, offramp)
+			require.NoError(t, err)
+			require.Equal(t, signer, accounts[start].PublicKey)
+			require.True(t, accounts[start+1].IsWritable)
+			require.True(t, accounts[start+3].IsWritable)
+			require.Equal(t, pool.LookupTableEntries, accounts[start+4:start+13])
+		}
+
+		_, _, err = executeRemainingAccounts(ExecuteMessage{ReceiverAccounts: randomPubkeys(t, 1)}, offramp, referenceAddresses.FeeQuoter, nil)
+		require.Error(t, err)
+	})
+
+	t.Run("lookup tables", func(t *testing.T) {
+		t.Parallel()
+		pools := []ExecuteTokenPool{newPool(t), newPool(t)}
+		msg := newMessage(t, pools...)
+		ix, err := NewExecuteInstruction(msg, offramp, transmitter, referenceAddresses, pools)
+		require.NoError(t, err)
+
+		// without tables, the instruction is too large
+		_, err = PlanExecuteTransactions(ix, transmitter, solana.Hash{1}, nil, solana.PublicKey{})
+		require.ErrorIs(t, err, ErrExecuteTxTooLarge)
+
+		tables := map[solana.PublicKey]solana.PublicKeySlice{
+			// unrelated tables are not used
+			randomPubkeys(t, 1)[0]: randomPubkeys(t, 10),
+		}
+		for _, pool := range pools {
+			tables[pool.LookupTable] = pool.LookupTableEntries.GetKeys()
+		}
+
+		// the pool tables are not enough, the rest of the accounts is added to a new table
+		extendTable := randomPubkeys(t, 1)[0]
+		tables[extendTable] = solana.PublicKeySlice{}
+		txs, err := PlanExecuteTransactions(ix, transmitter, solana.Hash{1}, tables, extendTable)
+		require.NoError(t, err)
+		require.Len(t, txs.LookupTables, 3)
+		// 10 fixed accounts and 13 remaining accounts are not in the pool tables
+		require.Len(t, txs.ExtendLookupTables, 2)
+		require.Len(t, txs.LookupTables[extendTable], 23)
+		require.Len(t, txs.Sizes, 3)
+		for _, size := range txs.Sizes {
+			require.LessOrEqual(t, size.Size, MaxSolanaTxSize, size.String())
+		}
+		// static accounts are the transmitter and the offramp program
+		require.Equal(t, 2, txs.Sizes[2].StaticAccounts)
+		require.Equal(t, 3, txs.Sizes[2].LookupTables)
+
+		// once extended, the table is used as is
+		tables[extendTable] = txs.LookupTables[extendTable]
+		txs, err = PlanExecuteTransactions(ix, transmitter, solana.Hash{1}, tables, extendTable)
+		require.NoError(t, err)
+		require.Empty(t, txs.ExtendLookupTables)
+		require.Len(t, txs.Sizes, 1)
+		require.LessOrEqual(t, txs.Sizes[0].Size, MaxSolanaTxSize)
+
+		// the serialized size matches the report
+		_, err = txs.Execute.Sign(func(_ solana.PublicKey) *solana.PrivateKey {
+			k, _ := solana.NewRandomPrivateKey()
+			return &k
+		})
+		require.NoError(t, err)
+		bz, err := txs.Execute.MarshalBinary()
+		require.NoError(t, err)
+		require.Len(t, bz, txs.Sizes[0].Size)
+	})
+
+	t.Run("oversized report", func(t *testing.T) {
+		t.Parallel()
+		msg := newMessage(t)
+		msg.Report.Message.Data = make([]byte, 3000)
+		ix, err := NewExecuteInstruction(msg, offramp, transmitter, referenceAddresses, nil)
+		require.NoError(t, err)
+		extendTable := randomPubkeys(t, 1)[0]
+
+		// lookup tables can't make up for the report alone exceeding the max transaction size
+		_, err = PlanExecuteTransactions(ix, transmitter, solana.Hash{1}, map[solana.PublicKey]solana.PublicKeySlice{extendTable: {}}, extendTable)
+		require.ErrorIs(t, err, ErrExecuteTxTooLarge)
+	})
+
+	t.Run("extension chunks", func(t *testing.T) {
+		t.Parallel()
+		accounts := randomPubkeys(t, 2*ExtendLookupTableChunkSize+5)
+		ix := solana.NewInstruction(offramp, solana.AccountMetaSlice{solana.Meta(transmitter).SIGNER().WRITE()}, nil)
+		for _, account := range accounts {
+			ix.AccountValues = append(ix.AccountValues, solana.Meta(account))
+		}
+		extendTable := randomPubkeys(t, 1)[0]
+		txs, err := PlanExecuteTransactions(ix, transmitter, solana.Hash{1}, map[solana.PublicKey]solana.PublicKeySlice{extendTable: {}}, extendTable)
+		require.NoError(t, err)
+		require.Len(t, txs.ExtendLookupTables, 3)
+		require.Equal(t, accounts, txs.LookupTables[extendTable])
+		for _, size := range txs.Sizes {
+			require.LessOrEqual(t, size.Size, MaxSolanaTxSize, size.String())
+		}
+	})
+}
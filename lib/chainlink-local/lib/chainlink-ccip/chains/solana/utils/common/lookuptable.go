@@ -120,6 +120,27 @@ func AwaitSlotChange(ctx context.Context, client *rpc.Client) error {
 	return nil
 }
 
+// AwaitLookupTableActivation waits until the entries last added to the lookup table can be used, entries only become
+// active in the slot after the one they were added in.
+func AwaitLookupTableActivation(ctx context.Context, client *rpc.Client, table solana.PublicKey) error {
+	lookupTableState, err := addresslookuptable.GetAddressLookupTableStateWithOpts(ctx, client, table, &rpc.GetAccountInfoOpts{
+		Commitment: rpc.CommitmentConfirmed,
+	})
+	if err != nil {
+		return err
+	}
+	for {
+		slot, err := client.GetSlot(ctx, rpc.CommitmentConfirmed)
+		if err != nil {
+			return err
+		}
+		if slot > lookupTableState.LastExtendedSlot {
+			return nil
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+}
+
 func SetupLookupTable(ctx context.Context, client *rpc.Client, admin solana.PrivateKey, entries []solana.PublicKey) (solana.PublicKey, error) {
 	table, err := CreateLookupTable(ctx, client, admin)
 	if err != nil {
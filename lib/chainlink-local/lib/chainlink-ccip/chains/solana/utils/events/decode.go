@@ -0,0 +1,180 @@
+package events
+
+import (
+	"encoding/base64"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/gagliardetto/solana-go"
+)
+
+var (
+	invokeRegex      = regexp.MustCompile(`^Program (\w+) invoke \[\d+\]`)
+	successRegex     = regexp.MustCompile(`^Program (\w+) success`)
+	failedRegex      = regexp.MustCompile(`^Program (\w+) failed: (.*)$`)
+	customErrorRegex = regexp.MustCompile(`custom program error: 0x([0-9a-fA-F]+)`)
+	anchorErrorRegex = regexp.MustCompile(`Error Code: (\w+)\. Error Number: (\d+)\. Error Message: (.*?)\.?$`)
+)
+
+// Transaction is the decoded logs of a transaction
+type Transaction struct {
+	// Events are the events emitted by the registered programs, in order. The events of a failed transaction are
+	// reverted with it.
+	Events []Event
+	// Failure is the reason the transaction failed, nil if it succeeded
+	Failure *Failure
+}
+
+// Event is a typed event, Data is a pointer to the event struct of the registry (e.g. *OfframpExecutionStateChanged)
+type Event struct {
+	ProgramID   solana.PublicKey
+	Program     string
+	Instruction string
+	Name        string
+	Data        any
+}
+
+// Failure is the error of the innermost program that failed
+type Failure struct {
+	ProgramID   solana.PublicKey
+	Program     string
+	Instruction string
+	// Code, Name and Msg are set for custom program errors, including the Anchor framework errors
+	Code uint32
+	Name string
+	Msg  string
+	// Reason is the reason logged by the runtime, e.g. "custom program error: 0x1770"
+	Reason string
+}
+
+func (f *Failure) Error() string {
+	if f.Name != "" {
+		return fmt.Sprintf("%s failed with %s (%d): %s", f.label(), f.Name, f.Code, f.Msg)
+	}
+	return fmt.Sprintf("%s failed: %s", f.label(), f.Reason)
+}
+
+func (f *Failure) label() string {
+	label := f.ProgramID.String()
+	if f.Program != "" {
+		label = f.Program
+	}
+	if f.Instruction != "" {
+		label += " " + f.Instruction
+	}
+	return label
+}
+
+type frame struct {
+	programID   solana.PublicKey
+	program     *Program
+	instruction string
+	anchorError *ErrorType
+}
+
+// DecodeTransaction decodes the events and the failure reason of a transaction from its logs. programs maps the
+// deployed program ids to their registry, e.g. the offramp id to &Offramp; the events of other programs are skipped.
+func DecodeTransaction(logs []string, programs map[solana.PublicKey]*Program) (Transaction, error) {
+	var tx Transaction
+	var stack []*frame
+	current := func() *frame {
+		if len(stack) == 0 {
+			return nil
+		}
+		return stack[len(stack)-1]
+	}
+
+	for _, line := range logs {
+		line = strings.TrimSpace(line)
+		switch {
+		case invokeRegex.MatchString(line):
+			programID, err := solana.PublicKeyFromBase58(invokeRegex.FindStringSubmatch(line)[1])
+			if err != nil {
+				return Transaction{}, fmt.Errorf("invalid program id in %q: %w", line, err)
+			}
+			stack = append(stack, &frame{programID: programID, program: programs[programID]})
+
+		case successRegex.MatchString(line):
+			if len(stack) > 0 {
+				stack = stack[:len(stack)-1]
+			}
+
+		case failedRegex.MatchString(line):
+			f := current()
+			if f == nil {
+				continue
+			}
+			stack = stack[:len(stack)-1]
+			// the callers of the failing program fail with the same error, the innermost one is the cause
+			if tx.Failure == nil {
+				tx.Failure = newFailure(f, failedRegex.FindStringSubmatch(line)[2])
+			}
+
+		case strings.HasPrefix(line, "Program log: Instruction: "):
+			if f := current(); f != nil {
+				f.instruction = strings.TrimPrefix(line, "Program log: Instruction: ")
+			}
+
+		case strings.HasPrefix(line, "Program log: AnchorError"):
+			match := anchorErrorRegex.FindStringSubmatch(line)
+			if f := current(); f != nil && match != nil {
+				code, err := strconv.ParseUint(match[2], 10, 32)
+				if err == nil {
+					f.anchorError = &ErrorType{Code: uint32(code), Name: match[1], Msg: match[3]}
+				}
+			}
+
+		case strings.HasPrefix(line, "Program data: "):
+			f := current()
+			if f == nil || f.program == nil {
+				continue
+			}
+			data, err := base64.StdEncoding.DecodeString(strings.TrimPrefix(line, "Program data: "))
+			if err != nil {
+				return Transaction{}, fmt.Errorf("%s: invalid event data: %w", f.program.Name, err)
+			}
+			event, decoded, err := f.program.DecodeEvent(data)
+			if err != nil {
+				return Transaction{}, err
+			}
+			tx.Events = append(tx.Events, Event{
+				ProgramID:   f.programID,
+				Program:     f.program.Name,
+				Instruction: f.instruction,
+				Name:        event.Name,
+				Data:        decoded,
+			})
+		}
+	}
+	return tx, nil
+}
+
+func newFailure(f *frame, reason string) *Failure {
+	failure := &Failure{ProgramID: f.programID, Instruction: f.instruction, Reason: reason}
+	if f.program != nil {
+		failure.Program = f.program.Name
+	}
+
+	match := customErrorRegex.FindStringSubmatch(reason)
+	if match == nil {
+		return failure
+	}
+	code, err := strconv.ParseUint(match[1], 16, 32)
+	if err != nil {
+		return failure
+	}
+	failure.Code = uint32(code)
+	if f.program != nil {
+		if e, ok := f.program.Error(failure.Code); ok {
+			failure.Name, failure.Msg = e.Name, e.Msg
+			return failure
+		}
+	}
+	// the program logs the Anchor errors it raises, which covers the programs missing from the registry
+	if f.anchorError != nil && f.anchorError.Code == failure.Code {
+		failure.Name, failure.Msg = f.anchorError.Name, f.anchorError.Msg
+	}
+	return failure
+}
@@ -0,0 +1,214 @@
+package events
+
+import (
+	"bytes"
+	"encoding/base64"
+	"fmt"
+	"testing"
+
+	bin "github.com/gagliardetto/binary"
+	"github.com/gagliardetto/solana-go"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink-ccip/chains/solana/gobindings/ccip_offramp"
+)
+
+func eventLog(t *testing.T, event EventType, data any) string {
+	var buf bytes.Buffer
+	buf.Write(event.Discriminator[:])
+	require.NoError(t, bin.NewBorshEncoder(&buf).Encode(data))
+	return "Program data: " + base64.StdEncoding.EncodeToString(buf.Bytes())
+}
+
+func eventType(t *testing.T, program *Program, name string, index int) EventType {
+	var found []EventType
+	for _, event := range program.Events {
+		if event.Name == name {
+			found = append(found, event)
+		}
+	}
+	require.Greater(t, len(found), index, "%s.%s", program.Name, name)
+	return found[index]
+}
+
+func TestDecodeTransaction(t *testing.T) {
+	t.Parallel()
+
+	offramp, pool, rmnRemote, receiver := solana.NewWallet().PublicKey(), solana.NewWallet().PublicKey(), solana.NewWallet().PublicKey(), solana.NewWallet().PublicKey()
+	programs := map[solana.PublicKey]*Program{offramp: &Offramp, pool: &TokenPool, rmnRemote: &RmnRemote}
+
+	t.Run("registry", func(t *testing.T) {
+		t.Parallel()
+		for _, program := range Programs {
+			require.NotEmpty(t, program.Events, program.Name)
+			require.NotEmpty(t, program.Errors, program.Name)
+		}
+		// custom errors of different programs share codes
+		offrampErr, ok := Offramp.Error(9000)
+		require.True(t, ok)
+		rmnErr, ok := RmnRemote.Error(9000)
+		require.True(t, ok)
+		require.NotEqual(t, offrampErr.Name, rmnErr.Name)
+		// the shared CCIP and Anchor errors are found from any program
+		_, ok = Router.Error(10001)
+		require.True(t, ok)
+		anchorErr, ok := FeeQuoter.Error(2006)
+		require.True(t, ok)
+		require.Equal(t, "ConstraintSeeds", anchorErr.Name)
+	})
+
+	t.Run("events", func(t *testing.T) {
+		t.Parallel()
+		executed := OfframpExecutionStateChanged{
+			SourceChainSelector: 1,
+			SequenceNumber:      2,
+			MessageId:           [32]uint8{3},
+			State:               ccip_offramp.Success_MessageExecutionState,
+		}
+		config := OfframpConfigSet{SvmChainSelector: 4, EnableManualExecutionAfter: 5}
+		ocrConfig := OfframpOcr3ConfigSet{
+			OcrPluginType: ccip_offramp.Execution_OcrPluginType,
+			Signers:       [][20]uint8{{1}, {2}},
+			Transmitters:  []solana.PublicKey{receiver},
+			F:             1,
+		}
+		root := ccip_offramp.MerkleRoot{SourceChainSelector: 1, OnRampAddress: []byte{1, 2}, MinSeqNr: 1, MaxSeqNr: 2}
+
+		logs := []string{
+			fmt.Sprintf("Program %s invoke [1]", offramp),
+			"Program log: Instruction: Execute",
+			eventLog(t, eventType(t, &Offramp, "ConfigSet", 0), config),
+			eventLog(t, eventType(t, &Offramp, "ConfigSet", 1), ocrConfig),
+			eventLog(t, eventType(t, &Offramp, "CommitReportAccepted", 0), OfframpCommitReportAccepted{}),
+			eventLog(t, eventType(t, &Offramp, "CommitReportAccepted", 0), OfframpCommitReportAccepted{MerkleRoot: &root}),
+			fmt.Sprintf("Program %s invoke [2]", receiver),
+			"Program log: Instruction: CcipReceive",
+			// events of unregistered programs are skipped
+			"Program data: AQID",
+			fmt.Sprintf("Program %s success", receiver),
+			eventLog(t, eventType(t, &Offramp, "ExecutionStateChanged", 0), executed),
+			fmt.Sprintf("Program %s consumed 1000 of 200000 compute units", offramp),
+			fmt.Sprintf("Program %s success", offramp),
+		}
+
+		tx, err := DecodeTransaction(logs, programs)
+		require.NoError(t, err)
+		require.Nil(t, tx.Failure)
+		require.Len(t, tx.Events, 5)
+		for _, event := range tx.Events {
+			require.Equal(t, offramp, event.ProgramID)
+			require.Equal(t, "ccip_offramp", event.Program)
+			require.Equal(t, "Execute", event.Instruction)
+		}
+		// events declared twice under the same name are told apart by their layout
+		require.Equal(t, &config, tx.Events[0].Data)
+		require.Equal(t, &ocrConfig, tx.Events[1].Data)
+		require.Nil(t, tx.Events[2].Data.(*OfframpCommitReportAccepted).MerkleRoot)
+		require.Equal(t, &root, tx.Events[3].Data.(*OfframpCommitReportAccepted).MerkleRoot)
+		require.Equal(t, "ExecutionStateChanged", tx.Events[4].Name)
+		require.Equal(t, &executed, tx.Events[4].Data)
+
+		_, err = DecodeTransaction([]string{
+			fmt.Sprintf("Program %s invoke [1]", offramp),
+			"Program data: AQIDBAUGBwgJ",
+		}, programs)
+		require.ErrorContains(t, err, "unknown event discriminator")
+	})
+
+	t.Run("options follow the Anchor layout", func(t *testing.T) {
+		t.Parallel()
+		accepted := eventType(t, &Offramp, "CommitReportAccepted", 0)
+		// a price only commit report, as emitted by the offramp: no merkle root, one gas price update
+		data := append([]byte{}, accepted.Discriminator[:]...)
+		data = append(data, 0)          // MerkleRoot: None
+		data = append(data, 0, 0, 0, 0) // TokenPriceUpdates: empty
+		data = append(data, 1, 0, 0, 0) // GasPriceUpdates: one update
+		data = append(data, 7, 0, 0, 0, 0, 0, 0, 0)
+		data = append(data, make([]byte, 28)...)
+
+		decoded, err := accepted.Decode(data)
+		require.NoError(t, err)
+		report := decoded.(*OfframpCommitReportAccepted)
+		require.Nil(t, report.MerkleRoot)
+		require.Empty(t, report.PriceUpdates.TokenPriceUpdates)
+		require.Len(t, report.PriceUpdates.GasPriceUpdates, 1)
+		require.Equal(t, uint64(7), report.PriceUpdates.GasPriceUpdates[0].DestChainSelector)
+
+		encoded, err := bin.MarshalBorsh(report)
+		require.NoError(t, err)
+		require.Equal(t, data[8:], encoded)
+	})
+
+	t.Run("failures", func(t *testing.T) {
+		t.Parallel()
+		tests := []struct {
+			name    string
+			logs    []string
+			program string
+			errName string
+			reason  string
+		}{
+			{
+				name: "inner program error",
+				logs: []string{
+					fmt.Sprintf("Program %s invoke [1]", offramp),
+					"Program log: Instruction: Execute",
+					fmt.Sprintf("Program %s invoke [2]", pool),
+					"Program log: Instruction: ReleaseOrMintTokens",
+					"Program log: AnchorError thrown in programs/base-token-pool/src/rate_limiter.rs:80. Error Code: RLRateLimitReached. Error Number: 6016. Error Message: RateLimit: rate limit reached.",
+					fmt.Sprintf("Program %s failed: custom program error: 0x1780", pool),
+					fmt.Sprintf("Program %s failed: custom program error: 0x1780", offramp),
+				},
+				program: "base_token_pool",
+				errName: "RLRateLimitReached",
+			},
+			{
+				name: "codes are looked up in the failing program",
+				logs: []string{
+					fmt.Sprintf("Program %s invoke [1]", rmnRemote),
+					fmt.Sprintf("Program %s failed: custom program error: 0x2328", rmnRemote),
+				},
+				program: "rmn_remote",
+				errName: rmnRemoteError(t, 9000),
+			},
+			{
+				name: "unregistered program",
+				logs: []string{
+					fmt.Sprintf("Program %s invoke [1]", receiver),
+					"Program log: AnchorError caused by account: config. Error Code: ConstraintSeeds. Error Number: 2006. Error Message: A seeds constraint was violated.",
+					fmt.Sprintf("Program %s failed: custom program error: 0x7d6", receiver),
+				},
+				errName: "ConstraintSeeds",
+			},
+			{
+				name: "runtime error",
+				logs: []string{
+					fmt.Sprintf("Program %s invoke [1]", offramp),
+					fmt.Sprintf("Program %s failed: exceeded CUs meter at BPF instruction", offramp),
+				},
+				program: "ccip_offramp",
+				reason:  "exceeded CUs meter at BPF instruction",
+			},
+		}
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				t.Parallel()
+				tx, err := DecodeTransaction(tt.logs, programs)
+				require.NoError(t, err)
+				require.NotNil(t, tx.Failure)
+				require.Equal(t, tt.program, tx.Failure.Program)
+				require.Equal(t, tt.errName, tx.Failure.Name)
+				if tt.reason != "" {
+					require.Equal(t, tt.reason, tx.Failure.Reason)
+				}
+				require.Contains(t, tx.Failure.Error(), tt.errName)
+			})
+		}
+	})
+}
+
+func rmnRemoteError(t *testing.T, code uint32) string {
+	e, ok := RmnRemote.Error(code)
+	require.True(t, ok)
+	return e.Name
+}
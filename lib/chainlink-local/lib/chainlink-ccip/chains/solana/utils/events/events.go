@@ -0,0 +1,130 @@
+// Package events decodes the events and errors of the CCIP programs from transaction logs.
+//
+// The registry in registry.go is generated from the Anchor IDLs of the programs, regenerate it with `go generate` after
+// rebuilding the IDLs.
+package events
+
+//go:generate go run ./gen -idl ../../contracts/target/idl -out registry.go
+
+import (
+	"bytes"
+	"fmt"
+
+	bin "github.com/gagliardetto/binary"
+)
+
+// Program is the registry of the events and errors of a program
+type Program struct {
+	Name   string
+	Events []EventType
+	Errors []ErrorType
+}
+
+// EventType is an event of a program, New returns a pointer to its typed struct
+type EventType struct {
+	Name          string
+	Discriminator [8]byte
+	New           func() any
+}
+
+// ErrorType is a custom error of a program
+type ErrorType struct {
+	Code uint32
+	Name string
+	Msg  string
+}
+
+// Programs are the registries of the CCIP programs. The burnmint and lockrelease token pools use TokenPool.
+var Programs = []*Program{&Router, &Offramp, &FeeQuoter, &RmnRemote, &TokenPool}
+
+// anchorErrors are the Anchor framework errors raised by account and instruction validation. Not exhaustive, see
+// https://anchor.so/errors for the complete list.
+var anchorErrors = []ErrorType{
+	{Code: 100, Name: "InstructionMissing", Msg: "8 byte instruction identifier not provided"},
+	{Code: 101, Name: "InstructionFallbackNotFound", Msg: "Fallback functions are not supported"},
+	{Code: 102, Name: "InstructionDidNotDeserialize", Msg: "The program could not deserialize the given instruction"},
+	{Code: 103, Name: "InstructionDidNotSerialize", Msg: "The program could not serialize the given instruction"},
+	{Code: 2000, Name: "ConstraintMut", Msg: "A mut constraint was violated"},
+	{Code: 2001, Name: "ConstraintHasOne", Msg: "A has one constraint was violated"},
+	{Code: 2002, Name: "ConstraintSigner", Msg: "A signer constraint was violated"},
+	{Code: 2003, Name: "ConstraintRaw", Msg: "A raw constraint was violated"},
+	{Code: 2004, Name: "ConstraintOwner", Msg: "An owner constraint was violated"},
+	{Code: 2005, Name: "ConstraintRentExempt", Msg: "A rent exemption constraint was violated"},
+	{Code: 2006, Name: "ConstraintSeeds", Msg: "A seeds constraint was violated"},
+	{Code: 2012, Name: "ConstraintAddress", Msg: "An address constraint was violated"},
+	{Code: 2014, Name: "ConstraintTokenMint", Msg: "A token mint constraint was violated"},
+	{Code: 2015, Name: "ConstraintTokenOwner", Msg: "A token owner constraint was violated"},
+	{Code: 3001, Name: "AccountDiscriminatorNotFound", Msg: "No 8 byte discriminator was found on the account"},
+	{Code: 3002, Name: "AccountDiscriminatorMismatch", Msg: "8 byte discriminator did not match what was expected"},
+	{Code: 3003, Name: "AccountDidNotDeserialize", Msg: "Failed to deserialize the account"},
+	{Code: 3004, Name: "AccountDidNotSerialize", Msg: "Failed to serialize the account"},
+	{Code: 3005, Name: "AccountNotEnoughKeys", Msg: "Not enough account keys given to the instruction"},
+	{Code: 3006, Name: "AccountNotMutable", Msg: "The given account is not mutable"},
+	{Code: 3007, Name: "AccountOwnedByWrongProgram", Msg: "The given account is owned by a different program than expected"},
+	{Code: 3008, Name: "InvalidProgramId", Msg: "Program ID was not as expected"},
+	{Code: 3009, Name: "InvalidProgramExecutable", Msg: "Program account is not executable"},
+	{Code: 3010, Name: "AccountNotSigner", Msg: "The given account did not sign"},
+	{Code: 3011, Name: "AccountNotSystemOwned", Msg: "The given account is not owned by the system program"},
+	{Code: 3012, Name: "AccountNotInitialized", Msg: "The program expected this account to be already initialized"},
+}
+
+// Event returns the event types of the program with the discriminator. A program can declare several events under the
+// same name, and so with the same discriminator.
+func (p *Program) Event(discriminator [8]byte) []EventType {
+	var events []EventType
+	for _, event := range p.Events {
+		if event.Discriminator == discriminator {
+			events = append(events, event)
+		}
+	}
+	return events
+}
+
+// Error returns the error raised by the program with the code, including the errors shared by the CCIP programs and the
+// Anchor framework errors
+func (p *Program) Error(code uint32) (ErrorType, bool) {
+	for _, errs := range [][]ErrorType{p.Errors, Common.Errors, anchorErrors} {
+		for _, e := range errs {
+			if e.Code == code {
+				return e, true
+			}
+		}
+	}
+	return ErrorType{}, false
+}
+
+// DecodeEvent decodes the event data, discriminator included, into the typed event of the program. Events sharing a
+// discriminator are told apart by their layout: the event must consume the data exactly.
+func (p *Program) DecodeEvent(data []byte) (EventType, any, error) {
+	if len(data) < 8 {
+		return EventType{}, nil, fmt.Errorf("event data of %d bytes has no discriminator", len(data))
+	}
+	candidates := p.Event([8]byte(data[:8]))
+	if len(candidates) == 0 {
+		return EventType{}, nil, fmt.Errorf("%s: unknown event discriminator %x", p.Name, data[:8])
+	}
+	var err error
+	for _, event := range candidates {
+		var decoded any
+		if decoded, err = event.Decode(data); err == nil {
+			return event, decoded, nil
+		}
+	}
+	return EventType{}, nil, err
+}
+
+// Decode decodes the event data, discriminator included
+func (e EventType) Decode(data []byte) (any, error) {
+	if len(data) < 8 || !bytes.Equal(data[:8], e.Discriminator[:]) {
+		return nil, fmt.Errorf("%s: discriminator mismatch", e.Name)
+	}
+	decoded := e.New()
+	decoder := bin.NewBorshDecoder(data[8:])
+	if err := decoder.Decode(decoded); err != nil {
+		return nil, fmt.Errorf("%s: %w", e.Name, err)
+	}
+	if decoder.Remaining() != 0 {
+		return nil, fmt.Errorf("%s: %d trailing bytes", e.Name, decoder.Remaining())
+	}
+	return decoded, nil
+}
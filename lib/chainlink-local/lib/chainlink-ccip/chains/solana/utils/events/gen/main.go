@@ -0,0 +1,285 @@
+// Command gen generates the event and error registry of the CCIP programs from their Anchor IDLs.
+//
+// anchor-go does not generate events, so the typed events are generated here, referencing the types of the gobindings
+// packages for the defined types they contain.
+package main
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"go/format"
+	"log"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+const localPrefix = "github.com/smartcontractkit/"
+
+type program struct {
+	// IDL is the name of the IDL file and of the gobindings package of the program
+	IDL string
+	// Var is the name of the generated Program variable, also used as prefix of the event types
+	Var string
+}
+
+var programs = []program{
+	{IDL: "ccip_router", Var: "Router"},
+	{IDL: "ccip_offramp", Var: "Offramp"},
+	{IDL: "fee_quoter", Var: "FeeQuoter"},
+	{IDL: "rmn_remote", Var: "RmnRemote"},
+	// the burnmint and lockrelease pools emit the events and errors of the base token pool
+	{IDL: "base_token_pool", Var: "TokenPool"},
+	// the errors shared by the CCIP programs
+	{IDL: "ccip_common", Var: "Common"},
+}
+
+// renames disambiguates events declared twice by a program under the same name, keyed by program, event name and
+// occurrence
+var renames = map[string]string{
+	"ccip_offramp.ConfigSet.1": "Ocr3ConfigSet",
+}
+
+var primitives = map[string]string{
+	"bool": "bool", "string": "string", "bytes": "[]byte",
+	"u8": "uint8", "u16": "uint16", "u32": "uint32", "u64": "uint64",
+	"i8": "int8", "i16": "int16", "i32": "int32", "i64": "int64",
+}
+
+type idl struct {
+	Events []idlEvent `json:"events"`
+	Errors []idlError `json:"errors"`
+}
+
+type idlEvent struct {
+	Name   string     `json:"name"`
+	Fields []idlField `json:"fields"`
+}
+
+type idlField struct {
+	Name string          `json:"name"`
+	Type json.RawMessage `json:"type"`
+}
+
+type idlError struct {
+	Code uint32 `json:"code"`
+	Name string `json:"name"`
+	Msg  string `json:"msg"`
+}
+
+func main() {
+	idlDir := flag.String("idl", "../../contracts/target/idl", "directory of the Anchor IDLs")
+	out := flag.String("out", "registry.go", "output file")
+	flag.Parse()
+
+	src, err := generate(*idlDir)
+	if err != nil {
+		log.Fatal(err)
+	}
+	if err = os.WriteFile(*out, src, 0o600); err != nil {
+		log.Fatal(err)
+	}
+}
+
+func generate(idlDir string) ([]byte, error) {
+	var body bytes.Buffer
+	imports := map[string]bool{}
+
+	for _, p := range programs {
+		data, err := os.ReadFile(filepath.Join(idlDir, p.IDL+".json"))
+		if err != nil {
+			return nil, err
+		}
+		var def idl
+		if err = json.Unmarshal(data, &def); err != nil {
+			return nil, fmt.Errorf("%s: %w", p.IDL, err)
+		}
+
+		typeNames := make([]string, len(def.Events))
+		seen := map[string]int{}
+		for i, event := range def.Events {
+			name := event.Name
+			if n := seen[event.Name]; n > 0 {
+				var ok bool
+				if name, ok = renames[fmt.Sprintf("%s.%s.%d", p.IDL, event.Name, n)]; !ok {
+					return nil, fmt.Errorf("%s: event %s is declared %d times, add it to renames", p.IDL, event.Name, n+1)
+				}
+			}
+			seen[event.Name]++
+			typeNames[i] = p.Var + name
+
+			fmt.Fprintf(&body, "// %s is the %s event of %s\ntype %s struct {\n", typeNames[i], event.Name, p.IDL, typeNames[i])
+			fields := make([]codecField, len(event.Fields))
+			hasOptional := false
+			for j, field := range event.Fields {
+				goType, optional, typeErr := fieldType(p.IDL, field.Type, imports)
+				if typeErr != nil {
+					return nil, fmt.Errorf("%s.%s.%s: %w", p.IDL, event.Name, field.Name, typeErr)
+				}
+				tag := ""
+				if optional {
+					tag = " `bin:\"optional\"`"
+					hasOptional = true
+				}
+				fields[j] = codecField{Name: exported(field.Name), Optional: optional}
+				fmt.Fprintf(&body, "\t%s %s%s\n", fields[j].Name, goType, tag)
+			}
+			body.WriteString("}\n\n")
+			// the borsh codec of gagliardetto/binary does not encode Options the way Anchor does, anchor-go generates the
+			// codec of the types with Options instead
+			if hasOptional {
+				imports["github.com/gagliardetto/binary"] = true
+				writeCodec(&body, typeNames[i], fields)
+			}
+		}
+
+		fmt.Fprintf(&body, "// %s is the registry of the %s program\nvar %s = Program{\n\tName: %q,\n", p.Var, p.IDL, p.Var, p.IDL)
+		if len(def.Events) > 0 {
+			body.WriteString("\tEvents: []EventType{\n")
+			for i, event := range def.Events {
+				discriminator := sha256.Sum256([]byte("event:" + event.Name))
+				fmt.Fprintf(&body, "\t\t{Name: %q, Discriminator: %s, New: func() any { return new(%s) }},\n",
+					event.Name, byteArray(discriminator[:8]), typeNames[i])
+			}
+			body.WriteString("\t},\n")
+		}
+		if len(def.Errors) > 0 {
+			sort.Slice(def.Errors, func(i, j int) bool { return def.Errors[i].Code < def.Errors[j].Code })
+			body.WriteString("\tErrors: []ErrorType{\n")
+			for _, e := range def.Errors {
+				msg := e.Msg
+				if msg == "" {
+					msg = e.Name
+				}
+				fmt.Fprintf(&body, "\t\t{Code: %d, Name: %q, Msg: %q},\n", e.Code, e.Name, msg)
+			}
+			body.WriteString("\t},\n")
+		}
+		body.WriteString("}\n\n")
+	}
+
+	var src bytes.Buffer
+	src.WriteString("// Code generated by utils/events/gen. DO NOT EDIT.\n\npackage events\n\nimport (\n")
+	paths := make([]string, 0, len(imports))
+	for path := range imports {
+		paths = append(paths, path)
+	}
+	sort.Strings(paths)
+	local := false
+	for _, path := range paths {
+		if strings.HasPrefix(path, localPrefix) && !local {
+			local = true
+			src.WriteString("\n")
+		}
+		if strings.HasSuffix(path, "gagliardetto/binary") {
+			fmt.Fprintf(&src, "\tbin %q\n", path)
+		} else {
+			fmt.Fprintf(&src, "\t%q\n", path)
+		}
+	}
+	src.WriteString(")\n\n")
+	src.Write(body.Bytes())
+	return format.Source(src.Bytes())
+}
+
+// codecField is a field of a type with a generated codec
+type codecField struct {
+	Name     string
+	Optional bool
+}
+
+// writeCodec writes the MarshalWithEncoder and UnmarshalWithDecoder methods of a type, following anchor-go. Options are
+// encoded as a bool telling whether the value is present, followed by the value if it is.
+func writeCodec(body *bytes.Buffer, typeName string, fields []codecField) {
+	fmt.Fprintf(body, "func (obj %s) MarshalWithEncoder(encoder *bin.Encoder) (err error) {\n", typeName)
+	for _, field := range fields {
+		if !field.Optional {
+			fmt.Fprintf(body, "\t// Serialize `%s` param:\n\terr = encoder.Encode(obj.%s)\n\tif err != nil {\n\t\treturn err\n\t}\n",
+				field.Name, field.Name)
+			continue
+		}
+		fmt.Fprintf(body, "\t// Serialize `%s` param (optional):\n\t{\n\t\tif obj.%s == nil {\n", field.Name, field.Name)
+		body.WriteString("\t\t\terr = encoder.WriteBool(false)\n\t\t\tif err != nil {\n\t\t\t\treturn err\n\t\t\t}\n\t\t} else {\n")
+		body.WriteString("\t\t\terr = encoder.WriteBool(true)\n\t\t\tif err != nil {\n\t\t\t\treturn err\n\t\t\t}\n")
+		fmt.Fprintf(body, "\t\t\terr = encoder.Encode(obj.%s)\n\t\t\tif err != nil {\n\t\t\t\treturn err\n\t\t\t}\n\t\t}\n\t}\n", field.Name)
+	}
+	body.WriteString("\treturn nil\n}\n\n")
+
+	fmt.Fprintf(body, "func (obj *%s) UnmarshalWithDecoder(decoder *bin.Decoder) (err error) {\n", typeName)
+	for _, field := range fields {
+		if !field.Optional {
+			fmt.Fprintf(body, "\t// Deserialize `%s`:\n\terr = decoder.Decode(&obj.%s)\n\tif err != nil {\n\t\treturn err\n\t}\n",
+				field.Name, field.Name)
+			continue
+		}
+		fmt.Fprintf(body, "\t// Deserialize `%s` (optional):\n\t{\n\t\tok, err := decoder.ReadBool()\n\t\tif err != nil {\n\t\t\treturn err\n\t\t}\n", field.Name)
+		fmt.Fprintf(body, "\t\tif ok {\n\t\t\terr = decoder.Decode(&obj.%s)\n\t\t\tif err != nil {\n\t\t\t\treturn err\n\t\t\t}\n\t\t}\n\t}\n", field.Name)
+	}
+	body.WriteString("\treturn nil\n}\n\n")
+}
+
+// fieldType returns the Go type of an IDL type and whether it is an Option
+func fieldType(pkg string, raw json.RawMessage, imports map[string]bool) (string, bool, error) {
+	var primitive string
+	if err := json.Unmarshal(raw, &primitive); err == nil {
+		if goType, ok := primitives[primitive]; ok {
+			return goType, false, nil
+		}
+		switch primitive {
+		case "u128":
+			imports["github.com/gagliardetto/binary"] = true
+			return "bin.Uint128", false, nil
+		case "i128":
+			imports["github.com/gagliardetto/binary"] = true
+			return "bin.Int128", false, nil
+		case "publicKey":
+			imports["github.com/gagliardetto/solana-go"] = true
+			return "solana.PublicKey", false, nil
+		default:
+			return "", false, fmt.Errorf("unsupported type %q", primitive)
+		}
+	}
+
+	var composite struct {
+		Defined string            `json:"defined"`
+		Option  json.RawMessage   `json:"option"`
+		Vec     json.RawMessage   `json:"vec"`
+		Array   []json.RawMessage `json:"array"`
+	}
+	if err := json.Unmarshal(raw, &composite); err != nil {
+		return "", false, err
+	}
+	switch {
+	case composite.Defined != "":
+		imports[localPrefix+"chainlink-ccip/chains/solana/gobindings/"+pkg] = true
+		return pkg + "." + composite.Defined, false, nil
+	case composite.Option != nil:
+		inner, _, err := fieldType(pkg, composite.Option, imports)
+		return "*" + inner, true, err
+	case composite.Vec != nil:
+		inner, _, err := fieldType(pkg, composite.Vec, imports)
+		return "[]" + inner, false, err
+	case len(composite.Array) == 2:
+		inner, _, err := fieldType(pkg, composite.Array[0], imports)
+		return fmt.Sprintf("[%s]%s", composite.Array[1], inner), false, err
+	default:
+		return "", false, fmt.Errorf("unsupported type %s", raw)
+	}
+}
+
+// exported follows the field naming of anchor-go, which only capitalizes the first letter
+func exported(name string) string {
+	return strings.ToUpper(name[:1]) + name[1:]
+}
+
+func byteArray(b []byte) string {
+	parts := make([]string, len(b))
+	for i, v := range b {
+		parts[i] = fmt.Sprintf("%d", v)
+	}
+	return "[8]byte{" + strings.Join(parts, ", ") + "}"
+}
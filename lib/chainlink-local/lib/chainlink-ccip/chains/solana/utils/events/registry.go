@@ -0,0 +1,742 @@
+// Code generated by utils/events/gen. DO NOT EDIT.
+
+package events
+
+import (
+	bin "github.com/gagliardetto/binary"
+	"github.com/gagliardetto/solana-go"
+
+	"github.com/smartcontractkit/chainlink-ccip/chains/solana/gobindings/base_token_pool"
+	"github.com/smartcontractkit/chainlink-ccip/chains/solana/gobindings/ccip_offramp"
+	"github.com/smartcontractkit/chainlink-ccip/chains/solana/gobindings/ccip_router"
+	"github.com/smartcontractkit/chainlink-ccip/chains/solana/gobindings/fee_quoter"
+	"github.com/smartcontractkit/chainlink-ccip/chains/solana/gobindings/rmn_remote"
+)
+
+// RouterConfigSet is the ConfigSet event of ccip_router
+type RouterConfigSet struct {
+	SvmChainSelector uint64
+	FeeQuoter        solana.PublicKey
+	RmnRemote        solana.PublicKey
+	LinkTokenMint    solana.PublicKey
+	FeeAggregator    solana.PublicKey
+}
+
+// RouterFeeTokenAdded is the FeeTokenAdded event of ccip_router
+type RouterFeeTokenAdded struct {
+	FeeToken solana.PublicKey
+	Enabled  bool
+}
+
+// RouterFeeTokenEnabled is the FeeTokenEnabled event of ccip_router
+type RouterFeeTokenEnabled struct {
+	FeeToken solana.PublicKey
+}
+
+// RouterFeeTokenDisabled is the FeeTokenDisabled event of ccip_router
+type RouterFeeTokenDisabled struct {
+	FeeToken solana.PublicKey
+}
+
+// RouterFeeTokenRemoved is the FeeTokenRemoved event of ccip_router
+type RouterFeeTokenRemoved struct {
+	FeeToken solana.PublicKey
+}
+
+// RouterDestChainConfigUpdated is the DestChainConfigUpdated event of ccip_router
+type RouterDestChainConfigUpdated struct {
+	DestChainSelector uint64
+	DestChainConfig   ccip_router.DestChainConfig
+}
+
+// RouterDestChainAdded is the DestChainAdded event of ccip_router
+type RouterDestChainAdded struct {
+	DestChainSelector uint64
+	DestChainConfig   ccip_router.DestChainConfig
+}
+
+// RouterOwnershipTransferRequested is the OwnershipTransferRequested event of ccip_router
+type RouterOwnershipTransferRequested struct {
+	From solana.PublicKey
+	To   solana.PublicKey
+}
+
+// RouterOwnershipTransferred is the OwnershipTransferred event of ccip_router
+type RouterOwnershipTransferred struct {
+	From solana.PublicKey
+	To   solana.PublicKey
+}
+
+// RouterOfframpAdded is the OfframpAdded event of ccip_router
+type RouterOfframpAdded struct {
+	SourceChainSelector uint64
+	Offramp             solana.PublicKey
+}
+
+// RouterOfframpRemoved is the OfframpRemoved event of ccip_router
+type RouterOfframpRemoved struct {
+	SourceChainSelector uint64
+	Offramp             solana.PublicKey
+}
+
+// RouterCcipVersionForDestChainVersionBumped is the CcipVersionForDestChainVersionBumped event of ccip_router
+type RouterCcipVersionForDestChainVersionBumped struct {
+	DestChainSelector      uint64
+	PreviousSequenceNumber uint64
+	NewSequenceNumber      uint64
+}
+
+// RouterCcipVersionForDestChainVersionRolledBack is the CcipVersionForDestChainVersionRolledBack event of ccip_router
+type RouterCcipVersionForDestChainVersionRolledBack struct {
+	DestChainSelector      uint64
+	PreviousSequenceNumber uint64
+	NewSequenceNumber      uint64
+}
+
+// RouterCCIPMessageSent is the CCIPMessageSent event of ccip_router
+type RouterCCIPMessageSent struct {
+	DestChainSelector uint64
+	SequenceNumber    uint64
+	Message           ccip_router.SVM2AnyRampMessage
+}
+
+// RouterPoolSet is the PoolSet event of ccip_router
+type RouterPoolSet struct {
+	Token                   solana.PublicKey
+	PreviousPoolLookupTable solana.PublicKey
+	NewPoolLookupTable      solana.PublicKey
+}
+
+// RouterAdministratorTransferRequested is the AdministratorTransferRequested event of ccip_router
+type RouterAdministratorTransferRequested struct {
+	Token        solana.PublicKey
+	CurrentAdmin solana.PublicKey
+	NewAdmin     solana.PublicKey
+}
+
+// RouterAdministratorTransferred is the AdministratorTransferred event of ccip_router
+type RouterAdministratorTransferred struct {
+	Token    solana.PublicKey
+	NewAdmin solana.PublicKey
+}
+
+// Router is the registry of the ccip_router program
+var Router = Program{
+	Name: "ccip_router",
+	Events: []EventType{
+		{Name: "ConfigSet", Discriminator: [8]byte{15, 104, 59, 16, 236, 241, 8, 6}, New: func() any { return new(RouterConfigSet) }},
+		{Name: "FeeTokenAdded", Discriminator: [8]byte{181, 180, 252, 21, 215, 79, 93, 237}, New: func() any { return new(RouterFeeTokenAdded) }},
+		{Name: "FeeTokenEnabled", Discriminator: [8]byte{106, 180, 145, 189, 113, 180, 21, 15}, New: func() any { return new(RouterFeeTokenEnabled) }},
+		{Name: "FeeTokenDisabled", Discriminator: [8]byte{34, 139, 66, 75, 30, 17, 45, 151}, New: func() any { return new(RouterFeeTokenDisabled) }},
+		{Name: "FeeTokenRemoved", Discriminator: [8]byte{40, 31, 230, 252, 183, 150, 147, 201}, New: func() any { return new(RouterFeeTokenRemoved) }},
+		{Name: "DestChainConfigUpdated", Discriminator: [8]byte{3, 141, 73, 190, 73, 231, 51, 80}, New: func() any { return new(RouterDestChainConfigUpdated) }},
+		{Name: "DestChainAdded", Discriminator: [8]byte{59, 154, 48, 81, 230, 41, 80, 200}, New: func() any { return new(RouterDestChainAdded) }},
+		{Name: "OwnershipTransferRequested", Discriminator: [8]byte{79, 54, 99, 123, 57, 244, 134, 35}, New: func() any { return new(RouterOwnershipTransferRequested) }},
+		{Name: "OwnershipTransferred", Discriminator: [8]byte{172, 61, 205, 183, 250, 50, 38, 98}, New: func() any { return new(RouterOwnershipTransferred) }},
+		{Name: "OfframpAdded", Discriminator: [8]byte{158, 77, 52, 73, 113, 247, 76, 150}, New: func() any { return new(RouterOfframpAdded) }},
+		{Name: "OfframpRemoved", Discriminator: [8]byte{231, 81, 202, 9, 89, 193, 154, 37}, New: func() any { return new(RouterOfframpRemoved) }},
+		{Name: "CcipVersionForDestChainVersionBumped", Discriminator: [8]byte{81, 97, 90, 70, 154, 163, 255, 78}, New: func() any { return new(RouterCcipVersionForDestChainVersionBumped) }},
+		{Name: "CcipVersionForDestChainVersionRolledBack", Discriminator: [8]byte{50, 79, 44, 175, 232, 241, 225, 171}, New: func() any { return new(RouterCcipVersionForDestChainVersionRolledBack) }},
+		{Name: "CCIPMessageSent", Discriminator: [8]byte{23, 77, 73, 183, 123, 185, 115, 57}, New: func() any { return new(RouterCCIPMessageSent) }},
+		{Name: "PoolSet", Discriminator: [8]byte{135, 203, 185, 106, 113, 87, 177, 32}, New: func() any { return new(RouterPoolSet) }},
+		{Name: "AdministratorTransferRequested", Discriminator: [8]byte{159, 30, 110, 86, 22, 35, 70, 125}, New: func() any { return new(RouterAdministratorTransferRequested) }},
+		{Name: "AdministratorTransferred", Discriminator: [8]byte{103, 127, 255, 114, 168, 163, 159, 124}, New: func() any { return new(RouterAdministratorTransferred) }},
+	},
+	Errors: []ErrorType{
+		{Code: 7000, Name: "Unauthorized", Msg: "The signer is unauthorized"},
+		{Code: 7001, Name: "InvalidRMNRemoteAddress", Msg: "Invalid RMN Remote Address"},
+		{Code: 7002, Name: "InvalidInputsMint", Msg: "Mint account input is invalid"},
+		{Code: 7003, Name: "InvalidVersion", Msg: "Invalid version of the onchain state"},
+		{Code: 7004, Name: "FeeTokenMismatch", Msg: "Fee token doesn't match transfer token"},
+		{Code: 7005, Name: "RedundantOwnerProposal", Msg: "Proposed owner is the current owner"},
+		{Code: 7006, Name: "ReachedMaxSequenceNumber", Msg: "Reached max sequence number"},
+		{Code: 7007, Name: "InvalidInputsTokenIndices", Msg: "Invalid pool account account indices"},
+		{Code: 7008, Name: "InvalidInputsPoolAccounts", Msg: "Invalid pool accounts"},
+		{Code: 7009, Name: "InvalidInputsTokenAccounts", Msg: "Invalid token accounts"},
+		{Code: 7010, Name: "InvalidInputsTokenAdminRegistryAccounts", Msg: "Invalid Token Admin Registry account"},
+		{Code: 7011, Name: "InvalidInputsLookupTableAccounts", Msg: "Invalid LookupTable account"},
+		{Code: 7012, Name: "InvalidInputsLookupTableAccountWritable", Msg: "Invalid LookupTable account writable access"},
+		{Code: 7013, Name: "InvalidInputsTokenAmount", Msg: "Cannot send zero tokens"},
+		{Code: 7014, Name: "InvalidInputsTransferAllAmount", Msg: "Must specify zero amount to send alongside transfer_all"},
+		{Code: 7015, Name: "InvalidInputsAtaAddress", Msg: "Invalid Associated Token Account address"},
+		{Code: 7016, Name: "InvalidInputsAtaWritable", Msg: "Invalid Associated Token Account writable flag"},
+		{Code: 7017, Name: "InvalidInputsChainSelector", Msg: "Chain selector is invalid"},
+		{Code: 7018, Name: "InsufficientLamports", Msg: "Insufficient lamports"},
+		{Code: 7019, Name: "InsufficientFunds", Msg: "Insufficient funds"},
+		{Code: 7020, Name: "SourceTokenDataTooLarge", Msg: "Source token data is too large"},
+		{Code: 7021, Name: "InvalidTokenAdminRegistryInputsZeroAddress", Msg: "New Admin can not be zero address"},
+		{Code: 7022, Name: "InvalidTokenAdminRegistryProposedAdmin", Msg: "An already owned registry can not be proposed"},
+		{Code: 7023, Name: "SenderNotAllowed", Msg: "Sender not allowed for that destination chain"},
+		{Code: 7024, Name: "InvalidCodeVersion", Msg: "Invalid code version"},
+		{Code: 7025, Name: "InvalidCcipVersionRollback", Msg: "Invalid rollback attempt on the CCIP version of the onramp to the destination chain"},
+	},
+}
+
+// OfframpSourceChainConfigUpdated is the SourceChainConfigUpdated event of ccip_offramp
+type OfframpSourceChainConfigUpdated struct {
+	SourceChainSelector uint64
+	SourceChainConfig   ccip_offramp.SourceChainConfig
+}
+
+// OfframpSourceChainAdded is the SourceChainAdded event of ccip_offramp
+type OfframpSourceChainAdded struct {
+	SourceChainSelector uint64
+	SourceChainConfig   ccip_offramp.SourceChainConfig
+}
+
+// OfframpOwnershipTransferRequested is the OwnershipTransferRequested event of ccip_offramp
+type OfframpOwnershipTransferRequested struct {
+	From solana.PublicKey
+	To   solana.PublicKey
+}
+
+// OfframpOwnershipTransferred is the OwnershipTransferred event of ccip_offramp
+type OfframpOwnershipTransferred struct {
+	From solana.PublicKey
+	To   solana.PublicKey
+}
+
+// OfframpConfigSet is the ConfigSet event of ccip_offramp
+type OfframpConfigSet struct {
+	SvmChainSelector           uint64
+	EnableManualExecutionAfter int64
+}
+
+// OfframpReferenceAddressesSet is the ReferenceAddressesSet event of ccip_offramp
+type OfframpReferenceAddressesSet struct {
+	Router             solana.PublicKey
+	FeeQuoter          solana.PublicKey
+	OfframpLookupTable solana.PublicKey
+	RmnRemote          solana.PublicKey
+}
+
+// OfframpCommitReportAccepted is the CommitReportAccepted event of ccip_offramp
+type OfframpCommitReportAccepted struct {
+	MerkleRoot   *ccip_offramp.MerkleRoot `bin:"optional"`
+	PriceUpdates ccip_offramp.PriceUpdates
+}
+
+func (obj OfframpCommitReportAccepted) MarshalWithEncoder(encoder *bin.Encoder) (err error) {
+	// Serialize `MerkleRoot` param (optional):
+	{
+		if obj.MerkleRoot == nil {
+			err = encoder.WriteBool(false)
+			if err != nil {
+				return err
+			}
+		} else {
+			err = encoder.WriteBool(true)
+			if err != nil {
+				return err
+			}
+			err = encoder.Encode(obj.MerkleRoot)
+			if err != nil {
+				return err
+			}
+		}
+	}
+	// Serialize `PriceUpdates` param:
+	err = encoder.Encode(obj.PriceUpdates)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+func (obj *OfframpCommitReportAccepted) UnmarshalWithDecoder(decoder *bin.Decoder) (err error) {
+	// Deserialize `MerkleRoot` (optional):
+	{
+		ok, err := decoder.ReadBool()
+		if err != nil {
+			return err
+		}
+		if ok {
+			err = decoder.Decode(&obj.MerkleRoot)
+			if err != nil {
+				return err
+			}
+		}
+	}
+	// Deserialize `PriceUpdates`:
+	err = decoder.Decode(&obj.PriceUpdates)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+// OfframpCommitReportPDAClosed is the CommitReportPDAClosed event of ccip_offramp
+type OfframpCommitReportPDAClosed struct {
+	SourceChainSelector uint64
+	MerkleRoot          [32]uint8
+}
+
+// OfframpSkippedAlreadyExecutedMessage is the SkippedAlreadyExecutedMessage event of ccip_offramp
+type OfframpSkippedAlreadyExecutedMessage struct {
+	SourceChainSelector uint64
+	SequenceNumber      uint64
+}
+
+// OfframpExecutionStateChanged is the ExecutionStateChanged event of ccip_offramp
+type OfframpExecutionStateChanged struct {
+	SourceChainSelector uint64
+	SequenceNumber      uint64
+	MessageId           [32]uint8
+	MessageHash         [32]uint8
+	State               ccip_offramp.MessageExecutionState
+}
+
+// OfframpOcr3ConfigSet is the ConfigSet event of ccip_offramp
+type OfframpOcr3ConfigSet struct {
+	OcrPluginType ccip_offramp.OcrPluginType
+	ConfigDigest  [32]uint8
+	Signers       [][20]uint8
+	Transmitters  []solana.PublicKey
+	F             uint8
+}
+
+// OfframpTransmitted is the Transmitted event of ccip_offramp
+type OfframpTransmitted struct {
+	OcrPluginType  ccip_offramp.OcrPluginType
+	ConfigDigest   [32]uint8
+	SequenceNumber uint64
+}
+
+// Offramp is the registry of the ccip_offramp program
+var Offramp = Program{
+	Name: "ccip_offramp",
+	Events: []EventType{
+		{Name: "SourceChainConfigUpdated", Discriminator: [8]byte{31, 205, 106, 132, 10, 220, 181, 30}, New: func() any { return new(OfframpSourceChainConfigUpdated) }},
+		{Name: "SourceChainAdded", Discriminator: [8]byte{98, 127, 170, 88, 67, 55, 230, 8}, New: func() any { return new(OfframpSourceChainAdded) }},
+		{Name: "OwnershipTransferRequested", Discriminator: [8]byte{79, 54, 99, 123, 57, 244, 134, 35}, New: func() any { return new(OfframpOwnershipTransferRequested) }},
+		{Name: "OwnershipTransferred", Discriminator: [8]byte{172, 61, 205, 183, 250, 50, 38, 98}, New: func() any { return new(OfframpOwnershipTransferred) }},
+		{Name: "ConfigSet", Discriminator: [8]byte{15, 104, 59, 16, 236, 241, 8, 6}, New: func() any { return new(OfframpConfigSet) }},
+		{Name: "ReferenceAddressesSet", Discriminator: [8]byte{146, 234, 139, 115, 99, 143, 216, 191}, New: func() any { return new(OfframpReferenceAddressesSet) }},
+		{Name: "CommitReportAccepted", Discriminator: [8]byte{44, 46, 77, 237, 70, 187, 170, 133}, New: func() any { return new(OfframpCommitReportAccepted) }},
+		{Name: "CommitReportPDAClosed", Discriminator: [8]byte{69, 240, 72, 149, 174, 18, 236, 46}, New: func() any { return new(OfframpCommitReportPDAClosed) }},
+		{Name: "SkippedAlreadyExecutedMessage", Discriminator: [8]byte{124, 136, 216, 231, 25, 232, 5, 239}, New: func() any { return new(OfframpSkippedAlreadyExecutedMessage) }},
+		{Name: "ExecutionStateChanged", Discriminator: [8]byte{185, 176, 140, 112, 239, 78, 31, 249}, New: func() any { return new(OfframpExecutionStateChanged) }},
+		{Name: "ConfigSet", Discriminator: [8]byte{15, 104, 59, 16, 236, 241, 8, 6}, New: func() any { return new(OfframpOcr3ConfigSet) }},
+		{Name: "Transmitted", Discriminator: [8]byte{144, 94, 142, 170, 49, 110, 67, 189}, New: func() any { return new(OfframpTransmitted) }},
+	},
+	Errors: []ErrorType{
+		{Code: 9000, Name: "InvalidSequenceInterval", Msg: "The given sequence interval is invalid"},
+		{Code: 9001, Name: "RootNotCommitted", Msg: "The given Merkle Root is missing"},
+		{Code: 9002, Name: "InvalidRMNRemoteAddress", Msg: "Invalid RMN Remote Address"},
+		{Code: 9003, Name: "ExistingMerkleRoot", Msg: "The given Merkle Root is already committed"},
+		{Code: 9004, Name: "Unauthorized", Msg: "The signer is unauthorized"},
+		{Code: 9005, Name: "InvalidNonce", Msg: "Invalid Nonce"},
+		{Code: 9006, Name: "InvalidInputsMissingWritable", Msg: "Account should be writable"},
+		{Code: 9007, Name: "OnrampNotConfigured", Msg: "Onramp was not configured"},
+		{Code: 9008, Name: "FailedToDeserializeReport", Msg: "Failed to deserialize report"},
+		{Code: 9009, Name: "InvalidPluginType", Msg: "Invalid plugin type"},
+		{Code: 9010, Name: "InvalidVersion", Msg: "Invalid version of the onchain state"},
+		{Code: 9011, Name: "MissingExpectedPriceUpdates", Msg: "Commit report is missing expected price updates"},
+		{Code: 9012, Name: "MissingExpectedMerkleRoot", Msg: "Commit report is missing expected merkle root"},
+		{Code: 9013, Name: "UnexpectedMerkleRoot", Msg: "Commit report contains unexpected merkle root"},
+		{Code: 9014, Name: "RedundantOwnerProposal", Msg: "Proposed owner is the current owner"},
+		{Code: 9015, Name: "UnsupportedSourceChainSelector", Msg: "Source chain selector not supported"},
+		{Code: 9016, Name: "UnsupportedDestinationChainSelector", Msg: "Destination chain selector not supported"},
+		{Code: 9017, Name: "InvalidProof", Msg: "Invalid Proof for Merkle Root"},
+		{Code: 9018, Name: "InvalidMessage", Msg: "Invalid message format"},
+		{Code: 9019, Name: "ReachedMaxSequenceNumber", Msg: "Reached max sequence number"},
+		{Code: 9020, Name: "ManualExecutionNotAllowed", Msg: "Manual execution not allowed"},
+		{Code: 9021, Name: "InvalidInputsNumberOfAccounts", Msg: "Number of accounts is invalid"},
+		{Code: 9022, Name: "InvalidInputsGlobalStateAccount", Msg: "Invalid global state account address"},
+		{Code: 9023, Name: "InvalidInputsTokenIndices", Msg: "Invalid pool account account indices"},
+		{Code: 9024, Name: "InvalidInputsPoolAccounts", Msg: "Invalid pool accounts"},
+		{Code: 9025, Name: "InvalidInputsTokenAccounts", Msg: "Invalid token accounts"},
+		{Code: 9026, Name: "InvalidInputsSysvarAccount", Msg: "Invalid sysvar instructions account"},
+		{Code: 9027, Name: "InvalidInputsFeeQuoterAccount", Msg: "Invalid fee quoter account"},
+		{Code: 9028, Name: "InvalidInputsAllowedOfframpAccount", Msg: "Invalid offramp authorization account"},
+		{Code: 9029, Name: "InvalidInputsTokenAdminRegistryAccounts", Msg: "Invalid Token Admin Registry account"},
+		{Code: 9030, Name: "InvalidInputsLookupTableAccounts", Msg: "Invalid LookupTable account"},
+		{Code: 9031, Name: "InvalidInputsLookupTableAccountWritable", Msg: "Invalid LookupTable account writable access"},
+		{Code: 9032, Name: "OfframpReleaseMintBalanceMismatch", Msg: "Release or mint balance mismatch"},
+		{Code: 9033, Name: "OfframpInvalidDataLength", Msg: "Invalid data length"},
+		{Code: 9034, Name: "StaleCommitReport", Msg: "Stale commit report"},
+		{Code: 9035, Name: "InvalidWritabilityBitmap", Msg: "Invalid writability bitmap"},
+		{Code: 9036, Name: "InvalidCodeVersion", Msg: "Invalid code version"},
+		{Code: 9037, Name: "Ocr3InvalidConfigFMustBePositive", Msg: "Invalid config: F must be positive"},
+		{Code: 9038, Name: "Ocr3InvalidConfigTooManyTransmitters", Msg: "Invalid config: Too many transmitters"},
+		{Code: 9039, Name: "Ocr3InvalidConfigNoTransmitters", Msg: "Invalid config: No transmitters"},
+		{Code: 9040, Name: "Ocr3InvalidConfigTooManySigners", Msg: "Invalid config: Too many signers"},
+		{Code: 9041, Name: "Ocr3InvalidConfigFIsTooHigh", Msg: "Invalid config: F is too high"},
+		{Code: 9042, Name: "Ocr3InvalidConfigRepeatedOracle", Msg: "Invalid config: Repeated oracle address"},
+		{Code: 9043, Name: "Ocr3WrongMessageLength", Msg: "Wrong message length"},
+		{Code: 9044, Name: "Ocr3ConfigDigestMismatch", Msg: "Config digest mismatch"},
+		{Code: 9045, Name: "Ocr3WrongNumberOfSignatures", Msg: "Wrong number signatures"},
+		{Code: 9046, Name: "Ocr3UnauthorizedTransmitter", Msg: "Unauthorized transmitter"},
+		{Code: 9047, Name: "Ocr3UnauthorizedSigner", Msg: "Unauthorized signer"},
+		{Code: 9048, Name: "Ocr3NonUniqueSignatures", Msg: "Non unique signatures"},
+		{Code: 9049, Name: "Ocr3OracleCannotBeZeroAddress", Msg: "Oracle cannot be zero address"},
+		{Code: 9050, Name: "Ocr3StaticConfigCannotBeChanged", Msg: "Static config cannot be changed"},
+		{Code: 9051, Name: "Ocr3InvalidPluginType", Msg: "Incorrect plugin type"},
+		{Code: 9052, Name: "Ocr3InvalidSignature", Msg: "Invalid signature"},
+		{Code: 9053, Name: "Ocr3SignaturesOutOfRegistration", Msg: "Signatures out of registration"},
+		{Code: 9054, Name: "InvalidOnrampAddress", Msg: "Invalid onramp address"},
+		{Code: 9055, Name: "InvalidInputsExternalExecutionSignerAccount", Msg: "Invalid external execution signer account"},
+		{Code: 9056, Name: "CommitReportHasPendingMessages", Msg: "Commit report has pending messages"},
+	},
+}
+
+// FeeQuoterConfigSet is the ConfigSet event of fee_quoter
+type FeeQuoterConfigSet struct {
+	MaxFeeJuelsPerMsg      bin.Uint128
+	LinkTokenMint          solana.PublicKey
+	LinkTokenLocalDecimals uint8
+	Onramp                 solana.PublicKey
+	DefaultCodeVersion     fee_quoter.CodeVersion
+}
+
+// FeeQuoterFeeTokenAdded is the FeeTokenAdded event of fee_quoter
+type FeeQuoterFeeTokenAdded struct {
+	FeeToken solana.PublicKey
+	Enabled  bool
+}
+
+// FeeQuoterFeeTokenEnabled is the FeeTokenEnabled event of fee_quoter
+type FeeQuoterFeeTokenEnabled struct {
+	FeeToken solana.PublicKey
+}
+
+// FeeQuoterFeeTokenDisabled is the FeeTokenDisabled event of fee_quoter
+type FeeQuoterFeeTokenDisabled struct {
+	FeeToken solana.PublicKey
+}
+
+// FeeQuoterFeeTokenRemoved is the FeeTokenRemoved event of fee_quoter
+type FeeQuoterFeeTokenRemoved struct {
+	FeeToken solana.PublicKey
+}
+
+// FeeQuoterDestChainAdded is the DestChainAdded event of fee_quoter
+type FeeQuoterDestChainAdded struct {
+	DestChainSelector uint64
+	DestChainConfig   fee_quoter.DestChainConfig
+}
+
+// FeeQuoterDestChainConfigUpdated is the DestChainConfigUpdated event of fee_quoter
+type FeeQuoterDestChainConfigUpdated struct {
+	DestChainSelector uint64
+	DestChainConfig   fee_quoter.DestChainConfig
+}
+
+// FeeQuoterOwnershipTransferRequested is the OwnershipTransferRequested event of fee_quoter
+type FeeQuoterOwnershipTransferRequested struct {
+	From solana.PublicKey
+	To   solana.PublicKey
+}
+
+// FeeQuoterOwnershipTransferred is the OwnershipTransferred event of fee_quoter
+type FeeQuoterOwnershipTransferred struct {
+	From solana.PublicKey
+	To   solana.PublicKey
+}
+
+// FeeQuoterUsdPerUnitGasUpdated is the UsdPerUnitGasUpdated event of fee_quoter
+type FeeQuoterUsdPerUnitGasUpdated struct {
+	DestChain uint64
+	Value     [28]uint8
+	Timestamp int64
+}
+
+// FeeQuoterUsdPerTokenUpdated is the UsdPerTokenUpdated event of fee_quoter
+type FeeQuoterUsdPerTokenUpdated struct {
+	Token     solana.PublicKey
+	Value     [28]uint8
+	Timestamp int64
+}
+
+// FeeQuoterTokenPriceUpdateIgnored is the TokenPriceUpdateIgnored event of fee_quoter
+type FeeQuoterTokenPriceUpdateIgnored struct {
+	Token solana.PublicKey
+	Value [28]uint8
+}
+
+// FeeQuoterTokenTransferFeeConfigUpdated is the TokenTransferFeeConfigUpdated event of fee_quoter
+type FeeQuoterTokenTransferFeeConfigUpdated struct {
+	DestChainSelector      uint64
+	Token                  solana.PublicKey
+	TokenTransferFeeConfig fee_quoter.TokenTransferFeeConfig
+}
+
+// FeeQuoterPremiumMultiplierWeiPerEthUpdated is the PremiumMultiplierWeiPerEthUpdated event of fee_quoter
+type FeeQuoterPremiumMultiplierWeiPerEthUpdated struct {
+	Token                      solana.PublicKey
+	PremiumMultiplierWeiPerEth uint64
+}
+
+// FeeQuoterPriceUpdaterAdded is the PriceUpdaterAdded event of fee_quoter
+type FeeQuoterPriceUpdaterAdded struct {
+	PriceUpdater solana.PublicKey
+}
+
+// FeeQuoterPriceUpdaterRemoved is the PriceUpdaterRemoved event of fee_quoter
+type FeeQuoterPriceUpdaterRemoved struct {
+	PriceUpdater solana.PublicKey
+}
+
+// FeeQuoter is the registry of the fee_quoter program
+var FeeQuoter = Program{
+	Name: "fee_quoter",
+	Events: []EventType{
+		{Name: "ConfigSet", Discriminator: [8]byte{15, 104, 59, 16, 236, 241, 8, 6}, New: func() any { return new(FeeQuoterConfigSet) }},
+		{Name: "FeeTokenAdded", Discriminator: [8]byte{181, 180, 252, 21, 215, 79, 93, 237}, New: func() any { return new(FeeQuoterFeeTokenAdded) }},
+		{Name: "FeeTokenEnabled", Discriminator: [8]byte{106, 180, 145, 189, 113, 180, 21, 15}, New: func() any { return new(FeeQuoterFeeTokenEnabled) }},
+		{Name: "FeeTokenDisabled", Discriminator: [8]byte{34, 139, 66, 75, 30, 17, 45, 151}, New: func() any { return new(FeeQuoterFeeTokenDisabled) }},
+		{Name: "FeeTokenRemoved", Discriminator: [8]byte{40, 31, 230, 252, 183, 150, 147, 201}, New: func() any { return new(FeeQuoterFeeTokenRemoved) }},
+		{Name: "DestChainAdded", Discriminator: [8]byte{59, 154, 48, 81, 230, 41, 80, 200}, New: func() any { return new(FeeQuoterDestChainAdded) }},
+		{Name: "DestChainConfigUpdated", Discriminator: [8]byte{3, 141, 73, 190, 73, 231, 51, 80}, New: func() any { return new(FeeQuoterDestChainConfigUpdated) }},
+		{Name: "OwnershipTransferRequested", Discriminator: [8]byte{79, 54, 99, 123, 57, 244, 134, 35}, New: func() any { return new(FeeQuoterOwnershipTransferRequested) }},
+		{Name: "OwnershipTransferred", Discriminator: [8]byte{172, 61, 205, 183, 250, 50, 38, 98}, New: func() any { return new(FeeQuoterOwnershipTransferred) }},
+		{Name: "UsdPerUnitGasUpdated", Discriminator: [8]byte{174, 255, 2, 41, 197, 110, 31, 40}, New: func() any { return new(FeeQuoterUsdPerUnitGasUpdated) }},
+		{Name: "UsdPerTokenUpdated", Discriminator: [8]byte{67, 154, 252, 56, 104, 14, 192, 219}, New: func() any { return new(FeeQuoterUsdPerTokenUpdated) }},
+		{Name: "TokenPriceUpdateIgnored", Discriminator: [8]byte{68, 119, 161, 131, 128, 65, 69, 201}, New: func() any { return new(FeeQuoterTokenPriceUpdateIgnored) }},
+		{Name: "TokenTransferFeeConfigUpdated", Discriminator: [8]byte{253, 199, 166, 1, 178, 150, 242, 253}, New: func() any { return new(FeeQuoterTokenTransferFeeConfigUpdated) }},
+		{Name: "PremiumMultiplierWeiPerEthUpdated", Discriminator: [8]byte{151, 5, 223, 182, 215, 187, 249, 225}, New: func() any { return new(FeeQuoterPremiumMultiplierWeiPerEthUpdated) }},
+		{Name: "PriceUpdaterAdded", Discriminator: [8]byte{87, 31, 151, 133, 151, 187, 97, 186}, New: func() any { return new(FeeQuoterPriceUpdaterAdded) }},
+		{Name: "PriceUpdaterRemoved", Discriminator: [8]byte{225, 194, 40, 213, 212, 39, 76, 148}, New: func() any { return new(FeeQuoterPriceUpdaterRemoved) }},
+	},
+	Errors: []ErrorType{
+		{Code: 8000, Name: "Unauthorized", Msg: "The signer is unauthorized"},
+		{Code: 8001, Name: "InvalidInputs", Msg: "Invalid inputs"},
+		{Code: 8002, Name: "ZeroGasLimit", Msg: "Gas limit is zero"},
+		{Code: 8003, Name: "DefaultGasLimitExceedsMaximum", Msg: "Default gas limit exceeds the maximum"},
+		{Code: 8004, Name: "InvalidVersion", Msg: "Invalid version of the onchain state"},
+		{Code: 8005, Name: "RedundantOwnerProposal", Msg: "Proposed owner is the current owner"},
+		{Code: 8006, Name: "InvalidInputsMissingWritable", Msg: "Account should be writable"},
+		{Code: 8007, Name: "InvalidInputsChainSelector", Msg: "Chain selector is invalid"},
+		{Code: 8008, Name: "InvalidInputsMint", Msg: "Mint account input is invalid"},
+		{Code: 8009, Name: "InvalidInputsMintOwner", Msg: "Mint account input has an invalid owner"},
+		{Code: 8010, Name: "InvalidInputsTokenConfigAccount", Msg: "Token config account is invalid"},
+		{Code: 8011, Name: "InvalidInputsMissingExtraArgs", Msg: "Missing extra args in message to SVM receiver"},
+		{Code: 8012, Name: "InvalidInputsMissingDataAfterExtraArgs", Msg: "Missing data after extra args tag"},
+		{Code: 8013, Name: "InvalidInputsDestChainStateAccount", Msg: "Destination chain state account is invalid"},
+		{Code: 8014, Name: "InvalidInputsPerChainPerTokenConfig", Msg: "Per chain per token config account is invalid"},
+		{Code: 8015, Name: "InvalidInputsBillingTokenConfig", Msg: "Billing token config account is invalid"},
+		{Code: 8016, Name: "InvalidInputsAccountCount", Msg: "Number of accounts provided is incorrect"},
+		{Code: 8017, Name: "InvalidInputsNoUpdates", Msg: "No price or gas update provided"},
+		{Code: 8018, Name: "InvalidInputsTokenAccounts", Msg: "Invalid token accounts"},
+		{Code: 8019, Name: "DestinationChainDisabled", Msg: "Destination chain disabled"},
+		{Code: 8020, Name: "FeeTokenDisabled", Msg: "Fee token disabled"},
+		{Code: 8021, Name: "MessageTooLarge", Msg: "Message exceeds maximum data size"},
+		{Code: 8022, Name: "UnsupportedNumberOfTokens", Msg: "Message contains an unsupported number of tokens"},
+		{Code: 8023, Name: "InvalidTokenPrice", Msg: "Invalid token price"},
+		{Code: 8024, Name: "StaleGasPrice", Msg: "Stale gas price"},
+		{Code: 8025, Name: "InvalidInputsMissingTokenConfig", Msg: "Inputs are missing token configuration"},
+		{Code: 8026, Name: "MessageFeeTooHigh", Msg: "Message fee is too high"},
+		{Code: 8027, Name: "MessageGasLimitTooHigh", Msg: "Message gas limit too high"},
+		{Code: 8028, Name: "ExtraArgOutOfOrderExecutionMustBeTrue", Msg: "Extra arg out of order execution must be true"},
+		{Code: 8029, Name: "InvalidExtraArgsTag", Msg: "Invalid extra args tag"},
+		{Code: 8030, Name: "InvalidExtraArgsAccounts", Msg: "Invalid amount of accounts in extra args"},
+		{Code: 8031, Name: "InvalidExtraArgsWritabilityBitmap", Msg: "Invalid writability bitmap in extra args"},
+		{Code: 8032, Name: "InvalidTokenReceiver", Msg: "Invalid token receiver"},
+		{Code: 8033, Name: "UnauthorizedPriceUpdater", Msg: "The caller is not an authorized price updater"},
+		{Code: 8034, Name: "InvalidTokenTransferFeeMaxMin", Msg: "Minimum token transfer fee exceeds maximum"},
+		{Code: 8035, Name: "InvalidTokenTransferFeeDestBytesOverhead", Msg: "Insufficient dest bytes overhead on transfer fee config"},
+		{Code: 8036, Name: "InvalidCodeVersion", Msg: "Invalid code version"},
+	},
+}
+
+// RmnRemoteOwnershipTransferRequested is the OwnershipTransferRequested event of rmn_remote
+type RmnRemoteOwnershipTransferRequested struct {
+	From solana.PublicKey
+	To   solana.PublicKey
+}
+
+// RmnRemoteOwnershipTransferred is the OwnershipTransferred event of rmn_remote
+type RmnRemoteOwnershipTransferred struct {
+	From solana.PublicKey
+	To   solana.PublicKey
+}
+
+// RmnRemoteConfigSet is the ConfigSet event of rmn_remote
+type RmnRemoteConfigSet struct {
+	DefaultCodeVersion rmn_remote.CodeVersion
+}
+
+// RmnRemoteSubjectCursed is the SubjectCursed event of rmn_remote
+type RmnRemoteSubjectCursed struct {
+	Subject rmn_remote.CurseSubject
+}
+
+// RmnRemoteSubjectUncursed is the SubjectUncursed event of rmn_remote
+type RmnRemoteSubjectUncursed struct {
+	Subject rmn_remote.CurseSubject
+}
+
+// RmnRemote is the registry of the rmn_remote program
+var RmnRemote = Program{
+	Name: "rmn_remote",
+	Events: []EventType{
+		{Name: "OwnershipTransferRequested", Discriminator: [8]byte{79, 54, 99, 123, 57, 244, 134, 35}, New: func() any { return new(RmnRemoteOwnershipTransferRequested) }},
+		{Name: "OwnershipTransferred", Discriminator: [8]byte{172, 61, 205, 183, 250, 50, 38, 98}, New: func() any { return new(RmnRemoteOwnershipTransferred) }},
+		{Name: "ConfigSet", Discriminator: [8]byte{15, 104, 59, 16, 236, 241, 8, 6}, New: func() any { return new(RmnRemoteConfigSet) }},
+		{Name: "SubjectCursed", Discriminator: [8]byte{64, 234, 236, 62, 237, 179, 9, 192}, New: func() any { return new(RmnRemoteSubjectCursed) }},
+		{Name: "SubjectUncursed", Discriminator: [8]byte{238, 50, 186, 246, 156, 119, 251, 250}, New: func() any { return new(RmnRemoteSubjectUncursed) }},
+	},
+	Errors: []ErrorType{
+		{Code: 9000, Name: "Unauthorized", Msg: "The signer is unauthorized"},
+		{Code: 9001, Name: "SubjectIsAlreadyCursed", Msg: "Subject is already cursed"},
+		{Code: 9002, Name: "SubjectWasNotCursed", Msg: "Subject was not cursed"},
+		{Code: 9003, Name: "RedundantOwnerProposal", Msg: "Proposed owner is the current owner"},
+		{Code: 9004, Name: "InvalidVersion", Msg: "Invalid version of the onchain state"},
+		{Code: 9005, Name: "SubjectCursed", Msg: "The subject is actively cursed"},
+		{Code: 9006, Name: "GloballyCursed", Msg: "This chain is globally cursed"},
+		{Code: 9007, Name: "InvalidCodeVersion", Msg: "Invalid code version"},
+	},
+}
+
+// TokenPoolBurned is the Burned event of base_token_pool
+type TokenPoolBurned struct {
+	Sender solana.PublicKey
+	Amount uint64
+	Mint   solana.PublicKey
+}
+
+// TokenPoolMinted is the Minted event of base_token_pool
+type TokenPoolMinted struct {
+	Sender    solana.PublicKey
+	Recipient solana.PublicKey
+	Amount    uint64
+	Mint      solana.PublicKey
+}
+
+// TokenPoolLocked is the Locked event of base_token_pool
+type TokenPoolLocked struct {
+	Sender solana.PublicKey
+	Amount uint64
+	Mint   solana.PublicKey
+}
+
+// TokenPoolReleased is the Released event of base_token_pool
+type TokenPoolReleased struct {
+	Sender    solana.PublicKey
+	Recipient solana.PublicKey
+	Amount    uint64
+	Mint      solana.PublicKey
+}
+
+// TokenPoolRemoteChainConfigured is the RemoteChainConfigured event of base_token_pool
+type TokenPoolRemoteChainConfigured struct {
+	ChainSelector         uint64
+	Token                 base_token_pool.RemoteAddress
+	PreviousToken         base_token_pool.RemoteAddress
+	PoolAddresses         []base_token_pool.RemoteAddress
+	PreviousPoolAddresses []base_token_pool.RemoteAddress
+	Mint                  solana.PublicKey
+}
+
+// TokenPoolRateLimitConfigured is the RateLimitConfigured event of base_token_pool
+type TokenPoolRateLimitConfigured struct {
+	ChainSelector     uint64
+	OutboundRateLimit base_token_pool.RateLimitConfig
+	InboundRateLimit  base_token_pool.RateLimitConfig
+	Mint              solana.PublicKey
+}
+
+// TokenPoolRemotePoolsAppended is the RemotePoolsAppended event of base_token_pool
+type TokenPoolRemotePoolsAppended struct {
+	ChainSelector         uint64
+	PoolAddresses         []base_token_pool.RemoteAddress
+	PreviousPoolAddresses []base_token_pool.RemoteAddress
+	Mint                  solana.PublicKey
+}
+
+// TokenPoolRemoteChainRemoved is the RemoteChainRemoved event of base_token_pool
+type TokenPoolRemoteChainRemoved struct {
+	ChainSelector uint64
+	Mint          solana.PublicKey
+}
+
+// TokenPoolRouterUpdated is the RouterUpdated event of base_token_pool
+type TokenPoolRouterUpdated struct {
+	OldRouter solana.PublicKey
+	NewRouter solana.PublicKey
+	Mint      solana.PublicKey
+}
+
+// TokenPoolOwnershipTransferRequested is the OwnershipTransferRequested event of base_token_pool
+type TokenPoolOwnershipTransferRequested struct {
+	From solana.PublicKey
+	To   solana.PublicKey
+	Mint solana.PublicKey
+}
+
+// TokenPoolOwnershipTransferred is the OwnershipTransferred event of base_token_pool
+type TokenPoolOwnershipTransferred struct {
+	From solana.PublicKey
+	To   solana.PublicKey
+	Mint solana.PublicKey
+}
+
+// TokenPoolTokensConsumed is the TokensConsumed event of base_token_pool
+type TokenPoolTokensConsumed struct {
+	Tokens uint64
+}
+
+// TokenPoolConfigChanged is the ConfigChanged event of base_token_pool
+type TokenPoolConfigChanged struct {
+	Config base_token_pool.RateLimitConfig
+}
+
+// TokenPool is the registry of the base_token_pool program
+var TokenPool = Program{
+	Name: "base_token_pool",
+	Events: []EventType{
+		{Name: "Burned", Discriminator: [8]byte{207, 37, 251, 154, 239, 229, 14, 67}, New: func() any { return new(TokenPoolBurned) }},
+		{Name: "Minted", Discriminator: [8]byte{174, 131, 21, 57, 88, 117, 114, 121}, New: func() any { return new(TokenPoolMinted) }},
+		{Name: "Locked", Discriminator: [8]byte{188, 53, 118, 62, 64, 12, 198, 84}, New: func() any { return new(TokenPoolLocked) }},
+		{Name: "Released", Discriminator: [8]byte{232, 229, 255, 136, 101, 189, 15, 220}, New: func() any { return new(TokenPoolReleased) }},
+		{Name: "RemoteChainConfigured", Discriminator: [8]byte{231, 252, 78, 228, 152, 49, 233, 226}, New: func() any { return new(TokenPoolRemoteChainConfigured) }},
+		{Name: "RateLimitConfigured", Discriminator: [8]byte{249, 210, 194, 93, 236, 75, 175, 59}, New: func() any { return new(TokenPoolRateLimitConfigured) }},
+		{Name: "RemotePoolsAppended", Discriminator: [8]byte{248, 177, 249, 167, 14, 247, 25, 223}, New: func() any { return new(TokenPoolRemotePoolsAppended) }},
+		{Name: "RemoteChainRemoved", Discriminator: [8]byte{4, 212, 235, 138, 165, 232, 75, 32}, New: func() any { return new(TokenPoolRemoteChainRemoved) }},
+		{Name: "RouterUpdated", Discriminator: [8]byte{230, 116, 235, 209, 74, 144, 208, 95}, New: func() any { return new(TokenPoolRouterUpdated) }},
+		{Name: "OwnershipTransferRequested", Discriminator: [8]byte{79, 54, 99, 123, 57, 244, 134, 35}, New: func() any { return new(TokenPoolOwnershipTransferRequested) }},
+		{Name: "OwnershipTransferred", Discriminator: [8]byte{172, 61, 205, 183, 250, 50, 38, 98}, New: func() any { return new(TokenPoolOwnershipTransferred) }},
+		{Name: "TokensConsumed", Discriminator: [8]byte{126, 8, 242, 245, 121, 78, 210, 0}, New: func() any { return new(TokenPoolTokensConsumed) }},
+		{Name: "ConfigChanged", Discriminator: [8]byte{147, 25, 86, 98, 98, 77, 78, 192}, New: func() any { return new(TokenPoolConfigChanged) }},
+	},
+	Errors: []ErrorType{
+		{Code: 6000, Name: "InvalidInitPoolPermissions", Msg: "Pool authority does not match token mint owner"},
+		{Code: 6001, Name: "InvalidRMNRemoteAddress", Msg: "Invalid RMN Remote Address"},
+		{Code: 6002, Name: "Unauthorized", Msg: "Unauthorized"},
+		{Code: 6003, Name: "InvalidInputs", Msg: "Invalid inputs"},
+		{Code: 6004, Name: "InvalidVersion", Msg: "Invalid state version"},
+		{Code: 6005, Name: "InvalidPoolCaller", Msg: "Caller is not ramp on router"},
+		{Code: 6006, Name: "InvalidSender", Msg: "Sender not allowed"},
+		{Code: 6007, Name: "InvalidSourcePoolAddress", Msg: "Invalid source pool address"},
+		{Code: 6008, Name: "InvalidToken", Msg: "Invalid token"},
+		{Code: 6009, Name: "InvalidTokenAmountConversion", Msg: "Invalid token amount conversion"},
+		{Code: 6010, Name: "AllowlistKeyAlreadyExisted", Msg: "Key already existed in the allowlist"},
+		{Code: 6011, Name: "AllowlistKeyDidNotExist", Msg: "Key did not exist in the allowlist"},
+		{Code: 6012, Name: "RemotePoolAddressAlreadyExisted", Msg: "Remote pool address already exists"},
+		{Code: 6013, Name: "NonemptyPoolAddressesInit", Msg: "Expected empty pool addresses during initialization"},
+		{Code: 6014, Name: "RLBucketOverfilled", Msg: "RateLimit: bucket overfilled"},
+		{Code: 6015, Name: "RLMaxCapacityExceeded", Msg: "RateLimit: max capacity exceeded"},
+		{Code: 6016, Name: "RLRateLimitReached", Msg: "RateLimit: rate limit reached"},
+		{Code: 6017, Name: "RLInvalidRateLimitRate", Msg: "RateLimit: invalid rate limit rate"},
+		{Code: 6018, Name: "RLDisabledNonZeroRateLimit", Msg: "RateLimit: disabled non-zero rate limit"},
+		{Code: 6019, Name: "LiquidityNotAccepted", Msg: "Liquidity not accepted"},
+	},
+}
+
+// Common is the registry of the ccip_common program
+var Common = Program{
+	Name: "ccip_common",
+	Errors: []ErrorType{
+		{Code: 10000, Name: "InvalidSequenceInterval", Msg: "The given sequence interval is invalid"},
+		{Code: 10001, Name: "InvalidInputsPoolAccounts", Msg: "Invalid pool accounts"},
+		{Code: 10002, Name: "InvalidInputsTokenAccounts", Msg: "Invalid token accounts"},
+		{Code: 10003, Name: "InvalidInputsTokenAdminRegistryAccounts", Msg: "Invalid Token Admin Registry account"},
+		{Code: 10004, Name: "InvalidInputsLookupTableAccounts", Msg: "Invalid LookupTable account"},
+		{Code: 10005, Name: "InvalidInputsLookupTableAccountWritable", Msg: "Invalid LookupTable account writable access"},
+		{Code: 10006, Name: "InvalidInputsPoolSignerAccounts", Msg: "Invalid pool signer account"},
+		{Code: 10007, Name: "InvalidChainFamilySelector", Msg: "Invalid chain family selector"},
+		{Code: 10008, Name: "InvalidEncoding", Msg: "Invalid encoding"},
+		{Code: 10009, Name: "InvalidEVMAddress", Msg: "Invalid EVM address"},
+		{Code: 10010, Name: "InvalidSVMAddress", Msg: "Invalid SVM address"},
+	},
+}
@@ -14,47 +14,78 @@ import (
 
 // mcm signer dataless pda
 func GetSignerPDA(msigID [32]byte) solana.PublicKey {
+	return FindSignerPDA(config.McmProgram, msigID)
+}
+
+func GetConfigPDA(msigID [32]byte) solana.PublicKey {
+	return FindConfigPDA(config.McmProgram, msigID)
+}
+
+func GetConfigSignersPDA(msigID [32]byte) solana.PublicKey {
+	return FindConfigSignersPDA(config.McmProgram, msigID)
+}
+
+func GetRootMetadataPDA(msigID [32]byte) solana.PublicKey {
+	return FindRootMetadataPDA(config.McmProgram, msigID)
+}
+
+func GetExpiringRootAndOpCountPDA(msigID [32]byte) solana.PublicKey {
+	return FindExpiringRootAndOpCountPDA(config.McmProgram, msigID)
+}
+
+// get address of the root_signatures pda
+func GetRootSignaturesPDA(msigID [32]byte, root [32]byte, validUntil uint32, authority solana.PublicKey) solana.PublicKey {
+	return FindRootSignaturesPDA(config.McmProgram, msigID, root, validUntil, authority)
+}
+
+// get address of the seen_signed_hashes pda
+func GetSeenSignedHashesPDA(msigID [32]byte, root [32]byte, validUntil uint32) solana.PublicKey {
+	return FindSeenSignedHashesPDA(config.McmProgram, msigID, root, validUntil)
+}
+
+// The Find*PDA functions derive the PDAs of the mcm program deployed at program, the Get*PDA functions use the test config program
+
+func FindSignerPDA(program solana.PublicKey, msigID [32]byte) solana.PublicKey {
 	pda, _, _ := solana.FindProgramAddress([][]byte{
 		[]byte("multisig_signer"),
 		msigID[:],
-	}, config.McmProgram)
+	}, program)
 	return pda
 }
 
-func GetConfigPDA(msigID [32]byte) solana.PublicKey {
+func FindConfigPDA(program solana.PublicKey, msigID [32]byte) solana.PublicKey {
 	pda, _, _ := solana.FindProgramAddress([][]byte{
 		[]byte("multisig_config"),
 		msigID[:],
-	}, config.McmProgram)
+	}, program)
 	return pda
 }
 
-func GetConfigSignersPDA(msigID [32]byte) solana.PublicKey {
+func FindConfigSignersPDA(program solana.PublicKey, msigID [32]byte) solana.PublicKey {
 	pda, _, _ := solana.FindProgramAddress([][]byte{
 		[]byte("multisig_config_signers"),
 		msigID[:],
-	}, config.McmProgram)
+	}, program)
 	return pda
 }
 
-func GetRootMetadataPDA(msigID [32]byte) solana.PublicKey {
+func FindRootMetadataPDA(program solana.PublicKey, msigID [32]byte) solana.PublicKey {
 	pda, _, _ := solana.FindProgramAddress([][]byte{
 		[]byte("root_metadata"),
 		msigID[:],
-	}, config.McmProgram)
+	}, program)
 	return pda
 }
 
-func GetExpiringRootAndOpCountPDA(msigID [32]byte) solana.PublicKey {
+func FindExpiringRootAndOpCountPDA(program solana.PublicKey, msigID [32]byte) solana.PublicKey {
 	pda, _, _ := solana.FindProgramAddress([][]byte{
 		[]byte("expiring_root_and_op_count"),
 		msigID[:],
-	}, config.McmProgram)
+	}, program)
 	return pda
 }
 
-// get address of the root_signatures pda
-func GetRootSignaturesPDA(msigID [32]byte, root [32]byte, validUntil uint32, authority solana.PublicKey) solana.PublicKey {
+func FindRootSignaturesPDA(program solana.PublicKey, msigID [32]byte, root [32]byte, validUntil uint32, authority solana.PublicKey) solana.PublicKey {
 	validUntilBytes := make([]byte, 4)
 	binary.LittleEndian.PutUint32(validUntilBytes, validUntil)
 
@@ -64,12 +95,11 @@ func GetRootSignaturesPDA(msigID [32]byte, root [32]byte, validUntil uint32, aut
 		root[:],
 		validUntilBytes,
 		authority[:],
-	}, config.McmProgram)
+	}, program)
 	return pda
 }
 
-// get address of the seen_signed_hashes pda
-func GetSeenSignedHashesPDA(msigID [32]byte, root [32]byte, validUntil uint32) solana.PublicKey {
+func FindSeenSignedHashesPDA(program solana.PublicKey, msigID [32]byte, root [32]byte, validUntil uint32) solana.PublicKey {
 	validUntilBytes := make([]byte, 4)
 	binary.LittleEndian.PutUint32(validUntilBytes, validUntil)
 	pda, _, _ := solana.FindProgramAddress([][]byte{
@@ -77,10 +107,20 @@ func GetSeenSignedHashesPDA(msigID [32]byte, root [32]byte, validUntil uint32) s
 		msigID[:],
 		root[:],
 		validUntilBytes,
-	}, config.McmProgram)
+	}, program)
 	return pda
 }
 
+// ForProgram returns the instruction sent to program. The mcm bindings send their instructions to mcm.ProgramID,
+// which is set once per process.
+func ForProgram(program solana.PublicKey, ix solana.Instruction) (solana.Instruction, error) {
+	data, err := ix.Data()
+	if err != nil {
+		return nil, err
+	}
+	return solana.NewInstruction(program, ix.Accounts(), data), nil
+}
+
 // utils for padding mcm id
 func PadString32(input string) ([32]byte, error) {
 	var result [32]byte
@@ -244,9 +284,19 @@ func GetAppendSignersIxs(signerAddresses [][20]uint8, msigID [32]byte, multisigC
 
 // instructions builder for preloading signatures
 func GetMcmPreloadSignaturesIxs(signatures []mcm.Signature, msigID [32]byte, root [32]uint8, validUntil uint32, authority solana.PublicKey, appendChunkSize int) ([]solana.Instruction, error) {
+	return FindMcmPreloadSignaturesIxs(config.McmProgram, signatures, msigID, root, validUntil, authority, appendChunkSize)
+}
+
+// get chunked append instructions to preload signatures to pda, required before set_root
+func GetAppendSignaturesIxs(signatures []mcm.Signature, msigID [32]byte, root [32]uint8, validUntil uint32, authority solana.PublicKey, chunkSize int) ([]solana.Instruction, error) {
+	return FindAppendSignaturesIxs(config.McmProgram, signatures, msigID, root, validUntil, authority, chunkSize)
+}
+
+// FindMcmPreloadSignaturesIxs builds the instructions preloading the signatures to the mcm program deployed at program
+func FindMcmPreloadSignaturesIxs(program solana.PublicKey, signatures []mcm.Signature, msigID [32]byte, root [32]uint8, validUntil uint32, authority solana.PublicKey, appendChunkSize int) ([]solana.Instruction, error) {
 	ixs := make([]solana.Instruction, 0)
 
-	signaturesPDA := GetRootSignaturesPDA(msigID, root, validUntil, authority)
+	signaturesPDA := FindRootSignaturesPDA(program, msigID, root, validUntil, authority)
 
 	initSigsIx, isErr := mcm.NewInitSignaturesInstruction(
 		msigID,
@@ -261,9 +311,13 @@ func GetMcmPreloadSignaturesIxs(signatures []mcm.Signature, msigID [32]byte, roo
 	if isErr != nil {
 		return nil, isErr
 	}
-	ixs = append(ixs, initSigsIx)
+	initSigsProgramIx, err := ForProgram(program, initSigsIx)
+	if err != nil {
+		return nil, err
+	}
+	ixs = append(ixs, initSigsProgramIx)
 
-	appendSigsIxs, asErr := GetAppendSignaturesIxs(signatures, msigID, root, validUntil, authority, appendChunkSize)
+	appendSigsIxs, asErr := FindAppendSignaturesIxs(program, signatures, msigID, root, validUntil, authority, appendChunkSize)
 	if asErr != nil {
 		return nil, asErr
 	}
@@ -280,14 +334,18 @@ func GetMcmPreloadSignaturesIxs(signatures []mcm.Signature, msigID [32]byte, roo
 	if fsErr != nil {
 		return nil, fsErr
 	}
-	ixs = append(ixs, finalizeSigsIx)
+	finalizeSigsProgramIx, err := ForProgram(program, finalizeSigsIx)
+	if err != nil {
+		return nil, err
+	}
+	ixs = append(ixs, finalizeSigsProgramIx)
 
 	return ixs, nil
 }
 
-// get chunked append instructions to preload signatures to pda, required before set_root
-func GetAppendSignaturesIxs(signatures []mcm.Signature, msigID [32]byte, root [32]uint8, validUntil uint32, authority solana.PublicKey, chunkSize int) ([]solana.Instruction, error) {
-	signaturesPDA := GetRootSignaturesPDA(msigID, root, validUntil, authority)
+// FindAppendSignaturesIxs builds the chunked instructions appending the signatures to the mcm program deployed at program
+func FindAppendSignaturesIxs(program solana.PublicKey, signatures []mcm.Signature, msigID [32]byte, root [32]uint8, validUntil uint32, authority solana.PublicKey, chunkSize int) ([]solana.Instruction, error) {
+	signaturesPDA := FindRootSignaturesPDA(program, msigID, root, validUntil, authority)
 
 	if chunkSize > config.MaxAppendSignatureBatchSize {
 		return nil, errors.New("chunkSize exceeds max signatures chunk size")
@@ -309,12 +367,18 @@ func GetAppendSignaturesIxs(signatures []mcm.Signature, msigID [32]byte, root [3
 		if appendErr != nil {
 			return nil, appendErr
 		}
-		ixs = append(ixs, appendIx)
+		appendProgramIx, err := ForProgram(program, appendIx)
+		if err != nil {
+			return nil, err
+		}
+		ixs = append(ixs, appendProgramIx)
 	}
 	return ixs, nil
 }
 
 type McmRootInput struct {
+	// ChainID is the mcm chain id of the network, the test config chain id if 0
+	ChainID              uint64
 	Multisig             solana.PublicKey
 	Operations           []McmOpNode
 	PreOpCount           uint64
@@ -333,13 +397,20 @@ type McmRootData struct {
 func CreateMcmRootData(input McmRootInput) (McmRootData, error) {
 	numOps := len(input.Operations)
 
+	chainID := input.ChainID
+	if chainID == 0 {
+		chainID = config.TestChainID
+	}
+
 	// add 1 for the root metadata node
 	nodes := make([]MerkleNode, numOps+1)
 	for i := range input.Operations {
+		input.Operations[i].ChainID = chainID
 		nodes[i] = &input.Operations[i]
 	}
 
 	rootMetadata := RootMetadataNode{
+		ChainID:              chainID,
 		Multisig:             input.Multisig,
 		PreOpCount:           input.PreOpCount,
 		PostOpCount:          input.PostOpCount,
@@ -354,7 +425,7 @@ func CreateMcmRootData(input McmRootInput) (McmRootData, error) {
 	}
 
 	metadata := mcm.RootMetadataInput{
-		ChainId:              config.TestChainID,
+		ChainId:              chainID,
 		Multisig:             rootMetadata.Multisig,
 		PreOpCount:           rootMetadata.PreOpCount,
 		PostOpCount:          rootMetadata.PostOpCount,
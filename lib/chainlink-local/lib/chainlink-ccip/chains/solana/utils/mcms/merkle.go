@@ -43,6 +43,8 @@ func (b *BaseNode) Size() int {
 
 type McmOpNode struct {
 	BaseNode
+	// ChainID is the mcm chain id of the network, the test config chain id if 0
+	ChainID           uint64
 	Nonce             uint64
 	Data              []byte
 	Multisig          solana.PublicKey // this is config PDA
@@ -54,7 +56,7 @@ func (t *McmOpNode) Buffers() [][]byte {
 	domainSeparatorHashBytes := eth.Keccak256([]byte("MANY_CHAIN_MULTI_SIG_DOMAIN_SEPARATOR_OP_SOLANA"))
 	buffers := [][]byte{
 		domainSeparatorHashBytes[:],
-		config.TestChainIDPaddedBuffer[:],
+		chainIDPaddedEncoding(t.ChainID),
 		t.Multisig.Bytes(),
 		numToU64LePaddedEncoding(t.Nonce),
 		t.To.Bytes(),
@@ -99,6 +101,8 @@ func (t *McmOpNode) Print(padding int) {
 
 type RootMetadataNode struct {
 	BaseNode
+	// ChainID is the mcm chain id of the network, the test config chain id if 0
+	ChainID              uint64
 	PreOpCount           uint64
 	PostOpCount          uint64
 	Multisig             solana.PublicKey
@@ -109,7 +113,7 @@ func (rm *RootMetadataNode) Buffers() [][]byte {
 	domainSeparatorHashBytes := eth.Keccak256([]byte("MANY_CHAIN_MULTI_SIG_DOMAIN_SEPARATOR_METADATA_SOLANA"))
 	return [][]byte{
 		domainSeparatorHashBytes[:],
-		config.TestChainIDPaddedBuffer[:],
+		chainIDPaddedEncoding(rm.ChainID),
 		rm.Multisig.Bytes(),
 		numToU64LePaddedEncoding(rm.PreOpCount),
 		numToU64LePaddedEncoding(rm.PostOpCount),
@@ -274,6 +278,13 @@ func NewOpMerkleTree(nodes []MerkleNode) (MerkleNode, error) {
 	}
 }
 
+func chainIDPaddedEncoding(chainID uint64) []byte {
+	if chainID == 0 {
+		return config.TestChainIDPaddedBuffer[:]
+	}
+	return numToU64LePaddedEncoding(chainID)
+}
+
 func numToU64LePaddedEncoding(n uint64) []byte {
 	b := make([]byte, 32)
 	binary.LittleEndian.PutUint64(b[24:], n)
@@ -0,0 +1,325 @@
+package mcms
+
+import (
+	"bytes"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+
+	"github.com/decred/dcrd/dcrec/secp256k1/v4/ecdsa"
+	"github.com/gagliardetto/solana-go"
+	"gopkg.in/yaml.v3"
+
+	"github.com/smartcontractkit/chainlink-ccip/chains/solana/contracts/tests/config"
+	"github.com/smartcontractkit/chainlink-ccip/chains/solana/gobindings/mcm"
+	"github.com/smartcontractkit/chainlink-ccip/chains/solana/utils/eth"
+)
+
+// ProposalFile is the YAML definition of a proposal: the instructions an mcm instance executes once its signers approve them
+type ProposalFile struct {
+	// MultisigID is the mcm instance id, padded to 32 bytes
+	MultisigID           string                `yaml:"multisigId" json:"multisigId"`
+	PreOpCount           uint64                `yaml:"preOpCount" json:"preOpCount"`
+	ValidUntil           uint32                `yaml:"validUntil" json:"validUntil"`
+	OverridePreviousRoot bool                  `yaml:"overridePreviousRoot" json:"overridePreviousRoot"`
+	Instructions         []ProposalInstruction `yaml:"instructions" json:"instructions"`
+}
+
+// ProposalInstruction is a Solana instruction with hex encoded data
+type ProposalInstruction struct {
+	ProgramID string            `yaml:"programId" json:"programId"`
+	Data      string            `yaml:"data" json:"data"`
+	Accounts  []ProposalAccount `yaml:"accounts" json:"accounts"`
+}
+
+type ProposalAccount struct {
+	PublicKey  string `yaml:"publicKey" json:"publicKey"`
+	IsWritable bool   `yaml:"isWritable" json:"isWritable"`
+	IsSigner   bool   `yaml:"isSigner" json:"isSigner"`
+}
+
+// Proposal is a parsed ProposalFile, with one op per instruction, for the mcm program deployed at McmProgram on the
+// network with the mcm chain id ChainID
+type Proposal struct {
+	ChainID              uint64
+	McmProgram           solana.PublicKey
+	MultisigID           [32]byte
+	PreOpCount           uint64
+	ValidUntil           uint32
+	OverridePreviousRoot bool
+	Operations           []McmOpNode
+}
+
+// ProposalSignature is the approval of a proposal root by an offline signer
+type ProposalSignature struct {
+	Root       string `json:"root"`
+	ValidUntil uint32 `json:"validUntil"`
+	Signer     string `json:"signer"`
+	// Signature is the hex encoded V, R and S of the signature over the EthMsgHash of the root
+	Signature string `json:"signature"`
+}
+
+// ProposalInstructions are the instructions that approve and execute a proposal, in order
+type ProposalInstructions struct {
+	// PreloadSignatures init, append and finalize the signatures of the root in the root signatures PDA of the authority
+	PreloadSignatures []solana.Instruction
+	SetRoot           solana.Instruction
+	Execute           []solana.Instruction
+}
+
+// ParseProposal reads and validates a YAML ProposalFile for the mcm program deployed at mcmProgram on the network with
+// the mcm chain id chainID
+func ParseProposal(r io.Reader, chainID uint64, mcmProgram solana.PublicKey) (Proposal, error) {
+	var file ProposalFile
+	decoder := yaml.NewDecoder(r)
+	decoder.KnownFields(true)
+	if err := decoder.Decode(&file); err != nil {
+		return Proposal{}, fmt.Errorf("failed to decode proposal: %w", err)
+	}
+	return file.Proposal(chainID, mcmProgram)
+}
+
+func (f ProposalFile) Proposal(chainID uint64, mcmProgram solana.PublicKey) (Proposal, error) {
+	if chainID == 0 {
+		return Proposal{}, errors.New("chain id is required")
+	}
+	if mcmProgram.IsZero() {
+		return Proposal{}, errors.New("mcm program is required")
+	}
+	msigID, err := PadString32(f.MultisigID)
+	if err != nil {
+		return Proposal{}, fmt.Errorf("invalid multisig id: %w", err)
+	}
+	if f.ValidUntil == 0 {
+		return Proposal{}, errors.New("validUntil is required")
+	}
+	if len(f.Instructions) == 0 {
+		return Proposal{}, errors.New("proposal has no instructions")
+	}
+
+	multisig := FindConfigPDA(mcmProgram, msigID)
+	signer := FindSignerPDA(mcmProgram, msigID)
+	proposal := Proposal{
+		ChainID:              chainID,
+		McmProgram:           mcmProgram,
+		MultisigID:           msigID,
+		PreOpCount:           f.PreOpCount,
+		ValidUntil:           f.ValidUntil,
+		OverridePreviousRoot: f.OverridePreviousRoot,
+	}
+	for i, instruction := range f.Instructions {
+		ix, ixErr := instruction.Instruction()
+		if ixErr != nil {
+			return Proposal{}, fmt.Errorf("instruction %d: %w", i, ixErr)
+		}
+		op, opErr := IxToMcmTestOpNode(multisig, signer, ix, f.PreOpCount+uint64(i))
+		if opErr != nil {
+			return Proposal{}, fmt.Errorf("instruction %d: %w", i, opErr)
+		}
+		op.ChainID = chainID
+		proposal.Operations = append(proposal.Operations, op)
+	}
+	return proposal, nil
+}
+
+// Instruction decodes the instruction
+func (i ProposalInstruction) Instruction() (solana.Instruction, error) {
+	programID, err := solana.PublicKeyFromBase58(i.ProgramID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid program id %q: %w", i.ProgramID, err)
+	}
+	data, err := hex.DecodeString(strings.TrimPrefix(i.Data, "0x"))
+	if err != nil {
+		return nil, fmt.Errorf("invalid data: %w", err)
+	}
+	accounts := make(solana.AccountMetaSlice, 0, len(i.Accounts))
+	for _, account := range i.Accounts {
+		key, keyErr := solana.PublicKeyFromBase58(account.PublicKey)
+		if keyErr != nil {
+			return nil, fmt.Errorf("invalid account %q: %w", account.PublicKey, keyErr)
+		}
+		accounts = append(accounts, solana.NewAccountMeta(key, account.IsWritable, account.IsSigner))
+	}
+	return solana.NewInstruction(programID, accounts, data), nil
+}
+
+// NewProposalInstruction encodes an instruction in the format of a ProposalFile
+func NewProposalInstruction(ix solana.Instruction) (ProposalInstruction, error) {
+	data, err := ix.Data()
+	if err != nil {
+		return ProposalInstruction{}, err
+	}
+	instruction := ProposalInstruction{
+		ProgramID: ix.ProgramID().String(),
+		Data:      hex.EncodeToString(data),
+	}
+	for _, account := range ix.Accounts() {
+		instruction.Accounts = append(instruction.Accounts, ProposalAccount{
+			PublicKey:  account.PublicKey.String(),
+			IsWritable: account.IsWritable,
+			IsSigner:   account.IsSigner,
+		})
+	}
+	return instruction, nil
+}
+
+// RootData builds the merkle tree of the proposal, after which the proofs of its operations are available
+func (p *Proposal) RootData() (McmRootData, error) {
+	return CreateMcmRootData(McmRootInput{
+		ChainID:              p.ChainID,
+		Multisig:             FindConfigPDA(p.McmProgram, p.MultisigID),
+		Operations:           p.Operations,
+		PreOpCount:           p.PreOpCount,
+		PostOpCount:          p.PreOpCount + uint64(len(p.Operations)),
+		ValidUntil:           p.ValidUntil,
+		OverridePreviousRoot: p.OverridePreviousRoot,
+	})
+}
+
+// Sign approves the root of the proposal with an offline signer
+func (p *Proposal) Sign(signer eth.Signer) (ProposalSignature, error) {
+	rootData, err := p.RootData()
+	if err != nil {
+		return ProposalSignature{}, err
+	}
+	signature, err := signer.Sign(rootData.EthMsgHash)
+	if err != nil {
+		return ProposalSignature{}, err
+	}
+	return ProposalSignature{
+		Root:       hex.EncodeToString(rootData.Root[:]),
+		ValidUntil: p.ValidUntil,
+		Signer:     signer.String(),
+		Signature:  hex.EncodeToString(append([]byte{signature.V}, append(signature.R[:], signature.S[:]...)...)),
+	}, nil
+}
+
+// Instructions verifies the signatures against the proposal root and builds the instructions approving and executing it,
+// sent by authority
+func (p *Proposal) Instructions(signatures []ProposalSignature, authority solana.PublicKey) (ProposalInstructions, error) {
+	rootData, err := p.RootData()
+	if err != nil {
+		return ProposalInstructions{}, err
+	}
+	sigs, err := SortSignatures(rootData, p.ValidUntil, signatures)
+	if err != nil {
+		return ProposalInstructions{}, err
+	}
+
+	preloadIxs, err := FindMcmPreloadSignaturesIxs(p.McmProgram, sigs, p.MultisigID, rootData.Root, p.ValidUntil, authority, config.MaxAppendSignatureBatchSize)
+	if err != nil {
+		return ProposalInstructions{}, err
+	}
+
+	multisig := FindConfigPDA(p.McmProgram, p.MultisigID)
+	rootMetadata := FindRootMetadataPDA(p.McmProgram, p.MultisigID)
+	expiringRootAndOpCount := FindExpiringRootAndOpCountPDA(p.McmProgram, p.MultisigID)
+	setRootBindingIx, err := mcm.NewSetRootInstruction(
+		p.MultisigID,
+		rootData.Root,
+		p.ValidUntil,
+		rootData.Metadata,
+		rootData.MetadataProof,
+		FindRootSignaturesPDA(p.McmProgram, p.MultisigID, rootData.Root, p.ValidUntil, authority),
+		rootMetadata,
+		FindSeenSignedHashesPDA(p.McmProgram, p.MultisigID, rootData.Root, p.ValidUntil),
+		expiringRootAndOpCount,
+		multisig,
+		authority,
+		solana.SystemProgramID,
+	).ValidateAndBuild()
+	if err != nil {
+		return ProposalInstructions{}, err
+	}
+	setRootIx, err := ForProgram(p.McmProgram, setRootBindingIx)
+	if err != nil {
+		return ProposalInstructions{}, err
+	}
+
+	ixs := ProposalInstructions{PreloadSignatures: preloadIxs, SetRoot: setRootIx}
+	for _, op := range p.Operations {
+		proofs, proofsErr := op.Proofs()
+		if proofsErr != nil {
+			return ProposalInstructions{}, proofsErr
+		}
+		ix := mcm.NewExecuteInstruction(
+			p.MultisigID,
+			p.ChainID,
+			op.Nonce,
+			op.Data,
+			proofs,
+			multisig,
+			rootMetadata,
+			expiringRootAndOpCount,
+			op.To,
+			FindSignerPDA(p.McmProgram, p.MultisigID),
+			authority,
+		)
+		ix.AccountMetaSlice = append(ix.AccountMetaSlice, op.RemainingAccounts...)
+		executeBindingIx, executeErr := ix.ValidateAndBuild()
+		if executeErr != nil {
+			return ProposalInstructions{}, fmt.Errorf("op %d: %w", op.Nonce, executeErr)
+		}
+		executeIx, executeErr := ForProgram(p.McmProgram, executeBindingIx)
+		if executeErr != nil {
+			return ProposalInstructions{}, fmt.Errorf("op %d: %w", op.Nonce, executeErr)
+		}
+		ixs.Execute = append(ixs.Execute, executeIx)
+	}
+	return ixs, nil
+}
+
+// SortSignatures decodes the signatures of a root and sorts them by signer address, as required by set_root
+func SortSignatures(rootData McmRootData, validUntil uint32, signatures []ProposalSignature) ([]mcm.Signature, error) {
+	root := hex.EncodeToString(rootData.Root[:])
+	type signed struct {
+		signer    [20]byte
+		signature mcm.Signature
+	}
+	sigs := make([]signed, 0, len(signatures))
+	for _, s := range signatures {
+		if strings.TrimPrefix(s.Root, "0x") != root || s.ValidUntil != validUntil {
+			return nil, fmt.Errorf("signature of %s is for root %s valid until %d, expected root %s valid until %d", s.Signer, s.Root, s.ValidUntil, root, validUntil)
+		}
+		raw, err := hex.DecodeString(strings.TrimPrefix(s.Signature, "0x"))
+		if err != nil || len(raw) != 65 {
+			return nil, fmt.Errorf("signature of %s must be 65 hex encoded bytes", s.Signer)
+		}
+		signature := mcm.Signature{V: raw[0], R: [32]byte(raw[1:33]), S: [32]byte(raw[33:65])}
+		signer, err := RecoverSigner(rootData.EthMsgHash, signature)
+		if err != nil {
+			return nil, fmt.Errorf("signature of %s: %w", s.Signer, err)
+		}
+		if s.Signer != "" && !strings.EqualFold(s.Signer, "0x"+hex.EncodeToString(signer[:])) {
+			return nil, fmt.Errorf("signature of %s was signed by 0x%x", s.Signer, signer)
+		}
+		sigs = append(sigs, signed{signer: signer, signature: signature})
+	}
+
+	sort.Slice(sigs, func(i, j int) bool {
+		return bytes.Compare(sigs[i].signer[:], sigs[j].signer[:]) < 0
+	})
+	result := make([]mcm.Signature, 0, len(sigs))
+	for i, s := range sigs {
+		if i > 0 && sigs[i-1].signer == s.signer {
+			return nil, fmt.Errorf("duplicate signature of 0x%x", s.signer)
+		}
+		result = append(result, s.signature)
+	}
+	return result, nil
+}
+
+// RecoverSigner returns the address that signed the hash
+func RecoverSigner(hash []byte, signature mcm.Signature) ([20]byte, error) {
+	compact := append([]byte{signature.V}, append(signature.R[:], signature.S[:]...)...)
+	publicKey, _, err := ecdsa.RecoverCompact(compact, hash)
+	if err != nil {
+		return [20]byte{}, err
+	}
+	var address [20]byte
+	copy(address[:], eth.Keccak256(publicKey.SerializeUncompressed()[1:])[12:])
+	return address, nil
+}
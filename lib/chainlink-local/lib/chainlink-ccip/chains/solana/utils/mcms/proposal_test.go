@@ -0,0 +1,191 @@
+package mcms
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/gagliardetto/solana-go"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink-ccip/chains/solana/contracts/tests/config"
+	"github.com/smartcontractkit/chainlink-ccip/chains/solana/utils/eth"
+)
+
+// testMcmProgram is the localnet mcm program of contracts/Anchor.toml
+var testMcmProgram = solana.MustPublicKeyFromBase58("5vNJx78mz7KVMjhuipyr9jKBKcMrKYGdjGkgE4LUmjKk")
+
+const testProposal = `
+multisigId: test-mcm
+preOpCount: 4
+validUntil: 1767225600
+instructions:
+  - programId: 11111111111111111111111111111111
+    data: 0x0200000040420f0000000000
+    accounts:
+      - publicKey: %SIGNER%
+        isWritable: true
+        isSigner: true
+      - publicKey: 4NHHyvYsAZFbfGd2Lx7rLZsPJtsZt3rW9aYprpPyn8zy
+        isWritable: true
+  - programId: 4NHHyvYsAZFbfGd2Lx7rLZsPJtsZt3rW9aYprpPyn8zy
+    data: ""
+`
+
+func parseTestProposal(t *testing.T) Proposal {
+	return parseChainProposal(t, config.TestChainID, testMcmProgram)
+}
+
+func parseChainProposal(t *testing.T, chainID uint64, mcmProgram solana.PublicKey) Proposal {
+	yaml := strings.ReplaceAll(testProposal, "%SIGNER%", FindSignerPDA(mcmProgram, config.TestMsigID).String())
+	proposal, err := ParseProposal(strings.NewReader(yaml), chainID, mcmProgram)
+	require.NoError(t, err)
+	return proposal
+}
+
+func TestProposal(t *testing.T) {
+	t.Parallel()
+
+	t.Run("parse", func(t *testing.T) {
+		t.Parallel()
+		proposal := parseTestProposal(t)
+		require.Equal(t, config.TestMsigID, proposal.MultisigID)
+		require.Len(t, proposal.Operations, 2)
+		require.Equal(t, uint64(4), proposal.Operations[0].Nonce)
+		require.Equal(t, uint64(5), proposal.Operations[1].Nonce)
+		require.Equal(t, solana.SystemProgramID, proposal.Operations[0].To)
+		// the multisig signer PDA signs through the mcm program
+		require.False(t, proposal.Operations[0].RemainingAccounts[0].IsSigner)
+		require.True(t, proposal.Operations[0].RemainingAccounts[0].IsWritable)
+
+		_, err := ParseProposal(strings.NewReader("multisigId: test-mcm\nvalidUntil: 1\n"), config.TestChainID, testMcmProgram)
+		require.ErrorContains(t, err, "no instructions")
+		_, err = ParseProposal(strings.NewReader("multisigId: test-mcm\nunknown: 1\n"), config.TestChainID, testMcmProgram)
+		require.Error(t, err)
+		_, err = ParseProposal(strings.NewReader("multisigId: test-mcm\nvalidUntil: 1\n"), 0, testMcmProgram)
+		require.ErrorContains(t, err, "chain id is required")
+		_, err = ParseProposal(strings.NewReader("multisigId: test-mcm\nvalidUntil: 1\n"), config.TestChainID, solana.PublicKey{})
+		require.ErrorContains(t, err, "mcm program is required")
+	})
+
+	t.Run("instruction round trip", func(t *testing.T) {
+		t.Parallel()
+		ix := solana.NewInstruction(solana.SystemProgramID, solana.AccountMetaSlice{solana.Meta(testMcmProgram).WRITE()}, []byte{1, 2, 3})
+		encoded, err := NewProposalInstruction(ix)
+		require.NoError(t, err)
+		require.Equal(t, "010203", encoded.Data)
+		decoded, err := encoded.Instruction()
+		require.NoError(t, err)
+		require.Equal(t, ix.ProgramID(), decoded.ProgramID())
+		require.Equal(t, ix.Accounts(), decoded.Accounts())
+	})
+
+	t.Run("sign and build instructions", func(t *testing.T) {
+		t.Parallel()
+		proposal := parseTestProposal(t)
+		rootData, err := proposal.RootData()
+		require.NoError(t, err)
+
+		signers, err := eth.GetEvmSigners(config.SignerPrivateKeys)
+		require.NoError(t, err)
+		// signatures are collected in any order
+		signatures := make([]ProposalSignature, 0, len(signers))
+		for i := len(signers) - 1; i >= 0; i-- {
+			signature, signErr := proposal.Sign(signers[i])
+			require.NoError(t, signErr)
+			require.Equal(t, signers[i].String(), signature.Signer)
+			signatures = append(signatures, signature)
+		}
+
+		sorted, err := SortSignatures(rootData, proposal.ValidUntil, signatures)
+		require.NoError(t, err)
+		require.Len(t, sorted, len(signers))
+		for i, signature := range sorted {
+			signer, recoverErr := RecoverSigner(rootData.EthMsgHash, signature)
+			require.NoError(t, recoverErr)
+			require.Equal(t, signers[i].Address, signer)
+		}
+
+		authority := solana.NewWallet().PublicKey()
+		ixs, err := proposal.Instructions(signatures, authority)
+		require.NoError(t, err)
+		// init, one append per 13 signatures and finalize
+		require.Len(t, ixs.PreloadSignatures, 3)
+		require.NotNil(t, ixs.SetRoot)
+		require.Len(t, ixs.Execute, 2)
+		for _, ix := range append(append(ixs.PreloadSignatures, ixs.SetRoot), ixs.Execute...) {
+			require.Equal(t, testMcmProgram, ix.ProgramID())
+		}
+		for i, ix := range ixs.Execute {
+			op := proposal.Operations[i]
+			// the op accounts follow the fixed execute accounts
+			accounts := ix.Accounts()
+			require.Equal(t, op.RemainingAccounts, accounts[len(accounts)-len(op.RemainingAccounts):])
+		}
+
+		_, err = SortSignatures(rootData, proposal.ValidUntil, append(signatures, signatures[0]))
+		require.ErrorContains(t, err, "duplicate")
+		_, err = SortSignatures(rootData, proposal.ValidUntil+1, signatures)
+		require.ErrorContains(t, err, "expected root")
+
+		tampered := signatures[0]
+		tampered.Signer = signatures[1].Signer
+		_, err = SortSignatures(rootData, proposal.ValidUntil, []ProposalSignature{tampered})
+		require.ErrorContains(t, err, "was signed by")
+	})
+
+	t.Run("root changes with the instructions", func(t *testing.T) {
+		t.Parallel()
+		a, b := parseTestProposal(t), parseTestProposal(t)
+		b.Operations[1].Data = []byte{1}
+		rootA, err := a.RootData()
+		require.NoError(t, err)
+		rootB, err := b.RootData()
+		require.NoError(t, err)
+		require.False(t, bytes.Equal(rootA.Root[:], rootB.Root[:]))
+	})
+
+	t.Run("chain id and mcm program", func(t *testing.T) {
+		t.Parallel()
+		test := parseTestProposal(t)
+		testRoot, err := test.RootData()
+		require.NoError(t, err)
+		// the test config chain id is the default of the merkle nodes
+		defaultRoot, err := CreateMcmRootData(McmRootInput{
+			Multisig:             FindConfigPDA(testMcmProgram, config.TestMsigID),
+			Operations:           parseTestProposal(t).Operations,
+			PreOpCount:           test.PreOpCount,
+			PostOpCount:          test.PreOpCount + uint64(len(test.Operations)),
+			ValidUntil:           test.ValidUntil,
+			OverridePreviousRoot: test.OverridePreviousRoot,
+		})
+		require.NoError(t, err)
+		require.Equal(t, testRoot.Root, defaultRoot.Root)
+
+		otherChain := parseChainProposal(t, config.TestChainID+1, testMcmProgram)
+		otherChainRoot, err := otherChain.RootData()
+		require.NoError(t, err)
+		require.NotEqual(t, testRoot.Root, otherChainRoot.Root)
+		require.Equal(t, config.TestChainID+1, otherChainRoot.Metadata.ChainId)
+
+		program := solana.NewWallet().PublicKey()
+		otherProgram := parseChainProposal(t, config.TestChainID, program)
+		otherProgramRoot, err := otherProgram.RootData()
+		require.NoError(t, err)
+		require.NotEqual(t, testRoot.Root, otherProgramRoot.Root)
+		require.Equal(t, FindConfigPDA(program, config.TestMsigID), otherProgramRoot.Metadata.Multisig)
+		require.NotEqual(t, FindConfigPDA(testMcmProgram, config.TestMsigID), otherProgramRoot.Metadata.Multisig)
+
+		signers, err := eth.GetEvmSigners(config.SignerPrivateKeys)
+		require.NoError(t, err)
+		signature, err := otherProgram.Sign(signers[0])
+		require.NoError(t, err)
+		authority := solana.NewWallet().PublicKey()
+		ixs, err := otherProgram.Instructions([]ProposalSignature{signature}, authority)
+		require.NoError(t, err)
+		for _, ix := range append(append(ixs.PreloadSignatures, ixs.SetRoot), ixs.Execute...) {
+			require.Equal(t, program, ix.ProgramID())
+		}
+		require.Equal(t, FindRootSignaturesPDA(program, config.TestMsigID, otherProgramRoot.Root, otherProgram.ValidUntil, authority), ixs.SetRoot.Accounts()[0].PublicKey)
+	})
+}
@@ -18,6 +18,7 @@ import (
 	"github.com/smartcontractkit/chainlink-ccip/commit/internal/builder"
 	"github.com/smartcontractkit/chainlink-ccip/commit/merkleroot/rmn"
 	"github.com/smartcontractkit/chainlink-ccip/commit/metrics"
+	"github.com/smartcontractkit/chainlink-ccip/internal/plugincommon"
 	"github.com/smartcontractkit/chainlink-ccip/internal/plugintypes"
 	"github.com/smartcontractkit/chainlink-ccip/internal/reader"
 	"github.com/smartcontractkit/chainlink-ccip/pkg/consts"
@@ -226,7 +227,7 @@ func (p *PluginFactory) NewReportingPlugin(ctx context.Context, config ocr3types
 		return nil, ocr3types.ReportingPluginInfo{}, fmt.Errorf("failed to create report builder: %w", err)
 	}
 
-	return NewPlugin(
+	return plugincommon.NewTracedPlugin(NewPlugin(
 			p.donID,
 			oracleIDToP2PID,
 			offchainConfig,
@@ -244,7 +245,7 @@ func (p *PluginFactory) NewReportingPlugin(ctx context.Context, config ocr3types
 			metricsReporter,
 			p.addrCodec,
 			reportBuilder,
-		), ocr3types.ReportingPluginInfo{
+		), "Commit", p.donID, config.OracleID), ocr3types.ReportingPluginInfo{
 			Name: "CCIPRoleCommit",
 			Limits: ocr3types.ReportingPluginLimits{
 				MaxQueryLength:       maxQueryLength,
@@ -26,6 +26,7 @@ import (
 	"github.com/smartcontractkit/chainlink-ccip/commit/merkleroot/rmn"
 	"github.com/smartcontractkit/chainlink-ccip/commit/tokenprice"
 	"github.com/smartcontractkit/chainlink-ccip/internal/mocks"
+	"github.com/smartcontractkit/chainlink-ccip/internal/plugincommon"
 	dt "github.com/smartcontractkit/chainlink-ccip/internal/plugincommon/discovery/discoverytypes"
 	"github.com/smartcontractkit/chainlink-ccip/internal/plugintypes"
 	reader2 "github.com/smartcontractkit/chainlink-ccip/internal/reader"
@@ -326,7 +327,9 @@ func TestPluginFactory_NewReportingPlugin(t *testing.T) {
 		})
 		require.NoError(t, err)
 
-		pluginCommit, is := plugin.(*Plugin)
+		tracedPlugin, is := plugin.(*plugincommon.TracedPlugin)
+		require.True(t, is)
+		pluginCommit, is := tracedPlugin.ReportingPlugin.(*Plugin)
 		require.True(t, is)
 		pluginOffchainConfig := pluginCommit.offchainCfg
 
@@ -0,0 +1,279 @@
+package ratelimit
+
+import (
+	"math/big"
+	"sync"
+	"time"
+
+	"github.com/smartcontractkit/chainlink-ccip/pkg/reader"
+	cciptypes "github.com/smartcontractkit/chainlink-ccip/pkg/types/ccipocr3"
+)
+
+// usdDecimals is the scale of the token prices and of the aggregate rate limiter bucket.
+var usdDecimals = big.NewInt(1e18)
+
+// bucket is the remaining inbound capacity of a rate limiter while an observation is being built.
+type bucket struct {
+	available *big.Int
+	capacity  *big.Int
+	rate      *big.Int
+}
+
+func newBucket(tokenBucket reader.TokenBucket) (*bucket, bool) {
+	if !tokenBucket.IsEnabled || tokenBucket.Tokens == nil || tokenBucket.Capacity == nil || tokenBucket.Rate == nil {
+		return nil, false
+	}
+	return &bucket{
+		available: new(big.Int).Set(tokenBucket.Tokens),
+		capacity:  new(big.Int).Set(tokenBucket.Capacity),
+		rate:      new(big.Int).Set(tokenBucket.Rate),
+	}, true
+}
+
+// refillDelay returns how long the bucket has to refill before the amount fits, see Limits.RefillDelay.
+func (b *bucket) refillDelay(amount *big.Int) (time.Duration, bool) {
+	if amount.Cmp(b.available) <= 0 {
+		return 0, true
+	}
+	if amount.Cmp(b.capacity) > 0 || b.rate.Sign() <= 0 {
+		return 0, false
+	}
+	// ceil((amount - available) / rate) seconds
+	missing := new(big.Int).Sub(amount, b.available)
+	seconds, remainder := new(big.Int).QuoRem(missing, b.rate, new(big.Int))
+	if remainder.Sign() > 0 {
+		seconds.Add(seconds, big.NewInt(1))
+	}
+	return time.Duration(seconds.Int64()) * time.Second, true
+}
+
+// Limits tracks the inbound rate limiters of the destination chain that the messages of one source chain go
+// through: the pools of the destination tokens, keyed by the destination token address of the messages, and the
+// MultiAggregateRateLimiter of the offramp. Tokens without an enabled rate limiter are not limited.
+//
+// The pool buckets are compared with the message amounts, which are in source token units. This is exact for pools
+// with the same decimals on both chains, which is the common case.
+type Limits struct {
+	// tracked are the tokens whose limits were read.
+	tracked   map[string]struct{}
+	pools     map[string]*bucket
+	aggregate *bucket
+	prices    map[string]*big.Int
+}
+
+func NewLimits() *Limits {
+	return &Limits{
+		tracked: make(map[string]struct{}),
+		pools:   make(map[string]*bucket),
+		prices:  make(map[string]*big.Int),
+	}
+}
+
+// Untracked returns the destination tokens of the messages whose limits were not read yet.
+func (l *Limits) Untracked(msgs []cciptypes.Message) []cciptypes.UnknownAddress {
+	var tokens []cciptypes.UnknownAddress
+	seen := make(map[string]struct{})
+	for _, msg := range msgs {
+		for _, tokenAmount := range msg.TokenAmounts {
+			key := tokenAmount.DestTokenAddress.String()
+			if _, ok := l.tracked[key]; ok {
+				continue
+			}
+			if _, ok := seen[key]; ok {
+				continue
+			}
+			seen[key] = struct{}{}
+			tokens = append(tokens, tokenAmount.DestTokenAddress)
+		}
+	}
+	return tokens
+}
+
+// Add adds the limits read for the provided tokens. The capacity already consumed from the tracked buckets is kept,
+// the aggregate bucket is only set by the first read that returns it.
+func (l *Limits) Add(tokens []cciptypes.UnknownAddress, limits reader.InboundRateLimits) {
+	for _, token := range tokens {
+		key := token.String()
+		if _, ok := l.tracked[key]; ok {
+			continue
+		}
+		l.tracked[key] = struct{}{}
+		if b, ok := newBucket(limits.TokenPools[key]); ok {
+			l.pools[key] = b
+		}
+		if price, ok := limits.AggregateTokenPrices[key]; ok && price != nil {
+			l.prices[key] = new(big.Int).Set(price)
+		}
+	}
+	if l.aggregate == nil && limits.Aggregate != nil {
+		if b, ok := newBucket(*limits.Aggregate); ok {
+			l.aggregate = b
+		}
+	}
+}
+
+// Amounts sums the token amounts of the message per destination token.
+func Amounts(msg cciptypes.Message) map[string]*big.Int {
+	amounts := make(map[string]*big.Int, len(msg.TokenAmounts))
+	for _, tokenAmount := range msg.TokenAmounts {
+		if tokenAmount.Amount.Int == nil {
+			continue
+		}
+		key := tokenAmount.DestTokenAddress.String()
+		if _, ok := amounts[key]; !ok {
+			amounts[key] = big.NewInt(0)
+		}
+		amounts[key].Add(amounts[key], tokenAmount.Amount.Int)
+	}
+	return amounts
+}
+
+// RefillDelay returns how long the message has to wait for the rate limiters to refill before it can be executed.
+// A zero delay means that the amounts fit the current capacity. When a capacity is lower than the amounts, or the
+// rate limiter doesn't refill, the message can't be executed until the rate limiter config changes and canRefill is
+// false.
+func (l *Limits) RefillDelay(amounts map[string]*big.Int) (delay time.Duration, canRefill bool) {
+	canRefill = true
+	for token, amount := range amounts {
+		b, ok := l.pools[token]
+		if !ok {
+			continue
+		}
+		tokenDelay, ok := b.refillDelay(amount)
+		if !ok {
+			canRefill = false
+			continue
+		}
+		delay = max(delay, tokenDelay)
+	}
+
+	if l.aggregate != nil {
+		aggregateDelay, ok := l.aggregate.refillDelay(l.aggregateValue(amounts))
+		if !ok {
+			canRefill = false
+		}
+		delay = max(delay, aggregateDelay)
+	}
+
+	if !canRefill {
+		return 0, false
+	}
+	return delay, true
+}
+
+// Consume subtracts the amounts from the rate limiters.
+func (l *Limits) Consume(amounts map[string]*big.Int) {
+	for token, amount := range amounts {
+		if b, ok := l.pools[token]; ok {
+			b.available.Sub(b.available, amount)
+		}
+	}
+	if l.aggregate != nil {
+		l.aggregate.available.Sub(l.aggregate.available, l.aggregateValue(amounts))
+	}
+}
+
+// aggregateValue returns the USD value of the amounts counted by the aggregate rate limiter.
+func (l *Limits) aggregateValue(amounts map[string]*big.Int) *big.Int {
+	value := big.NewInt(0)
+	for token, amount := range amounts {
+		price, ok := l.prices[token]
+		if !ok {
+			continue
+		}
+		tokenValue := new(big.Int).Mul(amount, price)
+		value.Add(value, tokenValue.Div(tokenValue, usdDecimals))
+	}
+	return value
+}
+
+type deferralKey struct {
+	sourceChain cciptypes.ChainSelector
+	messageID   cciptypes.Bytes32
+}
+
+type deferral struct {
+	until   time.Time
+	amounts map[string]*big.Int
+}
+
+// Deferrals holds the messages deferred because of the inbound rate limiters, they are not observed for execution
+// until the predicted refill time.
+// It provides a thread-safe access as it is called from multiple goroutines.
+type Deferrals struct {
+	locker    sync.RWMutex
+	deferrals map[deferralKey]deferral
+	// tokens keeps every token that had deferred volume, so that the volume can be reported as zero once the
+	// deferrals expire.
+	tokens map[cciptypes.ChainSelector]map[string]struct{}
+}
+
+func NewDeferrals() *Deferrals {
+	return &Deferrals{
+		locker:    sync.RWMutex{},
+		deferrals: make(map[deferralKey]deferral),
+		tokens:    make(map[cciptypes.ChainSelector]map[string]struct{}),
+	}
+}
+
+// IsDeferred returns whether the message is deferred at the provided time, and until when.
+func (d *Deferrals) IsDeferred(
+	sourceChain cciptypes.ChainSelector, messageID cciptypes.Bytes32, now time.Time,
+) (time.Time, bool) {
+	d.locker.RLock()
+	defer d.locker.RUnlock()
+
+	deferred, ok := d.deferrals[deferralKey{sourceChain: sourceChain, messageID: messageID}]
+	if !ok || !now.Before(deferred.until) {
+		return time.Time{}, false
+	}
+	return deferred.until, true
+}
+
+// Defer defers the message and its amounts until the provided time.
+func (d *Deferrals) Defer(
+	sourceChain cciptypes.ChainSelector, messageID cciptypes.Bytes32, until time.Time, amounts map[string]*big.Int,
+) {
+	d.locker.Lock()
+	defer d.locker.Unlock()
+
+	d.deferrals[deferralKey{sourceChain: sourceChain, messageID: messageID}] = deferral{until: until, amounts: amounts}
+	if _, ok := d.tokens[sourceChain]; !ok {
+		d.tokens[sourceChain] = make(map[string]struct{})
+	}
+	for token := range amounts {
+		d.tokens[sourceChain][token] = struct{}{}
+	}
+}
+
+// Expire removes the deferrals that ended at the provided time.
+func (d *Deferrals) Expire(now time.Time) {
+	d.locker.Lock()
+	defer d.locker.Unlock()
+
+	for key, deferred := range d.deferrals {
+		if !now.Before(deferred.until) {
+			delete(d.deferrals, key)
+		}
+	}
+}
+
+// Volume returns the total deferred amount per source chain and destination token.
+func (d *Deferrals) Volume() map[cciptypes.ChainSelector]map[string]*big.Int {
+	d.locker.RLock()
+	defer d.locker.RUnlock()
+
+	volume := make(map[cciptypes.ChainSelector]map[string]*big.Int, len(d.tokens))
+	for sourceChain, tokens := range d.tokens {
+		volume[sourceChain] = make(map[string]*big.Int, len(tokens))
+		for token := range tokens {
+			volume[sourceChain][token] = big.NewInt(0)
+		}
+	}
+	for key, deferred := range d.deferrals {
+		for token, amount := range deferred.amounts {
+			volume[key.sourceChain][token].Add(volume[key.sourceChain][token], amount)
+		}
+	}
+	return volume
+}
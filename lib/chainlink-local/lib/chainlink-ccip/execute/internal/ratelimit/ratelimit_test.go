@@ -0,0 +1,132 @@
+package ratelimit
+
+import (
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink-ccip/pkg/reader"
+	cciptypes "github.com/smartcontractkit/chainlink-ccip/pkg/types/ccipocr3"
+)
+
+var (
+	tokenA = cciptypes.UnknownAddress{0x1}
+	tokenB = cciptypes.UnknownAddress{0x2}
+	tokenC = cciptypes.UnknownAddress{0x3}
+)
+
+func message(amounts map[string]int64, tokens ...cciptypes.UnknownAddress) cciptypes.Message {
+	msg := cciptypes.Message{}
+	for _, token := range tokens {
+		msg.TokenAmounts = append(msg.TokenAmounts, cciptypes.RampTokenAmount{
+			DestTokenAddress: token,
+			Amount:           cciptypes.NewBigIntFromInt64(amounts[token.String()]),
+		})
+	}
+	return msg
+}
+
+func enabledBucket(tokens, capacity, rate int64) reader.TokenBucket {
+	return reader.TokenBucket{
+		Tokens:    big.NewInt(tokens),
+		IsEnabled: true,
+		Capacity:  big.NewInt(capacity),
+		Rate:      big.NewInt(rate),
+	}
+}
+
+func TestLimits(t *testing.T) {
+	limits := NewLimits()
+	msg := message(map[string]int64{tokenA.String(): 30, tokenB.String(): 4}, tokenA, tokenB, tokenA)
+
+	untracked := limits.Untracked([]cciptypes.Message{msg})
+	assert.Equal(t, []cciptypes.UnknownAddress{tokenA, tokenB}, untracked)
+
+	aggregate := enabledBucket(100, 200, 1)
+	limits.Add(untracked, reader.InboundRateLimits{
+		TokenPools: map[string]reader.TokenBucket{
+			tokenA.String(): enabledBucket(50, 100, 2),
+			tokenB.String(): {IsEnabled: false},
+		},
+		Aggregate:            &aggregate,
+		AggregateTokenPrices: map[string]*big.Int{tokenB.String(): big.NewInt(2e18)},
+	})
+	assert.Empty(t, limits.Untracked([]cciptypes.Message{msg}))
+
+	amounts := Amounts(msg)
+	assert.Equal(t, map[string]*big.Int{tokenA.String(): big.NewInt(60), tokenB.String(): big.NewInt(4)}, amounts)
+
+	// 60 tokenA out of 50 in the pool, a value of 8 out of 100 in the aggregate bucket
+	delay, canRefill := limits.RefillDelay(amounts)
+	require.True(t, canRefill)
+	assert.Equal(t, 5*time.Second, delay)
+
+	limits.Consume(map[string]*big.Int{tokenA.String(): big.NewInt(50), tokenB.String(): big.NewInt(45)})
+	delay, canRefill = limits.RefillDelay(map[string]*big.Int{tokenB.String(): big.NewInt(10)})
+	require.True(t, canRefill)
+	assert.Equal(t, 10*time.Second, delay, "a value of 20 for 10 left, refilled at 1 per second")
+
+	// a later read of new tokens keeps the consumed capacity
+	untracked = limits.Untracked([]cciptypes.Message{message(nil, tokenA, tokenC)})
+	assert.Equal(t, []cciptypes.UnknownAddress{tokenC}, untracked)
+	limits.Add(untracked, reader.InboundRateLimits{
+		TokenPools: map[string]reader.TokenBucket{
+			tokenA.String(): enabledBucket(100, 100, 2),
+			tokenC.String(): enabledBucket(10, 10, 0),
+		},
+		Aggregate: &aggregate,
+	})
+	delay, canRefill = limits.RefillDelay(map[string]*big.Int{tokenA.String(): big.NewInt(1)})
+	require.True(t, canRefill)
+	assert.Equal(t, time.Second, delay)
+
+	t.Run("can't refill", func(t *testing.T) {
+		_, canRefill := limits.RefillDelay(map[string]*big.Int{tokenA.String(): big.NewInt(101)})
+		assert.False(t, canRefill, "above the pool capacity")
+
+		_, canRefill = limits.RefillDelay(map[string]*big.Int{tokenC.String(): big.NewInt(11)})
+		assert.False(t, canRefill, "pool doesn't refill")
+
+		_, canRefill = limits.RefillDelay(map[string]*big.Int{tokenB.String(): big.NewInt(101)})
+		assert.False(t, canRefill, "above the aggregate capacity")
+	})
+}
+
+func TestDeferrals(t *testing.T) {
+	const sourceChain = cciptypes.ChainSelector(1)
+	now := time.Now()
+	id1, id2 := cciptypes.Bytes32{0x1}, cciptypes.Bytes32{0x2}
+
+	deferrals := NewDeferrals()
+	deferrals.Defer(sourceChain, id1, now.Add(time.Minute), map[string]*big.Int{tokenA.String(): big.NewInt(10)})
+	deferrals.Defer(sourceChain, id2, now.Add(time.Hour), map[string]*big.Int{
+		tokenA.String(): big.NewInt(5),
+		tokenB.String(): big.NewInt(1),
+	})
+
+	until, deferred := deferrals.IsDeferred(sourceChain, id1, now)
+	require.True(t, deferred)
+	assert.Equal(t, now.Add(time.Minute), until)
+	_, deferred = deferrals.IsDeferred(sourceChain+1, id1, now)
+	assert.False(t, deferred)
+	_, deferred = deferrals.IsDeferred(sourceChain, id1, now.Add(time.Minute))
+	assert.False(t, deferred)
+
+	assert.Equal(t, map[cciptypes.ChainSelector]map[string]*big.Int{
+		sourceChain: {tokenA.String(): big.NewInt(15), tokenB.String(): big.NewInt(1)},
+	}, deferrals.Volume())
+
+	deferrals.Expire(now.Add(time.Minute))
+	assert.Equal(t, map[cciptypes.ChainSelector]map[string]*big.Int{
+		sourceChain: {tokenA.String(): big.NewInt(5), tokenB.String(): big.NewInt(1)},
+	}, deferrals.Volume())
+
+	// expired tokens are reported as zero
+	deferrals.Expire(now.Add(time.Hour))
+	assert.Equal(t, map[cciptypes.ChainSelector]map[string]*big.Int{
+		sourceChain: {tokenA.String(): big.NewInt(0), tokenB.String(): big.NewInt(0)},
+	}, deferrals.Volume())
+}
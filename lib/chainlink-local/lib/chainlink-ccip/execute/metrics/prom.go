@@ -1,6 +1,7 @@
 package metrics
 
 import (
+	"math/big"
 	"time"
 
 	"github.com/prometheus/client_golang/prometheus"
@@ -85,6 +86,13 @@ var (
 		},
 		[]string{"chainID", "sourceChain", "method"},
 	)
+	PromExecRateLimitDeferredVolume = promauto.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "ccip_exec_rate_limit_deferred_volume",
+			Help: "This metric tracks the token amounts of the messages deferred because of the inbound rate limiters",
+		},
+		[]string{"chainID", "sourceChain", "token"},
+	)
 )
 
 type PromReporter struct {
@@ -98,6 +106,7 @@ type PromReporter struct {
 	sequenceNumbers           *prometheus.GaugeVec
 	processorLatencyHistogram *prometheus.HistogramVec
 	processorErrors           *prometheus.CounterVec
+	deferredVolume            *prometheus.GaugeVec
 }
 
 func NewPromReporter(lggr logger.Logger, selector cciptypes.ChainSelector) (*PromReporter, error) {
@@ -116,6 +125,7 @@ func NewPromReporter(lggr logger.Logger, selector cciptypes.ChainSelector) (*Pro
 		sequenceNumbers:           PromSequenceNumbers,
 		processorLatencyHistogram: PromExecProcessorLatencyHistogram,
 		processorErrors:           PromExecProcessorErrors,
+		deferredVolume:            PromExecRateLimitDeferredVolume,
 	}, nil
 }
 
@@ -180,6 +190,23 @@ func (p *PromReporter) TrackProcessorOutput(
 	// noop
 }
 
+func (p *PromReporter) TrackRateLimitDeferrals(volume map[cciptypes.ChainSelector]map[string]*big.Int) {
+	for sourceChainSelector, tokens := range volume {
+		sourceChain, err := sel.GetChainIDFromSelector(uint64(sourceChainSelector))
+		if err != nil {
+			p.lggr.Errorw("failed to get chain ID from selector", "err", err)
+			continue
+		}
+
+		for token, amount := range tokens {
+			value, _ := new(big.Float).SetInt(amount).Float64()
+			p.deferredVolume.
+				WithLabelValues(p.chainID, sourceChain, token).
+				Set(value)
+		}
+	}
+}
+
 func (p *PromReporter) trackMaxSequenceNumber(
 	sourceChainSelector cciptypes.ChainSelector,
 	maxSeqNr int,
@@ -2,6 +2,7 @@ package metrics
 
 import (
 	"fmt"
+	"math/big"
 	"testing"
 	"time"
 
@@ -469,6 +470,24 @@ func Test_LatencyAndErrors(t *testing.T) {
 	})
 }
 
+func Test_RateLimitDeferrals(t *testing.T) {
+	reporter, err := NewPromReporter(logger.Test(t), selector)
+	require.NoError(t, err)
+
+	t.Cleanup(cleanupMetrics(reporter))
+
+	sourceSelector := cciptypes.ChainSelector(4793464827907405086)
+	reporter.TrackRateLimitDeferrals(map[cciptypes.ChainSelector]map[string]*big.Int{
+		sourceSelector: {"0x01": big.NewInt(15), "0x02": big.NewInt(0)},
+		// unknown chains are skipped
+		cciptypes.ChainSelector(1): {"0x01": big.NewInt(1)},
+	})
+
+	require.Equal(t, float64(15), testutil.ToFloat64(reporter.deferredVolume.WithLabelValues(chainID, "3337", "0x01")))
+	require.Equal(t, float64(0), testutil.ToFloat64(reporter.deferredVolume.WithLabelValues(chainID, "3337", "0x02")))
+	require.Equal(t, 2, testutil.CollectAndCount(reporter.deferredVolume))
+}
+
 func cleanupMetrics(p *PromReporter) func() {
 	return func() {
 		p.sequenceNumbers.Reset()
@@ -477,5 +496,6 @@ func cleanupMetrics(p *PromReporter) func() {
 		p.execErrors.Reset()
 		p.processorLatencyHistogram.Reset()
 		p.processorErrors.Reset()
+		p.deferredVolume.Reset()
 	}
 }
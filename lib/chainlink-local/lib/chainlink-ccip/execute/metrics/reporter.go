@@ -1,11 +1,13 @@
 package metrics
 
 import (
+	"math/big"
 	"time"
 
 	"github.com/smartcontractkit/chainlink-ccip/execute/exectypes"
 	"github.com/smartcontractkit/chainlink-ccip/internal/plugincommon"
 	"github.com/smartcontractkit/chainlink-ccip/internal/plugintypes"
+	cciptypes "github.com/smartcontractkit/chainlink-ccip/pkg/types/ccipocr3"
 )
 
 // Reporter is a simple interface used for tracking observations and outcomes of the execution plugin.
@@ -13,12 +15,15 @@ import (
 // Main goal is to provide a simple way to track the performance of the execution plugin, for instance:
 // - understand how efficiently we batch (number of messages, number of token data, number of source chains used etc.)
 // - understand how many messages, reports, token data are observed by plugins
+// - understand how much volume is deferred because of the inbound rate limiters
 type Reporter interface {
 	TrackObservation(obs exectypes.Observation, state exectypes.PluginState)
 	TrackOutcome(outcome exectypes.Outcome, state exectypes.PluginState)
 	TrackLatency(state exectypes.PluginState, method plugincommon.MethodType, latency time.Duration, err error)
 	TrackProcessorOutput(string, plugincommon.MethodType, plugintypes.Trackable)
 	TrackProcessorLatency(processor string, method plugincommon.MethodType, latency time.Duration, err error)
+	// TrackRateLimitDeferrals tracks the amounts deferred per source chain and destination token.
+	TrackRateLimitDeferrals(volume map[cciptypes.ChainSelector]map[string]*big.Int)
 }
 
 type Noop struct{}
@@ -33,5 +38,7 @@ func (n *Noop) TrackProcessorOutput(string, plugincommon.MethodType, plugintypes
 
 func (n *Noop) TrackProcessorLatency(string, plugincommon.MethodType, time.Duration, error) {}
 
+func (n *Noop) TrackRateLimitDeferrals(map[cciptypes.ChainSelector]map[string]*big.Int) {}
+
 var _ Reporter = &Noop{}
 var _ Reporter = &PromReporter{}
@@ -13,6 +13,7 @@ import (
 	"github.com/smartcontractkit/libocr/offchainreporting2plus/types"
 
 	"github.com/smartcontractkit/chainlink-ccip/execute/exectypes"
+	"github.com/smartcontractkit/chainlink-ccip/execute/internal/ratelimit"
 	dt "github.com/smartcontractkit/chainlink-ccip/internal/plugincommon/discovery/discoverytypes"
 	"github.com/smartcontractkit/chainlink-ccip/pkg/logutil"
 	"github.com/smartcontractkit/chainlink-ccip/pkg/reader"
@@ -369,6 +370,12 @@ func (p *Plugin) getMessagesObservation(
 		return exectypes.LessThan(commitData[i], commitData[j])
 	})
 
+	// Messages deferred until the rate limiters refill are observed again once their deferral expires.
+	now := time.Now()
+	p.rateLimitDeferrals.Expire(now)
+	rateLimits := make(map[cciptypes.ChainSelector]*ratelimit.Limits)
+	defer func() { p.observer.TrackRateLimitDeferrals(p.rateLimitDeferrals.Volume()) }()
+
 	stop := false
 
 	totalMsgs := 0
@@ -421,15 +428,27 @@ func (p *Plugin) getMessagesObservation(
 		observation.Messages = messageObs
 		observation.TokenData = tkData
 
+		limits := p.getRateLimits(ctx, lggr, rateLimits, srcChain, msgs)
+
 		// Process each message in the report and override the empty message and token data if everything fits within
 		// the size limits
 		for _, msg := range msgs {
 			// If a message is inflight or already executed, don't include it fully in the observation
 			// because its already been transmitted in a previous report or executed onchain.
+			executed := slices.Contains(report.ExecutedMessages, msg.Header.SequenceNumber)
 			if p.inflightMessageCache.IsInflight(srcChain, msg.Header.MessageID) {
+				// The inflight amounts are not reflected onchain until the messages are executed.
+				if limits != nil && !executed {
+					limits.Consume(ratelimit.Amounts(msg))
+				}
+				continue
+			}
+			if executed {
 				continue
 			}
-			if slices.Contains(report.ExecutedMessages, msg.Header.SequenceNumber) {
+			// If a message doesn't fit the rate limiters, don't include it fully in the observation either
+			// as its execution would revert.
+			if p.isRateLimited(lggr, limits, srcChain, msg, now) {
 				continue
 			}
 
@@ -468,6 +487,80 @@ func (p *Plugin) getMessagesObservation(
 	return observation, nil
 }
 
+// getRateLimits returns the inbound rate limits of the source chain, reading the limits of the message tokens that
+// are not tracked yet. Nil is returned when the limits can't be read, the messages are then not rate limited.
+func (p *Plugin) getRateLimits(
+	ctx context.Context,
+	lggr logger.Logger,
+	rateLimits map[cciptypes.ChainSelector]*ratelimit.Limits,
+	srcChain cciptypes.ChainSelector,
+	msgs []cciptypes.Message,
+) *ratelimit.Limits {
+	limits, ok := rateLimits[srcChain]
+	if !ok {
+		// Only the destination chain readers can read the rate limiters.
+		supportsDest, err := p.chainSupport.SupportsDestChain(p.reportingCfg.OracleID)
+		if err != nil || !supportsDest {
+			rateLimits[srcChain] = nil
+			return nil
+		}
+		limits = ratelimit.NewLimits()
+		rateLimits[srcChain] = limits
+	}
+	if limits == nil {
+		return nil
+	}
+
+	tokens := limits.Untracked(msgs)
+	if len(tokens) == 0 {
+		return limits
+	}
+	inboundRateLimits, err := p.ccipReader.GetInboundRateLimits(ctx, srcChain, tokens)
+	if err != nil {
+		lggr.Warnw("unable to read the inbound rate limits, messages are not rate limited",
+			"srcChain", srcChain, "tokens", tokens, "err", err)
+		rateLimits[srcChain] = nil
+		return nil
+	}
+	limits.Add(tokens, inboundRateLimits)
+	return limits
+}
+
+// isRateLimited returns whether the message has to wait for the inbound rate limiters to refill. Messages that fit
+// consume the rate limiters, messages that don't are deferred until the predicted refill time.
+func (p *Plugin) isRateLimited(
+	lggr logger.Logger,
+	limits *ratelimit.Limits,
+	srcChain cciptypes.ChainSelector,
+	msg cciptypes.Message,
+	now time.Time,
+) bool {
+	msgLggr := logger.With(lggr, "srcChain", srcChain, "seqNum", msg.Header.SequenceNumber,
+		"messageID", msg.Header.MessageID)
+	if until, deferred := p.rateLimitDeferrals.IsDeferred(srcChain, msg.Header.MessageID, now); deferred {
+		msgLggr.Infow("message deferred until the rate limits refill", "until", until)
+		return true
+	}
+	if limits == nil {
+		return false
+	}
+
+	amounts := ratelimit.Amounts(msg)
+	delay, canRefill := limits.RefillDelay(amounts)
+	if !canRefill {
+		msgLggr.Warnw("message skipped, it exceeds the rate limits capacity")
+		return true
+	}
+	if delay > 0 {
+		until := now.Add(delay)
+		p.rateLimitDeferrals.Defer(srcChain, msg.Header.MessageID, until, amounts)
+		msgLggr.Infow("message deferred until the rate limits refill", "until", until)
+		return true
+	}
+	limits.Consume(amounts)
+	return false
+}
+
 func (p *Plugin) getFilterObservation(
 	ctx context.Context,
 	lggr logger.Logger,
@@ -3,6 +3,7 @@ package execute
 import (
 	"context"
 	"fmt"
+	"math/big"
 	"testing"
 	"time"
 
@@ -17,11 +18,15 @@ import (
 
 	"github.com/smartcontractkit/chainlink-ccip/execute/exectypes"
 	"github.com/smartcontractkit/chainlink-ccip/execute/internal/cache"
+	"github.com/smartcontractkit/chainlink-ccip/execute/internal/ratelimit"
+	"github.com/smartcontractkit/chainlink-ccip/execute/metrics"
 	"github.com/smartcontractkit/chainlink-ccip/execute/tokendata/observer"
 	"github.com/smartcontractkit/chainlink-ccip/internal/mocks"
+	plugincommon_mock "github.com/smartcontractkit/chainlink-ccip/mocks/internal_/plugincommon"
 	"github.com/smartcontractkit/chainlink-ccip/mocks/internal_/reader"
 	codec_mock "github.com/smartcontractkit/chainlink-ccip/mocks/pkg/ocrtypecodec/v1"
 	readerpkg_mock "github.com/smartcontractkit/chainlink-ccip/mocks/pkg/reader"
+	readerpkg "github.com/smartcontractkit/chainlink-ccip/pkg/reader"
 	cciptypes "github.com/smartcontractkit/chainlink-ccip/pkg/types/ccipocr3"
 )
 
@@ -160,6 +165,14 @@ func Test_getMessagesObservation(t *testing.T) {
 		return hashes
 	}
 
+	destToken := cciptypes.UnknownAddress{0xa}
+	withTokenAmount := func(msg cciptypes.Message, amount int64) cciptypes.Message {
+		msg.TokenAmounts = []cciptypes.RampTokenAmount{
+			{DestTokenAddress: destToken, Amount: cciptypes.NewBigIntFromInt64(amount)},
+		}
+		return msg
+	}
+
 	createTokenData := func(fromSeq, toSeq cciptypes.SeqNum) map[cciptypes.SeqNum]exectypes.MessageTokenData {
 		tokenData := make(map[cciptypes.SeqNum]exectypes.MessageTokenData)
 		for seq := fromSeq; seq <= toSeq; seq++ {
@@ -437,6 +450,69 @@ func Test_getMessagesObservation(t *testing.T) {
 			},
 			expectedError: false,
 		},
+		{
+			name: "rate limited messages are deferred but hashed",
+			commitData: []exectypes.CommitData{
+				createCommitData(src1, 1, 3),
+			},
+			setupMocks: func(ccipReader *readerpkg_mock.MockCCIPReader,
+				estimateProvider *ccipocr3.MockEstimateProvider,
+				inflightCache *cache.InflightMessageCache,
+				codec *codec_mock.MockExecCodec,
+			) {
+				// Any small size that fits within the max observation size
+				codec.EXPECT().EncodeObservation(mock.Anything).Return(oneByte, nil).Maybe()
+				messages := []cciptypes.Message{
+					withTokenAmount(NewMessage(1, 1, int(src1), int(dest)), 40),
+					withTokenAmount(NewMessage(2, 2, int(src1), int(dest)), 50),
+					withTokenAmount(NewMessage(3, 3, int(src1), int(dest)), 20),
+				}
+
+				ccipReader.On("MsgsBetweenSeqNums", ctx, src1, cciptypes.NewSeqNumRange(1, 3)).
+					Return(messages, nil)
+				ccipReader.EXPECT().GetInboundRateLimits(ctx, src1, []cciptypes.UnknownAddress{destToken}).
+					Return(readerpkg.InboundRateLimits{
+						TokenPools: map[string]readerpkg.TokenBucket{
+							destToken.String(): {
+								Tokens:    big.NewInt(100),
+								IsEnabled: true,
+								Capacity:  big.NewInt(100),
+								Rate:      big.NewInt(1),
+							},
+						},
+					}, nil)
+
+				// Message 1 is inflight and consumes 40 out of 100, message 2 consumes 50 and message 3 doesn't fit
+				inflightCache.MarkInflight(src1, messages[0].Header.MessageID)
+			},
+			expectedObs: exectypes.Observation{
+				Messages: exectypes.MessageObservations{
+					src1: {
+						// pseudo deleted
+						1: NewMessage(1, 1, 0, 0),
+						2: withTokenAmount(NewMessage(2, 2, int(src1), int(dest)), 50),
+						// pseudo deleted
+						3: NewMessage(3, 3, 0, 0),
+					},
+				},
+				CommitReports: exectypes.CommitObservations{
+					src1: []exectypes.CommitData{
+						createCommitData(src1, 1, 3),
+					},
+				},
+				Hashes: exectypes.MessageHashes{
+					src1: createHashesMap(1, 3),
+				},
+				TokenData: exectypes.TokenDataObservations{
+					src1: {
+						1: exectypes.NewMessageTokenData(),
+						2: exectypes.NewMessageTokenData(exectypes.NewNoopTokenData()),
+						3: exectypes.NewMessageTokenData(),
+					},
+				},
+			},
+			expectedError: false,
+		},
 		{
 			name: "executed messages are skipped but hashed",
 			commitData: []exectypes.CommitData{
@@ -638,6 +714,8 @@ func Test_getMessagesObservation(t *testing.T) {
 			inflightCache := cache.NewInflightMessageCache(inflightCacheTTL)
 			codec := codec_mock.NewMockExecCodec(t)
 			tokenDataObserver := observer.NoopTokenDataObserver{}
+			chainSupport := plugincommon_mock.NewMockChainSupport(t)
+			chainSupport.EXPECT().SupportsDestChain(mock.Anything).Return(true, nil).Maybe()
 
 			plugin := &Plugin{
 				lggr:                 mocks.NullLogger,
@@ -646,7 +724,10 @@ func Test_getMessagesObservation(t *testing.T) {
 				ocrTypeCodec:         codec,
 				estimateProvider:     estimateProvider,
 				inflightMessageCache: inflightCache,
+				rateLimitDeferrals:   ratelimit.NewDeferrals(),
 				tokenDataObserver:    &tokenDataObserver,
+				chainSupport:         chainSupport,
+				observer:             &metrics.Noop{},
 				offchainCfg: pluginconfig.ExecuteOffchainConfig{
 					BatchGasLimit: uint64(batchGasLimit),
 				},
@@ -29,6 +29,7 @@ import (
 
 	"github.com/smartcontractkit/chainlink-ccip/execute/exectypes"
 	"github.com/smartcontractkit/chainlink-ccip/execute/internal/cache"
+	"github.com/smartcontractkit/chainlink-ccip/execute/internal/ratelimit"
 	"github.com/smartcontractkit/chainlink-ccip/execute/metrics"
 	"github.com/smartcontractkit/chainlink-ccip/execute/report"
 	"github.com/smartcontractkit/chainlink-ccip/execute/tokendata/observer"
@@ -88,6 +89,8 @@ type Plugin struct {
 	commitRootsCache cache.CommitsRootsCache
 	// inflightMessageCache prevents duplicate reports from being sent for the same message.
 	inflightMessageCache inflightMessageCache
+	// rateLimitDeferrals holds the messages waiting for the inbound rate limiters to refill.
+	rateLimitDeferrals *ratelimit.Deferrals
 }
 
 func NewPlugin(
@@ -145,6 +148,7 @@ func NewPlugin(
 			offchainCfg.RootSnoozeTime.Duration(),
 		),
 		inflightMessageCache: cache.NewInflightMessageCache(offchainCfg.InflightCacheExpiry.Duration()),
+		rateLimitDeferrals:   ratelimit.NewDeferrals(),
 		ocrTypeCodec:         ocrTypCodec,
 		addrCodec:            addrCodec,
 	}
@@ -8,10 +8,12 @@ import (
 	"github.com/patrickmn/go-cache"
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/prometheus/client_golang/prometheus/promauto"
+	"go.opentelemetry.io/otel/attribute"
 
 	"github.com/smartcontractkit/chainlink-common/pkg/logger"
 
 	"github.com/smartcontractkit/chainlink-ccip/pkg/reader"
+	"github.com/smartcontractkit/chainlink-ccip/pkg/tracing"
 	cciptypes "github.com/smartcontractkit/chainlink-ccip/pkg/types/ccipocr3"
 )
 
@@ -70,8 +72,13 @@ func (o *ObservedAttestationClient) Attestations(
 	ctx context.Context,
 	msgs map[cciptypes.ChainSelector]map[reader.MessageTokenID]cciptypes.Bytes,
 ) (map[cciptypes.ChainSelector]map[reader.MessageTokenID]AttestationStatus, error) {
+	ctx, span := tracing.Start(ctx, "AttestationClient.Attestations",
+		tracing.TokenKey.String(o.Token()),
+		attribute.Int("chains", len(msgs)),
+	)
 	start := time.Now()
 	attestations, err := o.delegate.Attestations(ctx, msgs)
+	tracing.End(span, err)
 	if err != nil {
 		return nil, err
 	}
@@ -234,6 +234,14 @@ func (r InMemoryCCIPReader) GetOffRampSourceChainsConfig(ctx context.Context, ch
 	return nil, nil
 }
 
+func (r InMemoryCCIPReader) GetInboundRateLimits(
+	ctx context.Context,
+	sourceChain cciptypes.ChainSelector,
+	destTokens []cciptypes.UnknownAddress,
+) (reader.InboundRateLimits, error) {
+	return reader.InboundRateLimits{}, nil
+}
+
 // Close implements the reader.CCIPReader interface
 func (r InMemoryCCIPReader) Close() error {
 	// Since this is an in-memory implementation with no persistent connections
@@ -0,0 +1,107 @@
+package plugincommon
+
+import (
+	"context"
+
+	"go.opentelemetry.io/otel/attribute"
+
+	"github.com/smartcontractkit/libocr/commontypes"
+	"github.com/smartcontractkit/libocr/offchainreporting2plus/ocr3types"
+	"github.com/smartcontractkit/libocr/offchainreporting2plus/types"
+
+	"github.com/smartcontractkit/chainlink-ccip/pkg/tracing"
+)
+
+// TracedPlugin starts a span for each OCR3 phase of a ReportingPlugin, named after the plugin and the phase, e.g.
+// "Commit.Observation". The spans of the processors, readers and token data fetches called during a phase are its
+// children, so a round can be followed from the phase down to the RPC calls.
+type TracedPlugin struct {
+	ocr3types.ReportingPlugin[[]byte]
+	name  string
+	attrs []attribute.KeyValue
+}
+
+func NewTracedPlugin(
+	plugin ocr3types.ReportingPlugin[[]byte],
+	name string,
+	donID uint32,
+	oracleID commontypes.OracleID,
+) *TracedPlugin {
+	return &TracedPlugin{
+		ReportingPlugin: plugin,
+		name:            name,
+		attrs: []attribute.KeyValue{
+			tracing.PluginKey.String(name),
+			tracing.DonIDKey.Int64(int64(donID)),
+			tracing.OracleIDKey.Int(int(oracleID)),
+		},
+	}
+}
+
+func (p *TracedPlugin) Query(ctx context.Context, outctx ocr3types.OutcomeContext) (types.Query, error) {
+	return withTracedPhase(ctx, p, "Query", outctx.SeqNr, func(ctx context.Context) (types.Query, error) {
+		return p.ReportingPlugin.Query(ctx, outctx)
+	})
+}
+
+func (p *TracedPlugin) Observation(
+	ctx context.Context, outctx ocr3types.OutcomeContext, query types.Query,
+) (types.Observation, error) {
+	return withTracedPhase(ctx, p, "Observation", outctx.SeqNr, func(ctx context.Context) (types.Observation, error) {
+		return p.ReportingPlugin.Observation(ctx, outctx, query)
+	})
+}
+
+func (p *TracedPlugin) ValidateObservation(
+	ctx context.Context, outctx ocr3types.OutcomeContext, query types.Query, ao types.AttributedObservation,
+) error {
+	_, err := withTracedPhase(ctx, p, "ValidateObservation", outctx.SeqNr, func(ctx context.Context) (any, error) {
+		return nil, p.ReportingPlugin.ValidateObservation(ctx, outctx, query, ao)
+	})
+	return err
+}
+
+func (p *TracedPlugin) Outcome(
+	ctx context.Context, outctx ocr3types.OutcomeContext, query types.Query, aos []types.AttributedObservation,
+) (ocr3types.Outcome, error) {
+	return withTracedPhase(ctx, p, "Outcome", outctx.SeqNr, func(ctx context.Context) (ocr3types.Outcome, error) {
+		return p.ReportingPlugin.Outcome(ctx, outctx, query, aos)
+	})
+}
+
+func (p *TracedPlugin) Reports(
+	ctx context.Context, seqNr uint64, outcome ocr3types.Outcome,
+) ([]ocr3types.ReportPlus[[]byte], error) {
+	return withTracedPhase(ctx, p, "Reports", seqNr,
+		func(ctx context.Context) ([]ocr3types.ReportPlus[[]byte], error) {
+			return p.ReportingPlugin.Reports(ctx, seqNr, outcome)
+		})
+}
+
+func (p *TracedPlugin) ShouldAcceptAttestedReport(
+	ctx context.Context, seqNr uint64, r ocr3types.ReportWithInfo[[]byte],
+) (bool, error) {
+	return withTracedPhase(ctx, p, "ShouldAcceptAttestedReport", seqNr, func(ctx context.Context) (bool, error) {
+		return p.ReportingPlugin.ShouldAcceptAttestedReport(ctx, seqNr, r)
+	})
+}
+
+func (p *TracedPlugin) ShouldTransmitAcceptedReport(
+	ctx context.Context, seqNr uint64, r ocr3types.ReportWithInfo[[]byte],
+) (bool, error) {
+	return withTracedPhase(ctx, p, "ShouldTransmitAcceptedReport", seqNr, func(ctx context.Context) (bool, error) {
+		return p.ReportingPlugin.ShouldTransmitAcceptedReport(ctx, seqNr, r)
+	})
+}
+
+func withTracedPhase[T any](
+	ctx context.Context,
+	p *TracedPlugin,
+	phase string,
+	seqNr uint64,
+	f func(ctx context.Context) (T, error),
+) (T, error) {
+	//nolint:gosec // sequence numbers do not overflow an int64
+	attrs := append([]attribute.KeyValue{tracing.OCRSeqNrKey.Int64(int64(seqNr))}, p.attrs...)
+	return tracing.Trace(ctx, p.name+"."+phase, f, attrs...)
+}
@@ -0,0 +1,82 @@
+package plugincommon_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/utils/tests"
+	"github.com/smartcontractkit/libocr/offchainreporting2plus/ocr3types"
+	"github.com/smartcontractkit/libocr/offchainreporting2plus/types"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+
+	"github.com/smartcontractkit/chainlink-ccip/internal/plugincommon"
+	"github.com/smartcontractkit/chainlink-ccip/pkg/tracing"
+)
+
+// fakePlugin starts a child span in Observation and fails Outcome
+type fakePlugin struct {
+	ocr3types.ReportingPlugin[[]byte]
+}
+
+func (fakePlugin) Observation(
+	ctx context.Context, _ ocr3types.OutcomeContext, _ types.Query,
+) (types.Observation, error) {
+	_, span := tracing.Start(ctx, "Processor.Observation")
+	tracing.End(span, nil)
+	return types.Observation("obs"), nil
+}
+
+func (fakePlugin) Outcome(
+	_ context.Context, _ ocr3types.OutcomeContext, _ types.Query, _ []types.AttributedObservation,
+) (ocr3types.Outcome, error) {
+	return nil, errors.New("no consensus")
+}
+
+func TestTracedPlugin(t *testing.T) {
+	recorder := tracetest.NewSpanRecorder()
+	prev := otel.GetTracerProvider()
+	otel.SetTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder)))
+	t.Cleanup(func() { otel.SetTracerProvider(prev) })
+
+	ctx := tests.Context(t)
+	plugin := plugincommon.NewTracedPlugin(fakePlugin{}, "Commit", 3, 2)
+
+	obs, err := plugin.Observation(ctx, ocr3types.OutcomeContext{SeqNr: 10}, nil)
+	require.NoError(t, err)
+	require.Equal(t, types.Observation("obs"), obs)
+
+	_, err = plugin.Outcome(ctx, ocr3types.OutcomeContext{SeqNr: 11}, nil, nil)
+	require.EqualError(t, err, "no consensus")
+
+	spans := recorder.Ended()
+	require.Len(t, spans, 3)
+	child, observation, outcome := spans[0], spans[1], spans[2]
+
+	require.Equal(t, "Commit.Observation", observation.Name())
+	require.Equal(t, codes.Unset, observation.Status().Code)
+	require.ElementsMatch(t, []attribute.KeyValue{
+		tracing.OCRSeqNrKey.Int64(10),
+		tracing.PluginKey.String("Commit"),
+		tracing.DonIDKey.Int64(3),
+		tracing.OracleIDKey.Int(2),
+	}, observation.Attributes())
+
+	// the spans started during a phase are its children
+	require.Equal(t, "Processor.Observation", child.Name())
+	require.Equal(t, observation.SpanContext().SpanID(), child.Parent().SpanID())
+	require.Equal(t, observation.SpanContext().TraceID(), child.SpanContext().TraceID())
+
+	require.Equal(t, "Commit.Outcome", outcome.Name())
+	require.Equal(t, codes.Error, outcome.Status().Code)
+	require.Equal(t, "no consensus", outcome.Status().Description)
+	require.Len(t, outcome.Events(), 1)
+	require.Contains(t, outcome.Attributes(), tracing.OCRSeqNrKey.Int64(11))
+	// each phase starts its own trace
+	require.NotEqual(t, observation.SpanContext().TraceID(), outcome.SpanContext().TraceID())
+}
@@ -7,6 +7,7 @@ import (
 	"github.com/smartcontractkit/chainlink-common/pkg/logger"
 
 	"github.com/smartcontractkit/chainlink-ccip/internal/plugintypes"
+	"github.com/smartcontractkit/chainlink-ccip/pkg/tracing"
 )
 
 type MethodType = string
@@ -26,6 +27,7 @@ type MetricsReporter interface {
 // * latencies of most of the perf critical methods (Query, Observation, Outcome)
 // * observations and outcomes (and their stats) of the processor
 // * errors in the tracked methods
+// * spans of the tracked methods, children of the span of the plugin phase
 type TrackedProcessor[Query any, Observation plugintypes.Trackable, Outcome plugintypes.Trackable] struct {
 	PluginProcessor[Query, Observation, Outcome]
 	lggr          logger.Logger
@@ -48,7 +50,7 @@ func NewTrackedProcessor[Query any, Observation plugintypes.Trackable, Outcome p
 }
 
 func (p *TrackedProcessor[Query, Observation, Outcome]) Query(ctx context.Context, prev Outcome) (Query, error) {
-	return withTrackedMethod[Query](p, QueryMethod, func() (Query, error) {
+	return withTrackedMethod[Query](ctx, p, QueryMethod, func(ctx context.Context) (Query, error) {
 		return p.PluginProcessor.Query(ctx, prev)
 	})
 }
@@ -58,7 +60,7 @@ func (p *TrackedProcessor[Query, Observation, Outcome]) Observation(
 	prev Outcome,
 	query Query,
 ) (Observation, error) {
-	obs, err := withTrackedMethod[Observation](p, ObservationMethod, func() (Observation, error) {
+	obs, err := withTrackedMethod[Observation](ctx, p, ObservationMethod, func(ctx context.Context) (Observation, error) {
 		return p.PluginProcessor.Observation(ctx, prev, query)
 	})
 	if err == nil {
@@ -73,7 +75,7 @@ func (p *TrackedProcessor[Query, Observation, Outcome]) Outcome(
 	query Query,
 	aos []AttributedObservation[Observation],
 ) (Outcome, error) {
-	out, err := withTrackedMethod[Outcome](p, OutcomeMethod, func() (Outcome, error) {
+	out, err := withTrackedMethod[Outcome](ctx, p, OutcomeMethod, func(ctx context.Context) (Outcome, error) {
 		return p.PluginProcessor.Outcome(ctx, prev, query, aos)
 	})
 	if err == nil {
@@ -83,12 +85,13 @@ func (p *TrackedProcessor[Query, Observation, Outcome]) Outcome(
 }
 
 func withTrackedMethod[T any, Query any, Observation plugintypes.Trackable, Outcome plugintypes.Trackable](
+	ctx context.Context,
 	p *TrackedProcessor[Query, Observation, Outcome],
 	method string,
-	f func() (T, error),
+	f func(ctx context.Context) (T, error),
 ) (T, error) {
 	queryStarted := time.Now()
-	resp, err := f()
+	resp, err := tracing.Trace(ctx, p.processorName+"."+method, f, tracing.ProcessorKey.String(p.processorName))
 
 	latency := time.Since(queryStarted)
 	p.reporter.TrackProcessorLatency(p.processorName, method, latency, err)
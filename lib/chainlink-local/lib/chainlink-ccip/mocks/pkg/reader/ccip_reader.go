@@ -526,6 +526,64 @@ func (_c *MockCCIPReader_GetExpectedNextSequenceNumber_Call) RunAndReturn(run fu
 	return _c
 }
 
+// GetInboundRateLimits provides a mock function with given fields: ctx, sourceChain, destTokens
+func (_m *MockCCIPReader) GetInboundRateLimits(ctx context.Context, sourceChain ccipocr3.ChainSelector, destTokens []ccipocr3.UnknownAddress) (reader.InboundRateLimits, error) {
+	ret := _m.Called(ctx, sourceChain, destTokens)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetInboundRateLimits")
+	}
+
+	var r0 reader.InboundRateLimits
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, ccipocr3.ChainSelector, []ccipocr3.UnknownAddress) (reader.InboundRateLimits, error)); ok {
+		return rf(ctx, sourceChain, destTokens)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, ccipocr3.ChainSelector, []ccipocr3.UnknownAddress) reader.InboundRateLimits); ok {
+		r0 = rf(ctx, sourceChain, destTokens)
+	} else {
+		r0 = ret.Get(0).(reader.InboundRateLimits)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, ccipocr3.ChainSelector, []ccipocr3.UnknownAddress) error); ok {
+		r1 = rf(ctx, sourceChain, destTokens)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockCCIPReader_GetInboundRateLimits_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetInboundRateLimits'
+type MockCCIPReader_GetInboundRateLimits_Call struct {
+	*mock.Call
+}
+
+// GetInboundRateLimits is a helper method to define mock.On call
+//   - ctx context.Context
+//   - sourceChain ccipocr3.ChainSelector
+//   - destTokens []ccipocr3.UnknownAddress
+func (_e *MockCCIPReader_Expecter) GetInboundRateLimits(ctx interface{}, sourceChain interface{}, destTokens interface{}) *MockCCIPReader_GetInboundRateLimits_Call {
+	return &MockCCIPReader_GetInboundRateLimits_Call{Call: _e.mock.On("GetInboundRateLimits", ctx, sourceChain, destTokens)}
+}
+
+func (_c *MockCCIPReader_GetInboundRateLimits_Call) Run(run func(ctx context.Context, sourceChain ccipocr3.ChainSelector, destTokens []ccipocr3.UnknownAddress)) *MockCCIPReader_GetInboundRateLimits_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(ccipocr3.ChainSelector), args[2].([]ccipocr3.UnknownAddress))
+	})
+	return _c
+}
+
+func (_c *MockCCIPReader_GetInboundRateLimits_Call) Return(_a0 reader.InboundRateLimits, _a1 error) *MockCCIPReader_GetInboundRateLimits_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockCCIPReader_GetInboundRateLimits_Call) RunAndReturn(run func(context.Context, ccipocr3.ChainSelector, []ccipocr3.UnknownAddress) (reader.InboundRateLimits, error)) *MockCCIPReader_GetInboundRateLimits_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetLatestPriceSeqNr provides a mock function with given fields: ctx
 func (_m *MockCCIPReader) GetLatestPriceSeqNr(ctx context.Context) (uint64, error) {
 	ret := _m.Called(ctx)
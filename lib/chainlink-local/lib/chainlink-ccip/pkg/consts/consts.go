@@ -4,18 +4,21 @@ package consts
 
 // Contract Names
 const (
-	ContractNameOffRamp                = "OffRamp"
-	ContractNameOnRamp                 = "OnRamp"
-	ContractNameFeeQuoter              = "FeeQuoter"
-	ContractNameCapabilitiesRegistry   = "CapabilitiesRegistry"
-	ContractNameCCIPConfig             = "CCIPHome"
-	ContractNamePriceAggregator        = "AggregatorV3Interface"
-	ContractNameNonceManager           = "NonceManager"
-	ContractNameRMNHome                = "RMNHome"
-	ContractNameRMNRemote              = "RMNRemote"
-	ContractNameRMNProxy               = "RMNProxy"
-	ContractNameRouter                 = "Router"
-	ContractNameCCTPMessageTransmitter = "MessageTransmitter"
+	ContractNameOffRamp                   = "OffRamp"
+	ContractNameOnRamp                    = "OnRamp"
+	ContractNameFeeQuoter                 = "FeeQuoter"
+	ContractNameCapabilitiesRegistry      = "CapabilitiesRegistry"
+	ContractNameCCIPConfig                = "CCIPHome"
+	ContractNamePriceAggregator           = "AggregatorV3Interface"
+	ContractNameNonceManager              = "NonceManager"
+	ContractNameRMNHome                   = "RMNHome"
+	ContractNameRMNRemote                 = "RMNRemote"
+	ContractNameRMNProxy                  = "RMNProxy"
+	ContractNameRouter                    = "Router"
+	ContractNameCCTPMessageTransmitter    = "MessageTransmitter"
+	ContractNameTokenAdminRegistry        = "TokenAdminRegistry"
+	ContractNameTokenPool                 = "TokenPool"
+	ContractNameMultiAggregateRateLimiter = "MultiAggregateRateLimiter"
 )
 
 // Method Names
@@ -60,6 +63,16 @@ const (
 	MethodNameGetInboundNonce  = "GetInboundNonce"
 	MethodNameGetOutboundNonce = "GetOutboundNonce"
 
+	// TokenAdminRegistry methods
+	MethodNameGetPools = "GetPools"
+
+	// TokenPool methods
+	MethodNameGetCurrentInboundRateLimiterState = "GetCurrentInboundRateLimiterState"
+
+	// MultiAggregateRateLimiter methods
+	MethodNameCurrentRateLimiterState = "CurrentRateLimiterState"
+	MethodNameGetAllRateLimitTokens   = "GetAllRateLimitTokens"
+
 	// Deprecated: TODO: remove after chainlink is updated.
 	MethodNameOfframpGetDynamicConfig = "OfframpGetDynamicConfig"
 	// Deprecated: TODO: remove after chainlink is updated.
@@ -9,10 +9,13 @@ import (
 
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/prometheus/client_golang/prometheus/promauto"
+	"go.opentelemetry.io/otel/attribute"
 
 	"github.com/smartcontractkit/chainlink-common/pkg/logger"
 	"github.com/smartcontractkit/chainlink-common/pkg/types"
 	"github.com/smartcontractkit/chainlink-common/pkg/types/query/primitives"
+
+	"github.com/smartcontractkit/chainlink-ccip/pkg/tracing"
 )
 
 var (
@@ -101,11 +104,17 @@ func (o *Observed) GetLatestValue(
 	confidenceLevel primitives.ConfidenceLevel,
 	params, returnVal any,
 ) error {
+	contract, function := unpackReadIdentifier(readIdentifier)
+	ctx, span := tracing.Start(ctx, "ContractReader.GetLatestValue",
+		tracing.ChainIDKey.String(o.chainID),
+		attribute.String("contract", contract),
+		attribute.String("function", function),
+	)
 	start := time.Now()
 	err := o.ContractReaderFacade.GetLatestValue(ctx, readIdentifier, confidenceLevel, params, returnVal)
 	duration := time.Since(start)
+	tracing.End(span, err)
 
-	contract, function := unpackReadIdentifier(readIdentifier)
 	o.directRequestsDurations.
 		WithLabelValues(o.chainID, contract, function).
 		Observe(float64(duration))
@@ -125,9 +134,14 @@ func (o *Observed) BatchGetLatestValues(
 	ctx context.Context,
 	request types.BatchGetLatestValuesRequest,
 ) (types.BatchGetLatestValuesResult, error) {
+	ctx, span := tracing.Start(ctx, "ContractReader.BatchGetLatestValues",
+		tracing.ChainIDKey.String(o.chainID),
+		attribute.Int("size", len(request)),
+	)
 	start := time.Now()
 	result, err := o.ContractReaderFacade.BatchGetLatestValues(ctx, request)
 	duration := time.Since(start)
+	tracing.End(span, err)
 
 	o.batchRequestsDurations.
 		WithLabelValues(o.chainID).
@@ -815,6 +815,285 @@ func prepareNoncesInput(
 	return contractInput, responses, nil
 }
 
+func (r *ccipChainReader) GetInboundRateLimits(
+	ctx context.Context,
+	sourceChain cciptypes.ChainSelector,
+	destTokens []cciptypes.UnknownAddress,
+) (InboundRateLimits, error) {
+	lggr := logutil.WithContextValues(ctx, r.lggr)
+	if err := validateExtendedReaderExistence(r.contractReaders, r.destChain); err != nil {
+		return InboundRateLimits{}, err
+	}
+
+	config, err := r.configPoller.GetChainConfig(ctx, r.destChain)
+	if err != nil {
+		return InboundRateLimits{}, fmt.Errorf("get chain config: %w", err)
+	}
+
+	// the destination token addresses, keyed like the results
+	tokens := make(map[string][]byte, len(destTokens))
+	for _, destToken := range destTokens {
+		address, err := r.destTokenAddress(destToken)
+		if err != nil {
+			lggr.Warnw("unable to decode destination token address", "token", destToken, "err", err)
+			continue
+		}
+		tokens[destToken.String()] = address
+	}
+
+	limits := InboundRateLimits{
+		TokenPools:           make(map[string]TokenBucket),
+		AggregateTokenPrices: make(map[string]*big.Int),
+	}
+	if len(tokens) == 0 {
+		return limits, nil
+	}
+
+	registry := cciptypes.UnknownAddress(config.Offramp.StaticConfig.TokenAdminRegistry)
+	if !registry.IsZeroOrEmpty() {
+		limits.TokenPools, err = r.getTokenPoolInboundBuckets(ctx, sourceChain, registry, tokens)
+		if err != nil {
+			return InboundRateLimits{}, fmt.Errorf("get token pool buckets: %w", err)
+		}
+	}
+
+	rateLimiter := cciptypes.UnknownAddress(config.Offramp.DynamicConfig.MessageInterceptor)
+	if !rateLimiter.IsZeroOrEmpty() {
+		limits.Aggregate, limits.AggregateTokenPrices, err = r.getAggregateInboundBucket(
+			ctx, sourceChain, rateLimiter, tokens)
+		if err != nil {
+			return InboundRateLimits{}, fmt.Errorf("get aggregate rate limiter bucket: %w", err)
+		}
+	}
+
+	return limits, nil
+}
+
+// destTokenAddress decodes the destination token address of a message. EVM source chains abi encode it, the
+// address is then the last 20 bytes of the 32 byte word.
+func (r *ccipChainReader) destTokenAddress(token cciptypes.UnknownAddress) ([]byte, error) {
+	_, err := r.addrCodec.AddressBytesToString(token, r.destChain)
+	if err == nil {
+		return token, nil
+	}
+	if len(token) == 32 && bytes.Equal(token[:12], make([]byte, 12)) {
+		if _, err2 := r.addrCodec.AddressBytesToString(token[12:], r.destChain); err2 == nil {
+			return token[12:], nil
+		}
+	}
+	return nil, err
+}
+
+// getTokenPoolInboundBuckets reads the pools of the tokens from the TokenAdminRegistry, and their inbound buckets for
+// the source chain.
+func (r *ccipChainReader) getTokenPoolInboundBuckets(
+	ctx context.Context,
+	sourceChain cciptypes.ChainSelector,
+	registry []byte,
+	tokens map[string][]byte,
+) (map[string]TokenBucket, error) {
+	lggr := logutil.WithContextValues(ctx, r.lggr)
+
+	_, err := bindExtendedReaderContract(
+		ctx, lggr, r.contractReaders, r.destChain, consts.ContractNameTokenAdminRegistry, registry, r.addrCodec)
+	if err != nil {
+		return nil, fmt.Errorf("bind token admin registry: %w", err)
+	}
+
+	keys := maps.Keys(tokens)
+	slices.Sort(keys)
+	addresses := make([][]byte, len(keys))
+	for i, key := range keys {
+		addresses[i] = tokens[key]
+	}
+
+	var pools [][]byte
+	err = r.contractReaders[r.destChain].ExtendedGetLatestValue(
+		ctx,
+		consts.ContractNameTokenAdminRegistry,
+		consts.MethodNameGetPools,
+		primitives.Unconfirmed,
+		map[string]any{"tokens": addresses},
+		&pools,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("get pools: %w", err)
+	}
+	if len(pools) != len(keys) {
+		return nil, fmt.Errorf("expected %d pools, got %d", len(keys), len(pools))
+	}
+
+	request := make(types.BatchGetLatestValuesRequest)
+	poolTokens := make(map[types.BoundContract][]string)
+	for i, pool := range pools {
+		if cciptypes.UnknownAddress(pool).IsZeroOrEmpty() {
+			// the token isn't supported on the destination chain, its messages fail regardless of the rate limits.
+			continue
+		}
+		boundContract, err := bindExtendedReaderContract(
+			ctx, lggr, r.contractReaders, r.destChain, consts.ContractNameTokenPool, pool, r.addrCodec)
+		if err != nil {
+			return nil, fmt.Errorf("bind token pool %x: %w", pool, err)
+		}
+		if _, ok := request[boundContract]; !ok {
+			request[boundContract] = types.ContractBatch{{
+				ReadName:  consts.MethodNameGetCurrentInboundRateLimiterState,
+				Params:    map[string]any{"remoteChainSelector": sourceChain},
+				ReturnVal: &TokenBucket{},
+			}}
+		}
+		poolTokens[boundContract] = append(poolTokens[boundContract], keys[i])
+	}
+	if len(request) == 0 {
+		return map[string]TokenBucket{}, nil
+	}
+
+	results, err := r.contractReaders[r.destChain].BatchGetLatestValues(ctx, request)
+	if err != nil {
+		return nil, fmt.Errorf("batch get inbound rate limiter states: %w", err)
+	}
+
+	buckets := make(map[string]TokenBucket, len(tokens))
+	for boundContract, poolResults := range results {
+		if len(poolResults) != 1 {
+			lggr.Errorw("unexpected number of rate limiter states", "pool", boundContract.Address,
+				"expected", 1, "got", len(poolResults))
+			continue
+		}
+		returnVal, err := poolResults[0].GetResult()
+		if err != nil {
+			lggr.Errorw("failed to get inbound rate limiter state", "pool", boundContract.Address, "err", err)
+			continue
+		}
+		bucket, ok := returnVal.(*TokenBucket)
+		if !ok || bucket == nil {
+			lggr.Errorw("invalid inbound rate limiter state returned", "pool", boundContract.Address)
+			continue
+		}
+		for _, key := range poolTokens[boundContract] {
+			buckets[key] = *bucket
+		}
+	}
+	return buckets, nil
+}
+
+// rateLimitTokens is the response of the MultiAggregateRateLimiter's getAllRateLimitTokens method.
+type rateLimitTokens struct {
+	LocalTokens  [][]byte
+	RemoteTokens [][]byte
+}
+
+// getAggregateInboundBucket reads the inbound bucket of the MultiAggregateRateLimiter for the source chain, and the
+// prices of the tokens which it counts.
+func (r *ccipChainReader) getAggregateInboundBucket(
+	ctx context.Context,
+	sourceChain cciptypes.ChainSelector,
+	rateLimiter []byte,
+	tokens map[string][]byte,
+) (*TokenBucket, map[string]*big.Int, error) {
+	lggr := logutil.WithContextValues(ctx, r.lggr)
+
+	_, err := bindExtendedReaderContract(
+		ctx, lggr, r.contractReaders, r.destChain, consts.ContractNameMultiAggregateRateLimiter, rateLimiter, r.addrCodec)
+	if err != nil {
+		return nil, nil, fmt.Errorf("bind multi aggregate rate limiter: %w", err)
+	}
+
+	results, _, err := r.contractReaders[r.destChain].ExtendedBatchGetLatestValues(
+		ctx,
+		contractreader.ExtendedBatchGetLatestValuesRequest{
+			consts.ContractNameMultiAggregateRateLimiter: {
+				{
+					ReadName: consts.MethodNameCurrentRateLimiterState,
+					Params: map[string]any{
+						"remoteChainSelector": sourceChain,
+						"isOutboundLane":      false,
+					},
+					ReturnVal: &TokenBucket{},
+				},
+				{
+					ReadName:  consts.MethodNameGetAllRateLimitTokens,
+					Params:    map[string]any{"remoteChainSelector": sourceChain},
+					ReturnVal: &rateLimitTokens{},
+				},
+			},
+		},
+		false,
+	)
+	if err != nil {
+		return nil, nil, fmt.Errorf("batch get rate limiter state: %w", err)
+	}
+
+	var bucket *TokenBucket
+	var counted *rateLimitTokens
+	for _, contractResults := range results {
+		if len(contractResults) != 2 {
+			return nil, nil, fmt.Errorf("expected 2 rate limiter results, got %d", len(contractResults))
+		}
+		returnVal, err := contractResults[0].GetResult()
+		if err != nil {
+			return nil, nil, fmt.Errorf("get rate limiter state: %w", err)
+		}
+		bucket, _ = returnVal.(*TokenBucket)
+
+		returnVal, err = contractResults[1].GetResult()
+		if err != nil {
+			return nil, nil, fmt.Errorf("get rate limit tokens: %w", err)
+		}
+		counted, _ = returnVal.(*rateLimitTokens)
+	}
+	if bucket == nil || counted == nil {
+		return nil, nil, fmt.Errorf("invalid rate limiter results")
+	}
+	if !bucket.IsEnabled {
+		return nil, map[string]*big.Int{}, nil
+	}
+
+	// only the tokens registered in the rate limiter count towards the aggregate value
+	var keys []string
+	for key, address := range tokens {
+		for _, local := range counted.LocalTokens {
+			if bytes.Equal(address, local) {
+				keys = append(keys, key)
+				break
+			}
+		}
+	}
+	slices.Sort(keys)
+	prices := make(map[string]*big.Int, len(keys))
+	if len(keys) == 0 {
+		return bucket, prices, nil
+	}
+
+	addresses := make([][]byte, len(keys))
+	for i, key := range keys {
+		addresses[i] = tokens[key]
+	}
+	var updates []cciptypes.TimestampedUnixBig
+	err = r.contractReaders[r.destChain].ExtendedGetLatestValue(
+		ctx,
+		consts.ContractNameFeeQuoter,
+		consts.MethodNameFeeQuoterGetTokenPrices,
+		primitives.Unconfirmed,
+		map[string]any{"tokens": addresses},
+		&updates,
+	)
+	if err != nil {
+		return nil, nil, fmt.Errorf("get token prices: %w", err)
+	}
+	if len(updates) != len(keys) {
+		return nil, nil, fmt.Errorf("expected %d token prices, got %d", len(keys), len(updates))
+	}
+	for i, key := range keys {
+		if updates[i].Value == nil {
+			lggr.Warnw("missing price of rate limited token", "token", key)
+			continue
+		}
+		prices[key] = updates[i].Value
+	}
+	return bucket, prices, nil
+}
+
 func (r *ccipChainReader) GetChainsFeeComponents(
 	ctx context.Context,
 	chains []cciptypes.ChainSelector,
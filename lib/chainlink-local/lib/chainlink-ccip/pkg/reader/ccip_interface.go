@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"math/big"
 	"time"
 
 	"github.com/smartcontractkit/chainlink-common/pkg/logger"
@@ -63,6 +64,29 @@ type RouterConfig struct {
 	WrappedNativeAddress cciptypes.Bytes
 }
 
+// TokenBucket is the state of an onchain token bucket rate limiter. Tokens is the capacity left when the bucket was
+// read, it refills at Rate tokens per second up to Capacity.
+type TokenBucket struct {
+	Tokens      *big.Int
+	LastUpdated uint32
+	IsEnabled   bool
+	Capacity    *big.Int
+	Rate        *big.Int
+}
+
+// InboundRateLimits are the rate limiters of the destination chain that the messages of a source chain go through.
+type InboundRateLimits struct {
+	// TokenPools are the inbound buckets of the destination token pools, keyed by the destination token address of the
+	// messages as returned by UnknownAddress.String. Tokens without a pool are missing.
+	TokenPools map[string]TokenBucket
+	// Aggregate is the inbound bucket of the MultiAggregateRateLimiter used by the offramp, its unit is USD with 18
+	// decimals. It is nil when the offramp doesn't use the rate limiter.
+	Aggregate *TokenBucket
+	// AggregateTokenPrices are the USD prices, with 18 decimals, of the tokens counted by the aggregate rate limiter.
+	// They are keyed like TokenPools.
+	AggregateTokenPrices map[string]*big.Int
+}
+
 func (ca ContractAddresses) Append(contract string, chain cciptypes.ChainSelector, address []byte) ContractAddresses {
 	resp := ca
 	if resp == nil {
@@ -126,17 +150,20 @@ func NewCCIPChainReader(
 	offrampAddress []byte,
 	addrCodec cciptypes.AddressCodec,
 ) CCIPReader {
-	return NewObservedCCIPReader(
-		newCCIPChainReaderInternal(
-			ctx,
+	return NewTracedCCIPReader(
+		NewObservedCCIPReader(
+			newCCIPChainReaderInternal(
+				ctx,
+				lggr,
+				contractReaders,
+				contractWriters,
+				destChain,
+				offrampAddress,
+				addrCodec,
+			),
 			lggr,
-			contractReaders,
-			contractWriters,
 			destChain,
-			offrampAddress,
-			addrCodec,
 		),
-		lggr,
 		destChain,
 	)
 }
@@ -266,5 +293,14 @@ type CCIPReader interface {
 	GetOffRampSourceChainsConfig(ctx context.Context, sourceChains []cciptypes.ChainSelector,
 	) (map[cciptypes.ChainSelector]StaticSourceChainConfig, error)
 
+	// GetInboundRateLimits reads the rate limiters of the destination chain for the messages of the provided source
+	// chain: the inbound buckets of the pools of the provided destination tokens, and the inbound bucket of the
+	// MultiAggregateRateLimiter with the prices of the tokens it counts if the offramp uses one.
+	GetInboundRateLimits(
+		ctx context.Context,
+		sourceChain cciptypes.ChainSelector,
+		destTokens []cciptypes.UnknownAddress,
+	) (InboundRateLimits, error)
+
 	Close() error
 }
@@ -1523,6 +1523,115 @@ func TestCCIPChainReader_Nonces(t *testing.T) {
 	}
 }
 
+func TestCCIPChainReader_GetInboundRateLimits(t *testing.T) {
+	var (
+		tokenA      = cciptypes.UnknownAddress(bytes.Repeat([]byte{0x1}, 20))
+		tokenB      = cciptypes.UnknownAddress(bytes.Repeat([]byte{0x2}, 20))
+		registry    = bytes.Repeat([]byte{0x3}, 20)
+		rateLimiter = bytes.Repeat([]byte{0x4}, 20)
+		poolA       = bytes.Repeat([]byte{0x5}, 20)
+	)
+	poolBucket := TokenBucket{
+		Tokens: big.NewInt(50), IsEnabled: true, Capacity: big.NewInt(100), Rate: big.NewInt(1),
+	}
+	aggregateBucket := TokenBucket{
+		Tokens: big.NewInt(1e18), IsEnabled: true, Capacity: big.NewInt(2e18), Rate: big.NewInt(1e15),
+	}
+
+	mockCache := new(mockConfigCache)
+	mockCache.On("GetChainConfig", mock.Anything, chainC).Return(ChainConfigSnapshot{
+		Offramp: OfframpConfig{
+			StaticConfig:  offRampStaticChainConfig{TokenAdminRegistry: registry},
+			DynamicConfig: offRampDynamicChainConfig{MessageInterceptor: rateLimiter},
+		},
+	}, nil)
+
+	destCR := reader_mocks.NewMockExtended(t)
+	destCR.EXPECT().Bind(mock.Anything, mock.Anything).Return(nil)
+
+	// tokenB has no pool on the destination chain
+	destCR.EXPECT().ExtendedGetLatestValue(
+		mock.Anything,
+		consts.ContractNameTokenAdminRegistry,
+		consts.MethodNameGetPools,
+		primitives.Unconfirmed,
+		map[string]any{"tokens": [][]byte{tokenA, tokenB}},
+		mock.Anything,
+	).Return(nil).Run(withReturnValueOverridden(func(returnVal interface{}) {
+		*returnVal.(*[][]byte) = [][]byte{poolA, make([]byte, 20)}
+	}))
+
+	poolContract := types.BoundContract{Name: consts.ContractNameTokenPool, Address: "0x" + hex.EncodeToString(poolA)}
+	destCR.EXPECT().BatchGetLatestValues(
+		mock.Anything,
+		mock.MatchedBy(func(req types.BatchGetLatestValuesRequest) bool {
+			batch, ok := req[poolContract]
+			return ok && len(req) == 1 && len(batch) == 1 &&
+				batch[0].ReadName == consts.MethodNameGetCurrentInboundRateLimiterState &&
+				batch[0].Params.(map[string]any)["remoteChainSelector"] == chainA
+		}),
+	).RunAndReturn(func(
+		_ context.Context, req types.BatchGetLatestValuesRequest,
+	) (types.BatchGetLatestValuesResult, error) {
+		r := types.BatchReadResult{ReadName: consts.MethodNameGetCurrentInboundRateLimiterState}
+		r.SetResult(&poolBucket, nil)
+		return types.BatchGetLatestValuesResult{poolContract: {r}}, nil
+	})
+
+	// only tokenB is counted by the aggregate rate limiter
+	destCR.EXPECT().ExtendedBatchGetLatestValues(
+		mock.Anything,
+		mock.MatchedBy(func(req contractreader.ExtendedBatchGetLatestValuesRequest) bool {
+			batch := req[consts.ContractNameMultiAggregateRateLimiter]
+			return len(batch) == 2 &&
+				batch[0].ReadName == consts.MethodNameCurrentRateLimiterState &&
+				batch[0].Params.(map[string]any)["isOutboundLane"] == false &&
+				batch[1].ReadName == consts.MethodNameGetAllRateLimitTokens
+		}),
+		false,
+	).RunAndReturn(func(
+		_ context.Context, _ contractreader.ExtendedBatchGetLatestValuesRequest, _ bool,
+	) (types.BatchGetLatestValuesResult, []string, error) {
+		state := types.BatchReadResult{ReadName: consts.MethodNameCurrentRateLimiterState}
+		state.SetResult(&aggregateBucket, nil)
+		tokens := types.BatchReadResult{ReadName: consts.MethodNameGetAllRateLimitTokens}
+		tokens.SetResult(&rateLimitTokens{LocalTokens: [][]byte{tokenB}}, nil)
+		return types.BatchGetLatestValuesResult{
+			types.BoundContract{Name: consts.ContractNameMultiAggregateRateLimiter}: {state, tokens},
+		}, nil, nil
+	})
+
+	destCR.EXPECT().ExtendedGetLatestValue(
+		mock.Anything,
+		consts.ContractNameFeeQuoter,
+		consts.MethodNameFeeQuoterGetTokenPrices,
+		primitives.Unconfirmed,
+		map[string]any{"tokens": [][]byte{tokenB}},
+		mock.Anything,
+	).Return(nil).Run(withReturnValueOverridden(func(returnVal interface{}) {
+		*returnVal.(*[]cciptypes.TimestampedUnixBig) = []cciptypes.TimestampedUnixBig{{Value: big.NewInt(2e18)}}
+	}))
+
+	ccipReader := &ccipChainReader{
+		lggr:         logger.Test(t),
+		destChain:    chainC,
+		configPoller: mockCache,
+		contractReaders: map[cciptypes.ChainSelector]contractreader.Extended{
+			chainC: destCR,
+		},
+		addrCodec: internal.NewMockAddressCodecHex(t),
+	}
+
+	limits, err := ccipReader.GetInboundRateLimits(
+		context.Background(), chainA, []cciptypes.UnknownAddress{tokenB, tokenA})
+	require.NoError(t, err)
+	assert.Equal(t, InboundRateLimits{
+		TokenPools:           map[string]TokenBucket{tokenA.String(): poolBucket},
+		Aggregate:            &aggregateBucket,
+		AggregateTokenPrices: map[string]*big.Int{tokenB.String(): big.NewInt(2e18)},
+	}, limits)
+}
+
 func TestCCIPChainReader_DiscoverContracts_Parallel(t *testing.T) {
 	ctx := tests.Context(t)
 	destChain := cciptypes.ChainSelector(1)
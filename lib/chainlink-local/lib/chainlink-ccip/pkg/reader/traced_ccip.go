@@ -0,0 +1,246 @@
+package reader
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/types"
+	"github.com/smartcontractkit/chainlink-common/pkg/types/query/primitives"
+
+	"github.com/smartcontractkit/chainlink-ccip/pkg/tracing"
+	cciptypes "github.com/smartcontractkit/chainlink-ccip/pkg/types/ccipocr3"
+)
+
+// tracedCCIPReader starts a span for each CCIPReader call, a child of the span of the calling plugin phase or
+// processor. The contract reader calls made by the reader are children of this span.
+type tracedCCIPReader struct {
+	CCIPReader
+	destChain cciptypes.ChainSelector
+}
+
+func NewTracedCCIPReader(reader CCIPReader, destChainSelector cciptypes.ChainSelector) CCIPReader {
+	return &tracedCCIPReader{
+		CCIPReader: reader,
+		destChain:  destChainSelector,
+	}
+}
+
+func (t *tracedCCIPReader) CommitReportsGTETimestamp(
+	ctx context.Context,
+	ts time.Time,
+	confidence primitives.ConfidenceLevel,
+	limit int,
+) ([]cciptypes.CommitPluginReportWithMeta, error) {
+	return traced(ctx, t, "CommitReportsGTETimestamp",
+		func(ctx context.Context) ([]cciptypes.CommitPluginReportWithMeta, error) {
+			return t.CCIPReader.CommitReportsGTETimestamp(ctx, ts, confidence, limit)
+		},
+		attribute.Int("limit", limit),
+	)
+}
+
+func (t *tracedCCIPReader) ExecutedMessages(
+	ctx context.Context,
+	rangesPerChain map[cciptypes.ChainSelector][]cciptypes.SeqNumRange,
+	confidence primitives.ConfidenceLevel,
+) (map[cciptypes.ChainSelector][]cciptypes.SeqNum, error) {
+	return traced(ctx, t, "ExecutedMessages",
+		func(ctx context.Context) (map[cciptypes.ChainSelector][]cciptypes.SeqNum, error) {
+			return t.CCIPReader.ExecutedMessages(ctx, rangesPerChain, confidence)
+		},
+		attribute.Int("chains", len(rangesPerChain)),
+	)
+}
+
+func (t *tracedCCIPReader) MsgsBetweenSeqNums(
+	ctx context.Context,
+	chain cciptypes.ChainSelector,
+	seqNumRange cciptypes.SeqNumRange,
+) ([]cciptypes.Message, error) {
+	return traced(ctx, t, "MsgsBetweenSeqNums",
+		func(ctx context.Context) ([]cciptypes.Message, error) {
+			return t.CCIPReader.MsgsBetweenSeqNums(ctx, chain, seqNumRange)
+		},
+		selectorAttr(tracing.ChainKey, chain),
+		attribute.String("seqNumRange", seqNumRange.String()),
+	)
+}
+
+func (t *tracedCCIPReader) LatestMsgSeqNum(
+	ctx context.Context,
+	chain cciptypes.ChainSelector,
+) (cciptypes.SeqNum, error) {
+	return traced(ctx, t, "LatestMsgSeqNum",
+		func(ctx context.Context) (cciptypes.SeqNum, error) {
+			return t.CCIPReader.LatestMsgSeqNum(ctx, chain)
+		},
+		selectorAttr(tracing.ChainKey, chain),
+	)
+}
+
+func (t *tracedCCIPReader) GetExpectedNextSequenceNumber(
+	ctx context.Context,
+	sourceChainSelector cciptypes.ChainSelector,
+) (cciptypes.SeqNum, error) {
+	return traced(ctx, t, "GetExpectedNextSequenceNumber",
+		func(ctx context.Context) (cciptypes.SeqNum, error) {
+			return t.CCIPReader.GetExpectedNextSequenceNumber(ctx, sourceChainSelector)
+		},
+		selectorAttr(tracing.ChainKey, sourceChainSelector),
+	)
+}
+
+func (t *tracedCCIPReader) NextSeqNum(
+	ctx context.Context,
+	chains []cciptypes.ChainSelector,
+) (map[cciptypes.ChainSelector]cciptypes.SeqNum, error) {
+	return traced(ctx, t, "NextSeqNum",
+		func(ctx context.Context) (map[cciptypes.ChainSelector]cciptypes.SeqNum, error) {
+			return t.CCIPReader.NextSeqNum(ctx, chains)
+		},
+		attribute.Int("chains", len(chains)),
+	)
+}
+
+func (t *tracedCCIPReader) Nonces(
+	ctx context.Context,
+	addressesByChain map[cciptypes.ChainSelector][]string,
+) (map[cciptypes.ChainSelector]map[string]uint64, error) {
+	return traced(ctx, t, "Nonces",
+		func(ctx context.Context) (map[cciptypes.ChainSelector]map[string]uint64, error) {
+			return t.CCIPReader.Nonces(ctx, addressesByChain)
+		},
+		attribute.Int("chains", len(addressesByChain)),
+	)
+}
+
+func (t *tracedCCIPReader) GetChainsFeeComponents(
+	ctx context.Context,
+	chains []cciptypes.ChainSelector,
+) map[cciptypes.ChainSelector]types.ChainFeeComponents {
+	res, _ := traced(ctx, t, "GetChainsFeeComponents",
+		func(ctx context.Context) (map[cciptypes.ChainSelector]types.ChainFeeComponents, error) {
+			return t.CCIPReader.GetChainsFeeComponents(ctx, chains), nil
+		},
+		attribute.Int("chains", len(chains)),
+	)
+	return res
+}
+
+func (t *tracedCCIPReader) GetDestChainFeeComponents(ctx context.Context) (types.ChainFeeComponents, error) {
+	return traced(ctx, t, "GetDestChainFeeComponents", t.CCIPReader.GetDestChainFeeComponents)
+}
+
+func (t *tracedCCIPReader) GetWrappedNativeTokenPriceUSD(
+	ctx context.Context,
+	selectors []cciptypes.ChainSelector,
+) map[cciptypes.ChainSelector]cciptypes.BigInt {
+	res, _ := traced(ctx, t, "GetWrappedNativeTokenPriceUSD",
+		func(ctx context.Context) (map[cciptypes.ChainSelector]cciptypes.BigInt, error) {
+			return t.CCIPReader.GetWrappedNativeTokenPriceUSD(ctx, selectors), nil
+		},
+		attribute.Int("chains", len(selectors)),
+	)
+	return res
+}
+
+func (t *tracedCCIPReader) GetChainFeePriceUpdate(
+	ctx context.Context,
+	selectors []cciptypes.ChainSelector,
+) map[cciptypes.ChainSelector]cciptypes.TimestampedBig {
+	res, _ := traced(ctx, t, "GetChainFeePriceUpdate",
+		func(ctx context.Context) (map[cciptypes.ChainSelector]cciptypes.TimestampedBig, error) {
+			return t.CCIPReader.GetChainFeePriceUpdate(ctx, selectors), nil
+		},
+		attribute.Int("chains", len(selectors)),
+	)
+	return res
+}
+
+func (t *tracedCCIPReader) GetRMNRemoteConfig(ctx context.Context) (cciptypes.RemoteConfig, error) {
+	return traced(ctx, t, "GetRMNRemoteConfig", t.CCIPReader.GetRMNRemoteConfig)
+}
+
+func (t *tracedCCIPReader) GetRmnCurseInfo(ctx context.Context) (CurseInfo, error) {
+	return traced(ctx, t, "GetRmnCurseInfo", t.CCIPReader.GetRmnCurseInfo)
+}
+
+func (t *tracedCCIPReader) DiscoverContracts(
+	ctx context.Context,
+	allChains []cciptypes.ChainSelector,
+) (ContractAddresses, error) {
+	return traced(ctx, t, "DiscoverContracts",
+		func(ctx context.Context) (ContractAddresses, error) {
+			return t.CCIPReader.DiscoverContracts(ctx, allChains)
+		},
+		attribute.Int("chains", len(allChains)),
+	)
+}
+
+func (t *tracedCCIPReader) LinkPriceUSD(ctx context.Context) (cciptypes.BigInt, error) {
+	return traced(ctx, t, "LinkPriceUSD", t.CCIPReader.LinkPriceUSD)
+}
+
+func (t *tracedCCIPReader) Sync(ctx context.Context, contracts ContractAddresses) error {
+	_, err := traced(ctx, t, "Sync", func(ctx context.Context) (any, error) {
+		return nil, t.CCIPReader.Sync(ctx, contracts)
+	})
+	return err
+}
+
+func (t *tracedCCIPReader) GetLatestPriceSeqNr(ctx context.Context) (uint64, error) {
+	return traced(ctx, t, "GetLatestPriceSeqNr", t.CCIPReader.GetLatestPriceSeqNr)
+}
+
+func (t *tracedCCIPReader) GetOffRampConfigDigest(ctx context.Context, pluginType uint8) ([32]byte, error) {
+	return traced(ctx, t, "GetOffRampConfigDigest",
+		func(ctx context.Context) ([32]byte, error) {
+			return t.CCIPReader.GetOffRampConfigDigest(ctx, pluginType)
+		},
+		attribute.Int("pluginType", int(pluginType)),
+	)
+}
+
+func (t *tracedCCIPReader) GetOffRampSourceChainsConfig(
+	ctx context.Context,
+	sourceChains []cciptypes.ChainSelector,
+) (map[cciptypes.ChainSelector]StaticSourceChainConfig, error) {
+	return traced(ctx, t, "GetOffRampSourceChainsConfig",
+		func(ctx context.Context) (map[cciptypes.ChainSelector]StaticSourceChainConfig, error) {
+			return t.CCIPReader.GetOffRampSourceChainsConfig(ctx, sourceChains)
+		},
+		attribute.Int("chains", len(sourceChains)),
+	)
+}
+
+func (t *tracedCCIPReader) GetInboundRateLimits(
+	ctx context.Context,
+	sourceChain cciptypes.ChainSelector,
+	destTokens []cciptypes.UnknownAddress,
+) (InboundRateLimits, error) {
+	return traced(ctx, t, "GetInboundRateLimits",
+		func(ctx context.Context) (InboundRateLimits, error) {
+			return t.CCIPReader.GetInboundRateLimits(ctx, sourceChain, destTokens)
+		},
+		selectorAttr(tracing.ChainKey, sourceChain),
+		attribute.Int("tokens", len(destTokens)),
+	)
+}
+
+func traced[T any](
+	ctx context.Context,
+	t *tracedCCIPReader,
+	method string,
+	f func(ctx context.Context) (T, error),
+	attrs ...attribute.KeyValue,
+) (T, error) {
+	attrs = append(attrs, selectorAttr("destChainSelector", t.destChain))
+	return tracing.Trace(ctx, "CCIPReader."+method, f, attrs...)
+}
+
+func selectorAttr(key attribute.Key, chain cciptypes.ChainSelector) attribute.KeyValue {
+	return key.String(strconv.FormatUint(uint64(chain), 10))
+}
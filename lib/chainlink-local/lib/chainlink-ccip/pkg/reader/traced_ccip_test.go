@@ -0,0 +1,84 @@
+package reader_test
+
+import (
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/types"
+	"github.com/smartcontractkit/chainlink-common/pkg/utils/tests"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+
+	mock_reader "github.com/smartcontractkit/chainlink-ccip/mocks/pkg/reader"
+	"github.com/smartcontractkit/chainlink-ccip/pkg/reader"
+	"github.com/smartcontractkit/chainlink-ccip/pkg/tracing"
+	cciptypes "github.com/smartcontractkit/chainlink-ccip/pkg/types/ccipocr3"
+)
+
+func Test_TracedCCIPReader(t *testing.T) {
+	recorder := tracetest.NewSpanRecorder()
+	prev := otel.GetTracerProvider()
+	otel.SetTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder)))
+	t.Cleanup(func() { otel.SetTracerProvider(prev) })
+
+	destChain := cciptypes.ChainSelector(12922642891491394802)
+	sourceChain := cciptypes.ChainSelector(4793464827907405086)
+	seqNumRange := cciptypes.NewSeqNumRange(10, 20)
+
+	origin := mock_reader.NewMockCCIPReader(t)
+	r := reader.NewTracedCCIPReader(origin, destChain)
+
+	origin.EXPECT().
+		MsgsBetweenSeqNums(mock.Anything, sourceChain, seqNumRange).
+		Return([]cciptypes.Message{{}}, nil)
+	origin.EXPECT().
+		GetChainsFeeComponents(mock.Anything, []cciptypes.ChainSelector{sourceChain}).
+		Return(map[cciptypes.ChainSelector]types.ChainFeeComponents{
+			sourceChain: {ExecutionFee: big.NewInt(1), DataAvailabilityFee: big.NewInt(2)},
+		})
+	origin.EXPECT().
+		GetRMNRemoteConfig(mock.Anything).
+		Return(cciptypes.RemoteConfig{}, errors.New("rpc down"))
+
+	// the reader spans are children of the span of the caller
+	ctx, parent := tracing.Start(tests.Context(t), "Commit.Observation")
+
+	msgs, err := r.MsgsBetweenSeqNums(ctx, sourceChain, seqNumRange)
+	require.NoError(t, err)
+	require.Len(t, msgs, 1)
+
+	fees := r.GetChainsFeeComponents(ctx, []cciptypes.ChainSelector{sourceChain})
+	require.Len(t, fees, 1)
+
+	_, err = r.GetRMNRemoteConfig(ctx)
+	require.EqualError(t, err, "rpc down")
+	tracing.End(parent, nil)
+
+	spans := recorder.Ended()
+	require.Len(t, spans, 4)
+	msgsSpan, feesSpan, rmnSpan := spans[0], spans[1], spans[2]
+	for _, span := range spans[:3] {
+		require.Equal(t, parent.SpanContext().SpanID(), span.Parent().SpanID())
+		require.Equal(t, parent.SpanContext().TraceID(), span.SpanContext().TraceID())
+		require.Contains(t, span.Attributes(), attribute.String("destChainSelector", "12922642891491394802"))
+	}
+
+	require.Equal(t, "CCIPReader.MsgsBetweenSeqNums", msgsSpan.Name())
+	require.Equal(t, codes.Unset, msgsSpan.Status().Code)
+	require.Contains(t, msgsSpan.Attributes(), tracing.ChainKey.String("4793464827907405086"))
+	require.Contains(t, msgsSpan.Attributes(), attribute.String("seqNumRange", seqNumRange.String()))
+
+	require.Equal(t, "CCIPReader.GetChainsFeeComponents", feesSpan.Name())
+	require.Contains(t, feesSpan.Attributes(), attribute.Int("chains", 1))
+
+	require.Equal(t, "CCIPReader.GetRMNRemoteConfig", rmnSpan.Name())
+	require.Equal(t, codes.Error, rmnSpan.Status().Code)
+	require.Equal(t, "rpc down", rmnSpan.Status().Description)
+	require.Len(t, rmnSpan.Events(), 1)
+}
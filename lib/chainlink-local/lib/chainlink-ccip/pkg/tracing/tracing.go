@@ -0,0 +1,55 @@
+// Package tracing creates the OpenTelemetry spans of the CCIP plugins.
+//
+// Spans are created with the global tracer provider, which the node sets up from its Tracing config and exports to the
+// configured OTLP collector. When tracing is disabled the global provider is a no-op and so are the spans.
+package tracing
+
+import (
+	"context"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const tracerName = "github.com/smartcontractkit/chainlink-ccip"
+
+// Standardized span attribute keys, matching the logger keys of logutil where they overlap.
+const (
+	PluginKey    = attribute.Key("plugin")
+	DonIDKey     = attribute.Key("donID")
+	OracleIDKey  = attribute.Key("oracleID")
+	OCRSeqNrKey  = attribute.Key("ocrSeqNr")
+	ChainKey     = attribute.Key("chainSelector")
+	ChainIDKey   = attribute.Key("chainID")
+	ProcessorKey = attribute.Key("processor")
+	TokenKey     = attribute.Key("token")
+)
+
+// Start starts a span named name, child of the span of ctx if any. The span must be ended with End.
+func Start(ctx context.Context, name string, attrs ...attribute.KeyValue) (context.Context, trace.Span) {
+	return otel.Tracer(tracerName).Start(ctx, name, trace.WithAttributes(attrs...))
+}
+
+// End ends the span, recording err and setting the error status if it is not nil.
+func End(span trace.Span, err error) {
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+	span.End()
+}
+
+// Trace runs f in a span named name and returns its results.
+func Trace[T any](
+	ctx context.Context,
+	name string,
+	f func(ctx context.Context) (T, error),
+	attrs ...attribute.KeyValue,
+) (T, error) {
+	ctx, span := Start(ctx, name, attrs...)
+	res, err := f(ctx)
+	End(span, err)
+	return res, err
+}
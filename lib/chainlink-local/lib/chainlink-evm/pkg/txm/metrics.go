@@ -10,6 +10,7 @@ import (
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/prometheus/client_golang/prometheus/promauto"
+	"go.opentelemetry.io/otel/attribute"
 	"go.opentelemetry.io/otel/metric"
 	"google.golang.org/protobuf/proto"
 
@@ -32,6 +33,10 @@ var (
 		Name: "txm_num_nonce_gaps",
 		Help: "Total number of nonce gaps created that the transaction manager had to fill.",
 	}, []string{"chainID"})
+	promNumStuckTxDecisions = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "txm_num_stuck_tx_decisions",
+		Help: "Total number of remedies applied to stuck transactions, by reason and remedy.",
+	}, []string{"chainID", "reason", "remedy"})
 	promTimeUntilTxConfirmed = promauto.NewHistogramVec(prometheus.HistogramOpts{
 		Name: "txm_time_until_tx_confirmed",
 		Help: "The amount of time elapsed from a transaction being broadcast to being included in a block.",
@@ -44,6 +49,7 @@ type txmMetrics struct {
 	numBroadcastedTxs    metric.Int64Counter
 	numConfirmedTxs      metric.Int64Counter
 	numNonceGaps         metric.Int64Counter
+	numStuckTxDecisions  metric.Int64Counter
 	timeUntilTxConfirmed metric.Float64Histogram
 }
 
@@ -63,6 +69,11 @@ func NewTxmMetrics(chainID *big.Int) (*txmMetrics, error) {
 		return nil, fmt.Errorf("failed to register nonce gaps number: %w", err)
 	}
 
+	numStuckTxDecisions, err := beholder.GetMeter().Int64Counter("txm_num_stuck_tx_decisions")
+	if err != nil {
+		return nil, fmt.Errorf("failed to register stuck tx decisions number: %w", err)
+	}
+
 	timeUntilTxConfirmed, err := beholder.GetMeter().Float64Histogram("txm_time_until_tx_confirmed")
 	if err != nil {
 		return nil, fmt.Errorf("failed to register time until tx confirmed: %w", err)
@@ -74,6 +85,7 @@ func NewTxmMetrics(chainID *big.Int) (*txmMetrics, error) {
 		numBroadcastedTxs:    numBroadcastedTxs,
 		numConfirmedTxs:      numConfirmedTxs,
 		numNonceGaps:         numNonceGaps,
+		numStuckTxDecisions:  numStuckTxDecisions,
 		timeUntilTxConfirmed: timeUntilTxConfirmed,
 	}, nil
 }
@@ -93,6 +105,14 @@ func (m *txmMetrics) IncrementNumNonceGaps(ctx context.Context) {
 	m.numNonceGaps.Add(ctx, 1)
 }
 
+func (m *txmMetrics) IncrementNumStuckTxDecisions(ctx context.Context, reason types.StuckTxReason, remedy types.StuckTxRemedy) {
+	promNumStuckTxDecisions.WithLabelValues(m.chainID.String(), string(reason), string(remedy)).Add(float64(1))
+	m.numStuckTxDecisions.Add(ctx, 1, metric.WithAttributes(
+		attribute.String("reason", string(reason)),
+		attribute.String("remedy", string(remedy)),
+	))
+}
+
 func (m *txmMetrics) RecordTimeUntilTxConfirmed(ctx context.Context, duration float64) {
 	promTimeUntilTxConfirmed.WithLabelValues(m.chainID.String()).Observe(duration)
 	m.timeUntilTxConfirmed.Record(ctx, duration)
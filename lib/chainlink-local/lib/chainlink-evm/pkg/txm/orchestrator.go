@@ -371,3 +371,13 @@ func (o *Orchestrator[BLOCK_HASH, HEAD]) SendNativeToken(ctx context.Context, ch
 	o.txm.Trigger(from)
 	return tx, err
 }
+
+// StuckTxHistory returns the most recent stuck transaction decisions of the TXM for the address, oldest first.
+func (o *Orchestrator[BLOCK_HASH, HEAD]) StuckTxHistory(ctx context.Context, address common.Address) ([]txmtypes.StuckTxDecision, error) {
+	return o.txm.StuckTxHistory(address), nil
+}
+
+// FindStuckTransactions returns the transactions that the TXM is currently recovering, at most one per enabled address.
+func (o *Orchestrator[BLOCK_HASH, HEAD]) FindStuckTransactions(ctx context.Context) ([]*txmtypes.Transaction, error) {
+	return o.txm.FindStuckTransactions(), nil
+}
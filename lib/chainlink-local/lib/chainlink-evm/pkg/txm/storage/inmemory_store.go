@@ -108,7 +108,7 @@ func (m *InMemoryStore) CreateEmptyUnconfirmedTransaction(nonce uint64, gasLimit
 		ChainID:           m.chainID,
 		Nonce:             &nonce,
 		FromAddress:       m.address,
-		ToAddress:         common.Address{},
+		ToAddress:         m.address,
 		Value:             big.NewInt(0),
 		SpecifiedGasLimit: gasLimit,
 		CreatedAt:         time.Now(),
@@ -146,6 +146,7 @@ func TestCreateEmptyUnconfirmedTransaction(t *testing.T) {
 		tx, err := m.CreateEmptyUnconfirmedTransaction(2, 0)
 		require.NoError(t, err)
 		assert.Equal(t, txmgr.TxUnconfirmed, tx.State)
+		assert.Equal(t, fromAddress, tx.ToAddress, "empty transactions are self transfers")
 	})
 }
 
@@ -0,0 +1,191 @@
+package txm
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/smartcontractkit/chainlink-evm/pkg/gas"
+	"github.com/smartcontractkit/chainlink-evm/pkg/txm/types"
+)
+
+// stuckTxHistorySize is the number of decisions kept per address
+const stuckTxHistorySize = 100
+
+// stuckTxDiagnosis is why the lowest nonce unconfirmed transaction of an address isn't mined
+type stuckTxDiagnosis struct {
+	// InMempool is false if the RPC doesn't hold any transaction of the address at or above the nonce of the transaction
+	InMempool bool
+	// FeeBelowMarket is true if the fee of the newest attempt is below the fee currently estimated for the chain
+	FeeBelowMarket bool
+}
+
+// decideStuckTxRemedy picks the remedy for a stuck transaction. Nonce gaps are detected before any transaction is
+// diagnosed and are always filled with a self transfer. On chains that support purging, transactions are purged as
+// soon as the StuckTxDetector considers them terminally stuck, so they are never diagnosed.
+func decideStuckTxRemedy(d stuckTxDiagnosis) (types.StuckTxReason, types.StuckTxRemedy) {
+	switch {
+	case !d.InMempool:
+		return types.StuckTxReasonDroppedFromMempool, types.StuckTxRemedyRebroadcast
+	case d.FeeBelowMarket:
+		return types.StuckTxReasonFeeTooLow, types.StuckTxRemedyBump
+	default:
+		return types.StuckTxReasonTerminallyStuck, types.StuckTxRemedyRebroadcast
+	}
+}
+
+// diagnoseStuckTransaction diagnoses a transaction that has been waiting for confirmation for more than
+// RetryBlockThreshold blocks since its newest attempt was broadcasted.
+func (t *Txm) diagnoseStuckTransaction(ctx context.Context, tx *types.Transaction, lastAttempt *types.Attempt) (stuckTxDiagnosis, error) {
+	var d stuckTxDiagnosis
+
+	// The pending nonce includes the mempool of the RPC, it doesn't move past the nonce of a dropped transaction.
+	pendingNonce, err := t.client.PendingNonceAt(ctx, tx.FromAddress)
+	if err != nil {
+		return d, fmt.Errorf("failed to fetch pending nonce for txID: %v: %w", tx.ID, err)
+	}
+	d.InMempool = pendingNonce > *tx.Nonce
+
+	marketAttempt, err := t.attemptBuilder.NewAttempt(ctx, t.lggr, tx, t.config.EIP1559)
+	if err != nil {
+		return d, fmt.Errorf("failed to estimate market fee for txID: %v: %w", tx.ID, err)
+	}
+	d.FeeBelowMarket = compareFees(lastAttempt.Fee, marketAttempt.Fee) < 0
+	return d, nil
+}
+
+// compareFees compares the fees of the same type. Fees of different types are considered equal.
+func compareFees(fee gas.EvmFee, other gas.EvmFee) int {
+	if fee.GasPrice != nil && other.GasPrice != nil {
+		return fee.GasPrice.Cmp(other.GasPrice)
+	}
+	if !fee.ValidDynamic() || !other.ValidDynamic() {
+		return 0
+	}
+	if fee.GasFeeCap.Cmp(other.GasFeeCap) == 0 {
+		return fee.GasTipCap.Cmp(other.GasTipCap)
+	}
+	return fee.GasFeeCap.Cmp(other.GasFeeCap)
+}
+
+// recoverStuckTransaction diagnoses the transaction, applies the remedy and records the decision.
+func (t *Txm) recoverStuckTransaction(ctx context.Context, tx *types.Transaction, address common.Address) error {
+	lastAttempt := tx.Attempts[len(tx.Attempts)-1]
+	d, err := t.diagnoseStuckTransaction(ctx, tx, lastAttempt)
+	if err != nil {
+		return err
+	}
+
+	reason, remedy := decideStuckTxRemedy(d)
+	switch remedy {
+	case types.StuckTxRemedyRebroadcast:
+		t.lggr.Info("Rebroadcasting attempt for txID: ", tx.ID)
+		err = t.sendTransactionWithError(ctx, tx, lastAttempt, address)
+	case types.StuckTxRemedyBump:
+		err = t.createAndSendBumpAttempt(ctx, tx, *lastAttempt, address)
+	default:
+		err = fmt.Errorf("unknown stuck transaction remedy: %s", remedy)
+	}
+	t.recordStuckTxDecision(ctx, tx, reason, remedy, err)
+	return err
+}
+
+func (t *Txm) createAndSendBumpAttempt(ctx context.Context, tx *types.Transaction, previousAttempt types.Attempt, address common.Address) error {
+	attempt, err := t.attemptBuilder.NewBumpAttempt(ctx, t.lggr, tx, previousAttempt)
+	if err != nil {
+		return err
+	}
+	if err = t.txStore.AppendAttemptToTransaction(ctx, *tx.Nonce, address, attempt); err != nil {
+		return err
+	}
+	t.lggr.Infow("Bumped attempt", "txID", tx.ID, "previousFee", previousAttempt.Fee, "fee", attempt.Fee)
+	return t.sendTransactionWithError(ctx, tx, attempt, address)
+}
+
+func (t *Txm) recordStuckTxDecision(ctx context.Context, tx *types.Transaction, reason types.StuckTxReason, remedy types.StuckTxRemedy, err error) {
+	d := types.StuckTxDecision{
+		TxID:        tx.ID,
+		FromAddress: tx.FromAddress,
+		Nonce:       *tx.Nonce,
+		Reason:      reason,
+		Remedy:      remedy,
+		DecidedAt:   time.Now(),
+	}
+	if err != nil {
+		d.Error = err.Error()
+	}
+	t.metrics.IncrementNumStuckTxDecisions(ctx, reason, remedy)
+	t.lggr.Warnw("Recovering stuck transaction", "txID", tx.ID, "fromAddress", tx.FromAddress, "nonce", *tx.Nonce,
+		"reason", reason, "remedy", remedy, "err", err)
+	t.stuckTxHistory.record(tx, d)
+}
+
+// stuckTxHistory keeps the latest stuck transaction decisions of each address, and the transaction of each address
+// that is currently being recovered. Like the transactions of the TXM, it is kept in memory.
+type stuckTxHistory struct {
+	mu        sync.RWMutex
+	decisions map[common.Address][]types.StuckTxDecision
+	stuckTxs  map[common.Address]*types.Transaction
+}
+
+func newStuckTxHistory() *stuckTxHistory {
+	return &stuckTxHistory{
+		decisions: make(map[common.Address][]types.StuckTxDecision),
+		stuckTxs:  make(map[common.Address]*types.Transaction),
+	}
+}
+
+func (h *stuckTxHistory) record(tx *types.Transaction, d types.StuckTxDecision) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	decisions := append(h.decisions[d.FromAddress], d)
+	if len(decisions) > stuckTxHistorySize {
+		decisions = decisions[len(decisions)-stuckTxHistorySize:]
+	}
+	h.decisions[d.FromAddress] = decisions
+	h.stuckTxs[d.FromAddress] = tx.DeepCopy()
+}
+
+// unstuck forgets the transaction of the address that was being recovered unless it is still the lowest nonce
+// unconfirmed transaction.
+func (h *stuckTxHistory) unstuck(address common.Address, lowestUnconfirmed *types.Transaction) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	stuckTx, exists := h.stuckTxs[address]
+	if exists && (lowestUnconfirmed == nil || stuckTx.ID != lowestUnconfirmed.ID) {
+		delete(h.stuckTxs, address)
+	}
+}
+
+func (h *stuckTxHistory) get(address common.Address) []types.StuckTxDecision {
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+
+	return append([]types.StuckTxDecision{}, h.decisions[address]...)
+}
+
+func (h *stuckTxHistory) stuckTransactions() []*types.Transaction {
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+
+	txs := make([]*types.Transaction, 0, len(h.stuckTxs))
+	for _, tx := range h.stuckTxs {
+		txs = append(txs, tx.DeepCopy())
+	}
+	return txs
+}
+
+// StuckTxHistory returns the most recent stuck transaction decisions for the address, oldest first
+func (t *Txm) StuckTxHistory(address common.Address) []types.StuckTxDecision {
+	return t.stuckTxHistory.get(address)
+}
+
+// FindStuckTransactions returns the transactions that are currently being recovered, at most one per address
+func (t *Txm) FindStuckTransactions() []*types.Transaction {
+	return t.stuckTxHistory.stuckTransactions()
+}
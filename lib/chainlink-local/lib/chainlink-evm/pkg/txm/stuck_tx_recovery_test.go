@@ -0,0 +1,213 @@
+package txm
+
+import (
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/logger"
+	"github.com/smartcontractkit/chainlink-evm/pkg/assets"
+	"github.com/smartcontractkit/chainlink-evm/pkg/gas"
+	"github.com/smartcontractkit/chainlink-evm/pkg/keys/keystest"
+	"github.com/smartcontractkit/chainlink-evm/pkg/testutils"
+	"github.com/smartcontractkit/chainlink-evm/pkg/txm/storage"
+	"github.com/smartcontractkit/chainlink-evm/pkg/txm/types"
+)
+
+func TestDecideStuckTxRemedy(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name      string
+		diagnosis stuckTxDiagnosis
+		reason    types.StuckTxReason
+		remedy    types.StuckTxRemedy
+	}{
+		{"dropped from mempool", stuckTxDiagnosis{InMempool: false}, types.StuckTxReasonDroppedFromMempool, types.StuckTxRemedyRebroadcast},
+		{"dropped from mempool with a low fee", stuckTxDiagnosis{InMempool: false, FeeBelowMarket: true}, types.StuckTxReasonDroppedFromMempool, types.StuckTxRemedyRebroadcast},
+		{"fee too low", stuckTxDiagnosis{InMempool: true, FeeBelowMarket: true}, types.StuckTxReasonFeeTooLow, types.StuckTxRemedyBump},
+		{"terminally stuck", stuckTxDiagnosis{InMempool: true}, types.StuckTxReasonTerminallyStuck, types.StuckTxRemedyRebroadcast},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			reason, remedy := decideStuckTxRemedy(tt.diagnosis)
+			assert.Equal(t, tt.reason, reason)
+			assert.Equal(t, tt.remedy, remedy)
+		})
+	}
+}
+
+func TestCompareFees(t *testing.T) {
+	t.Parallel()
+
+	legacy := func(price int64) gas.EvmFee { return gas.EvmFee{GasPrice: assets.NewWeiI(price)} }
+	dynamic := func(feeCap, tipCap int64) gas.EvmFee {
+		return gas.EvmFee{DynamicFee: gas.DynamicFee{GasFeeCap: assets.NewWeiI(feeCap), GasTipCap: assets.NewWeiI(tipCap)}}
+	}
+
+	assert.Equal(t, -1, compareFees(legacy(1), legacy(2)))
+	assert.Equal(t, 0, compareFees(legacy(2), legacy(2)))
+	assert.Equal(t, -1, compareFees(dynamic(10, 1), dynamic(20, 1)))
+	assert.Equal(t, -1, compareFees(dynamic(10, 1), dynamic(10, 2)))
+	assert.Equal(t, 1, compareFees(dynamic(20, 1), dynamic(10, 2)))
+	assert.Equal(t, 0, compareFees(legacy(1), dynamic(10, 2)))
+}
+
+func TestStuckTxHistory(t *testing.T) {
+	t.Parallel()
+
+	address := testutils.NewAddress()
+	nonce := uint64(0)
+	tx := &types.Transaction{ID: 1, FromAddress: address, Nonce: &nonce}
+
+	h := newStuckTxHistory()
+	for i := range stuckTxHistorySize + 5 {
+		h.record(tx, types.StuckTxDecision{TxID: uint64(i), FromAddress: address})
+	}
+	decisions := h.get(address)
+	require.Len(t, decisions, stuckTxHistorySize)
+	assert.Equal(t, uint64(5), decisions[0].TxID, "oldest decisions are dropped")
+	assert.Empty(t, h.get(testutils.NewAddress()))
+
+	require.Len(t, h.stuckTransactions(), 1)
+	h.unstuck(address, tx)
+	require.Len(t, h.stuckTransactions(), 1, "tx is still the lowest nonce unconfirmed transaction")
+	h.unstuck(address, nil)
+	assert.Empty(t, h.stuckTransactions())
+	assert.Len(t, h.get(address), stuckTxHistorySize, "decisions are kept once the tx is confirmed")
+}
+
+func TestRecoverStuckTransaction(t *testing.T) {
+	t.Parallel()
+
+	address := testutils.NewAddress()
+	keystore := keystest.Addresses{}
+	c := Config{EIP1559: false, BlockTime: time.Nanosecond, RetryBlockThreshold: 1, EmptyTxLimitDefault: 22000}
+
+	// newBroadcastedTx returns a TXM with a transaction at nonce 0 whose first attempt was broadcasted
+	newBroadcastedTx := func(t *testing.T) (*Txm, *mockClient, *mockAttemptBuilder, *types.Attempt) {
+		lggr := logger.Test(t)
+		client := newMockClient(t)
+		ab := newMockAttemptBuilder(t)
+		txStore := storage.NewInMemoryStoreManager(lggr, testutils.FixtureChainID)
+		require.NoError(t, txStore.Add(address))
+		txm := NewTxm(lggr, testutils.FixtureChainID, client, ab, txStore, nil, c, keystore)
+		emptyMetrics, err := NewTxmMetrics(testutils.FixtureChainID)
+		require.NoError(t, err)
+		txm.metrics = emptyMetrics
+
+		_, err = txm.CreateTransaction(t.Context(), &types.TxRequest{
+			ChainID:     testutils.FixtureChainID,
+			FromAddress: address,
+			ToAddress:   testutils.NewAddress(),
+		})
+		require.NoError(t, err)
+		tx, err := txStore.UpdateUnstartedTransactionWithNonce(t.Context(), address, 0)
+		require.NoError(t, err)
+
+		attempt := &types.Attempt{
+			TxID:     tx.ID,
+			Hash:     common.Hash{0x1},
+			Fee:      gas.EvmFee{GasPrice: assets.NewWeiI(1)},
+			GasLimit: 22000,
+		}
+		ab.On("NewAttempt", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(attempt, nil).Once()
+		client.On("SendTransaction", mock.Anything, mock.Anything, mock.Anything).Return(nil).Once()
+		require.NoError(t, txm.createAndSendAttempt(t.Context(), tx, address))
+		return txm, client, ab, attempt
+	}
+
+	t.Run("rebroadcasts a transaction dropped from the mempool", func(t *testing.T) {
+		txm, client, ab, attempt := newBroadcastedTx(t)
+
+		client.On("NonceAt", mock.Anything, address, mock.Anything).Return(uint64(0), nil).Once()
+		client.On("PendingNonceAt", mock.Anything, address).Return(uint64(0), nil).Once()
+		ab.On("NewAttempt", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(attempt, nil).Once()
+		client.On("SendTransaction", mock.Anything, mock.Anything, mock.MatchedBy(func(a *types.Attempt) bool {
+			return a.Hash == attempt.Hash
+		})).Return(nil).Once()
+		_, err := txm.backfillTransactions(t.Context(), address)
+		require.NoError(t, err)
+
+		decisions := txm.StuckTxHistory(address)
+		require.Len(t, decisions, 1)
+		assert.Equal(t, attempt.TxID, decisions[0].TxID)
+		assert.Equal(t, uint64(0), decisions[0].Nonce)
+		assert.Equal(t, types.StuckTxReasonDroppedFromMempool, decisions[0].Reason)
+		assert.Equal(t, types.StuckTxRemedyRebroadcast, decisions[0].Remedy)
+		assert.Empty(t, decisions[0].Error)
+		require.Len(t, txm.FindStuckTransactions(), 1)
+
+		// once the transaction is confirmed it isn't stuck anymore
+		client.On("NonceAt", mock.Anything, address, mock.Anything).Return(uint64(1), nil).Once()
+		_, err = txm.backfillTransactions(t.Context(), address)
+		require.NoError(t, err)
+		assert.Empty(t, txm.FindStuckTransactions())
+		assert.Len(t, txm.StuckTxHistory(address), 1)
+	})
+
+	t.Run("bumps a transaction with a fee below the market", func(t *testing.T) {
+		txm, client, ab, attempt := newBroadcastedTx(t)
+
+		marketAttempt := &types.Attempt{TxID: attempt.TxID, Fee: gas.EvmFee{GasPrice: assets.NewWeiI(2)}}
+		bumpedAttempt := &types.Attempt{
+			TxID:     attempt.TxID,
+			Hash:     common.Hash{0x2},
+			Fee:      gas.EvmFee{GasPrice: assets.NewWeiI(3)},
+			GasLimit: 22000,
+		}
+		client.On("NonceAt", mock.Anything, address, mock.Anything).Return(uint64(0), nil).Once()
+		client.On("PendingNonceAt", mock.Anything, address).Return(uint64(1), nil).Once()
+		ab.On("NewAttempt", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(marketAttempt, nil).Once()
+		ab.On("NewBumpAttempt", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(bumpedAttempt, nil).Once()
+		client.On("SendTransaction", mock.Anything, mock.Anything, mock.MatchedBy(func(a *types.Attempt) bool {
+			return a.Hash == bumpedAttempt.Hash
+		})).Return(nil).Once()
+		_, err := txm.backfillTransactions(t.Context(), address)
+		require.NoError(t, err)
+
+		decisions := txm.StuckTxHistory(address)
+		require.Len(t, decisions, 1)
+		assert.Equal(t, types.StuckTxReasonFeeTooLow, decisions[0].Reason)
+		assert.Equal(t, types.StuckTxRemedyBump, decisions[0].Remedy)
+	})
+
+	t.Run("records a self transfer for a nonce gap", func(t *testing.T) {
+		txm, client, ab, attempt := newBroadcastedTx(t)
+
+		// the transaction was replaced, so nonce 0 is confirmed and nonce 1 was never sent
+		client.On("NonceAt", mock.Anything, address, mock.Anything).Return(uint64(1), nil).Once()
+		_, err := txm.backfillTransactions(t.Context(), address)
+		require.NoError(t, err)
+		assert.Empty(t, txm.StuckTxHistory(address), "all transactions are confirmed")
+
+		_, err = txm.CreateTransaction(t.Context(), &types.TxRequest{
+			ChainID:     testutils.FixtureChainID,
+			FromAddress: address,
+			ToAddress:   testutils.NewAddress(),
+		})
+		require.NoError(t, err)
+		_, err = txm.txStore.UpdateUnstartedTransactionWithNonce(t.Context(), address, 2)
+		require.NoError(t, err)
+
+		client.On("NonceAt", mock.Anything, address, mock.Anything).Return(uint64(1), nil).Once()
+		emptyTxAttempt := &types.Attempt{TxID: attempt.TxID + 2, Fee: gas.EvmFee{GasPrice: assets.NewWeiI(1)}, GasLimit: 22000}
+		ab.On("NewAttempt", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(emptyTxAttempt, nil).Once()
+		client.On("SendTransaction", mock.Anything, mock.Anything, mock.Anything).Return(nil).Once()
+		_, err = txm.backfillTransactions(t.Context(), address)
+		require.NoError(t, err)
+
+		decisions := txm.StuckTxHistory(address)
+		require.Len(t, decisions, 1)
+		assert.Equal(t, uint64(1), decisions[0].Nonce)
+		assert.Equal(t, types.StuckTxReasonNonceGap, decisions[0].Reason)
+		assert.Equal(t, types.StuckTxRemedySelfTransfer, decisions[0].Remedy)
+		stuckTxs := txm.FindStuckTransactions()
+		require.Len(t, stuckTxs, 1)
+		assert.Equal(t, address, stuckTxs[0].ToAddress)
+	})
+}
@@ -84,6 +84,7 @@ type Txm struct {
 	keystore        keys.AddressLister
 	config          Config
 	metrics         *txmMetrics
+	stuckTxHistory  *stuckTxHistory
 
 	nonceMapMu sync.RWMutex
 	nonceMap   map[common.Address]uint64
@@ -103,6 +104,7 @@ func NewTxm(lggr logger.Logger, chainID *big.Int, client Client, attemptBuilder
 		txStore:         txStore,
 		stuckTxDetector: stuckTxDetector,
 		config:          config,
+		stuckTxHistory:  newStuckTxHistory(),
 		nonceMap:        make(map[common.Address]uint64),
 		triggerCh:       make(map[common.Address]chan struct{}),
 	}
@@ -389,6 +391,7 @@ func (t *Txm) backfillTransactions(ctx context.Context, address common.Address)
 	if err != nil {
 		return false, err
 	}
+	t.stuckTxHistory.unstuck(address, tx)
 	if unconfirmedCount == 0 {
 		t.lggr.Debugf("All transactions confirmed for address: %v", address)
 		return false, err // TODO: add backoff to optimize requests
@@ -411,7 +414,9 @@ func (t *Txm) backfillTransactions(ctx context.Context, address common.Address)
 					return false, err
 				}
 				t.lggr.Infof("Marked tx as purgeable. Sending purge attempt for txID: %d", tx.ID)
-				return false, t.createAndSendAttempt(ctx, tx, address)
+				err = t.createAndSendAttempt(ctx, tx, address)
+				t.recordStuckTxDecision(ctx, tx, types.StuckTxReasonTerminallyStuck, types.StuckTxRemedyPurge, err)
+				return false, err
 			}
 		}
 
@@ -422,11 +427,13 @@ func (t *Txm) backfillTransactions(ctx context.Context, address common.Address)
 				tx.PrintWithAttempts())
 		}
 
-		if tx.LastBroadcastAt == nil || time.Since(*tx.LastBroadcastAt) > (t.config.BlockTime*time.Duration(t.config.RetryBlockThreshold)) {
-			// TODO: add optional graceful bumping strategy
+		if tx.LastBroadcastAt == nil || len(tx.Attempts) == 0 {
 			t.lggr.Info("Rebroadcasting attempt for txID: ", tx.ID)
 			return false, t.createAndSendAttempt(ctx, tx, address)
 		}
+		if time.Since(*tx.LastBroadcastAt) > (t.config.BlockTime * time.Duration(t.config.RetryBlockThreshold)) {
+			return false, t.recoverStuckTransaction(ctx, tx, address)
+		}
 	}
 	return false, nil
 }
@@ -436,7 +443,9 @@ func (t *Txm) createAndSendEmptyTx(ctx context.Context, latestNonce uint64, addr
 	if err != nil {
 		return err
 	}
-	return t.createAndSendAttempt(ctx, tx, address)
+	err = t.createAndSendAttempt(ctx, tx, address)
+	t.recordStuckTxDecision(ctx, tx, types.StuckTxReasonNonceGap, types.StuckTxRemedySelfTransfer, err)
+	return err
 }
 
 func (t *Txm) extractMetrics(ctx context.Context, txs []*types.Transaction) []uint64 {
@@ -0,0 +1,48 @@
+package types
+
+import (
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// StuckTxReason is why an unconfirmed transaction is considered stuck
+type StuckTxReason string
+
+const (
+	// The fee of the newest attempt is below the fee currently estimated for the chain
+	StuckTxReasonFeeTooLow StuckTxReason = "fee_too_low"
+	// The attempts of the transaction are not in the mempool of the RPC anymore
+	StuckTxReasonDroppedFromMempool StuckTxReason = "dropped_from_mempool"
+	// A lower nonce was neither mined nor sent by the TXM, so the transaction can never be mined
+	StuckTxReasonNonceGap StuckTxReason = "nonce_gap"
+	// The transaction is not mined despite paying the market fee, e.g. because it can never fit in a block
+	StuckTxReasonTerminallyStuck StuckTxReason = "terminally_stuck"
+)
+
+// StuckTxRemedy is how a stuck transaction is recovered
+type StuckTxRemedy string
+
+const (
+	// Sends the newest attempt of the transaction again
+	StuckTxRemedyRebroadcast StuckTxRemedy = "rebroadcast"
+	// Sends a new attempt of the transaction with a bumped fee
+	StuckTxRemedyBump StuckTxRemedy = "bump"
+	// Sends an empty transaction to the from address at the missing nonce
+	StuckTxRemedySelfTransfer StuckTxRemedy = "self_transfer"
+	// Replaces the transaction with an empty transaction at the same nonce
+	StuckTxRemedyPurge StuckTxRemedy = "purge"
+)
+
+// StuckTxDecision records how a stuck transaction was recovered
+type StuckTxDecision struct {
+	TxID        uint64
+	FromAddress common.Address
+	// Nonce the remedy applies to. It is the missing nonce for a self transfer
+	Nonce     uint64
+	Reason    StuckTxReason
+	Remedy    StuckTxRemedy
+	DecidedAt time.Time
+	// Error is set if the remedy failed to be applied
+	Error string
+}